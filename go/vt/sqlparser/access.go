@@ -0,0 +1,594 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import "strings"
+
+// AccessType is the set of the ways a statement accesses a table.
+type AccessType int8
+
+// Constants for Enum Type - AccessType
+const (
+	AccessRead AccessType = 1 << iota
+	AccessWrite
+	AccessCreate
+	AccessDrop
+)
+
+// ToString returns the access types joined with |, like "read|write".
+func (access AccessType) ToString() string {
+	var types []string
+	for _, typ := range []struct {
+		access AccessType
+		name   string
+	}{
+		{AccessRead, "read"},
+		{AccessWrite, "write"},
+		{AccessCreate, "create"},
+		{AccessDrop, "drop"},
+	} {
+		if access&typ.access != 0 {
+			types = append(types, typ.name)
+		}
+	}
+	return strings.Join(types, "|")
+}
+
+// AccessClause is the clause of a statement a column is referenced in.
+type AccessClause int8
+
+// Constants for Enum Type - AccessClause
+const (
+	ClauseSelect AccessClause = iota
+	ClauseWhere
+	ClauseJoinOn
+	ClauseGroupBy
+	ClauseHaving
+	ClauseOrderBy
+	ClauseSet
+	ClauseInsertColumns
+	ClauseOther
+)
+
+// ToString returns the clause as a string.
+func (clause AccessClause) ToString() string {
+	switch clause {
+	case ClauseSelect:
+		return "select"
+	case ClauseWhere:
+		return "where"
+	case ClauseJoinOn:
+		return "join on"
+	case ClauseGroupBy:
+		return "group by"
+	case ClauseHaving:
+		return "having"
+	case ClauseOrderBy:
+		return "order by"
+	case ClauseSet:
+		return "set"
+	case ClauseInsertColumns:
+		return "insert columns"
+	default:
+		return "other"
+	}
+}
+
+// ColumnAccess is a column referenced in a clause of a statement. The name
+// of a column referenced with a star expression is *.
+type ColumnAccess struct {
+	Name   IdentifierCI
+	Clause AccessClause
+}
+
+// TableAccess is a table accessed by a statement.
+type TableAccess struct {
+	Table  TableName
+	Access AccessType
+	// Columns are the columns of the table referenced by the statement, in
+	// the order they first appear in each clause.
+	Columns []ColumnAccess
+}
+
+// HasColumn returns whether the column is referenced in the clause.
+func (t *TableAccess) HasColumn(name string, clause AccessClause) bool {
+	for _, col := range t.Columns {
+		if col.Clause == clause && col.Name.EqualString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *TableAccess) addColumn(name IdentifierCI, clause AccessClause) {
+	if t.HasColumn(name.String(), clause) {
+		return
+	}
+	t.Columns = append(t.Columns, ColumnAccess{Name: name, Clause: clause})
+}
+
+// AccessSet is the set of the tables and columns accessed by a statement.
+type AccessSet struct {
+	// Tables are the base tables accessed by the statement, in the order
+	// they first appear. Common table expressions and derived tables are
+	// not part of them, the tables their queries access are.
+	Tables []*TableAccess
+	// Unresolved are the columns that could not be attributed to a table:
+	// the unqualified columns of a query on several tables, and the columns
+	// whose qualifier is not a table in scope.
+	Unresolved []ColumnAccess
+}
+
+// Table returns the access to the table, or nil if the statement does not
+// access it.
+func (set *AccessSet) Table(name TableName) *TableAccess {
+	for _, t := range set.Tables {
+		if t.Table == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// TableNames returns the tables accessed in any of the ways of access.
+func (set *AccessSet) TableNames(access AccessType) TableNames {
+	var names TableNames
+	for _, t := range set.Tables {
+		if t.Access&access != 0 {
+			names = append(names, t.Table)
+		}
+	}
+	return names
+}
+
+func (set *AccessSet) addTable(name TableName, access AccessType) *TableAccess {
+	t := set.Table(name)
+	if t == nil {
+		t = &TableAccess{Table: name}
+		set.Tables = append(set.Tables, t)
+	}
+	t.Access |= access
+	return t
+}
+
+// AnalyzeAccess returns the tables a statement reads, writes, creates or
+// drops, and the columns of these tables it references. Table aliases,
+// common table expressions, derived tables and subqueries are resolved.
+//
+// Without a schema, an unqualified column can only be attributed when a
+// single table is in scope; in a subquery, it is attributed to the table
+// of the subquery rather than to one of the outer query.
+func AnalyzeAccess(stmt Statement) *AccessSet {
+	a := &accessAnalyzer{set: &AccessSet{}}
+	a.statement(stmt)
+	return a.set
+}
+
+// accessSource is a table expression a query reads from.
+type accessSource struct {
+	// name is the name of the table, or the alias of the table expression.
+	name TableName
+	// table is nil for derived tables, common table expressions and dual.
+	table *TableAccess
+}
+
+// matches returns whether a column or star expression qualifier refers to
+// the source.
+func (src accessSource) matches(qualifier TableName) bool {
+	if src.name.Name != qualifier.Name {
+		return false
+	}
+	return qualifier.Qualifier.IsEmpty() || qualifier.Qualifier == src.name.Qualifier
+}
+
+// accessScope holds the names a query can refer to.
+type accessScope struct {
+	parent  *accessScope
+	sources []accessSource
+	ctes    map[string]bool
+	// aliases are the aliases of the select expressions, which GROUP BY,
+	// HAVING and ORDER BY can refer to.
+	aliases map[string]bool
+}
+
+func newAccessScope(parent *accessScope) *accessScope {
+	return &accessScope{parent: parent}
+}
+
+func (scope *accessScope) isCTE(name TableName) bool {
+	if !name.Qualifier.IsEmpty() {
+		return false
+	}
+	for s := scope; s != nil; s = s.parent {
+		if s.ctes[name.Name.String()] {
+			return true
+		}
+	}
+	return false
+}
+
+func (scope *accessScope) addCTE(name IdentifierCS) {
+	if scope.ctes == nil {
+		scope.ctes = map[string]bool{}
+	}
+	scope.ctes[name.String()] = true
+}
+
+// resolve returns the source a qualifier refers to.
+func (scope *accessScope) resolve(qualifier TableName) (accessSource, bool) {
+	for s := scope; s != nil; s = s.parent {
+		for _, src := range s.sources {
+			if src.matches(qualifier) {
+				return src, true
+			}
+		}
+	}
+	return accessSource{}, false
+}
+
+type accessAnalyzer struct {
+	set *AccessSet
+}
+
+func (a *accessAnalyzer) statement(stmt Statement) {
+	switch stmt := stmt.(type) {
+	case SelectStatement:
+		a.selectStatement(stmt, nil)
+	case *Insert:
+		a.insert(stmt)
+	case *Update:
+		a.update(stmt)
+	case *Delete:
+		a.delete(stmt)
+	case *Load:
+		t := a.set.addTable(stmt.Table, AccessWrite)
+		for _, expr := range stmt.Columns {
+			if col, ok := expr.(*ColName); ok {
+				t.addColumn(col.Name, ClauseInsertColumns)
+			}
+		}
+		a.updateExprs(stmt.SetExprs, singleTableScope(stmt.Table, t))
+	case *CreateTable:
+		a.set.addTable(stmt.Table, AccessCreate)
+		if stmt.OptLike != nil {
+			a.set.addTable(stmt.OptLike.LikeTable, AccessRead)
+		}
+	case *CreateView:
+		a.set.addTable(stmt.ViewName, AccessCreate)
+		a.selectStatement(stmt.Select, nil)
+	case *AlterView:
+		a.set.addTable(stmt.ViewName, AccessWrite)
+		a.selectStatement(stmt.Select, nil)
+	case *AlterTable:
+		a.set.addTable(stmt.Table, AccessWrite)
+	case *TruncateTable:
+		a.set.addTable(stmt.Table, AccessWrite)
+	case *DropTable:
+		for _, name := range stmt.FromTables {
+			a.set.addTable(name, AccessDrop)
+		}
+	case *DropView:
+		for _, name := range stmt.FromTables {
+			a.set.addTable(name, AccessDrop)
+		}
+	case *RenameTable:
+		for _, pair := range stmt.TablePairs {
+			a.set.addTable(pair.FromTable, AccessDrop)
+			a.set.addTable(pair.ToTable, AccessCreate)
+		}
+	}
+}
+
+func (a *accessAnalyzer) selectStatement(stmt SelectStatement, parent *accessScope) {
+	switch stmt := stmt.(type) {
+	case *Select:
+		scope := newAccessScope(parent)
+		a.with(stmt.With, scope)
+		for _, expr := range stmt.From {
+			a.tableExpr(expr, scope, AccessRead)
+		}
+		for _, expr := range stmt.SelectExprs {
+			a.selectExpr(expr, scope)
+		}
+		for _, expr := range stmt.SelectExprs {
+			if aliased, ok := expr.(*AliasedExpr); ok && !aliased.As.IsEmpty() {
+				if scope.aliases == nil {
+					scope.aliases = map[string]bool{}
+				}
+				scope.aliases[aliased.As.Lowered()] = true
+			}
+		}
+		if stmt.Where != nil {
+			a.expr(stmt.Where.Expr, scope, ClauseWhere)
+		}
+		for _, expr := range stmt.GroupBy {
+			a.expr(expr, scope, ClauseGroupBy)
+		}
+		if stmt.Having != nil {
+			a.expr(stmt.Having.Expr, scope, ClauseHaving)
+		}
+		for _, order := range stmt.OrderBy {
+			a.expr(order.Expr, scope, ClauseOrderBy)
+		}
+	case *Union:
+		scope := newAccessScope(parent)
+		a.with(stmt.With, scope)
+		a.selectStatement(stmt.Left, scope)
+		a.selectStatement(stmt.Right, scope)
+	}
+}
+
+// with analyzes the queries of the common table expressions and adds their
+// names to the scope.
+func (a *accessAnalyzer) with(with *With, scope *accessScope) {
+	if with == nil {
+		return
+	}
+	for _, cte := range with.ctes {
+		if with.Recursive {
+			scope.addCTE(cte.ID)
+		}
+		a.selectStatement(cte.Subquery.Select, scope)
+		scope.addCTE(cte.ID)
+	}
+}
+
+// tableExpr adds the sources of a table expression to the scope, and
+// analyzes its join conditions.
+func (a *accessAnalyzer) tableExpr(expr TableExpr, scope *accessScope, access AccessType) {
+	switch expr := expr.(type) {
+	case *AliasedTableExpr:
+		switch table := expr.Expr.(type) {
+		case TableName:
+			src := accessSource{name: table}
+			isDual := table.Qualifier.IsEmpty() && table.Name.String() == "dual"
+			if !isDual && !scope.isCTE(table) {
+				src.table = a.set.addTable(table, access)
+			}
+			if !expr.As.IsEmpty() {
+				src.name = TableName{Name: expr.As}
+			}
+			scope.sources = append(scope.sources, src)
+		case *DerivedTable:
+			a.selectStatement(table.Select, scope)
+			scope.sources = append(scope.sources, accessSource{name: TableName{Name: expr.As}})
+		}
+	case *ParenTableExpr:
+		for _, expr := range expr.Exprs {
+			a.tableExpr(expr, scope, access)
+		}
+	case *JoinTableExpr:
+		start := len(scope.sources)
+		a.tableExpr(expr.LeftExpr, scope, access)
+		mid := len(scope.sources)
+		a.tableExpr(expr.RightExpr, scope, access)
+		if expr.Condition == nil {
+			return
+		}
+		if expr.Condition.On != nil {
+			a.expr(expr.Condition.On, scope, ClauseJoinOn)
+		}
+		for _, col := range expr.Condition.Using {
+			// a USING column is a column of both sides of the join
+			for _, sources := range [][]accessSource{scope.sources[start:mid], scope.sources[mid:]} {
+				if len(sources) != 1 {
+					a.unresolved(col, ClauseJoinOn)
+					continue
+				}
+				if sources[0].table != nil {
+					sources[0].table.addColumn(col, ClauseJoinOn)
+				}
+			}
+		}
+	case *JSONTableExpr:
+		a.expr(expr.Expr, scope, ClauseOther)
+		scope.sources = append(scope.sources, accessSource{name: TableName{Name: expr.Alias}})
+	}
+}
+
+func (a *accessAnalyzer) selectExpr(expr SelectExpr, scope *accessScope) {
+	switch expr := expr.(type) {
+	case *StarExpr:
+		star := NewIdentifierCI("*")
+		if expr.TableName.IsEmpty() {
+			for _, src := range scope.sources {
+				if src.table != nil {
+					src.table.addColumn(star, ClauseSelect)
+				}
+			}
+			return
+		}
+		src, ok := scope.resolve(expr.TableName)
+		switch {
+		case !ok:
+			a.unresolved(star, ClauseSelect)
+		case src.table != nil:
+			src.table.addColumn(star, ClauseSelect)
+		}
+	case *AliasedExpr:
+		a.expr(expr.Expr, scope, ClauseSelect)
+	}
+}
+
+// expr attributes the columns of an expression to the tables in scope, and
+// analyzes its subqueries.
+func (a *accessAnalyzer) expr(expr SQLNode, scope *accessScope, clause AccessClause) {
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *ColName:
+			a.column(node, scope, clause)
+		case *Subquery:
+			a.selectStatement(node.Select, scope)
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+}
+
+func (a *accessAnalyzer) column(col *ColName, scope *accessScope, clause AccessClause) {
+	if !col.Qualifier.IsEmpty() {
+		src, ok := scope.resolve(col.Qualifier)
+		switch {
+		case !ok:
+			a.unresolved(col.Name, clause)
+		case src.table != nil:
+			src.table.addColumn(col.Name, clause)
+		}
+		return
+	}
+	switch clause {
+	case ClauseGroupBy, ClauseHaving, ClauseOrderBy:
+		if scope.aliases[col.Name.Lowered()] {
+			return
+		}
+	}
+	for s := scope; s != nil; s = s.parent {
+		switch len(s.sources) {
+		case 0:
+			continue
+		case 1:
+			if t := s.sources[0].table; t != nil {
+				t.addColumn(col.Name, clause)
+			}
+		default:
+			a.unresolved(col.Name, clause)
+		}
+		return
+	}
+	a.unresolved(col.Name, clause)
+}
+
+func (a *accessAnalyzer) unresolved(name IdentifierCI, clause AccessClause) {
+	for _, col := range a.set.Unresolved {
+		if col.Clause == clause && col.Name.Equal(name) {
+			return
+		}
+	}
+	a.set.Unresolved = append(a.set.Unresolved, ColumnAccess{Name: name, Clause: clause})
+}
+
+// updateExprs attributes the assigned columns, and the columns their values
+// reference, to the SET clause. The tables of the assigned columns are
+// written.
+func (a *accessAnalyzer) updateExprs(exprs UpdateExprs, scope *accessScope) {
+	for _, expr := range exprs {
+		if !expr.Name.Qualifier.IsEmpty() {
+			if src, ok := scope.resolve(expr.Name.Qualifier); ok && src.table != nil {
+				src.table.Access |= AccessWrite
+			}
+		} else if len(scope.sources) == 1 && scope.sources[0].table != nil {
+			scope.sources[0].table.Access |= AccessWrite
+		}
+		a.column(expr.Name, scope, ClauseSet)
+		a.expr(expr.Expr, scope, ClauseSet)
+	}
+}
+
+func singleTableScope(name TableName, t *TableAccess) *accessScope {
+	scope := newAccessScope(nil)
+	scope.sources = []accessSource{{name: name, table: t}}
+	return scope
+}
+
+func (a *accessAnalyzer) insert(stmt *Insert) {
+	name, ok := stmt.Table.Expr.(TableName)
+	if !ok {
+		return
+	}
+	t := a.set.addTable(name, AccessWrite)
+	scope := singleTableScope(name, t)
+	if !stmt.Table.As.IsEmpty() {
+		scope.sources[0].name = TableName{Name: stmt.Table.As}
+	}
+	for _, col := range stmt.Columns {
+		t.addColumn(col, ClauseInsertColumns)
+	}
+	switch rows := stmt.Rows.(type) {
+	case SelectStatement:
+		a.selectStatement(rows, nil)
+	case Values:
+		a.expr(rows, scope, ClauseOther)
+	}
+	a.updateExprs(UpdateExprs(stmt.OnDup), scope)
+	if stmt.OnConflict != nil {
+		a.updateExprs(stmt.OnConflict.Exprs, scope)
+		if stmt.OnConflict.Where != nil {
+			a.expr(stmt.OnConflict.Where.Expr, scope, ClauseWhere)
+		}
+	}
+	for _, expr := range stmt.Returning {
+		a.selectExpr(expr, scope)
+	}
+}
+
+// readUnwritten marks the tables of a scope that are not written as read.
+func readUnwritten(scope *accessScope) {
+	for _, src := range scope.sources {
+		if src.table != nil && src.table.Access&AccessWrite == 0 {
+			src.table.Access |= AccessRead
+		}
+	}
+}
+
+func (a *accessAnalyzer) update(stmt *Update) {
+	scope := newAccessScope(nil)
+	a.with(stmt.With, scope)
+	for _, expr := range stmt.TableExprs {
+		a.tableExpr(expr, scope, 0)
+	}
+	a.updateExprs(stmt.Exprs, scope)
+	readUnwritten(scope)
+	if stmt.Where != nil {
+		a.expr(stmt.Where.Expr, scope, ClauseWhere)
+	}
+	for _, order := range stmt.OrderBy {
+		a.expr(order.Expr, scope, ClauseOrderBy)
+	}
+	for _, expr := range stmt.Returning {
+		a.selectExpr(expr, scope)
+	}
+}
+
+func (a *accessAnalyzer) delete(stmt *Delete) {
+	scope := newAccessScope(nil)
+	a.with(stmt.With, scope)
+	for _, expr := range stmt.TableExprs {
+		a.tableExpr(expr, scope, 0)
+	}
+	if len(stmt.Targets) == 0 {
+		for _, src := range scope.sources {
+			if src.table != nil {
+				src.table.Access |= AccessWrite
+			}
+		}
+	}
+	for _, target := range stmt.Targets {
+		if src, ok := scope.resolve(target); ok && src.table != nil {
+			src.table.Access |= AccessWrite
+		}
+	}
+	readUnwritten(scope)
+	if stmt.Where != nil {
+		a.expr(stmt.Where.Expr, scope, ClauseWhere)
+	}
+	for _, order := range stmt.OrderBy {
+		a.expr(order.Expr, scope, ClauseOrderBy)
+	}
+	for _, expr := range stmt.Returning {
+		a.selectExpr(expr, scope)
+	}
+}
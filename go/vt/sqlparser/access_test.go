@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// accessString returns the tables of an access set as
+// "table access: column/clause ...".
+func accessString(set *AccessSet) []string {
+	var result []string
+	for _, t := range set.Tables {
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "%s %s", String(t.Table), t.Access.ToString())
+		for i, col := range t.Columns {
+			if i == 0 {
+				sb.WriteByte(':')
+			}
+			fmt.Fprintf(&sb, " %s/%s", col.Name.String(), col.Clause.ToString())
+		}
+		result = append(result, sb.String())
+	}
+	return result
+}
+
+func TestAnalyzeAccess(t *testing.T) {
+	testcases := []struct {
+		sql        string
+		tables     []string
+		unresolved []string
+	}{{
+		sql:    "select a, t.b from t where c = 1 group by d having count(e) > 1 order by f",
+		tables: []string{"t read: a/select b/select c/where d/group by e/having f/order by"},
+	}, {
+		sql: "select x.a, y.b from db.t1 as x join t2 y on x.id = y.id where y.c = 1",
+		tables: []string{
+			"db.t1 read: id/join on a/select",
+			"t2 read: id/join on b/select c/where",
+		},
+	}, {
+		sql:        "select a, t1.b from t1, t2 where t2.c = d",
+		tables:     []string{"t1 read: b/select", "t2 read: c/where"},
+		unresolved: []string{"a/select", "d/where"},
+	}, {
+		sql:    "select a + 1 as x from t order by x, b",
+		tables: []string{"t read: a/select b/order by"},
+	}, {
+		sql:    "select t1.*, t2.a from t1 join t2 using (id)",
+		tables: []string{"t1 read: id/join on */select", "t2 read: id/join on a/select"},
+	}, {
+		sql: "with c as (select a, b from t1 where d = 1) select c.a, t2.e from c join t2 on c.b = t2.b",
+		tables: []string{
+			"t1 read: a/select b/select d/where",
+			"t2 read: b/join on e/select",
+		},
+	}, {
+		sql:    "with recursive c as (select 1 as n from dual union all select n + 1 from c where n < 5) select * from c",
+		tables: nil,
+	}, {
+		sql: "select d.a from (select a from t1 where b = 1) as d where d.a in (select c from t2 where t2.e = d.a)",
+		tables: []string{
+			"t1 read: a/select b/where",
+			"t2 read: c/select e/where",
+		},
+	}, {
+		sql:    "select a from t1 where exists (select 1 from t2 where t2.b = t1.b)",
+		tables: []string{"t1 read: a/select b/where", "t2 read: b/where"},
+	}, {
+		sql:    "select a from t1 union select b from t2",
+		tables: []string{"t1 read: a/select", "t2 read: b/select"},
+	}, {
+		sql:    "select * from t1 as x where x.a = (select max(a) from t1)",
+		tables: []string{"t1 read: */select a/where a/select"},
+	}, {
+		sql:    "insert into t(a, b) values (1, 2) on duplicate key update b = b + 1",
+		tables: []string{"t write: a/insert columns b/insert columns b/set"},
+	}, {
+		sql:    "insert into t1(a) select b from t2 where c = 1",
+		tables: []string{"t1 write: a/insert columns", "t2 read: b/select c/where"},
+	}, {
+		sql:    "update t set a = 1, b = c where d = 2 order by e",
+		tables: []string{"t write: a/set b/set c/set d/where e/order by"},
+	}, {
+		sql:    "update t1 as x join t2 as y on x.id = y.id set x.a = y.b where y.c = 1",
+		tables: []string{"t1 write: id/join on a/set", "t2 read: id/join on b/set c/where"},
+	}, {
+		sql:    "delete from t where a = 1",
+		tables: []string{"t write: a/where"},
+	}, {
+		sql:    "delete x from t1 as x join t2 on x.id = t2.id where t2.a = 1",
+		tables: []string{"t1 write: id/join on", "t2 read: id/join on a/where"},
+	}, {
+		sql:    "create table t (a int)",
+		tables: []string{"t create"},
+	}, {
+		sql:    "create table t2 like t1",
+		tables: []string{"t2 create", "t1 read"},
+	}, {
+		sql:    "create view v as select a from t",
+		tables: []string{"v create", "t read: a/select"},
+	}, {
+		sql:    "drop table t1, db.t2",
+		tables: []string{"t1 drop", "db.t2 drop"},
+	}, {
+		sql:    "rename table t1 to t2",
+		tables: []string{"t1 drop", "t2 create"},
+	}, {
+		sql:    "truncate table t",
+		tables: []string{"t write"},
+	}, {
+		sql:    "alter table t add column a int",
+		tables: []string{"t write"},
+	}, {
+		sql:    "load data infile 'x.csv' into table t (a, b) set c = 1",
+		tables: []string{"t write: a/insert columns b/insert columns c/set"},
+	}, {
+		sql: "show tables",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.sql, func(t *testing.T) {
+			stmt, err := Parse(tcase.sql)
+			require.NoError(t, err)
+			set := AnalyzeAccess(stmt)
+			assert.Equal(t, tcase.tables, accessString(set))
+			var unresolved []string
+			for _, col := range set.Unresolved {
+				unresolved = append(unresolved, col.Name.String()+"/"+col.Clause.ToString())
+			}
+			assert.Equal(t, tcase.unresolved, unresolved)
+		})
+	}
+}
+
+func TestAccessSetTableNames(t *testing.T) {
+	stmt, err := Parse("insert into t1 select a from t2 join t3 on t2.id = t3.id")
+	require.NoError(t, err)
+	set := AnalyzeAccess(stmt)
+	assert.Equal(t, "t1", String(set.TableNames(AccessWrite)))
+	assert.Equal(t, "t2, t3", String(set.TableNames(AccessRead)))
+	assert.Equal(t, "t1, t2, t3", String(set.TableNames(AccessRead|AccessWrite)))
+
+	t2 := set.Table(TableName{Name: NewIdentifierCS("t2")})
+	require.NotNil(t, t2)
+	assert.True(t, t2.HasColumn("ID", ClauseJoinOn))
+	assert.False(t, t2.HasColumn("id", ClauseWhere))
+	assert.Nil(t, set.Table(TableName{Name: NewIdentifierCS("t4")}))
+	assert.Equal(t, "read|write", (AccessRead | AccessWrite).ToString())
+}
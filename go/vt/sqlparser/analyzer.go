@@ -123,6 +123,8 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtExecute
 	case *DeallocateStmt:
 		return StmtDeallocate
+	case *Grant, *Revoke, *CreateUser, *AlterUser, *DropUser, *RenameUser, *CreateRole, *DropRole, *SetRole, *SetDefaultRole, *SetPassword:
+		return StmtPriv
 	default:
 		return StmtUnknown
 	}
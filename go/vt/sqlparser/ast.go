@@ -277,6 +277,8 @@ type (
 		ExportOption string
 		Manifest     string
 		Overwrite    string
+		// Variables holds the targets of an INTO clause of variables.
+		Variables Exprs
 	}
 
 	// SelectIntoType is an enum for SelectInto.Type
@@ -285,10 +287,15 @@ type (
 	// Lock is an enum for the type of lock in the statement
 	Lock int8
 
-	// Union represents a UNION statement.
+	// SetOpType is an enum for Union.Type
+	SetOpType int8
+
+	// Union represents a UNION, INTERSECT or EXCEPT statement, as told by
+	// its Type.
 	Union struct {
 		Left     SelectStatement
 		Right    SelectStatement
+		Type     SetOpType
 		Distinct bool
 		OrderBy  OrderBy
 		With     *With
@@ -332,6 +339,8 @@ type (
 		Columns    Columns
 		Rows       InsertRows
 		OnDup      OnDup
+		OnConflict *OnConflict
+		Returning  SelectExprs
 	}
 
 	// Ignore represents whether ignore was specified or not
@@ -351,6 +360,7 @@ type (
 		Where      *Where
 		OrderBy    OrderBy
 		Limit      *Limit
+		Returning  SelectExprs
 	}
 
 	// Delete represents a DELETE statement.
@@ -365,6 +375,7 @@ type (
 		Where      *Where
 		OrderBy    OrderBy
 		Limit      *Limit
+		Returning  SelectExprs
 	}
 
 	// Set represents a SET statement.
@@ -549,11 +560,201 @@ type (
 		Address string
 	}
 
+	// CreateProcedure represents a CREATE PROCEDURE statement.
+	CreateProcedure struct {
+		Comments        *ParsedComments
+		Definer         *Definer
+		IfNotExists     bool
+		Name            TableName
+		Params          []*ProcParameter
+		Characteristics []*RoutineCharacteristic
+		Body            Statement
+	}
+
+	// CreateFunction represents a CREATE FUNCTION statement for a stored function.
+	CreateFunction struct {
+		Comments        *ParsedComments
+		Definer         *Definer
+		IfNotExists     bool
+		Name            TableName
+		Params          []*ProcParameter
+		Returns         *ColumnType
+		Characteristics []*RoutineCharacteristic
+		Body            Statement
+	}
+
+	// AlterProcedure represents an ALTER PROCEDURE statement.
+	AlterProcedure struct {
+		Comments        *ParsedComments
+		Name            TableName
+		Characteristics []*RoutineCharacteristic
+	}
+
+	// AlterFunction represents an ALTER FUNCTION statement.
+	AlterFunction struct {
+		Comments        *ParsedComments
+		Name            TableName
+		Characteristics []*RoutineCharacteristic
+	}
+
+	// DropProcedure represents a DROP PROCEDURE statement.
+	DropProcedure struct {
+		Comments *ParsedComments
+		IfExists bool
+		Name     TableName
+	}
+
+	// DropFunction represents a DROP FUNCTION statement.
+	DropFunction struct {
+		Comments *ParsedComments
+		IfExists bool
+		Name     TableName
+	}
+
+	// ProcParameter represents a parameter of a stored procedure or function.
+	// The Mode is only set for procedure parameters.
+	ProcParameter struct {
+		Mode ProcParameterMode
+		Name IdentifierCI
+		Type *ColumnType
+	}
+
+	// ProcParameterMode is an enum for ProcParameter.Mode
+	ProcParameterMode int8
+
+	// RoutineCharacteristic represents a characteristic of a stored procedure or function,
+	// such as DETERMINISTIC or SQL SECURITY INVOKER.
+	RoutineCharacteristic struct {
+		Type RoutineCharacteristicType
+		// Comment is set for CommentCharacteristic.
+		Comment string
+	}
+
+	// RoutineCharacteristicType is an enum for RoutineCharacteristic.Type
+	RoutineCharacteristicType int8
+
+	// CreateTrigger represents a CREATE TRIGGER statement.
+	CreateTrigger struct {
+		Comments    *ParsedComments
+		Definer     *Definer
+		IfNotExists bool
+		Name        TableName
+		Timing      TriggerTiming
+		Event       TriggerEvent
+		Table       TableName
+		Order       *TriggerOrder
+		Body        Statement
+	}
+
+	// DropTrigger represents a DROP TRIGGER statement.
+	DropTrigger struct {
+		Comments *ParsedComments
+		IfExists bool
+		Name     TableName
+	}
+
+	// TriggerTiming is an enum for CreateTrigger.Timing
+	TriggerTiming int8
+
+	// TriggerEvent is an enum for CreateTrigger.Event
+	TriggerEvent int8
+
+	// TriggerOrder represents the FOLLOWS or PRECEDES clause of a CREATE TRIGGER statement.
+	TriggerOrder struct {
+		Precedes bool
+		Name     IdentifierCI
+	}
+
+	// CreateEvent represents a CREATE EVENT statement.
+	CreateEvent struct {
+		Comments     *ParsedComments
+		Definer      *Definer
+		IfNotExists  bool
+		Name         TableName
+		Schedule     *EventSchedule
+		OnCompletion EventOnCompletion
+		Status       EventStatus
+		Comment      *Literal
+		Body         Statement
+	}
+
+	// AlterEvent represents an ALTER EVENT statement.
+	// The clauses that are not given are left empty.
+	AlterEvent struct {
+		Comments     *ParsedComments
+		Definer      *Definer
+		Name         TableName
+		Schedule     *EventSchedule
+		OnCompletion EventOnCompletion
+		RenameTo     TableName
+		Status       EventStatus
+		Comment      *Literal
+		Body         Statement
+	}
+
+	// DropEvent represents a DROP EVENT statement.
+	DropEvent struct {
+		Comments *ParsedComments
+		IfExists bool
+		Name     TableName
+	}
+
+	// EventSchedule represents the ON SCHEDULE clause of an event. Either At is set
+	// for a one-time event, or Every and Unit for a recurring one.
+	EventSchedule struct {
+		At     Expr
+		Every  Expr
+		Unit   IntervalTypes
+		Starts Expr
+		Ends   Expr
+	}
+
+	// EventOnCompletion is an enum for the ON COMPLETION clause of an event.
+	EventOnCompletion int8
+
+	// EventStatus is an enum for the ENABLE and DISABLE clauses of an event.
+	EventStatus int8
+
 	// DDLAction is an enum for DDL.Action
 	DDLAction int8
 
-	// Load represents a LOAD statement
+	// Load represents a LOAD DATA statement
 	Load struct {
+		Priority   LoadPriority
+		Local      bool
+		FileName   string
+		Replace    bool
+		Ignore     Ignore
+		Table      TableName
+		Partitions Partitions
+		Charset    ColumnCharset
+		Fields     *LoadFields
+		Lines      *LoadLines
+		// IgnoreLines is the number of lines of the IGNORE n LINES clause.
+		IgnoreLines *Literal
+		// Columns holds the *ColName columns and *Variable user variables the
+		// fields of the input lines are assigned to.
+		Columns  Exprs
+		SetExprs UpdateExprs
+	}
+
+	// LoadPriority is an enum for Load.Priority
+	LoadPriority int8
+
+	// LoadFields represents the FIELDS clause of a LOAD DATA statement.
+	// The options that are not given are nil.
+	LoadFields struct {
+		TerminatedBy       *Literal
+		OptionallyEnclosed bool
+		EnclosedBy         *Literal
+		EscapedBy          *Literal
+	}
+
+	// LoadLines represents the LINES clause of a LOAD DATA statement.
+	// The options that are not given are nil.
+	LoadLines struct {
+		StartingBy   *Literal
+		TerminatedBy *Literal
 	}
 
 	// PurgeBinaryLogs represents a PURGE BINARY LOGS statement
@@ -562,6 +763,165 @@ type (
 		Before string
 	}
 
+	// Grant represents a GRANT statement. It either grants Privileges On a
+	// privilege level, Roles, or the Proxy of an account, to the accounts in To.
+	Grant struct {
+		Privileges      []*GrantPrivilege
+		On              *PrivilegeLevel
+		Roles           []*Account
+		Proxy           *Account
+		To              []*Account
+		WithGrantOption bool
+		WithAdminOption bool
+	}
+
+	// Revoke represents a REVOKE statement. It either revokes Privileges On a
+	// privilege level, Roles, or the Proxy of an account, from the accounts
+	// in From. On is nil for REVOKE ALL PRIVILEGES, GRANT OPTION.
+	Revoke struct {
+		IfExists          bool
+		Privileges        []*GrantPrivilege
+		On                *PrivilegeLevel
+		Roles             []*Account
+		Proxy             *Account
+		From              []*Account
+		IgnoreUnknownUser bool
+	}
+
+	// GrantPrivilege represents a privilege of a GRANT or REVOKE statement, such as
+	// `select (a, b)` or the dynamic privilege `backup_admin`. Name is lower case.
+	GrantPrivilege struct {
+		Name    string
+		Columns Columns
+	}
+
+	// PrivilegeLevel represents the ON clause of a GRANT or REVOKE statement:
+	// *, *.*, db.*, db.tbl or tbl, with an optional object type.
+	PrivilegeLevel struct {
+		ObjectType PrivilegeObjectType
+		// Global is set for *.*
+		Global bool
+		// DB is the database of db.* and db.tbl.
+		DB IdentifierCS
+		// Name is the table or routine of db.tbl and tbl, empty for the wildcards.
+		Name IdentifierCS
+	}
+
+	// PrivilegeObjectType is an enum for PrivilegeLevel.ObjectType
+	PrivilegeObjectType int8
+
+	// Account represents the name of a user account or role, 'user'@'host'.
+	// Host is empty when the name has no host part. CurrentUser is set for
+	// the USER() account of ALTER USER.
+	Account struct {
+		User        string
+		Host        string
+		CurrentUser bool
+	}
+
+	// Authentication represents the IDENTIFIED clause of an account.
+	Authentication struct {
+		Plugin IdentifierCI
+		// Password is the password of IDENTIFIED BY, or the hashed password
+		// of IDENTIFIED WITH ... AS when Hashed is set.
+		Password       *Literal
+		Hashed         bool
+		RandomPassword bool
+		// CurrentPassword is the password of REPLACE, which ALTER USER
+		// checks before changing the password.
+		CurrentPassword *Literal
+	}
+
+	// UserSpec represents an account and its authentication in a
+	// CREATE USER or ALTER USER statement.
+	UserSpec struct {
+		Account *Account
+		Auth    *Authentication
+	}
+
+	// AccountLock is an enum for the ACCOUNT LOCK and ACCOUNT UNLOCK options.
+	AccountLock int8
+
+	// PasswordExpire represents the PASSWORD EXPIRE option of an account.
+	// Days is set for PASSWORD EXPIRE INTERVAL n DAY.
+	PasswordExpire struct {
+		Type PasswordExpireType
+		Days *Literal
+	}
+
+	// PasswordExpireType is an enum for PasswordExpire.Type
+	PasswordExpireType int8
+
+	// CreateUser represents a CREATE USER statement.
+	CreateUser struct {
+		IfNotExists    bool
+		Users          []*UserSpec
+		DefaultRoles   []*Account
+		PasswordExpire *PasswordExpire
+		AccountLock    AccountLock
+	}
+
+	// AlterUser represents an ALTER USER statement.
+	AlterUser struct {
+		IfExists       bool
+		Users          []*UserSpec
+		PasswordExpire *PasswordExpire
+		AccountLock    AccountLock
+	}
+
+	// DropUser represents a DROP USER statement.
+	DropUser struct {
+		IfExists bool
+		Users    []*Account
+	}
+
+	// RenameUserPair represents an account and its new name in a RENAME USER statement.
+	RenameUserPair struct {
+		FromUser *Account
+		ToUser   *Account
+	}
+
+	// RenameUser represents a RENAME USER statement.
+	RenameUser struct {
+		UserPairs []*RenameUserPair
+	}
+
+	// CreateRole represents a CREATE ROLE statement.
+	CreateRole struct {
+		IfNotExists bool
+		Roles       []*Account
+	}
+
+	// DropRole represents a DROP ROLE statement.
+	DropRole struct {
+		IfExists bool
+		Roles    []*Account
+	}
+
+	// SetRoleType is an enum for SetRole.Type and SetDefaultRole.Type
+	SetRoleType int8
+
+	// SetRole represents a SET ROLE statement. Roles is set for ListRoles,
+	// and holds the excepted roles of ALL EXCEPT for AllRoles.
+	SetRole struct {
+		Type  SetRoleType
+		Roles []*Account
+	}
+
+	// SetDefaultRole represents a SET DEFAULT ROLE statement. Roles is set for ListRoles.
+	SetDefaultRole struct {
+		Type  SetRoleType
+		Roles []*Account
+		To    []*Account
+	}
+
+	// SetPassword represents a SET PASSWORD statement. For is nil for the
+	// current user, and Password is nil for TO RANDOM.
+	SetPassword struct {
+		For      *Account
+		Password *Literal
+	}
+
 	// Show represents a show statement.
 	Show struct {
 		Internal ShowInternal
@@ -694,6 +1054,180 @@ type (
 	CommentOnly struct {
 		Comments []string
 	}
+
+	// BadStatement is the placeholder of a statement with a syntax error,
+	// see ParseScript. It holds the text of the statement.
+	BadStatement struct {
+		SQL string
+	}
+)
+
+// Compound statements are the statements of the bodies of stored programs.
+// They implement the Statement interface, so that they can be mixed
+// with plain SQL statements in a StatementList.
+type (
+	// StatementList represents the statements of a compound statement.
+	StatementList []Statement
+
+	// BeginEndBlock represents a [label:] BEGIN ... END [label] compound statement.
+	BeginEndBlock struct {
+		Label      IdentifierCI
+		Statements StatementList
+	}
+
+	// DeclareVar represents a DECLARE statement of local variables.
+	DeclareVar struct {
+		Names   Columns
+		Type    *ColumnType
+		Default Expr
+	}
+
+	// DeclareCondition represents a DECLARE ... CONDITION statement.
+	DeclareCondition struct {
+		Name  IdentifierCI
+		Value *ConditionValue
+	}
+
+	// DeclareCursor represents a DECLARE ... CURSOR statement.
+	DeclareCursor struct {
+		Name   IdentifierCI
+		Select SelectStatement
+	}
+
+	// DeclareHandler represents a DECLARE ... HANDLER statement.
+	DeclareHandler struct {
+		Action     HandlerAction
+		Conditions []*ConditionValue
+		Statement  Statement
+	}
+
+	// HandlerAction is an enum for DeclareHandler.Action
+	HandlerAction int8
+
+	// ConditionValue represents a condition of a handler, a named condition or a signal.
+	ConditionValue struct {
+		Type ConditionValueType
+		// Value is the error code for ErrorCodeCondition, or the SQLSTATE value for SQLStateCondition.
+		Value string
+		// Name is set for NamedCondition.
+		Name IdentifierCI
+	}
+
+	// ConditionValueType is an enum for ConditionValue.Type
+	ConditionValueType int8
+
+	// IfStatement represents an IF ... END IF statement.
+	IfStatement struct {
+		Cond       Expr
+		Statements StatementList
+		ElseIfs    []*ElseIfBlock
+		Else       StatementList
+	}
+
+	// ElseIfBlock represents an ELSEIF clause of an IfStatement.
+	ElseIfBlock struct {
+		Cond       Expr
+		Statements StatementList
+	}
+
+	// CaseStatement represents a CASE ... END CASE statement.
+	CaseStatement struct {
+		Expr  Expr
+		Whens []*CaseStatementWhen
+		Else  StatementList
+	}
+
+	// CaseStatementWhen represents a WHEN clause of a CaseStatement.
+	CaseStatementWhen struct {
+		Cond       Expr
+		Statements StatementList
+	}
+
+	// LoopStatement represents a [label:] LOOP ... END LOOP [label] statement.
+	LoopStatement struct {
+		Label      IdentifierCI
+		Statements StatementList
+	}
+
+	// WhileStatement represents a [label:] WHILE ... END WHILE [label] statement.
+	WhileStatement struct {
+		Label      IdentifierCI
+		Cond       Expr
+		Statements StatementList
+	}
+
+	// RepeatStatement represents a [label:] REPEAT ... END REPEAT [label] statement.
+	RepeatStatement struct {
+		Label      IdentifierCI
+		Statements StatementList
+		Until      Expr
+	}
+
+	// LeaveStatement represents a LEAVE statement.
+	LeaveStatement struct {
+		Label IdentifierCI
+	}
+
+	// IterateStatement represents an ITERATE statement.
+	IterateStatement struct {
+		Label IdentifierCI
+	}
+
+	// RoutineSet represents a SET statement of a stored program body that
+	// assigns to a local variable or to a NEW.col or OLD.col column of a
+	// trigger. A SET statement that only assigns to user and system
+	// variables is represented by a Set.
+	RoutineSet struct {
+		Comments *ParsedComments
+		Exprs    RoutineSetExprs
+	}
+
+	// RoutineSetExprs represents the assignments of a RoutineSet.
+	RoutineSetExprs []*RoutineSetExpr
+
+	// RoutineSetExpr represents an assignment of a RoutineSet. Var is a
+	// *ColName for a local variable or a trigger column, and a *Variable
+	// otherwise.
+	RoutineSetExpr struct {
+		Var  Expr
+		Expr Expr
+	}
+
+	// ReturnStatement represents a RETURN statement of a stored function.
+	ReturnStatement struct {
+		Expr Expr
+	}
+
+	// OpenCursor represents an OPEN statement.
+	OpenCursor struct {
+		Name IdentifierCI
+	}
+
+	// CloseCursor represents a CLOSE statement.
+	CloseCursor struct {
+		Name IdentifierCI
+	}
+
+	// FetchCursor represents a FETCH ... INTO statement.
+	FetchCursor struct {
+		Name      IdentifierCI
+		Variables Columns
+	}
+
+	// Signal represents a SIGNAL or RESIGNAL statement.
+	// The Condition is optional for RESIGNAL.
+	Signal struct {
+		Resignal  bool
+		Condition *ConditionValue
+		Items     []*SignalItem
+	}
+
+	// SignalItem represents a condition information item of a Signal,
+	// such as MESSAGE_TEXT = 'error'.
+	SignalItem struct {
+		Name  string
+		Value Expr
+	}
 )
 
 func (*Union) iStatement()               {}
@@ -717,6 +1251,7 @@ func (*Release) iStatement()             {}
 func (*OtherRead) iStatement()           {}
 func (*OtherAdmin) iStatement()          {}
 func (*CommentOnly) iStatement()         {}
+func (*BadStatement) iStatement()        {}
 func (*Select) iSelectStatement()        {}
 func (*Union) iSelectStatement()         {}
 func (*Load) iStatement()                {}
@@ -746,15 +1281,66 @@ func (*PrepareStmt) iStatement()         {}
 func (*ExecuteStmt) iStatement()         {}
 func (*DeallocateStmt) iStatement()      {}
 func (*PurgeBinaryLogs) iStatement()     {}
-
-func (*CreateView) iDDLStatement()    {}
-func (*AlterView) iDDLStatement()     {}
-func (*CreateTable) iDDLStatement()   {}
-func (*DropTable) iDDLStatement()     {}
-func (*DropView) iDDLStatement()      {}
-func (*AlterTable) iDDLStatement()    {}
-func (*TruncateTable) iDDLStatement() {}
-func (*RenameTable) iDDLStatement()   {}
+func (*Grant) iStatement()               {}
+func (*Revoke) iStatement()              {}
+func (*CreateUser) iStatement()          {}
+func (*AlterUser) iStatement()           {}
+func (*DropUser) iStatement()            {}
+func (*RenameUser) iStatement()          {}
+func (*CreateRole) iStatement()          {}
+func (*DropRole) iStatement()            {}
+func (*SetRole) iStatement()             {}
+func (*SetDefaultRole) iStatement()      {}
+func (*SetPassword) iStatement()         {}
+func (*CreateProcedure) iStatement()     {}
+func (*CreateFunction) iStatement()      {}
+func (*AlterProcedure) iStatement()      {}
+func (*AlterFunction) iStatement()       {}
+func (*DropProcedure) iStatement()       {}
+func (*DropFunction) iStatement()        {}
+func (*CreateTrigger) iStatement()       {}
+func (*DropTrigger) iStatement()         {}
+func (*CreateEvent) iStatement()         {}
+func (*AlterEvent) iStatement()          {}
+func (*DropEvent) iStatement()           {}
+func (*BeginEndBlock) iStatement()       {}
+func (*DeclareVar) iStatement()          {}
+func (*DeclareCondition) iStatement()    {}
+func (*DeclareCursor) iStatement()       {}
+func (*DeclareHandler) iStatement()      {}
+func (*IfStatement) iStatement()         {}
+func (*CaseStatement) iStatement()       {}
+func (*LoopStatement) iStatement()       {}
+func (*WhileStatement) iStatement()      {}
+func (*RepeatStatement) iStatement()     {}
+func (*LeaveStatement) iStatement()      {}
+func (*IterateStatement) iStatement()    {}
+func (*RoutineSet) iStatement()          {}
+func (*ReturnStatement) iStatement()     {}
+func (*OpenCursor) iStatement()          {}
+func (*CloseCursor) iStatement()         {}
+func (*FetchCursor) iStatement()         {}
+func (*Signal) iStatement()              {}
+
+func (*CreateView) iDDLStatement()      {}
+func (*AlterView) iDDLStatement()       {}
+func (*CreateTable) iDDLStatement()     {}
+func (*DropTable) iDDLStatement()       {}
+func (*DropView) iDDLStatement()        {}
+func (*AlterTable) iDDLStatement()      {}
+func (*TruncateTable) iDDLStatement()   {}
+func (*RenameTable) iDDLStatement()     {}
+func (*CreateProcedure) iDDLStatement() {}
+func (*CreateFunction) iDDLStatement()  {}
+func (*AlterProcedure) iDDLStatement()  {}
+func (*AlterFunction) iDDLStatement()   {}
+func (*DropProcedure) iDDLStatement()   {}
+func (*DropFunction) iDDLStatement()    {}
+func (*CreateTrigger) iDDLStatement()   {}
+func (*DropTrigger) iDDLStatement()     {}
+func (*CreateEvent) iDDLStatement()     {}
+func (*AlterEvent) iDDLStatement()      {}
+func (*DropEvent) iDDLStatement()       {}
 
 func (*AddConstraintDefinition) iAlterOption() {}
 func (*AddIndexDefinition) iAlterOption()      {}
@@ -855,9 +1441,97 @@ func (node *AlterView) IsFullyParsed() bool {
 	return true
 }
 
+// IsFullyParsed implements the DDLStatement interface
+func (node *CreateProcedure) IsFullyParsed() bool {
+	return true
+}
+
+// IsFullyParsed implements the DDLStatement interface
+func (node *CreateFunction) IsFullyParsed() bool {
+	return true
+}
+
+// IsFullyParsed implements the DDLStatement interface
+func (node *AlterProcedure) IsFullyParsed() bool {
+	return true
+}
+
+// IsFullyParsed implements the DDLStatement interface
+func (node *AlterFunction) IsFullyParsed() bool {
+	return true
+}
+
+// IsFullyParsed implements the DDLStatement interface
+func (node *DropProcedure) IsFullyParsed() bool {
+	return true
+}
+
+// IsFullyParsed implements the DDLStatement interface
+func (node *DropFunction) IsFullyParsed() bool {
+	return true
+}
+
+// IsFullyParsed implements the DDLStatement interface
+func (node *CreateTrigger) IsFullyParsed() bool {
+	return true
+}
+
+// IsFullyParsed implements the DDLStatement interface
+func (node *DropTrigger) IsFullyParsed() bool {
+	return true
+}
+
+// IsFullyParsed implements the DDLStatement interface
+func (node *CreateEvent) IsFullyParsed() bool {
+	return true
+}
+
+// IsFullyParsed implements the DDLStatement interface
+func (node *AlterEvent) IsFullyParsed() bool {
+	return true
+}
+
+// IsFullyParsed implements the DDLStatement interface
+func (node *DropEvent) IsFullyParsed() bool {
+	return true
+}
+
 // SetFullyParsed implements the DDLStatement interface
 func (node *AlterView) SetFullyParsed(fullyParsed bool) {}
 
+// SetFullyParsed implements the DDLStatement interface
+func (node *CreateProcedure) SetFullyParsed(fullyParsed bool) {}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *CreateFunction) SetFullyParsed(fullyParsed bool) {}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *AlterProcedure) SetFullyParsed(fullyParsed bool) {}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *AlterFunction) SetFullyParsed(fullyParsed bool) {}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *DropProcedure) SetFullyParsed(fullyParsed bool) {}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *DropFunction) SetFullyParsed(fullyParsed bool) {}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *CreateTrigger) SetFullyParsed(fullyParsed bool) {}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *DropTrigger) SetFullyParsed(fullyParsed bool) {}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *CreateEvent) SetFullyParsed(fullyParsed bool) {}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *AlterEvent) SetFullyParsed(fullyParsed bool) {}
+
+// SetFullyParsed implements the DDLStatement interface
+func (node *DropEvent) SetFullyParsed(fullyParsed bool) {}
+
 // IsTemporary implements the DDLStatement interface
 func (*TruncateTable) IsTemporary() bool {
 	return false
@@ -898,30 +1572,85 @@ func (node *AlterView) IsTemporary() bool {
 	return false
 }
 
-// GetTable implements the DDLStatement interface
-func (node *TruncateTable) GetTable() TableName {
-	return node.Table
+// IsTemporary implements the DDLStatement interface
+func (node *CreateProcedure) IsTemporary() bool {
+	return false
 }
 
-// GetTable implements the DDLStatement interface
-func (node *CreateTable) GetTable() TableName {
-	return node.Table
+// IsTemporary implements the DDLStatement interface
+func (node *CreateFunction) IsTemporary() bool {
+	return false
 }
 
-// GetTable implements the DDLStatement interface
-func (node *AlterTable) GetTable() TableName {
-	return node.Table
+// IsTemporary implements the DDLStatement interface
+func (node *AlterProcedure) IsTemporary() bool {
+	return false
 }
 
-// GetTable implements the DDLStatement interface
-func (node *CreateView) GetTable() TableName {
-	return node.ViewName
+// IsTemporary implements the DDLStatement interface
+func (node *AlterFunction) IsTemporary() bool {
+	return false
 }
 
-// GetTable implements the DDLStatement interface
-func (node *AlterView) GetTable() TableName {
-	return node.ViewName
-}
+// IsTemporary implements the DDLStatement interface
+func (node *DropProcedure) IsTemporary() bool {
+	return false
+}
+
+// IsTemporary implements the DDLStatement interface
+func (node *DropFunction) IsTemporary() bool {
+	return false
+}
+
+// IsTemporary implements the DDLStatement interface
+func (node *CreateTrigger) IsTemporary() bool {
+	return false
+}
+
+// IsTemporary implements the DDLStatement interface
+func (node *DropTrigger) IsTemporary() bool {
+	return false
+}
+
+// IsTemporary implements the DDLStatement interface
+func (node *CreateEvent) IsTemporary() bool {
+	return false
+}
+
+// IsTemporary implements the DDLStatement interface
+func (node *AlterEvent) IsTemporary() bool {
+	return false
+}
+
+// IsTemporary implements the DDLStatement interface
+func (node *DropEvent) IsTemporary() bool {
+	return false
+}
+
+// GetTable implements the DDLStatement interface
+func (node *TruncateTable) GetTable() TableName {
+	return node.Table
+}
+
+// GetTable implements the DDLStatement interface
+func (node *CreateTable) GetTable() TableName {
+	return node.Table
+}
+
+// GetTable implements the DDLStatement interface
+func (node *AlterTable) GetTable() TableName {
+	return node.Table
+}
+
+// GetTable implements the DDLStatement interface
+func (node *CreateView) GetTable() TableName {
+	return node.ViewName
+}
+
+// GetTable implements the DDLStatement interface
+func (node *AlterView) GetTable() TableName {
+	return node.ViewName
+}
 
 // GetTable implements the DDLStatement interface
 func (node *DropView) GetTable() TableName {
@@ -938,6 +1667,61 @@ func (node *RenameTable) GetTable() TableName {
 	return TableName{}
 }
 
+// GetTable implements the DDLStatement interface
+func (node *CreateProcedure) GetTable() TableName {
+	return TableName{}
+}
+
+// GetTable implements the DDLStatement interface
+func (node *CreateFunction) GetTable() TableName {
+	return TableName{}
+}
+
+// GetTable implements the DDLStatement interface
+func (node *AlterProcedure) GetTable() TableName {
+	return TableName{}
+}
+
+// GetTable implements the DDLStatement interface
+func (node *AlterFunction) GetTable() TableName {
+	return TableName{}
+}
+
+// GetTable implements the DDLStatement interface
+func (node *DropProcedure) GetTable() TableName {
+	return TableName{}
+}
+
+// GetTable implements the DDLStatement interface
+func (node *DropFunction) GetTable() TableName {
+	return TableName{}
+}
+
+// GetTable implements the DDLStatement interface
+func (node *CreateTrigger) GetTable() TableName {
+	return node.Table
+}
+
+// GetTable implements the DDLStatement interface
+func (node *DropTrigger) GetTable() TableName {
+	return TableName{}
+}
+
+// GetTable implements the DDLStatement interface
+func (node *CreateEvent) GetTable() TableName {
+	return TableName{}
+}
+
+// GetTable implements the DDLStatement interface
+func (node *AlterEvent) GetTable() TableName {
+	return TableName{}
+}
+
+// GetTable implements the DDLStatement interface
+func (node *DropEvent) GetTable() TableName {
+	return TableName{}
+}
+
 // GetAction implements the DDLStatement interface
 func (node *TruncateTable) GetAction() DDLAction {
 	return TruncateDDLAction
@@ -978,6 +1762,61 @@ func (node *DropView) GetAction() DDLAction {
 	return DropDDLAction
 }
 
+// GetAction implements the DDLStatement interface
+func (node *CreateProcedure) GetAction() DDLAction {
+	return CreateDDLAction
+}
+
+// GetAction implements the DDLStatement interface
+func (node *CreateFunction) GetAction() DDLAction {
+	return CreateDDLAction
+}
+
+// GetAction implements the DDLStatement interface
+func (node *AlterProcedure) GetAction() DDLAction {
+	return AlterDDLAction
+}
+
+// GetAction implements the DDLStatement interface
+func (node *AlterFunction) GetAction() DDLAction {
+	return AlterDDLAction
+}
+
+// GetAction implements the DDLStatement interface
+func (node *DropProcedure) GetAction() DDLAction {
+	return DropDDLAction
+}
+
+// GetAction implements the DDLStatement interface
+func (node *DropFunction) GetAction() DDLAction {
+	return DropDDLAction
+}
+
+// GetAction implements the DDLStatement interface
+func (node *CreateTrigger) GetAction() DDLAction {
+	return CreateDDLAction
+}
+
+// GetAction implements the DDLStatement interface
+func (node *DropTrigger) GetAction() DDLAction {
+	return DropDDLAction
+}
+
+// GetAction implements the DDLStatement interface
+func (node *CreateEvent) GetAction() DDLAction {
+	return CreateDDLAction
+}
+
+// GetAction implements the DDLStatement interface
+func (node *AlterEvent) GetAction() DDLAction {
+	return AlterDDLAction
+}
+
+// GetAction implements the DDLStatement interface
+func (node *DropEvent) GetAction() DDLAction {
+	return DropDDLAction
+}
+
 // GetOptLike implements the DDLStatement interface
 func (node *CreateTable) GetOptLike() *OptLike {
 	return node.OptLike
@@ -1018,6 +1857,61 @@ func (node *DropView) GetOptLike() *OptLike {
 	return nil
 }
 
+// GetOptLike implements the DDLStatement interface
+func (node *CreateProcedure) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *CreateFunction) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *AlterProcedure) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *AlterFunction) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *DropProcedure) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *DropFunction) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *CreateTrigger) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *DropTrigger) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *CreateEvent) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *AlterEvent) GetOptLike() *OptLike {
+	return nil
+}
+
+// GetOptLike implements the DDLStatement interface
+func (node *DropEvent) GetOptLike() *OptLike {
+	return nil
+}
+
 // GetIfExists implements the DDLStatement interface
 func (node *RenameTable) GetIfExists() bool {
 	return false
@@ -1058,6 +1952,61 @@ func (node *DropView) GetIfExists() bool {
 	return node.IfExists
 }
 
+// GetIfExists implements the DDLStatement interface
+func (node *CreateProcedure) GetIfExists() bool {
+	return false
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *CreateFunction) GetIfExists() bool {
+	return false
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *AlterProcedure) GetIfExists() bool {
+	return false
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *AlterFunction) GetIfExists() bool {
+	return false
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *DropProcedure) GetIfExists() bool {
+	return node.IfExists
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *DropFunction) GetIfExists() bool {
+	return node.IfExists
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *CreateTrigger) GetIfExists() bool {
+	return false
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *DropTrigger) GetIfExists() bool {
+	return node.IfExists
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *CreateEvent) GetIfExists() bool {
+	return false
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *AlterEvent) GetIfExists() bool {
+	return false
+}
+
+// GetIfExists implements the DDLStatement interface
+func (node *DropEvent) GetIfExists() bool {
+	return node.IfExists
+}
+
 // GetIfNotExists implements the DDLStatement interface
 func (node *RenameTable) GetIfNotExists() bool {
 	return false
@@ -1098,6 +2047,61 @@ func (node *DropView) GetIfNotExists() bool {
 	return false
 }
 
+// GetIfNotExists implements the DDLStatement interface
+func (node *CreateProcedure) GetIfNotExists() bool {
+	return node.IfNotExists
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *CreateFunction) GetIfNotExists() bool {
+	return node.IfNotExists
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *AlterProcedure) GetIfNotExists() bool {
+	return false
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *AlterFunction) GetIfNotExists() bool {
+	return false
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *DropProcedure) GetIfNotExists() bool {
+	return false
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *DropFunction) GetIfNotExists() bool {
+	return false
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *CreateTrigger) GetIfNotExists() bool {
+	return node.IfNotExists
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *DropTrigger) GetIfNotExists() bool {
+	return false
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *CreateEvent) GetIfNotExists() bool {
+	return node.IfNotExists
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *AlterEvent) GetIfNotExists() bool {
+	return false
+}
+
+// GetIfNotExists implements the DDLStatement interface
+func (node *DropEvent) GetIfNotExists() bool {
+	return false
+}
+
 // GetIsReplace implements the DDLStatement interface
 func (node *RenameTable) GetIsReplace() bool {
 	return false
@@ -1138,6 +2142,61 @@ func (node *DropView) GetIsReplace() bool {
 	return false
 }
 
+// GetIsReplace implements the DDLStatement interface
+func (node *CreateProcedure) GetIsReplace() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *CreateFunction) GetIsReplace() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *AlterProcedure) GetIsReplace() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *AlterFunction) GetIsReplace() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *DropProcedure) GetIsReplace() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *DropFunction) GetIsReplace() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *CreateTrigger) GetIsReplace() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *DropTrigger) GetIsReplace() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *CreateEvent) GetIsReplace() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *AlterEvent) GetIsReplace() bool {
+	return false
+}
+
+// GetIsReplace implements the DDLStatement interface
+func (node *DropEvent) GetIsReplace() bool {
+	return false
+}
+
 // GetTableSpec implements the DDLStatement interface
 func (node *CreateTable) GetTableSpec() *TableSpec {
 	return node.TableSpec
@@ -1178,6 +2237,61 @@ func (node *DropView) GetTableSpec() *TableSpec {
 	return nil
 }
 
+// GetTableSpec implements the DDLStatement interface
+func (node *CreateProcedure) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *CreateFunction) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *AlterProcedure) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *AlterFunction) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *DropProcedure) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *DropFunction) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *CreateTrigger) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *DropTrigger) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *CreateEvent) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *AlterEvent) GetTableSpec() *TableSpec {
+	return nil
+}
+
+// GetTableSpec implements the DDLStatement interface
+func (node *DropEvent) GetTableSpec() *TableSpec {
+	return nil
+}
+
 // GetFromTables implements the DDLStatement interface
 func (node *RenameTable) GetFromTables() TableNames {
 	var fromTables TableNames
@@ -1218,7 +2332,62 @@ func (node *DropView) GetFromTables() TableNames {
 }
 
 // GetFromTables implements the DDLStatement interface
-func (node *AlterView) GetFromTables() TableNames {
+func (node *AlterView) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *CreateProcedure) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *CreateFunction) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *AlterProcedure) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *AlterFunction) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *DropProcedure) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *DropFunction) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *CreateTrigger) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *DropTrigger) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *CreateEvent) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *AlterEvent) GetFromTables() TableNames {
+	return nil
+}
+
+// GetFromTables implements the DDLStatement interface
+func (node *DropEvent) GetFromTables() TableNames {
 	return nil
 }
 
@@ -1267,6 +2436,61 @@ func (node *AlterView) SetFromTables(tables TableNames) {
 	// irrelevant
 }
 
+// SetFromTables implements DDLStatement.
+func (node *CreateProcedure) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetFromTables implements DDLStatement.
+func (node *CreateFunction) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetFromTables implements DDLStatement.
+func (node *AlterProcedure) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetFromTables implements DDLStatement.
+func (node *AlterFunction) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetFromTables implements DDLStatement.
+func (node *DropProcedure) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetFromTables implements DDLStatement.
+func (node *DropFunction) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetFromTables implements DDLStatement.
+func (node *CreateTrigger) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetFromTables implements DDLStatement.
+func (node *DropTrigger) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetFromTables implements DDLStatement.
+func (node *CreateEvent) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetFromTables implements DDLStatement.
+func (node *AlterEvent) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
+// SetFromTables implements DDLStatement.
+func (node *DropEvent) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
 // SetComments implements Commented interface.
 func (node *RenameTable) SetComments(comments Comments) {
 	// irrelevant
@@ -1317,6 +2541,61 @@ func (node *AlterView) SetComments(comments Comments) {
 	node.Comments = comments.Parsed()
 }
 
+// SetComments implements Commented interface.
+func (node *CreateProcedure) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// SetComments implements Commented interface.
+func (node *CreateFunction) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// SetComments implements Commented interface.
+func (node *AlterProcedure) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// SetComments implements Commented interface.
+func (node *AlterFunction) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// SetComments implements Commented interface.
+func (node *DropProcedure) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// SetComments implements Commented interface.
+func (node *DropFunction) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// SetComments implements Commented interface.
+func (node *CreateTrigger) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// SetComments implements Commented interface.
+func (node *DropTrigger) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// SetComments implements Commented interface.
+func (node *CreateEvent) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// SetComments implements Commented interface.
+func (node *AlterEvent) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
+// SetComments implements Commented interface.
+func (node *DropEvent) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
 // SetComments for RevertMigration, does not implement DDLStatement
 func (node *RevertMigration) SetComments(comments Comments) {
 	node.Comments = comments.Parsed()
@@ -1399,6 +2678,61 @@ func (node *AlterView) GetParsedComments() *ParsedComments {
 	return node.Comments
 }
 
+// GetParsedComments implements Commented interface.
+func (node *CreateProcedure) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// GetParsedComments implements Commented interface.
+func (node *CreateFunction) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// GetParsedComments implements Commented interface.
+func (node *AlterProcedure) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// GetParsedComments implements Commented interface.
+func (node *AlterFunction) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// GetParsedComments implements Commented interface.
+func (node *DropProcedure) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// GetParsedComments implements Commented interface.
+func (node *DropFunction) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// GetParsedComments implements Commented interface.
+func (node *CreateTrigger) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// GetParsedComments implements Commented interface.
+func (node *DropTrigger) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// GetParsedComments implements Commented interface.
+func (node *CreateEvent) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// GetParsedComments implements Commented interface.
+func (node *AlterEvent) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
+// GetParsedComments implements Commented interface.
+func (node *DropEvent) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
 // GetParsedComments implements SupportOptimizerHint.
 func (node *Delete) GetParsedComments() *ParsedComments {
 	return node.Comments
@@ -1474,6 +2808,61 @@ func (node *DropView) GetToTables() TableNames {
 	return nil
 }
 
+// GetToTables implements the DDLStatement interface
+func (node *CreateProcedure) GetToTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *CreateFunction) GetToTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *AlterProcedure) GetToTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *AlterFunction) GetToTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *DropProcedure) GetToTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *DropFunction) GetToTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *CreateTrigger) GetToTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *DropTrigger) GetToTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *CreateEvent) GetToTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *AlterEvent) GetToTables() TableNames {
+	return nil
+}
+
+// GetToTables implements the DDLStatement interface
+func (node *DropEvent) GetToTables() TableNames {
+	return nil
+}
+
 // AffectedTables returns the list table names affected by the DDLStatement.
 func (node *RenameTable) AffectedTables() TableNames {
 	list := make(TableNames, 0, 2*len(node.TablePairs))
@@ -1526,6 +2915,61 @@ func (node *DropView) AffectedTables() TableNames {
 	return node.FromTables
 }
 
+// AffectedTables implements DDLStatement.
+func (node *CreateProcedure) AffectedTables() TableNames {
+	return nil
+}
+
+// AffectedTables implements DDLStatement.
+func (node *CreateFunction) AffectedTables() TableNames {
+	return nil
+}
+
+// AffectedTables implements DDLStatement.
+func (node *AlterProcedure) AffectedTables() TableNames {
+	return nil
+}
+
+// AffectedTables implements DDLStatement.
+func (node *AlterFunction) AffectedTables() TableNames {
+	return nil
+}
+
+// AffectedTables implements DDLStatement.
+func (node *DropProcedure) AffectedTables() TableNames {
+	return nil
+}
+
+// AffectedTables implements DDLStatement.
+func (node *DropFunction) AffectedTables() TableNames {
+	return nil
+}
+
+// AffectedTables implements DDLStatement.
+func (node *CreateTrigger) AffectedTables() TableNames {
+	return TableNames{node.Table}
+}
+
+// AffectedTables implements DDLStatement.
+func (node *DropTrigger) AffectedTables() TableNames {
+	return nil
+}
+
+// AffectedTables implements DDLStatement.
+func (node *CreateEvent) AffectedTables() TableNames {
+	return nil
+}
+
+// AffectedTables implements DDLStatement.
+func (node *AlterEvent) AffectedTables() TableNames {
+	return nil
+}
+
+// AffectedTables implements DDLStatement.
+func (node *DropEvent) AffectedTables() TableNames {
+	return nil
+}
+
 // SetTable implements DDLStatement.
 func (node *TruncateTable) SetTable(qualifier string, name string) {
 	node.Table.Qualifier = NewIdentifierCS(qualifier)
@@ -1565,6 +3009,42 @@ func (node *DropTable) SetTable(qualifier string, name string) {}
 // SetTable implements DDLStatement.
 func (node *DropView) SetTable(qualifier string, name string) {}
 
+// SetTable implements DDLStatement.
+func (node *CreateProcedure) SetTable(qualifier string, name string) {}
+
+// SetTable implements DDLStatement.
+func (node *CreateFunction) SetTable(qualifier string, name string) {}
+
+// SetTable implements DDLStatement.
+func (node *AlterProcedure) SetTable(qualifier string, name string) {}
+
+// SetTable implements DDLStatement.
+func (node *AlterFunction) SetTable(qualifier string, name string) {}
+
+// SetTable implements DDLStatement.
+func (node *DropProcedure) SetTable(qualifier string, name string) {}
+
+// SetTable implements DDLStatement.
+func (node *DropFunction) SetTable(qualifier string, name string) {}
+
+// SetTable implements DDLStatement.
+func (node *CreateTrigger) SetTable(qualifier string, name string) {
+	node.Table.Qualifier = NewIdentifierCS(qualifier)
+	node.Table.Name = NewIdentifierCS(name)
+}
+
+// SetTable implements DDLStatement.
+func (node *DropTrigger) SetTable(qualifier string, name string) {}
+
+// SetTable implements DDLStatement.
+func (node *CreateEvent) SetTable(qualifier string, name string) {}
+
+// SetTable implements DDLStatement.
+func (node *AlterEvent) SetTable(qualifier string, name string) {}
+
+// SetTable implements DDLStatement.
+func (node *DropEvent) SetTable(qualifier string, name string) {}
+
 func (*DropDatabase) iDBDDLStatement()   {}
 func (*CreateDatabase) iDBDDLStatement() {}
 func (*AlterDatabase) iDBDDLStatement()  {}
@@ -2291,6 +3771,11 @@ type (
 		Type sqltypes.Type
 	}
 
+	// PositionalArg represents a PostgreSQL positional parameter, e.g. $1.
+	PositionalArg struct {
+		Index int
+	}
+
 	// NullVal represents a NULL value.
 	NullVal struct{}
 
@@ -2412,6 +3897,13 @@ type (
 		Array bool
 	}
 
+	// TypeCastExpr represents a PostgreSQL cast, e.g. expr::type
+	TypeCastExpr struct {
+		Expr  Expr
+		Type  *ConvertType
+		Array bool
+	}
+
 	// ConvertExpr represents a call to CONVERT(expr, type)
 	ConvertExpr struct {
 		Expr Expr
@@ -3149,6 +4641,7 @@ func (*ExistsExpr) iExpr()                         {}
 func (*AssignmentExpr) iExpr()                     {}
 func (*Literal) iExpr()                            {}
 func (*Argument) iExpr()                           {}
+func (*PositionalArg) iExpr()                      {}
 func (*NullVal) iExpr()                            {}
 func (BoolVal) iExpr()                             {}
 func (*ColName) iExpr()                            {}
@@ -3167,6 +4660,7 @@ func (*CurTimeFuncExpr) iExpr()                    {}
 func (*CaseExpr) iExpr()                           {}
 func (*ValuesFuncExpr) iExpr()                     {}
 func (*CastExpr) iExpr()                           {}
+func (*TypeCastExpr) iExpr()                       {}
 func (*ConvertExpr) iExpr()                        {}
 func (*SubstrExpr) iExpr()                         {}
 func (*InsertExpr) iExpr()                         {}
@@ -3468,6 +4962,17 @@ type SetExpr struct {
 // OnDup represents an ON DUPLICATE KEY clause.
 type OnDup UpdateExprs
 
+// OnConflict represents a PostgreSQL ON CONFLICT clause.
+// The conflict target is either a list of columns or a constraint.
+// Exprs and Where are only set for DO UPDATE.
+type OnConflict struct {
+	Columns    Columns
+	Constraint IdentifierCI
+	DoNothing  bool
+	Exprs      UpdateExprs
+	Where      *Where
+}
+
 // IdentifierCI is a case insensitive SQL identifier. It will be escaped with
 // backquotes if necessary.
 type IdentifierCI struct {
@@ -23,6 +23,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return nil
 	}
 	switch in := in.(type) {
+	case *Account:
+		return CloneRefOfAccount(in)
 	case *AddColumns:
 		return CloneRefOfAddColumns(in)
 	case *AddConstraintDefinition:
@@ -43,12 +45,20 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfAlterColumn(in)
 	case *AlterDatabase:
 		return CloneRefOfAlterDatabase(in)
+	case *AlterEvent:
+		return CloneRefOfAlterEvent(in)
+	case *AlterFunction:
+		return CloneRefOfAlterFunction(in)
 	case *AlterIndex:
 		return CloneRefOfAlterIndex(in)
 	case *AlterMigration:
 		return CloneRefOfAlterMigration(in)
+	case *AlterProcedure:
+		return CloneRefOfAlterProcedure(in)
 	case *AlterTable:
 		return CloneRefOfAlterTable(in)
+	case *AlterUser:
+		return CloneRefOfAlterUser(in)
 	case *AlterView:
 		return CloneRefOfAlterView(in)
 	case *AlterVschema:
@@ -61,12 +71,18 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfArgumentLessWindowExpr(in)
 	case *AssignmentExpr:
 		return CloneRefOfAssignmentExpr(in)
+	case *Authentication:
+		return CloneRefOfAuthentication(in)
 	case *AutoIncSpec:
 		return CloneRefOfAutoIncSpec(in)
 	case *Avg:
 		return CloneRefOfAvg(in)
+	case *BadStatement:
+		return CloneRefOfBadStatement(in)
 	case *Begin:
 		return CloneRefOfBegin(in)
+	case *BeginEndBlock:
+		return CloneRefOfBeginEndBlock(in)
 	case *BetweenExpr:
 		return CloneRefOfBetweenExpr(in)
 	case *BinaryExpr:
@@ -83,6 +99,10 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfCallProc(in)
 	case *CaseExpr:
 		return CloneRefOfCaseExpr(in)
+	case *CaseStatement:
+		return CloneRefOfCaseStatement(in)
+	case *CaseStatementWhen:
+		return CloneRefOfCaseStatementWhen(in)
 	case *CastExpr:
 		return CloneRefOfCastExpr(in)
 	case *ChangeColumn:
@@ -91,6 +111,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfCharExpr(in)
 	case *CheckConstraintDefinition:
 		return CloneRefOfCheckConstraintDefinition(in)
+	case *CloseCursor:
+		return CloneRefOfCloseCursor(in)
 	case *ColName:
 		return CloneRefOfColName(in)
 	case *CollateExpr:
@@ -109,6 +131,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfCommonTableExpr(in)
 	case *ComparisonExpr:
 		return CloneRefOfComparisonExpr(in)
+	case *ConditionValue:
+		return CloneRefOfConditionValue(in)
 	case *ConstraintDefinition:
 		return CloneRefOfConstraintDefinition(in)
 	case *ConvertExpr:
@@ -123,8 +147,20 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfCountStar(in)
 	case *CreateDatabase:
 		return CloneRefOfCreateDatabase(in)
+	case *CreateEvent:
+		return CloneRefOfCreateEvent(in)
+	case *CreateFunction:
+		return CloneRefOfCreateFunction(in)
+	case *CreateProcedure:
+		return CloneRefOfCreateProcedure(in)
+	case *CreateRole:
+		return CloneRefOfCreateRole(in)
 	case *CreateTable:
 		return CloneRefOfCreateTable(in)
+	case *CreateTrigger:
+		return CloneRefOfCreateTrigger(in)
+	case *CreateUser:
+		return CloneRefOfCreateUser(in)
 	case *CreateView:
 		return CloneRefOfCreateView(in)
 	case *CurTimeFuncExpr:
@@ -135,6 +171,14 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfDateSubExpr(in)
 	case *DeallocateStmt:
 		return CloneRefOfDeallocateStmt(in)
+	case *DeclareCondition:
+		return CloneRefOfDeclareCondition(in)
+	case *DeclareCursor:
+		return CloneRefOfDeclareCursor(in)
+	case *DeclareHandler:
+		return CloneRefOfDeclareHandler(in)
+	case *DeclareVar:
+		return CloneRefOfDeclareVar(in)
 	case *Default:
 		return CloneRefOfDefault(in)
 	case *Definer:
@@ -147,12 +191,28 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfDropColumn(in)
 	case *DropDatabase:
 		return CloneRefOfDropDatabase(in)
+	case *DropEvent:
+		return CloneRefOfDropEvent(in)
+	case *DropFunction:
+		return CloneRefOfDropFunction(in)
 	case *DropKey:
 		return CloneRefOfDropKey(in)
+	case *DropProcedure:
+		return CloneRefOfDropProcedure(in)
+	case *DropRole:
+		return CloneRefOfDropRole(in)
 	case *DropTable:
 		return CloneRefOfDropTable(in)
+	case *DropTrigger:
+		return CloneRefOfDropTrigger(in)
+	case *DropUser:
+		return CloneRefOfDropUser(in)
 	case *DropView:
 		return CloneRefOfDropView(in)
+	case *ElseIfBlock:
+		return CloneRefOfElseIfBlock(in)
+	case *EventSchedule:
+		return CloneRefOfEventSchedule(in)
 	case *ExecuteStmt:
 		return CloneRefOfExecuteStmt(in)
 	case *ExistsExpr:
@@ -169,6 +229,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfExtractValueExpr(in)
 	case *ExtractedSubquery:
 		return CloneRefOfExtractedSubquery(in)
+	case *FetchCursor:
+		return CloneRefOfFetchCursor(in)
 	case *FirstOrLastValueExpr:
 		return CloneRefOfFirstOrLastValueExpr(in)
 	case *Flush:
@@ -207,6 +269,10 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfGeomFromWKBExpr(in)
 	case *GeomPropertyFuncExpr:
 		return CloneRefOfGeomPropertyFuncExpr(in)
+	case *Grant:
+		return CloneRefOfGrant(in)
+	case *GrantPrivilege:
+		return CloneRefOfGrantPrivilege(in)
 	case GroupBy:
 		return CloneGroupBy(in)
 	case *GroupConcatExpr:
@@ -215,6 +281,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneIdentifierCI(in)
 	case IdentifierCS:
 		return CloneIdentifierCS(in)
+	case *IfStatement:
+		return CloneRefOfIfStatement(in)
 	case *IndexDefinition:
 		return CloneRefOfIndexDefinition(in)
 	case *IndexHint:
@@ -233,6 +301,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfIntroducerExpr(in)
 	case *IsExpr:
 		return CloneRefOfIsExpr(in)
+	case *IterateStatement:
+		return CloneRefOfIterateStatement(in)
 	case *JSONArrayExpr:
 		return CloneRefOfJSONArrayExpr(in)
 	case *JSONAttributesExpr:
@@ -289,6 +359,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfKeyState(in)
 	case *LagLeadExpr:
 		return CloneRefOfLagLeadExpr(in)
+	case *LeaveStatement:
+		return CloneRefOfLeaveStatement(in)
 	case *Limit:
 		return CloneRefOfLimit(in)
 	case *LineStringExpr:
@@ -301,6 +373,10 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfLiteral(in)
 	case *Load:
 		return CloneRefOfLoad(in)
+	case *LoadFields:
+		return CloneRefOfLoadFields(in)
+	case *LoadLines:
+		return CloneRefOfLoadLines(in)
 	case *LocateExpr:
 		return CloneRefOfLocateExpr(in)
 	case *LockOption:
@@ -309,6 +385,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfLockTables(in)
 	case *LockingFunc:
 		return CloneRefOfLockingFunc(in)
+	case *LoopStatement:
+		return CloneRefOfLoopStatement(in)
 	case MatchAction:
 		return in
 	case *MatchExpr:
@@ -345,8 +423,12 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfNullVal(in)
 	case *Offset:
 		return CloneRefOfOffset(in)
+	case *OnConflict:
+		return CloneRefOfOnConflict(in)
 	case OnDup:
 		return CloneOnDup(in)
+	case *OpenCursor:
+		return CloneRefOfOpenCursor(in)
 	case *OptLike:
 		return CloneRefOfOptLike(in)
 	case *OrExpr:
@@ -381,6 +463,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfPartitionValueRange(in)
 	case Partitions:
 		return ClonePartitions(in)
+	case *PasswordExpire:
+		return CloneRefOfPasswordExpire(in)
 	case *PerformanceSchemaFuncExpr:
 		return CloneRefOfPerformanceSchemaFuncExpr(in)
 	case *PointExpr:
@@ -391,8 +475,14 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfPolygonExpr(in)
 	case *PolygonPropertyFuncExpr:
 		return CloneRefOfPolygonPropertyFuncExpr(in)
+	case *PositionalArg:
+		return CloneRefOfPositionalArg(in)
 	case *PrepareStmt:
 		return CloneRefOfPrepareStmt(in)
+	case *PrivilegeLevel:
+		return CloneRefOfPrivilegeLevel(in)
+	case *ProcParameter:
+		return CloneRefOfProcParameter(in)
 	case *PurgeBinaryLogs:
 		return CloneRefOfPurgeBinaryLogs(in)
 	case ReferenceAction:
@@ -417,12 +507,30 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfRenameTable(in)
 	case *RenameTableName:
 		return CloneRefOfRenameTableName(in)
+	case *RenameUser:
+		return CloneRefOfRenameUser(in)
+	case *RenameUserPair:
+		return CloneRefOfRenameUserPair(in)
+	case *RepeatStatement:
+		return CloneRefOfRepeatStatement(in)
+	case *ReturnStatement:
+		return CloneRefOfReturnStatement(in)
 	case *RevertMigration:
 		return CloneRefOfRevertMigration(in)
+	case *Revoke:
+		return CloneRefOfRevoke(in)
 	case *Rollback:
 		return CloneRefOfRollback(in)
 	case RootNode:
 		return CloneRootNode(in)
+	case *RoutineCharacteristic:
+		return CloneRefOfRoutineCharacteristic(in)
+	case *RoutineSet:
+		return CloneRefOfRoutineSet(in)
+	case *RoutineSetExpr:
+		return CloneRefOfRoutineSetExpr(in)
+	case RoutineSetExprs:
+		return CloneRoutineSetExprs(in)
 	case *SRollback:
 		return CloneRefOfSRollback(in)
 	case *Savepoint:
@@ -435,10 +543,16 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfSelectInto(in)
 	case *Set:
 		return CloneRefOfSet(in)
+	case *SetDefaultRole:
+		return CloneRefOfSetDefaultRole(in)
 	case *SetExpr:
 		return CloneRefOfSetExpr(in)
 	case SetExprs:
 		return CloneSetExprs(in)
+	case *SetPassword:
+		return CloneRefOfSetPassword(in)
+	case *SetRole:
+		return CloneRefOfSetRole(in)
 	case *Show:
 		return CloneRefOfShow(in)
 	case *ShowBasic:
@@ -455,8 +569,14 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfShowThrottledApps(in)
 	case *ShowThrottlerStatus:
 		return CloneRefOfShowThrottlerStatus(in)
+	case *Signal:
+		return CloneRefOfSignal(in)
+	case *SignalItem:
+		return CloneRefOfSignalItem(in)
 	case *StarExpr:
 		return CloneRefOfStarExpr(in)
+	case StatementList:
+		return CloneStatementList(in)
 	case *Std:
 		return CloneRefOfStd(in)
 	case *StdDev:
@@ -495,10 +615,14 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfTablespaceOperation(in)
 	case *TimestampFuncExpr:
 		return CloneRefOfTimestampFuncExpr(in)
+	case *TriggerOrder:
+		return CloneRefOfTriggerOrder(in)
 	case *TrimFuncExpr:
 		return CloneRefOfTrimFuncExpr(in)
 	case *TruncateTable:
 		return CloneRefOfTruncateTable(in)
+	case *TypeCastExpr:
+		return CloneRefOfTypeCastExpr(in)
 	case *UnaryExpr:
 		return CloneRefOfUnaryExpr(in)
 	case *Union:
@@ -515,6 +639,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfUpdateXMLExpr(in)
 	case *Use:
 		return CloneRefOfUse(in)
+	case *UserSpec:
+		return CloneRefOfUserSpec(in)
 	case *VExplainStmt:
 		return CloneRefOfVExplainStmt(in)
 	case *VStream:
@@ -545,6 +671,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfWhen(in)
 	case *Where:
 		return CloneRefOfWhere(in)
+	case *WhileStatement:
+		return CloneRefOfWhileStatement(in)
 	case *WindowDefinition:
 		return CloneRefOfWindowDefinition(in)
 	case WindowDefinitions:
@@ -561,6 +689,15 @@ func CloneSQLNode(in SQLNode) SQLNode {
 	}
 }
 
+// CloneRefOfAccount creates a deep clone of the input.
+func CloneRefOfAccount(n *Account) *Account {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
 // CloneRefOfAddColumns creates a deep clone of the input.
 func CloneRefOfAddColumns(n *AddColumns) *AddColumns {
 	if n == nil {
@@ -659,6 +796,34 @@ func CloneRefOfAlterDatabase(n *AlterDatabase) *AlterDatabase {
 	return &out
 }
 
+// CloneRefOfAlterEvent creates a deep clone of the input.
+func CloneRefOfAlterEvent(n *AlterEvent) *AlterEvent {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Definer = CloneRefOfDefiner(n.Definer)
+	out.Name = CloneTableName(n.Name)
+	out.Schedule = CloneRefOfEventSchedule(n.Schedule)
+	out.RenameTo = CloneTableName(n.RenameTo)
+	out.Comment = CloneRefOfLiteral(n.Comment)
+	out.Body = CloneStatement(n.Body)
+	return &out
+}
+
+// CloneRefOfAlterFunction creates a deep clone of the input.
+func CloneRefOfAlterFunction(n *AlterFunction) *AlterFunction {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Name = CloneTableName(n.Name)
+	out.Characteristics = CloneSliceOfRefOfRoutineCharacteristic(n.Characteristics)
+	return &out
+}
+
 // CloneRefOfAlterIndex creates a deep clone of the input.
 func CloneRefOfAlterIndex(n *AlterIndex) *AlterIndex {
 	if n == nil {
@@ -679,6 +844,18 @@ func CloneRefOfAlterMigration(n *AlterMigration) *AlterMigration {
 	return &out
 }
 
+// CloneRefOfAlterProcedure creates a deep clone of the input.
+func CloneRefOfAlterProcedure(n *AlterProcedure) *AlterProcedure {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Name = CloneTableName(n.Name)
+	out.Characteristics = CloneSliceOfRefOfRoutineCharacteristic(n.Characteristics)
+	return &out
+}
+
 // CloneRefOfAlterTable creates a deep clone of the input.
 func CloneRefOfAlterTable(n *AlterTable) *AlterTable {
 	if n == nil {
@@ -693,6 +870,17 @@ func CloneRefOfAlterTable(n *AlterTable) *AlterTable {
 	return &out
 }
 
+// CloneRefOfAlterUser creates a deep clone of the input.
+func CloneRefOfAlterUser(n *AlterUser) *AlterUser {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Users = CloneSliceOfRefOfUserSpec(n.Users)
+	out.PasswordExpire = CloneRefOfPasswordExpire(n.PasswordExpire)
+	return &out
+}
+
 // CloneRefOfAlterView creates a deep clone of the input.
 func CloneRefOfAlterView(n *AlterView) *AlterView {
 	if n == nil {
@@ -761,6 +949,18 @@ func CloneRefOfAssignmentExpr(n *AssignmentExpr) *AssignmentExpr {
 	return &out
 }
 
+// CloneRefOfAuthentication creates a deep clone of the input.
+func CloneRefOfAuthentication(n *Authentication) *Authentication {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Plugin = CloneIdentifierCI(n.Plugin)
+	out.Password = CloneRefOfLiteral(n.Password)
+	out.CurrentPassword = CloneRefOfLiteral(n.CurrentPassword)
+	return &out
+}
+
 // CloneRefOfAutoIncSpec creates a deep clone of the input.
 func CloneRefOfAutoIncSpec(n *AutoIncSpec) *AutoIncSpec {
 	if n == nil {
@@ -782,6 +982,15 @@ func CloneRefOfAvg(n *Avg) *Avg {
 	return &out
 }
 
+// CloneRefOfBadStatement creates a deep clone of the input.
+func CloneRefOfBadStatement(n *BadStatement) *BadStatement {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
 // CloneRefOfBegin creates a deep clone of the input.
 func CloneRefOfBegin(n *Begin) *Begin {
 	if n == nil {
@@ -792,6 +1001,17 @@ func CloneRefOfBegin(n *Begin) *Begin {
 	return &out
 }
 
+// CloneRefOfBeginEndBlock creates a deep clone of the input.
+func CloneRefOfBeginEndBlock(n *BeginEndBlock) *BeginEndBlock {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Label = CloneIdentifierCI(n.Label)
+	out.Statements = CloneStatementList(n.Statements)
+	return &out
+}
+
 // CloneRefOfBetweenExpr creates a deep clone of the input.
 func CloneRefOfBetweenExpr(n *BetweenExpr) *BetweenExpr {
 	if n == nil {
@@ -868,6 +1088,29 @@ func CloneRefOfCaseExpr(n *CaseExpr) *CaseExpr {
 	return &out
 }
 
+// CloneRefOfCaseStatement creates a deep clone of the input.
+func CloneRefOfCaseStatement(n *CaseStatement) *CaseStatement {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.Whens = CloneSliceOfRefOfCaseStatementWhen(n.Whens)
+	out.Else = CloneStatementList(n.Else)
+	return &out
+}
+
+// CloneRefOfCaseStatementWhen creates a deep clone of the input.
+func CloneRefOfCaseStatementWhen(n *CaseStatementWhen) *CaseStatementWhen {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Cond = CloneExpr(n.Cond)
+	out.Statements = CloneStatementList(n.Statements)
+	return &out
+}
+
 // CloneRefOfCastExpr creates a deep clone of the input.
 func CloneRefOfCastExpr(n *CastExpr) *CastExpr {
 	if n == nil {
@@ -911,6 +1154,16 @@ func CloneRefOfCheckConstraintDefinition(n *CheckConstraintDefinition) *CheckCon
 	return &out
 }
 
+// CloneRefOfCloseCursor creates a deep clone of the input.
+func CloneRefOfCloseCursor(n *CloseCursor) *CloseCursor {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneIdentifierCI(n.Name)
+	return &out
+}
+
 // CloneRefOfColName creates a deep clone of the input.
 func CloneRefOfColName(n *ColName) *ColName {
 	return n
@@ -1006,6 +1259,16 @@ func CloneRefOfComparisonExpr(n *ComparisonExpr) *ComparisonExpr {
 	return &out
 }
 
+// CloneRefOfConditionValue creates a deep clone of the input.
+func CloneRefOfConditionValue(n *ConditionValue) *ConditionValue {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneIdentifierCI(n.Name)
+	return &out
+}
+
 // CloneRefOfConstraintDefinition creates a deep clone of the input.
 func CloneRefOfConstraintDefinition(n *ConstraintDefinition) *ConstraintDefinition {
 	if n == nil {
@@ -1081,6 +1344,62 @@ func CloneRefOfCreateDatabase(n *CreateDatabase) *CreateDatabase {
 	return &out
 }
 
+// CloneRefOfCreateEvent creates a deep clone of the input.
+func CloneRefOfCreateEvent(n *CreateEvent) *CreateEvent {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Definer = CloneRefOfDefiner(n.Definer)
+	out.Name = CloneTableName(n.Name)
+	out.Schedule = CloneRefOfEventSchedule(n.Schedule)
+	out.Comment = CloneRefOfLiteral(n.Comment)
+	out.Body = CloneStatement(n.Body)
+	return &out
+}
+
+// CloneRefOfCreateFunction creates a deep clone of the input.
+func CloneRefOfCreateFunction(n *CreateFunction) *CreateFunction {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Definer = CloneRefOfDefiner(n.Definer)
+	out.Name = CloneTableName(n.Name)
+	out.Params = CloneSliceOfRefOfProcParameter(n.Params)
+	out.Returns = CloneRefOfColumnType(n.Returns)
+	out.Characteristics = CloneSliceOfRefOfRoutineCharacteristic(n.Characteristics)
+	out.Body = CloneStatement(n.Body)
+	return &out
+}
+
+// CloneRefOfCreateProcedure creates a deep clone of the input.
+func CloneRefOfCreateProcedure(n *CreateProcedure) *CreateProcedure {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Definer = CloneRefOfDefiner(n.Definer)
+	out.Name = CloneTableName(n.Name)
+	out.Params = CloneSliceOfRefOfProcParameter(n.Params)
+	out.Characteristics = CloneSliceOfRefOfRoutineCharacteristic(n.Characteristics)
+	out.Body = CloneStatement(n.Body)
+	return &out
+}
+
+// CloneRefOfCreateRole creates a deep clone of the input.
+func CloneRefOfCreateRole(n *CreateRole) *CreateRole {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Roles = CloneSliceOfRefOfAccount(n.Roles)
+	return &out
+}
+
 // CloneRefOfCreateTable creates a deep clone of the input.
 func CloneRefOfCreateTable(n *CreateTable) *CreateTable {
 	if n == nil {
@@ -1094,6 +1413,33 @@ func CloneRefOfCreateTable(n *CreateTable) *CreateTable {
 	return &out
 }
 
+// CloneRefOfCreateTrigger creates a deep clone of the input.
+func CloneRefOfCreateTrigger(n *CreateTrigger) *CreateTrigger {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Definer = CloneRefOfDefiner(n.Definer)
+	out.Name = CloneTableName(n.Name)
+	out.Table = CloneTableName(n.Table)
+	out.Order = CloneRefOfTriggerOrder(n.Order)
+	out.Body = CloneStatement(n.Body)
+	return &out
+}
+
+// CloneRefOfCreateUser creates a deep clone of the input.
+func CloneRefOfCreateUser(n *CreateUser) *CreateUser {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Users = CloneSliceOfRefOfUserSpec(n.Users)
+	out.DefaultRoles = CloneSliceOfRefOfAccount(n.DefaultRoles)
+	out.PasswordExpire = CloneRefOfPasswordExpire(n.PasswordExpire)
+	return &out
+}
+
 // CloneRefOfCreateView creates a deep clone of the input.
 func CloneRefOfCreateView(n *CreateView) *CreateView {
 	if n == nil {
@@ -1151,6 +1497,51 @@ func CloneRefOfDeallocateStmt(n *DeallocateStmt) *DeallocateStmt {
 	return &out
 }
 
+// CloneRefOfDeclareCondition creates a deep clone of the input.
+func CloneRefOfDeclareCondition(n *DeclareCondition) *DeclareCondition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneIdentifierCI(n.Name)
+	out.Value = CloneRefOfConditionValue(n.Value)
+	return &out
+}
+
+// CloneRefOfDeclareCursor creates a deep clone of the input.
+func CloneRefOfDeclareCursor(n *DeclareCursor) *DeclareCursor {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneIdentifierCI(n.Name)
+	out.Select = CloneSelectStatement(n.Select)
+	return &out
+}
+
+// CloneRefOfDeclareHandler creates a deep clone of the input.
+func CloneRefOfDeclareHandler(n *DeclareHandler) *DeclareHandler {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Conditions = CloneSliceOfRefOfConditionValue(n.Conditions)
+	out.Statement = CloneStatement(n.Statement)
+	return &out
+}
+
+// CloneRefOfDeclareVar creates a deep clone of the input.
+func CloneRefOfDeclareVar(n *DeclareVar) *DeclareVar {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Names = CloneColumns(n.Names)
+	out.Type = CloneRefOfColumnType(n.Type)
+	out.Default = CloneExpr(n.Default)
+	return &out
+}
+
 // CloneRefOfDefault creates a deep clone of the input.
 func CloneRefOfDefault(n *Default) *Default {
 	if n == nil {
@@ -1183,6 +1574,7 @@ func CloneRefOfDelete(n *Delete) *Delete {
 	out.Where = CloneRefOfWhere(n.Where)
 	out.OrderBy = CloneOrderBy(n.OrderBy)
 	out.Limit = CloneRefOfLimit(n.Limit)
+	out.Returning = CloneSelectExprs(n.Returning)
 	return &out
 }
 
@@ -1217,6 +1609,28 @@ func CloneRefOfDropDatabase(n *DropDatabase) *DropDatabase {
 	return &out
 }
 
+// CloneRefOfDropEvent creates a deep clone of the input.
+func CloneRefOfDropEvent(n *DropEvent) *DropEvent {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Name = CloneTableName(n.Name)
+	return &out
+}
+
+// CloneRefOfDropFunction creates a deep clone of the input.
+func CloneRefOfDropFunction(n *DropFunction) *DropFunction {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Name = CloneTableName(n.Name)
+	return &out
+}
+
 // CloneRefOfDropKey creates a deep clone of the input.
 func CloneRefOfDropKey(n *DropKey) *DropKey {
 	if n == nil {
@@ -1227,6 +1641,27 @@ func CloneRefOfDropKey(n *DropKey) *DropKey {
 	return &out
 }
 
+// CloneRefOfDropProcedure creates a deep clone of the input.
+func CloneRefOfDropProcedure(n *DropProcedure) *DropProcedure {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Name = CloneTableName(n.Name)
+	return &out
+}
+
+// CloneRefOfDropRole creates a deep clone of the input.
+func CloneRefOfDropRole(n *DropRole) *DropRole {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Roles = CloneSliceOfRefOfAccount(n.Roles)
+	return &out
+}
+
 // CloneRefOfDropTable creates a deep clone of the input.
 func CloneRefOfDropTable(n *DropTable) *DropTable {
 	if n == nil {
@@ -1238,6 +1673,27 @@ func CloneRefOfDropTable(n *DropTable) *DropTable {
 	return &out
 }
 
+// CloneRefOfDropTrigger creates a deep clone of the input.
+func CloneRefOfDropTrigger(n *DropTrigger) *DropTrigger {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Name = CloneTableName(n.Name)
+	return &out
+}
+
+// CloneRefOfDropUser creates a deep clone of the input.
+func CloneRefOfDropUser(n *DropUser) *DropUser {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Users = CloneSliceOfRefOfAccount(n.Users)
+	return &out
+}
+
 // CloneRefOfDropView creates a deep clone of the input.
 func CloneRefOfDropView(n *DropView) *DropView {
 	if n == nil {
@@ -1249,6 +1705,30 @@ func CloneRefOfDropView(n *DropView) *DropView {
 	return &out
 }
 
+// CloneRefOfElseIfBlock creates a deep clone of the input.
+func CloneRefOfElseIfBlock(n *ElseIfBlock) *ElseIfBlock {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Cond = CloneExpr(n.Cond)
+	out.Statements = CloneStatementList(n.Statements)
+	return &out
+}
+
+// CloneRefOfEventSchedule creates a deep clone of the input.
+func CloneRefOfEventSchedule(n *EventSchedule) *EventSchedule {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.At = CloneExpr(n.At)
+	out.Every = CloneExpr(n.Every)
+	out.Starts = CloneExpr(n.Starts)
+	out.Ends = CloneExpr(n.Ends)
+	return &out
+}
+
 // CloneRefOfExecuteStmt creates a deep clone of the input.
 func CloneRefOfExecuteStmt(n *ExecuteStmt) *ExecuteStmt {
 	if n == nil {
@@ -1338,6 +1818,17 @@ func CloneRefOfExtractedSubquery(n *ExtractedSubquery) *ExtractedSubquery {
 	return &out
 }
 
+// CloneRefOfFetchCursor creates a deep clone of the input.
+func CloneRefOfFetchCursor(n *FetchCursor) *FetchCursor {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneIdentifierCI(n.Name)
+	out.Variables = CloneColumns(n.Variables)
+	return &out
+}
+
 // CloneRefOfFirstOrLastValueExpr creates a deep clone of the input.
 func CloneRefOfFirstOrLastValueExpr(n *FirstOrLastValueExpr) *FirstOrLastValueExpr {
 	if n == nil {
@@ -1551,6 +2042,30 @@ func CloneRefOfGeomPropertyFuncExpr(n *GeomPropertyFuncExpr) *GeomPropertyFuncEx
 	return &out
 }
 
+// CloneRefOfGrant creates a deep clone of the input.
+func CloneRefOfGrant(n *Grant) *Grant {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Privileges = CloneSliceOfRefOfGrantPrivilege(n.Privileges)
+	out.On = CloneRefOfPrivilegeLevel(n.On)
+	out.Roles = CloneSliceOfRefOfAccount(n.Roles)
+	out.Proxy = CloneRefOfAccount(n.Proxy)
+	out.To = CloneSliceOfRefOfAccount(n.To)
+	return &out
+}
+
+// CloneRefOfGrantPrivilege creates a deep clone of the input.
+func CloneRefOfGrantPrivilege(n *GrantPrivilege) *GrantPrivilege {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Columns = CloneColumns(n.Columns)
+	return &out
+}
+
 // CloneGroupBy creates a deep clone of the input.
 func CloneGroupBy(n GroupBy) GroupBy {
 	if n == nil {
@@ -1585,6 +2100,19 @@ func CloneIdentifierCS(n IdentifierCS) IdentifierCS {
 	return *CloneRefOfIdentifierCS(&n)
 }
 
+// CloneRefOfIfStatement creates a deep clone of the input.
+func CloneRefOfIfStatement(n *IfStatement) *IfStatement {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Cond = CloneExpr(n.Cond)
+	out.Statements = CloneStatementList(n.Statements)
+	out.ElseIfs = CloneSliceOfRefOfElseIfBlock(n.ElseIfs)
+	out.Else = CloneStatementList(n.Else)
+	return &out
+}
+
 // CloneRefOfIndexDefinition creates a deep clone of the input.
 func CloneRefOfIndexDefinition(n *IndexDefinition) *IndexDefinition {
 	if n == nil {
@@ -1642,6 +2170,8 @@ func CloneRefOfInsert(n *Insert) *Insert {
 	out.Columns = CloneColumns(n.Columns)
 	out.Rows = CloneInsertRows(n.Rows)
 	out.OnDup = CloneOnDup(n.OnDup)
+	out.OnConflict = CloneRefOfOnConflict(n.OnConflict)
+	out.Returning = CloneSelectExprs(n.Returning)
 	return &out
 }
 
@@ -1689,6 +2219,16 @@ func CloneRefOfIsExpr(n *IsExpr) *IsExpr {
 	return &out
 }
 
+// CloneRefOfIterateStatement creates a deep clone of the input.
+func CloneRefOfIterateStatement(n *IterateStatement) *IterateStatement {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Label = CloneIdentifierCI(n.Label)
+	return &out
+}
+
 // CloneRefOfJSONArrayExpr creates a deep clone of the input.
 func CloneRefOfJSONArrayExpr(n *JSONArrayExpr) *JSONArrayExpr {
 	if n == nil {
@@ -2002,6 +2542,16 @@ func CloneRefOfLagLeadExpr(n *LagLeadExpr) *LagLeadExpr {
 	return &out
 }
 
+// CloneRefOfLeaveStatement creates a deep clone of the input.
+func CloneRefOfLeaveStatement(n *LeaveStatement) *LeaveStatement {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Label = CloneIdentifierCI(n.Label)
+	return &out
+}
+
 // CloneRefOfLimit creates a deep clone of the input.
 func CloneRefOfLimit(n *Limit) *Limit {
 	if n == nil {
@@ -2049,6 +2599,37 @@ func CloneRefOfLoad(n *Load) *Load {
 		return nil
 	}
 	out := *n
+	out.Table = CloneTableName(n.Table)
+	out.Partitions = ClonePartitions(n.Partitions)
+	out.Charset = CloneColumnCharset(n.Charset)
+	out.Fields = CloneRefOfLoadFields(n.Fields)
+	out.Lines = CloneRefOfLoadLines(n.Lines)
+	out.IgnoreLines = CloneRefOfLiteral(n.IgnoreLines)
+	out.Columns = CloneExprs(n.Columns)
+	out.SetExprs = CloneUpdateExprs(n.SetExprs)
+	return &out
+}
+
+// CloneRefOfLoadFields creates a deep clone of the input.
+func CloneRefOfLoadFields(n *LoadFields) *LoadFields {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.TerminatedBy = CloneRefOfLiteral(n.TerminatedBy)
+	out.EnclosedBy = CloneRefOfLiteral(n.EnclosedBy)
+	out.EscapedBy = CloneRefOfLiteral(n.EscapedBy)
+	return &out
+}
+
+// CloneRefOfLoadLines creates a deep clone of the input.
+func CloneRefOfLoadLines(n *LoadLines) *LoadLines {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.StartingBy = CloneRefOfLiteral(n.StartingBy)
+	out.TerminatedBy = CloneRefOfLiteral(n.TerminatedBy)
 	return &out
 }
 
@@ -2094,6 +2675,17 @@ func CloneRefOfLockingFunc(n *LockingFunc) *LockingFunc {
 	return &out
 }
 
+// CloneRefOfLoopStatement creates a deep clone of the input.
+func CloneRefOfLoopStatement(n *LoopStatement) *LoopStatement {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Label = CloneIdentifierCI(n.Label)
+	out.Statements = CloneStatementList(n.Statements)
+	return &out
+}
+
 // CloneRefOfMatchExpr creates a deep clone of the input.
 func CloneRefOfMatchExpr(n *MatchExpr) *MatchExpr {
 	if n == nil {
@@ -2272,6 +2864,19 @@ func CloneRefOfOffset(n *Offset) *Offset {
 	return &out
 }
 
+// CloneRefOfOnConflict creates a deep clone of the input.
+func CloneRefOfOnConflict(n *OnConflict) *OnConflict {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Columns = CloneColumns(n.Columns)
+	out.Constraint = CloneIdentifierCI(n.Constraint)
+	out.Exprs = CloneUpdateExprs(n.Exprs)
+	out.Where = CloneRefOfWhere(n.Where)
+	return &out
+}
+
 // CloneOnDup creates a deep clone of the input.
 func CloneOnDup(n OnDup) OnDup {
 	if n == nil {
@@ -2284,6 +2889,16 @@ func CloneOnDup(n OnDup) OnDup {
 	return res
 }
 
+// CloneRefOfOpenCursor creates a deep clone of the input.
+func CloneRefOfOpenCursor(n *OpenCursor) *OpenCursor {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneIdentifierCI(n.Name)
+	return &out
+}
+
 // CloneRefOfOptLike creates a deep clone of the input.
 func CloneRefOfOptLike(n *OptLike) *OptLike {
 	if n == nil {
@@ -2471,6 +3086,16 @@ func ClonePartitions(n Partitions) Partitions {
 	return res
 }
 
+// CloneRefOfPasswordExpire creates a deep clone of the input.
+func CloneRefOfPasswordExpire(n *PasswordExpire) *PasswordExpire {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Days = CloneRefOfLiteral(n.Days)
+	return &out
+}
+
 // CloneRefOfPerformanceSchemaFuncExpr creates a deep clone of the input.
 func CloneRefOfPerformanceSchemaFuncExpr(n *PerformanceSchemaFuncExpr) *PerformanceSchemaFuncExpr {
 	if n == nil {
@@ -2524,6 +3149,15 @@ func CloneRefOfPolygonPropertyFuncExpr(n *PolygonPropertyFuncExpr) *PolygonPrope
 	return &out
 }
 
+// CloneRefOfPositionalArg creates a deep clone of the input.
+func CloneRefOfPositionalArg(n *PositionalArg) *PositionalArg {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
 // CloneRefOfPrepareStmt creates a deep clone of the input.
 func CloneRefOfPrepareStmt(n *PrepareStmt) *PrepareStmt {
 	if n == nil {
@@ -2536,6 +3170,28 @@ func CloneRefOfPrepareStmt(n *PrepareStmt) *PrepareStmt {
 	return &out
 }
 
+// CloneRefOfPrivilegeLevel creates a deep clone of the input.
+func CloneRefOfPrivilegeLevel(n *PrivilegeLevel) *PrivilegeLevel {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.DB = CloneIdentifierCS(n.DB)
+	out.Name = CloneIdentifierCS(n.Name)
+	return &out
+}
+
+// CloneRefOfProcParameter creates a deep clone of the input.
+func CloneRefOfProcParameter(n *ProcParameter) *ProcParameter {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneIdentifierCI(n.Name)
+	out.Type = CloneRefOfColumnType(n.Type)
+	return &out
+}
+
 // CloneRefOfPurgeBinaryLogs creates a deep clone of the input.
 func CloneRefOfPurgeBinaryLogs(n *PurgeBinaryLogs) *PurgeBinaryLogs {
 	if n == nil {
@@ -2664,6 +3320,49 @@ func CloneRefOfRenameTableName(n *RenameTableName) *RenameTableName {
 	return &out
 }
 
+// CloneRefOfRenameUser creates a deep clone of the input.
+func CloneRefOfRenameUser(n *RenameUser) *RenameUser {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.UserPairs = CloneSliceOfRefOfRenameUserPair(n.UserPairs)
+	return &out
+}
+
+// CloneRefOfRenameUserPair creates a deep clone of the input.
+func CloneRefOfRenameUserPair(n *RenameUserPair) *RenameUserPair {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.FromUser = CloneRefOfAccount(n.FromUser)
+	out.ToUser = CloneRefOfAccount(n.ToUser)
+	return &out
+}
+
+// CloneRefOfRepeatStatement creates a deep clone of the input.
+func CloneRefOfRepeatStatement(n *RepeatStatement) *RepeatStatement {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Label = CloneIdentifierCI(n.Label)
+	out.Statements = CloneStatementList(n.Statements)
+	out.Until = CloneExpr(n.Until)
+	return &out
+}
+
+// CloneRefOfReturnStatement creates a deep clone of the input.
+func CloneRefOfReturnStatement(n *ReturnStatement) *ReturnStatement {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
 // CloneRefOfRevertMigration creates a deep clone of the input.
 func CloneRefOfRevertMigration(n *RevertMigration) *RevertMigration {
 	if n == nil {
@@ -2674,6 +3373,20 @@ func CloneRefOfRevertMigration(n *RevertMigration) *RevertMigration {
 	return &out
 }
 
+// CloneRefOfRevoke creates a deep clone of the input.
+func CloneRefOfRevoke(n *Revoke) *Revoke {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Privileges = CloneSliceOfRefOfGrantPrivilege(n.Privileges)
+	out.On = CloneRefOfPrivilegeLevel(n.On)
+	out.Roles = CloneSliceOfRefOfAccount(n.Roles)
+	out.Proxy = CloneRefOfAccount(n.Proxy)
+	out.From = CloneSliceOfRefOfAccount(n.From)
+	return &out
+}
+
 // CloneRefOfRollback creates a deep clone of the input.
 func CloneRefOfRollback(n *Rollback) *Rollback {
 	if n == nil {
@@ -2688,6 +3401,49 @@ func CloneRootNode(n RootNode) RootNode {
 	return *CloneRefOfRootNode(&n)
 }
 
+// CloneRefOfRoutineCharacteristic creates a deep clone of the input.
+func CloneRefOfRoutineCharacteristic(n *RoutineCharacteristic) *RoutineCharacteristic {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfRoutineSet creates a deep clone of the input.
+func CloneRefOfRoutineSet(n *RoutineSet) *RoutineSet {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.Exprs = CloneRoutineSetExprs(n.Exprs)
+	return &out
+}
+
+// CloneRefOfRoutineSetExpr creates a deep clone of the input.
+func CloneRefOfRoutineSetExpr(n *RoutineSetExpr) *RoutineSetExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Var = CloneExpr(n.Var)
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
+// CloneRoutineSetExprs creates a deep clone of the input.
+func CloneRoutineSetExprs(n RoutineSetExprs) RoutineSetExprs {
+	if n == nil {
+		return nil
+	}
+	res := make(RoutineSetExprs, len(n))
+	for i, x := range n {
+		res[i] = CloneRefOfRoutineSetExpr(x)
+	}
+	return res
+}
+
 // CloneRefOfSRollback creates a deep clone of the input.
 func CloneRefOfSRollback(n *SRollback) *SRollback {
 	if n == nil {
@@ -2748,6 +3504,7 @@ func CloneRefOfSelectInto(n *SelectInto) *SelectInto {
 	}
 	out := *n
 	out.Charset = CloneColumnCharset(n.Charset)
+	out.Variables = CloneExprs(n.Variables)
 	return &out
 }
 
@@ -2762,6 +3519,17 @@ func CloneRefOfSet(n *Set) *Set {
 	return &out
 }
 
+// CloneRefOfSetDefaultRole creates a deep clone of the input.
+func CloneRefOfSetDefaultRole(n *SetDefaultRole) *SetDefaultRole {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Roles = CloneSliceOfRefOfAccount(n.Roles)
+	out.To = CloneSliceOfRefOfAccount(n.To)
+	return &out
+}
+
 // CloneRefOfSetExpr creates a deep clone of the input.
 func CloneRefOfSetExpr(n *SetExpr) *SetExpr {
 	if n == nil {
@@ -2785,6 +3553,27 @@ func CloneSetExprs(n SetExprs) SetExprs {
 	return res
 }
 
+// CloneRefOfSetPassword creates a deep clone of the input.
+func CloneRefOfSetPassword(n *SetPassword) *SetPassword {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.For = CloneRefOfAccount(n.For)
+	out.Password = CloneRefOfLiteral(n.Password)
+	return &out
+}
+
+// CloneRefOfSetRole creates a deep clone of the input.
+func CloneRefOfSetRole(n *SetRole) *SetRole {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Roles = CloneSliceOfRefOfAccount(n.Roles)
+	return &out
+}
+
 // CloneRefOfShow creates a deep clone of the input.
 func CloneRefOfShow(n *Show) *Show {
 	if n == nil {
@@ -2866,6 +3655,27 @@ func CloneRefOfShowThrottlerStatus(n *ShowThrottlerStatus) *ShowThrottlerStatus
 	return &out
 }
 
+// CloneRefOfSignal creates a deep clone of the input.
+func CloneRefOfSignal(n *Signal) *Signal {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Condition = CloneRefOfConditionValue(n.Condition)
+	out.Items = CloneSliceOfRefOfSignalItem(n.Items)
+	return &out
+}
+
+// CloneRefOfSignalItem creates a deep clone of the input.
+func CloneRefOfSignalItem(n *SignalItem) *SignalItem {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Value = CloneExpr(n.Value)
+	return &out
+}
+
 // CloneRefOfStarExpr creates a deep clone of the input.
 func CloneRefOfStarExpr(n *StarExpr) *StarExpr {
 	if n == nil {
@@ -2876,6 +3686,18 @@ func CloneRefOfStarExpr(n *StarExpr) *StarExpr {
 	return &out
 }
 
+// CloneStatementList creates a deep clone of the input.
+func CloneStatementList(n StatementList) StatementList {
+	if n == nil {
+		return nil
+	}
+	res := make(StatementList, len(n))
+	for i, x := range n {
+		res[i] = CloneStatement(x)
+	}
+	return res
+}
+
 // CloneRefOfStd creates a deep clone of the input.
 func CloneRefOfStd(n *Std) *Std {
 	if n == nil {
@@ -3084,6 +3906,16 @@ func CloneRefOfTimestampFuncExpr(n *TimestampFuncExpr) *TimestampFuncExpr {
 	return &out
 }
 
+// CloneRefOfTriggerOrder creates a deep clone of the input.
+func CloneRefOfTriggerOrder(n *TriggerOrder) *TriggerOrder {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneIdentifierCI(n.Name)
+	return &out
+}
+
 // CloneRefOfTrimFuncExpr creates a deep clone of the input.
 func CloneRefOfTrimFuncExpr(n *TrimFuncExpr) *TrimFuncExpr {
 	if n == nil {
@@ -3105,6 +3937,17 @@ func CloneRefOfTruncateTable(n *TruncateTable) *TruncateTable {
 	return &out
 }
 
+// CloneRefOfTypeCastExpr creates a deep clone of the input.
+func CloneRefOfTypeCastExpr(n *TypeCastExpr) *TypeCastExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.Type = CloneRefOfConvertType(n.Type)
+	return &out
+}
+
 // CloneRefOfUnaryExpr creates a deep clone of the input.
 func CloneRefOfUnaryExpr(n *UnaryExpr) *UnaryExpr {
 	if n == nil {
@@ -3152,6 +3995,7 @@ func CloneRefOfUpdate(n *Update) *Update {
 	out.Where = CloneRefOfWhere(n.Where)
 	out.OrderBy = CloneOrderBy(n.OrderBy)
 	out.Limit = CloneRefOfLimit(n.Limit)
+	out.Returning = CloneSelectExprs(n.Returning)
 	return &out
 }
 
@@ -3200,6 +4044,17 @@ func CloneRefOfUse(n *Use) *Use {
 	return &out
 }
 
+// CloneRefOfUserSpec creates a deep clone of the input.
+func CloneRefOfUserSpec(n *UserSpec) *UserSpec {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Account = CloneRefOfAccount(n.Account)
+	out.Auth = CloneRefOfAuthentication(n.Auth)
+	return &out
+}
+
 // CloneRefOfVExplainStmt creates a deep clone of the input.
 func CloneRefOfVExplainStmt(n *VExplainStmt) *VExplainStmt {
 	if n == nil {
@@ -3357,6 +4212,18 @@ func CloneRefOfWhere(n *Where) *Where {
 	return &out
 }
 
+// CloneRefOfWhileStatement creates a deep clone of the input.
+func CloneRefOfWhileStatement(n *WhileStatement) *WhileStatement {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Label = CloneIdentifierCI(n.Label)
+	out.Cond = CloneExpr(n.Cond)
+	out.Statements = CloneStatementList(n.Statements)
+	return &out
+}
+
 // CloneRefOfWindowDefinition creates a deep clone of the input.
 func CloneRefOfWindowDefinition(n *WindowDefinition) *WindowDefinition {
 	if n == nil {
@@ -3742,16 +4609,38 @@ func CloneDDLStatement(in DDLStatement) DDLStatement {
 		return nil
 	}
 	switch in := in.(type) {
+	case *AlterEvent:
+		return CloneRefOfAlterEvent(in)
+	case *AlterFunction:
+		return CloneRefOfAlterFunction(in)
+	case *AlterProcedure:
+		return CloneRefOfAlterProcedure(in)
 	case *AlterTable:
 		return CloneRefOfAlterTable(in)
 	case *AlterView:
 		return CloneRefOfAlterView(in)
+	case *CreateEvent:
+		return CloneRefOfCreateEvent(in)
+	case *CreateFunction:
+		return CloneRefOfCreateFunction(in)
+	case *CreateProcedure:
+		return CloneRefOfCreateProcedure(in)
 	case *CreateTable:
 		return CloneRefOfCreateTable(in)
+	case *CreateTrigger:
+		return CloneRefOfCreateTrigger(in)
 	case *CreateView:
 		return CloneRefOfCreateView(in)
+	case *DropEvent:
+		return CloneRefOfDropEvent(in)
+	case *DropFunction:
+		return CloneRefOfDropFunction(in)
+	case *DropProcedure:
+		return CloneRefOfDropProcedure(in)
 	case *DropTable:
 		return CloneRefOfDropTable(in)
+	case *DropTrigger:
+		return CloneRefOfDropTrigger(in)
 	case *DropView:
 		return CloneRefOfDropView(in)
 	case *RenameTable:
@@ -3972,6 +4861,8 @@ func CloneExpr(in Expr) Expr {
 		return CloneRefOfPolygonExpr(in)
 	case *PolygonPropertyFuncExpr:
 		return CloneRefOfPolygonPropertyFuncExpr(in)
+	case *PositionalArg:
+		return CloneRefOfPositionalArg(in)
 	case *RegexpInstrExpr:
 		return CloneRefOfRegexpInstrExpr(in)
 	case *RegexpLikeExpr:
@@ -3998,6 +4889,8 @@ func CloneExpr(in Expr) Expr {
 		return CloneRefOfTimestampFuncExpr(in)
 	case *TrimFuncExpr:
 		return CloneRefOfTrimFuncExpr(in)
+	case *TypeCastExpr:
+		return CloneRefOfTypeCastExpr(in)
 	case *UnaryExpr:
 		return CloneRefOfUnaryExpr(in)
 	case *UpdateXMLExpr:
@@ -4118,36 +5011,84 @@ func CloneStatement(in Statement) Statement {
 	switch in := in.(type) {
 	case *AlterDatabase:
 		return CloneRefOfAlterDatabase(in)
+	case *AlterEvent:
+		return CloneRefOfAlterEvent(in)
+	case *AlterFunction:
+		return CloneRefOfAlterFunction(in)
 	case *AlterMigration:
 		return CloneRefOfAlterMigration(in)
+	case *AlterProcedure:
+		return CloneRefOfAlterProcedure(in)
 	case *AlterTable:
 		return CloneRefOfAlterTable(in)
+	case *AlterUser:
+		return CloneRefOfAlterUser(in)
 	case *AlterView:
 		return CloneRefOfAlterView(in)
 	case *AlterVschema:
 		return CloneRefOfAlterVschema(in)
+	case *BadStatement:
+		return CloneRefOfBadStatement(in)
 	case *Begin:
 		return CloneRefOfBegin(in)
+	case *BeginEndBlock:
+		return CloneRefOfBeginEndBlock(in)
 	case *CallProc:
 		return CloneRefOfCallProc(in)
+	case *CaseStatement:
+		return CloneRefOfCaseStatement(in)
+	case *CloseCursor:
+		return CloneRefOfCloseCursor(in)
 	case *CommentOnly:
 		return CloneRefOfCommentOnly(in)
 	case *Commit:
 		return CloneRefOfCommit(in)
 	case *CreateDatabase:
 		return CloneRefOfCreateDatabase(in)
+	case *CreateEvent:
+		return CloneRefOfCreateEvent(in)
+	case *CreateFunction:
+		return CloneRefOfCreateFunction(in)
+	case *CreateProcedure:
+		return CloneRefOfCreateProcedure(in)
+	case *CreateRole:
+		return CloneRefOfCreateRole(in)
 	case *CreateTable:
 		return CloneRefOfCreateTable(in)
+	case *CreateTrigger:
+		return CloneRefOfCreateTrigger(in)
+	case *CreateUser:
+		return CloneRefOfCreateUser(in)
 	case *CreateView:
 		return CloneRefOfCreateView(in)
 	case *DeallocateStmt:
 		return CloneRefOfDeallocateStmt(in)
+	case *DeclareCondition:
+		return CloneRefOfDeclareCondition(in)
+	case *DeclareCursor:
+		return CloneRefOfDeclareCursor(in)
+	case *DeclareHandler:
+		return CloneRefOfDeclareHandler(in)
+	case *DeclareVar:
+		return CloneRefOfDeclareVar(in)
 	case *Delete:
 		return CloneRefOfDelete(in)
 	case *DropDatabase:
 		return CloneRefOfDropDatabase(in)
+	case *DropEvent:
+		return CloneRefOfDropEvent(in)
+	case *DropFunction:
+		return CloneRefOfDropFunction(in)
+	case *DropProcedure:
+		return CloneRefOfDropProcedure(in)
+	case *DropRole:
+		return CloneRefOfDropRole(in)
 	case *DropTable:
 		return CloneRefOfDropTable(in)
+	case *DropTrigger:
+		return CloneRefOfDropTrigger(in)
+	case *DropUser:
+		return CloneRefOfDropUser(in)
 	case *DropView:
 		return CloneRefOfDropView(in)
 	case *ExecuteStmt:
@@ -4156,14 +5097,28 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfExplainStmt(in)
 	case *ExplainTab:
 		return CloneRefOfExplainTab(in)
+	case *FetchCursor:
+		return CloneRefOfFetchCursor(in)
 	case *Flush:
 		return CloneRefOfFlush(in)
+	case *Grant:
+		return CloneRefOfGrant(in)
+	case *IfStatement:
+		return CloneRefOfIfStatement(in)
 	case *Insert:
 		return CloneRefOfInsert(in)
+	case *IterateStatement:
+		return CloneRefOfIterateStatement(in)
+	case *LeaveStatement:
+		return CloneRefOfLeaveStatement(in)
 	case *Load:
 		return CloneRefOfLoad(in)
 	case *LockTables:
 		return CloneRefOfLockTables(in)
+	case *LoopStatement:
+		return CloneRefOfLoopStatement(in)
+	case *OpenCursor:
+		return CloneRefOfOpenCursor(in)
 	case *OtherAdmin:
 		return CloneRefOfOtherAdmin(in)
 	case *OtherRead:
@@ -4176,10 +5131,20 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfRelease(in)
 	case *RenameTable:
 		return CloneRefOfRenameTable(in)
+	case *RenameUser:
+		return CloneRefOfRenameUser(in)
+	case *RepeatStatement:
+		return CloneRefOfRepeatStatement(in)
+	case *ReturnStatement:
+		return CloneRefOfReturnStatement(in)
 	case *RevertMigration:
 		return CloneRefOfRevertMigration(in)
+	case *Revoke:
+		return CloneRefOfRevoke(in)
 	case *Rollback:
 		return CloneRefOfRollback(in)
+	case *RoutineSet:
+		return CloneRefOfRoutineSet(in)
 	case *SRollback:
 		return CloneRefOfSRollback(in)
 	case *Savepoint:
@@ -4188,6 +5153,12 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfSelect(in)
 	case *Set:
 		return CloneRefOfSet(in)
+	case *SetDefaultRole:
+		return CloneRefOfSetDefaultRole(in)
+	case *SetPassword:
+		return CloneRefOfSetPassword(in)
+	case *SetRole:
+		return CloneRefOfSetRole(in)
 	case *Show:
 		return CloneRefOfShow(in)
 	case *ShowMigrationLogs:
@@ -4196,6 +5167,8 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfShowThrottledApps(in)
 	case *ShowThrottlerStatus:
 		return CloneRefOfShowThrottlerStatus(in)
+	case *Signal:
+		return CloneRefOfSignal(in)
 	case *Stream:
 		return CloneRefOfStream(in)
 	case *TruncateTable:
@@ -4212,6 +5185,8 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfVExplainStmt(in)
 	case *VStream:
 		return CloneRefOfVStream(in)
+	case *WhileStatement:
+		return CloneRefOfWhileStatement(in)
 	default:
 		// this should never happen
 		return nil
@@ -4271,6 +5246,18 @@ func CloneSliceOfDatabaseOption(n []DatabaseOption) []DatabaseOption {
 	return res
 }
 
+// CloneSliceOfRefOfRoutineCharacteristic creates a deep clone of the input.
+func CloneSliceOfRefOfRoutineCharacteristic(n []*RoutineCharacteristic) []*RoutineCharacteristic {
+	if n == nil {
+		return nil
+	}
+	res := make([]*RoutineCharacteristic, len(n))
+	for i, x := range n {
+		res[i] = CloneRefOfRoutineCharacteristic(x)
+	}
+	return res
+}
+
 // CloneSliceOfAlterOption creates a deep clone of the input.
 func CloneSliceOfAlterOption(n []AlterOption) []AlterOption {
 	if n == nil {
@@ -4283,6 +5270,18 @@ func CloneSliceOfAlterOption(n []AlterOption) []AlterOption {
 	return res
 }
 
+// CloneSliceOfRefOfUserSpec creates a deep clone of the input.
+func CloneSliceOfRefOfUserSpec(n []*UserSpec) []*UserSpec {
+	if n == nil {
+		return nil
+	}
+	res := make([]*UserSpec, len(n))
+	for i, x := range n {
+		res[i] = CloneRefOfUserSpec(x)
+	}
+	return res
+}
+
 // CloneSliceOfIdentifierCI creates a deep clone of the input.
 func CloneSliceOfIdentifierCI(n []IdentifierCI) []IdentifierCI {
 	if n == nil {
@@ -4317,6 +5316,18 @@ func CloneSliceOfRefOfWhen(n []*When) []*When {
 	return res
 }
 
+// CloneSliceOfRefOfCaseStatementWhen creates a deep clone of the input.
+func CloneSliceOfRefOfCaseStatementWhen(n []*CaseStatementWhen) []*CaseStatementWhen {
+	if n == nil {
+		return nil
+	}
+	res := make([]*CaseStatementWhen, len(n))
+	for i, x := range n {
+		res[i] = CloneRefOfCaseStatementWhen(x)
+	}
+	return res
+}
+
 // CloneRefOfColumnTypeOptions creates a deep clone of the input.
 func CloneRefOfColumnTypeOptions(n *ColumnTypeOptions) *ColumnTypeOptions {
 	if n == nil {
@@ -4351,6 +5362,42 @@ func CloneSliceOfString(n []string) []string {
 	return res
 }
 
+// CloneSliceOfRefOfProcParameter creates a deep clone of the input.
+func CloneSliceOfRefOfProcParameter(n []*ProcParameter) []*ProcParameter {
+	if n == nil {
+		return nil
+	}
+	res := make([]*ProcParameter, len(n))
+	for i, x := range n {
+		res[i] = CloneRefOfProcParameter(x)
+	}
+	return res
+}
+
+// CloneSliceOfRefOfAccount creates a deep clone of the input.
+func CloneSliceOfRefOfAccount(n []*Account) []*Account {
+	if n == nil {
+		return nil
+	}
+	res := make([]*Account, len(n))
+	for i, x := range n {
+		res[i] = CloneRefOfAccount(x)
+	}
+	return res
+}
+
+// CloneSliceOfRefOfConditionValue creates a deep clone of the input.
+func CloneSliceOfRefOfConditionValue(n []*ConditionValue) []*ConditionValue {
+	if n == nil {
+		return nil
+	}
+	res := make([]*ConditionValue, len(n))
+	for i, x := range n {
+		res[i] = CloneRefOfConditionValue(x)
+	}
+	return res
+}
+
 // CloneSliceOfRefOfVariable creates a deep clone of the input.
 func CloneSliceOfRefOfVariable(n []*Variable) []*Variable {
 	if n == nil {
@@ -4363,6 +5410,18 @@ func CloneSliceOfRefOfVariable(n []*Variable) []*Variable {
 	return res
 }
 
+// CloneSliceOfRefOfGrantPrivilege creates a deep clone of the input.
+func CloneSliceOfRefOfGrantPrivilege(n []*GrantPrivilege) []*GrantPrivilege {
+	if n == nil {
+		return nil
+	}
+	res := make([]*GrantPrivilege, len(n))
+	for i, x := range n {
+		res[i] = CloneRefOfGrantPrivilege(x)
+	}
+	return res
+}
+
 // CloneRefOfIdentifierCI creates a deep clone of the input.
 func CloneRefOfIdentifierCI(n *IdentifierCI) *IdentifierCI {
 	if n == nil {
@@ -4381,6 +5440,18 @@ func CloneRefOfIdentifierCS(n *IdentifierCS) *IdentifierCS {
 	return &out
 }
 
+// CloneSliceOfRefOfElseIfBlock creates a deep clone of the input.
+func CloneSliceOfRefOfElseIfBlock(n []*ElseIfBlock) []*ElseIfBlock {
+	if n == nil {
+		return nil
+	}
+	res := make([]*ElseIfBlock, len(n))
+	for i, x := range n {
+		res[i] = CloneRefOfElseIfBlock(x)
+	}
+	return res
+}
+
 // CloneSliceOfRefOfIndexColumn creates a deep clone of the input.
 func CloneSliceOfRefOfIndexColumn(n []*IndexColumn) []*IndexColumn {
 	if n == nil {
@@ -4545,6 +5616,18 @@ func CloneSliceOfRefOfRenameTablePair(n []*RenameTablePair) []*RenameTablePair {
 	return res
 }
 
+// CloneSliceOfRefOfRenameUserPair creates a deep clone of the input.
+func CloneSliceOfRefOfRenameUserPair(n []*RenameUserPair) []*RenameUserPair {
+	if n == nil {
+		return nil
+	}
+	res := make([]*RenameUserPair, len(n))
+	for i, x := range n {
+		res[i] = CloneRefOfRenameUserPair(x)
+	}
+	return res
+}
+
 // CloneRefOfRootNode creates a deep clone of the input.
 func CloneRefOfRootNode(n *RootNode) *RootNode {
 	if n == nil {
@@ -4567,6 +5650,18 @@ func CloneSliceOfTableExpr(n []TableExpr) []TableExpr {
 	return res
 }
 
+// CloneSliceOfRefOfSignalItem creates a deep clone of the input.
+func CloneSliceOfRefOfSignalItem(n []*SignalItem) []*SignalItem {
+	if n == nil {
+		return nil
+	}
+	res := make([]*SignalItem, len(n))
+	for i, x := range n {
+		res[i] = CloneRefOfSignalItem(x)
+	}
+	return res
+}
+
 // CloneRefOfTableName creates a deep clone of the input.
 func CloneRefOfTableName(n *TableName) *TableName {
 	if n == nil {
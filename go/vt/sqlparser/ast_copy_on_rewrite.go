@@ -22,6 +22,8 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return n, false
 	}
 	switch n := n.(type) {
+	case *Account:
+		return c.copyOnRewriteRefOfAccount(n, parent)
 	case *AddColumns:
 		return c.copyOnRewriteRefOfAddColumns(n, parent)
 	case *AddConstraintDefinition:
@@ -42,12 +44,20 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfAlterColumn(n, parent)
 	case *AlterDatabase:
 		return c.copyOnRewriteRefOfAlterDatabase(n, parent)
+	case *AlterEvent:
+		return c.copyOnRewriteRefOfAlterEvent(n, parent)
+	case *AlterFunction:
+		return c.copyOnRewriteRefOfAlterFunction(n, parent)
 	case *AlterIndex:
 		return c.copyOnRewriteRefOfAlterIndex(n, parent)
 	case *AlterMigration:
 		return c.copyOnRewriteRefOfAlterMigration(n, parent)
+	case *AlterProcedure:
+		return c.copyOnRewriteRefOfAlterProcedure(n, parent)
 	case *AlterTable:
 		return c.copyOnRewriteRefOfAlterTable(n, parent)
+	case *AlterUser:
+		return c.copyOnRewriteRefOfAlterUser(n, parent)
 	case *AlterView:
 		return c.copyOnRewriteRefOfAlterView(n, parent)
 	case *AlterVschema:
@@ -60,12 +70,18 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfArgumentLessWindowExpr(n, parent)
 	case *AssignmentExpr:
 		return c.copyOnRewriteRefOfAssignmentExpr(n, parent)
+	case *Authentication:
+		return c.copyOnRewriteRefOfAuthentication(n, parent)
 	case *AutoIncSpec:
 		return c.copyOnRewriteRefOfAutoIncSpec(n, parent)
 	case *Avg:
 		return c.copyOnRewriteRefOfAvg(n, parent)
+	case *BadStatement:
+		return c.copyOnRewriteRefOfBadStatement(n, parent)
 	case *Begin:
 		return c.copyOnRewriteRefOfBegin(n, parent)
+	case *BeginEndBlock:
+		return c.copyOnRewriteRefOfBeginEndBlock(n, parent)
 	case *BetweenExpr:
 		return c.copyOnRewriteRefOfBetweenExpr(n, parent)
 	case *BinaryExpr:
@@ -82,6 +98,10 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfCallProc(n, parent)
 	case *CaseExpr:
 		return c.copyOnRewriteRefOfCaseExpr(n, parent)
+	case *CaseStatement:
+		return c.copyOnRewriteRefOfCaseStatement(n, parent)
+	case *CaseStatementWhen:
+		return c.copyOnRewriteRefOfCaseStatementWhen(n, parent)
 	case *CastExpr:
 		return c.copyOnRewriteRefOfCastExpr(n, parent)
 	case *ChangeColumn:
@@ -90,6 +110,8 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfCharExpr(n, parent)
 	case *CheckConstraintDefinition:
 		return c.copyOnRewriteRefOfCheckConstraintDefinition(n, parent)
+	case *CloseCursor:
+		return c.copyOnRewriteRefOfCloseCursor(n, parent)
 	case *ColName:
 		return c.copyOnRewriteRefOfColName(n, parent)
 	case *CollateExpr:
@@ -108,6 +130,8 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfCommonTableExpr(n, parent)
 	case *ComparisonExpr:
 		return c.copyOnRewriteRefOfComparisonExpr(n, parent)
+	case *ConditionValue:
+		return c.copyOnRewriteRefOfConditionValue(n, parent)
 	case *ConstraintDefinition:
 		return c.copyOnRewriteRefOfConstraintDefinition(n, parent)
 	case *ConvertExpr:
@@ -122,8 +146,20 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfCountStar(n, parent)
 	case *CreateDatabase:
 		return c.copyOnRewriteRefOfCreateDatabase(n, parent)
+	case *CreateEvent:
+		return c.copyOnRewriteRefOfCreateEvent(n, parent)
+	case *CreateFunction:
+		return c.copyOnRewriteRefOfCreateFunction(n, parent)
+	case *CreateProcedure:
+		return c.copyOnRewriteRefOfCreateProcedure(n, parent)
+	case *CreateRole:
+		return c.copyOnRewriteRefOfCreateRole(n, parent)
 	case *CreateTable:
 		return c.copyOnRewriteRefOfCreateTable(n, parent)
+	case *CreateTrigger:
+		return c.copyOnRewriteRefOfCreateTrigger(n, parent)
+	case *CreateUser:
+		return c.copyOnRewriteRefOfCreateUser(n, parent)
 	case *CreateView:
 		return c.copyOnRewriteRefOfCreateView(n, parent)
 	case *CurTimeFuncExpr:
@@ -134,6 +170,14 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfDateSubExpr(n, parent)
 	case *DeallocateStmt:
 		return c.copyOnRewriteRefOfDeallocateStmt(n, parent)
+	case *DeclareCondition:
+		return c.copyOnRewriteRefOfDeclareCondition(n, parent)
+	case *DeclareCursor:
+		return c.copyOnRewriteRefOfDeclareCursor(n, parent)
+	case *DeclareHandler:
+		return c.copyOnRewriteRefOfDeclareHandler(n, parent)
+	case *DeclareVar:
+		return c.copyOnRewriteRefOfDeclareVar(n, parent)
 	case *Default:
 		return c.copyOnRewriteRefOfDefault(n, parent)
 	case *Definer:
@@ -146,12 +190,28 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfDropColumn(n, parent)
 	case *DropDatabase:
 		return c.copyOnRewriteRefOfDropDatabase(n, parent)
+	case *DropEvent:
+		return c.copyOnRewriteRefOfDropEvent(n, parent)
+	case *DropFunction:
+		return c.copyOnRewriteRefOfDropFunction(n, parent)
 	case *DropKey:
 		return c.copyOnRewriteRefOfDropKey(n, parent)
+	case *DropProcedure:
+		return c.copyOnRewriteRefOfDropProcedure(n, parent)
+	case *DropRole:
+		return c.copyOnRewriteRefOfDropRole(n, parent)
 	case *DropTable:
 		return c.copyOnRewriteRefOfDropTable(n, parent)
+	case *DropTrigger:
+		return c.copyOnRewriteRefOfDropTrigger(n, parent)
+	case *DropUser:
+		return c.copyOnRewriteRefOfDropUser(n, parent)
 	case *DropView:
 		return c.copyOnRewriteRefOfDropView(n, parent)
+	case *ElseIfBlock:
+		return c.copyOnRewriteRefOfElseIfBlock(n, parent)
+	case *EventSchedule:
+		return c.copyOnRewriteRefOfEventSchedule(n, parent)
 	case *ExecuteStmt:
 		return c.copyOnRewriteRefOfExecuteStmt(n, parent)
 	case *ExistsExpr:
@@ -168,6 +228,8 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfExtractValueExpr(n, parent)
 	case *ExtractedSubquery:
 		return c.copyOnRewriteRefOfExtractedSubquery(n, parent)
+	case *FetchCursor:
+		return c.copyOnRewriteRefOfFetchCursor(n, parent)
 	case *FirstOrLastValueExpr:
 		return c.copyOnRewriteRefOfFirstOrLastValueExpr(n, parent)
 	case *Flush:
@@ -206,6 +268,10 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfGeomFromWKBExpr(n, parent)
 	case *GeomPropertyFuncExpr:
 		return c.copyOnRewriteRefOfGeomPropertyFuncExpr(n, parent)
+	case *Grant:
+		return c.copyOnRewriteRefOfGrant(n, parent)
+	case *GrantPrivilege:
+		return c.copyOnRewriteRefOfGrantPrivilege(n, parent)
 	case GroupBy:
 		return c.copyOnRewriteGroupBy(n, parent)
 	case *GroupConcatExpr:
@@ -214,6 +280,8 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteIdentifierCI(n, parent)
 	case IdentifierCS:
 		return c.copyOnRewriteIdentifierCS(n, parent)
+	case *IfStatement:
+		return c.copyOnRewriteRefOfIfStatement(n, parent)
 	case *IndexDefinition:
 		return c.copyOnRewriteRefOfIndexDefinition(n, parent)
 	case *IndexHint:
@@ -232,6 +300,8 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfIntroducerExpr(n, parent)
 	case *IsExpr:
 		return c.copyOnRewriteRefOfIsExpr(n, parent)
+	case *IterateStatement:
+		return c.copyOnRewriteRefOfIterateStatement(n, parent)
 	case *JSONArrayExpr:
 		return c.copyOnRewriteRefOfJSONArrayExpr(n, parent)
 	case *JSONAttributesExpr:
@@ -288,6 +358,8 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfKeyState(n, parent)
 	case *LagLeadExpr:
 		return c.copyOnRewriteRefOfLagLeadExpr(n, parent)
+	case *LeaveStatement:
+		return c.copyOnRewriteRefOfLeaveStatement(n, parent)
 	case *Limit:
 		return c.copyOnRewriteRefOfLimit(n, parent)
 	case *LineStringExpr:
@@ -300,6 +372,10 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfLiteral(n, parent)
 	case *Load:
 		return c.copyOnRewriteRefOfLoad(n, parent)
+	case *LoadFields:
+		return c.copyOnRewriteRefOfLoadFields(n, parent)
+	case *LoadLines:
+		return c.copyOnRewriteRefOfLoadLines(n, parent)
 	case *LocateExpr:
 		return c.copyOnRewriteRefOfLocateExpr(n, parent)
 	case *LockOption:
@@ -308,6 +384,8 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfLockTables(n, parent)
 	case *LockingFunc:
 		return c.copyOnRewriteRefOfLockingFunc(n, parent)
+	case *LoopStatement:
+		return c.copyOnRewriteRefOfLoopStatement(n, parent)
 	case MatchAction:
 		return c.copyOnRewriteMatchAction(n, parent)
 	case *MatchExpr:
@@ -344,8 +422,12 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfNullVal(n, parent)
 	case *Offset:
 		return c.copyOnRewriteRefOfOffset(n, parent)
+	case *OnConflict:
+		return c.copyOnRewriteRefOfOnConflict(n, parent)
 	case OnDup:
 		return c.copyOnRewriteOnDup(n, parent)
+	case *OpenCursor:
+		return c.copyOnRewriteRefOfOpenCursor(n, parent)
 	case *OptLike:
 		return c.copyOnRewriteRefOfOptLike(n, parent)
 	case *OrExpr:
@@ -380,6 +462,8 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfPartitionValueRange(n, parent)
 	case Partitions:
 		return c.copyOnRewritePartitions(n, parent)
+	case *PasswordExpire:
+		return c.copyOnRewriteRefOfPasswordExpire(n, parent)
 	case *PerformanceSchemaFuncExpr:
 		return c.copyOnRewriteRefOfPerformanceSchemaFuncExpr(n, parent)
 	case *PointExpr:
@@ -390,8 +474,14 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfPolygonExpr(n, parent)
 	case *PolygonPropertyFuncExpr:
 		return c.copyOnRewriteRefOfPolygonPropertyFuncExpr(n, parent)
+	case *PositionalArg:
+		return c.copyOnRewriteRefOfPositionalArg(n, parent)
 	case *PrepareStmt:
 		return c.copyOnRewriteRefOfPrepareStmt(n, parent)
+	case *PrivilegeLevel:
+		return c.copyOnRewriteRefOfPrivilegeLevel(n, parent)
+	case *ProcParameter:
+		return c.copyOnRewriteRefOfProcParameter(n, parent)
 	case *PurgeBinaryLogs:
 		return c.copyOnRewriteRefOfPurgeBinaryLogs(n, parent)
 	case ReferenceAction:
@@ -416,12 +506,30 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfRenameTable(n, parent)
 	case *RenameTableName:
 		return c.copyOnRewriteRefOfRenameTableName(n, parent)
+	case *RenameUser:
+		return c.copyOnRewriteRefOfRenameUser(n, parent)
+	case *RenameUserPair:
+		return c.copyOnRewriteRefOfRenameUserPair(n, parent)
+	case *RepeatStatement:
+		return c.copyOnRewriteRefOfRepeatStatement(n, parent)
+	case *ReturnStatement:
+		return c.copyOnRewriteRefOfReturnStatement(n, parent)
 	case *RevertMigration:
 		return c.copyOnRewriteRefOfRevertMigration(n, parent)
+	case *Revoke:
+		return c.copyOnRewriteRefOfRevoke(n, parent)
 	case *Rollback:
 		return c.copyOnRewriteRefOfRollback(n, parent)
 	case RootNode:
 		return c.copyOnRewriteRootNode(n, parent)
+	case *RoutineCharacteristic:
+		return c.copyOnRewriteRefOfRoutineCharacteristic(n, parent)
+	case *RoutineSet:
+		return c.copyOnRewriteRefOfRoutineSet(n, parent)
+	case *RoutineSetExpr:
+		return c.copyOnRewriteRefOfRoutineSetExpr(n, parent)
+	case RoutineSetExprs:
+		return c.copyOnRewriteRoutineSetExprs(n, parent)
 	case *SRollback:
 		return c.copyOnRewriteRefOfSRollback(n, parent)
 	case *Savepoint:
@@ -434,10 +542,16 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfSelectInto(n, parent)
 	case *Set:
 		return c.copyOnRewriteRefOfSet(n, parent)
+	case *SetDefaultRole:
+		return c.copyOnRewriteRefOfSetDefaultRole(n, parent)
 	case *SetExpr:
 		return c.copyOnRewriteRefOfSetExpr(n, parent)
 	case SetExprs:
 		return c.copyOnRewriteSetExprs(n, parent)
+	case *SetPassword:
+		return c.copyOnRewriteRefOfSetPassword(n, parent)
+	case *SetRole:
+		return c.copyOnRewriteRefOfSetRole(n, parent)
 	case *Show:
 		return c.copyOnRewriteRefOfShow(n, parent)
 	case *ShowBasic:
@@ -454,8 +568,14 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfShowThrottledApps(n, parent)
 	case *ShowThrottlerStatus:
 		return c.copyOnRewriteRefOfShowThrottlerStatus(n, parent)
+	case *Signal:
+		return c.copyOnRewriteRefOfSignal(n, parent)
+	case *SignalItem:
+		return c.copyOnRewriteRefOfSignalItem(n, parent)
 	case *StarExpr:
 		return c.copyOnRewriteRefOfStarExpr(n, parent)
+	case StatementList:
+		return c.copyOnRewriteStatementList(n, parent)
 	case *Std:
 		return c.copyOnRewriteRefOfStd(n, parent)
 	case *StdDev:
@@ -494,10 +614,14 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfTablespaceOperation(n, parent)
 	case *TimestampFuncExpr:
 		return c.copyOnRewriteRefOfTimestampFuncExpr(n, parent)
+	case *TriggerOrder:
+		return c.copyOnRewriteRefOfTriggerOrder(n, parent)
 	case *TrimFuncExpr:
 		return c.copyOnRewriteRefOfTrimFuncExpr(n, parent)
 	case *TruncateTable:
 		return c.copyOnRewriteRefOfTruncateTable(n, parent)
+	case *TypeCastExpr:
+		return c.copyOnRewriteRefOfTypeCastExpr(n, parent)
 	case *UnaryExpr:
 		return c.copyOnRewriteRefOfUnaryExpr(n, parent)
 	case *Union:
@@ -514,6 +638,8 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfUpdateXMLExpr(n, parent)
 	case *Use:
 		return c.copyOnRewriteRefOfUse(n, parent)
+	case *UserSpec:
+		return c.copyOnRewriteRefOfUserSpec(n, parent)
 	case *VExplainStmt:
 		return c.copyOnRewriteRefOfVExplainStmt(n, parent)
 	case *VStream:
@@ -544,6 +670,8 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfWhen(n, parent)
 	case *Where:
 		return c.copyOnRewriteRefOfWhere(n, parent)
+	case *WhileStatement:
+		return c.copyOnRewriteRefOfWhileStatement(n, parent)
 	case *WindowDefinition:
 		return c.copyOnRewriteRefOfWindowDefinition(n, parent)
 	case WindowDefinitions:
@@ -559,6 +687,18 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return nil, false
 	}
 }
+func (c *cow) copyOnRewriteRefOfAccount(n *Account, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfAddColumns(n *AddColumns, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -769,6 +909,74 @@ func (c *cow) copyOnRewriteRefOfAlterDatabase(n *AlterDatabase, parent SQLNode)
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfAlterEvent(n *AlterEvent, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		_Definer, changedDefiner := c.copyOnRewriteRefOfDefiner(n.Definer, n)
+		_Name, changedName := c.copyOnRewriteTableName(n.Name, n)
+		_Schedule, changedSchedule := c.copyOnRewriteRefOfEventSchedule(n.Schedule, n)
+		_RenameTo, changedRenameTo := c.copyOnRewriteTableName(n.RenameTo, n)
+		_Comment, changedComment := c.copyOnRewriteRefOfLiteral(n.Comment, n)
+		_Body, changedBody := c.copyOnRewriteStatement(n.Body, n)
+		if changedComments || changedDefiner || changedName || changedSchedule || changedRenameTo || changedComment || changedBody {
+			res := *n
+			res.Comments, _ = _Comments.(*ParsedComments)
+			res.Definer, _ = _Definer.(*Definer)
+			res.Name, _ = _Name.(TableName)
+			res.Schedule, _ = _Schedule.(*EventSchedule)
+			res.RenameTo, _ = _RenameTo.(TableName)
+			res.Comment, _ = _Comment.(*Literal)
+			res.Body, _ = _Body.(Statement)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfAlterFunction(n *AlterFunction, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		_Name, changedName := c.copyOnRewriteTableName(n.Name, n)
+		var changedCharacteristics bool
+		_Characteristics := make([]*RoutineCharacteristic, len(n.Characteristics))
+		for x, el := range n.Characteristics {
+			this, changed := c.copyOnRewriteRefOfRoutineCharacteristic(el, n)
+			_Characteristics[x] = this.(*RoutineCharacteristic)
+			if changed {
+				changedCharacteristics = true
+			}
+		}
+		if changedComments || changedName || changedCharacteristics {
+			res := *n
+			res.Comments, _ = _Comments.(*ParsedComments)
+			res.Name, _ = _Name.(TableName)
+			res.Characteristics = _Characteristics
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfAlterIndex(n *AlterIndex, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -813,6 +1021,40 @@ func (c *cow) copyOnRewriteRefOfAlterMigration(n *AlterMigration, parent SQLNode
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfAlterProcedure(n *AlterProcedure, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		_Name, changedName := c.copyOnRewriteTableName(n.Name, n)
+		var changedCharacteristics bool
+		_Characteristics := make([]*RoutineCharacteristic, len(n.Characteristics))
+		for x, el := range n.Characteristics {
+			this, changed := c.copyOnRewriteRefOfRoutineCharacteristic(el, n)
+			_Characteristics[x] = this.(*RoutineCharacteristic)
+			if changed {
+				changedCharacteristics = true
+			}
+		}
+		if changedComments || changedName || changedCharacteristics {
+			res := *n
+			res.Comments, _ = _Comments.(*ParsedComments)
+			res.Name, _ = _Name.(TableName)
+			res.Characteristics = _Characteristics
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfAlterTable(n *AlterTable, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -851,6 +1093,38 @@ func (c *cow) copyOnRewriteRefOfAlterTable(n *AlterTable, parent SQLNode) (out S
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfAlterUser(n *AlterUser, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		var changedUsers bool
+		_Users := make([]*UserSpec, len(n.Users))
+		for x, el := range n.Users {
+			this, changed := c.copyOnRewriteRefOfUserSpec(el, n)
+			_Users[x] = this.(*UserSpec)
+			if changed {
+				changedUsers = true
+			}
+		}
+		_PasswordExpire, changedPasswordExpire := c.copyOnRewriteRefOfPasswordExpire(n.PasswordExpire, n)
+		if changedUsers || changedPasswordExpire {
+			res := *n
+			res.Users = _Users
+			res.PasswordExpire, _ = _PasswordExpire.(*PasswordExpire)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfAlterView(n *AlterView, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -999,6 +1273,32 @@ func (c *cow) copyOnRewriteRefOfAssignmentExpr(n *AssignmentExpr, parent SQLNode
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfAuthentication(n *Authentication, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Plugin, changedPlugin := c.copyOnRewriteIdentifierCI(n.Plugin, n)
+		_Password, changedPassword := c.copyOnRewriteRefOfLiteral(n.Password, n)
+		_CurrentPassword, changedCurrentPassword := c.copyOnRewriteRefOfLiteral(n.CurrentPassword, n)
+		if changedPlugin || changedPassword || changedCurrentPassword {
+			res := *n
+			res.Plugin, _ = _Plugin.(IdentifierCI)
+			res.Password, _ = _Password.(*Literal)
+			res.CurrentPassword, _ = _CurrentPassword.(*Literal)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfAutoIncSpec(n *AutoIncSpec, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -1045,6 +1345,18 @@ func (c *cow) copyOnRewriteRefOfAvg(n *Avg, parent SQLNode) (out SQLNode, change
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfBadStatement(n *BadStatement, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfBegin(n *Begin, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -1057,6 +1369,30 @@ func (c *cow) copyOnRewriteRefOfBegin(n *Begin, parent SQLNode) (out SQLNode, ch
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfBeginEndBlock(n *BeginEndBlock, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Label, changedLabel := c.copyOnRewriteIdentifierCI(n.Label, n)
+		_Statements, changedStatements := c.copyOnRewriteStatementList(n.Statements, n)
+		if changedLabel || changedStatements {
+			res := *n
+			res.Label, _ = _Label.(IdentifierCI)
+			res.Statements, _ = _Statements.(StatementList)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfBetweenExpr(n *BetweenExpr, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -1231,6 +1567,64 @@ func (c *cow) copyOnRewriteRefOfCaseExpr(n *CaseExpr, parent SQLNode) (out SQLNo
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfCaseStatement(n *CaseStatement, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Expr, changedExpr := c.copyOnRewriteExpr(n.Expr, n)
+		var changedWhens bool
+		_Whens := make([]*CaseStatementWhen, len(n.Whens))
+		for x, el := range n.Whens {
+			this, changed := c.copyOnRewriteRefOfCaseStatementWhen(el, n)
+			_Whens[x] = this.(*CaseStatementWhen)
+			if changed {
+				changedWhens = true
+			}
+		}
+		_Else, changedElse := c.copyOnRewriteStatementList(n.Else, n)
+		if changedExpr || changedWhens || changedElse {
+			res := *n
+			res.Expr, _ = _Expr.(Expr)
+			res.Whens = _Whens
+			res.Else, _ = _Else.(StatementList)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfCaseStatementWhen(n *CaseStatementWhen, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Cond, changedCond := c.copyOnRewriteExpr(n.Cond, n)
+		_Statements, changedStatements := c.copyOnRewriteStatementList(n.Statements, n)
+		if changedCond || changedStatements {
+			res := *n
+			res.Cond, _ = _Cond.(Expr)
+			res.Statements, _ = _Statements.(StatementList)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfCastExpr(n *CastExpr, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -1325,6 +1719,28 @@ func (c *cow) copyOnRewriteRefOfCheckConstraintDefinition(n *CheckConstraintDefi
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfCloseCursor(n *CloseCursor, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Name, changedName := c.copyOnRewriteIdentifierCI(n.Name, n)
+		if changedName {
+			res := *n
+			res.Name, _ = _Name.(IdentifierCI)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfColName(n *ColName, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -1518,6 +1934,28 @@ func (c *cow) copyOnRewriteRefOfComparisonExpr(n *ComparisonExpr, parent SQLNode
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfConditionValue(n *ConditionValue, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Name, changedName := c.copyOnRewriteIdentifierCI(n.Name, n)
+		if changedName {
+			res := *n
+			res.Name, _ = _Name.(IdentifierCI)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfConstraintDefinition(n *ConstraintDefinition, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -1670,22 +2108,256 @@ func (c *cow) copyOnRewriteRefOfCreateDatabase(n *CreateDatabase, parent SQLNode
 	}
 	return
 }
-func (c *cow) copyOnRewriteRefOfCreateTable(n *CreateTable, parent SQLNode) (out SQLNode, changed bool) {
+func (c *cow) copyOnRewriteRefOfCreateEvent(n *CreateEvent, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		_Table, changedTable := c.copyOnRewriteTableName(n.Table, n)
-		_TableSpec, changedTableSpec := c.copyOnRewriteRefOfTableSpec(n.TableSpec, n)
-		_OptLike, changedOptLike := c.copyOnRewriteRefOfOptLike(n.OptLike, n)
 		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
-		if changedTable || changedTableSpec || changedOptLike || changedComments {
+		_Definer, changedDefiner := c.copyOnRewriteRefOfDefiner(n.Definer, n)
+		_Name, changedName := c.copyOnRewriteTableName(n.Name, n)
+		_Schedule, changedSchedule := c.copyOnRewriteRefOfEventSchedule(n.Schedule, n)
+		_Comment, changedComment := c.copyOnRewriteRefOfLiteral(n.Comment, n)
+		_Body, changedBody := c.copyOnRewriteStatement(n.Body, n)
+		if changedComments || changedDefiner || changedName || changedSchedule || changedComment || changedBody {
 			res := *n
-			res.Table, _ = _Table.(TableName)
-			res.TableSpec, _ = _TableSpec.(*TableSpec)
-			res.OptLike, _ = _OptLike.(*OptLike)
 			res.Comments, _ = _Comments.(*ParsedComments)
+			res.Definer, _ = _Definer.(*Definer)
+			res.Name, _ = _Name.(TableName)
+			res.Schedule, _ = _Schedule.(*EventSchedule)
+			res.Comment, _ = _Comment.(*Literal)
+			res.Body, _ = _Body.(Statement)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfCreateFunction(n *CreateFunction, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		_Definer, changedDefiner := c.copyOnRewriteRefOfDefiner(n.Definer, n)
+		_Name, changedName := c.copyOnRewriteTableName(n.Name, n)
+		var changedParams bool
+		_Params := make([]*ProcParameter, len(n.Params))
+		for x, el := range n.Params {
+			this, changed := c.copyOnRewriteRefOfProcParameter(el, n)
+			_Params[x] = this.(*ProcParameter)
+			if changed {
+				changedParams = true
+			}
+		}
+		_Returns, changedReturns := c.copyOnRewriteRefOfColumnType(n.Returns, n)
+		var changedCharacteristics bool
+		_Characteristics := make([]*RoutineCharacteristic, len(n.Characteristics))
+		for x, el := range n.Characteristics {
+			this, changed := c.copyOnRewriteRefOfRoutineCharacteristic(el, n)
+			_Characteristics[x] = this.(*RoutineCharacteristic)
+			if changed {
+				changedCharacteristics = true
+			}
+		}
+		_Body, changedBody := c.copyOnRewriteStatement(n.Body, n)
+		if changedComments || changedDefiner || changedName || changedParams || changedReturns || changedCharacteristics || changedBody {
+			res := *n
+			res.Comments, _ = _Comments.(*ParsedComments)
+			res.Definer, _ = _Definer.(*Definer)
+			res.Name, _ = _Name.(TableName)
+			res.Params = _Params
+			res.Returns, _ = _Returns.(*ColumnType)
+			res.Characteristics = _Characteristics
+			res.Body, _ = _Body.(Statement)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfCreateProcedure(n *CreateProcedure, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		_Definer, changedDefiner := c.copyOnRewriteRefOfDefiner(n.Definer, n)
+		_Name, changedName := c.copyOnRewriteTableName(n.Name, n)
+		var changedParams bool
+		_Params := make([]*ProcParameter, len(n.Params))
+		for x, el := range n.Params {
+			this, changed := c.copyOnRewriteRefOfProcParameter(el, n)
+			_Params[x] = this.(*ProcParameter)
+			if changed {
+				changedParams = true
+			}
+		}
+		var changedCharacteristics bool
+		_Characteristics := make([]*RoutineCharacteristic, len(n.Characteristics))
+		for x, el := range n.Characteristics {
+			this, changed := c.copyOnRewriteRefOfRoutineCharacteristic(el, n)
+			_Characteristics[x] = this.(*RoutineCharacteristic)
+			if changed {
+				changedCharacteristics = true
+			}
+		}
+		_Body, changedBody := c.copyOnRewriteStatement(n.Body, n)
+		if changedComments || changedDefiner || changedName || changedParams || changedCharacteristics || changedBody {
+			res := *n
+			res.Comments, _ = _Comments.(*ParsedComments)
+			res.Definer, _ = _Definer.(*Definer)
+			res.Name, _ = _Name.(TableName)
+			res.Params = _Params
+			res.Characteristics = _Characteristics
+			res.Body, _ = _Body.(Statement)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfCreateRole(n *CreateRole, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		var changedRoles bool
+		_Roles := make([]*Account, len(n.Roles))
+		for x, el := range n.Roles {
+			this, changed := c.copyOnRewriteRefOfAccount(el, n)
+			_Roles[x] = this.(*Account)
+			if changed {
+				changedRoles = true
+			}
+		}
+		if changedRoles {
+			res := *n
+			res.Roles = _Roles
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfCreateTable(n *CreateTable, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Table, changedTable := c.copyOnRewriteTableName(n.Table, n)
+		_TableSpec, changedTableSpec := c.copyOnRewriteRefOfTableSpec(n.TableSpec, n)
+		_OptLike, changedOptLike := c.copyOnRewriteRefOfOptLike(n.OptLike, n)
+		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		if changedTable || changedTableSpec || changedOptLike || changedComments {
+			res := *n
+			res.Table, _ = _Table.(TableName)
+			res.TableSpec, _ = _TableSpec.(*TableSpec)
+			res.OptLike, _ = _OptLike.(*OptLike)
+			res.Comments, _ = _Comments.(*ParsedComments)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfCreateTrigger(n *CreateTrigger, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		_Definer, changedDefiner := c.copyOnRewriteRefOfDefiner(n.Definer, n)
+		_Name, changedName := c.copyOnRewriteTableName(n.Name, n)
+		_Table, changedTable := c.copyOnRewriteTableName(n.Table, n)
+		_Order, changedOrder := c.copyOnRewriteRefOfTriggerOrder(n.Order, n)
+		_Body, changedBody := c.copyOnRewriteStatement(n.Body, n)
+		if changedComments || changedDefiner || changedName || changedTable || changedOrder || changedBody {
+			res := *n
+			res.Comments, _ = _Comments.(*ParsedComments)
+			res.Definer, _ = _Definer.(*Definer)
+			res.Name, _ = _Name.(TableName)
+			res.Table, _ = _Table.(TableName)
+			res.Order, _ = _Order.(*TriggerOrder)
+			res.Body, _ = _Body.(Statement)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfCreateUser(n *CreateUser, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		var changedUsers bool
+		_Users := make([]*UserSpec, len(n.Users))
+		for x, el := range n.Users {
+			this, changed := c.copyOnRewriteRefOfUserSpec(el, n)
+			_Users[x] = this.(*UserSpec)
+			if changed {
+				changedUsers = true
+			}
+		}
+		var changedDefaultRoles bool
+		_DefaultRoles := make([]*Account, len(n.DefaultRoles))
+		for x, el := range n.DefaultRoles {
+			this, changed := c.copyOnRewriteRefOfAccount(el, n)
+			_DefaultRoles[x] = this.(*Account)
+			if changed {
+				changedDefaultRoles = true
+			}
+		}
+		_PasswordExpire, changedPasswordExpire := c.copyOnRewriteRefOfPasswordExpire(n.PasswordExpire, n)
+		if changedUsers || changedDefaultRoles || changedPasswordExpire {
+			res := *n
+			res.Users = _Users
+			res.DefaultRoles = _DefaultRoles
+			res.PasswordExpire, _ = _PasswordExpire.(*PasswordExpire)
 			out = &res
 			if c.cloned != nil {
 				c.cloned(n, out)
@@ -1822,6 +2494,112 @@ func (c *cow) copyOnRewriteRefOfDeallocateStmt(n *DeallocateStmt, parent SQLNode
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfDeclareCondition(n *DeclareCondition, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Name, changedName := c.copyOnRewriteIdentifierCI(n.Name, n)
+		_Value, changedValue := c.copyOnRewriteRefOfConditionValue(n.Value, n)
+		if changedName || changedValue {
+			res := *n
+			res.Name, _ = _Name.(IdentifierCI)
+			res.Value, _ = _Value.(*ConditionValue)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfDeclareCursor(n *DeclareCursor, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Name, changedName := c.copyOnRewriteIdentifierCI(n.Name, n)
+		_Select, changedSelect := c.copyOnRewriteSelectStatement(n.Select, n)
+		if changedName || changedSelect {
+			res := *n
+			res.Name, _ = _Name.(IdentifierCI)
+			res.Select, _ = _Select.(SelectStatement)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfDeclareHandler(n *DeclareHandler, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		var changedConditions bool
+		_Conditions := make([]*ConditionValue, len(n.Conditions))
+		for x, el := range n.Conditions {
+			this, changed := c.copyOnRewriteRefOfConditionValue(el, n)
+			_Conditions[x] = this.(*ConditionValue)
+			if changed {
+				changedConditions = true
+			}
+		}
+		_Statement, changedStatement := c.copyOnRewriteStatement(n.Statement, n)
+		if changedConditions || changedStatement {
+			res := *n
+			res.Conditions = _Conditions
+			res.Statement, _ = _Statement.(Statement)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfDeclareVar(n *DeclareVar, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Names, changedNames := c.copyOnRewriteColumns(n.Names, n)
+		_Type, changedType := c.copyOnRewriteRefOfColumnType(n.Type, n)
+		_Default, changedDefault := c.copyOnRewriteExpr(n.Default, n)
+		if changedNames || changedType || changedDefault {
+			res := *n
+			res.Names, _ = _Names.(Columns)
+			res.Type, _ = _Type.(*ColumnType)
+			res.Default, _ = _Default.(Expr)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfDefault(n *Default, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -1860,7 +2638,8 @@ func (c *cow) copyOnRewriteRefOfDelete(n *Delete, parent SQLNode) (out SQLNode,
 		_Where, changedWhere := c.copyOnRewriteRefOfWhere(n.Where, n)
 		_OrderBy, changedOrderBy := c.copyOnRewriteOrderBy(n.OrderBy, n)
 		_Limit, changedLimit := c.copyOnRewriteRefOfLimit(n.Limit, n)
-		if changedWith || changedComments || changedTargets || changedTableExprs || changedPartitions || changedWhere || changedOrderBy || changedLimit {
+		_Returning, changedReturning := c.copyOnRewriteSelectExprs(n.Returning, n)
+		if changedWith || changedComments || changedTargets || changedTableExprs || changedPartitions || changedWhere || changedOrderBy || changedLimit || changedReturning {
 			res := *n
 			res.With, _ = _With.(*With)
 			res.Comments, _ = _Comments.(*ParsedComments)
@@ -1870,6 +2649,7 @@ func (c *cow) copyOnRewriteRefOfDelete(n *Delete, parent SQLNode) (out SQLNode,
 			res.Where, _ = _Where.(*Where)
 			res.OrderBy, _ = _OrderBy.(OrderBy)
 			res.Limit, _ = _Limit.(*Limit)
+			res.Returning, _ = _Returning.(SelectExprs)
 			out = &res
 			if c.cloned != nil {
 				c.cloned(n, out)
@@ -1950,6 +2730,54 @@ func (c *cow) copyOnRewriteRefOfDropDatabase(n *DropDatabase, parent SQLNode) (o
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfDropEvent(n *DropEvent, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		_Name, changedName := c.copyOnRewriteTableName(n.Name, n)
+		if changedComments || changedName {
+			res := *n
+			res.Comments, _ = _Comments.(*ParsedComments)
+			res.Name, _ = _Name.(TableName)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfDropFunction(n *DropFunction, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		_Name, changedName := c.copyOnRewriteTableName(n.Name, n)
+		if changedComments || changedName {
+			res := *n
+			res.Comments, _ = _Comments.(*ParsedComments)
+			res.Name, _ = _Name.(TableName)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfDropKey(n *DropKey, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -1972,6 +2800,60 @@ func (c *cow) copyOnRewriteRefOfDropKey(n *DropKey, parent SQLNode) (out SQLNode
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfDropProcedure(n *DropProcedure, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		_Name, changedName := c.copyOnRewriteTableName(n.Name, n)
+		if changedComments || changedName {
+			res := *n
+			res.Comments, _ = _Comments.(*ParsedComments)
+			res.Name, _ = _Name.(TableName)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfDropRole(n *DropRole, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		var changedRoles bool
+		_Roles := make([]*Account, len(n.Roles))
+		for x, el := range n.Roles {
+			this, changed := c.copyOnRewriteRefOfAccount(el, n)
+			_Roles[x] = this.(*Account)
+			if changed {
+				changedRoles = true
+			}
+		}
+		if changedRoles {
+			res := *n
+			res.Roles = _Roles
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfDropTable(n *DropTable, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -1996,18 +2878,124 @@ func (c *cow) copyOnRewriteRefOfDropTable(n *DropTable, parent SQLNode) (out SQL
 	}
 	return
 }
-func (c *cow) copyOnRewriteRefOfDropView(n *DropView, parent SQLNode) (out SQLNode, changed bool) {
+func (c *cow) copyOnRewriteRefOfDropTrigger(n *DropTrigger, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		_Name, changedName := c.copyOnRewriteTableName(n.Name, n)
+		if changedComments || changedName {
+			res := *n
+			res.Comments, _ = _Comments.(*ParsedComments)
+			res.Name, _ = _Name.(TableName)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfDropUser(n *DropUser, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		var changedUsers bool
+		_Users := make([]*Account, len(n.Users))
+		for x, el := range n.Users {
+			this, changed := c.copyOnRewriteRefOfAccount(el, n)
+			_Users[x] = this.(*Account)
+			if changed {
+				changedUsers = true
+			}
+		}
+		if changedUsers {
+			res := *n
+			res.Users = _Users
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfDropView(n *DropView, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_FromTables, changedFromTables := c.copyOnRewriteTableNames(n.FromTables, n)
+		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		if changedFromTables || changedComments {
+			res := *n
+			res.FromTables, _ = _FromTables.(TableNames)
+			res.Comments, _ = _Comments.(*ParsedComments)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfElseIfBlock(n *ElseIfBlock, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Cond, changedCond := c.copyOnRewriteExpr(n.Cond, n)
+		_Statements, changedStatements := c.copyOnRewriteStatementList(n.Statements, n)
+		if changedCond || changedStatements {
+			res := *n
+			res.Cond, _ = _Cond.(Expr)
+			res.Statements, _ = _Statements.(StatementList)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfEventSchedule(n *EventSchedule, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		_FromTables, changedFromTables := c.copyOnRewriteTableNames(n.FromTables, n)
-		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
-		if changedFromTables || changedComments {
+		_At, changedAt := c.copyOnRewriteExpr(n.At, n)
+		_Every, changedEvery := c.copyOnRewriteExpr(n.Every, n)
+		_Starts, changedStarts := c.copyOnRewriteExpr(n.Starts, n)
+		_Ends, changedEnds := c.copyOnRewriteExpr(n.Ends, n)
+		if changedAt || changedEvery || changedStarts || changedEnds {
 			res := *n
-			res.FromTables, _ = _FromTables.(TableNames)
-			res.Comments, _ = _Comments.(*ParsedComments)
+			res.At, _ = _At.(Expr)
+			res.Every, _ = _Every.(Expr)
+			res.Starts, _ = _Starts.(Expr)
+			res.Ends, _ = _Ends.(Expr)
 			out = &res
 			if c.cloned != nil {
 				c.cloned(n, out)
@@ -2219,6 +3207,30 @@ func (c *cow) copyOnRewriteRefOfExtractedSubquery(n *ExtractedSubquery, parent S
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfFetchCursor(n *FetchCursor, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Name, changedName := c.copyOnRewriteIdentifierCI(n.Name, n)
+		_Variables, changedVariables := c.copyOnRewriteColumns(n.Variables, n)
+		if changedName || changedVariables {
+			res := *n
+			res.Name, _ = _Name.(IdentifierCI)
+			res.Variables, _ = _Variables.(Columns)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfFirstOrLastValueExpr(n *FirstOrLastValueExpr, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -2665,6 +3677,82 @@ func (c *cow) copyOnRewriteRefOfGeomPropertyFuncExpr(n *GeomPropertyFuncExpr, pa
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfGrant(n *Grant, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		var changedPrivileges bool
+		_Privileges := make([]*GrantPrivilege, len(n.Privileges))
+		for x, el := range n.Privileges {
+			this, changed := c.copyOnRewriteRefOfGrantPrivilege(el, n)
+			_Privileges[x] = this.(*GrantPrivilege)
+			if changed {
+				changedPrivileges = true
+			}
+		}
+		_On, changedOn := c.copyOnRewriteRefOfPrivilegeLevel(n.On, n)
+		var changedRoles bool
+		_Roles := make([]*Account, len(n.Roles))
+		for x, el := range n.Roles {
+			this, changed := c.copyOnRewriteRefOfAccount(el, n)
+			_Roles[x] = this.(*Account)
+			if changed {
+				changedRoles = true
+			}
+		}
+		_Proxy, changedProxy := c.copyOnRewriteRefOfAccount(n.Proxy, n)
+		var changedTo bool
+		_To := make([]*Account, len(n.To))
+		for x, el := range n.To {
+			this, changed := c.copyOnRewriteRefOfAccount(el, n)
+			_To[x] = this.(*Account)
+			if changed {
+				changedTo = true
+			}
+		}
+		if changedPrivileges || changedOn || changedRoles || changedProxy || changedTo {
+			res := *n
+			res.Privileges = _Privileges
+			res.On, _ = _On.(*PrivilegeLevel)
+			res.Roles = _Roles
+			res.Proxy, _ = _Proxy.(*Account)
+			res.To = _To
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfGrantPrivilege(n *GrantPrivilege, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Columns, changedColumns := c.copyOnRewriteColumns(n.Columns, n)
+		if changedColumns {
+			res := *n
+			res.Columns, _ = _Columns.(Columns)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteGroupBy(n GroupBy, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -2732,6 +3820,42 @@ func (c *cow) copyOnRewriteIdentifierCS(n IdentifierCS, parent SQLNode) (out SQL
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfIfStatement(n *IfStatement, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Cond, changedCond := c.copyOnRewriteExpr(n.Cond, n)
+		_Statements, changedStatements := c.copyOnRewriteStatementList(n.Statements, n)
+		var changedElseIfs bool
+		_ElseIfs := make([]*ElseIfBlock, len(n.ElseIfs))
+		for x, el := range n.ElseIfs {
+			this, changed := c.copyOnRewriteRefOfElseIfBlock(el, n)
+			_ElseIfs[x] = this.(*ElseIfBlock)
+			if changed {
+				changedElseIfs = true
+			}
+		}
+		_Else, changedElse := c.copyOnRewriteStatementList(n.Else, n)
+		if changedCond || changedStatements || changedElseIfs || changedElse {
+			res := *n
+			res.Cond, _ = _Cond.(Expr)
+			res.Statements, _ = _Statements.(StatementList)
+			res.ElseIfs = _ElseIfs
+			res.Else, _ = _Else.(StatementList)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfIndexDefinition(n *IndexDefinition, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -2843,7 +3967,9 @@ func (c *cow) copyOnRewriteRefOfInsert(n *Insert, parent SQLNode) (out SQLNode,
 		_Columns, changedColumns := c.copyOnRewriteColumns(n.Columns, n)
 		_Rows, changedRows := c.copyOnRewriteInsertRows(n.Rows, n)
 		_OnDup, changedOnDup := c.copyOnRewriteOnDup(n.OnDup, n)
-		if changedComments || changedTable || changedPartitions || changedColumns || changedRows || changedOnDup {
+		_OnConflict, changedOnConflict := c.copyOnRewriteRefOfOnConflict(n.OnConflict, n)
+		_Returning, changedReturning := c.copyOnRewriteSelectExprs(n.Returning, n)
+		if changedComments || changedTable || changedPartitions || changedColumns || changedRows || changedOnDup || changedOnConflict || changedReturning {
 			res := *n
 			res.Comments, _ = _Comments.(*ParsedComments)
 			res.Table, _ = _Table.(*AliasedTableExpr)
@@ -2851,6 +3977,8 @@ func (c *cow) copyOnRewriteRefOfInsert(n *Insert, parent SQLNode) (out SQLNode,
 			res.Columns, _ = _Columns.(Columns)
 			res.Rows, _ = _Rows.(InsertRows)
 			res.OnDup, _ = _OnDup.(OnDup)
+			res.OnConflict, _ = _OnConflict.(*OnConflict)
+			res.Returning, _ = _Returning.(SelectExprs)
 			out = &res
 			if c.cloned != nil {
 				c.cloned(n, out)
@@ -2959,6 +4087,28 @@ func (c *cow) copyOnRewriteRefOfIsExpr(n *IsExpr, parent SQLNode) (out SQLNode,
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfIterateStatement(n *IterateStatement, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Label, changedLabel := c.copyOnRewriteIdentifierCI(n.Label, n)
+		if changedLabel {
+			res := *n
+			res.Label, _ = _Label.(IdentifierCI)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfJSONArrayExpr(n *JSONArrayExpr, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -3675,6 +4825,28 @@ func (c *cow) copyOnRewriteRefOfLagLeadExpr(n *LagLeadExpr, parent SQLNode) (out
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfLeaveStatement(n *LeaveStatement, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Label, changedLabel := c.copyOnRewriteIdentifierCI(n.Label, n)
+		if changedLabel {
+			res := *n
+			res.Label, _ = _Label.(IdentifierCI)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfLimit(n *Limit, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -3763,6 +4935,78 @@ func (c *cow) copyOnRewriteRefOfLoad(n *Load, parent SQLNode) (out SQLNode, chan
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
+		_Table, changedTable := c.copyOnRewriteTableName(n.Table, n)
+		_Partitions, changedPartitions := c.copyOnRewritePartitions(n.Partitions, n)
+		_Fields, changedFields := c.copyOnRewriteRefOfLoadFields(n.Fields, n)
+		_Lines, changedLines := c.copyOnRewriteRefOfLoadLines(n.Lines, n)
+		_IgnoreLines, changedIgnoreLines := c.copyOnRewriteRefOfLiteral(n.IgnoreLines, n)
+		_Columns, changedColumns := c.copyOnRewriteExprs(n.Columns, n)
+		_SetExprs, changedSetExprs := c.copyOnRewriteUpdateExprs(n.SetExprs, n)
+		if changedTable || changedPartitions || changedFields || changedLines || changedIgnoreLines || changedColumns || changedSetExprs {
+			res := *n
+			res.Table, _ = _Table.(TableName)
+			res.Partitions, _ = _Partitions.(Partitions)
+			res.Fields, _ = _Fields.(*LoadFields)
+			res.Lines, _ = _Lines.(*LoadLines)
+			res.IgnoreLines, _ = _IgnoreLines.(*Literal)
+			res.Columns, _ = _Columns.(Exprs)
+			res.SetExprs, _ = _SetExprs.(UpdateExprs)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfLoadFields(n *LoadFields, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_TerminatedBy, changedTerminatedBy := c.copyOnRewriteRefOfLiteral(n.TerminatedBy, n)
+		_EnclosedBy, changedEnclosedBy := c.copyOnRewriteRefOfLiteral(n.EnclosedBy, n)
+		_EscapedBy, changedEscapedBy := c.copyOnRewriteRefOfLiteral(n.EscapedBy, n)
+		if changedTerminatedBy || changedEnclosedBy || changedEscapedBy {
+			res := *n
+			res.TerminatedBy, _ = _TerminatedBy.(*Literal)
+			res.EnclosedBy, _ = _EnclosedBy.(*Literal)
+			res.EscapedBy, _ = _EscapedBy.(*Literal)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfLoadLines(n *LoadLines, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_StartingBy, changedStartingBy := c.copyOnRewriteRefOfLiteral(n.StartingBy, n)
+		_TerminatedBy, changedTerminatedBy := c.copyOnRewriteRefOfLiteral(n.TerminatedBy, n)
+		if changedStartingBy || changedTerminatedBy {
+			res := *n
+			res.StartingBy, _ = _StartingBy.(*Literal)
+			res.TerminatedBy, _ = _TerminatedBy.(*Literal)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
 	}
 	if c.post != nil {
 		out, changed = c.postVisit(out, parent, changed)
@@ -3843,6 +5087,30 @@ func (c *cow) copyOnRewriteRefOfLockingFunc(n *LockingFunc, parent SQLNode) (out
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfLoopStatement(n *LoopStatement, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Label, changedLabel := c.copyOnRewriteIdentifierCI(n.Label, n)
+		_Statements, changedStatements := c.copyOnRewriteStatementList(n.Statements, n)
+		if changedLabel || changedStatements {
+			res := *n
+			res.Label, _ = _Label.(IdentifierCI)
+			res.Statements, _ = _Statements.(StatementList)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfMatchExpr(n *MatchExpr, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -4222,6 +5490,34 @@ func (c *cow) copyOnRewriteRefOfOffset(n *Offset, parent SQLNode) (out SQLNode,
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfOnConflict(n *OnConflict, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Columns, changedColumns := c.copyOnRewriteColumns(n.Columns, n)
+		_Constraint, changedConstraint := c.copyOnRewriteIdentifierCI(n.Constraint, n)
+		_Exprs, changedExprs := c.copyOnRewriteUpdateExprs(n.Exprs, n)
+		_Where, changedWhere := c.copyOnRewriteRefOfWhere(n.Where, n)
+		if changedColumns || changedConstraint || changedExprs || changedWhere {
+			res := *n
+			res.Columns, _ = _Columns.(Columns)
+			res.Constraint, _ = _Constraint.(IdentifierCI)
+			res.Exprs, _ = _Exprs.(UpdateExprs)
+			res.Where, _ = _Where.(*Where)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteOnDup(n OnDup, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -4245,6 +5541,28 @@ func (c *cow) copyOnRewriteOnDup(n OnDup, parent SQLNode) (out SQLNode, changed
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfOpenCursor(n *OpenCursor, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Name, changedName := c.copyOnRewriteIdentifierCI(n.Name, n)
+		if changedName {
+			res := *n
+			res.Name, _ = _Name.(IdentifierCI)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfOptLike(n *OptLike, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -4615,9 +5933,31 @@ func (c *cow) copyOnRewritePartitions(n Partitions, parent SQLNode) (out SQLNode
 			if change {
 				changed = true
 			}
-		}
-		if changed {
-			out = res
+		}
+		if changed {
+			out = res
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfPasswordExpire(n *PasswordExpire, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Days, changedDays := c.copyOnRewriteRefOfLiteral(n.Days, n)
+		if changedDays {
+			res := *n
+			res.Days, _ = _Days.(*Literal)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
 		}
 	}
 	if c.post != nil {
@@ -4741,6 +6081,18 @@ func (c *cow) copyOnRewriteRefOfPolygonPropertyFuncExpr(n *PolygonPropertyFuncEx
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfPositionalArg(n *PositionalArg, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfPrepareStmt(n *PrepareStmt, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -4767,6 +6119,54 @@ func (c *cow) copyOnRewriteRefOfPrepareStmt(n *PrepareStmt, parent SQLNode) (out
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfPrivilegeLevel(n *PrivilegeLevel, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_DB, changedDB := c.copyOnRewriteIdentifierCS(n.DB, n)
+		_Name, changedName := c.copyOnRewriteIdentifierCS(n.Name, n)
+		if changedDB || changedName {
+			res := *n
+			res.DB, _ = _DB.(IdentifierCS)
+			res.Name, _ = _Name.(IdentifierCS)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfProcParameter(n *ProcParameter, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Name, changedName := c.copyOnRewriteIdentifierCI(n.Name, n)
+		_Type, changedType := c.copyOnRewriteRefOfColumnType(n.Type, n)
+		if changedName || changedType {
+			res := *n
+			res.Name, _ = _Name.(IdentifierCI)
+			res.Type, _ = _Type.(*ColumnType)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfPurgeBinaryLogs(n *PurgeBinaryLogs, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -4999,7 +6399,250 @@ func (c *cow) copyOnRewriteRefOfRenameIndex(n *RenameIndex, parent SQLNode) (out
 	}
 	return
 }
-func (c *cow) copyOnRewriteRefOfRenameTable(n *RenameTable, parent SQLNode) (out SQLNode, changed bool) {
+func (c *cow) copyOnRewriteRefOfRenameTable(n *RenameTable, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfRenameTableName(n *RenameTableName, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Table, changedTable := c.copyOnRewriteTableName(n.Table, n)
+		if changedTable {
+			res := *n
+			res.Table, _ = _Table.(TableName)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfRenameUser(n *RenameUser, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		var changedUserPairs bool
+		_UserPairs := make([]*RenameUserPair, len(n.UserPairs))
+		for x, el := range n.UserPairs {
+			this, changed := c.copyOnRewriteRefOfRenameUserPair(el, n)
+			_UserPairs[x] = this.(*RenameUserPair)
+			if changed {
+				changedUserPairs = true
+			}
+		}
+		if changedUserPairs {
+			res := *n
+			res.UserPairs = _UserPairs
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfRenameUserPair(n *RenameUserPair, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_FromUser, changedFromUser := c.copyOnRewriteRefOfAccount(n.FromUser, n)
+		_ToUser, changedToUser := c.copyOnRewriteRefOfAccount(n.ToUser, n)
+		if changedFromUser || changedToUser {
+			res := *n
+			res.FromUser, _ = _FromUser.(*Account)
+			res.ToUser, _ = _ToUser.(*Account)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfRepeatStatement(n *RepeatStatement, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Label, changedLabel := c.copyOnRewriteIdentifierCI(n.Label, n)
+		_Statements, changedStatements := c.copyOnRewriteStatementList(n.Statements, n)
+		_Until, changedUntil := c.copyOnRewriteExpr(n.Until, n)
+		if changedLabel || changedStatements || changedUntil {
+			res := *n
+			res.Label, _ = _Label.(IdentifierCI)
+			res.Statements, _ = _Statements.(StatementList)
+			res.Until, _ = _Until.(Expr)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfReturnStatement(n *ReturnStatement, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Expr, changedExpr := c.copyOnRewriteExpr(n.Expr, n)
+		if changedExpr {
+			res := *n
+			res.Expr, _ = _Expr.(Expr)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfRevertMigration(n *RevertMigration, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		if changedComments {
+			res := *n
+			res.Comments, _ = _Comments.(*ParsedComments)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfRevoke(n *Revoke, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		var changedPrivileges bool
+		_Privileges := make([]*GrantPrivilege, len(n.Privileges))
+		for x, el := range n.Privileges {
+			this, changed := c.copyOnRewriteRefOfGrantPrivilege(el, n)
+			_Privileges[x] = this.(*GrantPrivilege)
+			if changed {
+				changedPrivileges = true
+			}
+		}
+		_On, changedOn := c.copyOnRewriteRefOfPrivilegeLevel(n.On, n)
+		var changedRoles bool
+		_Roles := make([]*Account, len(n.Roles))
+		for x, el := range n.Roles {
+			this, changed := c.copyOnRewriteRefOfAccount(el, n)
+			_Roles[x] = this.(*Account)
+			if changed {
+				changedRoles = true
+			}
+		}
+		_Proxy, changedProxy := c.copyOnRewriteRefOfAccount(n.Proxy, n)
+		var changedFrom bool
+		_From := make([]*Account, len(n.From))
+		for x, el := range n.From {
+			this, changed := c.copyOnRewriteRefOfAccount(el, n)
+			_From[x] = this.(*Account)
+			if changed {
+				changedFrom = true
+			}
+		}
+		if changedPrivileges || changedOn || changedRoles || changedProxy || changedFrom {
+			res := *n
+			res.Privileges = _Privileges
+			res.On, _ = _On.(*PrivilegeLevel)
+			res.Roles = _Roles
+			res.Proxy, _ = _Proxy.(*Account)
+			res.From = _From
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfRollback(n *Rollback, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRootNode(n RootNode, parent SQLNode) (out SQLNode, changed bool) {
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_SQLNode, changedSQLNode := c.copyOnRewriteSQLNode(n.SQLNode, n)
+		if changedSQLNode {
+			res := n
+			res.SQLNode, _ = _SQLNode.(SQLNode)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfRoutineCharacteristic(n *RoutineCharacteristic, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
 	}
@@ -5011,16 +6654,18 @@ func (c *cow) copyOnRewriteRefOfRenameTable(n *RenameTable, parent SQLNode) (out
 	}
 	return
 }
-func (c *cow) copyOnRewriteRefOfRenameTableName(n *RenameTableName, parent SQLNode) (out SQLNode, changed bool) {
+func (c *cow) copyOnRewriteRefOfRoutineSet(n *RoutineSet, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		_Table, changedTable := c.copyOnRewriteTableName(n.Table, n)
-		if changedTable {
+		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
+		_Exprs, changedExprs := c.copyOnRewriteRoutineSetExprs(n.Exprs, n)
+		if changedComments || changedExprs {
 			res := *n
-			res.Table, _ = _Table.(TableName)
+			res.Comments, _ = _Comments.(*ParsedComments)
+			res.Exprs, _ = _Exprs.(RoutineSetExprs)
 			out = &res
 			if c.cloned != nil {
 				c.cloned(n, out)
@@ -5033,16 +6678,18 @@ func (c *cow) copyOnRewriteRefOfRenameTableName(n *RenameTableName, parent SQLNo
 	}
 	return
 }
-func (c *cow) copyOnRewriteRefOfRevertMigration(n *RevertMigration, parent SQLNode) (out SQLNode, changed bool) {
+func (c *cow) copyOnRewriteRefOfRoutineSetExpr(n *RoutineSetExpr, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-		_Comments, changedComments := c.copyOnRewriteRefOfParsedComments(n.Comments, n)
-		if changedComments {
+		_Var, changedVar := c.copyOnRewriteExpr(n.Var, n)
+		_Expr, changedExpr := c.copyOnRewriteExpr(n.Expr, n)
+		if changedVar || changedExpr {
 			res := *n
-			res.Comments, _ = _Comments.(*ParsedComments)
+			res.Var, _ = _Var.(Expr)
+			res.Expr, _ = _Expr.(Expr)
 			out = &res
 			if c.cloned != nil {
 				c.cloned(n, out)
@@ -5055,30 +6702,22 @@ func (c *cow) copyOnRewriteRefOfRevertMigration(n *RevertMigration, parent SQLNo
 	}
 	return
 }
-func (c *cow) copyOnRewriteRefOfRollback(n *Rollback, parent SQLNode) (out SQLNode, changed bool) {
+func (c *cow) copyOnRewriteRoutineSetExprs(n RoutineSetExprs, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
-	}
-	if c.post != nil {
-		out, changed = c.postVisit(out, parent, changed)
-	}
-	return
-}
-func (c *cow) copyOnRewriteRootNode(n RootNode, parent SQLNode) (out SQLNode, changed bool) {
-	out = n
-	if c.pre == nil || c.pre(n, parent) {
-		_SQLNode, changedSQLNode := c.copyOnRewriteSQLNode(n.SQLNode, n)
-		if changedSQLNode {
-			res := n
-			res.SQLNode, _ = _SQLNode.(SQLNode)
-			out = &res
-			if c.cloned != nil {
-				c.cloned(n, out)
+		res := make(RoutineSetExprs, len(n))
+		for x, el := range n {
+			this, change := c.copyOnRewriteRefOfRoutineSetExpr(el, n)
+			res[x] = this.(*RoutineSetExpr)
+			if change {
+				changed = true
 			}
-			changed = true
+		}
+		if changed {
+			out = res
 		}
 	}
 	if c.post != nil {
@@ -5209,6 +6848,16 @@ func (c *cow) copyOnRewriteRefOfSelectInto(n *SelectInto, parent SQLNode) (out S
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
+		_Variables, changedVariables := c.copyOnRewriteExprs(n.Variables, n)
+		if changedVariables {
+			res := *n
+			res.Variables, _ = _Variables.(Exprs)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
 	}
 	if c.post != nil {
 		out, changed = c.postVisit(out, parent, changed)
@@ -5239,6 +6888,46 @@ func (c *cow) copyOnRewriteRefOfSet(n *Set, parent SQLNode) (out SQLNode, change
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfSetDefaultRole(n *SetDefaultRole, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		var changedRoles bool
+		_Roles := make([]*Account, len(n.Roles))
+		for x, el := range n.Roles {
+			this, changed := c.copyOnRewriteRefOfAccount(el, n)
+			_Roles[x] = this.(*Account)
+			if changed {
+				changedRoles = true
+			}
+		}
+		var changedTo bool
+		_To := make([]*Account, len(n.To))
+		for x, el := range n.To {
+			this, changed := c.copyOnRewriteRefOfAccount(el, n)
+			_To[x] = this.(*Account)
+			if changed {
+				changedTo = true
+			}
+		}
+		if changedRoles || changedTo {
+			res := *n
+			res.Roles = _Roles
+			res.To = _To
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfSetExpr(n *SetExpr, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -5286,6 +6975,60 @@ func (c *cow) copyOnRewriteSetExprs(n SetExprs, parent SQLNode) (out SQLNode, ch
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfSetPassword(n *SetPassword, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_For, changedFor := c.copyOnRewriteRefOfAccount(n.For, n)
+		_Password, changedPassword := c.copyOnRewriteRefOfLiteral(n.Password, n)
+		if changedFor || changedPassword {
+			res := *n
+			res.For, _ = _For.(*Account)
+			res.Password, _ = _Password.(*Literal)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfSetRole(n *SetRole, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		var changedRoles bool
+		_Roles := make([]*Account, len(n.Roles))
+		for x, el := range n.Roles {
+			this, changed := c.copyOnRewriteRefOfAccount(el, n)
+			_Roles[x] = this.(*Account)
+			if changed {
+				changedRoles = true
+			}
+		}
+		if changedRoles {
+			res := *n
+			res.Roles = _Roles
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfShow(n *Show, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -5436,6 +7179,60 @@ func (c *cow) copyOnRewriteRefOfShowThrottlerStatus(n *ShowThrottlerStatus, pare
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfSignal(n *Signal, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Condition, changedCondition := c.copyOnRewriteRefOfConditionValue(n.Condition, n)
+		var changedItems bool
+		_Items := make([]*SignalItem, len(n.Items))
+		for x, el := range n.Items {
+			this, changed := c.copyOnRewriteRefOfSignalItem(el, n)
+			_Items[x] = this.(*SignalItem)
+			if changed {
+				changedItems = true
+			}
+		}
+		if changedCondition || changedItems {
+			res := *n
+			res.Condition, _ = _Condition.(*ConditionValue)
+			res.Items = _Items
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
+func (c *cow) copyOnRewriteRefOfSignalItem(n *SignalItem, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Value, changedValue := c.copyOnRewriteExpr(n.Value, n)
+		if changedValue {
+			res := *n
+			res.Value, _ = _Value.(Expr)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfStarExpr(n *StarExpr, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -5458,6 +7255,29 @@ func (c *cow) copyOnRewriteRefOfStarExpr(n *StarExpr, parent SQLNode) (out SQLNo
 	}
 	return
 }
+func (c *cow) copyOnRewriteStatementList(n StatementList, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		res := make(StatementList, len(n))
+		for x, el := range n {
+			this, change := c.copyOnRewriteStatement(el, n)
+			res[x] = this.(Statement)
+			if change {
+				changed = true
+			}
+		}
+		if changed {
+			out = res
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfStd(n *Std, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -5911,6 +7731,28 @@ func (c *cow) copyOnRewriteRefOfTimestampFuncExpr(n *TimestampFuncExpr, parent S
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfTriggerOrder(n *TriggerOrder, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Name, changedName := c.copyOnRewriteIdentifierCI(n.Name, n)
+		if changedName {
+			res := *n
+			res.Name, _ = _Name.(IdentifierCI)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfTrimFuncExpr(n *TrimFuncExpr, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -5957,6 +7799,30 @@ func (c *cow) copyOnRewriteRefOfTruncateTable(n *TruncateTable, parent SQLNode)
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfTypeCastExpr(n *TypeCastExpr, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Expr, changedExpr := c.copyOnRewriteExpr(n.Expr, n)
+		_Type, changedType := c.copyOnRewriteRefOfConvertType(n.Type, n)
+		if changedExpr || changedType {
+			res := *n
+			res.Expr, _ = _Expr.(Expr)
+			res.Type, _ = _Type.(*ConvertType)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfUnaryExpr(n *UnaryExpr, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -6036,7 +7902,8 @@ func (c *cow) copyOnRewriteRefOfUpdate(n *Update, parent SQLNode) (out SQLNode,
 		_Where, changedWhere := c.copyOnRewriteRefOfWhere(n.Where, n)
 		_OrderBy, changedOrderBy := c.copyOnRewriteOrderBy(n.OrderBy, n)
 		_Limit, changedLimit := c.copyOnRewriteRefOfLimit(n.Limit, n)
-		if changedWith || changedComments || changedTableExprs || changedExprs || changedWhere || changedOrderBy || changedLimit {
+		_Returning, changedReturning := c.copyOnRewriteSelectExprs(n.Returning, n)
+		if changedWith || changedComments || changedTableExprs || changedExprs || changedWhere || changedOrderBy || changedLimit || changedReturning {
 			res := *n
 			res.With, _ = _With.(*With)
 			res.Comments, _ = _Comments.(*ParsedComments)
@@ -6045,6 +7912,7 @@ func (c *cow) copyOnRewriteRefOfUpdate(n *Update, parent SQLNode) (out SQLNode,
 			res.Where, _ = _Where.(*Where)
 			res.OrderBy, _ = _OrderBy.(OrderBy)
 			res.Limit, _ = _Limit.(*Limit)
+			res.Returning, _ = _Returning.(SelectExprs)
 			out = &res
 			if c.cloned != nil {
 				c.cloned(n, out)
@@ -6152,6 +8020,30 @@ func (c *cow) copyOnRewriteRefOfUse(n *Use, parent SQLNode) (out SQLNode, change
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfUserSpec(n *UserSpec, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Account, changedAccount := c.copyOnRewriteRefOfAccount(n.Account, n)
+		_Auth, changedAuth := c.copyOnRewriteRefOfAuthentication(n.Auth, n)
+		if changedAccount || changedAuth {
+			res := *n
+			res.Account, _ = _Account.(*Account)
+			res.Auth, _ = _Auth.(*Authentication)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfVExplainStmt(n *VExplainStmt, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -6497,6 +8389,32 @@ func (c *cow) copyOnRewriteRefOfWhere(n *Where, parent SQLNode) (out SQLNode, ch
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfWhileStatement(n *WhileStatement, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Label, changedLabel := c.copyOnRewriteIdentifierCI(n.Label, n)
+		_Cond, changedCond := c.copyOnRewriteExpr(n.Cond, n)
+		_Statements, changedStatements := c.copyOnRewriteStatementList(n.Statements, n)
+		if changedLabel || changedCond || changedStatements {
+			res := *n
+			res.Label, _ = _Label.(IdentifierCI)
+			res.Cond, _ = _Cond.(Expr)
+			res.Statements, _ = _Statements.(StatementList)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfWindowDefinition(n *WindowDefinition, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -6941,16 +8859,38 @@ func (c *cow) copyOnRewriteDDLStatement(n DDLStatement, parent SQLNode) (out SQL
 		return n, false
 	}
 	switch n := n.(type) {
+	case *AlterEvent:
+		return c.copyOnRewriteRefOfAlterEvent(n, parent)
+	case *AlterFunction:
+		return c.copyOnRewriteRefOfAlterFunction(n, parent)
+	case *AlterProcedure:
+		return c.copyOnRewriteRefOfAlterProcedure(n, parent)
 	case *AlterTable:
 		return c.copyOnRewriteRefOfAlterTable(n, parent)
 	case *AlterView:
 		return c.copyOnRewriteRefOfAlterView(n, parent)
+	case *CreateEvent:
+		return c.copyOnRewriteRefOfCreateEvent(n, parent)
+	case *CreateFunction:
+		return c.copyOnRewriteRefOfCreateFunction(n, parent)
+	case *CreateProcedure:
+		return c.copyOnRewriteRefOfCreateProcedure(n, parent)
 	case *CreateTable:
 		return c.copyOnRewriteRefOfCreateTable(n, parent)
+	case *CreateTrigger:
+		return c.copyOnRewriteRefOfCreateTrigger(n, parent)
 	case *CreateView:
 		return c.copyOnRewriteRefOfCreateView(n, parent)
+	case *DropEvent:
+		return c.copyOnRewriteRefOfDropEvent(n, parent)
+	case *DropFunction:
+		return c.copyOnRewriteRefOfDropFunction(n, parent)
+	case *DropProcedure:
+		return c.copyOnRewriteRefOfDropProcedure(n, parent)
 	case *DropTable:
 		return c.copyOnRewriteRefOfDropTable(n, parent)
+	case *DropTrigger:
+		return c.copyOnRewriteRefOfDropTrigger(n, parent)
 	case *DropView:
 		return c.copyOnRewriteRefOfDropView(n, parent)
 	case *RenameTable:
@@ -7167,6 +9107,8 @@ func (c *cow) copyOnRewriteExpr(n Expr, parent SQLNode) (out SQLNode, changed bo
 		return c.copyOnRewriteRefOfPolygonExpr(n, parent)
 	case *PolygonPropertyFuncExpr:
 		return c.copyOnRewriteRefOfPolygonPropertyFuncExpr(n, parent)
+	case *PositionalArg:
+		return c.copyOnRewriteRefOfPositionalArg(n, parent)
 	case *RegexpInstrExpr:
 		return c.copyOnRewriteRefOfRegexpInstrExpr(n, parent)
 	case *RegexpLikeExpr:
@@ -7193,6 +9135,8 @@ func (c *cow) copyOnRewriteExpr(n Expr, parent SQLNode) (out SQLNode, changed bo
 		return c.copyOnRewriteRefOfTimestampFuncExpr(n, parent)
 	case *TrimFuncExpr:
 		return c.copyOnRewriteRefOfTrimFuncExpr(n, parent)
+	case *TypeCastExpr:
+		return c.copyOnRewriteRefOfTypeCastExpr(n, parent)
 	case *UnaryExpr:
 		return c.copyOnRewriteRefOfUnaryExpr(n, parent)
 	case *UpdateXMLExpr:
@@ -7301,36 +9245,84 @@ func (c *cow) copyOnRewriteStatement(n Statement, parent SQLNode) (out SQLNode,
 	switch n := n.(type) {
 	case *AlterDatabase:
 		return c.copyOnRewriteRefOfAlterDatabase(n, parent)
+	case *AlterEvent:
+		return c.copyOnRewriteRefOfAlterEvent(n, parent)
+	case *AlterFunction:
+		return c.copyOnRewriteRefOfAlterFunction(n, parent)
 	case *AlterMigration:
 		return c.copyOnRewriteRefOfAlterMigration(n, parent)
+	case *AlterProcedure:
+		return c.copyOnRewriteRefOfAlterProcedure(n, parent)
 	case *AlterTable:
 		return c.copyOnRewriteRefOfAlterTable(n, parent)
+	case *AlterUser:
+		return c.copyOnRewriteRefOfAlterUser(n, parent)
 	case *AlterView:
 		return c.copyOnRewriteRefOfAlterView(n, parent)
 	case *AlterVschema:
 		return c.copyOnRewriteRefOfAlterVschema(n, parent)
+	case *BadStatement:
+		return c.copyOnRewriteRefOfBadStatement(n, parent)
 	case *Begin:
 		return c.copyOnRewriteRefOfBegin(n, parent)
+	case *BeginEndBlock:
+		return c.copyOnRewriteRefOfBeginEndBlock(n, parent)
 	case *CallProc:
 		return c.copyOnRewriteRefOfCallProc(n, parent)
+	case *CaseStatement:
+		return c.copyOnRewriteRefOfCaseStatement(n, parent)
+	case *CloseCursor:
+		return c.copyOnRewriteRefOfCloseCursor(n, parent)
 	case *CommentOnly:
 		return c.copyOnRewriteRefOfCommentOnly(n, parent)
 	case *Commit:
 		return c.copyOnRewriteRefOfCommit(n, parent)
 	case *CreateDatabase:
 		return c.copyOnRewriteRefOfCreateDatabase(n, parent)
+	case *CreateEvent:
+		return c.copyOnRewriteRefOfCreateEvent(n, parent)
+	case *CreateFunction:
+		return c.copyOnRewriteRefOfCreateFunction(n, parent)
+	case *CreateProcedure:
+		return c.copyOnRewriteRefOfCreateProcedure(n, parent)
+	case *CreateRole:
+		return c.copyOnRewriteRefOfCreateRole(n, parent)
 	case *CreateTable:
 		return c.copyOnRewriteRefOfCreateTable(n, parent)
+	case *CreateTrigger:
+		return c.copyOnRewriteRefOfCreateTrigger(n, parent)
+	case *CreateUser:
+		return c.copyOnRewriteRefOfCreateUser(n, parent)
 	case *CreateView:
 		return c.copyOnRewriteRefOfCreateView(n, parent)
 	case *DeallocateStmt:
 		return c.copyOnRewriteRefOfDeallocateStmt(n, parent)
+	case *DeclareCondition:
+		return c.copyOnRewriteRefOfDeclareCondition(n, parent)
+	case *DeclareCursor:
+		return c.copyOnRewriteRefOfDeclareCursor(n, parent)
+	case *DeclareHandler:
+		return c.copyOnRewriteRefOfDeclareHandler(n, parent)
+	case *DeclareVar:
+		return c.copyOnRewriteRefOfDeclareVar(n, parent)
 	case *Delete:
 		return c.copyOnRewriteRefOfDelete(n, parent)
 	case *DropDatabase:
 		return c.copyOnRewriteRefOfDropDatabase(n, parent)
+	case *DropEvent:
+		return c.copyOnRewriteRefOfDropEvent(n, parent)
+	case *DropFunction:
+		return c.copyOnRewriteRefOfDropFunction(n, parent)
+	case *DropProcedure:
+		return c.copyOnRewriteRefOfDropProcedure(n, parent)
+	case *DropRole:
+		return c.copyOnRewriteRefOfDropRole(n, parent)
 	case *DropTable:
 		return c.copyOnRewriteRefOfDropTable(n, parent)
+	case *DropTrigger:
+		return c.copyOnRewriteRefOfDropTrigger(n, parent)
+	case *DropUser:
+		return c.copyOnRewriteRefOfDropUser(n, parent)
 	case *DropView:
 		return c.copyOnRewriteRefOfDropView(n, parent)
 	case *ExecuteStmt:
@@ -7339,14 +9331,28 @@ func (c *cow) copyOnRewriteStatement(n Statement, parent SQLNode) (out SQLNode,
 		return c.copyOnRewriteRefOfExplainStmt(n, parent)
 	case *ExplainTab:
 		return c.copyOnRewriteRefOfExplainTab(n, parent)
+	case *FetchCursor:
+		return c.copyOnRewriteRefOfFetchCursor(n, parent)
 	case *Flush:
 		return c.copyOnRewriteRefOfFlush(n, parent)
+	case *Grant:
+		return c.copyOnRewriteRefOfGrant(n, parent)
+	case *IfStatement:
+		return c.copyOnRewriteRefOfIfStatement(n, parent)
 	case *Insert:
 		return c.copyOnRewriteRefOfInsert(n, parent)
+	case *IterateStatement:
+		return c.copyOnRewriteRefOfIterateStatement(n, parent)
+	case *LeaveStatement:
+		return c.copyOnRewriteRefOfLeaveStatement(n, parent)
 	case *Load:
 		return c.copyOnRewriteRefOfLoad(n, parent)
 	case *LockTables:
 		return c.copyOnRewriteRefOfLockTables(n, parent)
+	case *LoopStatement:
+		return c.copyOnRewriteRefOfLoopStatement(n, parent)
+	case *OpenCursor:
+		return c.copyOnRewriteRefOfOpenCursor(n, parent)
 	case *OtherAdmin:
 		return c.copyOnRewriteRefOfOtherAdmin(n, parent)
 	case *OtherRead:
@@ -7359,10 +9365,20 @@ func (c *cow) copyOnRewriteStatement(n Statement, parent SQLNode) (out SQLNode,
 		return c.copyOnRewriteRefOfRelease(n, parent)
 	case *RenameTable:
 		return c.copyOnRewriteRefOfRenameTable(n, parent)
+	case *RenameUser:
+		return c.copyOnRewriteRefOfRenameUser(n, parent)
+	case *RepeatStatement:
+		return c.copyOnRewriteRefOfRepeatStatement(n, parent)
+	case *ReturnStatement:
+		return c.copyOnRewriteRefOfReturnStatement(n, parent)
 	case *RevertMigration:
 		return c.copyOnRewriteRefOfRevertMigration(n, parent)
+	case *Revoke:
+		return c.copyOnRewriteRefOfRevoke(n, parent)
 	case *Rollback:
 		return c.copyOnRewriteRefOfRollback(n, parent)
+	case *RoutineSet:
+		return c.copyOnRewriteRefOfRoutineSet(n, parent)
 	case *SRollback:
 		return c.copyOnRewriteRefOfSRollback(n, parent)
 	case *Savepoint:
@@ -7371,6 +9387,12 @@ func (c *cow) copyOnRewriteStatement(n Statement, parent SQLNode) (out SQLNode,
 		return c.copyOnRewriteRefOfSelect(n, parent)
 	case *Set:
 		return c.copyOnRewriteRefOfSet(n, parent)
+	case *SetDefaultRole:
+		return c.copyOnRewriteRefOfSetDefaultRole(n, parent)
+	case *SetPassword:
+		return c.copyOnRewriteRefOfSetPassword(n, parent)
+	case *SetRole:
+		return c.copyOnRewriteRefOfSetRole(n, parent)
 	case *Show:
 		return c.copyOnRewriteRefOfShow(n, parent)
 	case *ShowMigrationLogs:
@@ -7379,6 +9401,8 @@ func (c *cow) copyOnRewriteStatement(n Statement, parent SQLNode) (out SQLNode,
 		return c.copyOnRewriteRefOfShowThrottledApps(n, parent)
 	case *ShowThrottlerStatus:
 		return c.copyOnRewriteRefOfShowThrottlerStatus(n, parent)
+	case *Signal:
+		return c.copyOnRewriteRefOfSignal(n, parent)
 	case *Stream:
 		return c.copyOnRewriteRefOfStream(n, parent)
 	case *TruncateTable:
@@ -7395,6 +9419,8 @@ func (c *cow) copyOnRewriteStatement(n Statement, parent SQLNode) (out SQLNode,
 		return c.copyOnRewriteRefOfVExplainStmt(n, parent)
 	case *VStream:
 		return c.copyOnRewriteRefOfVStream(n, parent)
+	case *WhileStatement:
+		return c.copyOnRewriteRefOfWhileStatement(n, parent)
 	default:
 		// this should never happen
 		return nil, false
@@ -26,6 +26,12 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 		return false
 	}
 	switch a := inA.(type) {
+	case *Account:
+		b, ok := inB.(*Account)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfAccount(a, b)
 	case *AddColumns:
 		b, ok := inB.(*AddColumns)
 		if !ok {
@@ -86,6 +92,18 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfAlterDatabase(a, b)
+	case *AlterEvent:
+		b, ok := inB.(*AlterEvent)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfAlterEvent(a, b)
+	case *AlterFunction:
+		b, ok := inB.(*AlterFunction)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfAlterFunction(a, b)
 	case *AlterIndex:
 		b, ok := inB.(*AlterIndex)
 		if !ok {
@@ -98,12 +116,24 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfAlterMigration(a, b)
+	case *AlterProcedure:
+		b, ok := inB.(*AlterProcedure)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfAlterProcedure(a, b)
 	case *AlterTable:
 		b, ok := inB.(*AlterTable)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfAlterTable(a, b)
+	case *AlterUser:
+		b, ok := inB.(*AlterUser)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfAlterUser(a, b)
 	case *AlterView:
 		b, ok := inB.(*AlterView)
 		if !ok {
@@ -140,6 +170,12 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfAssignmentExpr(a, b)
+	case *Authentication:
+		b, ok := inB.(*Authentication)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfAuthentication(a, b)
 	case *AutoIncSpec:
 		b, ok := inB.(*AutoIncSpec)
 		if !ok {
@@ -152,12 +188,24 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfAvg(a, b)
+	case *BadStatement:
+		b, ok := inB.(*BadStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfBadStatement(a, b)
 	case *Begin:
 		b, ok := inB.(*Begin)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfBegin(a, b)
+	case *BeginEndBlock:
+		b, ok := inB.(*BeginEndBlock)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfBeginEndBlock(a, b)
 	case *BetweenExpr:
 		b, ok := inB.(*BetweenExpr)
 		if !ok {
@@ -206,6 +254,18 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfCaseExpr(a, b)
+	case *CaseStatement:
+		b, ok := inB.(*CaseStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCaseStatement(a, b)
+	case *CaseStatementWhen:
+		b, ok := inB.(*CaseStatementWhen)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCaseStatementWhen(a, b)
 	case *CastExpr:
 		b, ok := inB.(*CastExpr)
 		if !ok {
@@ -230,6 +290,12 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfCheckConstraintDefinition(a, b)
+	case *CloseCursor:
+		b, ok := inB.(*CloseCursor)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCloseCursor(a, b)
 	case *ColName:
 		b, ok := inB.(*ColName)
 		if !ok {
@@ -284,6 +350,12 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfComparisonExpr(a, b)
+	case *ConditionValue:
+		b, ok := inB.(*ConditionValue)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfConditionValue(a, b)
 	case *ConstraintDefinition:
 		b, ok := inB.(*ConstraintDefinition)
 		if !ok {
@@ -326,12 +398,48 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfCreateDatabase(a, b)
+	case *CreateEvent:
+		b, ok := inB.(*CreateEvent)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCreateEvent(a, b)
+	case *CreateFunction:
+		b, ok := inB.(*CreateFunction)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCreateFunction(a, b)
+	case *CreateProcedure:
+		b, ok := inB.(*CreateProcedure)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCreateProcedure(a, b)
+	case *CreateRole:
+		b, ok := inB.(*CreateRole)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCreateRole(a, b)
 	case *CreateTable:
 		b, ok := inB.(*CreateTable)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfCreateTable(a, b)
+	case *CreateTrigger:
+		b, ok := inB.(*CreateTrigger)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCreateTrigger(a, b)
+	case *CreateUser:
+		b, ok := inB.(*CreateUser)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCreateUser(a, b)
 	case *CreateView:
 		b, ok := inB.(*CreateView)
 		if !ok {
@@ -362,6 +470,30 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfDeallocateStmt(a, b)
+	case *DeclareCondition:
+		b, ok := inB.(*DeclareCondition)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDeclareCondition(a, b)
+	case *DeclareCursor:
+		b, ok := inB.(*DeclareCursor)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDeclareCursor(a, b)
+	case *DeclareHandler:
+		b, ok := inB.(*DeclareHandler)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDeclareHandler(a, b)
+	case *DeclareVar:
+		b, ok := inB.(*DeclareVar)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDeclareVar(a, b)
 	case *Default:
 		b, ok := inB.(*Default)
 		if !ok {
@@ -398,24 +530,72 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfDropDatabase(a, b)
+	case *DropEvent:
+		b, ok := inB.(*DropEvent)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDropEvent(a, b)
+	case *DropFunction:
+		b, ok := inB.(*DropFunction)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDropFunction(a, b)
 	case *DropKey:
 		b, ok := inB.(*DropKey)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfDropKey(a, b)
+	case *DropProcedure:
+		b, ok := inB.(*DropProcedure)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDropProcedure(a, b)
+	case *DropRole:
+		b, ok := inB.(*DropRole)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDropRole(a, b)
 	case *DropTable:
 		b, ok := inB.(*DropTable)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfDropTable(a, b)
+	case *DropTrigger:
+		b, ok := inB.(*DropTrigger)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDropTrigger(a, b)
+	case *DropUser:
+		b, ok := inB.(*DropUser)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDropUser(a, b)
 	case *DropView:
 		b, ok := inB.(*DropView)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfDropView(a, b)
+	case *ElseIfBlock:
+		b, ok := inB.(*ElseIfBlock)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfElseIfBlock(a, b)
+	case *EventSchedule:
+		b, ok := inB.(*EventSchedule)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfEventSchedule(a, b)
 	case *ExecuteStmt:
 		b, ok := inB.(*ExecuteStmt)
 		if !ok {
@@ -464,6 +644,12 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfExtractedSubquery(a, b)
+	case *FetchCursor:
+		b, ok := inB.(*FetchCursor)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfFetchCursor(a, b)
 	case *FirstOrLastValueExpr:
 		b, ok := inB.(*FirstOrLastValueExpr)
 		if !ok {
@@ -578,6 +764,18 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfGeomPropertyFuncExpr(a, b)
+	case *Grant:
+		b, ok := inB.(*Grant)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfGrant(a, b)
+	case *GrantPrivilege:
+		b, ok := inB.(*GrantPrivilege)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfGrantPrivilege(a, b)
 	case GroupBy:
 		b, ok := inB.(GroupBy)
 		if !ok {
@@ -602,6 +800,12 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.IdentifierCS(a, b)
+	case *IfStatement:
+		b, ok := inB.(*IfStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfIfStatement(a, b)
 	case *IndexDefinition:
 		b, ok := inB.(*IndexDefinition)
 		if !ok {
@@ -656,6 +860,12 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfIsExpr(a, b)
+	case *IterateStatement:
+		b, ok := inB.(*IterateStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfIterateStatement(a, b)
 	case *JSONArrayExpr:
 		b, ok := inB.(*JSONArrayExpr)
 		if !ok {
@@ -824,6 +1034,12 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfLagLeadExpr(a, b)
+	case *LeaveStatement:
+		b, ok := inB.(*LeaveStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfLeaveStatement(a, b)
 	case *Limit:
 		b, ok := inB.(*Limit)
 		if !ok {
@@ -860,6 +1076,18 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfLoad(a, b)
+	case *LoadFields:
+		b, ok := inB.(*LoadFields)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfLoadFields(a, b)
+	case *LoadLines:
+		b, ok := inB.(*LoadLines)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfLoadLines(a, b)
 	case *LocateExpr:
 		b, ok := inB.(*LocateExpr)
 		if !ok {
@@ -884,6 +1112,12 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfLockingFunc(a, b)
+	case *LoopStatement:
+		b, ok := inB.(*LoopStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfLoopStatement(a, b)
 	case MatchAction:
 		b, ok := inB.(MatchAction)
 		if !ok {
@@ -992,12 +1226,24 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfOffset(a, b)
+	case *OnConflict:
+		b, ok := inB.(*OnConflict)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfOnConflict(a, b)
 	case OnDup:
 		b, ok := inB.(OnDup)
 		if !ok {
 			return false
 		}
 		return cmp.OnDup(a, b)
+	case *OpenCursor:
+		b, ok := inB.(*OpenCursor)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfOpenCursor(a, b)
 	case *OptLike:
 		b, ok := inB.(*OptLike)
 		if !ok {
@@ -1100,6 +1346,12 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.Partitions(a, b)
+	case *PasswordExpire:
+		b, ok := inB.(*PasswordExpire)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfPasswordExpire(a, b)
 	case *PerformanceSchemaFuncExpr:
 		b, ok := inB.(*PerformanceSchemaFuncExpr)
 		if !ok {
@@ -1130,12 +1382,30 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfPolygonPropertyFuncExpr(a, b)
+	case *PositionalArg:
+		b, ok := inB.(*PositionalArg)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfPositionalArg(a, b)
 	case *PrepareStmt:
 		b, ok := inB.(*PrepareStmt)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfPrepareStmt(a, b)
+	case *PrivilegeLevel:
+		b, ok := inB.(*PrivilegeLevel)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfPrivilegeLevel(a, b)
+	case *ProcParameter:
+		b, ok := inB.(*ProcParameter)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfProcParameter(a, b)
 	case *PurgeBinaryLogs:
 		b, ok := inB.(*PurgeBinaryLogs)
 		if !ok {
@@ -1208,12 +1478,42 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfRenameTableName(a, b)
+	case *RenameUser:
+		b, ok := inB.(*RenameUser)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfRenameUser(a, b)
+	case *RenameUserPair:
+		b, ok := inB.(*RenameUserPair)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfRenameUserPair(a, b)
+	case *RepeatStatement:
+		b, ok := inB.(*RepeatStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfRepeatStatement(a, b)
+	case *ReturnStatement:
+		b, ok := inB.(*ReturnStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfReturnStatement(a, b)
 	case *RevertMigration:
 		b, ok := inB.(*RevertMigration)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfRevertMigration(a, b)
+	case *Revoke:
+		b, ok := inB.(*Revoke)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfRevoke(a, b)
 	case *Rollback:
 		b, ok := inB.(*Rollback)
 		if !ok {
@@ -1226,6 +1526,30 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RootNode(a, b)
+	case *RoutineCharacteristic:
+		b, ok := inB.(*RoutineCharacteristic)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfRoutineCharacteristic(a, b)
+	case *RoutineSet:
+		b, ok := inB.(*RoutineSet)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfRoutineSet(a, b)
+	case *RoutineSetExpr:
+		b, ok := inB.(*RoutineSetExpr)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfRoutineSetExpr(a, b)
+	case RoutineSetExprs:
+		b, ok := inB.(RoutineSetExprs)
+		if !ok {
+			return false
+		}
+		return cmp.RoutineSetExprs(a, b)
 	case *SRollback:
 		b, ok := inB.(*SRollback)
 		if !ok {
@@ -1262,6 +1586,12 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfSet(a, b)
+	case *SetDefaultRole:
+		b, ok := inB.(*SetDefaultRole)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfSetDefaultRole(a, b)
 	case *SetExpr:
 		b, ok := inB.(*SetExpr)
 		if !ok {
@@ -1274,6 +1604,18 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.SetExprs(a, b)
+	case *SetPassword:
+		b, ok := inB.(*SetPassword)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfSetPassword(a, b)
+	case *SetRole:
+		b, ok := inB.(*SetRole)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfSetRole(a, b)
 	case *Show:
 		b, ok := inB.(*Show)
 		if !ok {
@@ -1322,12 +1664,30 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfShowThrottlerStatus(a, b)
+	case *Signal:
+		b, ok := inB.(*Signal)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfSignal(a, b)
+	case *SignalItem:
+		b, ok := inB.(*SignalItem)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfSignalItem(a, b)
 	case *StarExpr:
 		b, ok := inB.(*StarExpr)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfStarExpr(a, b)
+	case StatementList:
+		b, ok := inB.(StatementList)
+		if !ok {
+			return false
+		}
+		return cmp.StatementList(a, b)
 	case *Std:
 		b, ok := inB.(*Std)
 		if !ok {
@@ -1442,6 +1802,12 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfTimestampFuncExpr(a, b)
+	case *TriggerOrder:
+		b, ok := inB.(*TriggerOrder)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfTriggerOrder(a, b)
 	case *TrimFuncExpr:
 		b, ok := inB.(*TrimFuncExpr)
 		if !ok {
@@ -1454,6 +1820,12 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfTruncateTable(a, b)
+	case *TypeCastExpr:
+		b, ok := inB.(*TypeCastExpr)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfTypeCastExpr(a, b)
 	case *UnaryExpr:
 		b, ok := inB.(*UnaryExpr)
 		if !ok {
@@ -1502,6 +1874,12 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfUse(a, b)
+	case *UserSpec:
+		b, ok := inB.(*UserSpec)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfUserSpec(a, b)
 	case *VExplainStmt:
 		b, ok := inB.(*VExplainStmt)
 		if !ok {
@@ -1592,6 +1970,12 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfWhere(a, b)
+	case *WhileStatement:
+		b, ok := inB.(*WhileStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfWhileStatement(a, b)
 	case *WindowDefinition:
 		b, ok := inB.(*WindowDefinition)
 		if !ok {
@@ -1628,6 +2012,19 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 	}
 }
 
+// RefOfAccount does deep equals between the two objects.
+func (cmp *Comparator) RefOfAccount(a, b *Account) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.User == b.User &&
+		a.Host == b.Host &&
+		a.CurrentUser == b.CurrentUser
+}
+
 // RefOfAddColumns does deep equals between the two objects.
 func (cmp *Comparator) RefOfAddColumns(a, b *AddColumns) bool {
 	if a == b {
@@ -1742,6 +2139,38 @@ func (cmp *Comparator) RefOfAlterDatabase(a, b *AlterDatabase) bool {
 		cmp.SliceOfDatabaseOption(a.AlterOptions, b.AlterOptions)
 }
 
+// RefOfAlterEvent does deep equals between the two objects.
+func (cmp *Comparator) RefOfAlterEvent(a, b *AlterEvent) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.RefOfParsedComments(a.Comments, b.Comments) &&
+		cmp.RefOfDefiner(a.Definer, b.Definer) &&
+		cmp.TableName(a.Name, b.Name) &&
+		cmp.RefOfEventSchedule(a.Schedule, b.Schedule) &&
+		a.OnCompletion == b.OnCompletion &&
+		cmp.TableName(a.RenameTo, b.RenameTo) &&
+		a.Status == b.Status &&
+		cmp.RefOfLiteral(a.Comment, b.Comment) &&
+		cmp.Statement(a.Body, b.Body)
+}
+
+// RefOfAlterFunction does deep equals between the two objects.
+func (cmp *Comparator) RefOfAlterFunction(a, b *AlterFunction) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.RefOfParsedComments(a.Comments, b.Comments) &&
+		cmp.TableName(a.Name, b.Name) &&
+		cmp.SliceOfRefOfRoutineCharacteristic(a.Characteristics, b.Characteristics)
+}
+
 // RefOfAlterIndex does deep equals between the two objects.
 func (cmp *Comparator) RefOfAlterIndex(a, b *AlterIndex) bool {
 	if a == b {
@@ -1769,6 +2198,19 @@ func (cmp *Comparator) RefOfAlterMigration(a, b *AlterMigration) bool {
 		cmp.RefOfLiteral(a.Ratio, b.Ratio)
 }
 
+// RefOfAlterProcedure does deep equals between the two objects.
+func (cmp *Comparator) RefOfAlterProcedure(a, b *AlterProcedure) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.RefOfParsedComments(a.Comments, b.Comments) &&
+		cmp.TableName(a.Name, b.Name) &&
+		cmp.SliceOfRefOfRoutineCharacteristic(a.Characteristics, b.Characteristics)
+}
+
 // RefOfAlterTable does deep equals between the two objects.
 func (cmp *Comparator) RefOfAlterTable(a, b *AlterTable) bool {
 	if a == b {
@@ -1785,6 +2227,20 @@ func (cmp *Comparator) RefOfAlterTable(a, b *AlterTable) bool {
 		cmp.RefOfParsedComments(a.Comments, b.Comments)
 }
 
+// RefOfAlterUser does deep equals between the two objects.
+func (cmp *Comparator) RefOfAlterUser(a, b *AlterUser) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfExists == b.IfExists &&
+		cmp.SliceOfRefOfUserSpec(a.Users, b.Users) &&
+		cmp.RefOfPasswordExpire(a.PasswordExpire, b.PasswordExpire) &&
+		a.AccountLock == b.AccountLock
+}
+
 // RefOfAlterView does deep equals between the two objects.
 func (cmp *Comparator) RefOfAlterView(a, b *AlterView) bool {
 	if a == b {
@@ -1866,6 +2322,21 @@ func (cmp *Comparator) RefOfAssignmentExpr(a, b *AssignmentExpr) bool {
 		cmp.Expr(a.Right, b.Right)
 }
 
+// RefOfAuthentication does deep equals between the two objects.
+func (cmp *Comparator) RefOfAuthentication(a, b *Authentication) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Hashed == b.Hashed &&
+		a.RandomPassword == b.RandomPassword &&
+		cmp.IdentifierCI(a.Plugin, b.Plugin) &&
+		cmp.RefOfLiteral(a.Password, b.Password) &&
+		cmp.RefOfLiteral(a.CurrentPassword, b.CurrentPassword)
+}
+
 // RefOfAutoIncSpec does deep equals between the two objects.
 func (cmp *Comparator) RefOfAutoIncSpec(a, b *AutoIncSpec) bool {
 	if a == b {
@@ -1890,6 +2361,17 @@ func (cmp *Comparator) RefOfAvg(a, b *Avg) bool {
 		cmp.Expr(a.Arg, b.Arg)
 }
 
+// RefOfBadStatement does deep equals between the two objects.
+func (cmp *Comparator) RefOfBadStatement(a, b *BadStatement) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.SQL == b.SQL
+}
+
 // RefOfBegin does deep equals between the two objects.
 func (cmp *Comparator) RefOfBegin(a, b *Begin) bool {
 	if a == b {
@@ -1901,8 +2383,20 @@ func (cmp *Comparator) RefOfBegin(a, b *Begin) bool {
 	return cmp.SliceOfTxAccessMode(a.TxAccessModes, b.TxAccessModes)
 }
 
-// RefOfBetweenExpr does deep equals between the two objects.
-func (cmp *Comparator) RefOfBetweenExpr(a, b *BetweenExpr) bool {
+// RefOfBeginEndBlock does deep equals between the two objects.
+func (cmp *Comparator) RefOfBeginEndBlock(a, b *BeginEndBlock) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.IdentifierCI(a.Label, b.Label) &&
+		cmp.StatementList(a.Statements, b.Statements)
+}
+
+// RefOfBetweenExpr does deep equals between the two objects.
+func (cmp *Comparator) RefOfBetweenExpr(a, b *BetweenExpr) bool {
 	if a == b {
 		return true
 	}
@@ -1986,6 +2480,31 @@ func (cmp *Comparator) RefOfCaseExpr(a, b *CaseExpr) bool {
 		cmp.Expr(a.Else, b.Else)
 }
 
+// RefOfCaseStatement does deep equals between the two objects.
+func (cmp *Comparator) RefOfCaseStatement(a, b *CaseStatement) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.Expr(a.Expr, b.Expr) &&
+		cmp.SliceOfRefOfCaseStatementWhen(a.Whens, b.Whens) &&
+		cmp.StatementList(a.Else, b.Else)
+}
+
+// RefOfCaseStatementWhen does deep equals between the two objects.
+func (cmp *Comparator) RefOfCaseStatementWhen(a, b *CaseStatementWhen) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.Expr(a.Cond, b.Cond) &&
+		cmp.StatementList(a.Statements, b.Statements)
+}
+
 // RefOfCastExpr does deep equals between the two objects.
 func (cmp *Comparator) RefOfCastExpr(a, b *CastExpr) bool {
 	if a == b {
@@ -2037,6 +2556,17 @@ func (cmp *Comparator) RefOfCheckConstraintDefinition(a, b *CheckConstraintDefin
 		cmp.Expr(a.Expr, b.Expr)
 }
 
+// RefOfCloseCursor does deep equals between the two objects.
+func (cmp *Comparator) RefOfCloseCursor(a, b *CloseCursor) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.IdentifierCI(a.Name, b.Name)
+}
+
 // RefOfColName does deep equals between the two objects.
 func (cmp *Comparator) RefOfColName(a, b *ColName) bool {
 	if a == b {
@@ -2156,6 +2686,19 @@ func (cmp *Comparator) RefOfComparisonExpr(a, b *ComparisonExpr) bool {
 		cmp.Expr(a.Escape, b.Escape)
 }
 
+// RefOfConditionValue does deep equals between the two objects.
+func (cmp *Comparator) RefOfConditionValue(a, b *ConditionValue) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Value == b.Value &&
+		a.Type == b.Type &&
+		cmp.IdentifierCI(a.Name, b.Name)
+}
+
 // RefOfConstraintDefinition does deep equals between the two objects.
 func (cmp *Comparator) RefOfConstraintDefinition(a, b *ConstraintDefinition) bool {
 	if a == b {
@@ -2244,6 +2787,72 @@ func (cmp *Comparator) RefOfCreateDatabase(a, b *CreateDatabase) bool {
 		cmp.SliceOfDatabaseOption(a.CreateOptions, b.CreateOptions)
 }
 
+// RefOfCreateEvent does deep equals between the two objects.
+func (cmp *Comparator) RefOfCreateEvent(a, b *CreateEvent) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfNotExists == b.IfNotExists &&
+		cmp.RefOfParsedComments(a.Comments, b.Comments) &&
+		cmp.RefOfDefiner(a.Definer, b.Definer) &&
+		cmp.TableName(a.Name, b.Name) &&
+		cmp.RefOfEventSchedule(a.Schedule, b.Schedule) &&
+		a.OnCompletion == b.OnCompletion &&
+		a.Status == b.Status &&
+		cmp.RefOfLiteral(a.Comment, b.Comment) &&
+		cmp.Statement(a.Body, b.Body)
+}
+
+// RefOfCreateFunction does deep equals between the two objects.
+func (cmp *Comparator) RefOfCreateFunction(a, b *CreateFunction) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfNotExists == b.IfNotExists &&
+		cmp.RefOfParsedComments(a.Comments, b.Comments) &&
+		cmp.RefOfDefiner(a.Definer, b.Definer) &&
+		cmp.TableName(a.Name, b.Name) &&
+		cmp.SliceOfRefOfProcParameter(a.Params, b.Params) &&
+		cmp.RefOfColumnType(a.Returns, b.Returns) &&
+		cmp.SliceOfRefOfRoutineCharacteristic(a.Characteristics, b.Characteristics) &&
+		cmp.Statement(a.Body, b.Body)
+}
+
+// RefOfCreateProcedure does deep equals between the two objects.
+func (cmp *Comparator) RefOfCreateProcedure(a, b *CreateProcedure) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfNotExists == b.IfNotExists &&
+		cmp.RefOfParsedComments(a.Comments, b.Comments) &&
+		cmp.RefOfDefiner(a.Definer, b.Definer) &&
+		cmp.TableName(a.Name, b.Name) &&
+		cmp.SliceOfRefOfProcParameter(a.Params, b.Params) &&
+		cmp.SliceOfRefOfRoutineCharacteristic(a.Characteristics, b.Characteristics) &&
+		cmp.Statement(a.Body, b.Body)
+}
+
+// RefOfCreateRole does deep equals between the two objects.
+func (cmp *Comparator) RefOfCreateRole(a, b *CreateRole) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfNotExists == b.IfNotExists &&
+		cmp.SliceOfRefOfAccount(a.Roles, b.Roles)
+}
+
 // RefOfCreateTable does deep equals between the two objects.
 func (cmp *Comparator) RefOfCreateTable(a, b *CreateTable) bool {
 	if a == b {
@@ -2261,6 +2870,40 @@ func (cmp *Comparator) RefOfCreateTable(a, b *CreateTable) bool {
 		cmp.RefOfParsedComments(a.Comments, b.Comments)
 }
 
+// RefOfCreateTrigger does deep equals between the two objects.
+func (cmp *Comparator) RefOfCreateTrigger(a, b *CreateTrigger) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfNotExists == b.IfNotExists &&
+		cmp.RefOfParsedComments(a.Comments, b.Comments) &&
+		cmp.RefOfDefiner(a.Definer, b.Definer) &&
+		cmp.TableName(a.Name, b.Name) &&
+		a.Timing == b.Timing &&
+		a.Event == b.Event &&
+		cmp.TableName(a.Table, b.Table) &&
+		cmp.RefOfTriggerOrder(a.Order, b.Order) &&
+		cmp.Statement(a.Body, b.Body)
+}
+
+// RefOfCreateUser does deep equals between the two objects.
+func (cmp *Comparator) RefOfCreateUser(a, b *CreateUser) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfNotExists == b.IfNotExists &&
+		cmp.SliceOfRefOfUserSpec(a.Users, b.Users) &&
+		cmp.SliceOfRefOfAccount(a.DefaultRoles, b.DefaultRoles) &&
+		cmp.RefOfPasswordExpire(a.PasswordExpire, b.PasswordExpire) &&
+		a.AccountLock == b.AccountLock
+}
+
 // RefOfCreateView does deep equals between the two objects.
 func (cmp *Comparator) RefOfCreateView(a, b *CreateView) bool {
 	if a == b {
@@ -2332,6 +2975,56 @@ func (cmp *Comparator) RefOfDeallocateStmt(a, b *DeallocateStmt) bool {
 		cmp.IdentifierCI(a.Name, b.Name)
 }
 
+// RefOfDeclareCondition does deep equals between the two objects.
+func (cmp *Comparator) RefOfDeclareCondition(a, b *DeclareCondition) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.IdentifierCI(a.Name, b.Name) &&
+		cmp.RefOfConditionValue(a.Value, b.Value)
+}
+
+// RefOfDeclareCursor does deep equals between the two objects.
+func (cmp *Comparator) RefOfDeclareCursor(a, b *DeclareCursor) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.IdentifierCI(a.Name, b.Name) &&
+		cmp.SelectStatement(a.Select, b.Select)
+}
+
+// RefOfDeclareHandler does deep equals between the two objects.
+func (cmp *Comparator) RefOfDeclareHandler(a, b *DeclareHandler) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Action == b.Action &&
+		cmp.SliceOfRefOfConditionValue(a.Conditions, b.Conditions) &&
+		cmp.Statement(a.Statement, b.Statement)
+}
+
+// RefOfDeclareVar does deep equals between the two objects.
+func (cmp *Comparator) RefOfDeclareVar(a, b *DeclareVar) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.Columns(a.Names, b.Names) &&
+		cmp.RefOfColumnType(a.Type, b.Type) &&
+		cmp.Expr(a.Default, b.Default)
+}
+
 // RefOfDefault does deep equals between the two objects.
 func (cmp *Comparator) RefOfDefault(a, b *Default) bool {
 	if a == b {
@@ -2371,7 +3064,8 @@ func (cmp *Comparator) RefOfDelete(a, b *Delete) bool {
 		cmp.Partitions(a.Partitions, b.Partitions) &&
 		cmp.RefOfWhere(a.Where, b.Where) &&
 		cmp.OrderBy(a.OrderBy, b.OrderBy) &&
-		cmp.RefOfLimit(a.Limit, b.Limit)
+		cmp.RefOfLimit(a.Limit, b.Limit) &&
+		cmp.SelectExprs(a.Returning, b.Returning)
 }
 
 // RefOfDerivedTable does deep equals between the two objects.
@@ -2410,6 +3104,32 @@ func (cmp *Comparator) RefOfDropDatabase(a, b *DropDatabase) bool {
 		cmp.IdentifierCS(a.DBName, b.DBName)
 }
 
+// RefOfDropEvent does deep equals between the two objects.
+func (cmp *Comparator) RefOfDropEvent(a, b *DropEvent) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfExists == b.IfExists &&
+		cmp.RefOfParsedComments(a.Comments, b.Comments) &&
+		cmp.TableName(a.Name, b.Name)
+}
+
+// RefOfDropFunction does deep equals between the two objects.
+func (cmp *Comparator) RefOfDropFunction(a, b *DropFunction) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfExists == b.IfExists &&
+		cmp.RefOfParsedComments(a.Comments, b.Comments) &&
+		cmp.TableName(a.Name, b.Name)
+}
+
 // RefOfDropKey does deep equals between the two objects.
 func (cmp *Comparator) RefOfDropKey(a, b *DropKey) bool {
 	if a == b {
@@ -2422,6 +3142,31 @@ func (cmp *Comparator) RefOfDropKey(a, b *DropKey) bool {
 		cmp.IdentifierCI(a.Name, b.Name)
 }
 
+// RefOfDropProcedure does deep equals between the two objects.
+func (cmp *Comparator) RefOfDropProcedure(a, b *DropProcedure) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfExists == b.IfExists &&
+		cmp.RefOfParsedComments(a.Comments, b.Comments) &&
+		cmp.TableName(a.Name, b.Name)
+}
+
+// RefOfDropRole does deep equals between the two objects.
+func (cmp *Comparator) RefOfDropRole(a, b *DropRole) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfExists == b.IfExists &&
+		cmp.SliceOfRefOfAccount(a.Roles, b.Roles)
+}
+
 // RefOfDropTable does deep equals between the two objects.
 func (cmp *Comparator) RefOfDropTable(a, b *DropTable) bool {
 	if a == b {
@@ -2436,6 +3181,31 @@ func (cmp *Comparator) RefOfDropTable(a, b *DropTable) bool {
 		cmp.RefOfParsedComments(a.Comments, b.Comments)
 }
 
+// RefOfDropTrigger does deep equals between the two objects.
+func (cmp *Comparator) RefOfDropTrigger(a, b *DropTrigger) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfExists == b.IfExists &&
+		cmp.RefOfParsedComments(a.Comments, b.Comments) &&
+		cmp.TableName(a.Name, b.Name)
+}
+
+// RefOfDropUser does deep equals between the two objects.
+func (cmp *Comparator) RefOfDropUser(a, b *DropUser) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfExists == b.IfExists &&
+		cmp.SliceOfRefOfAccount(a.Users, b.Users)
+}
+
 // RefOfDropView does deep equals between the two objects.
 func (cmp *Comparator) RefOfDropView(a, b *DropView) bool {
 	if a == b {
@@ -2449,6 +3219,33 @@ func (cmp *Comparator) RefOfDropView(a, b *DropView) bool {
 		cmp.RefOfParsedComments(a.Comments, b.Comments)
 }
 
+// RefOfElseIfBlock does deep equals between the two objects.
+func (cmp *Comparator) RefOfElseIfBlock(a, b *ElseIfBlock) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.Expr(a.Cond, b.Cond) &&
+		cmp.StatementList(a.Statements, b.Statements)
+}
+
+// RefOfEventSchedule does deep equals between the two objects.
+func (cmp *Comparator) RefOfEventSchedule(a, b *EventSchedule) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.Expr(a.At, b.At) &&
+		cmp.Expr(a.Every, b.Every) &&
+		a.Unit == b.Unit &&
+		cmp.Expr(a.Starts, b.Starts) &&
+		cmp.Expr(a.Ends, b.Ends)
+}
+
 // RefOfExecuteStmt does deep equals between the two objects.
 func (cmp *Comparator) RefOfExecuteStmt(a, b *ExecuteStmt) bool {
 	if a == b {
@@ -2553,6 +3350,18 @@ func (cmp *Comparator) RefOfExtractedSubquery(a, b *ExtractedSubquery) bool {
 		cmp.Expr(a.alternative, b.alternative)
 }
 
+// RefOfFetchCursor does deep equals between the two objects.
+func (cmp *Comparator) RefOfFetchCursor(a, b *FetchCursor) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.IdentifierCI(a.Name, b.Name) &&
+		cmp.Columns(a.Variables, b.Variables)
+}
+
 // RefOfFirstOrLastValueExpr does deep equals between the two objects.
 func (cmp *Comparator) RefOfFirstOrLastValueExpr(a, b *FirstOrLastValueExpr) bool {
 	if a == b {
@@ -2801,6 +3610,35 @@ func (cmp *Comparator) RefOfGeomPropertyFuncExpr(a, b *GeomPropertyFuncExpr) boo
 		cmp.Expr(a.Geom, b.Geom)
 }
 
+// RefOfGrant does deep equals between the two objects.
+func (cmp *Comparator) RefOfGrant(a, b *Grant) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.WithGrantOption == b.WithGrantOption &&
+		a.WithAdminOption == b.WithAdminOption &&
+		cmp.SliceOfRefOfGrantPrivilege(a.Privileges, b.Privileges) &&
+		cmp.RefOfPrivilegeLevel(a.On, b.On) &&
+		cmp.SliceOfRefOfAccount(a.Roles, b.Roles) &&
+		cmp.RefOfAccount(a.Proxy, b.Proxy) &&
+		cmp.SliceOfRefOfAccount(a.To, b.To)
+}
+
+// RefOfGrantPrivilege does deep equals between the two objects.
+func (cmp *Comparator) RefOfGrantPrivilege(a, b *GrantPrivilege) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Name == b.Name &&
+		cmp.Columns(a.Columns, b.Columns)
+}
+
 // GroupBy does deep equals between the two objects.
 func (cmp *Comparator) GroupBy(a, b GroupBy) bool {
 	if len(a) != len(b) {
@@ -2840,6 +3678,20 @@ func (cmp *Comparator) IdentifierCS(a, b IdentifierCS) bool {
 	return a.v == b.v
 }
 
+// RefOfIfStatement does deep equals between the two objects.
+func (cmp *Comparator) RefOfIfStatement(a, b *IfStatement) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.Expr(a.Cond, b.Cond) &&
+		cmp.StatementList(a.Statements, b.Statements) &&
+		cmp.SliceOfRefOfElseIfBlock(a.ElseIfs, b.ElseIfs) &&
+		cmp.StatementList(a.Else, b.Else)
+}
+
 // RefOfIndexDefinition does deep equals between the two objects.
 func (cmp *Comparator) RefOfIndexDefinition(a, b *IndexDefinition) bool {
 	if a == b {
@@ -2911,7 +3763,9 @@ func (cmp *Comparator) RefOfInsert(a, b *Insert) bool {
 		cmp.Partitions(a.Partitions, b.Partitions) &&
 		cmp.Columns(a.Columns, b.Columns) &&
 		cmp.InsertRows(a.Rows, b.Rows) &&
-		cmp.OnDup(a.OnDup, b.OnDup)
+		cmp.OnDup(a.OnDup, b.OnDup) &&
+		cmp.RefOfOnConflict(a.OnConflict, b.OnConflict) &&
+		cmp.SelectExprs(a.Returning, b.Returning)
 }
 
 // RefOfInsertExpr does deep equals between the two objects.
@@ -2964,6 +3818,17 @@ func (cmp *Comparator) RefOfIsExpr(a, b *IsExpr) bool {
 		a.Right == b.Right
 }
 
+// RefOfIterateStatement does deep equals between the two objects.
+func (cmp *Comparator) RefOfIterateStatement(a, b *IterateStatement) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.IdentifierCI(a.Label, b.Label)
+}
+
 // RefOfJSONArrayExpr does deep equals between the two objects.
 func (cmp *Comparator) RefOfJSONArrayExpr(a, b *JSONArrayExpr) bool {
 	if a == b {
@@ -3312,6 +4177,17 @@ func (cmp *Comparator) RefOfLagLeadExpr(a, b *LagLeadExpr) bool {
 		cmp.RefOfNullTreatmentClause(a.NullTreatmentClause, b.NullTreatmentClause)
 }
 
+// RefOfLeaveStatement does deep equals between the two objects.
+func (cmp *Comparator) RefOfLeaveStatement(a, b *LeaveStatement) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.IdentifierCI(a.Label, b.Label)
+}
+
 // RefOfLimit does deep equals between the two objects.
 func (cmp *Comparator) RefOfLimit(a, b *Limit) bool {
 	if a == b {
@@ -3343,32 +4219,70 @@ func (cmp *Comparator) RefOfLinestrPropertyFuncExpr(a, b *LinestrPropertyFuncExp
 	if a == nil || b == nil {
 		return false
 	}
-	return a.Property == b.Property &&
-		cmp.Expr(a.Linestring, b.Linestring) &&
-		cmp.Expr(a.PropertyDefArg, b.PropertyDefArg)
+	return a.Property == b.Property &&
+		cmp.Expr(a.Linestring, b.Linestring) &&
+		cmp.Expr(a.PropertyDefArg, b.PropertyDefArg)
+}
+
+// RefOfLiteral does deep equals between the two objects.
+func (cmp *Comparator) RefOfLiteral(a, b *Literal) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Val == b.Val &&
+		a.Type == b.Type
+}
+
+// RefOfLoad does deep equals between the two objects.
+func (cmp *Comparator) RefOfLoad(a, b *Load) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Local == b.Local &&
+		a.FileName == b.FileName &&
+		a.Replace == b.Replace &&
+		a.Priority == b.Priority &&
+		a.Ignore == b.Ignore &&
+		cmp.TableName(a.Table, b.Table) &&
+		cmp.Partitions(a.Partitions, b.Partitions) &&
+		cmp.ColumnCharset(a.Charset, b.Charset) &&
+		cmp.RefOfLoadFields(a.Fields, b.Fields) &&
+		cmp.RefOfLoadLines(a.Lines, b.Lines) &&
+		cmp.RefOfLiteral(a.IgnoreLines, b.IgnoreLines) &&
+		cmp.Exprs(a.Columns, b.Columns) &&
+		cmp.UpdateExprs(a.SetExprs, b.SetExprs)
 }
 
-// RefOfLiteral does deep equals between the two objects.
-func (cmp *Comparator) RefOfLiteral(a, b *Literal) bool {
+// RefOfLoadFields does deep equals between the two objects.
+func (cmp *Comparator) RefOfLoadFields(a, b *LoadFields) bool {
 	if a == b {
 		return true
 	}
 	if a == nil || b == nil {
 		return false
 	}
-	return a.Val == b.Val &&
-		a.Type == b.Type
+	return a.OptionallyEnclosed == b.OptionallyEnclosed &&
+		cmp.RefOfLiteral(a.TerminatedBy, b.TerminatedBy) &&
+		cmp.RefOfLiteral(a.EnclosedBy, b.EnclosedBy) &&
+		cmp.RefOfLiteral(a.EscapedBy, b.EscapedBy)
 }
 
-// RefOfLoad does deep equals between the two objects.
-func (cmp *Comparator) RefOfLoad(a, b *Load) bool {
+// RefOfLoadLines does deep equals between the two objects.
+func (cmp *Comparator) RefOfLoadLines(a, b *LoadLines) bool {
 	if a == b {
 		return true
 	}
 	if a == nil || b == nil {
 		return false
 	}
-	return true
+	return cmp.RefOfLiteral(a.StartingBy, b.StartingBy) &&
+		cmp.RefOfLiteral(a.TerminatedBy, b.TerminatedBy)
 }
 
 // RefOfLocateExpr does deep equals between the two objects.
@@ -3419,6 +4333,18 @@ func (cmp *Comparator) RefOfLockingFunc(a, b *LockingFunc) bool {
 		cmp.Expr(a.Timeout, b.Timeout)
 }
 
+// RefOfLoopStatement does deep equals between the two objects.
+func (cmp *Comparator) RefOfLoopStatement(a, b *LoopStatement) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.IdentifierCI(a.Label, b.Label) &&
+		cmp.StatementList(a.Statements, b.Statements)
+}
+
 // RefOfMatchExpr does deep equals between the two objects.
 func (cmp *Comparator) RefOfMatchExpr(a, b *MatchExpr) bool {
 	if a == b {
@@ -3621,6 +4547,21 @@ func (cmp *Comparator) RefOfOffset(a, b *Offset) bool {
 		cmp.Expr(a.Original, b.Original)
 }
 
+// RefOfOnConflict does deep equals between the two objects.
+func (cmp *Comparator) RefOfOnConflict(a, b *OnConflict) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.DoNothing == b.DoNothing &&
+		cmp.Columns(a.Columns, b.Columns) &&
+		cmp.IdentifierCI(a.Constraint, b.Constraint) &&
+		cmp.UpdateExprs(a.Exprs, b.Exprs) &&
+		cmp.RefOfWhere(a.Where, b.Where)
+}
+
 // OnDup does deep equals between the two objects.
 func (cmp *Comparator) OnDup(a, b OnDup) bool {
 	if len(a) != len(b) {
@@ -3634,6 +4575,17 @@ func (cmp *Comparator) OnDup(a, b OnDup) bool {
 	return true
 }
 
+// RefOfOpenCursor does deep equals between the two objects.
+func (cmp *Comparator) RefOfOpenCursor(a, b *OpenCursor) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.IdentifierCI(a.Name, b.Name)
+}
+
 // RefOfOptLike does deep equals between the two objects.
 func (cmp *Comparator) RefOfOptLike(a, b *OptLike) bool {
 	if a == b {
@@ -3853,6 +4805,18 @@ func (cmp *Comparator) Partitions(a, b Partitions) bool {
 	return true
 }
 
+// RefOfPasswordExpire does deep equals between the two objects.
+func (cmp *Comparator) RefOfPasswordExpire(a, b *PasswordExpire) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		cmp.RefOfLiteral(a.Days, b.Days)
+}
+
 // RefOfPerformanceSchemaFuncExpr does deep equals between the two objects.
 func (cmp *Comparator) RefOfPerformanceSchemaFuncExpr(a, b *PerformanceSchemaFuncExpr) bool {
 	if a == b {
@@ -3914,6 +4878,17 @@ func (cmp *Comparator) RefOfPolygonPropertyFuncExpr(a, b *PolygonPropertyFuncExp
 		cmp.Expr(a.PropertyDefArg, b.PropertyDefArg)
 }
 
+// RefOfPositionalArg does deep equals between the two objects.
+func (cmp *Comparator) RefOfPositionalArg(a, b *PositionalArg) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Index == b.Index
+}
+
 // RefOfPrepareStmt does deep equals between the two objects.
 func (cmp *Comparator) RefOfPrepareStmt(a, b *PrepareStmt) bool {
 	if a == b {
@@ -3927,6 +4902,33 @@ func (cmp *Comparator) RefOfPrepareStmt(a, b *PrepareStmt) bool {
 		cmp.RefOfParsedComments(a.Comments, b.Comments)
 }
 
+// RefOfPrivilegeLevel does deep equals between the two objects.
+func (cmp *Comparator) RefOfPrivilegeLevel(a, b *PrivilegeLevel) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Global == b.Global &&
+		a.ObjectType == b.ObjectType &&
+		cmp.IdentifierCS(a.DB, b.DB) &&
+		cmp.IdentifierCS(a.Name, b.Name)
+}
+
+// RefOfProcParameter does deep equals between the two objects.
+func (cmp *Comparator) RefOfProcParameter(a, b *ProcParameter) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Mode == b.Mode &&
+		cmp.IdentifierCI(a.Name, b.Name) &&
+		cmp.RefOfColumnType(a.Type, b.Type)
+}
+
 // RefOfPurgeBinaryLogs does deep equals between the two objects.
 func (cmp *Comparator) RefOfPurgeBinaryLogs(a, b *PurgeBinaryLogs) bool {
 	if a == b {
@@ -4071,6 +5073,53 @@ func (cmp *Comparator) RefOfRenameTableName(a, b *RenameTableName) bool {
 	return cmp.TableName(a.Table, b.Table)
 }
 
+// RefOfRenameUser does deep equals between the two objects.
+func (cmp *Comparator) RefOfRenameUser(a, b *RenameUser) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.SliceOfRefOfRenameUserPair(a.UserPairs, b.UserPairs)
+}
+
+// RefOfRenameUserPair does deep equals between the two objects.
+func (cmp *Comparator) RefOfRenameUserPair(a, b *RenameUserPair) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.RefOfAccount(a.FromUser, b.FromUser) &&
+		cmp.RefOfAccount(a.ToUser, b.ToUser)
+}
+
+// RefOfRepeatStatement does deep equals between the two objects.
+func (cmp *Comparator) RefOfRepeatStatement(a, b *RepeatStatement) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.IdentifierCI(a.Label, b.Label) &&
+		cmp.StatementList(a.Statements, b.Statements) &&
+		cmp.Expr(a.Until, b.Until)
+}
+
+// RefOfReturnStatement does deep equals between the two objects.
+func (cmp *Comparator) RefOfReturnStatement(a, b *ReturnStatement) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.Expr(a.Expr, b.Expr)
+}
+
 // RefOfRevertMigration does deep equals between the two objects.
 func (cmp *Comparator) RefOfRevertMigration(a, b *RevertMigration) bool {
 	if a == b {
@@ -4083,6 +5132,23 @@ func (cmp *Comparator) RefOfRevertMigration(a, b *RevertMigration) bool {
 		cmp.RefOfParsedComments(a.Comments, b.Comments)
 }
 
+// RefOfRevoke does deep equals between the two objects.
+func (cmp *Comparator) RefOfRevoke(a, b *Revoke) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfExists == b.IfExists &&
+		a.IgnoreUnknownUser == b.IgnoreUnknownUser &&
+		cmp.SliceOfRefOfGrantPrivilege(a.Privileges, b.Privileges) &&
+		cmp.RefOfPrivilegeLevel(a.On, b.On) &&
+		cmp.SliceOfRefOfAccount(a.Roles, b.Roles) &&
+		cmp.RefOfAccount(a.Proxy, b.Proxy) &&
+		cmp.SliceOfRefOfAccount(a.From, b.From)
+}
+
 // RefOfRollback does deep equals between the two objects.
 func (cmp *Comparator) RefOfRollback(a, b *Rollback) bool {
 	if a == b {
@@ -4099,6 +5165,55 @@ func (cmp *Comparator) RootNode(a, b RootNode) bool {
 	return cmp.SQLNode(a.SQLNode, b.SQLNode)
 }
 
+// RefOfRoutineCharacteristic does deep equals between the two objects.
+func (cmp *Comparator) RefOfRoutineCharacteristic(a, b *RoutineCharacteristic) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Comment == b.Comment &&
+		a.Type == b.Type
+}
+
+// RefOfRoutineSet does deep equals between the two objects.
+func (cmp *Comparator) RefOfRoutineSet(a, b *RoutineSet) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.RefOfParsedComments(a.Comments, b.Comments) &&
+		cmp.RoutineSetExprs(a.Exprs, b.Exprs)
+}
+
+// RefOfRoutineSetExpr does deep equals between the two objects.
+func (cmp *Comparator) RefOfRoutineSetExpr(a, b *RoutineSetExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.Expr(a.Var, b.Var) &&
+		cmp.Expr(a.Expr, b.Expr)
+}
+
+// RoutineSetExprs does deep equals between the two objects.
+func (cmp *Comparator) RoutineSetExprs(a, b RoutineSetExprs) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !cmp.RefOfRoutineSetExpr(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // RefOfSRollback does deep equals between the two objects.
 func (cmp *Comparator) RefOfSRollback(a, b *SRollback) bool {
 	if a == b {
@@ -4174,7 +5289,8 @@ func (cmp *Comparator) RefOfSelectInto(a, b *SelectInto) bool {
 		a.Manifest == b.Manifest &&
 		a.Overwrite == b.Overwrite &&
 		a.Type == b.Type &&
-		cmp.ColumnCharset(a.Charset, b.Charset)
+		cmp.ColumnCharset(a.Charset, b.Charset) &&
+		cmp.Exprs(a.Variables, b.Variables)
 }
 
 // RefOfSet does deep equals between the two objects.
@@ -4189,6 +5305,19 @@ func (cmp *Comparator) RefOfSet(a, b *Set) bool {
 		cmp.SetExprs(a.Exprs, b.Exprs)
 }
 
+// RefOfSetDefaultRole does deep equals between the two objects.
+func (cmp *Comparator) RefOfSetDefaultRole(a, b *SetDefaultRole) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		cmp.SliceOfRefOfAccount(a.Roles, b.Roles) &&
+		cmp.SliceOfRefOfAccount(a.To, b.To)
+}
+
 // RefOfSetExpr does deep equals between the two objects.
 func (cmp *Comparator) RefOfSetExpr(a, b *SetExpr) bool {
 	if a == b {
@@ -4214,6 +5343,30 @@ func (cmp *Comparator) SetExprs(a, b SetExprs) bool {
 	return true
 }
 
+// RefOfSetPassword does deep equals between the two objects.
+func (cmp *Comparator) RefOfSetPassword(a, b *SetPassword) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.RefOfAccount(a.For, b.For) &&
+		cmp.RefOfLiteral(a.Password, b.Password)
+}
+
+// RefOfSetRole does deep equals between the two objects.
+func (cmp *Comparator) RefOfSetRole(a, b *SetRole) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		cmp.SliceOfRefOfAccount(a.Roles, b.Roles)
+}
+
 // RefOfShow does deep equals between the two objects.
 func (cmp *Comparator) RefOfShow(a, b *Show) bool {
 	if a == b {
@@ -4309,6 +5462,31 @@ func (cmp *Comparator) RefOfShowThrottlerStatus(a, b *ShowThrottlerStatus) bool
 	return cmp.Comments(a.Comments, b.Comments)
 }
 
+// RefOfSignal does deep equals between the two objects.
+func (cmp *Comparator) RefOfSignal(a, b *Signal) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Resignal == b.Resignal &&
+		cmp.RefOfConditionValue(a.Condition, b.Condition) &&
+		cmp.SliceOfRefOfSignalItem(a.Items, b.Items)
+}
+
+// RefOfSignalItem does deep equals between the two objects.
+func (cmp *Comparator) RefOfSignalItem(a, b *SignalItem) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Name == b.Name &&
+		cmp.Expr(a.Value, b.Value)
+}
+
 // RefOfStarExpr does deep equals between the two objects.
 func (cmp *Comparator) RefOfStarExpr(a, b *StarExpr) bool {
 	if a == b {
@@ -4320,6 +5498,19 @@ func (cmp *Comparator) RefOfStarExpr(a, b *StarExpr) bool {
 	return cmp.TableName(a.TableName, b.TableName)
 }
 
+// StatementList does deep equals between the two objects.
+func (cmp *Comparator) StatementList(a, b StatementList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !cmp.Statement(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // RefOfStd does deep equals between the two objects.
 func (cmp *Comparator) RefOfStd(a, b *Std) bool {
 	if a == b {
@@ -4556,6 +5747,18 @@ func (cmp *Comparator) RefOfTimestampFuncExpr(a, b *TimestampFuncExpr) bool {
 		cmp.Expr(a.Expr2, b.Expr2)
 }
 
+// RefOfTriggerOrder does deep equals between the two objects.
+func (cmp *Comparator) RefOfTriggerOrder(a, b *TriggerOrder) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Precedes == b.Precedes &&
+		cmp.IdentifierCI(a.Name, b.Name)
+}
+
 // RefOfTrimFuncExpr does deep equals between the two objects.
 func (cmp *Comparator) RefOfTrimFuncExpr(a, b *TrimFuncExpr) bool {
 	if a == b {
@@ -4581,6 +5784,19 @@ func (cmp *Comparator) RefOfTruncateTable(a, b *TruncateTable) bool {
 	return cmp.TableName(a.Table, b.Table)
 }
 
+// RefOfTypeCastExpr does deep equals between the two objects.
+func (cmp *Comparator) RefOfTypeCastExpr(a, b *TypeCastExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Array == b.Array &&
+		cmp.Expr(a.Expr, b.Expr) &&
+		cmp.RefOfConvertType(a.Type, b.Type)
+}
+
 // RefOfUnaryExpr does deep equals between the two objects.
 func (cmp *Comparator) RefOfUnaryExpr(a, b *UnaryExpr) bool {
 	if a == b {
@@ -4604,6 +5820,7 @@ func (cmp *Comparator) RefOfUnion(a, b *Union) bool {
 	return a.Distinct == b.Distinct &&
 		cmp.SelectStatement(a.Left, b.Left) &&
 		cmp.SelectStatement(a.Right, b.Right) &&
+		a.Type == b.Type &&
 		cmp.OrderBy(a.OrderBy, b.OrderBy) &&
 		cmp.RefOfWith(a.With, b.With) &&
 		cmp.RefOfLimit(a.Limit, b.Limit) &&
@@ -4637,7 +5854,8 @@ func (cmp *Comparator) RefOfUpdate(a, b *Update) bool {
 		cmp.UpdateExprs(a.Exprs, b.Exprs) &&
 		cmp.RefOfWhere(a.Where, b.Where) &&
 		cmp.OrderBy(a.OrderBy, b.OrderBy) &&
-		cmp.RefOfLimit(a.Limit, b.Limit)
+		cmp.RefOfLimit(a.Limit, b.Limit) &&
+		cmp.SelectExprs(a.Returning, b.Returning)
 }
 
 // RefOfUpdateExpr does deep equals between the two objects.
@@ -4689,6 +5907,18 @@ func (cmp *Comparator) RefOfUse(a, b *Use) bool {
 	return cmp.IdentifierCS(a.DBName, b.DBName)
 }
 
+// RefOfUserSpec does deep equals between the two objects.
+func (cmp *Comparator) RefOfUserSpec(a, b *UserSpec) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.RefOfAccount(a.Account, b.Account) &&
+		cmp.RefOfAuthentication(a.Auth, b.Auth)
+}
+
 // RefOfVExplainStmt does deep equals between the two objects.
 func (cmp *Comparator) RefOfVExplainStmt(a, b *VExplainStmt) bool {
 	if a == b {
@@ -4865,6 +6095,19 @@ func (cmp *Comparator) RefOfWhere(a, b *Where) bool {
 		cmp.Expr(a.Expr, b.Expr)
 }
 
+// RefOfWhileStatement does deep equals between the two objects.
+func (cmp *Comparator) RefOfWhileStatement(a, b *WhileStatement) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return cmp.IdentifierCI(a.Label, b.Label) &&
+		cmp.Expr(a.Cond, b.Cond) &&
+		cmp.StatementList(a.Statements, b.Statements)
+}
+
 // RefOfWindowDefinition does deep equals between the two objects.
 func (cmp *Comparator) RefOfWindowDefinition(a, b *WindowDefinition) bool {
 	if a == b {
@@ -5777,6 +7020,24 @@ func (cmp *Comparator) DDLStatement(inA, inB DDLStatement) bool {
 		return false
 	}
 	switch a := inA.(type) {
+	case *AlterEvent:
+		b, ok := inB.(*AlterEvent)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfAlterEvent(a, b)
+	case *AlterFunction:
+		b, ok := inB.(*AlterFunction)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfAlterFunction(a, b)
+	case *AlterProcedure:
+		b, ok := inB.(*AlterProcedure)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfAlterProcedure(a, b)
 	case *AlterTable:
 		b, ok := inB.(*AlterTable)
 		if !ok {
@@ -5789,24 +7050,72 @@ func (cmp *Comparator) DDLStatement(inA, inB DDLStatement) bool {
 			return false
 		}
 		return cmp.RefOfAlterView(a, b)
+	case *CreateEvent:
+		b, ok := inB.(*CreateEvent)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCreateEvent(a, b)
+	case *CreateFunction:
+		b, ok := inB.(*CreateFunction)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCreateFunction(a, b)
+	case *CreateProcedure:
+		b, ok := inB.(*CreateProcedure)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCreateProcedure(a, b)
 	case *CreateTable:
 		b, ok := inB.(*CreateTable)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfCreateTable(a, b)
+	case *CreateTrigger:
+		b, ok := inB.(*CreateTrigger)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCreateTrigger(a, b)
 	case *CreateView:
 		b, ok := inB.(*CreateView)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfCreateView(a, b)
+	case *DropEvent:
+		b, ok := inB.(*DropEvent)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDropEvent(a, b)
+	case *DropFunction:
+		b, ok := inB.(*DropFunction)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDropFunction(a, b)
+	case *DropProcedure:
+		b, ok := inB.(*DropProcedure)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDropProcedure(a, b)
 	case *DropTable:
 		b, ok := inB.(*DropTable)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfDropTable(a, b)
+	case *DropTrigger:
+		b, ok := inB.(*DropTrigger)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDropTrigger(a, b)
 	case *DropView:
 		b, ok := inB.(*DropView)
 		if !ok {
@@ -6425,6 +7734,12 @@ func (cmp *Comparator) Expr(inA, inB Expr) bool {
 			return false
 		}
 		return cmp.RefOfPolygonPropertyFuncExpr(a, b)
+	case *PositionalArg:
+		b, ok := inB.(*PositionalArg)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfPositionalArg(a, b)
 	case *RegexpInstrExpr:
 		b, ok := inB.(*RegexpInstrExpr)
 		if !ok {
@@ -6503,6 +7818,12 @@ func (cmp *Comparator) Expr(inA, inB Expr) bool {
 			return false
 		}
 		return cmp.RefOfTrimFuncExpr(a, b)
+	case *TypeCastExpr:
+		b, ok := inB.(*TypeCastExpr)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfTypeCastExpr(a, b)
 	case *UnaryExpr:
 		b, ok := inB.(*UnaryExpr)
 		if !ok {
@@ -6737,18 +8058,42 @@ func (cmp *Comparator) Statement(inA, inB Statement) bool {
 			return false
 		}
 		return cmp.RefOfAlterDatabase(a, b)
+	case *AlterEvent:
+		b, ok := inB.(*AlterEvent)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfAlterEvent(a, b)
+	case *AlterFunction:
+		b, ok := inB.(*AlterFunction)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfAlterFunction(a, b)
 	case *AlterMigration:
 		b, ok := inB.(*AlterMigration)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfAlterMigration(a, b)
+	case *AlterProcedure:
+		b, ok := inB.(*AlterProcedure)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfAlterProcedure(a, b)
 	case *AlterTable:
 		b, ok := inB.(*AlterTable)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfAlterTable(a, b)
+	case *AlterUser:
+		b, ok := inB.(*AlterUser)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfAlterUser(a, b)
 	case *AlterView:
 		b, ok := inB.(*AlterView)
 		if !ok {
@@ -6761,18 +8106,42 @@ func (cmp *Comparator) Statement(inA, inB Statement) bool {
 			return false
 		}
 		return cmp.RefOfAlterVschema(a, b)
+	case *BadStatement:
+		b, ok := inB.(*BadStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfBadStatement(a, b)
 	case *Begin:
 		b, ok := inB.(*Begin)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfBegin(a, b)
+	case *BeginEndBlock:
+		b, ok := inB.(*BeginEndBlock)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfBeginEndBlock(a, b)
 	case *CallProc:
 		b, ok := inB.(*CallProc)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfCallProc(a, b)
+	case *CaseStatement:
+		b, ok := inB.(*CaseStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCaseStatement(a, b)
+	case *CloseCursor:
+		b, ok := inB.(*CloseCursor)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCloseCursor(a, b)
 	case *CommentOnly:
 		b, ok := inB.(*CommentOnly)
 		if !ok {
@@ -6791,12 +8160,48 @@ func (cmp *Comparator) Statement(inA, inB Statement) bool {
 			return false
 		}
 		return cmp.RefOfCreateDatabase(a, b)
+	case *CreateEvent:
+		b, ok := inB.(*CreateEvent)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCreateEvent(a, b)
+	case *CreateFunction:
+		b, ok := inB.(*CreateFunction)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCreateFunction(a, b)
+	case *CreateProcedure:
+		b, ok := inB.(*CreateProcedure)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCreateProcedure(a, b)
+	case *CreateRole:
+		b, ok := inB.(*CreateRole)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCreateRole(a, b)
 	case *CreateTable:
 		b, ok := inB.(*CreateTable)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfCreateTable(a, b)
+	case *CreateTrigger:
+		b, ok := inB.(*CreateTrigger)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCreateTrigger(a, b)
+	case *CreateUser:
+		b, ok := inB.(*CreateUser)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfCreateUser(a, b)
 	case *CreateView:
 		b, ok := inB.(*CreateView)
 		if !ok {
@@ -6809,6 +8214,30 @@ func (cmp *Comparator) Statement(inA, inB Statement) bool {
 			return false
 		}
 		return cmp.RefOfDeallocateStmt(a, b)
+	case *DeclareCondition:
+		b, ok := inB.(*DeclareCondition)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDeclareCondition(a, b)
+	case *DeclareCursor:
+		b, ok := inB.(*DeclareCursor)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDeclareCursor(a, b)
+	case *DeclareHandler:
+		b, ok := inB.(*DeclareHandler)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDeclareHandler(a, b)
+	case *DeclareVar:
+		b, ok := inB.(*DeclareVar)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDeclareVar(a, b)
 	case *Delete:
 		b, ok := inB.(*Delete)
 		if !ok {
@@ -6821,12 +8250,48 @@ func (cmp *Comparator) Statement(inA, inB Statement) bool {
 			return false
 		}
 		return cmp.RefOfDropDatabase(a, b)
+	case *DropEvent:
+		b, ok := inB.(*DropEvent)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDropEvent(a, b)
+	case *DropFunction:
+		b, ok := inB.(*DropFunction)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDropFunction(a, b)
+	case *DropProcedure:
+		b, ok := inB.(*DropProcedure)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDropProcedure(a, b)
+	case *DropRole:
+		b, ok := inB.(*DropRole)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDropRole(a, b)
 	case *DropTable:
 		b, ok := inB.(*DropTable)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfDropTable(a, b)
+	case *DropTrigger:
+		b, ok := inB.(*DropTrigger)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDropTrigger(a, b)
+	case *DropUser:
+		b, ok := inB.(*DropUser)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfDropUser(a, b)
 	case *DropView:
 		b, ok := inB.(*DropView)
 		if !ok {
@@ -6851,18 +8316,48 @@ func (cmp *Comparator) Statement(inA, inB Statement) bool {
 			return false
 		}
 		return cmp.RefOfExplainTab(a, b)
+	case *FetchCursor:
+		b, ok := inB.(*FetchCursor)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfFetchCursor(a, b)
 	case *Flush:
 		b, ok := inB.(*Flush)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfFlush(a, b)
+	case *Grant:
+		b, ok := inB.(*Grant)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfGrant(a, b)
+	case *IfStatement:
+		b, ok := inB.(*IfStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfIfStatement(a, b)
 	case *Insert:
 		b, ok := inB.(*Insert)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfInsert(a, b)
+	case *IterateStatement:
+		b, ok := inB.(*IterateStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfIterateStatement(a, b)
+	case *LeaveStatement:
+		b, ok := inB.(*LeaveStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfLeaveStatement(a, b)
 	case *Load:
 		b, ok := inB.(*Load)
 		if !ok {
@@ -6875,6 +8370,18 @@ func (cmp *Comparator) Statement(inA, inB Statement) bool {
 			return false
 		}
 		return cmp.RefOfLockTables(a, b)
+	case *LoopStatement:
+		b, ok := inB.(*LoopStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfLoopStatement(a, b)
+	case *OpenCursor:
+		b, ok := inB.(*OpenCursor)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfOpenCursor(a, b)
 	case *OtherAdmin:
 		b, ok := inB.(*OtherAdmin)
 		if !ok {
@@ -6911,18 +8418,48 @@ func (cmp *Comparator) Statement(inA, inB Statement) bool {
 			return false
 		}
 		return cmp.RefOfRenameTable(a, b)
+	case *RenameUser:
+		b, ok := inB.(*RenameUser)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfRenameUser(a, b)
+	case *RepeatStatement:
+		b, ok := inB.(*RepeatStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfRepeatStatement(a, b)
+	case *ReturnStatement:
+		b, ok := inB.(*ReturnStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfReturnStatement(a, b)
 	case *RevertMigration:
 		b, ok := inB.(*RevertMigration)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfRevertMigration(a, b)
+	case *Revoke:
+		b, ok := inB.(*Revoke)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfRevoke(a, b)
 	case *Rollback:
 		b, ok := inB.(*Rollback)
 		if !ok {
 			return false
 		}
 		return cmp.RefOfRollback(a, b)
+	case *RoutineSet:
+		b, ok := inB.(*RoutineSet)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfRoutineSet(a, b)
 	case *SRollback:
 		b, ok := inB.(*SRollback)
 		if !ok {
@@ -6947,6 +8484,24 @@ func (cmp *Comparator) Statement(inA, inB Statement) bool {
 			return false
 		}
 		return cmp.RefOfSet(a, b)
+	case *SetDefaultRole:
+		b, ok := inB.(*SetDefaultRole)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfSetDefaultRole(a, b)
+	case *SetPassword:
+		b, ok := inB.(*SetPassword)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfSetPassword(a, b)
+	case *SetRole:
+		b, ok := inB.(*SetRole)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfSetRole(a, b)
 	case *Show:
 		b, ok := inB.(*Show)
 		if !ok {
@@ -6971,6 +8526,12 @@ func (cmp *Comparator) Statement(inA, inB Statement) bool {
 			return false
 		}
 		return cmp.RefOfShowThrottlerStatus(a, b)
+	case *Signal:
+		b, ok := inB.(*Signal)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfSignal(a, b)
 	case *Stream:
 		b, ok := inB.(*Stream)
 		if !ok {
@@ -7019,6 +8580,12 @@ func (cmp *Comparator) Statement(inA, inB Statement) bool {
 			return false
 		}
 		return cmp.RefOfVStream(a, b)
+	case *WhileStatement:
+		b, ok := inB.(*WhileStatement)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfWhileStatement(a, b)
 	default:
 		// this should never happen
 		return false
@@ -7101,6 +8668,19 @@ func (cmp *Comparator) SliceOfDatabaseOption(a, b []DatabaseOption) bool {
 	return true
 }
 
+// SliceOfRefOfRoutineCharacteristic does deep equals between the two objects.
+func (cmp *Comparator) SliceOfRefOfRoutineCharacteristic(a, b []*RoutineCharacteristic) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !cmp.RefOfRoutineCharacteristic(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // SliceOfAlterOption does deep equals between the two objects.
 func (cmp *Comparator) SliceOfAlterOption(a, b []AlterOption) bool {
 	if len(a) != len(b) {
@@ -7114,6 +8694,19 @@ func (cmp *Comparator) SliceOfAlterOption(a, b []AlterOption) bool {
 	return true
 }
 
+// SliceOfRefOfUserSpec does deep equals between the two objects.
+func (cmp *Comparator) SliceOfRefOfUserSpec(a, b []*UserSpec) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !cmp.RefOfUserSpec(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // SliceOfIdentifierCI does deep equals between the two objects.
 func (cmp *Comparator) SliceOfIdentifierCI(a, b []IdentifierCI) bool {
 	if len(a) != len(b) {
@@ -7153,6 +8746,19 @@ func (cmp *Comparator) SliceOfRefOfWhen(a, b []*When) bool {
 	return true
 }
 
+// SliceOfRefOfCaseStatementWhen does deep equals between the two objects.
+func (cmp *Comparator) SliceOfRefOfCaseStatementWhen(a, b []*CaseStatementWhen) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !cmp.RefOfCaseStatementWhen(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // RefOfColumnTypeOptions does deep equals between the two objects.
 func (cmp *Comparator) RefOfColumnTypeOptions(a, b *ColumnTypeOptions) bool {
 	if a == b {
@@ -7197,6 +8803,45 @@ func (cmp *Comparator) SliceOfString(a, b []string) bool {
 	return true
 }
 
+// SliceOfRefOfProcParameter does deep equals between the two objects.
+func (cmp *Comparator) SliceOfRefOfProcParameter(a, b []*ProcParameter) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !cmp.RefOfProcParameter(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// SliceOfRefOfAccount does deep equals between the two objects.
+func (cmp *Comparator) SliceOfRefOfAccount(a, b []*Account) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !cmp.RefOfAccount(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// SliceOfRefOfConditionValue does deep equals between the two objects.
+func (cmp *Comparator) SliceOfRefOfConditionValue(a, b []*ConditionValue) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !cmp.RefOfConditionValue(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // SliceOfRefOfVariable does deep equals between the two objects.
 func (cmp *Comparator) SliceOfRefOfVariable(a, b []*Variable) bool {
 	if len(a) != len(b) {
@@ -7210,6 +8855,19 @@ func (cmp *Comparator) SliceOfRefOfVariable(a, b []*Variable) bool {
 	return true
 }
 
+// SliceOfRefOfGrantPrivilege does deep equals between the two objects.
+func (cmp *Comparator) SliceOfRefOfGrantPrivilege(a, b []*GrantPrivilege) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !cmp.RefOfGrantPrivilege(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // RefOfIdentifierCI does deep equals between the two objects.
 func (cmp *Comparator) RefOfIdentifierCI(a, b *IdentifierCI) bool {
 	if a == b {
@@ -7233,6 +8891,19 @@ func (cmp *Comparator) RefOfIdentifierCS(a, b *IdentifierCS) bool {
 	return a.v == b.v
 }
 
+// SliceOfRefOfElseIfBlock does deep equals between the two objects.
+func (cmp *Comparator) SliceOfRefOfElseIfBlock(a, b []*ElseIfBlock) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !cmp.RefOfElseIfBlock(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // SliceOfRefOfIndexColumn does deep equals between the two objects.
 func (cmp *Comparator) SliceOfRefOfIndexColumn(a, b []*IndexColumn) bool {
 	if len(a) != len(b) {
@@ -7413,6 +9084,19 @@ func (cmp *Comparator) SliceOfRefOfRenameTablePair(a, b []*RenameTablePair) bool
 	return true
 }
 
+// SliceOfRefOfRenameUserPair does deep equals between the two objects.
+func (cmp *Comparator) SliceOfRefOfRenameUserPair(a, b []*RenameUserPair) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !cmp.RefOfRenameUserPair(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // RefOfRootNode does deep equals between the two objects.
 func (cmp *Comparator) RefOfRootNode(a, b *RootNode) bool {
 	if a == b {
@@ -7437,6 +9121,19 @@ func (cmp *Comparator) SliceOfTableExpr(a, b []TableExpr) bool {
 	return true
 }
 
+// SliceOfRefOfSignalItem does deep equals between the two objects.
+func (cmp *Comparator) SliceOfRefOfSignalItem(a, b []*SignalItem) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !cmp.RefOfSignalItem(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // RefOfTableName does deep equals between the two objects.
 func (cmp *Comparator) RefOfTableName(a, b *TableName) bool {
 	if a == b {
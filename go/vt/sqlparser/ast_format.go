@@ -44,12 +44,16 @@ func (node *Select) Format(buf *TrackedBuffer) {
 		buf.literal(SQLCalcFoundRowsStr)
 	}
 
-	buf.astPrintf(node, "%v from ", node.SelectExprs)
-
-	prefix := ""
-	for _, expr := range node.From {
-		buf.astPrintf(node, "%s%v", prefix, expr)
-		prefix = ", "
+	buf.astPrintf(node, "%v", node.SelectExprs)
+
+	// PostgreSQL has no dual table, a SELECT without FROM clause is enough
+	if !buf.postgres() || !isDual(node.From) {
+		buf.literal(" from ")
+		prefix := ""
+		for _, expr := range node.From {
+			buf.astPrintf(node, "%s%v", prefix, expr)
+			prefix = ", "
+		}
 	}
 
 	buf.astPrintf(node, "%v%v%v",
@@ -72,29 +76,34 @@ func (node *CommentOnly) Format(buf *TrackedBuffer) {
 	}
 }
 
+// Format formats the node.
+func (node *BadStatement) Format(buf *TrackedBuffer) {
+	buf.WriteString(node.SQL)
+}
+
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	if requiresParen(node.Left) {
+	if node.With != nil {
+		buf.astPrintf(node, "%v", node.With)
+	}
+	leftParens, rightParens := setOpParens(node)
+	if leftParens {
 		buf.astPrintf(node, "(%v)", node.Left)
 	} else {
 		buf.astPrintf(node, "%v", node.Left)
 	}
 
 	buf.WriteByte(' ')
-	if node.Distinct {
-		buf.literal(UnionStr)
-	} else {
-		buf.literal(UnionAllStr)
-	}
+	buf.literal(node.operator())
 	buf.WriteByte(' ')
 
-	if requiresParen(node.Right) {
+	if rightParens {
 		buf.astPrintf(node, "(%v)", node.Right)
 	} else {
 		buf.astPrintf(node, "%v", node.Right)
 	}
 
-	buf.astPrintf(node, "%v%v%s", node.OrderBy, node.Limit, node.Lock.ToString())
+	buf.astPrintf(node, "%v%v%s%v", node.OrderBy, node.Limit, node.Lock.ToString(), node.Into)
 }
 
 // Format formats the node.
@@ -128,7 +137,12 @@ func (node *Insert) Format(buf *TrackedBuffer) {
 			node.Comments, node.Ignore.ToString(),
 			node.Table.Expr, node.Partitions, node.Columns, node.Rows, node.OnDup)
 	}
-
+	if node.OnConflict != nil {
+		buf.astPrintf(node, "%v", node.OnConflict)
+	}
+	if node.Returning != nil {
+		buf.astPrintf(node, " returning %v", node.Returning)
+	}
 }
 
 // Format formats the node.
@@ -158,6 +172,9 @@ func (node *Update) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "update %v%s%v set %v%v%v%v",
 		node.Comments, node.Ignore.ToString(), node.TableExprs,
 		node.Exprs, node.Where, node.OrderBy, node.Limit)
+	if node.Returning != nil {
+		buf.astPrintf(node, " returning %v", node.Returning)
+	}
 }
 
 // Format formats the node.
@@ -173,6 +190,9 @@ func (node *Delete) Format(buf *TrackedBuffer) {
 		buf.astPrintf(node, "%v ", node.Targets)
 	}
 	buf.astPrintf(node, "from %v%v%v%v%v", node.TableExprs, node.Partitions, node.Where, node.OrderBy, node.Limit)
+	if node.Returning != nil {
+		buf.astPrintf(node, " returning %v", node.Returning)
+	}
 }
 
 // Format formats the node.
@@ -632,14 +652,20 @@ func (ts *TableSpec) Format(buf *TrackedBuffer) {
 		if i == 0 {
 			buf.astPrintf(ts, "\t%v", col)
 		} else {
-			buf.astPrintf(ts, ",\n\t%v", col)
+			buf.astPrintf(ts, ",")
+			buf.writeTrailingComments()
+			buf.astPrintf(ts, "\n\t%v", col)
 		}
 	}
 	for _, idx := range ts.Indexes {
-		buf.astPrintf(ts, ",\n\t%v", idx)
+		buf.astPrintf(ts, ",")
+		buf.writeTrailingComments()
+		buf.astPrintf(ts, "\n\t%v", idx)
 	}
 	for _, c := range ts.Constraints {
-		buf.astPrintf(ts, ",\n\t%v", c)
+		buf.astPrintf(ts, ",")
+		buf.writeTrailingComments()
+		buf.astPrintf(ts, "\n\t%v", c)
 	}
 
 	buf.astPrintf(ts, "\n)")
@@ -1297,7 +1323,7 @@ func (node *AssignmentExpr) Format(buf *TrackedBuffer) {
 func (node *Literal) Format(buf *TrackedBuffer) {
 	switch node.Type {
 	case StrVal:
-		sqltypes.MakeTrusted(sqltypes.VarBinary, node.Bytes()).EncodeSQL(buf)
+		writeStrVal(buf, node.Bytes())
 	case IntVal, FloatVal, DecimalVal, HexNum:
 		buf.astPrintf(node, "%#s", node.Val)
 	case HexVal:
@@ -1315,6 +1341,11 @@ func (node *Literal) Format(buf *TrackedBuffer) {
 	}
 }
 
+// Format formats the node.
+func (node *PositionalArg) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "$%d", node.Index)
+}
+
 // Format formats the node.
 func (node *Argument) Format(buf *TrackedBuffer) {
 	buf.WriteArg(":", node.Name)
@@ -1823,6 +1854,14 @@ func (node *CastExpr) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, ")")
 }
 
+// Format formats the node.
+func (node *TypeCastExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%l::%v", node.Expr, node.Type)
+	if node.Array {
+		buf.literal("[]")
+	}
+}
+
 // Format formats the node.
 func (node *ConvertExpr) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "convert(%v, %v)", node.Expr, node.Type)
@@ -1991,6 +2030,22 @@ func (node OnDup) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, " on duplicate key update %v", UpdateExprs(node))
 }
 
+// Format formats the node.
+func (node *OnConflict) Format(buf *TrackedBuffer) {
+	buf.literal(" on conflict")
+	if node.Columns != nil {
+		buf.astPrintf(node, " %v", node.Columns)
+	}
+	if !node.Constraint.IsEmpty() {
+		buf.astPrintf(node, " on constraint %v", node.Constraint)
+	}
+	if node.DoNothing {
+		buf.literal(" do nothing")
+		return
+	}
+	buf.astPrintf(node, " do update set %v%v", node.Exprs, node.Where)
+}
+
 // Format formats the node.
 func (node IdentifierCI) Format(buf *TrackedBuffer) {
 	if node.IsEmpty() {
@@ -2006,7 +2061,296 @@ func (node IdentifierCS) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *Load) Format(buf *TrackedBuffer) {
-	buf.literal("AST node missing for Load type")
+	buf.astPrintf(node, "load data %s", node.Priority.ToString())
+	if node.Local {
+		buf.literal("local ")
+	}
+	buf.literal("infile ")
+	writeStrVal(buf, []byte(node.FileName))
+	if node.Replace {
+		buf.literal(" replace")
+	} else if node.Ignore {
+		buf.literal(" ignore")
+	}
+	buf.astPrintf(node, " into table %v%v", node.Table, node.Partitions)
+	if node.Charset.Name != "" {
+		buf.astPrintf(node, " character set %#s", node.Charset.Name)
+	}
+	buf.astPrintf(node, "%v%v", node.Fields, node.Lines)
+	if node.IgnoreLines != nil {
+		buf.astPrintf(node, " ignore %v lines", node.IgnoreLines)
+	}
+	if node.Columns != nil {
+		buf.astPrintf(node, " (%v)", node.Columns)
+	}
+	if len(node.SetExprs) > 0 {
+		buf.astPrintf(node, " set %v", node.SetExprs)
+	}
+}
+
+// Format formats the node.
+func (node *LoadFields) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.literal(" fields")
+	if node.TerminatedBy != nil {
+		buf.astPrintf(node, " terminated by %v", node.TerminatedBy)
+	}
+	if node.EnclosedBy != nil {
+		if node.OptionallyEnclosed {
+			buf.literal(" optionally")
+		}
+		buf.astPrintf(node, " enclosed by %v", node.EnclosedBy)
+	}
+	if node.EscapedBy != nil {
+		buf.astPrintf(node, " escaped by %v", node.EscapedBy)
+	}
+}
+
+// Format formats the node.
+func (node *Grant) Format(buf *TrackedBuffer) {
+	buf.literal("grant ")
+	formatPrivileges(buf, node.Privileges)
+	formatAccounts(buf, node.Roles)
+	if node.Proxy != nil {
+		buf.astPrintf(node, "proxy on %v", node.Proxy)
+	}
+	if node.On != nil {
+		buf.astPrintf(node, " on %v", node.On)
+	}
+	buf.literal(" to ")
+	formatAccounts(buf, node.To)
+	if node.WithGrantOption {
+		buf.literal(" with grant option")
+	}
+	if node.WithAdminOption {
+		buf.literal(" with admin option")
+	}
+}
+
+// Format formats the node.
+func (node *Revoke) Format(buf *TrackedBuffer) {
+	buf.literal("revoke ")
+	if node.IfExists {
+		buf.literal("if exists ")
+	}
+	formatPrivileges(buf, node.Privileges)
+	formatAccounts(buf, node.Roles)
+	if node.Proxy != nil {
+		buf.astPrintf(node, "proxy on %v", node.Proxy)
+	}
+	if node.On != nil {
+		buf.astPrintf(node, " on %v", node.On)
+	}
+	buf.literal(" from ")
+	formatAccounts(buf, node.From)
+	if node.IgnoreUnknownUser {
+		buf.literal(" ignore unknown user")
+	}
+}
+
+// Format formats the node.
+func (node *GrantPrivilege) Format(buf *TrackedBuffer) {
+	buf.literal(node.Name)
+	if node.Columns != nil {
+		buf.astPrintf(node, " %v", node.Columns)
+	}
+}
+
+// Format formats the node.
+func (node *PrivilegeLevel) Format(buf *TrackedBuffer) {
+	buf.literal(node.ObjectType.ToString())
+	switch {
+	case node.Global:
+		buf.literal("*.*")
+	case node.Name.IsEmpty() && node.DB.IsEmpty():
+		buf.WriteByte('*')
+	case node.Name.IsEmpty():
+		buf.astPrintf(node, "%v.*", node.DB)
+	case node.DB.IsEmpty():
+		buf.astPrintf(node, "%v", node.Name)
+	default:
+		buf.astPrintf(node, "%v.%v", node.DB, node.Name)
+	}
+}
+
+// Format formats the node.
+func (node *Account) Format(buf *TrackedBuffer) {
+	if node.CurrentUser {
+		buf.literal("user()")
+		return
+	}
+	writeStrVal(buf, []byte(node.User))
+	if node.Host != "" {
+		buf.WriteByte('@')
+		writeStrVal(buf, []byte(node.Host))
+	}
+}
+
+// Format formats the node.
+func (node *Authentication) Format(buf *TrackedBuffer) {
+	buf.literal("identified")
+	if !node.Plugin.IsEmpty() {
+		buf.astPrintf(node, " with %v", node.Plugin)
+	}
+	switch {
+	case node.RandomPassword:
+		buf.literal(" by random password")
+	case node.Password == nil:
+	case node.Hashed:
+		buf.astPrintf(node, " as %v", node.Password)
+	default:
+		buf.astPrintf(node, " by %v", node.Password)
+	}
+	if node.CurrentPassword != nil {
+		buf.astPrintf(node, " replace %v", node.CurrentPassword)
+	}
+}
+
+// Format formats the node.
+func (node *UserSpec) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v", node.Account)
+	if node.Auth != nil {
+		buf.astPrintf(node, " %v", node.Auth)
+	}
+}
+
+// Format formats the node.
+func (node *CreateUser) Format(buf *TrackedBuffer) {
+	buf.literal("create user ")
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	for i, user := range node.Users {
+		if i != 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", user)
+	}
+	if len(node.DefaultRoles) > 0 {
+		buf.literal(" default role ")
+		formatAccounts(buf, node.DefaultRoles)
+	}
+	if node.PasswordExpire != nil {
+		buf.astPrintf(node, " %v", node.PasswordExpire)
+	}
+	buf.literal(node.AccountLock.ToString())
+}
+
+// Format formats the node.
+func (node *PasswordExpire) Format(buf *TrackedBuffer) {
+	buf.literal(node.Type.ToString())
+	if node.Type == PasswordExpireInterval {
+		buf.astPrintf(node, " %v day", node.Days)
+	}
+}
+
+// Format formats the node.
+func (node *AlterUser) Format(buf *TrackedBuffer) {
+	buf.literal("alter user ")
+	if node.IfExists {
+		buf.literal("if exists ")
+	}
+	for i, user := range node.Users {
+		if i != 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", user)
+	}
+	if node.PasswordExpire != nil {
+		buf.astPrintf(node, " %v", node.PasswordExpire)
+	}
+	buf.literal(node.AccountLock.ToString())
+}
+
+// Format formats the node.
+func (node *DropUser) Format(buf *TrackedBuffer) {
+	buf.literal("drop user ")
+	if node.IfExists {
+		buf.literal("if exists ")
+	}
+	formatAccounts(buf, node.Users)
+}
+
+// Format formats the node.
+func (node *RenameUserPair) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v to %v", node.FromUser, node.ToUser)
+}
+
+// Format formats the node.
+func (node *RenameUser) Format(buf *TrackedBuffer) {
+	buf.literal("rename user ")
+	for i, pair := range node.UserPairs {
+		if i != 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", pair)
+	}
+}
+
+// Format formats the node.
+func (node *CreateRole) Format(buf *TrackedBuffer) {
+	buf.literal("create role ")
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	formatAccounts(buf, node.Roles)
+}
+
+// Format formats the node.
+func (node *DropRole) Format(buf *TrackedBuffer) {
+	buf.literal("drop role ")
+	if node.IfExists {
+		buf.literal("if exists ")
+	}
+	formatAccounts(buf, node.Roles)
+}
+
+// Format formats the node.
+func (node *SetRole) Format(buf *TrackedBuffer) {
+	buf.literal("set role ")
+	buf.literal(node.Type.ToString())
+	if node.Type == AllRoles && len(node.Roles) > 0 {
+		buf.literal(" except ")
+	}
+	formatAccounts(buf, node.Roles)
+}
+
+// Format formats the node.
+func (node *SetDefaultRole) Format(buf *TrackedBuffer) {
+	buf.literal("set default role ")
+	buf.literal(node.Type.ToString())
+	formatAccounts(buf, node.Roles)
+	buf.literal(" to ")
+	formatAccounts(buf, node.To)
+}
+
+// Format formats the node.
+func (node *SetPassword) Format(buf *TrackedBuffer) {
+	buf.literal("set password")
+	if node.For != nil {
+		buf.astPrintf(node, " for %v", node.For)
+	}
+	if node.Password == nil {
+		buf.literal(" to random")
+	} else {
+		buf.astPrintf(node, " = %v", node.Password)
+	}
+}
+
+// Format formats the node.
+func (node *LoadLines) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.literal(" lines")
+	if node.StartingBy != nil {
+		buf.astPrintf(node, " starting by %v", node.StartingBy)
+	}
+	if node.TerminatedBy != nil {
+		buf.astPrintf(node, " terminated by %v", node.TerminatedBy)
+	}
 }
 
 // Format formats the node.
@@ -2040,6 +2384,10 @@ func (node *SelectInto) Format(buf *TrackedBuffer) {
 	if node == nil {
 		return
 	}
+	if node.Type == IntoVariables {
+		buf.astPrintf(node, "%s%v", node.Type.ToString(), node.Variables)
+		return
+	}
 	buf.astPrintf(node, "%s%#s", node.Type.ToString(), node.FileName)
 	if node.Charset.Name != "" {
 		buf.astPrintf(node, " character set %#s", node.Charset.Name)
@@ -2192,6 +2540,448 @@ func (node *DropView) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "view%s %v", exists, node.FromTables)
 }
 
+// Format formats the node.
+func (node *CreateProcedure) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %v", node.Comments)
+	if node.Definer != nil {
+		buf.astPrintf(node, "definer = %v ", node.Definer)
+	}
+	buf.literal("procedure ")
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	buf.astPrintf(node, "%v(", node.Name)
+	for i, param := range node.Params {
+		if i != 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", param)
+	}
+	buf.WriteByte(')')
+	for _, characteristic := range node.Characteristics {
+		buf.astPrintf(node, " %v", characteristic)
+	}
+	buf.astPrintf(node, " %v", node.Body)
+}
+
+// Format formats the node.
+func (node *CreateFunction) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %v", node.Comments)
+	if node.Definer != nil {
+		buf.astPrintf(node, "definer = %v ", node.Definer)
+	}
+	buf.literal("function ")
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	buf.astPrintf(node, "%v(", node.Name)
+	for i, param := range node.Params {
+		if i != 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", param)
+	}
+	buf.astPrintf(node, ") returns %v", node.Returns)
+	for _, characteristic := range node.Characteristics {
+		buf.astPrintf(node, " %v", characteristic)
+	}
+	buf.astPrintf(node, " %v", node.Body)
+}
+
+// Format formats the node.
+func (node *AlterProcedure) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "alter %vprocedure %v", node.Comments, node.Name)
+	for _, characteristic := range node.Characteristics {
+		buf.astPrintf(node, " %v", characteristic)
+	}
+}
+
+// Format formats the node.
+func (node *AlterFunction) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "alter %vfunction %v", node.Comments, node.Name)
+	for _, characteristic := range node.Characteristics {
+		buf.astPrintf(node, " %v", characteristic)
+	}
+}
+
+// Format formats the node.
+func (node *DropProcedure) Format(buf *TrackedBuffer) {
+	exists := ""
+	if node.IfExists {
+		exists = " if exists"
+	}
+	buf.astPrintf(node, "drop %vprocedure%s %v", node.Comments, exists, node.Name)
+}
+
+// Format formats the node.
+func (node *DropFunction) Format(buf *TrackedBuffer) {
+	exists := ""
+	if node.IfExists {
+		exists = " if exists"
+	}
+	buf.astPrintf(node, "drop %vfunction%s %v", node.Comments, exists, node.Name)
+}
+
+// Format formats the node.
+func (node *ProcParameter) Format(buf *TrackedBuffer) {
+	if node.Mode != DefaultParameterMode {
+		buf.astPrintf(node, "%s ", node.Mode.ToString())
+	}
+	buf.astPrintf(node, "%v %v", node.Name, node.Type)
+}
+
+// Format formats the node.
+func (node *RoutineCharacteristic) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s", node.Type.ToString())
+	if node.Type == CommentCharacteristic {
+		buf.WriteByte(' ')
+		writeStrVal(buf, []byte(node.Comment))
+	}
+}
+
+// Format formats the node.
+func (node *CreateTrigger) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %v", node.Comments)
+	if node.Definer != nil {
+		buf.astPrintf(node, "definer = %v ", node.Definer)
+	}
+	buf.literal("trigger ")
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	buf.astPrintf(node, "%v %s %s on %v for each row", node.Name, node.Timing.ToString(), node.Event.ToString(), node.Table)
+	if node.Order != nil {
+		buf.astPrintf(node, " %v", node.Order)
+	}
+	buf.astPrintf(node, " %v", node.Body)
+}
+
+// Format formats the node.
+func (node *DropTrigger) Format(buf *TrackedBuffer) {
+	exists := ""
+	if node.IfExists {
+		exists = " if exists"
+	}
+	buf.astPrintf(node, "drop %vtrigger%s %v", node.Comments, exists, node.Name)
+}
+
+// Format formats the node.
+func (node *TriggerOrder) Format(buf *TrackedBuffer) {
+	if node.Precedes {
+		buf.astPrintf(node, "precedes %v", node.Name)
+	} else {
+		buf.astPrintf(node, "follows %v", node.Name)
+	}
+}
+
+// Format formats the node.
+func (node *CreateEvent) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %v", node.Comments)
+	if node.Definer != nil {
+		buf.astPrintf(node, "definer = %v ", node.Definer)
+	}
+	buf.literal("event ")
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	buf.astPrintf(node, "%v on schedule %v", node.Name, node.Schedule)
+	if node.OnCompletion != NoOnCompletion {
+		buf.astPrintf(node, " %s", node.OnCompletion.ToString())
+	}
+	if node.Status != NoEventStatus {
+		buf.astPrintf(node, " %s", node.Status.ToString())
+	}
+	if node.Comment != nil {
+		buf.astPrintf(node, " comment %v", node.Comment)
+	}
+	buf.astPrintf(node, " do %v", node.Body)
+}
+
+// Format formats the node.
+func (node *AlterEvent) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "alter %v", node.Comments)
+	if node.Definer != nil {
+		buf.astPrintf(node, "definer = %v ", node.Definer)
+	}
+	buf.astPrintf(node, "event %v", node.Name)
+	if node.Schedule != nil {
+		buf.astPrintf(node, " on schedule %v", node.Schedule)
+	}
+	if node.OnCompletion != NoOnCompletion {
+		buf.astPrintf(node, " %s", node.OnCompletion.ToString())
+	}
+	if !node.RenameTo.IsEmpty() {
+		buf.astPrintf(node, " rename to %v", node.RenameTo)
+	}
+	if node.Status != NoEventStatus {
+		buf.astPrintf(node, " %s", node.Status.ToString())
+	}
+	if node.Comment != nil {
+		buf.astPrintf(node, " comment %v", node.Comment)
+	}
+	if node.Body != nil {
+		buf.astPrintf(node, " do %v", node.Body)
+	}
+}
+
+// Format formats the node.
+func (node *DropEvent) Format(buf *TrackedBuffer) {
+	exists := ""
+	if node.IfExists {
+		exists = " if exists"
+	}
+	buf.astPrintf(node, "drop %vevent%s %v", node.Comments, exists, node.Name)
+}
+
+// Format formats the node.
+func (node *EventSchedule) Format(buf *TrackedBuffer) {
+	if node.At != nil {
+		buf.astPrintf(node, "at %v", node.At)
+	} else {
+		buf.astPrintf(node, "every %v %s", node.Every, node.Unit.ToString())
+	}
+	if node.Starts != nil {
+		buf.astPrintf(node, " starts %v", node.Starts)
+	}
+	if node.Ends != nil {
+		buf.astPrintf(node, " ends %v", node.Ends)
+	}
+}
+
+// Format formats the node.
+func (node StatementList) Format(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v;", prefix, n)
+		prefix = " "
+	}
+}
+
+// Format formats the node.
+func (node *BeginEndBlock) Format(buf *TrackedBuffer) {
+	if !node.Label.IsEmpty() {
+		buf.astPrintf(node, "%v: ", node.Label)
+	}
+	buf.literal("begin")
+	if len(node.Statements) > 0 {
+		buf.astPrintf(node, " %v", node.Statements)
+	}
+	buf.literal(" end")
+	if !node.Label.IsEmpty() {
+		buf.astPrintf(node, " %v", node.Label)
+	}
+}
+
+// Format formats the node.
+func (node *DeclareVar) Format(buf *TrackedBuffer) {
+	buf.literal("declare ")
+	for i, name := range node.Names {
+		if i != 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", name)
+	}
+	buf.astPrintf(node, " %v", node.Type)
+	if node.Default != nil {
+		buf.astPrintf(node, " default %v", node.Default)
+	}
+}
+
+// Format formats the node.
+func (node *DeclareCondition) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "declare %v condition for %v", node.Name, node.Value)
+}
+
+// Format formats the node.
+func (node *DeclareCursor) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "declare %v cursor for %v", node.Name, node.Select)
+}
+
+// Format formats the node.
+func (node *DeclareHandler) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "declare %s handler for ", node.Action.ToString())
+	for i, condition := range node.Conditions {
+		if i != 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", condition)
+	}
+	buf.astPrintf(node, " %v", node.Statement)
+}
+
+// Format formats the node.
+func (node *ConditionValue) Format(buf *TrackedBuffer) {
+	switch node.Type {
+	case ErrorCodeCondition:
+		buf.astPrintf(node, "%#s", node.Value)
+	case SQLStateCondition:
+		buf.literal("sqlstate ")
+		writeStrVal(buf, []byte(node.Value))
+	case NamedCondition:
+		buf.astPrintf(node, "%v", node.Name)
+	case SQLWarningCondition:
+		buf.literal(SQLWarningConditionStr)
+	case NotFoundCondition:
+		buf.literal(NotFoundConditionStr)
+	case SQLExceptionCondition:
+		buf.literal(SQLExceptionConditionStr)
+	}
+}
+
+// Format formats the node.
+func (node *IfStatement) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "if %v then %v", node.Cond, node.Statements)
+	for _, elseIf := range node.ElseIfs {
+		buf.astPrintf(node, " %v", elseIf)
+	}
+	if len(node.Else) > 0 {
+		buf.astPrintf(node, " else %v", node.Else)
+	}
+	buf.literal(" end if")
+}
+
+// Format formats the node.
+func (node *ElseIfBlock) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "elseif %v then %v", node.Cond, node.Statements)
+}
+
+// Format formats the node.
+func (node *CaseStatement) Format(buf *TrackedBuffer) {
+	buf.literal("case")
+	if node.Expr != nil {
+		buf.astPrintf(node, " %v", node.Expr)
+	}
+	for _, when := range node.Whens {
+		buf.astPrintf(node, " %v", when)
+	}
+	if len(node.Else) > 0 {
+		buf.astPrintf(node, " else %v", node.Else)
+	}
+	buf.literal(" end case")
+}
+
+// Format formats the node.
+func (node *CaseStatementWhen) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "when %v then %v", node.Cond, node.Statements)
+}
+
+// Format formats the node.
+func (node *LoopStatement) Format(buf *TrackedBuffer) {
+	if !node.Label.IsEmpty() {
+		buf.astPrintf(node, "%v: ", node.Label)
+	}
+	buf.astPrintf(node, "loop %v end loop", node.Statements)
+	if !node.Label.IsEmpty() {
+		buf.astPrintf(node, " %v", node.Label)
+	}
+}
+
+// Format formats the node.
+func (node *WhileStatement) Format(buf *TrackedBuffer) {
+	if !node.Label.IsEmpty() {
+		buf.astPrintf(node, "%v: ", node.Label)
+	}
+	buf.astPrintf(node, "while %v do %v end while", node.Cond, node.Statements)
+	if !node.Label.IsEmpty() {
+		buf.astPrintf(node, " %v", node.Label)
+	}
+}
+
+// Format formats the node.
+func (node *RepeatStatement) Format(buf *TrackedBuffer) {
+	if !node.Label.IsEmpty() {
+		buf.astPrintf(node, "%v: ", node.Label)
+	}
+	buf.astPrintf(node, "repeat %v until %v end repeat", node.Statements, node.Until)
+	if !node.Label.IsEmpty() {
+		buf.astPrintf(node, " %v", node.Label)
+	}
+}
+
+// Format formats the node.
+func (node *LeaveStatement) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "leave %v", node.Label)
+}
+
+// Format formats the node.
+func (node *IterateStatement) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "iterate %v", node.Label)
+}
+
+// Format formats the node.
+func (node *RoutineSet) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "set %v%v", node.Comments, node.Exprs)
+}
+
+// Format formats the node.
+func (node RoutineSetExprs) Format(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+// Format formats the node.
+func (node *RoutineSetExpr) Format(buf *TrackedBuffer) {
+	if variable, ok := node.Var.(*Variable); ok && (variable.Name.EqualString("charset") || variable.Name.EqualString("names")) {
+		buf.astPrintf(node, "%s %v", variable.Name.String(), node.Expr)
+		return
+	}
+	buf.astPrintf(node, "%v = %v", node.Var, node.Expr)
+}
+
+// Format formats the node.
+func (node *ReturnStatement) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "return %v", node.Expr)
+}
+
+// Format formats the node.
+func (node *OpenCursor) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "open %v", node.Name)
+}
+
+// Format formats the node.
+func (node *CloseCursor) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "close %v", node.Name)
+}
+
+// Format formats the node.
+func (node *FetchCursor) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "fetch %v into ", node.Name)
+	for i, variable := range node.Variables {
+		if i != 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", variable)
+	}
+}
+
+// Format formats the node.
+func (node *Signal) Format(buf *TrackedBuffer) {
+	if node.Resignal {
+		buf.literal("resignal")
+	} else {
+		buf.literal("signal")
+	}
+	if node.Condition != nil {
+		buf.astPrintf(node, " %v", node.Condition)
+	}
+	for i, item := range node.Items {
+		if i == 0 {
+			buf.literal(" set ")
+		} else {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", item)
+	}
+}
+
+// Format formats the node.
+func (node *SignalItem) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s = %v", node.Name, node.Value)
+}
+
 // Format formats the AlterTable node.
 func (node *AlterTable) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "alter %vtable %v", node.Comments, node.Table)
@@ -49,13 +49,16 @@ func (node *Select) formatFast(buf *TrackedBuffer) {
 	}
 
 	node.SelectExprs.formatFast(buf)
-	buf.WriteString(" from ")
 
-	prefix := ""
-	for _, expr := range node.From {
-		buf.WriteString(prefix)
-		expr.formatFast(buf)
-		prefix = ", "
+	// PostgreSQL has no dual table, a SELECT without FROM clause is enough
+	if !buf.postgres() || !isDual(node.From) {
+		buf.WriteString(" from ")
+		prefix := ""
+		for _, expr := range node.From {
+			buf.WriteString(prefix)
+			expr.formatFast(buf)
+			prefix = ", "
+		}
 	}
 
 	node.Where.formatFast(buf)
@@ -84,9 +87,18 @@ func (node *CommentOnly) formatFast(buf *TrackedBuffer) {
 	}
 }
 
+// formatFast formats the node.
+func (node *BadStatement) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.SQL)
+}
+
 // formatFast formats the node.
 func (node *Union) formatFast(buf *TrackedBuffer) {
-	if requiresParen(node.Left) {
+	if node.With != nil {
+		node.With.formatFast(buf)
+	}
+	leftParens, rightParens := setOpParens(node)
+	if leftParens {
 		buf.WriteByte('(')
 		node.Left.formatFast(buf)
 		buf.WriteByte(')')
@@ -95,14 +107,10 @@ func (node *Union) formatFast(buf *TrackedBuffer) {
 	}
 
 	buf.WriteByte(' ')
-	if node.Distinct {
-		buf.WriteString(UnionStr)
-	} else {
-		buf.WriteString(UnionAllStr)
-	}
+	buf.WriteString(node.operator())
 	buf.WriteByte(' ')
 
-	if requiresParen(node.Right) {
+	if rightParens {
 		buf.WriteByte('(')
 		node.Right.formatFast(buf)
 		buf.WriteByte(')')
@@ -113,6 +121,7 @@ func (node *Union) formatFast(buf *TrackedBuffer) {
 	node.OrderBy.formatFast(buf)
 	node.Limit.formatFast(buf)
 	buf.WriteString(node.Lock.ToString())
+	node.Into.formatFast(buf)
 }
 
 // formatFast formats the node.
@@ -196,7 +205,13 @@ func (node *Insert) formatFast(buf *TrackedBuffer) {
 		node.OnDup.formatFast(buf)
 
 	}
-
+	if node.OnConflict != nil {
+		node.OnConflict.formatFast(buf)
+	}
+	if node.Returning != nil {
+		buf.WriteString(" returning ")
+		node.Returning.formatFast(buf)
+	}
 }
 
 // formatFast formats the node.
@@ -242,6 +257,10 @@ func (node *Update) formatFast(buf *TrackedBuffer) {
 
 	node.Limit.formatFast(buf)
 
+	if node.Returning != nil {
+		buf.WriteString(" returning ")
+		node.Returning.formatFast(buf)
+	}
 }
 
 // formatFast formats the node.
@@ -264,6 +283,10 @@ func (node *Delete) formatFast(buf *TrackedBuffer) {
 	node.Where.formatFast(buf)
 	node.OrderBy.formatFast(buf)
 	node.Limit.formatFast(buf)
+	if node.Returning != nil {
+		buf.WriteString(" returning ")
+		node.Returning.formatFast(buf)
+	}
 }
 
 // formatFast formats the node.
@@ -833,16 +856,22 @@ func (ts *TableSpec) formatFast(buf *TrackedBuffer) {
 			buf.WriteByte('\t')
 			col.formatFast(buf)
 		} else {
-			buf.WriteString(",\n\t")
+			buf.WriteByte(',')
+			buf.writeTrailingComments()
+			buf.WriteString("\n\t")
 			col.formatFast(buf)
 		}
 	}
 	for _, idx := range ts.Indexes {
-		buf.WriteString(",\n\t")
+		buf.WriteByte(',')
+		buf.writeTrailingComments()
+		buf.WriteString("\n\t")
 		idx.formatFast(buf)
 	}
 	for _, c := range ts.Constraints {
-		buf.WriteString(",\n\t")
+		buf.WriteByte(',')
+		buf.writeTrailingComments()
+		buf.WriteString("\n\t")
 		c.formatFast(buf)
 	}
 
@@ -1697,7 +1726,7 @@ func (node *AssignmentExpr) formatFast(buf *TrackedBuffer) {
 func (node *Literal) formatFast(buf *TrackedBuffer) {
 	switch node.Type {
 	case StrVal:
-		sqltypes.MakeTrusted(sqltypes.VarBinary, node.Bytes()).EncodeSQL(buf)
+		writeStrVal(buf, node.Bytes())
 	case IntVal, FloatVal, DecimalVal, HexNum:
 		buf.WriteString(node.Val)
 	case HexVal:
@@ -1725,6 +1754,12 @@ func (node *Literal) formatFast(buf *TrackedBuffer) {
 	}
 }
 
+// formatFast formats the node.
+func (node *PositionalArg) formatFast(buf *TrackedBuffer) {
+	buf.WriteByte('$')
+	buf.WriteString(fmt.Sprintf("%d", node.Index))
+}
+
 // formatFast formats the node.
 func (node *Argument) formatFast(buf *TrackedBuffer) {
 	buf.WriteArg(":", node.Name)
@@ -2448,6 +2483,16 @@ func (node *CastExpr) formatFast(buf *TrackedBuffer) {
 	buf.WriteByte(')')
 }
 
+// formatFast formats the node.
+func (node *TypeCastExpr) formatFast(buf *TrackedBuffer) {
+	buf.printExpr(node, node.Expr, true)
+	buf.WriteString("::")
+	node.Type.formatFast(buf)
+	if node.Array {
+		buf.WriteString("[]")
+	}
+}
+
 // formatFast formats the node.
 func (node *ConvertExpr) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("convert(")
@@ -2654,6 +2699,26 @@ func (node OnDup) formatFast(buf *TrackedBuffer) {
 	UpdateExprs(node).formatFast(buf)
 }
 
+// formatFast formats the node.
+func (node *OnConflict) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(" on conflict")
+	if node.Columns != nil {
+		buf.WriteByte(' ')
+		node.Columns.formatFast(buf)
+	}
+	if !node.Constraint.IsEmpty() {
+		buf.WriteString(" on constraint ")
+		node.Constraint.formatFast(buf)
+	}
+	if node.DoNothing {
+		buf.WriteString(" do nothing")
+		return
+	}
+	buf.WriteString(" do update set ")
+	node.Exprs.formatFast(buf)
+	node.Where.formatFast(buf)
+}
+
 // formatFast formats the node.
 func (node IdentifierCI) formatFast(buf *TrackedBuffer) {
 	if node.IsEmpty() {
@@ -2669,7 +2734,332 @@ func (node IdentifierCS) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node.
 func (node *Load) formatFast(buf *TrackedBuffer) {
-	buf.WriteString("AST node missing for Load type")
+	buf.WriteString("load data ")
+	buf.WriteString(node.Priority.ToString())
+	if node.Local {
+		buf.WriteString("local ")
+	}
+	buf.WriteString("infile ")
+	writeStrVal(buf, []byte(node.FileName))
+	if node.Replace {
+		buf.WriteString(" replace")
+	} else if node.Ignore {
+		buf.WriteString(" ignore")
+	}
+	buf.WriteString(" into table ")
+	node.Table.formatFast(buf)
+	node.Partitions.formatFast(buf)
+	if node.Charset.Name != "" {
+		buf.WriteString(" character set ")
+		buf.WriteString(node.Charset.Name)
+	}
+	node.Fields.formatFast(buf)
+	node.Lines.formatFast(buf)
+	if node.IgnoreLines != nil {
+		buf.WriteString(" ignore ")
+		node.IgnoreLines.formatFast(buf)
+		buf.WriteString(" lines")
+	}
+	if node.Columns != nil {
+		buf.WriteString(" (")
+		node.Columns.formatFast(buf)
+		buf.WriteByte(')')
+	}
+	if len(node.SetExprs) > 0 {
+		buf.WriteString(" set ")
+		node.SetExprs.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *LoadFields) formatFast(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString(" fields")
+	if node.TerminatedBy != nil {
+		buf.WriteString(" terminated by ")
+		node.TerminatedBy.formatFast(buf)
+	}
+	if node.EnclosedBy != nil {
+		if node.OptionallyEnclosed {
+			buf.WriteString(" optionally")
+		}
+		buf.WriteString(" enclosed by ")
+		node.EnclosedBy.formatFast(buf)
+	}
+	if node.EscapedBy != nil {
+		buf.WriteString(" escaped by ")
+		node.EscapedBy.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *Grant) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("grant ")
+	formatPrivileges(buf, node.Privileges)
+	formatAccounts(buf, node.Roles)
+	if node.Proxy != nil {
+		buf.WriteString("proxy on ")
+		node.Proxy.formatFast(buf)
+	}
+	if node.On != nil {
+		buf.WriteString(" on ")
+		node.On.formatFast(buf)
+	}
+	buf.WriteString(" to ")
+	formatAccounts(buf, node.To)
+	if node.WithGrantOption {
+		buf.WriteString(" with grant option")
+	}
+	if node.WithAdminOption {
+		buf.WriteString(" with admin option")
+	}
+}
+
+// formatFast formats the node.
+func (node *Revoke) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("revoke ")
+	if node.IfExists {
+		buf.WriteString("if exists ")
+	}
+	formatPrivileges(buf, node.Privileges)
+	formatAccounts(buf, node.Roles)
+	if node.Proxy != nil {
+		buf.WriteString("proxy on ")
+		node.Proxy.formatFast(buf)
+	}
+	if node.On != nil {
+		buf.WriteString(" on ")
+		node.On.formatFast(buf)
+	}
+	buf.WriteString(" from ")
+	formatAccounts(buf, node.From)
+	if node.IgnoreUnknownUser {
+		buf.WriteString(" ignore unknown user")
+	}
+}
+
+// formatFast formats the node.
+func (node *GrantPrivilege) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Name)
+	if node.Columns != nil {
+		buf.WriteByte(' ')
+		node.Columns.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *PrivilegeLevel) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.ObjectType.ToString())
+	switch {
+	case node.Global:
+		buf.WriteString("*.*")
+	case node.Name.IsEmpty() && node.DB.IsEmpty():
+		buf.WriteByte('*')
+	case node.Name.IsEmpty():
+		node.DB.formatFast(buf)
+		buf.WriteString(".*")
+	case node.DB.IsEmpty():
+		node.Name.formatFast(buf)
+	default:
+		node.DB.formatFast(buf)
+		buf.WriteByte('.')
+		node.Name.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *Account) formatFast(buf *TrackedBuffer) {
+	if node.CurrentUser {
+		buf.WriteString("user()")
+		return
+	}
+	writeStrVal(buf, []byte(node.User))
+	if node.Host != "" {
+		buf.WriteByte('@')
+		writeStrVal(buf, []byte(node.Host))
+	}
+}
+
+// formatFast formats the node.
+func (node *Authentication) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("identified")
+	if !node.Plugin.IsEmpty() {
+		buf.WriteString(" with ")
+		node.Plugin.formatFast(buf)
+	}
+	switch {
+	case node.RandomPassword:
+		buf.WriteString(" by random password")
+	case node.Password == nil:
+	case node.Hashed:
+		buf.WriteString(" as ")
+		node.Password.formatFast(buf)
+	default:
+		buf.WriteString(" by ")
+		node.Password.formatFast(buf)
+	}
+	if node.CurrentPassword != nil {
+		buf.WriteString(" replace ")
+		node.CurrentPassword.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *UserSpec) formatFast(buf *TrackedBuffer) {
+	node.Account.formatFast(buf)
+	if node.Auth != nil {
+		buf.WriteByte(' ')
+		node.Auth.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *CreateUser) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create user ")
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	for i, user := range node.Users {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		user.formatFast(buf)
+	}
+	if len(node.DefaultRoles) > 0 {
+		buf.WriteString(" default role ")
+		formatAccounts(buf, node.DefaultRoles)
+	}
+	if node.PasswordExpire != nil {
+		buf.WriteByte(' ')
+		node.PasswordExpire.formatFast(buf)
+	}
+	buf.WriteString(node.AccountLock.ToString())
+}
+
+// formatFast formats the node.
+func (node *PasswordExpire) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Type.ToString())
+	if node.Type == PasswordExpireInterval {
+		buf.WriteByte(' ')
+		node.Days.formatFast(buf)
+		buf.WriteString(" day")
+	}
+}
+
+// formatFast formats the node.
+func (node *AlterUser) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("alter user ")
+	if node.IfExists {
+		buf.WriteString("if exists ")
+	}
+	for i, user := range node.Users {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		user.formatFast(buf)
+	}
+	if node.PasswordExpire != nil {
+		buf.WriteByte(' ')
+		node.PasswordExpire.formatFast(buf)
+	}
+	buf.WriteString(node.AccountLock.ToString())
+}
+
+// formatFast formats the node.
+func (node *DropUser) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("drop user ")
+	if node.IfExists {
+		buf.WriteString("if exists ")
+	}
+	formatAccounts(buf, node.Users)
+}
+
+// formatFast formats the node.
+func (node *RenameUserPair) formatFast(buf *TrackedBuffer) {
+	node.FromUser.formatFast(buf)
+	buf.WriteString(" to ")
+	node.ToUser.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *RenameUser) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("rename user ")
+	for i, pair := range node.UserPairs {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		pair.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *CreateRole) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create role ")
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	formatAccounts(buf, node.Roles)
+}
+
+// formatFast formats the node.
+func (node *DropRole) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("drop role ")
+	if node.IfExists {
+		buf.WriteString("if exists ")
+	}
+	formatAccounts(buf, node.Roles)
+}
+
+// formatFast formats the node.
+func (node *SetRole) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("set role ")
+	buf.WriteString(node.Type.ToString())
+	if node.Type == AllRoles && len(node.Roles) > 0 {
+		buf.WriteString(" except ")
+	}
+	formatAccounts(buf, node.Roles)
+}
+
+// formatFast formats the node.
+func (node *SetDefaultRole) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("set default role ")
+	buf.WriteString(node.Type.ToString())
+	formatAccounts(buf, node.Roles)
+	buf.WriteString(" to ")
+	formatAccounts(buf, node.To)
+}
+
+// formatFast formats the node.
+func (node *SetPassword) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("set password")
+	if node.For != nil {
+		buf.WriteString(" for ")
+		node.For.formatFast(buf)
+	}
+	if node.Password == nil {
+		buf.WriteString(" to random")
+	} else {
+		buf.WriteString(" = ")
+		node.Password.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *LoadLines) formatFast(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString(" lines")
+	if node.StartingBy != nil {
+		buf.WriteString(" starting by ")
+		node.StartingBy.formatFast(buf)
+	}
+	if node.TerminatedBy != nil {
+		buf.WriteString(" terminated by ")
+		node.TerminatedBy.formatFast(buf)
+	}
 }
 
 // formatFast formats the node.
@@ -2709,6 +3099,11 @@ func (node *SelectInto) formatFast(buf *TrackedBuffer) {
 	if node == nil {
 		return
 	}
+	if node.Type == IntoVariables {
+		buf.WriteString(node.Type.ToString())
+		node.Variables.formatFast(buf)
+		return
+	}
 	buf.WriteString(node.Type.ToString())
 	buf.WriteString(node.FileName)
 	if node.Charset.Name != "" {
@@ -2912,6 +3307,594 @@ func (node *DropView) formatFast(buf *TrackedBuffer) {
 	node.FromTables.formatFast(buf)
 }
 
+// formatFast formats the node.
+func (node *CreateProcedure) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
+	if node.Definer != nil {
+		buf.WriteString("definer = ")
+		node.Definer.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString("procedure ")
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	node.Name.formatFast(buf)
+	buf.WriteByte('(')
+	for i, param := range node.Params {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		param.formatFast(buf)
+	}
+	buf.WriteByte(')')
+	for _, characteristic := range node.Characteristics {
+		buf.WriteByte(' ')
+		characteristic.formatFast(buf)
+	}
+	buf.WriteByte(' ')
+	node.Body.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *CreateFunction) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
+	if node.Definer != nil {
+		buf.WriteString("definer = ")
+		node.Definer.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString("function ")
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	node.Name.formatFast(buf)
+	buf.WriteByte('(')
+	for i, param := range node.Params {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		param.formatFast(buf)
+	}
+	buf.WriteString(") returns ")
+	node.Returns.formatFast(buf)
+	for _, characteristic := range node.Characteristics {
+		buf.WriteByte(' ')
+		characteristic.formatFast(buf)
+	}
+	buf.WriteByte(' ')
+	node.Body.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *AlterProcedure) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("alter ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("procedure ")
+	node.Name.formatFast(buf)
+	for _, characteristic := range node.Characteristics {
+		buf.WriteByte(' ')
+		characteristic.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *AlterFunction) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("alter ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("function ")
+	node.Name.formatFast(buf)
+	for _, characteristic := range node.Characteristics {
+		buf.WriteByte(' ')
+		characteristic.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *DropProcedure) formatFast(buf *TrackedBuffer) {
+	exists := ""
+	if node.IfExists {
+		exists = " if exists"
+	}
+	buf.WriteString("drop ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("procedure")
+	buf.WriteString(exists)
+	buf.WriteByte(' ')
+	node.Name.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *DropFunction) formatFast(buf *TrackedBuffer) {
+	exists := ""
+	if node.IfExists {
+		exists = " if exists"
+	}
+	buf.WriteString("drop ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("function")
+	buf.WriteString(exists)
+	buf.WriteByte(' ')
+	node.Name.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *ProcParameter) formatFast(buf *TrackedBuffer) {
+	if node.Mode != DefaultParameterMode {
+		buf.WriteString(node.Mode.ToString())
+		buf.WriteByte(' ')
+	}
+	node.Name.formatFast(buf)
+	buf.WriteByte(' ')
+	node.Type.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *RoutineCharacteristic) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Type.ToString())
+	if node.Type == CommentCharacteristic {
+		buf.WriteByte(' ')
+		writeStrVal(buf, []byte(node.Comment))
+	}
+}
+
+// formatFast formats the node.
+func (node *CreateTrigger) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
+	if node.Definer != nil {
+		buf.WriteString("definer = ")
+		node.Definer.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString("trigger ")
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	node.Name.formatFast(buf)
+	buf.WriteByte(' ')
+	buf.WriteString(node.Timing.ToString())
+	buf.WriteByte(' ')
+	buf.WriteString(node.Event.ToString())
+	buf.WriteString(" on ")
+	node.Table.formatFast(buf)
+	buf.WriteString(" for each row")
+	if node.Order != nil {
+		buf.WriteByte(' ')
+		node.Order.formatFast(buf)
+	}
+	buf.WriteByte(' ')
+	node.Body.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *DropTrigger) formatFast(buf *TrackedBuffer) {
+	exists := ""
+	if node.IfExists {
+		exists = " if exists"
+	}
+	buf.WriteString("drop ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("trigger")
+	buf.WriteString(exists)
+	buf.WriteByte(' ')
+	node.Name.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *TriggerOrder) formatFast(buf *TrackedBuffer) {
+	if node.Precedes {
+		buf.WriteString("precedes ")
+		node.Name.formatFast(buf)
+	} else {
+		buf.WriteString("follows ")
+		node.Name.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *CreateEvent) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
+	if node.Definer != nil {
+		buf.WriteString("definer = ")
+		node.Definer.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString("event ")
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	node.Name.formatFast(buf)
+	buf.WriteString(" on schedule ")
+	node.Schedule.formatFast(buf)
+	if node.OnCompletion != NoOnCompletion {
+		buf.WriteByte(' ')
+		buf.WriteString(node.OnCompletion.ToString())
+	}
+	if node.Status != NoEventStatus {
+		buf.WriteByte(' ')
+		buf.WriteString(node.Status.ToString())
+	}
+	if node.Comment != nil {
+		buf.WriteString(" comment ")
+		node.Comment.formatFast(buf)
+	}
+	buf.WriteString(" do ")
+	node.Body.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *AlterEvent) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("alter ")
+	node.Comments.formatFast(buf)
+	if node.Definer != nil {
+		buf.WriteString("definer = ")
+		node.Definer.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString("event ")
+	node.Name.formatFast(buf)
+	if node.Schedule != nil {
+		buf.WriteString(" on schedule ")
+		node.Schedule.formatFast(buf)
+	}
+	if node.OnCompletion != NoOnCompletion {
+		buf.WriteByte(' ')
+		buf.WriteString(node.OnCompletion.ToString())
+	}
+	if !node.RenameTo.IsEmpty() {
+		buf.WriteString(" rename to ")
+		node.RenameTo.formatFast(buf)
+	}
+	if node.Status != NoEventStatus {
+		buf.WriteByte(' ')
+		buf.WriteString(node.Status.ToString())
+	}
+	if node.Comment != nil {
+		buf.WriteString(" comment ")
+		node.Comment.formatFast(buf)
+	}
+	if node.Body != nil {
+		buf.WriteString(" do ")
+		node.Body.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *DropEvent) formatFast(buf *TrackedBuffer) {
+	exists := ""
+	if node.IfExists {
+		exists = " if exists"
+	}
+	buf.WriteString("drop ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("event")
+	buf.WriteString(exists)
+	buf.WriteByte(' ')
+	node.Name.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *EventSchedule) formatFast(buf *TrackedBuffer) {
+	if node.At != nil {
+		buf.WriteString("at ")
+		node.At.formatFast(buf)
+	} else {
+		buf.WriteString("every ")
+		node.Every.formatFast(buf)
+		buf.WriteByte(' ')
+		buf.WriteString(node.Unit.ToString())
+	}
+	if node.Starts != nil {
+		buf.WriteString(" starts ")
+		node.Starts.formatFast(buf)
+	}
+	if node.Ends != nil {
+		buf.WriteString(" ends ")
+		node.Ends.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node StatementList) formatFast(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.WriteString(prefix)
+		n.formatFast(buf)
+		buf.WriteByte(';')
+		prefix = " "
+	}
+}
+
+// formatFast formats the node.
+func (node *BeginEndBlock) formatFast(buf *TrackedBuffer) {
+	if !node.Label.IsEmpty() {
+		node.Label.formatFast(buf)
+		buf.WriteString(": ")
+	}
+	buf.WriteString("begin")
+	if len(node.Statements) > 0 {
+		buf.WriteByte(' ')
+		node.Statements.formatFast(buf)
+	}
+	buf.WriteString(" end")
+	if !node.Label.IsEmpty() {
+		buf.WriteByte(' ')
+		node.Label.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *DeclareVar) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("declare ")
+	for i, name := range node.Names {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		name.formatFast(buf)
+	}
+	buf.WriteByte(' ')
+	node.Type.formatFast(buf)
+	if node.Default != nil {
+		buf.WriteString(" default ")
+		node.Default.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *DeclareCondition) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("declare ")
+	node.Name.formatFast(buf)
+	buf.WriteString(" condition for ")
+	node.Value.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *DeclareCursor) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("declare ")
+	node.Name.formatFast(buf)
+	buf.WriteString(" cursor for ")
+	node.Select.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *DeclareHandler) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("declare ")
+	buf.WriteString(node.Action.ToString())
+	buf.WriteString(" handler for ")
+	for i, condition := range node.Conditions {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		condition.formatFast(buf)
+	}
+	buf.WriteByte(' ')
+	node.Statement.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *ConditionValue) formatFast(buf *TrackedBuffer) {
+	switch node.Type {
+	case ErrorCodeCondition:
+		buf.WriteString(node.Value)
+	case SQLStateCondition:
+		buf.WriteString("sqlstate ")
+		writeStrVal(buf, []byte(node.Value))
+	case NamedCondition:
+		node.Name.formatFast(buf)
+	case SQLWarningCondition:
+		buf.WriteString(SQLWarningConditionStr)
+	case NotFoundCondition:
+		buf.WriteString(NotFoundConditionStr)
+	case SQLExceptionCondition:
+		buf.WriteString(SQLExceptionConditionStr)
+	}
+}
+
+// formatFast formats the node.
+func (node *IfStatement) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("if ")
+	node.Cond.formatFast(buf)
+	buf.WriteString(" then ")
+	node.Statements.formatFast(buf)
+	for _, elseIf := range node.ElseIfs {
+		buf.WriteByte(' ')
+		elseIf.formatFast(buf)
+	}
+	if len(node.Else) > 0 {
+		buf.WriteString(" else ")
+		node.Else.formatFast(buf)
+	}
+	buf.WriteString(" end if")
+}
+
+// formatFast formats the node.
+func (node *ElseIfBlock) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("elseif ")
+	node.Cond.formatFast(buf)
+	buf.WriteString(" then ")
+	node.Statements.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *CaseStatement) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("case")
+	if node.Expr != nil {
+		buf.WriteByte(' ')
+		node.Expr.formatFast(buf)
+	}
+	for _, when := range node.Whens {
+		buf.WriteByte(' ')
+		when.formatFast(buf)
+	}
+	if len(node.Else) > 0 {
+		buf.WriteString(" else ")
+		node.Else.formatFast(buf)
+	}
+	buf.WriteString(" end case")
+}
+
+// formatFast formats the node.
+func (node *CaseStatementWhen) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("when ")
+	node.Cond.formatFast(buf)
+	buf.WriteString(" then ")
+	node.Statements.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *LoopStatement) formatFast(buf *TrackedBuffer) {
+	if !node.Label.IsEmpty() {
+		node.Label.formatFast(buf)
+		buf.WriteString(": ")
+	}
+	buf.WriteString("loop ")
+	node.Statements.formatFast(buf)
+	buf.WriteString(" end loop")
+	if !node.Label.IsEmpty() {
+		buf.WriteByte(' ')
+		node.Label.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *WhileStatement) formatFast(buf *TrackedBuffer) {
+	if !node.Label.IsEmpty() {
+		node.Label.formatFast(buf)
+		buf.WriteString(": ")
+	}
+	buf.WriteString("while ")
+	node.Cond.formatFast(buf)
+	buf.WriteString(" do ")
+	node.Statements.formatFast(buf)
+	buf.WriteString(" end while")
+	if !node.Label.IsEmpty() {
+		buf.WriteByte(' ')
+		node.Label.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *RepeatStatement) formatFast(buf *TrackedBuffer) {
+	if !node.Label.IsEmpty() {
+		node.Label.formatFast(buf)
+		buf.WriteString(": ")
+	}
+	buf.WriteString("repeat ")
+	node.Statements.formatFast(buf)
+	buf.WriteString(" until ")
+	node.Until.formatFast(buf)
+	buf.WriteString(" end repeat")
+	if !node.Label.IsEmpty() {
+		buf.WriteByte(' ')
+		node.Label.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *LeaveStatement) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("leave ")
+	node.Label.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *IterateStatement) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("iterate ")
+	node.Label.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *RoutineSet) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("set ")
+	node.Comments.formatFast(buf)
+	node.Exprs.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node RoutineSetExprs) formatFast(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.WriteString(prefix)
+		n.formatFast(buf)
+		prefix = ", "
+	}
+}
+
+// formatFast formats the node.
+func (node *RoutineSetExpr) formatFast(buf *TrackedBuffer) {
+	if variable, ok := node.Var.(*Variable); ok && (variable.Name.EqualString("charset") || variable.Name.EqualString("names")) {
+		buf.WriteString(variable.Name.String())
+		buf.WriteByte(' ')
+		node.Expr.formatFast(buf)
+		return
+	}
+	node.Var.formatFast(buf)
+	buf.WriteString(" = ")
+	node.Expr.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *ReturnStatement) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("return ")
+	node.Expr.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *OpenCursor) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("open ")
+	node.Name.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *CloseCursor) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("close ")
+	node.Name.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *FetchCursor) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("fetch ")
+	node.Name.formatFast(buf)
+	buf.WriteString(" into ")
+	for i, variable := range node.Variables {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		variable.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *Signal) formatFast(buf *TrackedBuffer) {
+	if node.Resignal {
+		buf.WriteString("resignal")
+	} else {
+		buf.WriteString("signal")
+	}
+	if node.Condition != nil {
+		buf.WriteByte(' ')
+		node.Condition.formatFast(buf)
+	}
+	for i, item := range node.Items {
+		if i == 0 {
+			buf.WriteString(" set ")
+		} else {
+			buf.WriteString(", ")
+		}
+		item.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *SignalItem) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Name)
+	buf.WriteString(" = ")
+	node.Value.formatFast(buf)
+}
+
 // formatFast formats the AlterTable node.
 func (node *AlterTable) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("alter ")
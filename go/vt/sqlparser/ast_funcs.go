@@ -335,6 +335,10 @@ func (node *ParsedComments) AddQueryHint(queryHint string) (Comments, error) {
 				if idx == -1 {
 					return nil, vterrors.New(vtrpcpb.Code_INTERNAL, "Query hint comment is malformed")
 				}
+				if merged, ok := mergeOptimizerHints(comment, queryHint); ok {
+					newComments = append(Comments{merged}, newComments...)
+					continue
+				}
 				if strings.Contains(comment, queryHint) {
 					newComments = append(Comments{comment}, newComments...)
 					continue
@@ -353,6 +357,24 @@ func (node *ParsedComments) AddQueryHint(queryHint string) (Comments, error) {
 	return newComments, nil
 }
 
+// mergeOptimizerHints adds the optimizer hints of a query hint to the
+// hints of a hint comment that are not already there. It fails if either
+// cannot be parsed.
+func mergeOptimizerHints(comment, queryHint string) (string, bool) {
+	hints, err := ParseOptimizerHints(comment)
+	if err != nil {
+		return "", false
+	}
+	added, err := ParseOptimizerHints(queryHint)
+	if err != nil {
+		return "", false
+	}
+	for _, hint := range added {
+		hints = hints.Add(hint)
+	}
+	return fmt.Sprintf("%s %s */", queryOptimizerPrefix, hints.String()), true
+}
+
 // ParseParams parses the vindex parameter list, pulling out the special-case
 // "owner" parameter
 func (node *VindexSpec) ParseParams() (string, map[string]string) {
@@ -706,6 +728,20 @@ func NewSetStatement(comments *ParsedComments, exprs SetExprs) *Set {
 	return &Set{Exprs: exprs, Comments: comments}
 }
 
+// NewRoutineSetStatement returns a SET statement of a stored program body.
+// It returns a Set when all the assignments are to user or system variables.
+func NewRoutineSetStatement(comments *ParsedComments, exprs RoutineSetExprs) Statement {
+	setExprs := make(SetExprs, 0, len(exprs))
+	for _, expr := range exprs {
+		variable, ok := expr.Var.(*Variable)
+		if !ok {
+			return &RoutineSet{Comments: comments, Exprs: exprs}
+		}
+		setExprs = append(setExprs, &SetExpr{Var: variable, Expr: expr.Expr})
+	}
+	return NewSetStatement(comments, setExprs)
+}
+
 // NewVariableExpression returns an expression the evaluates to a variable at runtime.
 // The AtCount and the prefix of the name of the variable will decide how it's evaluated
 func NewVariableExpression(str string, at AtCount) *Variable {
@@ -881,7 +917,9 @@ func formatID(buf *TrackedBuffer, original string, at AtCount) {
 		return
 	}
 	_, isKeyword := keywordLookupTable.LookupString(original)
-	if buf.escape == escapeAllIdentifiers || isKeyword || containEscapableChars(original, at) {
+	if buf.escape == escapeAllIdentifiers || isKeyword || containEscapableChars(original, at) ||
+		// PostgreSQL folds the identifiers that are not quoted to lower case
+		buf.postgres() && strings.ToLower(original) != original {
 		writeEscapedString(buf, original)
 	} else {
 		buf.WriteString(original)
@@ -889,14 +927,46 @@ func formatID(buf *TrackedBuffer, original string, at AtCount) {
 }
 
 func writeEscapedString(buf *TrackedBuffer, original string) {
-	buf.WriteByte('`')
+	quote := buf.identifierQuote()
+	buf.WriteByte(quote)
 	for _, c := range original {
 		buf.WriteRune(c)
-		if c == '`' {
-			buf.WriteByte('`')
+		if c == rune(quote) {
+			buf.WriteByte(quote)
 		}
 	}
-	buf.WriteByte('`')
+	buf.WriteByte(quote)
+}
+
+// writeStrVal writes a string literal. Dialects without backslash escapes
+// only need to double the quotes.
+func writeStrVal(buf *TrackedBuffer, val []byte) {
+	if buf.dialect == nil || buf.dialect.EscapingBackslash() {
+		sqltypes.MakeTrusted(sqltypes.VarBinary, val).EncodeSQL(buf)
+		return
+	}
+	buf.WriteByte('\'')
+	for _, c := range val {
+		buf.WriteByte(c)
+		if c == '\'' {
+			buf.WriteByte('\'')
+		}
+	}
+	buf.WriteByte('\'')
+}
+
+// isDual reports whether the table expressions are the dual table of a
+// SELECT without FROM clause.
+func isDual(from []TableExpr) bool {
+	if len(from) != 1 {
+		return false
+	}
+	aliased, ok := from[0].(*AliasedTableExpr)
+	if !ok || !aliased.As.IsEmpty() || aliased.Hints != nil || aliased.Partitions != nil || aliased.Columns != nil {
+		return false
+	}
+	table, ok := aliased.Expr.(TableName)
+	return ok && table.Qualifier.IsEmpty() && table.Name.String() == "dual"
 }
 
 func CompliantString(in SQLNode) string {
@@ -1103,6 +1173,30 @@ func requiresParen(stmt SelectStatement) bool {
 	return false
 }
 
+// precedence returns how tightly a set operation binds its operands:
+// INTERSECT binds tighter than UNION and EXCEPT.
+func (ty SetOpType) precedence() int {
+	if ty == IntersectType {
+		return 2
+	}
+	return 1
+}
+
+// setOpParens returns whether the operands of a set operation must be
+// printed in parenthesis to keep their meaning. Set operations are left
+// associative.
+func setOpParens(node *Union) (left, right bool) {
+	operandPrecedence := func(stmt SelectStatement) int {
+		if union, ok := stmt.(*Union); ok {
+			return union.Type.precedence()
+		}
+		return 3
+	}
+	left = requiresParen(node.Left) || operandPrecedence(node.Left) < node.Type.precedence()
+	right = requiresParen(node.Right) || operandPrecedence(node.Right) <= node.Type.precedence()
+	return left, right
+}
+
 func setLockInSelect(stmt SelectStatement, lock Lock) {
 	stmt.SetLock(lock)
 }
@@ -1181,6 +1275,24 @@ func (lock Lock) ToString() string {
 	}
 }
 
+// operator returns the keywords of the set operation
+func (node *Union) operator() string {
+	switch {
+	case node.Type == IntersectType && node.Distinct:
+		return IntersectStr
+	case node.Type == IntersectType:
+		return IntersectAllStr
+	case node.Type == ExceptType && node.Distinct:
+		return ExceptStr
+	case node.Type == ExceptType:
+		return ExceptAllStr
+	case node.Distinct:
+		return UnionStr
+	default:
+		return UnionAllStr
+	}
+}
+
 // ToString returns the string associated with WhereType
 func (whereType WhereType) ToString() string {
 	switch whereType {
@@ -1244,6 +1356,10 @@ func (op ComparisonExprOperator) ToString() string {
 		return RegexpStr
 	case NotRegexpOp:
 		return NotRegexpStr
+	case ILikeOp:
+		return ILikeStr
+	case NotILikeOp:
+		return NotILikeStr
 	default:
 		return "Unknown ComparisonExpOperator"
 	}
@@ -1298,6 +1414,8 @@ func (op BinaryExprOperator) ToString() string {
 		return JSONExtractOpStr
 	case JSONUnquoteExtractOp:
 		return JSONUnquoteExtractOpStr
+	case ConcatOp:
+		return ConcatStr
 	default:
 		return "Unknown BinaryExprOperator"
 	}
@@ -1726,6 +1844,8 @@ func (sel SelectIntoType) ToString() string {
 		return IntoOutfileS3Str
 	case IntoDumpfile:
 		return IntoDumpfileStr
+	case IntoVariables:
+		return IntoVariablesStr
 	default:
 		return "Unknown Select Into Type"
 	}
@@ -1761,6 +1881,102 @@ func (ty LockType) ToString() string {
 	}
 }
 
+// formatPrivileges formats a comma separated list of privileges.
+func formatPrivileges(buf *TrackedBuffer, privileges []*GrantPrivilege) {
+	for i, privilege := range privileges {
+		if i != 0 {
+			buf.literal(", ")
+		}
+		buf.formatter(privilege)
+	}
+}
+
+// formatAccounts formats a comma separated list of accounts.
+func formatAccounts(buf *TrackedBuffer, accounts []*Account) {
+	for i, account := range accounts {
+		if i != 0 {
+			buf.literal(", ")
+		}
+		buf.formatter(account)
+	}
+}
+
+// ToString returns the object type of a privilege level as a string
+func (ty PrivilegeObjectType) ToString() string {
+	switch ty {
+	case NoObjectType:
+		return ""
+	case TableObjectType:
+		return TableObjectTypeStr
+	case FunctionObjectType:
+		return FunctionObjectTypeStr
+	case ProcedureObjectType:
+		return ProcedureObjectTypeStr
+	default:
+		return "Unknown PrivilegeObjectType"
+	}
+}
+
+// ToString returns the account lock option as a string
+func (lock AccountLock) ToString() string {
+	switch lock {
+	case NoAccountLock:
+		return ""
+	case AccountLocked:
+		return AccountLockedStr
+	case AccountUnlocked:
+		return AccountUnlockedStr
+	default:
+		return "Unknown AccountLock"
+	}
+}
+
+// ToString returns the type as a string
+func (ty PasswordExpireType) ToString() string {
+	switch ty {
+	case PasswordExpireNow:
+		return PasswordExpireNowStr
+	case PasswordExpireDefault:
+		return PasswordExpireDefaultStr
+	case PasswordExpireNever:
+		return PasswordExpireNeverStr
+	case PasswordExpireInterval:
+		return PasswordExpireIntervalStr
+	default:
+		return "Unknown PasswordExpireType"
+	}
+}
+
+// ToString returns the type of a SET ROLE statement as a string
+func (ty SetRoleType) ToString() string {
+	switch ty {
+	case ListRoles:
+		return ""
+	case DefaultRole:
+		return DefaultRoleStr
+	case NoRoles:
+		return NoRolesStr
+	case AllRoles:
+		return AllRolesStr
+	default:
+		return "Unknown SetRoleType"
+	}
+}
+
+// ToString returns the priority of a LOAD DATA statement as a string
+func (ty LoadPriority) ToString() string {
+	switch ty {
+	case NoLoadPriority:
+		return ""
+	case LowPriorityLoad:
+		return LowPriorityLoadStr
+	case ConcurrentLoad:
+		return ConcurrentLoadStr
+	default:
+		return "Unknown LoadPriority"
+	}
+}
+
 // ToString returns ShowCommandType as a string
 func (ty ShowCommandType) ToString() string {
 	switch ty {
@@ -1880,6 +2096,142 @@ func (lock LockOptionType) ToString() string {
 	}
 }
 
+// ToString returns the ProcParameterMode as a string
+func (mode ProcParameterMode) ToString() string {
+	switch mode {
+	case DefaultParameterMode:
+		return ""
+	case InParameterMode:
+		return InParameterStr
+	case OutParameterMode:
+		return OutParameterStr
+	case InOutParameterMode:
+		return InOutParameterStr
+	default:
+		return "Unknown ProcParameterMode"
+	}
+}
+
+// ToString returns the RoutineCharacteristicType as a string
+func (ty RoutineCharacteristicType) ToString() string {
+	switch ty {
+	case CommentCharacteristic:
+		return CommentCharacteristicStr
+	case LanguageSQLCharacteristic:
+		return LanguageSQLCharacteristicStr
+	case DeterministicCharacteristic:
+		return DeterministicCharacteristicStr
+	case NotDeterministicCharacteristic:
+		return NotDeterministicCharacteristicStr
+	case ContainsSQLCharacteristic:
+		return ContainsSQLCharacteristicStr
+	case NoSQLCharacteristic:
+		return NoSQLCharacteristicStr
+	case ReadsSQLDataCharacteristic:
+		return ReadsSQLDataCharacteristicStr
+	case ModifiesSQLDataCharacteristic:
+		return ModifiesSQLDataCharacteristicStr
+	case SQLSecurityDefinerCharacteristic:
+		return SQLSecurityDefinerStr
+	case SQLSecurityInvokerCharacteristic:
+		return SQLSecurityInvokerStr
+	default:
+		return "Unknown RoutineCharacteristicType"
+	}
+}
+
+// ToString returns the TriggerTiming as a string
+func (timing TriggerTiming) ToString() string {
+	switch timing {
+	case BeforeTrigger:
+		return BeforeTriggerStr
+	case AfterTrigger:
+		return AfterTriggerStr
+	default:
+		return "Unknown TriggerTiming"
+	}
+}
+
+// ToString returns the TriggerEvent as a string
+func (event TriggerEvent) ToString() string {
+	switch event {
+	case InsertTrigger:
+		return InsertTriggerStr
+	case UpdateTrigger:
+		return UpdateTriggerStr
+	case DeleteTrigger:
+		return DeleteTriggerStr
+	default:
+		return "Unknown TriggerEvent"
+	}
+}
+
+// ToString returns the EventOnCompletion as a string
+func (onCompletion EventOnCompletion) ToString() string {
+	switch onCompletion {
+	case NoOnCompletion:
+		return ""
+	case OnCompletionPreserve:
+		return OnCompletionPreserveStr
+	case OnCompletionNotPreserve:
+		return OnCompletionNotPreserveStr
+	default:
+		return "Unknown EventOnCompletion"
+	}
+}
+
+// ToString returns the EventStatus as a string
+func (status EventStatus) ToString() string {
+	switch status {
+	case NoEventStatus:
+		return ""
+	case EnableEventStatus:
+		return EnableEventStatusStr
+	case DisableEventStatus:
+		return DisableEventStatusStr
+	case DisableOnReplicaEventStatus:
+		return DisableOnReplicaEventStatusStr
+	default:
+		return "Unknown EventStatus"
+	}
+}
+
+// ToString returns the HandlerAction as a string
+func (action HandlerAction) ToString() string {
+	switch action {
+	case ContinueHandler:
+		return ContinueHandlerStr
+	case ExitHandler:
+		return ExitHandlerStr
+	case UndoHandler:
+		return UndoHandlerStr
+	default:
+		return "Unknown HandlerAction"
+	}
+}
+
+// signalItemNames are the condition information items that can be set by SIGNAL and RESIGNAL.
+var signalItemNames = map[string]bool{
+	"class_origin":       true,
+	"subclass_origin":    true,
+	"message_text":       true,
+	"mysql_errno":        true,
+	"constraint_catalog": true,
+	"constraint_schema":  true,
+	"constraint_name":    true,
+	"catalog_name":       true,
+	"schema_name":        true,
+	"table_name":         true,
+	"column_name":        true,
+	"cursor_name":        true,
+}
+
+// endLabelMismatch reports whether the end label of a compound statement
+// is given and does not match its begin label.
+func endLabelMismatch(begin, end IdentifierCI) bool {
+	return !end.IsEmpty() && !end.Equal(begin)
+}
+
 // ToString returns the string associated with JoinType
 func (columnFormat ColumnFormat) ToString() string {
 	switch columnFormat {
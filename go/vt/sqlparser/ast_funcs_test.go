@@ -69,6 +69,16 @@ func TestAddQueryHint(t *testing.T) {
 			queryHint: "SET_VAR(bb)",
 			expected:  Comments{"/*+ SET_VAR(bb) */"},
 		},
+		{
+			comments:  Comments{"/*+ SET_VAR(a=10) */"},
+			queryHint: "SET_VAR(a=1)",
+			expected:  Comments{"/*+ SET_VAR(a = 10) SET_VAR(a = 1) */"},
+		},
+		{
+			comments:  Comments{"/*+ BKA(t1) MAX_EXECUTION_TIME(10) */"},
+			queryHint: "MAX_EXECUTION_TIME(10)",
+			expected:  Comments{"/*+ BKA(t1) MAX_EXECUTION_TIME(10) */"},
+		},
 	}
 
 	for i, tc := range tcs {
@@ -0,0 +1,14028 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by ASTHelperGen. DO NOT EDIT.
+
+package sqlparser
+
+import "encoding/json"
+
+// SQLNode encodes the value as JSON.
+func (enc *jsonEncoder) SQLNode(n SQLNode) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case *Account:
+		enc.RefOfAccount(n)
+	case *AddColumns:
+		enc.RefOfAddColumns(n)
+	case *AddConstraintDefinition:
+		enc.RefOfAddConstraintDefinition(n)
+	case *AddIndexDefinition:
+		enc.RefOfAddIndexDefinition(n)
+	case AlgorithmValue:
+		enc.openNode("AlgorithmValue")
+		enc.field("Value")
+		enc.value(n)
+		enc.closeObject()
+	case *AliasedExpr:
+		enc.RefOfAliasedExpr(n)
+	case *AliasedTableExpr:
+		enc.RefOfAliasedTableExpr(n)
+	case *AlterCharset:
+		enc.RefOfAlterCharset(n)
+	case *AlterCheck:
+		enc.RefOfAlterCheck(n)
+	case *AlterColumn:
+		enc.RefOfAlterColumn(n)
+	case *AlterDatabase:
+		enc.RefOfAlterDatabase(n)
+	case *AlterEvent:
+		enc.RefOfAlterEvent(n)
+	case *AlterFunction:
+		enc.RefOfAlterFunction(n)
+	case *AlterIndex:
+		enc.RefOfAlterIndex(n)
+	case *AlterMigration:
+		enc.RefOfAlterMigration(n)
+	case *AlterProcedure:
+		enc.RefOfAlterProcedure(n)
+	case *AlterTable:
+		enc.RefOfAlterTable(n)
+	case *AlterUser:
+		enc.RefOfAlterUser(n)
+	case *AlterView:
+		enc.RefOfAlterView(n)
+	case *AlterVschema:
+		enc.RefOfAlterVschema(n)
+	case *AndExpr:
+		enc.RefOfAndExpr(n)
+	case *Argument:
+		enc.RefOfArgument(n)
+	case *ArgumentLessWindowExpr:
+		enc.RefOfArgumentLessWindowExpr(n)
+	case *AssignmentExpr:
+		enc.RefOfAssignmentExpr(n)
+	case *Authentication:
+		enc.RefOfAuthentication(n)
+	case *AutoIncSpec:
+		enc.RefOfAutoIncSpec(n)
+	case *Avg:
+		enc.RefOfAvg(n)
+	case *BadStatement:
+		enc.RefOfBadStatement(n)
+	case *Begin:
+		enc.RefOfBegin(n)
+	case *BeginEndBlock:
+		enc.RefOfBeginEndBlock(n)
+	case *BetweenExpr:
+		enc.RefOfBetweenExpr(n)
+	case *BinaryExpr:
+		enc.RefOfBinaryExpr(n)
+	case *BitAnd:
+		enc.RefOfBitAnd(n)
+	case *BitOr:
+		enc.RefOfBitOr(n)
+	case *BitXor:
+		enc.RefOfBitXor(n)
+	case BoolVal:
+		enc.openNode("BoolVal")
+		enc.field("Value")
+		enc.value(n)
+		enc.closeObject()
+	case *CallProc:
+		enc.RefOfCallProc(n)
+	case *CaseExpr:
+		enc.RefOfCaseExpr(n)
+	case *CaseStatement:
+		enc.RefOfCaseStatement(n)
+	case *CaseStatementWhen:
+		enc.RefOfCaseStatementWhen(n)
+	case *CastExpr:
+		enc.RefOfCastExpr(n)
+	case *ChangeColumn:
+		enc.RefOfChangeColumn(n)
+	case *CharExpr:
+		enc.RefOfCharExpr(n)
+	case *CheckConstraintDefinition:
+		enc.RefOfCheckConstraintDefinition(n)
+	case *CloseCursor:
+		enc.RefOfCloseCursor(n)
+	case *ColName:
+		enc.RefOfColName(n)
+	case *CollateExpr:
+		enc.RefOfCollateExpr(n)
+	case *ColumnDefinition:
+		enc.RefOfColumnDefinition(n)
+	case *ColumnType:
+		enc.RefOfColumnType(n)
+	case Columns:
+		enc.openNode("Columns")
+		enc.field("Value")
+		enc.Columns(n)
+		enc.closeObject()
+	case *CommentOnly:
+		enc.RefOfCommentOnly(n)
+	case *Commit:
+		enc.RefOfCommit(n)
+	case *CommonTableExpr:
+		enc.RefOfCommonTableExpr(n)
+	case *ComparisonExpr:
+		enc.RefOfComparisonExpr(n)
+	case *ConditionValue:
+		enc.RefOfConditionValue(n)
+	case *ConstraintDefinition:
+		enc.RefOfConstraintDefinition(n)
+	case *ConvertExpr:
+		enc.RefOfConvertExpr(n)
+	case *ConvertType:
+		enc.RefOfConvertType(n)
+	case *ConvertUsingExpr:
+		enc.RefOfConvertUsingExpr(n)
+	case *Count:
+		enc.RefOfCount(n)
+	case *CountStar:
+		enc.RefOfCountStar(n)
+	case *CreateDatabase:
+		enc.RefOfCreateDatabase(n)
+	case *CreateEvent:
+		enc.RefOfCreateEvent(n)
+	case *CreateFunction:
+		enc.RefOfCreateFunction(n)
+	case *CreateProcedure:
+		enc.RefOfCreateProcedure(n)
+	case *CreateRole:
+		enc.RefOfCreateRole(n)
+	case *CreateTable:
+		enc.RefOfCreateTable(n)
+	case *CreateTrigger:
+		enc.RefOfCreateTrigger(n)
+	case *CreateUser:
+		enc.RefOfCreateUser(n)
+	case *CreateView:
+		enc.RefOfCreateView(n)
+	case *CurTimeFuncExpr:
+		enc.RefOfCurTimeFuncExpr(n)
+	case *DateAddExpr:
+		enc.RefOfDateAddExpr(n)
+	case *DateSubExpr:
+		enc.RefOfDateSubExpr(n)
+	case *DeallocateStmt:
+		enc.RefOfDeallocateStmt(n)
+	case *DeclareCondition:
+		enc.RefOfDeclareCondition(n)
+	case *DeclareCursor:
+		enc.RefOfDeclareCursor(n)
+	case *DeclareHandler:
+		enc.RefOfDeclareHandler(n)
+	case *DeclareVar:
+		enc.RefOfDeclareVar(n)
+	case *Default:
+		enc.RefOfDefault(n)
+	case *Definer:
+		enc.RefOfDefiner(n)
+	case *Delete:
+		enc.RefOfDelete(n)
+	case *DerivedTable:
+		enc.RefOfDerivedTable(n)
+	case *DropColumn:
+		enc.RefOfDropColumn(n)
+	case *DropDatabase:
+		enc.RefOfDropDatabase(n)
+	case *DropEvent:
+		enc.RefOfDropEvent(n)
+	case *DropFunction:
+		enc.RefOfDropFunction(n)
+	case *DropKey:
+		enc.RefOfDropKey(n)
+	case *DropProcedure:
+		enc.RefOfDropProcedure(n)
+	case *DropRole:
+		enc.RefOfDropRole(n)
+	case *DropTable:
+		enc.RefOfDropTable(n)
+	case *DropTrigger:
+		enc.RefOfDropTrigger(n)
+	case *DropUser:
+		enc.RefOfDropUser(n)
+	case *DropView:
+		enc.RefOfDropView(n)
+	case *ElseIfBlock:
+		enc.RefOfElseIfBlock(n)
+	case *EventSchedule:
+		enc.RefOfEventSchedule(n)
+	case *ExecuteStmt:
+		enc.RefOfExecuteStmt(n)
+	case *ExistsExpr:
+		enc.RefOfExistsExpr(n)
+	case *ExplainStmt:
+		enc.RefOfExplainStmt(n)
+	case *ExplainTab:
+		enc.RefOfExplainTab(n)
+	case Exprs:
+		enc.openNode("Exprs")
+		enc.field("Value")
+		enc.Exprs(n)
+		enc.closeObject()
+	case *ExtractFuncExpr:
+		enc.RefOfExtractFuncExpr(n)
+	case *ExtractValueExpr:
+		enc.RefOfExtractValueExpr(n)
+	case *ExtractedSubquery:
+		enc.RefOfExtractedSubquery(n)
+	case *FetchCursor:
+		enc.RefOfFetchCursor(n)
+	case *FirstOrLastValueExpr:
+		enc.RefOfFirstOrLastValueExpr(n)
+	case *Flush:
+		enc.RefOfFlush(n)
+	case *Force:
+		enc.RefOfForce(n)
+	case *ForeignKeyDefinition:
+		enc.RefOfForeignKeyDefinition(n)
+	case *FrameClause:
+		enc.RefOfFrameClause(n)
+	case *FramePoint:
+		enc.RefOfFramePoint(n)
+	case *FromFirstLastClause:
+		enc.RefOfFromFirstLastClause(n)
+	case *FuncExpr:
+		enc.RefOfFuncExpr(n)
+	case *GTIDFuncExpr:
+		enc.RefOfGTIDFuncExpr(n)
+	case *GeoHashFromLatLongExpr:
+		enc.RefOfGeoHashFromLatLongExpr(n)
+	case *GeoHashFromPointExpr:
+		enc.RefOfGeoHashFromPointExpr(n)
+	case *GeoJSONFromGeomExpr:
+		enc.RefOfGeoJSONFromGeomExpr(n)
+	case *GeomCollPropertyFuncExpr:
+		enc.RefOfGeomCollPropertyFuncExpr(n)
+	case *GeomFormatExpr:
+		enc.RefOfGeomFormatExpr(n)
+	case *GeomFromGeoHashExpr:
+		enc.RefOfGeomFromGeoHashExpr(n)
+	case *GeomFromGeoJSONExpr:
+		enc.RefOfGeomFromGeoJSONExpr(n)
+	case *GeomFromTextExpr:
+		enc.RefOfGeomFromTextExpr(n)
+	case *GeomFromWKBExpr:
+		enc.RefOfGeomFromWKBExpr(n)
+	case *GeomPropertyFuncExpr:
+		enc.RefOfGeomPropertyFuncExpr(n)
+	case *Grant:
+		enc.RefOfGrant(n)
+	case *GrantPrivilege:
+		enc.RefOfGrantPrivilege(n)
+	case GroupBy:
+		enc.openNode("GroupBy")
+		enc.field("Value")
+		enc.GroupBy(n)
+		enc.closeObject()
+	case *GroupConcatExpr:
+		enc.RefOfGroupConcatExpr(n)
+	case IdentifierCI:
+		enc.openNode("IdentifierCI")
+		enc.field("Value")
+		enc.value(n)
+		enc.closeObject()
+	case IdentifierCS:
+		enc.openNode("IdentifierCS")
+		enc.field("Value")
+		enc.value(n)
+		enc.closeObject()
+	case *IfStatement:
+		enc.RefOfIfStatement(n)
+	case *IndexDefinition:
+		enc.RefOfIndexDefinition(n)
+	case *IndexHint:
+		enc.RefOfIndexHint(n)
+	case IndexHints:
+		enc.openNode("IndexHints")
+		enc.field("Value")
+		enc.IndexHints(n)
+		enc.closeObject()
+	case *IndexInfo:
+		enc.RefOfIndexInfo(n)
+	case *Insert:
+		enc.RefOfInsert(n)
+	case *InsertExpr:
+		enc.RefOfInsertExpr(n)
+	case *IntervalFuncExpr:
+		enc.RefOfIntervalFuncExpr(n)
+	case *IntroducerExpr:
+		enc.RefOfIntroducerExpr(n)
+	case *IsExpr:
+		enc.RefOfIsExpr(n)
+	case *IterateStatement:
+		enc.RefOfIterateStatement(n)
+	case *JSONArrayExpr:
+		enc.RefOfJSONArrayExpr(n)
+	case *JSONAttributesExpr:
+		enc.RefOfJSONAttributesExpr(n)
+	case *JSONContainsExpr:
+		enc.RefOfJSONContainsExpr(n)
+	case *JSONContainsPathExpr:
+		enc.RefOfJSONContainsPathExpr(n)
+	case *JSONExtractExpr:
+		enc.RefOfJSONExtractExpr(n)
+	case *JSONKeysExpr:
+		enc.RefOfJSONKeysExpr(n)
+	case *JSONObjectExpr:
+		enc.RefOfJSONObjectExpr(n)
+	case *JSONObjectParam:
+		enc.RefOfJSONObjectParam(n)
+	case *JSONOverlapsExpr:
+		enc.RefOfJSONOverlapsExpr(n)
+	case *JSONPrettyExpr:
+		enc.RefOfJSONPrettyExpr(n)
+	case *JSONQuoteExpr:
+		enc.RefOfJSONQuoteExpr(n)
+	case *JSONRemoveExpr:
+		enc.RefOfJSONRemoveExpr(n)
+	case *JSONSchemaValidFuncExpr:
+		enc.RefOfJSONSchemaValidFuncExpr(n)
+	case *JSONSchemaValidationReportFuncExpr:
+		enc.RefOfJSONSchemaValidationReportFuncExpr(n)
+	case *JSONSearchExpr:
+		enc.RefOfJSONSearchExpr(n)
+	case *JSONStorageFreeExpr:
+		enc.RefOfJSONStorageFreeExpr(n)
+	case *JSONStorageSizeExpr:
+		enc.RefOfJSONStorageSizeExpr(n)
+	case *JSONTableExpr:
+		enc.RefOfJSONTableExpr(n)
+	case *JSONUnquoteExpr:
+		enc.RefOfJSONUnquoteExpr(n)
+	case *JSONValueExpr:
+		enc.RefOfJSONValueExpr(n)
+	case *JSONValueMergeExpr:
+		enc.RefOfJSONValueMergeExpr(n)
+	case *JSONValueModifierExpr:
+		enc.RefOfJSONValueModifierExpr(n)
+	case *JoinCondition:
+		enc.RefOfJoinCondition(n)
+	case *JoinTableExpr:
+		enc.RefOfJoinTableExpr(n)
+	case *JtColumnDefinition:
+		enc.RefOfJtColumnDefinition(n)
+	case *JtOnResponse:
+		enc.RefOfJtOnResponse(n)
+	case *KeyState:
+		enc.RefOfKeyState(n)
+	case *LagLeadExpr:
+		enc.RefOfLagLeadExpr(n)
+	case *LeaveStatement:
+		enc.RefOfLeaveStatement(n)
+	case *Limit:
+		enc.RefOfLimit(n)
+	case *LineStringExpr:
+		enc.RefOfLineStringExpr(n)
+	case *LinestrPropertyFuncExpr:
+		enc.RefOfLinestrPropertyFuncExpr(n)
+	case ListArg:
+		enc.openNode("ListArg")
+		enc.field("Value")
+		enc.value(n)
+		enc.closeObject()
+	case *Literal:
+		enc.RefOfLiteral(n)
+	case *Load:
+		enc.RefOfLoad(n)
+	case *LoadFields:
+		enc.RefOfLoadFields(n)
+	case *LoadLines:
+		enc.RefOfLoadLines(n)
+	case *LocateExpr:
+		enc.RefOfLocateExpr(n)
+	case *LockOption:
+		enc.RefOfLockOption(n)
+	case *LockTables:
+		enc.RefOfLockTables(n)
+	case *LockingFunc:
+		enc.RefOfLockingFunc(n)
+	case *LoopStatement:
+		enc.RefOfLoopStatement(n)
+	case MatchAction:
+		enc.openNode("MatchAction")
+		enc.field("Value")
+		enc.value(n)
+		enc.closeObject()
+	case *MatchExpr:
+		enc.RefOfMatchExpr(n)
+	case *Max:
+		enc.RefOfMax(n)
+	case *MemberOfExpr:
+		enc.RefOfMemberOfExpr(n)
+	case *Min:
+		enc.RefOfMin(n)
+	case *ModifyColumn:
+		enc.RefOfModifyColumn(n)
+	case *MultiLinestringExpr:
+		enc.RefOfMultiLinestringExpr(n)
+	case *MultiPointExpr:
+		enc.RefOfMultiPointExpr(n)
+	case *MultiPolygonExpr:
+		enc.RefOfMultiPolygonExpr(n)
+	case *NTHValueExpr:
+		enc.RefOfNTHValueExpr(n)
+	case *NamedWindow:
+		enc.RefOfNamedWindow(n)
+	case NamedWindows:
+		enc.openNode("NamedWindows")
+		enc.field("Value")
+		enc.NamedWindows(n)
+		enc.closeObject()
+	case *Nextval:
+		enc.RefOfNextval(n)
+	case *NotExpr:
+		enc.RefOfNotExpr(n)
+	case *NtileExpr:
+		enc.RefOfNtileExpr(n)
+	case *NullTreatmentClause:
+		enc.RefOfNullTreatmentClause(n)
+	case *NullVal:
+		enc.RefOfNullVal(n)
+	case *Offset:
+		enc.RefOfOffset(n)
+	case *OnConflict:
+		enc.RefOfOnConflict(n)
+	case OnDup:
+		enc.openNode("OnDup")
+		enc.field("Value")
+		enc.OnDup(n)
+		enc.closeObject()
+	case *OpenCursor:
+		enc.RefOfOpenCursor(n)
+	case *OptLike:
+		enc.RefOfOptLike(n)
+	case *OrExpr:
+		enc.RefOfOrExpr(n)
+	case *Order:
+		enc.RefOfOrder(n)
+	case OrderBy:
+		enc.openNode("OrderBy")
+		enc.field("Value")
+		enc.OrderBy(n)
+		enc.closeObject()
+	case *OrderByOption:
+		enc.RefOfOrderByOption(n)
+	case *OtherAdmin:
+		enc.RefOfOtherAdmin(n)
+	case *OtherRead:
+		enc.RefOfOtherRead(n)
+	case *OverClause:
+		enc.RefOfOverClause(n)
+	case *ParenTableExpr:
+		enc.RefOfParenTableExpr(n)
+	case *ParsedComments:
+		enc.RefOfParsedComments(n)
+	case *PartitionDefinition:
+		enc.RefOfPartitionDefinition(n)
+	case *PartitionDefinitionOptions:
+		enc.RefOfPartitionDefinitionOptions(n)
+	case *PartitionEngine:
+		enc.RefOfPartitionEngine(n)
+	case *PartitionOption:
+		enc.RefOfPartitionOption(n)
+	case *PartitionSpec:
+		enc.RefOfPartitionSpec(n)
+	case *PartitionValueRange:
+		enc.RefOfPartitionValueRange(n)
+	case Partitions:
+		enc.openNode("Partitions")
+		enc.field("Value")
+		enc.Partitions(n)
+		enc.closeObject()
+	case *PasswordExpire:
+		enc.RefOfPasswordExpire(n)
+	case *PerformanceSchemaFuncExpr:
+		enc.RefOfPerformanceSchemaFuncExpr(n)
+	case *PointExpr:
+		enc.RefOfPointExpr(n)
+	case *PointPropertyFuncExpr:
+		enc.RefOfPointPropertyFuncExpr(n)
+	case *PolygonExpr:
+		enc.RefOfPolygonExpr(n)
+	case *PolygonPropertyFuncExpr:
+		enc.RefOfPolygonPropertyFuncExpr(n)
+	case *PositionalArg:
+		enc.RefOfPositionalArg(n)
+	case *PrepareStmt:
+		enc.RefOfPrepareStmt(n)
+	case *PrivilegeLevel:
+		enc.RefOfPrivilegeLevel(n)
+	case *ProcParameter:
+		enc.RefOfProcParameter(n)
+	case *PurgeBinaryLogs:
+		enc.RefOfPurgeBinaryLogs(n)
+	case ReferenceAction:
+		enc.openNode("ReferenceAction")
+		enc.field("Value")
+		enc.value(n)
+		enc.closeObject()
+	case *ReferenceDefinition:
+		enc.RefOfReferenceDefinition(n)
+	case *RegexpInstrExpr:
+		enc.RefOfRegexpInstrExpr(n)
+	case *RegexpLikeExpr:
+		enc.RefOfRegexpLikeExpr(n)
+	case *RegexpReplaceExpr:
+		enc.RefOfRegexpReplaceExpr(n)
+	case *RegexpSubstrExpr:
+		enc.RefOfRegexpSubstrExpr(n)
+	case *Release:
+		enc.RefOfRelease(n)
+	case *RenameColumn:
+		enc.RefOfRenameColumn(n)
+	case *RenameIndex:
+		enc.RefOfRenameIndex(n)
+	case *RenameTable:
+		enc.RefOfRenameTable(n)
+	case *RenameTableName:
+		enc.RefOfRenameTableName(n)
+	case *RenameUser:
+		enc.RefOfRenameUser(n)
+	case *RenameUserPair:
+		enc.RefOfRenameUserPair(n)
+	case *RepeatStatement:
+		enc.RefOfRepeatStatement(n)
+	case *ReturnStatement:
+		enc.RefOfReturnStatement(n)
+	case *RevertMigration:
+		enc.RefOfRevertMigration(n)
+	case *Revoke:
+		enc.RefOfRevoke(n)
+	case *Rollback:
+		enc.RefOfRollback(n)
+	case RootNode:
+		enc.RootNode(n)
+	case *RoutineCharacteristic:
+		enc.RefOfRoutineCharacteristic(n)
+	case *RoutineSet:
+		enc.RefOfRoutineSet(n)
+	case *RoutineSetExpr:
+		enc.RefOfRoutineSetExpr(n)
+	case RoutineSetExprs:
+		enc.openNode("RoutineSetExprs")
+		enc.field("Value")
+		enc.RoutineSetExprs(n)
+		enc.closeObject()
+	case *SRollback:
+		enc.RefOfSRollback(n)
+	case *Savepoint:
+		enc.RefOfSavepoint(n)
+	case *Select:
+		enc.RefOfSelect(n)
+	case SelectExprs:
+		enc.openNode("SelectExprs")
+		enc.field("Value")
+		enc.SelectExprs(n)
+		enc.closeObject()
+	case *SelectInto:
+		enc.RefOfSelectInto(n)
+	case *Set:
+		enc.RefOfSet(n)
+	case *SetDefaultRole:
+		enc.RefOfSetDefaultRole(n)
+	case *SetExpr:
+		enc.RefOfSetExpr(n)
+	case SetExprs:
+		enc.openNode("SetExprs")
+		enc.field("Value")
+		enc.SetExprs(n)
+		enc.closeObject()
+	case *SetPassword:
+		enc.RefOfSetPassword(n)
+	case *SetRole:
+		enc.RefOfSetRole(n)
+	case *Show:
+		enc.RefOfShow(n)
+	case *ShowBasic:
+		enc.RefOfShowBasic(n)
+	case *ShowCreate:
+		enc.RefOfShowCreate(n)
+	case *ShowFilter:
+		enc.RefOfShowFilter(n)
+	case *ShowMigrationLogs:
+		enc.RefOfShowMigrationLogs(n)
+	case *ShowOther:
+		enc.RefOfShowOther(n)
+	case *ShowThrottledApps:
+		enc.RefOfShowThrottledApps(n)
+	case *ShowThrottlerStatus:
+		enc.RefOfShowThrottlerStatus(n)
+	case *Signal:
+		enc.RefOfSignal(n)
+	case *SignalItem:
+		enc.RefOfSignalItem(n)
+	case *StarExpr:
+		enc.RefOfStarExpr(n)
+	case StatementList:
+		enc.openNode("StatementList")
+		enc.field("Value")
+		enc.StatementList(n)
+		enc.closeObject()
+	case *Std:
+		enc.RefOfStd(n)
+	case *StdDev:
+		enc.RefOfStdDev(n)
+	case *StdPop:
+		enc.RefOfStdPop(n)
+	case *StdSamp:
+		enc.RefOfStdSamp(n)
+	case *Stream:
+		enc.RefOfStream(n)
+	case *SubPartition:
+		enc.RefOfSubPartition(n)
+	case *SubPartitionDefinition:
+		enc.RefOfSubPartitionDefinition(n)
+	case *SubPartitionDefinitionOptions:
+		enc.RefOfSubPartitionDefinitionOptions(n)
+	case SubPartitionDefinitions:
+		enc.openNode("SubPartitionDefinitions")
+		enc.field("Value")
+		enc.SubPartitionDefinitions(n)
+		enc.closeObject()
+	case *Subquery:
+		enc.RefOfSubquery(n)
+	case *SubstrExpr:
+		enc.RefOfSubstrExpr(n)
+	case *Sum:
+		enc.RefOfSum(n)
+	case TableExprs:
+		enc.openNode("TableExprs")
+		enc.field("Value")
+		enc.TableExprs(n)
+		enc.closeObject()
+	case TableName:
+		enc.TableName(n)
+	case TableNames:
+		enc.openNode("TableNames")
+		enc.field("Value")
+		enc.TableNames(n)
+		enc.closeObject()
+	case TableOptions:
+		enc.openNode("TableOptions")
+		enc.field("Value")
+		enc.TableOptions(n)
+		enc.closeObject()
+	case *TableSpec:
+		enc.RefOfTableSpec(n)
+	case *TablespaceOperation:
+		enc.RefOfTablespaceOperation(n)
+	case *TimestampFuncExpr:
+		enc.RefOfTimestampFuncExpr(n)
+	case *TriggerOrder:
+		enc.RefOfTriggerOrder(n)
+	case *TrimFuncExpr:
+		enc.RefOfTrimFuncExpr(n)
+	case *TruncateTable:
+		enc.RefOfTruncateTable(n)
+	case *TypeCastExpr:
+		enc.RefOfTypeCastExpr(n)
+	case *UnaryExpr:
+		enc.RefOfUnaryExpr(n)
+	case *Union:
+		enc.RefOfUnion(n)
+	case *UnlockTables:
+		enc.RefOfUnlockTables(n)
+	case *Update:
+		enc.RefOfUpdate(n)
+	case *UpdateExpr:
+		enc.RefOfUpdateExpr(n)
+	case UpdateExprs:
+		enc.openNode("UpdateExprs")
+		enc.field("Value")
+		enc.UpdateExprs(n)
+		enc.closeObject()
+	case *UpdateXMLExpr:
+		enc.RefOfUpdateXMLExpr(n)
+	case *Use:
+		enc.RefOfUse(n)
+	case *UserSpec:
+		enc.RefOfUserSpec(n)
+	case *VExplainStmt:
+		enc.RefOfVExplainStmt(n)
+	case *VStream:
+		enc.RefOfVStream(n)
+	case ValTuple:
+		enc.openNode("ValTuple")
+		enc.field("Value")
+		enc.ValTuple(n)
+		enc.closeObject()
+	case *Validation:
+		enc.RefOfValidation(n)
+	case Values:
+		enc.openNode("Values")
+		enc.field("Value")
+		enc.Values(n)
+		enc.closeObject()
+	case *ValuesFuncExpr:
+		enc.RefOfValuesFuncExpr(n)
+	case *VarPop:
+		enc.RefOfVarPop(n)
+	case *VarSamp:
+		enc.RefOfVarSamp(n)
+	case *Variable:
+		enc.RefOfVariable(n)
+	case *Variance:
+		enc.RefOfVariance(n)
+	case VindexParam:
+		enc.VindexParam(n)
+	case *VindexSpec:
+		enc.RefOfVindexSpec(n)
+	case *WeightStringFuncExpr:
+		enc.RefOfWeightStringFuncExpr(n)
+	case *When:
+		enc.RefOfWhen(n)
+	case *Where:
+		enc.RefOfWhere(n)
+	case *WhileStatement:
+		enc.RefOfWhileStatement(n)
+	case *WindowDefinition:
+		enc.RefOfWindowDefinition(n)
+	case WindowDefinitions:
+		enc.openNode("WindowDefinitions")
+		enc.field("Value")
+		enc.WindowDefinitions(n)
+		enc.closeObject()
+	case *WindowSpecification:
+		enc.RefOfWindowSpecification(n)
+	case *With:
+		enc.RefOfWith(n)
+	case *XorExpr:
+		enc.RefOfXorExpr(n)
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// SQLNode decodes the value from JSON.
+func (dec *jsonDecoder) SQLNode(data json.RawMessage) SQLNode {
+	tag, fields := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "Account":
+		return dec.RefOfAccount(data)
+	case "AddColumns":
+		return dec.RefOfAddColumns(data)
+	case "AddConstraintDefinition":
+		return dec.RefOfAddConstraintDefinition(data)
+	case "AddIndexDefinition":
+		return dec.RefOfAddIndexDefinition(data)
+	case "AlgorithmValue":
+		var out AlgorithmValue
+		dec.value(fields["Value"], &out)
+		return out
+	case "AliasedExpr":
+		return dec.RefOfAliasedExpr(data)
+	case "AliasedTableExpr":
+		return dec.RefOfAliasedTableExpr(data)
+	case "AlterCharset":
+		return dec.RefOfAlterCharset(data)
+	case "AlterCheck":
+		return dec.RefOfAlterCheck(data)
+	case "AlterColumn":
+		return dec.RefOfAlterColumn(data)
+	case "AlterDatabase":
+		return dec.RefOfAlterDatabase(data)
+	case "AlterEvent":
+		return dec.RefOfAlterEvent(data)
+	case "AlterFunction":
+		return dec.RefOfAlterFunction(data)
+	case "AlterIndex":
+		return dec.RefOfAlterIndex(data)
+	case "AlterMigration":
+		return dec.RefOfAlterMigration(data)
+	case "AlterProcedure":
+		return dec.RefOfAlterProcedure(data)
+	case "AlterTable":
+		return dec.RefOfAlterTable(data)
+	case "AlterUser":
+		return dec.RefOfAlterUser(data)
+	case "AlterView":
+		return dec.RefOfAlterView(data)
+	case "AlterVschema":
+		return dec.RefOfAlterVschema(data)
+	case "AndExpr":
+		return dec.RefOfAndExpr(data)
+	case "Argument":
+		return dec.RefOfArgument(data)
+	case "ArgumentLessWindowExpr":
+		return dec.RefOfArgumentLessWindowExpr(data)
+	case "AssignmentExpr":
+		return dec.RefOfAssignmentExpr(data)
+	case "Authentication":
+		return dec.RefOfAuthentication(data)
+	case "AutoIncSpec":
+		return dec.RefOfAutoIncSpec(data)
+	case "Avg":
+		return dec.RefOfAvg(data)
+	case "BadStatement":
+		return dec.RefOfBadStatement(data)
+	case "Begin":
+		return dec.RefOfBegin(data)
+	case "BeginEndBlock":
+		return dec.RefOfBeginEndBlock(data)
+	case "BetweenExpr":
+		return dec.RefOfBetweenExpr(data)
+	case "BinaryExpr":
+		return dec.RefOfBinaryExpr(data)
+	case "BitAnd":
+		return dec.RefOfBitAnd(data)
+	case "BitOr":
+		return dec.RefOfBitOr(data)
+	case "BitXor":
+		return dec.RefOfBitXor(data)
+	case "BoolVal":
+		var out BoolVal
+		dec.value(fields["Value"], &out)
+		return out
+	case "CallProc":
+		return dec.RefOfCallProc(data)
+	case "CaseExpr":
+		return dec.RefOfCaseExpr(data)
+	case "CaseStatement":
+		return dec.RefOfCaseStatement(data)
+	case "CaseStatementWhen":
+		return dec.RefOfCaseStatementWhen(data)
+	case "CastExpr":
+		return dec.RefOfCastExpr(data)
+	case "ChangeColumn":
+		return dec.RefOfChangeColumn(data)
+	case "CharExpr":
+		return dec.RefOfCharExpr(data)
+	case "CheckConstraintDefinition":
+		return dec.RefOfCheckConstraintDefinition(data)
+	case "CloseCursor":
+		return dec.RefOfCloseCursor(data)
+	case "ColName":
+		return dec.RefOfColName(data)
+	case "CollateExpr":
+		return dec.RefOfCollateExpr(data)
+	case "ColumnDefinition":
+		return dec.RefOfColumnDefinition(data)
+	case "ColumnType":
+		return dec.RefOfColumnType(data)
+	case "Columns":
+		var out Columns
+		out = dec.Columns(fields["Value"])
+		return out
+	case "CommentOnly":
+		return dec.RefOfCommentOnly(data)
+	case "Commit":
+		return dec.RefOfCommit(data)
+	case "CommonTableExpr":
+		return dec.RefOfCommonTableExpr(data)
+	case "ComparisonExpr":
+		return dec.RefOfComparisonExpr(data)
+	case "ConditionValue":
+		return dec.RefOfConditionValue(data)
+	case "ConstraintDefinition":
+		return dec.RefOfConstraintDefinition(data)
+	case "ConvertExpr":
+		return dec.RefOfConvertExpr(data)
+	case "ConvertType":
+		return dec.RefOfConvertType(data)
+	case "ConvertUsingExpr":
+		return dec.RefOfConvertUsingExpr(data)
+	case "Count":
+		return dec.RefOfCount(data)
+	case "CountStar":
+		return dec.RefOfCountStar(data)
+	case "CreateDatabase":
+		return dec.RefOfCreateDatabase(data)
+	case "CreateEvent":
+		return dec.RefOfCreateEvent(data)
+	case "CreateFunction":
+		return dec.RefOfCreateFunction(data)
+	case "CreateProcedure":
+		return dec.RefOfCreateProcedure(data)
+	case "CreateRole":
+		return dec.RefOfCreateRole(data)
+	case "CreateTable":
+		return dec.RefOfCreateTable(data)
+	case "CreateTrigger":
+		return dec.RefOfCreateTrigger(data)
+	case "CreateUser":
+		return dec.RefOfCreateUser(data)
+	case "CreateView":
+		return dec.RefOfCreateView(data)
+	case "CurTimeFuncExpr":
+		return dec.RefOfCurTimeFuncExpr(data)
+	case "DateAddExpr":
+		return dec.RefOfDateAddExpr(data)
+	case "DateSubExpr":
+		return dec.RefOfDateSubExpr(data)
+	case "DeallocateStmt":
+		return dec.RefOfDeallocateStmt(data)
+	case "DeclareCondition":
+		return dec.RefOfDeclareCondition(data)
+	case "DeclareCursor":
+		return dec.RefOfDeclareCursor(data)
+	case "DeclareHandler":
+		return dec.RefOfDeclareHandler(data)
+	case "DeclareVar":
+		return dec.RefOfDeclareVar(data)
+	case "Default":
+		return dec.RefOfDefault(data)
+	case "Definer":
+		return dec.RefOfDefiner(data)
+	case "Delete":
+		return dec.RefOfDelete(data)
+	case "DerivedTable":
+		return dec.RefOfDerivedTable(data)
+	case "DropColumn":
+		return dec.RefOfDropColumn(data)
+	case "DropDatabase":
+		return dec.RefOfDropDatabase(data)
+	case "DropEvent":
+		return dec.RefOfDropEvent(data)
+	case "DropFunction":
+		return dec.RefOfDropFunction(data)
+	case "DropKey":
+		return dec.RefOfDropKey(data)
+	case "DropProcedure":
+		return dec.RefOfDropProcedure(data)
+	case "DropRole":
+		return dec.RefOfDropRole(data)
+	case "DropTable":
+		return dec.RefOfDropTable(data)
+	case "DropTrigger":
+		return dec.RefOfDropTrigger(data)
+	case "DropUser":
+		return dec.RefOfDropUser(data)
+	case "DropView":
+		return dec.RefOfDropView(data)
+	case "ElseIfBlock":
+		return dec.RefOfElseIfBlock(data)
+	case "EventSchedule":
+		return dec.RefOfEventSchedule(data)
+	case "ExecuteStmt":
+		return dec.RefOfExecuteStmt(data)
+	case "ExistsExpr":
+		return dec.RefOfExistsExpr(data)
+	case "ExplainStmt":
+		return dec.RefOfExplainStmt(data)
+	case "ExplainTab":
+		return dec.RefOfExplainTab(data)
+	case "Exprs":
+		var out Exprs
+		out = dec.Exprs(fields["Value"])
+		return out
+	case "ExtractFuncExpr":
+		return dec.RefOfExtractFuncExpr(data)
+	case "ExtractValueExpr":
+		return dec.RefOfExtractValueExpr(data)
+	case "ExtractedSubquery":
+		return dec.RefOfExtractedSubquery(data)
+	case "FetchCursor":
+		return dec.RefOfFetchCursor(data)
+	case "FirstOrLastValueExpr":
+		return dec.RefOfFirstOrLastValueExpr(data)
+	case "Flush":
+		return dec.RefOfFlush(data)
+	case "Force":
+		return dec.RefOfForce(data)
+	case "ForeignKeyDefinition":
+		return dec.RefOfForeignKeyDefinition(data)
+	case "FrameClause":
+		return dec.RefOfFrameClause(data)
+	case "FramePoint":
+		return dec.RefOfFramePoint(data)
+	case "FromFirstLastClause":
+		return dec.RefOfFromFirstLastClause(data)
+	case "FuncExpr":
+		return dec.RefOfFuncExpr(data)
+	case "GTIDFuncExpr":
+		return dec.RefOfGTIDFuncExpr(data)
+	case "GeoHashFromLatLongExpr":
+		return dec.RefOfGeoHashFromLatLongExpr(data)
+	case "GeoHashFromPointExpr":
+		return dec.RefOfGeoHashFromPointExpr(data)
+	case "GeoJSONFromGeomExpr":
+		return dec.RefOfGeoJSONFromGeomExpr(data)
+	case "GeomCollPropertyFuncExpr":
+		return dec.RefOfGeomCollPropertyFuncExpr(data)
+	case "GeomFormatExpr":
+		return dec.RefOfGeomFormatExpr(data)
+	case "GeomFromGeoHashExpr":
+		return dec.RefOfGeomFromGeoHashExpr(data)
+	case "GeomFromGeoJSONExpr":
+		return dec.RefOfGeomFromGeoJSONExpr(data)
+	case "GeomFromTextExpr":
+		return dec.RefOfGeomFromTextExpr(data)
+	case "GeomFromWKBExpr":
+		return dec.RefOfGeomFromWKBExpr(data)
+	case "GeomPropertyFuncExpr":
+		return dec.RefOfGeomPropertyFuncExpr(data)
+	case "Grant":
+		return dec.RefOfGrant(data)
+	case "GrantPrivilege":
+		return dec.RefOfGrantPrivilege(data)
+	case "GroupBy":
+		var out GroupBy
+		out = dec.GroupBy(fields["Value"])
+		return out
+	case "GroupConcatExpr":
+		return dec.RefOfGroupConcatExpr(data)
+	case "IdentifierCI":
+		var out IdentifierCI
+		dec.value(fields["Value"], &out)
+		return out
+	case "IdentifierCS":
+		var out IdentifierCS
+		dec.value(fields["Value"], &out)
+		return out
+	case "IfStatement":
+		return dec.RefOfIfStatement(data)
+	case "IndexDefinition":
+		return dec.RefOfIndexDefinition(data)
+	case "IndexHint":
+		return dec.RefOfIndexHint(data)
+	case "IndexHints":
+		var out IndexHints
+		out = dec.IndexHints(fields["Value"])
+		return out
+	case "IndexInfo":
+		return dec.RefOfIndexInfo(data)
+	case "Insert":
+		return dec.RefOfInsert(data)
+	case "InsertExpr":
+		return dec.RefOfInsertExpr(data)
+	case "IntervalFuncExpr":
+		return dec.RefOfIntervalFuncExpr(data)
+	case "IntroducerExpr":
+		return dec.RefOfIntroducerExpr(data)
+	case "IsExpr":
+		return dec.RefOfIsExpr(data)
+	case "IterateStatement":
+		return dec.RefOfIterateStatement(data)
+	case "JSONArrayExpr":
+		return dec.RefOfJSONArrayExpr(data)
+	case "JSONAttributesExpr":
+		return dec.RefOfJSONAttributesExpr(data)
+	case "JSONContainsExpr":
+		return dec.RefOfJSONContainsExpr(data)
+	case "JSONContainsPathExpr":
+		return dec.RefOfJSONContainsPathExpr(data)
+	case "JSONExtractExpr":
+		return dec.RefOfJSONExtractExpr(data)
+	case "JSONKeysExpr":
+		return dec.RefOfJSONKeysExpr(data)
+	case "JSONObjectExpr":
+		return dec.RefOfJSONObjectExpr(data)
+	case "JSONObjectParam":
+		return dec.RefOfJSONObjectParam(data)
+	case "JSONOverlapsExpr":
+		return dec.RefOfJSONOverlapsExpr(data)
+	case "JSONPrettyExpr":
+		return dec.RefOfJSONPrettyExpr(data)
+	case "JSONQuoteExpr":
+		return dec.RefOfJSONQuoteExpr(data)
+	case "JSONRemoveExpr":
+		return dec.RefOfJSONRemoveExpr(data)
+	case "JSONSchemaValidFuncExpr":
+		return dec.RefOfJSONSchemaValidFuncExpr(data)
+	case "JSONSchemaValidationReportFuncExpr":
+		return dec.RefOfJSONSchemaValidationReportFuncExpr(data)
+	case "JSONSearchExpr":
+		return dec.RefOfJSONSearchExpr(data)
+	case "JSONStorageFreeExpr":
+		return dec.RefOfJSONStorageFreeExpr(data)
+	case "JSONStorageSizeExpr":
+		return dec.RefOfJSONStorageSizeExpr(data)
+	case "JSONTableExpr":
+		return dec.RefOfJSONTableExpr(data)
+	case "JSONUnquoteExpr":
+		return dec.RefOfJSONUnquoteExpr(data)
+	case "JSONValueExpr":
+		return dec.RefOfJSONValueExpr(data)
+	case "JSONValueMergeExpr":
+		return dec.RefOfJSONValueMergeExpr(data)
+	case "JSONValueModifierExpr":
+		return dec.RefOfJSONValueModifierExpr(data)
+	case "JoinCondition":
+		return dec.RefOfJoinCondition(data)
+	case "JoinTableExpr":
+		return dec.RefOfJoinTableExpr(data)
+	case "JtColumnDefinition":
+		return dec.RefOfJtColumnDefinition(data)
+	case "JtOnResponse":
+		return dec.RefOfJtOnResponse(data)
+	case "KeyState":
+		return dec.RefOfKeyState(data)
+	case "LagLeadExpr":
+		return dec.RefOfLagLeadExpr(data)
+	case "LeaveStatement":
+		return dec.RefOfLeaveStatement(data)
+	case "Limit":
+		return dec.RefOfLimit(data)
+	case "LineStringExpr":
+		return dec.RefOfLineStringExpr(data)
+	case "LinestrPropertyFuncExpr":
+		return dec.RefOfLinestrPropertyFuncExpr(data)
+	case "ListArg":
+		var out ListArg
+		dec.value(fields["Value"], &out)
+		return out
+	case "Literal":
+		return dec.RefOfLiteral(data)
+	case "Load":
+		return dec.RefOfLoad(data)
+	case "LoadFields":
+		return dec.RefOfLoadFields(data)
+	case "LoadLines":
+		return dec.RefOfLoadLines(data)
+	case "LocateExpr":
+		return dec.RefOfLocateExpr(data)
+	case "LockOption":
+		return dec.RefOfLockOption(data)
+	case "LockTables":
+		return dec.RefOfLockTables(data)
+	case "LockingFunc":
+		return dec.RefOfLockingFunc(data)
+	case "LoopStatement":
+		return dec.RefOfLoopStatement(data)
+	case "MatchAction":
+		var out MatchAction
+		dec.value(fields["Value"], &out)
+		return out
+	case "MatchExpr":
+		return dec.RefOfMatchExpr(data)
+	case "Max":
+		return dec.RefOfMax(data)
+	case "MemberOfExpr":
+		return dec.RefOfMemberOfExpr(data)
+	case "Min":
+		return dec.RefOfMin(data)
+	case "ModifyColumn":
+		return dec.RefOfModifyColumn(data)
+	case "MultiLinestringExpr":
+		return dec.RefOfMultiLinestringExpr(data)
+	case "MultiPointExpr":
+		return dec.RefOfMultiPointExpr(data)
+	case "MultiPolygonExpr":
+		return dec.RefOfMultiPolygonExpr(data)
+	case "NTHValueExpr":
+		return dec.RefOfNTHValueExpr(data)
+	case "NamedWindow":
+		return dec.RefOfNamedWindow(data)
+	case "NamedWindows":
+		var out NamedWindows
+		out = dec.NamedWindows(fields["Value"])
+		return out
+	case "Nextval":
+		return dec.RefOfNextval(data)
+	case "NotExpr":
+		return dec.RefOfNotExpr(data)
+	case "NtileExpr":
+		return dec.RefOfNtileExpr(data)
+	case "NullTreatmentClause":
+		return dec.RefOfNullTreatmentClause(data)
+	case "NullVal":
+		return dec.RefOfNullVal(data)
+	case "Offset":
+		return dec.RefOfOffset(data)
+	case "OnConflict":
+		return dec.RefOfOnConflict(data)
+	case "OnDup":
+		var out OnDup
+		out = dec.OnDup(fields["Value"])
+		return out
+	case "OpenCursor":
+		return dec.RefOfOpenCursor(data)
+	case "OptLike":
+		return dec.RefOfOptLike(data)
+	case "OrExpr":
+		return dec.RefOfOrExpr(data)
+	case "Order":
+		return dec.RefOfOrder(data)
+	case "OrderBy":
+		var out OrderBy
+		out = dec.OrderBy(fields["Value"])
+		return out
+	case "OrderByOption":
+		return dec.RefOfOrderByOption(data)
+	case "OtherAdmin":
+		return dec.RefOfOtherAdmin(data)
+	case "OtherRead":
+		return dec.RefOfOtherRead(data)
+	case "OverClause":
+		return dec.RefOfOverClause(data)
+	case "ParenTableExpr":
+		return dec.RefOfParenTableExpr(data)
+	case "ParsedComments":
+		return dec.RefOfParsedComments(data)
+	case "PartitionDefinition":
+		return dec.RefOfPartitionDefinition(data)
+	case "PartitionDefinitionOptions":
+		return dec.RefOfPartitionDefinitionOptions(data)
+	case "PartitionEngine":
+		return dec.RefOfPartitionEngine(data)
+	case "PartitionOption":
+		return dec.RefOfPartitionOption(data)
+	case "PartitionSpec":
+		return dec.RefOfPartitionSpec(data)
+	case "PartitionValueRange":
+		return dec.RefOfPartitionValueRange(data)
+	case "Partitions":
+		var out Partitions
+		out = dec.Partitions(fields["Value"])
+		return out
+	case "PasswordExpire":
+		return dec.RefOfPasswordExpire(data)
+	case "PerformanceSchemaFuncExpr":
+		return dec.RefOfPerformanceSchemaFuncExpr(data)
+	case "PointExpr":
+		return dec.RefOfPointExpr(data)
+	case "PointPropertyFuncExpr":
+		return dec.RefOfPointPropertyFuncExpr(data)
+	case "PolygonExpr":
+		return dec.RefOfPolygonExpr(data)
+	case "PolygonPropertyFuncExpr":
+		return dec.RefOfPolygonPropertyFuncExpr(data)
+	case "PositionalArg":
+		return dec.RefOfPositionalArg(data)
+	case "PrepareStmt":
+		return dec.RefOfPrepareStmt(data)
+	case "PrivilegeLevel":
+		return dec.RefOfPrivilegeLevel(data)
+	case "ProcParameter":
+		return dec.RefOfProcParameter(data)
+	case "PurgeBinaryLogs":
+		return dec.RefOfPurgeBinaryLogs(data)
+	case "ReferenceAction":
+		var out ReferenceAction
+		dec.value(fields["Value"], &out)
+		return out
+	case "ReferenceDefinition":
+		return dec.RefOfReferenceDefinition(data)
+	case "RegexpInstrExpr":
+		return dec.RefOfRegexpInstrExpr(data)
+	case "RegexpLikeExpr":
+		return dec.RefOfRegexpLikeExpr(data)
+	case "RegexpReplaceExpr":
+		return dec.RefOfRegexpReplaceExpr(data)
+	case "RegexpSubstrExpr":
+		return dec.RefOfRegexpSubstrExpr(data)
+	case "Release":
+		return dec.RefOfRelease(data)
+	case "RenameColumn":
+		return dec.RefOfRenameColumn(data)
+	case "RenameIndex":
+		return dec.RefOfRenameIndex(data)
+	case "RenameTable":
+		return dec.RefOfRenameTable(data)
+	case "RenameTableName":
+		return dec.RefOfRenameTableName(data)
+	case "RenameUser":
+		return dec.RefOfRenameUser(data)
+	case "RenameUserPair":
+		return dec.RefOfRenameUserPair(data)
+	case "RepeatStatement":
+		return dec.RefOfRepeatStatement(data)
+	case "ReturnStatement":
+		return dec.RefOfReturnStatement(data)
+	case "RevertMigration":
+		return dec.RefOfRevertMigration(data)
+	case "Revoke":
+		return dec.RefOfRevoke(data)
+	case "Rollback":
+		return dec.RefOfRollback(data)
+	case "RootNode":
+		return dec.RootNode(data)
+	case "RoutineCharacteristic":
+		return dec.RefOfRoutineCharacteristic(data)
+	case "RoutineSet":
+		return dec.RefOfRoutineSet(data)
+	case "RoutineSetExpr":
+		return dec.RefOfRoutineSetExpr(data)
+	case "RoutineSetExprs":
+		var out RoutineSetExprs
+		out = dec.RoutineSetExprs(fields["Value"])
+		return out
+	case "SRollback":
+		return dec.RefOfSRollback(data)
+	case "Savepoint":
+		return dec.RefOfSavepoint(data)
+	case "Select":
+		return dec.RefOfSelect(data)
+	case "SelectExprs":
+		var out SelectExprs
+		out = dec.SelectExprs(fields["Value"])
+		return out
+	case "SelectInto":
+		return dec.RefOfSelectInto(data)
+	case "Set":
+		return dec.RefOfSet(data)
+	case "SetDefaultRole":
+		return dec.RefOfSetDefaultRole(data)
+	case "SetExpr":
+		return dec.RefOfSetExpr(data)
+	case "SetExprs":
+		var out SetExprs
+		out = dec.SetExprs(fields["Value"])
+		return out
+	case "SetPassword":
+		return dec.RefOfSetPassword(data)
+	case "SetRole":
+		return dec.RefOfSetRole(data)
+	case "Show":
+		return dec.RefOfShow(data)
+	case "ShowBasic":
+		return dec.RefOfShowBasic(data)
+	case "ShowCreate":
+		return dec.RefOfShowCreate(data)
+	case "ShowFilter":
+		return dec.RefOfShowFilter(data)
+	case "ShowMigrationLogs":
+		return dec.RefOfShowMigrationLogs(data)
+	case "ShowOther":
+		return dec.RefOfShowOther(data)
+	case "ShowThrottledApps":
+		return dec.RefOfShowThrottledApps(data)
+	case "ShowThrottlerStatus":
+		return dec.RefOfShowThrottlerStatus(data)
+	case "Signal":
+		return dec.RefOfSignal(data)
+	case "SignalItem":
+		return dec.RefOfSignalItem(data)
+	case "StarExpr":
+		return dec.RefOfStarExpr(data)
+	case "StatementList":
+		var out StatementList
+		out = dec.StatementList(fields["Value"])
+		return out
+	case "Std":
+		return dec.RefOfStd(data)
+	case "StdDev":
+		return dec.RefOfStdDev(data)
+	case "StdPop":
+		return dec.RefOfStdPop(data)
+	case "StdSamp":
+		return dec.RefOfStdSamp(data)
+	case "Stream":
+		return dec.RefOfStream(data)
+	case "SubPartition":
+		return dec.RefOfSubPartition(data)
+	case "SubPartitionDefinition":
+		return dec.RefOfSubPartitionDefinition(data)
+	case "SubPartitionDefinitionOptions":
+		return dec.RefOfSubPartitionDefinitionOptions(data)
+	case "SubPartitionDefinitions":
+		var out SubPartitionDefinitions
+		out = dec.SubPartitionDefinitions(fields["Value"])
+		return out
+	case "Subquery":
+		return dec.RefOfSubquery(data)
+	case "SubstrExpr":
+		return dec.RefOfSubstrExpr(data)
+	case "Sum":
+		return dec.RefOfSum(data)
+	case "TableExprs":
+		var out TableExprs
+		out = dec.TableExprs(fields["Value"])
+		return out
+	case "TableName":
+		return dec.TableName(data)
+	case "TableNames":
+		var out TableNames
+		out = dec.TableNames(fields["Value"])
+		return out
+	case "TableOptions":
+		var out TableOptions
+		out = dec.TableOptions(fields["Value"])
+		return out
+	case "TableSpec":
+		return dec.RefOfTableSpec(data)
+	case "TablespaceOperation":
+		return dec.RefOfTablespaceOperation(data)
+	case "TimestampFuncExpr":
+		return dec.RefOfTimestampFuncExpr(data)
+	case "TriggerOrder":
+		return dec.RefOfTriggerOrder(data)
+	case "TrimFuncExpr":
+		return dec.RefOfTrimFuncExpr(data)
+	case "TruncateTable":
+		return dec.RefOfTruncateTable(data)
+	case "TypeCastExpr":
+		return dec.RefOfTypeCastExpr(data)
+	case "UnaryExpr":
+		return dec.RefOfUnaryExpr(data)
+	case "Union":
+		return dec.RefOfUnion(data)
+	case "UnlockTables":
+		return dec.RefOfUnlockTables(data)
+	case "Update":
+		return dec.RefOfUpdate(data)
+	case "UpdateExpr":
+		return dec.RefOfUpdateExpr(data)
+	case "UpdateExprs":
+		var out UpdateExprs
+		out = dec.UpdateExprs(fields["Value"])
+		return out
+	case "UpdateXMLExpr":
+		return dec.RefOfUpdateXMLExpr(data)
+	case "Use":
+		return dec.RefOfUse(data)
+	case "UserSpec":
+		return dec.RefOfUserSpec(data)
+	case "VExplainStmt":
+		return dec.RefOfVExplainStmt(data)
+	case "VStream":
+		return dec.RefOfVStream(data)
+	case "ValTuple":
+		var out ValTuple
+		out = dec.ValTuple(fields["Value"])
+		return out
+	case "Validation":
+		return dec.RefOfValidation(data)
+	case "Values":
+		var out Values
+		out = dec.Values(fields["Value"])
+		return out
+	case "ValuesFuncExpr":
+		return dec.RefOfValuesFuncExpr(data)
+	case "VarPop":
+		return dec.RefOfVarPop(data)
+	case "VarSamp":
+		return dec.RefOfVarSamp(data)
+	case "Variable":
+		return dec.RefOfVariable(data)
+	case "Variance":
+		return dec.RefOfVariance(data)
+	case "VindexParam":
+		return dec.VindexParam(data)
+	case "VindexSpec":
+		return dec.RefOfVindexSpec(data)
+	case "WeightStringFuncExpr":
+		return dec.RefOfWeightStringFuncExpr(data)
+	case "When":
+		return dec.RefOfWhen(data)
+	case "Where":
+		return dec.RefOfWhere(data)
+	case "WhileStatement":
+		return dec.RefOfWhileStatement(data)
+	case "WindowDefinition":
+		return dec.RefOfWindowDefinition(data)
+	case "WindowDefinitions":
+		var out WindowDefinitions
+		out = dec.WindowDefinitions(fields["Value"])
+		return out
+	case "WindowSpecification":
+		return dec.RefOfWindowSpecification(data)
+	case "With":
+		return dec.RefOfWith(data)
+	case "XorExpr":
+		return dec.RefOfXorExpr(data)
+	}
+	dec.unknownTag(tag, "SQLNode")
+	return nil
+}
+
+// RefOfAccount encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAccount(n *Account) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Account")
+	enc.field("User")
+	enc.value(n.User)
+	enc.field("Host")
+	enc.value(n.Host)
+	enc.field("CurrentUser")
+	enc.value(n.CurrentUser)
+	enc.closeObject()
+}
+
+// RefOfAccount decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAccount(data json.RawMessage) *Account {
+	fields := dec.object(data, "Account")
+	if fields == nil {
+		return nil
+	}
+	out := &Account{}
+	dec.value(fields["User"], &out.User)
+	dec.value(fields["Host"], &out.Host)
+	dec.value(fields["CurrentUser"], &out.CurrentUser)
+	return out
+}
+
+// RefOfAddColumns encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAddColumns(n *AddColumns) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AddColumns")
+	enc.field("Columns")
+	enc.SliceOfRefOfColumnDefinition(n.Columns)
+	enc.field("First")
+	enc.value(n.First)
+	enc.field("After")
+	enc.RefOfColName(n.After)
+	enc.closeObject()
+}
+
+// RefOfAddColumns decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAddColumns(data json.RawMessage) *AddColumns {
+	fields := dec.object(data, "AddColumns")
+	if fields == nil {
+		return nil
+	}
+	out := &AddColumns{}
+	out.Columns = dec.SliceOfRefOfColumnDefinition(fields["Columns"])
+	dec.value(fields["First"], &out.First)
+	out.After = dec.RefOfColName(fields["After"])
+	return out
+}
+
+// RefOfAddConstraintDefinition encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAddConstraintDefinition(n *AddConstraintDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AddConstraintDefinition")
+	enc.field("ConstraintDefinition")
+	enc.RefOfConstraintDefinition(n.ConstraintDefinition)
+	enc.closeObject()
+}
+
+// RefOfAddConstraintDefinition decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAddConstraintDefinition(data json.RawMessage) *AddConstraintDefinition {
+	fields := dec.object(data, "AddConstraintDefinition")
+	if fields == nil {
+		return nil
+	}
+	out := &AddConstraintDefinition{}
+	out.ConstraintDefinition = dec.RefOfConstraintDefinition(fields["ConstraintDefinition"])
+	return out
+}
+
+// RefOfAddIndexDefinition encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAddIndexDefinition(n *AddIndexDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AddIndexDefinition")
+	enc.field("IndexDefinition")
+	enc.RefOfIndexDefinition(n.IndexDefinition)
+	enc.closeObject()
+}
+
+// RefOfAddIndexDefinition decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAddIndexDefinition(data json.RawMessage) *AddIndexDefinition {
+	fields := dec.object(data, "AddIndexDefinition")
+	if fields == nil {
+		return nil
+	}
+	out := &AddIndexDefinition{}
+	out.IndexDefinition = dec.RefOfIndexDefinition(fields["IndexDefinition"])
+	return out
+}
+
+// RefOfAliasedExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAliasedExpr(n *AliasedExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AliasedExpr")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("As")
+	enc.value(n.As)
+	enc.closeObject()
+}
+
+// RefOfAliasedExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAliasedExpr(data json.RawMessage) *AliasedExpr {
+	fields := dec.object(data, "AliasedExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &AliasedExpr{}
+	out.Expr = dec.Expr(fields["Expr"])
+	dec.value(fields["As"], &out.As)
+	return out
+}
+
+// RefOfAliasedTableExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAliasedTableExpr(n *AliasedTableExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AliasedTableExpr")
+	enc.field("Expr")
+	enc.SimpleTableExpr(n.Expr)
+	enc.field("Partitions")
+	enc.Partitions(n.Partitions)
+	enc.field("As")
+	enc.value(n.As)
+	enc.field("Hints")
+	enc.IndexHints(n.Hints)
+	enc.field("Columns")
+	enc.Columns(n.Columns)
+	enc.closeObject()
+}
+
+// RefOfAliasedTableExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAliasedTableExpr(data json.RawMessage) *AliasedTableExpr {
+	fields := dec.object(data, "AliasedTableExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &AliasedTableExpr{}
+	out.Expr = dec.SimpleTableExpr(fields["Expr"])
+	out.Partitions = dec.Partitions(fields["Partitions"])
+	dec.value(fields["As"], &out.As)
+	out.Hints = dec.IndexHints(fields["Hints"])
+	out.Columns = dec.Columns(fields["Columns"])
+	return out
+}
+
+// RefOfAlterCharset encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAlterCharset(n *AlterCharset) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AlterCharset")
+	enc.field("CharacterSet")
+	enc.value(n.CharacterSet)
+	enc.field("Collate")
+	enc.value(n.Collate)
+	enc.closeObject()
+}
+
+// RefOfAlterCharset decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAlterCharset(data json.RawMessage) *AlterCharset {
+	fields := dec.object(data, "AlterCharset")
+	if fields == nil {
+		return nil
+	}
+	out := &AlterCharset{}
+	dec.value(fields["CharacterSet"], &out.CharacterSet)
+	dec.value(fields["Collate"], &out.Collate)
+	return out
+}
+
+// RefOfAlterCheck encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAlterCheck(n *AlterCheck) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AlterCheck")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Enforced")
+	enc.value(n.Enforced)
+	enc.closeObject()
+}
+
+// RefOfAlterCheck decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAlterCheck(data json.RawMessage) *AlterCheck {
+	fields := dec.object(data, "AlterCheck")
+	if fields == nil {
+		return nil
+	}
+	out := &AlterCheck{}
+	dec.value(fields["Name"], &out.Name)
+	dec.value(fields["Enforced"], &out.Enforced)
+	return out
+}
+
+// RefOfAlterColumn encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAlterColumn(n *AlterColumn) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AlterColumn")
+	enc.field("Column")
+	enc.RefOfColName(n.Column)
+	enc.field("DropDefault")
+	enc.value(n.DropDefault)
+	enc.field("DefaultVal")
+	enc.Expr(n.DefaultVal)
+	enc.field("Invisible")
+	enc.RefOfBool(n.Invisible)
+	enc.closeObject()
+}
+
+// RefOfAlterColumn decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAlterColumn(data json.RawMessage) *AlterColumn {
+	fields := dec.object(data, "AlterColumn")
+	if fields == nil {
+		return nil
+	}
+	out := &AlterColumn{}
+	out.Column = dec.RefOfColName(fields["Column"])
+	dec.value(fields["DropDefault"], &out.DropDefault)
+	out.DefaultVal = dec.Expr(fields["DefaultVal"])
+	out.Invisible = dec.RefOfBool(fields["Invisible"])
+	return out
+}
+
+// RefOfAlterDatabase encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAlterDatabase(n *AlterDatabase) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AlterDatabase")
+	enc.field("DBName")
+	enc.value(n.DBName)
+	enc.field("UpdateDataDirectory")
+	enc.value(n.UpdateDataDirectory)
+	enc.field("AlterOptions")
+	enc.SliceOfDatabaseOption(n.AlterOptions)
+	enc.field("FullyParsed")
+	enc.value(n.FullyParsed)
+	enc.closeObject()
+}
+
+// RefOfAlterDatabase decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAlterDatabase(data json.RawMessage) *AlterDatabase {
+	fields := dec.object(data, "AlterDatabase")
+	if fields == nil {
+		return nil
+	}
+	out := &AlterDatabase{}
+	dec.value(fields["DBName"], &out.DBName)
+	dec.value(fields["UpdateDataDirectory"], &out.UpdateDataDirectory)
+	out.AlterOptions = dec.SliceOfDatabaseOption(fields["AlterOptions"])
+	dec.value(fields["FullyParsed"], &out.FullyParsed)
+	return out
+}
+
+// RefOfAlterEvent encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAlterEvent(n *AlterEvent) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AlterEvent")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("Definer")
+	enc.RefOfDefiner(n.Definer)
+	enc.field("Name")
+	enc.TableName(n.Name)
+	enc.field("Schedule")
+	enc.RefOfEventSchedule(n.Schedule)
+	enc.field("OnCompletion")
+	enc.value(n.OnCompletion)
+	enc.field("RenameTo")
+	enc.TableName(n.RenameTo)
+	enc.field("Status")
+	enc.value(n.Status)
+	enc.field("Comment")
+	enc.RefOfLiteral(n.Comment)
+	enc.field("Body")
+	enc.Statement(n.Body)
+	enc.closeObject()
+}
+
+// RefOfAlterEvent decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAlterEvent(data json.RawMessage) *AlterEvent {
+	fields := dec.object(data, "AlterEvent")
+	if fields == nil {
+		return nil
+	}
+	out := &AlterEvent{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	out.Definer = dec.RefOfDefiner(fields["Definer"])
+	out.Name = dec.TableName(fields["Name"])
+	out.Schedule = dec.RefOfEventSchedule(fields["Schedule"])
+	dec.value(fields["OnCompletion"], &out.OnCompletion)
+	out.RenameTo = dec.TableName(fields["RenameTo"])
+	dec.value(fields["Status"], &out.Status)
+	out.Comment = dec.RefOfLiteral(fields["Comment"])
+	out.Body = dec.Statement(fields["Body"])
+	return out
+}
+
+// RefOfAlterFunction encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAlterFunction(n *AlterFunction) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AlterFunction")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("Name")
+	enc.TableName(n.Name)
+	enc.field("Characteristics")
+	enc.SliceOfRefOfRoutineCharacteristic(n.Characteristics)
+	enc.closeObject()
+}
+
+// RefOfAlterFunction decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAlterFunction(data json.RawMessage) *AlterFunction {
+	fields := dec.object(data, "AlterFunction")
+	if fields == nil {
+		return nil
+	}
+	out := &AlterFunction{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	out.Name = dec.TableName(fields["Name"])
+	out.Characteristics = dec.SliceOfRefOfRoutineCharacteristic(fields["Characteristics"])
+	return out
+}
+
+// RefOfAlterIndex encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAlterIndex(n *AlterIndex) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AlterIndex")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Invisible")
+	enc.value(n.Invisible)
+	enc.closeObject()
+}
+
+// RefOfAlterIndex decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAlterIndex(data json.RawMessage) *AlterIndex {
+	fields := dec.object(data, "AlterIndex")
+	if fields == nil {
+		return nil
+	}
+	out := &AlterIndex{}
+	dec.value(fields["Name"], &out.Name)
+	dec.value(fields["Invisible"], &out.Invisible)
+	return out
+}
+
+// RefOfAlterMigration encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAlterMigration(n *AlterMigration) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AlterMigration")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("UUID")
+	enc.value(n.UUID)
+	enc.field("Expire")
+	enc.value(n.Expire)
+	enc.field("Ratio")
+	enc.RefOfLiteral(n.Ratio)
+	enc.field("Shards")
+	enc.value(n.Shards)
+	enc.closeObject()
+}
+
+// RefOfAlterMigration decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAlterMigration(data json.RawMessage) *AlterMigration {
+	fields := dec.object(data, "AlterMigration")
+	if fields == nil {
+		return nil
+	}
+	out := &AlterMigration{}
+	dec.value(fields["Type"], &out.Type)
+	dec.value(fields["UUID"], &out.UUID)
+	dec.value(fields["Expire"], &out.Expire)
+	out.Ratio = dec.RefOfLiteral(fields["Ratio"])
+	dec.value(fields["Shards"], &out.Shards)
+	return out
+}
+
+// RefOfAlterProcedure encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAlterProcedure(n *AlterProcedure) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AlterProcedure")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("Name")
+	enc.TableName(n.Name)
+	enc.field("Characteristics")
+	enc.SliceOfRefOfRoutineCharacteristic(n.Characteristics)
+	enc.closeObject()
+}
+
+// RefOfAlterProcedure decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAlterProcedure(data json.RawMessage) *AlterProcedure {
+	fields := dec.object(data, "AlterProcedure")
+	if fields == nil {
+		return nil
+	}
+	out := &AlterProcedure{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	out.Name = dec.TableName(fields["Name"])
+	out.Characteristics = dec.SliceOfRefOfRoutineCharacteristic(fields["Characteristics"])
+	return out
+}
+
+// RefOfAlterTable encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAlterTable(n *AlterTable) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AlterTable")
+	enc.field("Table")
+	enc.TableName(n.Table)
+	enc.field("AlterOptions")
+	enc.SliceOfAlterOption(n.AlterOptions)
+	enc.field("PartitionSpec")
+	enc.RefOfPartitionSpec(n.PartitionSpec)
+	enc.field("PartitionOption")
+	enc.RefOfPartitionOption(n.PartitionOption)
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("FullyParsed")
+	enc.value(n.FullyParsed)
+	enc.closeObject()
+}
+
+// RefOfAlterTable decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAlterTable(data json.RawMessage) *AlterTable {
+	fields := dec.object(data, "AlterTable")
+	if fields == nil {
+		return nil
+	}
+	out := &AlterTable{}
+	out.Table = dec.TableName(fields["Table"])
+	out.AlterOptions = dec.SliceOfAlterOption(fields["AlterOptions"])
+	out.PartitionSpec = dec.RefOfPartitionSpec(fields["PartitionSpec"])
+	out.PartitionOption = dec.RefOfPartitionOption(fields["PartitionOption"])
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	dec.value(fields["FullyParsed"], &out.FullyParsed)
+	return out
+}
+
+// RefOfAlterUser encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAlterUser(n *AlterUser) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AlterUser")
+	enc.field("IfExists")
+	enc.value(n.IfExists)
+	enc.field("Users")
+	enc.SliceOfRefOfUserSpec(n.Users)
+	enc.field("PasswordExpire")
+	enc.RefOfPasswordExpire(n.PasswordExpire)
+	enc.field("AccountLock")
+	enc.value(n.AccountLock)
+	enc.closeObject()
+}
+
+// RefOfAlterUser decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAlterUser(data json.RawMessage) *AlterUser {
+	fields := dec.object(data, "AlterUser")
+	if fields == nil {
+		return nil
+	}
+	out := &AlterUser{}
+	dec.value(fields["IfExists"], &out.IfExists)
+	out.Users = dec.SliceOfRefOfUserSpec(fields["Users"])
+	out.PasswordExpire = dec.RefOfPasswordExpire(fields["PasswordExpire"])
+	dec.value(fields["AccountLock"], &out.AccountLock)
+	return out
+}
+
+// RefOfAlterView encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAlterView(n *AlterView) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AlterView")
+	enc.field("ViewName")
+	enc.TableName(n.ViewName)
+	enc.field("Algorithm")
+	enc.value(n.Algorithm)
+	enc.field("Definer")
+	enc.RefOfDefiner(n.Definer)
+	enc.field("Security")
+	enc.value(n.Security)
+	enc.field("Columns")
+	enc.Columns(n.Columns)
+	enc.field("Select")
+	enc.SelectStatement(n.Select)
+	enc.field("CheckOption")
+	enc.value(n.CheckOption)
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.closeObject()
+}
+
+// RefOfAlterView decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAlterView(data json.RawMessage) *AlterView {
+	fields := dec.object(data, "AlterView")
+	if fields == nil {
+		return nil
+	}
+	out := &AlterView{}
+	out.ViewName = dec.TableName(fields["ViewName"])
+	dec.value(fields["Algorithm"], &out.Algorithm)
+	out.Definer = dec.RefOfDefiner(fields["Definer"])
+	dec.value(fields["Security"], &out.Security)
+	out.Columns = dec.Columns(fields["Columns"])
+	out.Select = dec.SelectStatement(fields["Select"])
+	dec.value(fields["CheckOption"], &out.CheckOption)
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	return out
+}
+
+// RefOfAlterVschema encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAlterVschema(n *AlterVschema) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AlterVschema")
+	enc.field("Action")
+	enc.value(n.Action)
+	enc.field("Table")
+	enc.TableName(n.Table)
+	enc.field("VindexSpec")
+	enc.RefOfVindexSpec(n.VindexSpec)
+	enc.field("VindexCols")
+	enc.SliceOfIdentifierCI(n.VindexCols)
+	enc.field("AutoIncSpec")
+	enc.RefOfAutoIncSpec(n.AutoIncSpec)
+	enc.closeObject()
+}
+
+// RefOfAlterVschema decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAlterVschema(data json.RawMessage) *AlterVschema {
+	fields := dec.object(data, "AlterVschema")
+	if fields == nil {
+		return nil
+	}
+	out := &AlterVschema{}
+	dec.value(fields["Action"], &out.Action)
+	out.Table = dec.TableName(fields["Table"])
+	out.VindexSpec = dec.RefOfVindexSpec(fields["VindexSpec"])
+	out.VindexCols = dec.SliceOfIdentifierCI(fields["VindexCols"])
+	out.AutoIncSpec = dec.RefOfAutoIncSpec(fields["AutoIncSpec"])
+	return out
+}
+
+// RefOfAndExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAndExpr(n *AndExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AndExpr")
+	enc.field("Left")
+	enc.Expr(n.Left)
+	enc.field("Right")
+	enc.Expr(n.Right)
+	enc.closeObject()
+}
+
+// RefOfAndExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAndExpr(data json.RawMessage) *AndExpr {
+	fields := dec.object(data, "AndExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &AndExpr{}
+	out.Left = dec.Expr(fields["Left"])
+	out.Right = dec.Expr(fields["Right"])
+	return out
+}
+
+// RefOfArgument encodes the value as JSON.
+func (enc *jsonEncoder) RefOfArgument(n *Argument) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Argument")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.closeObject()
+}
+
+// RefOfArgument decodes the value from JSON.
+func (dec *jsonDecoder) RefOfArgument(data json.RawMessage) *Argument {
+	fields := dec.object(data, "Argument")
+	if fields == nil {
+		return nil
+	}
+	out := &Argument{}
+	dec.value(fields["Name"], &out.Name)
+	dec.value(fields["Type"], &out.Type)
+	return out
+}
+
+// RefOfArgumentLessWindowExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfArgumentLessWindowExpr(n *ArgumentLessWindowExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ArgumentLessWindowExpr")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("OverClause")
+	enc.RefOfOverClause(n.OverClause)
+	enc.closeObject()
+}
+
+// RefOfArgumentLessWindowExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfArgumentLessWindowExpr(data json.RawMessage) *ArgumentLessWindowExpr {
+	fields := dec.object(data, "ArgumentLessWindowExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &ArgumentLessWindowExpr{}
+	dec.value(fields["Type"], &out.Type)
+	out.OverClause = dec.RefOfOverClause(fields["OverClause"])
+	return out
+}
+
+// RefOfAssignmentExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAssignmentExpr(n *AssignmentExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AssignmentExpr")
+	enc.field("Left")
+	enc.Expr(n.Left)
+	enc.field("Right")
+	enc.Expr(n.Right)
+	enc.closeObject()
+}
+
+// RefOfAssignmentExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAssignmentExpr(data json.RawMessage) *AssignmentExpr {
+	fields := dec.object(data, "AssignmentExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &AssignmentExpr{}
+	out.Left = dec.Expr(fields["Left"])
+	out.Right = dec.Expr(fields["Right"])
+	return out
+}
+
+// RefOfAuthentication encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAuthentication(n *Authentication) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Authentication")
+	enc.field("Plugin")
+	enc.value(n.Plugin)
+	enc.field("Password")
+	enc.RefOfLiteral(n.Password)
+	enc.field("Hashed")
+	enc.value(n.Hashed)
+	enc.field("RandomPassword")
+	enc.value(n.RandomPassword)
+	enc.field("CurrentPassword")
+	enc.RefOfLiteral(n.CurrentPassword)
+	enc.closeObject()
+}
+
+// RefOfAuthentication decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAuthentication(data json.RawMessage) *Authentication {
+	fields := dec.object(data, "Authentication")
+	if fields == nil {
+		return nil
+	}
+	out := &Authentication{}
+	dec.value(fields["Plugin"], &out.Plugin)
+	out.Password = dec.RefOfLiteral(fields["Password"])
+	dec.value(fields["Hashed"], &out.Hashed)
+	dec.value(fields["RandomPassword"], &out.RandomPassword)
+	out.CurrentPassword = dec.RefOfLiteral(fields["CurrentPassword"])
+	return out
+}
+
+// RefOfAutoIncSpec encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAutoIncSpec(n *AutoIncSpec) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("AutoIncSpec")
+	enc.field("Column")
+	enc.value(n.Column)
+	enc.field("Sequence")
+	enc.TableName(n.Sequence)
+	enc.closeObject()
+}
+
+// RefOfAutoIncSpec decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAutoIncSpec(data json.RawMessage) *AutoIncSpec {
+	fields := dec.object(data, "AutoIncSpec")
+	if fields == nil {
+		return nil
+	}
+	out := &AutoIncSpec{}
+	dec.value(fields["Column"], &out.Column)
+	out.Sequence = dec.TableName(fields["Sequence"])
+	return out
+}
+
+// RefOfAvg encodes the value as JSON.
+func (enc *jsonEncoder) RefOfAvg(n *Avg) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Avg")
+	enc.field("Arg")
+	enc.Expr(n.Arg)
+	enc.field("Distinct")
+	enc.value(n.Distinct)
+	enc.closeObject()
+}
+
+// RefOfAvg decodes the value from JSON.
+func (dec *jsonDecoder) RefOfAvg(data json.RawMessage) *Avg {
+	fields := dec.object(data, "Avg")
+	if fields == nil {
+		return nil
+	}
+	out := &Avg{}
+	out.Arg = dec.Expr(fields["Arg"])
+	dec.value(fields["Distinct"], &out.Distinct)
+	return out
+}
+
+// RefOfBadStatement encodes the value as JSON.
+func (enc *jsonEncoder) RefOfBadStatement(n *BadStatement) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("BadStatement")
+	enc.field("SQL")
+	enc.value(n.SQL)
+	enc.closeObject()
+}
+
+// RefOfBadStatement decodes the value from JSON.
+func (dec *jsonDecoder) RefOfBadStatement(data json.RawMessage) *BadStatement {
+	fields := dec.object(data, "BadStatement")
+	if fields == nil {
+		return nil
+	}
+	out := &BadStatement{}
+	dec.value(fields["SQL"], &out.SQL)
+	return out
+}
+
+// RefOfBegin encodes the value as JSON.
+func (enc *jsonEncoder) RefOfBegin(n *Begin) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Begin")
+	enc.field("TxAccessModes")
+	enc.SliceOfTxAccessMode(n.TxAccessModes)
+	enc.closeObject()
+}
+
+// RefOfBegin decodes the value from JSON.
+func (dec *jsonDecoder) RefOfBegin(data json.RawMessage) *Begin {
+	fields := dec.object(data, "Begin")
+	if fields == nil {
+		return nil
+	}
+	out := &Begin{}
+	out.TxAccessModes = dec.SliceOfTxAccessMode(fields["TxAccessModes"])
+	return out
+}
+
+// RefOfBeginEndBlock encodes the value as JSON.
+func (enc *jsonEncoder) RefOfBeginEndBlock(n *BeginEndBlock) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("BeginEndBlock")
+	enc.field("Label")
+	enc.value(n.Label)
+	enc.field("Statements")
+	enc.StatementList(n.Statements)
+	enc.closeObject()
+}
+
+// RefOfBeginEndBlock decodes the value from JSON.
+func (dec *jsonDecoder) RefOfBeginEndBlock(data json.RawMessage) *BeginEndBlock {
+	fields := dec.object(data, "BeginEndBlock")
+	if fields == nil {
+		return nil
+	}
+	out := &BeginEndBlock{}
+	dec.value(fields["Label"], &out.Label)
+	out.Statements = dec.StatementList(fields["Statements"])
+	return out
+}
+
+// RefOfBetweenExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfBetweenExpr(n *BetweenExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("BetweenExpr")
+	enc.field("IsBetween")
+	enc.value(n.IsBetween)
+	enc.field("Left")
+	enc.Expr(n.Left)
+	enc.field("From")
+	enc.Expr(n.From)
+	enc.field("To")
+	enc.Expr(n.To)
+	enc.closeObject()
+}
+
+// RefOfBetweenExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfBetweenExpr(data json.RawMessage) *BetweenExpr {
+	fields := dec.object(data, "BetweenExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &BetweenExpr{}
+	dec.value(fields["IsBetween"], &out.IsBetween)
+	out.Left = dec.Expr(fields["Left"])
+	out.From = dec.Expr(fields["From"])
+	out.To = dec.Expr(fields["To"])
+	return out
+}
+
+// RefOfBinaryExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfBinaryExpr(n *BinaryExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("BinaryExpr")
+	enc.field("Operator")
+	enc.value(n.Operator)
+	enc.field("Left")
+	enc.Expr(n.Left)
+	enc.field("Right")
+	enc.Expr(n.Right)
+	enc.closeObject()
+}
+
+// RefOfBinaryExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfBinaryExpr(data json.RawMessage) *BinaryExpr {
+	fields := dec.object(data, "BinaryExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &BinaryExpr{}
+	dec.value(fields["Operator"], &out.Operator)
+	out.Left = dec.Expr(fields["Left"])
+	out.Right = dec.Expr(fields["Right"])
+	return out
+}
+
+// RefOfBitAnd encodes the value as JSON.
+func (enc *jsonEncoder) RefOfBitAnd(n *BitAnd) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("BitAnd")
+	enc.field("Arg")
+	enc.Expr(n.Arg)
+	enc.closeObject()
+}
+
+// RefOfBitAnd decodes the value from JSON.
+func (dec *jsonDecoder) RefOfBitAnd(data json.RawMessage) *BitAnd {
+	fields := dec.object(data, "BitAnd")
+	if fields == nil {
+		return nil
+	}
+	out := &BitAnd{}
+	out.Arg = dec.Expr(fields["Arg"])
+	return out
+}
+
+// RefOfBitOr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfBitOr(n *BitOr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("BitOr")
+	enc.field("Arg")
+	enc.Expr(n.Arg)
+	enc.closeObject()
+}
+
+// RefOfBitOr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfBitOr(data json.RawMessage) *BitOr {
+	fields := dec.object(data, "BitOr")
+	if fields == nil {
+		return nil
+	}
+	out := &BitOr{}
+	out.Arg = dec.Expr(fields["Arg"])
+	return out
+}
+
+// RefOfBitXor encodes the value as JSON.
+func (enc *jsonEncoder) RefOfBitXor(n *BitXor) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("BitXor")
+	enc.field("Arg")
+	enc.Expr(n.Arg)
+	enc.closeObject()
+}
+
+// RefOfBitXor decodes the value from JSON.
+func (dec *jsonDecoder) RefOfBitXor(data json.RawMessage) *BitXor {
+	fields := dec.object(data, "BitXor")
+	if fields == nil {
+		return nil
+	}
+	out := &BitXor{}
+	out.Arg = dec.Expr(fields["Arg"])
+	return out
+}
+
+// RefOfCallProc encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCallProc(n *CallProc) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CallProc")
+	enc.field("Name")
+	enc.TableName(n.Name)
+	enc.field("Params")
+	enc.Exprs(n.Params)
+	enc.closeObject()
+}
+
+// RefOfCallProc decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCallProc(data json.RawMessage) *CallProc {
+	fields := dec.object(data, "CallProc")
+	if fields == nil {
+		return nil
+	}
+	out := &CallProc{}
+	out.Name = dec.TableName(fields["Name"])
+	out.Params = dec.Exprs(fields["Params"])
+	return out
+}
+
+// RefOfCaseExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCaseExpr(n *CaseExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CaseExpr")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Whens")
+	enc.SliceOfRefOfWhen(n.Whens)
+	enc.field("Else")
+	enc.Expr(n.Else)
+	enc.closeObject()
+}
+
+// RefOfCaseExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCaseExpr(data json.RawMessage) *CaseExpr {
+	fields := dec.object(data, "CaseExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &CaseExpr{}
+	out.Expr = dec.Expr(fields["Expr"])
+	out.Whens = dec.SliceOfRefOfWhen(fields["Whens"])
+	out.Else = dec.Expr(fields["Else"])
+	return out
+}
+
+// RefOfCaseStatement encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCaseStatement(n *CaseStatement) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CaseStatement")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Whens")
+	enc.SliceOfRefOfCaseStatementWhen(n.Whens)
+	enc.field("Else")
+	enc.StatementList(n.Else)
+	enc.closeObject()
+}
+
+// RefOfCaseStatement decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCaseStatement(data json.RawMessage) *CaseStatement {
+	fields := dec.object(data, "CaseStatement")
+	if fields == nil {
+		return nil
+	}
+	out := &CaseStatement{}
+	out.Expr = dec.Expr(fields["Expr"])
+	out.Whens = dec.SliceOfRefOfCaseStatementWhen(fields["Whens"])
+	out.Else = dec.StatementList(fields["Else"])
+	return out
+}
+
+// RefOfCaseStatementWhen encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCaseStatementWhen(n *CaseStatementWhen) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CaseStatementWhen")
+	enc.field("Cond")
+	enc.Expr(n.Cond)
+	enc.field("Statements")
+	enc.StatementList(n.Statements)
+	enc.closeObject()
+}
+
+// RefOfCaseStatementWhen decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCaseStatementWhen(data json.RawMessage) *CaseStatementWhen {
+	fields := dec.object(data, "CaseStatementWhen")
+	if fields == nil {
+		return nil
+	}
+	out := &CaseStatementWhen{}
+	out.Cond = dec.Expr(fields["Cond"])
+	out.Statements = dec.StatementList(fields["Statements"])
+	return out
+}
+
+// RefOfCastExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCastExpr(n *CastExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CastExpr")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Type")
+	enc.RefOfConvertType(n.Type)
+	enc.field("Array")
+	enc.value(n.Array)
+	enc.closeObject()
+}
+
+// RefOfCastExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCastExpr(data json.RawMessage) *CastExpr {
+	fields := dec.object(data, "CastExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &CastExpr{}
+	out.Expr = dec.Expr(fields["Expr"])
+	out.Type = dec.RefOfConvertType(fields["Type"])
+	dec.value(fields["Array"], &out.Array)
+	return out
+}
+
+// RefOfChangeColumn encodes the value as JSON.
+func (enc *jsonEncoder) RefOfChangeColumn(n *ChangeColumn) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ChangeColumn")
+	enc.field("OldColumn")
+	enc.RefOfColName(n.OldColumn)
+	enc.field("NewColDefinition")
+	enc.RefOfColumnDefinition(n.NewColDefinition)
+	enc.field("First")
+	enc.value(n.First)
+	enc.field("After")
+	enc.RefOfColName(n.After)
+	enc.closeObject()
+}
+
+// RefOfChangeColumn decodes the value from JSON.
+func (dec *jsonDecoder) RefOfChangeColumn(data json.RawMessage) *ChangeColumn {
+	fields := dec.object(data, "ChangeColumn")
+	if fields == nil {
+		return nil
+	}
+	out := &ChangeColumn{}
+	out.OldColumn = dec.RefOfColName(fields["OldColumn"])
+	out.NewColDefinition = dec.RefOfColumnDefinition(fields["NewColDefinition"])
+	dec.value(fields["First"], &out.First)
+	out.After = dec.RefOfColName(fields["After"])
+	return out
+}
+
+// RefOfCharExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCharExpr(n *CharExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CharExpr")
+	enc.field("Exprs")
+	enc.Exprs(n.Exprs)
+	enc.field("Charset")
+	enc.value(n.Charset)
+	enc.closeObject()
+}
+
+// RefOfCharExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCharExpr(data json.RawMessage) *CharExpr {
+	fields := dec.object(data, "CharExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &CharExpr{}
+	out.Exprs = dec.Exprs(fields["Exprs"])
+	dec.value(fields["Charset"], &out.Charset)
+	return out
+}
+
+// RefOfCheckConstraintDefinition encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCheckConstraintDefinition(n *CheckConstraintDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CheckConstraintDefinition")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Enforced")
+	enc.value(n.Enforced)
+	enc.closeObject()
+}
+
+// RefOfCheckConstraintDefinition decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCheckConstraintDefinition(data json.RawMessage) *CheckConstraintDefinition {
+	fields := dec.object(data, "CheckConstraintDefinition")
+	if fields == nil {
+		return nil
+	}
+	out := &CheckConstraintDefinition{}
+	out.Expr = dec.Expr(fields["Expr"])
+	dec.value(fields["Enforced"], &out.Enforced)
+	return out
+}
+
+// RefOfCloseCursor encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCloseCursor(n *CloseCursor) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CloseCursor")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.closeObject()
+}
+
+// RefOfCloseCursor decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCloseCursor(data json.RawMessage) *CloseCursor {
+	fields := dec.object(data, "CloseCursor")
+	if fields == nil {
+		return nil
+	}
+	out := &CloseCursor{}
+	dec.value(fields["Name"], &out.Name)
+	return out
+}
+
+// RefOfColName encodes the value as JSON.
+func (enc *jsonEncoder) RefOfColName(n *ColName) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ColName")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Qualifier")
+	enc.TableName(n.Qualifier)
+	enc.closeObject()
+}
+
+// RefOfColName decodes the value from JSON.
+func (dec *jsonDecoder) RefOfColName(data json.RawMessage) *ColName {
+	fields := dec.object(data, "ColName")
+	if fields == nil {
+		return nil
+	}
+	out := &ColName{}
+	dec.value(fields["Name"], &out.Name)
+	out.Qualifier = dec.TableName(fields["Qualifier"])
+	return out
+}
+
+// RefOfCollateExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCollateExpr(n *CollateExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CollateExpr")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Collation")
+	enc.value(n.Collation)
+	enc.closeObject()
+}
+
+// RefOfCollateExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCollateExpr(data json.RawMessage) *CollateExpr {
+	fields := dec.object(data, "CollateExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &CollateExpr{}
+	out.Expr = dec.Expr(fields["Expr"])
+	dec.value(fields["Collation"], &out.Collation)
+	return out
+}
+
+// RefOfColumnDefinition encodes the value as JSON.
+func (enc *jsonEncoder) RefOfColumnDefinition(n *ColumnDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ColumnDefinition")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Type")
+	enc.RefOfColumnType(n.Type)
+	enc.closeObject()
+}
+
+// RefOfColumnDefinition decodes the value from JSON.
+func (dec *jsonDecoder) RefOfColumnDefinition(data json.RawMessage) *ColumnDefinition {
+	fields := dec.object(data, "ColumnDefinition")
+	if fields == nil {
+		return nil
+	}
+	out := &ColumnDefinition{}
+	dec.value(fields["Name"], &out.Name)
+	out.Type = dec.RefOfColumnType(fields["Type"])
+	return out
+}
+
+// RefOfColumnType encodes the value as JSON.
+func (enc *jsonEncoder) RefOfColumnType(n *ColumnType) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ColumnType")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Options")
+	enc.RefOfColumnTypeOptions(n.Options)
+	enc.field("Length")
+	enc.RefOfLiteral(n.Length)
+	enc.field("Unsigned")
+	enc.value(n.Unsigned)
+	enc.field("Zerofill")
+	enc.value(n.Zerofill)
+	enc.field("Scale")
+	enc.RefOfLiteral(n.Scale)
+	enc.field("Charset")
+	enc.ColumnCharset(n.Charset)
+	enc.field("EnumValues")
+	enc.SliceOfString(n.EnumValues)
+	enc.closeObject()
+}
+
+// RefOfColumnType decodes the value from JSON.
+func (dec *jsonDecoder) RefOfColumnType(data json.RawMessage) *ColumnType {
+	fields := dec.object(data, "ColumnType")
+	if fields == nil {
+		return nil
+	}
+	out := &ColumnType{}
+	dec.value(fields["Type"], &out.Type)
+	out.Options = dec.RefOfColumnTypeOptions(fields["Options"])
+	out.Length = dec.RefOfLiteral(fields["Length"])
+	dec.value(fields["Unsigned"], &out.Unsigned)
+	dec.value(fields["Zerofill"], &out.Zerofill)
+	out.Scale = dec.RefOfLiteral(fields["Scale"])
+	out.Charset = dec.ColumnCharset(fields["Charset"])
+	out.EnumValues = dec.SliceOfString(fields["EnumValues"])
+	return out
+}
+
+// Columns encodes the value as JSON.
+func (enc *jsonEncoder) Columns(n Columns) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.value(el)
+	}
+	enc.closeArray()
+}
+
+// Columns decodes the value from JSON.
+func (dec *jsonDecoder) Columns(data json.RawMessage) Columns {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(Columns, len(elements))
+	for i, el := range elements {
+		dec.value(el, &out[i])
+	}
+	return out
+}
+
+// RefOfCommentOnly encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCommentOnly(n *CommentOnly) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CommentOnly")
+	enc.field("Comments")
+	enc.SliceOfString(n.Comments)
+	enc.closeObject()
+}
+
+// RefOfCommentOnly decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCommentOnly(data json.RawMessage) *CommentOnly {
+	fields := dec.object(data, "CommentOnly")
+	if fields == nil {
+		return nil
+	}
+	out := &CommentOnly{}
+	out.Comments = dec.SliceOfString(fields["Comments"])
+	return out
+}
+
+// RefOfCommit encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCommit(n *Commit) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Commit")
+	enc.closeObject()
+}
+
+// RefOfCommit decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCommit(data json.RawMessage) *Commit {
+	if dec.object(data, "Commit") == nil {
+		return nil
+	}
+	out := &Commit{}
+	return out
+}
+
+// RefOfCommonTableExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCommonTableExpr(n *CommonTableExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CommonTableExpr")
+	enc.field("ID")
+	enc.value(n.ID)
+	enc.field("Columns")
+	enc.Columns(n.Columns)
+	enc.field("Subquery")
+	enc.RefOfSubquery(n.Subquery)
+	enc.closeObject()
+}
+
+// RefOfCommonTableExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCommonTableExpr(data json.RawMessage) *CommonTableExpr {
+	fields := dec.object(data, "CommonTableExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &CommonTableExpr{}
+	dec.value(fields["ID"], &out.ID)
+	out.Columns = dec.Columns(fields["Columns"])
+	out.Subquery = dec.RefOfSubquery(fields["Subquery"])
+	return out
+}
+
+// RefOfComparisonExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfComparisonExpr(n *ComparisonExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ComparisonExpr")
+	enc.field("Operator")
+	enc.value(n.Operator)
+	enc.field("Left")
+	enc.Expr(n.Left)
+	enc.field("Right")
+	enc.Expr(n.Right)
+	enc.field("Escape")
+	enc.Expr(n.Escape)
+	enc.closeObject()
+}
+
+// RefOfComparisonExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfComparisonExpr(data json.RawMessage) *ComparisonExpr {
+	fields := dec.object(data, "ComparisonExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &ComparisonExpr{}
+	dec.value(fields["Operator"], &out.Operator)
+	out.Left = dec.Expr(fields["Left"])
+	out.Right = dec.Expr(fields["Right"])
+	out.Escape = dec.Expr(fields["Escape"])
+	return out
+}
+
+// RefOfConditionValue encodes the value as JSON.
+func (enc *jsonEncoder) RefOfConditionValue(n *ConditionValue) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ConditionValue")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Value")
+	enc.value(n.Value)
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.closeObject()
+}
+
+// RefOfConditionValue decodes the value from JSON.
+func (dec *jsonDecoder) RefOfConditionValue(data json.RawMessage) *ConditionValue {
+	fields := dec.object(data, "ConditionValue")
+	if fields == nil {
+		return nil
+	}
+	out := &ConditionValue{}
+	dec.value(fields["Type"], &out.Type)
+	dec.value(fields["Value"], &out.Value)
+	dec.value(fields["Name"], &out.Name)
+	return out
+}
+
+// RefOfConstraintDefinition encodes the value as JSON.
+func (enc *jsonEncoder) RefOfConstraintDefinition(n *ConstraintDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ConstraintDefinition")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Details")
+	enc.ConstraintInfo(n.Details)
+	enc.closeObject()
+}
+
+// RefOfConstraintDefinition decodes the value from JSON.
+func (dec *jsonDecoder) RefOfConstraintDefinition(data json.RawMessage) *ConstraintDefinition {
+	fields := dec.object(data, "ConstraintDefinition")
+	if fields == nil {
+		return nil
+	}
+	out := &ConstraintDefinition{}
+	dec.value(fields["Name"], &out.Name)
+	out.Details = dec.ConstraintInfo(fields["Details"])
+	return out
+}
+
+// RefOfConvertExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfConvertExpr(n *ConvertExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ConvertExpr")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Type")
+	enc.RefOfConvertType(n.Type)
+	enc.closeObject()
+}
+
+// RefOfConvertExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfConvertExpr(data json.RawMessage) *ConvertExpr {
+	fields := dec.object(data, "ConvertExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &ConvertExpr{}
+	out.Expr = dec.Expr(fields["Expr"])
+	out.Type = dec.RefOfConvertType(fields["Type"])
+	return out
+}
+
+// RefOfConvertType encodes the value as JSON.
+func (enc *jsonEncoder) RefOfConvertType(n *ConvertType) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ConvertType")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Length")
+	enc.RefOfLiteral(n.Length)
+	enc.field("Scale")
+	enc.RefOfLiteral(n.Scale)
+	enc.field("Charset")
+	enc.ColumnCharset(n.Charset)
+	enc.closeObject()
+}
+
+// RefOfConvertType decodes the value from JSON.
+func (dec *jsonDecoder) RefOfConvertType(data json.RawMessage) *ConvertType {
+	fields := dec.object(data, "ConvertType")
+	if fields == nil {
+		return nil
+	}
+	out := &ConvertType{}
+	dec.value(fields["Type"], &out.Type)
+	out.Length = dec.RefOfLiteral(fields["Length"])
+	out.Scale = dec.RefOfLiteral(fields["Scale"])
+	out.Charset = dec.ColumnCharset(fields["Charset"])
+	return out
+}
+
+// RefOfConvertUsingExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfConvertUsingExpr(n *ConvertUsingExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ConvertUsingExpr")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.closeObject()
+}
+
+// RefOfConvertUsingExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfConvertUsingExpr(data json.RawMessage) *ConvertUsingExpr {
+	fields := dec.object(data, "ConvertUsingExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &ConvertUsingExpr{}
+	out.Expr = dec.Expr(fields["Expr"])
+	dec.value(fields["Type"], &out.Type)
+	return out
+}
+
+// RefOfCount encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCount(n *Count) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Count")
+	enc.field("Args")
+	enc.Exprs(n.Args)
+	enc.field("Distinct")
+	enc.value(n.Distinct)
+	enc.closeObject()
+}
+
+// RefOfCount decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCount(data json.RawMessage) *Count {
+	fields := dec.object(data, "Count")
+	if fields == nil {
+		return nil
+	}
+	out := &Count{}
+	out.Args = dec.Exprs(fields["Args"])
+	dec.value(fields["Distinct"], &out.Distinct)
+	return out
+}
+
+// RefOfCountStar encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCountStar(n *CountStar) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CountStar")
+	enc.closeObject()
+}
+
+// RefOfCountStar decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCountStar(data json.RawMessage) *CountStar {
+	if dec.object(data, "CountStar") == nil {
+		return nil
+	}
+	out := &CountStar{}
+	return out
+}
+
+// RefOfCreateDatabase encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCreateDatabase(n *CreateDatabase) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CreateDatabase")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("DBName")
+	enc.value(n.DBName)
+	enc.field("IfNotExists")
+	enc.value(n.IfNotExists)
+	enc.field("CreateOptions")
+	enc.SliceOfDatabaseOption(n.CreateOptions)
+	enc.field("FullyParsed")
+	enc.value(n.FullyParsed)
+	enc.closeObject()
+}
+
+// RefOfCreateDatabase decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCreateDatabase(data json.RawMessage) *CreateDatabase {
+	fields := dec.object(data, "CreateDatabase")
+	if fields == nil {
+		return nil
+	}
+	out := &CreateDatabase{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	dec.value(fields["DBName"], &out.DBName)
+	dec.value(fields["IfNotExists"], &out.IfNotExists)
+	out.CreateOptions = dec.SliceOfDatabaseOption(fields["CreateOptions"])
+	dec.value(fields["FullyParsed"], &out.FullyParsed)
+	return out
+}
+
+// RefOfCreateEvent encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCreateEvent(n *CreateEvent) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CreateEvent")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("Definer")
+	enc.RefOfDefiner(n.Definer)
+	enc.field("IfNotExists")
+	enc.value(n.IfNotExists)
+	enc.field("Name")
+	enc.TableName(n.Name)
+	enc.field("Schedule")
+	enc.RefOfEventSchedule(n.Schedule)
+	enc.field("OnCompletion")
+	enc.value(n.OnCompletion)
+	enc.field("Status")
+	enc.value(n.Status)
+	enc.field("Comment")
+	enc.RefOfLiteral(n.Comment)
+	enc.field("Body")
+	enc.Statement(n.Body)
+	enc.closeObject()
+}
+
+// RefOfCreateEvent decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCreateEvent(data json.RawMessage) *CreateEvent {
+	fields := dec.object(data, "CreateEvent")
+	if fields == nil {
+		return nil
+	}
+	out := &CreateEvent{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	out.Definer = dec.RefOfDefiner(fields["Definer"])
+	dec.value(fields["IfNotExists"], &out.IfNotExists)
+	out.Name = dec.TableName(fields["Name"])
+	out.Schedule = dec.RefOfEventSchedule(fields["Schedule"])
+	dec.value(fields["OnCompletion"], &out.OnCompletion)
+	dec.value(fields["Status"], &out.Status)
+	out.Comment = dec.RefOfLiteral(fields["Comment"])
+	out.Body = dec.Statement(fields["Body"])
+	return out
+}
+
+// RefOfCreateFunction encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCreateFunction(n *CreateFunction) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CreateFunction")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("Definer")
+	enc.RefOfDefiner(n.Definer)
+	enc.field("IfNotExists")
+	enc.value(n.IfNotExists)
+	enc.field("Name")
+	enc.TableName(n.Name)
+	enc.field("Params")
+	enc.SliceOfRefOfProcParameter(n.Params)
+	enc.field("Returns")
+	enc.RefOfColumnType(n.Returns)
+	enc.field("Characteristics")
+	enc.SliceOfRefOfRoutineCharacteristic(n.Characteristics)
+	enc.field("Body")
+	enc.Statement(n.Body)
+	enc.closeObject()
+}
+
+// RefOfCreateFunction decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCreateFunction(data json.RawMessage) *CreateFunction {
+	fields := dec.object(data, "CreateFunction")
+	if fields == nil {
+		return nil
+	}
+	out := &CreateFunction{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	out.Definer = dec.RefOfDefiner(fields["Definer"])
+	dec.value(fields["IfNotExists"], &out.IfNotExists)
+	out.Name = dec.TableName(fields["Name"])
+	out.Params = dec.SliceOfRefOfProcParameter(fields["Params"])
+	out.Returns = dec.RefOfColumnType(fields["Returns"])
+	out.Characteristics = dec.SliceOfRefOfRoutineCharacteristic(fields["Characteristics"])
+	out.Body = dec.Statement(fields["Body"])
+	return out
+}
+
+// RefOfCreateProcedure encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCreateProcedure(n *CreateProcedure) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CreateProcedure")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("Definer")
+	enc.RefOfDefiner(n.Definer)
+	enc.field("IfNotExists")
+	enc.value(n.IfNotExists)
+	enc.field("Name")
+	enc.TableName(n.Name)
+	enc.field("Params")
+	enc.SliceOfRefOfProcParameter(n.Params)
+	enc.field("Characteristics")
+	enc.SliceOfRefOfRoutineCharacteristic(n.Characteristics)
+	enc.field("Body")
+	enc.Statement(n.Body)
+	enc.closeObject()
+}
+
+// RefOfCreateProcedure decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCreateProcedure(data json.RawMessage) *CreateProcedure {
+	fields := dec.object(data, "CreateProcedure")
+	if fields == nil {
+		return nil
+	}
+	out := &CreateProcedure{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	out.Definer = dec.RefOfDefiner(fields["Definer"])
+	dec.value(fields["IfNotExists"], &out.IfNotExists)
+	out.Name = dec.TableName(fields["Name"])
+	out.Params = dec.SliceOfRefOfProcParameter(fields["Params"])
+	out.Characteristics = dec.SliceOfRefOfRoutineCharacteristic(fields["Characteristics"])
+	out.Body = dec.Statement(fields["Body"])
+	return out
+}
+
+// RefOfCreateRole encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCreateRole(n *CreateRole) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CreateRole")
+	enc.field("IfNotExists")
+	enc.value(n.IfNotExists)
+	enc.field("Roles")
+	enc.SliceOfRefOfAccount(n.Roles)
+	enc.closeObject()
+}
+
+// RefOfCreateRole decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCreateRole(data json.RawMessage) *CreateRole {
+	fields := dec.object(data, "CreateRole")
+	if fields == nil {
+		return nil
+	}
+	out := &CreateRole{}
+	dec.value(fields["IfNotExists"], &out.IfNotExists)
+	out.Roles = dec.SliceOfRefOfAccount(fields["Roles"])
+	return out
+}
+
+// RefOfCreateTable encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCreateTable(n *CreateTable) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CreateTable")
+	enc.field("Temp")
+	enc.value(n.Temp)
+	enc.field("Table")
+	enc.TableName(n.Table)
+	enc.field("IfNotExists")
+	enc.value(n.IfNotExists)
+	enc.field("TableSpec")
+	enc.RefOfTableSpec(n.TableSpec)
+	enc.field("OptLike")
+	enc.RefOfOptLike(n.OptLike)
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("FullyParsed")
+	enc.value(n.FullyParsed)
+	enc.closeObject()
+}
+
+// RefOfCreateTable decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCreateTable(data json.RawMessage) *CreateTable {
+	fields := dec.object(data, "CreateTable")
+	if fields == nil {
+		return nil
+	}
+	out := &CreateTable{}
+	dec.value(fields["Temp"], &out.Temp)
+	out.Table = dec.TableName(fields["Table"])
+	dec.value(fields["IfNotExists"], &out.IfNotExists)
+	out.TableSpec = dec.RefOfTableSpec(fields["TableSpec"])
+	out.OptLike = dec.RefOfOptLike(fields["OptLike"])
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	dec.value(fields["FullyParsed"], &out.FullyParsed)
+	return out
+}
+
+// RefOfCreateTrigger encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCreateTrigger(n *CreateTrigger) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CreateTrigger")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("Definer")
+	enc.RefOfDefiner(n.Definer)
+	enc.field("IfNotExists")
+	enc.value(n.IfNotExists)
+	enc.field("Name")
+	enc.TableName(n.Name)
+	enc.field("Timing")
+	enc.value(n.Timing)
+	enc.field("Event")
+	enc.value(n.Event)
+	enc.field("Table")
+	enc.TableName(n.Table)
+	enc.field("Order")
+	enc.RefOfTriggerOrder(n.Order)
+	enc.field("Body")
+	enc.Statement(n.Body)
+	enc.closeObject()
+}
+
+// RefOfCreateTrigger decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCreateTrigger(data json.RawMessage) *CreateTrigger {
+	fields := dec.object(data, "CreateTrigger")
+	if fields == nil {
+		return nil
+	}
+	out := &CreateTrigger{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	out.Definer = dec.RefOfDefiner(fields["Definer"])
+	dec.value(fields["IfNotExists"], &out.IfNotExists)
+	out.Name = dec.TableName(fields["Name"])
+	dec.value(fields["Timing"], &out.Timing)
+	dec.value(fields["Event"], &out.Event)
+	out.Table = dec.TableName(fields["Table"])
+	out.Order = dec.RefOfTriggerOrder(fields["Order"])
+	out.Body = dec.Statement(fields["Body"])
+	return out
+}
+
+// RefOfCreateUser encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCreateUser(n *CreateUser) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CreateUser")
+	enc.field("IfNotExists")
+	enc.value(n.IfNotExists)
+	enc.field("Users")
+	enc.SliceOfRefOfUserSpec(n.Users)
+	enc.field("DefaultRoles")
+	enc.SliceOfRefOfAccount(n.DefaultRoles)
+	enc.field("PasswordExpire")
+	enc.RefOfPasswordExpire(n.PasswordExpire)
+	enc.field("AccountLock")
+	enc.value(n.AccountLock)
+	enc.closeObject()
+}
+
+// RefOfCreateUser decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCreateUser(data json.RawMessage) *CreateUser {
+	fields := dec.object(data, "CreateUser")
+	if fields == nil {
+		return nil
+	}
+	out := &CreateUser{}
+	dec.value(fields["IfNotExists"], &out.IfNotExists)
+	out.Users = dec.SliceOfRefOfUserSpec(fields["Users"])
+	out.DefaultRoles = dec.SliceOfRefOfAccount(fields["DefaultRoles"])
+	out.PasswordExpire = dec.RefOfPasswordExpire(fields["PasswordExpire"])
+	dec.value(fields["AccountLock"], &out.AccountLock)
+	return out
+}
+
+// RefOfCreateView encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCreateView(n *CreateView) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CreateView")
+	enc.field("ViewName")
+	enc.TableName(n.ViewName)
+	enc.field("Algorithm")
+	enc.value(n.Algorithm)
+	enc.field("Definer")
+	enc.RefOfDefiner(n.Definer)
+	enc.field("Security")
+	enc.value(n.Security)
+	enc.field("Columns")
+	enc.Columns(n.Columns)
+	enc.field("Select")
+	enc.SelectStatement(n.Select)
+	enc.field("CheckOption")
+	enc.value(n.CheckOption)
+	enc.field("IsReplace")
+	enc.value(n.IsReplace)
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.closeObject()
+}
+
+// RefOfCreateView decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCreateView(data json.RawMessage) *CreateView {
+	fields := dec.object(data, "CreateView")
+	if fields == nil {
+		return nil
+	}
+	out := &CreateView{}
+	out.ViewName = dec.TableName(fields["ViewName"])
+	dec.value(fields["Algorithm"], &out.Algorithm)
+	out.Definer = dec.RefOfDefiner(fields["Definer"])
+	dec.value(fields["Security"], &out.Security)
+	out.Columns = dec.Columns(fields["Columns"])
+	out.Select = dec.SelectStatement(fields["Select"])
+	dec.value(fields["CheckOption"], &out.CheckOption)
+	dec.value(fields["IsReplace"], &out.IsReplace)
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	return out
+}
+
+// RefOfCurTimeFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfCurTimeFuncExpr(n *CurTimeFuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("CurTimeFuncExpr")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Fsp")
+	enc.value(n.Fsp)
+	enc.closeObject()
+}
+
+// RefOfCurTimeFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfCurTimeFuncExpr(data json.RawMessage) *CurTimeFuncExpr {
+	fields := dec.object(data, "CurTimeFuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &CurTimeFuncExpr{}
+	dec.value(fields["Name"], &out.Name)
+	dec.value(fields["Fsp"], &out.Fsp)
+	return out
+}
+
+// RefOfDateAddExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDateAddExpr(n *DateAddExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DateAddExpr")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Date")
+	enc.Expr(n.Date)
+	enc.field("Unit")
+	enc.value(n.Unit)
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.closeObject()
+}
+
+// RefOfDateAddExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDateAddExpr(data json.RawMessage) *DateAddExpr {
+	fields := dec.object(data, "DateAddExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &DateAddExpr{}
+	dec.value(fields["Type"], &out.Type)
+	out.Date = dec.Expr(fields["Date"])
+	dec.value(fields["Unit"], &out.Unit)
+	out.Expr = dec.Expr(fields["Expr"])
+	return out
+}
+
+// RefOfDateSubExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDateSubExpr(n *DateSubExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DateSubExpr")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Date")
+	enc.Expr(n.Date)
+	enc.field("Unit")
+	enc.value(n.Unit)
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.closeObject()
+}
+
+// RefOfDateSubExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDateSubExpr(data json.RawMessage) *DateSubExpr {
+	fields := dec.object(data, "DateSubExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &DateSubExpr{}
+	dec.value(fields["Type"], &out.Type)
+	out.Date = dec.Expr(fields["Date"])
+	dec.value(fields["Unit"], &out.Unit)
+	out.Expr = dec.Expr(fields["Expr"])
+	return out
+}
+
+// RefOfDeallocateStmt encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDeallocateStmt(n *DeallocateStmt) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DeallocateStmt")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.closeObject()
+}
+
+// RefOfDeallocateStmt decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDeallocateStmt(data json.RawMessage) *DeallocateStmt {
+	fields := dec.object(data, "DeallocateStmt")
+	if fields == nil {
+		return nil
+	}
+	out := &DeallocateStmt{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	dec.value(fields["Name"], &out.Name)
+	return out
+}
+
+// RefOfDeclareCondition encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDeclareCondition(n *DeclareCondition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DeclareCondition")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Value")
+	enc.RefOfConditionValue(n.Value)
+	enc.closeObject()
+}
+
+// RefOfDeclareCondition decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDeclareCondition(data json.RawMessage) *DeclareCondition {
+	fields := dec.object(data, "DeclareCondition")
+	if fields == nil {
+		return nil
+	}
+	out := &DeclareCondition{}
+	dec.value(fields["Name"], &out.Name)
+	out.Value = dec.RefOfConditionValue(fields["Value"])
+	return out
+}
+
+// RefOfDeclareCursor encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDeclareCursor(n *DeclareCursor) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DeclareCursor")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Select")
+	enc.SelectStatement(n.Select)
+	enc.closeObject()
+}
+
+// RefOfDeclareCursor decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDeclareCursor(data json.RawMessage) *DeclareCursor {
+	fields := dec.object(data, "DeclareCursor")
+	if fields == nil {
+		return nil
+	}
+	out := &DeclareCursor{}
+	dec.value(fields["Name"], &out.Name)
+	out.Select = dec.SelectStatement(fields["Select"])
+	return out
+}
+
+// RefOfDeclareHandler encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDeclareHandler(n *DeclareHandler) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DeclareHandler")
+	enc.field("Action")
+	enc.value(n.Action)
+	enc.field("Conditions")
+	enc.SliceOfRefOfConditionValue(n.Conditions)
+	enc.field("Statement")
+	enc.Statement(n.Statement)
+	enc.closeObject()
+}
+
+// RefOfDeclareHandler decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDeclareHandler(data json.RawMessage) *DeclareHandler {
+	fields := dec.object(data, "DeclareHandler")
+	if fields == nil {
+		return nil
+	}
+	out := &DeclareHandler{}
+	dec.value(fields["Action"], &out.Action)
+	out.Conditions = dec.SliceOfRefOfConditionValue(fields["Conditions"])
+	out.Statement = dec.Statement(fields["Statement"])
+	return out
+}
+
+// RefOfDeclareVar encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDeclareVar(n *DeclareVar) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DeclareVar")
+	enc.field("Names")
+	enc.Columns(n.Names)
+	enc.field("Type")
+	enc.RefOfColumnType(n.Type)
+	enc.field("Default")
+	enc.Expr(n.Default)
+	enc.closeObject()
+}
+
+// RefOfDeclareVar decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDeclareVar(data json.RawMessage) *DeclareVar {
+	fields := dec.object(data, "DeclareVar")
+	if fields == nil {
+		return nil
+	}
+	out := &DeclareVar{}
+	out.Names = dec.Columns(fields["Names"])
+	out.Type = dec.RefOfColumnType(fields["Type"])
+	out.Default = dec.Expr(fields["Default"])
+	return out
+}
+
+// RefOfDefault encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDefault(n *Default) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Default")
+	enc.field("ColName")
+	enc.value(n.ColName)
+	enc.closeObject()
+}
+
+// RefOfDefault decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDefault(data json.RawMessage) *Default {
+	fields := dec.object(data, "Default")
+	if fields == nil {
+		return nil
+	}
+	out := &Default{}
+	dec.value(fields["ColName"], &out.ColName)
+	return out
+}
+
+// RefOfDefiner encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDefiner(n *Definer) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Definer")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Address")
+	enc.value(n.Address)
+	enc.closeObject()
+}
+
+// RefOfDefiner decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDefiner(data json.RawMessage) *Definer {
+	fields := dec.object(data, "Definer")
+	if fields == nil {
+		return nil
+	}
+	out := &Definer{}
+	dec.value(fields["Name"], &out.Name)
+	dec.value(fields["Address"], &out.Address)
+	return out
+}
+
+// RefOfDelete encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDelete(n *Delete) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Delete")
+	enc.field("With")
+	enc.RefOfWith(n.With)
+	enc.field("Ignore")
+	enc.value(n.Ignore)
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("Targets")
+	enc.TableNames(n.Targets)
+	enc.field("TableExprs")
+	enc.TableExprs(n.TableExprs)
+	enc.field("Partitions")
+	enc.Partitions(n.Partitions)
+	enc.field("Where")
+	enc.RefOfWhere(n.Where)
+	enc.field("OrderBy")
+	enc.OrderBy(n.OrderBy)
+	enc.field("Limit")
+	enc.RefOfLimit(n.Limit)
+	enc.field("Returning")
+	enc.SelectExprs(n.Returning)
+	enc.closeObject()
+}
+
+// RefOfDelete decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDelete(data json.RawMessage) *Delete {
+	fields := dec.object(data, "Delete")
+	if fields == nil {
+		return nil
+	}
+	out := &Delete{}
+	out.With = dec.RefOfWith(fields["With"])
+	dec.value(fields["Ignore"], &out.Ignore)
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	out.Targets = dec.TableNames(fields["Targets"])
+	out.TableExprs = dec.TableExprs(fields["TableExprs"])
+	out.Partitions = dec.Partitions(fields["Partitions"])
+	out.Where = dec.RefOfWhere(fields["Where"])
+	out.OrderBy = dec.OrderBy(fields["OrderBy"])
+	out.Limit = dec.RefOfLimit(fields["Limit"])
+	out.Returning = dec.SelectExprs(fields["Returning"])
+	return out
+}
+
+// RefOfDerivedTable encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDerivedTable(n *DerivedTable) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DerivedTable")
+	enc.field("Lateral")
+	enc.value(n.Lateral)
+	enc.field("Select")
+	enc.SelectStatement(n.Select)
+	enc.closeObject()
+}
+
+// RefOfDerivedTable decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDerivedTable(data json.RawMessage) *DerivedTable {
+	fields := dec.object(data, "DerivedTable")
+	if fields == nil {
+		return nil
+	}
+	out := &DerivedTable{}
+	dec.value(fields["Lateral"], &out.Lateral)
+	out.Select = dec.SelectStatement(fields["Select"])
+	return out
+}
+
+// RefOfDropColumn encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDropColumn(n *DropColumn) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DropColumn")
+	enc.field("Name")
+	enc.RefOfColName(n.Name)
+	enc.closeObject()
+}
+
+// RefOfDropColumn decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDropColumn(data json.RawMessage) *DropColumn {
+	fields := dec.object(data, "DropColumn")
+	if fields == nil {
+		return nil
+	}
+	out := &DropColumn{}
+	out.Name = dec.RefOfColName(fields["Name"])
+	return out
+}
+
+// RefOfDropDatabase encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDropDatabase(n *DropDatabase) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DropDatabase")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("DBName")
+	enc.value(n.DBName)
+	enc.field("IfExists")
+	enc.value(n.IfExists)
+	enc.closeObject()
+}
+
+// RefOfDropDatabase decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDropDatabase(data json.RawMessage) *DropDatabase {
+	fields := dec.object(data, "DropDatabase")
+	if fields == nil {
+		return nil
+	}
+	out := &DropDatabase{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	dec.value(fields["DBName"], &out.DBName)
+	dec.value(fields["IfExists"], &out.IfExists)
+	return out
+}
+
+// RefOfDropEvent encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDropEvent(n *DropEvent) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DropEvent")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("IfExists")
+	enc.value(n.IfExists)
+	enc.field("Name")
+	enc.TableName(n.Name)
+	enc.closeObject()
+}
+
+// RefOfDropEvent decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDropEvent(data json.RawMessage) *DropEvent {
+	fields := dec.object(data, "DropEvent")
+	if fields == nil {
+		return nil
+	}
+	out := &DropEvent{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	dec.value(fields["IfExists"], &out.IfExists)
+	out.Name = dec.TableName(fields["Name"])
+	return out
+}
+
+// RefOfDropFunction encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDropFunction(n *DropFunction) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DropFunction")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("IfExists")
+	enc.value(n.IfExists)
+	enc.field("Name")
+	enc.TableName(n.Name)
+	enc.closeObject()
+}
+
+// RefOfDropFunction decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDropFunction(data json.RawMessage) *DropFunction {
+	fields := dec.object(data, "DropFunction")
+	if fields == nil {
+		return nil
+	}
+	out := &DropFunction{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	dec.value(fields["IfExists"], &out.IfExists)
+	out.Name = dec.TableName(fields["Name"])
+	return out
+}
+
+// RefOfDropKey encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDropKey(n *DropKey) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DropKey")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.closeObject()
+}
+
+// RefOfDropKey decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDropKey(data json.RawMessage) *DropKey {
+	fields := dec.object(data, "DropKey")
+	if fields == nil {
+		return nil
+	}
+	out := &DropKey{}
+	dec.value(fields["Type"], &out.Type)
+	dec.value(fields["Name"], &out.Name)
+	return out
+}
+
+// RefOfDropProcedure encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDropProcedure(n *DropProcedure) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DropProcedure")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("IfExists")
+	enc.value(n.IfExists)
+	enc.field("Name")
+	enc.TableName(n.Name)
+	enc.closeObject()
+}
+
+// RefOfDropProcedure decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDropProcedure(data json.RawMessage) *DropProcedure {
+	fields := dec.object(data, "DropProcedure")
+	if fields == nil {
+		return nil
+	}
+	out := &DropProcedure{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	dec.value(fields["IfExists"], &out.IfExists)
+	out.Name = dec.TableName(fields["Name"])
+	return out
+}
+
+// RefOfDropRole encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDropRole(n *DropRole) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DropRole")
+	enc.field("IfExists")
+	enc.value(n.IfExists)
+	enc.field("Roles")
+	enc.SliceOfRefOfAccount(n.Roles)
+	enc.closeObject()
+}
+
+// RefOfDropRole decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDropRole(data json.RawMessage) *DropRole {
+	fields := dec.object(data, "DropRole")
+	if fields == nil {
+		return nil
+	}
+	out := &DropRole{}
+	dec.value(fields["IfExists"], &out.IfExists)
+	out.Roles = dec.SliceOfRefOfAccount(fields["Roles"])
+	return out
+}
+
+// RefOfDropTable encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDropTable(n *DropTable) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DropTable")
+	enc.field("Temp")
+	enc.value(n.Temp)
+	enc.field("FromTables")
+	enc.TableNames(n.FromTables)
+	enc.field("IfExists")
+	enc.value(n.IfExists)
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.closeObject()
+}
+
+// RefOfDropTable decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDropTable(data json.RawMessage) *DropTable {
+	fields := dec.object(data, "DropTable")
+	if fields == nil {
+		return nil
+	}
+	out := &DropTable{}
+	dec.value(fields["Temp"], &out.Temp)
+	out.FromTables = dec.TableNames(fields["FromTables"])
+	dec.value(fields["IfExists"], &out.IfExists)
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	return out
+}
+
+// RefOfDropTrigger encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDropTrigger(n *DropTrigger) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DropTrigger")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("IfExists")
+	enc.value(n.IfExists)
+	enc.field("Name")
+	enc.TableName(n.Name)
+	enc.closeObject()
+}
+
+// RefOfDropTrigger decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDropTrigger(data json.RawMessage) *DropTrigger {
+	fields := dec.object(data, "DropTrigger")
+	if fields == nil {
+		return nil
+	}
+	out := &DropTrigger{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	dec.value(fields["IfExists"], &out.IfExists)
+	out.Name = dec.TableName(fields["Name"])
+	return out
+}
+
+// RefOfDropUser encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDropUser(n *DropUser) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DropUser")
+	enc.field("IfExists")
+	enc.value(n.IfExists)
+	enc.field("Users")
+	enc.SliceOfRefOfAccount(n.Users)
+	enc.closeObject()
+}
+
+// RefOfDropUser decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDropUser(data json.RawMessage) *DropUser {
+	fields := dec.object(data, "DropUser")
+	if fields == nil {
+		return nil
+	}
+	out := &DropUser{}
+	dec.value(fields["IfExists"], &out.IfExists)
+	out.Users = dec.SliceOfRefOfAccount(fields["Users"])
+	return out
+}
+
+// RefOfDropView encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDropView(n *DropView) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("DropView")
+	enc.field("FromTables")
+	enc.TableNames(n.FromTables)
+	enc.field("IfExists")
+	enc.value(n.IfExists)
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.closeObject()
+}
+
+// RefOfDropView decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDropView(data json.RawMessage) *DropView {
+	fields := dec.object(data, "DropView")
+	if fields == nil {
+		return nil
+	}
+	out := &DropView{}
+	out.FromTables = dec.TableNames(fields["FromTables"])
+	dec.value(fields["IfExists"], &out.IfExists)
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	return out
+}
+
+// RefOfElseIfBlock encodes the value as JSON.
+func (enc *jsonEncoder) RefOfElseIfBlock(n *ElseIfBlock) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ElseIfBlock")
+	enc.field("Cond")
+	enc.Expr(n.Cond)
+	enc.field("Statements")
+	enc.StatementList(n.Statements)
+	enc.closeObject()
+}
+
+// RefOfElseIfBlock decodes the value from JSON.
+func (dec *jsonDecoder) RefOfElseIfBlock(data json.RawMessage) *ElseIfBlock {
+	fields := dec.object(data, "ElseIfBlock")
+	if fields == nil {
+		return nil
+	}
+	out := &ElseIfBlock{}
+	out.Cond = dec.Expr(fields["Cond"])
+	out.Statements = dec.StatementList(fields["Statements"])
+	return out
+}
+
+// RefOfEventSchedule encodes the value as JSON.
+func (enc *jsonEncoder) RefOfEventSchedule(n *EventSchedule) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("EventSchedule")
+	enc.field("At")
+	enc.Expr(n.At)
+	enc.field("Every")
+	enc.Expr(n.Every)
+	enc.field("Unit")
+	enc.value(n.Unit)
+	enc.field("Starts")
+	enc.Expr(n.Starts)
+	enc.field("Ends")
+	enc.Expr(n.Ends)
+	enc.closeObject()
+}
+
+// RefOfEventSchedule decodes the value from JSON.
+func (dec *jsonDecoder) RefOfEventSchedule(data json.RawMessage) *EventSchedule {
+	fields := dec.object(data, "EventSchedule")
+	if fields == nil {
+		return nil
+	}
+	out := &EventSchedule{}
+	out.At = dec.Expr(fields["At"])
+	out.Every = dec.Expr(fields["Every"])
+	dec.value(fields["Unit"], &out.Unit)
+	out.Starts = dec.Expr(fields["Starts"])
+	out.Ends = dec.Expr(fields["Ends"])
+	return out
+}
+
+// RefOfExecuteStmt encodes the value as JSON.
+func (enc *jsonEncoder) RefOfExecuteStmt(n *ExecuteStmt) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ExecuteStmt")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("Arguments")
+	enc.SliceOfRefOfVariable(n.Arguments)
+	enc.closeObject()
+}
+
+// RefOfExecuteStmt decodes the value from JSON.
+func (dec *jsonDecoder) RefOfExecuteStmt(data json.RawMessage) *ExecuteStmt {
+	fields := dec.object(data, "ExecuteStmt")
+	if fields == nil {
+		return nil
+	}
+	out := &ExecuteStmt{}
+	dec.value(fields["Name"], &out.Name)
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	out.Arguments = dec.SliceOfRefOfVariable(fields["Arguments"])
+	return out
+}
+
+// RefOfExistsExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfExistsExpr(n *ExistsExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ExistsExpr")
+	enc.field("Subquery")
+	enc.RefOfSubquery(n.Subquery)
+	enc.closeObject()
+}
+
+// RefOfExistsExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfExistsExpr(data json.RawMessage) *ExistsExpr {
+	fields := dec.object(data, "ExistsExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &ExistsExpr{}
+	out.Subquery = dec.RefOfSubquery(fields["Subquery"])
+	return out
+}
+
+// RefOfExplainStmt encodes the value as JSON.
+func (enc *jsonEncoder) RefOfExplainStmt(n *ExplainStmt) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ExplainStmt")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Statement")
+	enc.Statement(n.Statement)
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.closeObject()
+}
+
+// RefOfExplainStmt decodes the value from JSON.
+func (dec *jsonDecoder) RefOfExplainStmt(data json.RawMessage) *ExplainStmt {
+	fields := dec.object(data, "ExplainStmt")
+	if fields == nil {
+		return nil
+	}
+	out := &ExplainStmt{}
+	dec.value(fields["Type"], &out.Type)
+	out.Statement = dec.Statement(fields["Statement"])
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	return out
+}
+
+// RefOfExplainTab encodes the value as JSON.
+func (enc *jsonEncoder) RefOfExplainTab(n *ExplainTab) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ExplainTab")
+	enc.field("Table")
+	enc.TableName(n.Table)
+	enc.field("Wild")
+	enc.value(n.Wild)
+	enc.closeObject()
+}
+
+// RefOfExplainTab decodes the value from JSON.
+func (dec *jsonDecoder) RefOfExplainTab(data json.RawMessage) *ExplainTab {
+	fields := dec.object(data, "ExplainTab")
+	if fields == nil {
+		return nil
+	}
+	out := &ExplainTab{}
+	out.Table = dec.TableName(fields["Table"])
+	dec.value(fields["Wild"], &out.Wild)
+	return out
+}
+
+// Exprs encodes the value as JSON.
+func (enc *jsonEncoder) Exprs(n Exprs) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.Expr(el)
+	}
+	enc.closeArray()
+}
+
+// Exprs decodes the value from JSON.
+func (dec *jsonDecoder) Exprs(data json.RawMessage) Exprs {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(Exprs, len(elements))
+	for i, el := range elements {
+		out[i] = dec.Expr(el)
+	}
+	return out
+}
+
+// RefOfExtractFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfExtractFuncExpr(n *ExtractFuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ExtractFuncExpr")
+	enc.field("IntervalTypes")
+	enc.value(n.IntervalTypes)
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.closeObject()
+}
+
+// RefOfExtractFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfExtractFuncExpr(data json.RawMessage) *ExtractFuncExpr {
+	fields := dec.object(data, "ExtractFuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &ExtractFuncExpr{}
+	dec.value(fields["IntervalTypes"], &out.IntervalTypes)
+	out.Expr = dec.Expr(fields["Expr"])
+	return out
+}
+
+// RefOfExtractValueExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfExtractValueExpr(n *ExtractValueExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ExtractValueExpr")
+	enc.field("Fragment")
+	enc.Expr(n.Fragment)
+	enc.field("XPathExpr")
+	enc.Expr(n.XPathExpr)
+	enc.closeObject()
+}
+
+// RefOfExtractValueExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfExtractValueExpr(data json.RawMessage) *ExtractValueExpr {
+	fields := dec.object(data, "ExtractValueExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &ExtractValueExpr{}
+	out.Fragment = dec.Expr(fields["Fragment"])
+	out.XPathExpr = dec.Expr(fields["XPathExpr"])
+	return out
+}
+
+// RefOfExtractedSubquery encodes the value as JSON.
+func (enc *jsonEncoder) RefOfExtractedSubquery(n *ExtractedSubquery) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ExtractedSubquery")
+	enc.field("Original")
+	enc.Expr(n.Original)
+	enc.field("OpCode")
+	enc.value(n.OpCode)
+	enc.field("Subquery")
+	enc.RefOfSubquery(n.Subquery)
+	enc.field("OtherSide")
+	enc.Expr(n.OtherSide)
+	enc.field("Merged")
+	enc.value(n.Merged)
+	enc.field("hasValuesArg")
+	enc.value(n.hasValuesArg)
+	enc.field("argName")
+	enc.value(n.argName)
+	enc.field("alternative")
+	enc.Expr(n.alternative)
+	enc.closeObject()
+}
+
+// RefOfExtractedSubquery decodes the value from JSON.
+func (dec *jsonDecoder) RefOfExtractedSubquery(data json.RawMessage) *ExtractedSubquery {
+	fields := dec.object(data, "ExtractedSubquery")
+	if fields == nil {
+		return nil
+	}
+	out := &ExtractedSubquery{}
+	out.Original = dec.Expr(fields["Original"])
+	dec.value(fields["OpCode"], &out.OpCode)
+	out.Subquery = dec.RefOfSubquery(fields["Subquery"])
+	out.OtherSide = dec.Expr(fields["OtherSide"])
+	dec.value(fields["Merged"], &out.Merged)
+	dec.value(fields["hasValuesArg"], &out.hasValuesArg)
+	dec.value(fields["argName"], &out.argName)
+	out.alternative = dec.Expr(fields["alternative"])
+	return out
+}
+
+// RefOfFetchCursor encodes the value as JSON.
+func (enc *jsonEncoder) RefOfFetchCursor(n *FetchCursor) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("FetchCursor")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Variables")
+	enc.Columns(n.Variables)
+	enc.closeObject()
+}
+
+// RefOfFetchCursor decodes the value from JSON.
+func (dec *jsonDecoder) RefOfFetchCursor(data json.RawMessage) *FetchCursor {
+	fields := dec.object(data, "FetchCursor")
+	if fields == nil {
+		return nil
+	}
+	out := &FetchCursor{}
+	dec.value(fields["Name"], &out.Name)
+	out.Variables = dec.Columns(fields["Variables"])
+	return out
+}
+
+// RefOfFirstOrLastValueExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfFirstOrLastValueExpr(n *FirstOrLastValueExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("FirstOrLastValueExpr")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("NullTreatmentClause")
+	enc.RefOfNullTreatmentClause(n.NullTreatmentClause)
+	enc.field("OverClause")
+	enc.RefOfOverClause(n.OverClause)
+	enc.closeObject()
+}
+
+// RefOfFirstOrLastValueExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfFirstOrLastValueExpr(data json.RawMessage) *FirstOrLastValueExpr {
+	fields := dec.object(data, "FirstOrLastValueExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &FirstOrLastValueExpr{}
+	dec.value(fields["Type"], &out.Type)
+	out.Expr = dec.Expr(fields["Expr"])
+	out.NullTreatmentClause = dec.RefOfNullTreatmentClause(fields["NullTreatmentClause"])
+	out.OverClause = dec.RefOfOverClause(fields["OverClause"])
+	return out
+}
+
+// RefOfFlush encodes the value as JSON.
+func (enc *jsonEncoder) RefOfFlush(n *Flush) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Flush")
+	enc.field("IsLocal")
+	enc.value(n.IsLocal)
+	enc.field("FlushOptions")
+	enc.SliceOfString(n.FlushOptions)
+	enc.field("TableNames")
+	enc.TableNames(n.TableNames)
+	enc.field("WithLock")
+	enc.value(n.WithLock)
+	enc.field("ForExport")
+	enc.value(n.ForExport)
+	enc.closeObject()
+}
+
+// RefOfFlush decodes the value from JSON.
+func (dec *jsonDecoder) RefOfFlush(data json.RawMessage) *Flush {
+	fields := dec.object(data, "Flush")
+	if fields == nil {
+		return nil
+	}
+	out := &Flush{}
+	dec.value(fields["IsLocal"], &out.IsLocal)
+	out.FlushOptions = dec.SliceOfString(fields["FlushOptions"])
+	out.TableNames = dec.TableNames(fields["TableNames"])
+	dec.value(fields["WithLock"], &out.WithLock)
+	dec.value(fields["ForExport"], &out.ForExport)
+	return out
+}
+
+// RefOfForce encodes the value as JSON.
+func (enc *jsonEncoder) RefOfForce(n *Force) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Force")
+	enc.closeObject()
+}
+
+// RefOfForce decodes the value from JSON.
+func (dec *jsonDecoder) RefOfForce(data json.RawMessage) *Force {
+	if dec.object(data, "Force") == nil {
+		return nil
+	}
+	out := &Force{}
+	return out
+}
+
+// RefOfForeignKeyDefinition encodes the value as JSON.
+func (enc *jsonEncoder) RefOfForeignKeyDefinition(n *ForeignKeyDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ForeignKeyDefinition")
+	enc.field("Source")
+	enc.Columns(n.Source)
+	enc.field("IndexName")
+	enc.value(n.IndexName)
+	enc.field("ReferenceDefinition")
+	enc.RefOfReferenceDefinition(n.ReferenceDefinition)
+	enc.closeObject()
+}
+
+// RefOfForeignKeyDefinition decodes the value from JSON.
+func (dec *jsonDecoder) RefOfForeignKeyDefinition(data json.RawMessage) *ForeignKeyDefinition {
+	fields := dec.object(data, "ForeignKeyDefinition")
+	if fields == nil {
+		return nil
+	}
+	out := &ForeignKeyDefinition{}
+	out.Source = dec.Columns(fields["Source"])
+	dec.value(fields["IndexName"], &out.IndexName)
+	out.ReferenceDefinition = dec.RefOfReferenceDefinition(fields["ReferenceDefinition"])
+	return out
+}
+
+// RefOfFrameClause encodes the value as JSON.
+func (enc *jsonEncoder) RefOfFrameClause(n *FrameClause) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("FrameClause")
+	enc.field("Unit")
+	enc.value(n.Unit)
+	enc.field("Start")
+	enc.RefOfFramePoint(n.Start)
+	enc.field("End")
+	enc.RefOfFramePoint(n.End)
+	enc.closeObject()
+}
+
+// RefOfFrameClause decodes the value from JSON.
+func (dec *jsonDecoder) RefOfFrameClause(data json.RawMessage) *FrameClause {
+	fields := dec.object(data, "FrameClause")
+	if fields == nil {
+		return nil
+	}
+	out := &FrameClause{}
+	dec.value(fields["Unit"], &out.Unit)
+	out.Start = dec.RefOfFramePoint(fields["Start"])
+	out.End = dec.RefOfFramePoint(fields["End"])
+	return out
+}
+
+// RefOfFramePoint encodes the value as JSON.
+func (enc *jsonEncoder) RefOfFramePoint(n *FramePoint) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("FramePoint")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Unit")
+	enc.value(n.Unit)
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.closeObject()
+}
+
+// RefOfFramePoint decodes the value from JSON.
+func (dec *jsonDecoder) RefOfFramePoint(data json.RawMessage) *FramePoint {
+	fields := dec.object(data, "FramePoint")
+	if fields == nil {
+		return nil
+	}
+	out := &FramePoint{}
+	dec.value(fields["Type"], &out.Type)
+	dec.value(fields["Unit"], &out.Unit)
+	out.Expr = dec.Expr(fields["Expr"])
+	return out
+}
+
+// RefOfFromFirstLastClause encodes the value as JSON.
+func (enc *jsonEncoder) RefOfFromFirstLastClause(n *FromFirstLastClause) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("FromFirstLastClause")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.closeObject()
+}
+
+// RefOfFromFirstLastClause decodes the value from JSON.
+func (dec *jsonDecoder) RefOfFromFirstLastClause(data json.RawMessage) *FromFirstLastClause {
+	fields := dec.object(data, "FromFirstLastClause")
+	if fields == nil {
+		return nil
+	}
+	out := &FromFirstLastClause{}
+	dec.value(fields["Type"], &out.Type)
+	return out
+}
+
+// RefOfFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfFuncExpr(n *FuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("FuncExpr")
+	enc.field("Qualifier")
+	enc.value(n.Qualifier)
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Exprs")
+	enc.SelectExprs(n.Exprs)
+	enc.closeObject()
+}
+
+// RefOfFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfFuncExpr(data json.RawMessage) *FuncExpr {
+	fields := dec.object(data, "FuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &FuncExpr{}
+	dec.value(fields["Qualifier"], &out.Qualifier)
+	dec.value(fields["Name"], &out.Name)
+	out.Exprs = dec.SelectExprs(fields["Exprs"])
+	return out
+}
+
+// RefOfGTIDFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfGTIDFuncExpr(n *GTIDFuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("GTIDFuncExpr")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Set1")
+	enc.Expr(n.Set1)
+	enc.field("Set2")
+	enc.Expr(n.Set2)
+	enc.field("Timeout")
+	enc.Expr(n.Timeout)
+	enc.field("Channel")
+	enc.Expr(n.Channel)
+	enc.closeObject()
+}
+
+// RefOfGTIDFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfGTIDFuncExpr(data json.RawMessage) *GTIDFuncExpr {
+	fields := dec.object(data, "GTIDFuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &GTIDFuncExpr{}
+	dec.value(fields["Type"], &out.Type)
+	out.Set1 = dec.Expr(fields["Set1"])
+	out.Set2 = dec.Expr(fields["Set2"])
+	out.Timeout = dec.Expr(fields["Timeout"])
+	out.Channel = dec.Expr(fields["Channel"])
+	return out
+}
+
+// RefOfGeoHashFromLatLongExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfGeoHashFromLatLongExpr(n *GeoHashFromLatLongExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("GeoHashFromLatLongExpr")
+	enc.field("Latitude")
+	enc.Expr(n.Latitude)
+	enc.field("Longitude")
+	enc.Expr(n.Longitude)
+	enc.field("MaxLength")
+	enc.Expr(n.MaxLength)
+	enc.closeObject()
+}
+
+// RefOfGeoHashFromLatLongExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfGeoHashFromLatLongExpr(data json.RawMessage) *GeoHashFromLatLongExpr {
+	fields := dec.object(data, "GeoHashFromLatLongExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &GeoHashFromLatLongExpr{}
+	out.Latitude = dec.Expr(fields["Latitude"])
+	out.Longitude = dec.Expr(fields["Longitude"])
+	out.MaxLength = dec.Expr(fields["MaxLength"])
+	return out
+}
+
+// RefOfGeoHashFromPointExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfGeoHashFromPointExpr(n *GeoHashFromPointExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("GeoHashFromPointExpr")
+	enc.field("Point")
+	enc.Expr(n.Point)
+	enc.field("MaxLength")
+	enc.Expr(n.MaxLength)
+	enc.closeObject()
+}
+
+// RefOfGeoHashFromPointExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfGeoHashFromPointExpr(data json.RawMessage) *GeoHashFromPointExpr {
+	fields := dec.object(data, "GeoHashFromPointExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &GeoHashFromPointExpr{}
+	out.Point = dec.Expr(fields["Point"])
+	out.MaxLength = dec.Expr(fields["MaxLength"])
+	return out
+}
+
+// RefOfGeoJSONFromGeomExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfGeoJSONFromGeomExpr(n *GeoJSONFromGeomExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("GeoJSONFromGeomExpr")
+	enc.field("Geom")
+	enc.Expr(n.Geom)
+	enc.field("MaxDecimalDigits")
+	enc.Expr(n.MaxDecimalDigits)
+	enc.field("Bitmask")
+	enc.Expr(n.Bitmask)
+	enc.closeObject()
+}
+
+// RefOfGeoJSONFromGeomExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfGeoJSONFromGeomExpr(data json.RawMessage) *GeoJSONFromGeomExpr {
+	fields := dec.object(data, "GeoJSONFromGeomExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &GeoJSONFromGeomExpr{}
+	out.Geom = dec.Expr(fields["Geom"])
+	out.MaxDecimalDigits = dec.Expr(fields["MaxDecimalDigits"])
+	out.Bitmask = dec.Expr(fields["Bitmask"])
+	return out
+}
+
+// RefOfGeomCollPropertyFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfGeomCollPropertyFuncExpr(n *GeomCollPropertyFuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("GeomCollPropertyFuncExpr")
+	enc.field("Property")
+	enc.value(n.Property)
+	enc.field("GeomColl")
+	enc.Expr(n.GeomColl)
+	enc.field("PropertyDefArg")
+	enc.Expr(n.PropertyDefArg)
+	enc.closeObject()
+}
+
+// RefOfGeomCollPropertyFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfGeomCollPropertyFuncExpr(data json.RawMessage) *GeomCollPropertyFuncExpr {
+	fields := dec.object(data, "GeomCollPropertyFuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &GeomCollPropertyFuncExpr{}
+	dec.value(fields["Property"], &out.Property)
+	out.GeomColl = dec.Expr(fields["GeomColl"])
+	out.PropertyDefArg = dec.Expr(fields["PropertyDefArg"])
+	return out
+}
+
+// RefOfGeomFormatExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfGeomFormatExpr(n *GeomFormatExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("GeomFormatExpr")
+	enc.field("FormatType")
+	enc.value(n.FormatType)
+	enc.field("Geom")
+	enc.Expr(n.Geom)
+	enc.field("AxisOrderOpt")
+	enc.Expr(n.AxisOrderOpt)
+	enc.closeObject()
+}
+
+// RefOfGeomFormatExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfGeomFormatExpr(data json.RawMessage) *GeomFormatExpr {
+	fields := dec.object(data, "GeomFormatExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &GeomFormatExpr{}
+	dec.value(fields["FormatType"], &out.FormatType)
+	out.Geom = dec.Expr(fields["Geom"])
+	out.AxisOrderOpt = dec.Expr(fields["AxisOrderOpt"])
+	return out
+}
+
+// RefOfGeomFromGeoHashExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfGeomFromGeoHashExpr(n *GeomFromGeoHashExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("GeomFromGeoHashExpr")
+	enc.field("GeomType")
+	enc.value(n.GeomType)
+	enc.field("GeoHash")
+	enc.Expr(n.GeoHash)
+	enc.field("SridOpt")
+	enc.Expr(n.SridOpt)
+	enc.closeObject()
+}
+
+// RefOfGeomFromGeoHashExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfGeomFromGeoHashExpr(data json.RawMessage) *GeomFromGeoHashExpr {
+	fields := dec.object(data, "GeomFromGeoHashExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &GeomFromGeoHashExpr{}
+	dec.value(fields["GeomType"], &out.GeomType)
+	out.GeoHash = dec.Expr(fields["GeoHash"])
+	out.SridOpt = dec.Expr(fields["SridOpt"])
+	return out
+}
+
+// RefOfGeomFromGeoJSONExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfGeomFromGeoJSONExpr(n *GeomFromGeoJSONExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("GeomFromGeoJSONExpr")
+	enc.field("GeoJSON")
+	enc.Expr(n.GeoJSON)
+	enc.field("HigherDimHandlerOpt")
+	enc.Expr(n.HigherDimHandlerOpt)
+	enc.field("Srid")
+	enc.Expr(n.Srid)
+	enc.closeObject()
+}
+
+// RefOfGeomFromGeoJSONExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfGeomFromGeoJSONExpr(data json.RawMessage) *GeomFromGeoJSONExpr {
+	fields := dec.object(data, "GeomFromGeoJSONExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &GeomFromGeoJSONExpr{}
+	out.GeoJSON = dec.Expr(fields["GeoJSON"])
+	out.HigherDimHandlerOpt = dec.Expr(fields["HigherDimHandlerOpt"])
+	out.Srid = dec.Expr(fields["Srid"])
+	return out
+}
+
+// RefOfGeomFromTextExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfGeomFromTextExpr(n *GeomFromTextExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("GeomFromTextExpr")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("WktText")
+	enc.Expr(n.WktText)
+	enc.field("Srid")
+	enc.Expr(n.Srid)
+	enc.field("AxisOrderOpt")
+	enc.Expr(n.AxisOrderOpt)
+	enc.closeObject()
+}
+
+// RefOfGeomFromTextExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfGeomFromTextExpr(data json.RawMessage) *GeomFromTextExpr {
+	fields := dec.object(data, "GeomFromTextExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &GeomFromTextExpr{}
+	dec.value(fields["Type"], &out.Type)
+	out.WktText = dec.Expr(fields["WktText"])
+	out.Srid = dec.Expr(fields["Srid"])
+	out.AxisOrderOpt = dec.Expr(fields["AxisOrderOpt"])
+	return out
+}
+
+// RefOfGeomFromWKBExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfGeomFromWKBExpr(n *GeomFromWKBExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("GeomFromWKBExpr")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("WkbBlob")
+	enc.Expr(n.WkbBlob)
+	enc.field("Srid")
+	enc.Expr(n.Srid)
+	enc.field("AxisOrderOpt")
+	enc.Expr(n.AxisOrderOpt)
+	enc.closeObject()
+}
+
+// RefOfGeomFromWKBExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfGeomFromWKBExpr(data json.RawMessage) *GeomFromWKBExpr {
+	fields := dec.object(data, "GeomFromWKBExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &GeomFromWKBExpr{}
+	dec.value(fields["Type"], &out.Type)
+	out.WkbBlob = dec.Expr(fields["WkbBlob"])
+	out.Srid = dec.Expr(fields["Srid"])
+	out.AxisOrderOpt = dec.Expr(fields["AxisOrderOpt"])
+	return out
+}
+
+// RefOfGeomPropertyFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfGeomPropertyFuncExpr(n *GeomPropertyFuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("GeomPropertyFuncExpr")
+	enc.field("Property")
+	enc.value(n.Property)
+	enc.field("Geom")
+	enc.Expr(n.Geom)
+	enc.closeObject()
+}
+
+// RefOfGeomPropertyFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfGeomPropertyFuncExpr(data json.RawMessage) *GeomPropertyFuncExpr {
+	fields := dec.object(data, "GeomPropertyFuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &GeomPropertyFuncExpr{}
+	dec.value(fields["Property"], &out.Property)
+	out.Geom = dec.Expr(fields["Geom"])
+	return out
+}
+
+// RefOfGrant encodes the value as JSON.
+func (enc *jsonEncoder) RefOfGrant(n *Grant) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Grant")
+	enc.field("Privileges")
+	enc.SliceOfRefOfGrantPrivilege(n.Privileges)
+	enc.field("On")
+	enc.RefOfPrivilegeLevel(n.On)
+	enc.field("Roles")
+	enc.SliceOfRefOfAccount(n.Roles)
+	enc.field("Proxy")
+	enc.RefOfAccount(n.Proxy)
+	enc.field("To")
+	enc.SliceOfRefOfAccount(n.To)
+	enc.field("WithGrantOption")
+	enc.value(n.WithGrantOption)
+	enc.field("WithAdminOption")
+	enc.value(n.WithAdminOption)
+	enc.closeObject()
+}
+
+// RefOfGrant decodes the value from JSON.
+func (dec *jsonDecoder) RefOfGrant(data json.RawMessage) *Grant {
+	fields := dec.object(data, "Grant")
+	if fields == nil {
+		return nil
+	}
+	out := &Grant{}
+	out.Privileges = dec.SliceOfRefOfGrantPrivilege(fields["Privileges"])
+	out.On = dec.RefOfPrivilegeLevel(fields["On"])
+	out.Roles = dec.SliceOfRefOfAccount(fields["Roles"])
+	out.Proxy = dec.RefOfAccount(fields["Proxy"])
+	out.To = dec.SliceOfRefOfAccount(fields["To"])
+	dec.value(fields["WithGrantOption"], &out.WithGrantOption)
+	dec.value(fields["WithAdminOption"], &out.WithAdminOption)
+	return out
+}
+
+// RefOfGrantPrivilege encodes the value as JSON.
+func (enc *jsonEncoder) RefOfGrantPrivilege(n *GrantPrivilege) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("GrantPrivilege")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Columns")
+	enc.Columns(n.Columns)
+	enc.closeObject()
+}
+
+// RefOfGrantPrivilege decodes the value from JSON.
+func (dec *jsonDecoder) RefOfGrantPrivilege(data json.RawMessage) *GrantPrivilege {
+	fields := dec.object(data, "GrantPrivilege")
+	if fields == nil {
+		return nil
+	}
+	out := &GrantPrivilege{}
+	dec.value(fields["Name"], &out.Name)
+	out.Columns = dec.Columns(fields["Columns"])
+	return out
+}
+
+// GroupBy encodes the value as JSON.
+func (enc *jsonEncoder) GroupBy(n GroupBy) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.Expr(el)
+	}
+	enc.closeArray()
+}
+
+// GroupBy decodes the value from JSON.
+func (dec *jsonDecoder) GroupBy(data json.RawMessage) GroupBy {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(GroupBy, len(elements))
+	for i, el := range elements {
+		out[i] = dec.Expr(el)
+	}
+	return out
+}
+
+// RefOfGroupConcatExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfGroupConcatExpr(n *GroupConcatExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("GroupConcatExpr")
+	enc.field("Distinct")
+	enc.value(n.Distinct)
+	enc.field("Exprs")
+	enc.Exprs(n.Exprs)
+	enc.field("OrderBy")
+	enc.OrderBy(n.OrderBy)
+	enc.field("Separator")
+	enc.value(n.Separator)
+	enc.field("Limit")
+	enc.RefOfLimit(n.Limit)
+	enc.closeObject()
+}
+
+// RefOfGroupConcatExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfGroupConcatExpr(data json.RawMessage) *GroupConcatExpr {
+	fields := dec.object(data, "GroupConcatExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &GroupConcatExpr{}
+	dec.value(fields["Distinct"], &out.Distinct)
+	out.Exprs = dec.Exprs(fields["Exprs"])
+	out.OrderBy = dec.OrderBy(fields["OrderBy"])
+	dec.value(fields["Separator"], &out.Separator)
+	out.Limit = dec.RefOfLimit(fields["Limit"])
+	return out
+}
+
+// RefOfIfStatement encodes the value as JSON.
+func (enc *jsonEncoder) RefOfIfStatement(n *IfStatement) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("IfStatement")
+	enc.field("Cond")
+	enc.Expr(n.Cond)
+	enc.field("Statements")
+	enc.StatementList(n.Statements)
+	enc.field("ElseIfs")
+	enc.SliceOfRefOfElseIfBlock(n.ElseIfs)
+	enc.field("Else")
+	enc.StatementList(n.Else)
+	enc.closeObject()
+}
+
+// RefOfIfStatement decodes the value from JSON.
+func (dec *jsonDecoder) RefOfIfStatement(data json.RawMessage) *IfStatement {
+	fields := dec.object(data, "IfStatement")
+	if fields == nil {
+		return nil
+	}
+	out := &IfStatement{}
+	out.Cond = dec.Expr(fields["Cond"])
+	out.Statements = dec.StatementList(fields["Statements"])
+	out.ElseIfs = dec.SliceOfRefOfElseIfBlock(fields["ElseIfs"])
+	out.Else = dec.StatementList(fields["Else"])
+	return out
+}
+
+// RefOfIndexDefinition encodes the value as JSON.
+func (enc *jsonEncoder) RefOfIndexDefinition(n *IndexDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("IndexDefinition")
+	enc.field("Info")
+	enc.RefOfIndexInfo(n.Info)
+	enc.field("Columns")
+	enc.SliceOfRefOfIndexColumn(n.Columns)
+	enc.field("Options")
+	enc.SliceOfRefOfIndexOption(n.Options)
+	enc.closeObject()
+}
+
+// RefOfIndexDefinition decodes the value from JSON.
+func (dec *jsonDecoder) RefOfIndexDefinition(data json.RawMessage) *IndexDefinition {
+	fields := dec.object(data, "IndexDefinition")
+	if fields == nil {
+		return nil
+	}
+	out := &IndexDefinition{}
+	out.Info = dec.RefOfIndexInfo(fields["Info"])
+	out.Columns = dec.SliceOfRefOfIndexColumn(fields["Columns"])
+	out.Options = dec.SliceOfRefOfIndexOption(fields["Options"])
+	return out
+}
+
+// RefOfIndexHint encodes the value as JSON.
+func (enc *jsonEncoder) RefOfIndexHint(n *IndexHint) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("IndexHint")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("ForType")
+	enc.value(n.ForType)
+	enc.field("Indexes")
+	enc.SliceOfIdentifierCI(n.Indexes)
+	enc.closeObject()
+}
+
+// RefOfIndexHint decodes the value from JSON.
+func (dec *jsonDecoder) RefOfIndexHint(data json.RawMessage) *IndexHint {
+	fields := dec.object(data, "IndexHint")
+	if fields == nil {
+		return nil
+	}
+	out := &IndexHint{}
+	dec.value(fields["Type"], &out.Type)
+	dec.value(fields["ForType"], &out.ForType)
+	out.Indexes = dec.SliceOfIdentifierCI(fields["Indexes"])
+	return out
+}
+
+// IndexHints encodes the value as JSON.
+func (enc *jsonEncoder) IndexHints(n IndexHints) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfIndexHint(el)
+	}
+	enc.closeArray()
+}
+
+// IndexHints decodes the value from JSON.
+func (dec *jsonDecoder) IndexHints(data json.RawMessage) IndexHints {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(IndexHints, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfIndexHint(el)
+	}
+	return out
+}
+
+// RefOfIndexInfo encodes the value as JSON.
+func (enc *jsonEncoder) RefOfIndexInfo(n *IndexInfo) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("IndexInfo")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("ConstraintName")
+	enc.value(n.ConstraintName)
+	enc.field("Primary")
+	enc.value(n.Primary)
+	enc.field("Spatial")
+	enc.value(n.Spatial)
+	enc.field("Fulltext")
+	enc.value(n.Fulltext)
+	enc.field("Unique")
+	enc.value(n.Unique)
+	enc.closeObject()
+}
+
+// RefOfIndexInfo decodes the value from JSON.
+func (dec *jsonDecoder) RefOfIndexInfo(data json.RawMessage) *IndexInfo {
+	fields := dec.object(data, "IndexInfo")
+	if fields == nil {
+		return nil
+	}
+	out := &IndexInfo{}
+	dec.value(fields["Type"], &out.Type)
+	dec.value(fields["Name"], &out.Name)
+	dec.value(fields["ConstraintName"], &out.ConstraintName)
+	dec.value(fields["Primary"], &out.Primary)
+	dec.value(fields["Spatial"], &out.Spatial)
+	dec.value(fields["Fulltext"], &out.Fulltext)
+	dec.value(fields["Unique"], &out.Unique)
+	return out
+}
+
+// RefOfInsert encodes the value as JSON.
+func (enc *jsonEncoder) RefOfInsert(n *Insert) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Insert")
+	enc.field("Action")
+	enc.value(n.Action)
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("Ignore")
+	enc.value(n.Ignore)
+	enc.field("Table")
+	enc.RefOfAliasedTableExpr(n.Table)
+	enc.field("Partitions")
+	enc.Partitions(n.Partitions)
+	enc.field("Columns")
+	enc.Columns(n.Columns)
+	enc.field("Rows")
+	enc.InsertRows(n.Rows)
+	enc.field("OnDup")
+	enc.OnDup(n.OnDup)
+	enc.field("OnConflict")
+	enc.RefOfOnConflict(n.OnConflict)
+	enc.field("Returning")
+	enc.SelectExprs(n.Returning)
+	enc.closeObject()
+}
+
+// RefOfInsert decodes the value from JSON.
+func (dec *jsonDecoder) RefOfInsert(data json.RawMessage) *Insert {
+	fields := dec.object(data, "Insert")
+	if fields == nil {
+		return nil
+	}
+	out := &Insert{}
+	dec.value(fields["Action"], &out.Action)
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	dec.value(fields["Ignore"], &out.Ignore)
+	out.Table = dec.RefOfAliasedTableExpr(fields["Table"])
+	out.Partitions = dec.Partitions(fields["Partitions"])
+	out.Columns = dec.Columns(fields["Columns"])
+	out.Rows = dec.InsertRows(fields["Rows"])
+	out.OnDup = dec.OnDup(fields["OnDup"])
+	out.OnConflict = dec.RefOfOnConflict(fields["OnConflict"])
+	out.Returning = dec.SelectExprs(fields["Returning"])
+	return out
+}
+
+// RefOfInsertExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfInsertExpr(n *InsertExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("InsertExpr")
+	enc.field("Str")
+	enc.Expr(n.Str)
+	enc.field("Pos")
+	enc.Expr(n.Pos)
+	enc.field("Len")
+	enc.Expr(n.Len)
+	enc.field("NewStr")
+	enc.Expr(n.NewStr)
+	enc.closeObject()
+}
+
+// RefOfInsertExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfInsertExpr(data json.RawMessage) *InsertExpr {
+	fields := dec.object(data, "InsertExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &InsertExpr{}
+	out.Str = dec.Expr(fields["Str"])
+	out.Pos = dec.Expr(fields["Pos"])
+	out.Len = dec.Expr(fields["Len"])
+	out.NewStr = dec.Expr(fields["NewStr"])
+	return out
+}
+
+// RefOfIntervalFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfIntervalFuncExpr(n *IntervalFuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("IntervalFuncExpr")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Exprs")
+	enc.Exprs(n.Exprs)
+	enc.closeObject()
+}
+
+// RefOfIntervalFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfIntervalFuncExpr(data json.RawMessage) *IntervalFuncExpr {
+	fields := dec.object(data, "IntervalFuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &IntervalFuncExpr{}
+	out.Expr = dec.Expr(fields["Expr"])
+	out.Exprs = dec.Exprs(fields["Exprs"])
+	return out
+}
+
+// RefOfIntroducerExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfIntroducerExpr(n *IntroducerExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("IntroducerExpr")
+	enc.field("CharacterSet")
+	enc.value(n.CharacterSet)
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.closeObject()
+}
+
+// RefOfIntroducerExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfIntroducerExpr(data json.RawMessage) *IntroducerExpr {
+	fields := dec.object(data, "IntroducerExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &IntroducerExpr{}
+	dec.value(fields["CharacterSet"], &out.CharacterSet)
+	out.Expr = dec.Expr(fields["Expr"])
+	return out
+}
+
+// RefOfIsExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfIsExpr(n *IsExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("IsExpr")
+	enc.field("Left")
+	enc.Expr(n.Left)
+	enc.field("Right")
+	enc.value(n.Right)
+	enc.closeObject()
+}
+
+// RefOfIsExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfIsExpr(data json.RawMessage) *IsExpr {
+	fields := dec.object(data, "IsExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &IsExpr{}
+	out.Left = dec.Expr(fields["Left"])
+	dec.value(fields["Right"], &out.Right)
+	return out
+}
+
+// RefOfIterateStatement encodes the value as JSON.
+func (enc *jsonEncoder) RefOfIterateStatement(n *IterateStatement) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("IterateStatement")
+	enc.field("Label")
+	enc.value(n.Label)
+	enc.closeObject()
+}
+
+// RefOfIterateStatement decodes the value from JSON.
+func (dec *jsonDecoder) RefOfIterateStatement(data json.RawMessage) *IterateStatement {
+	fields := dec.object(data, "IterateStatement")
+	if fields == nil {
+		return nil
+	}
+	out := &IterateStatement{}
+	dec.value(fields["Label"], &out.Label)
+	return out
+}
+
+// RefOfJSONArrayExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONArrayExpr(n *JSONArrayExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONArrayExpr")
+	enc.field("Params")
+	enc.Exprs(n.Params)
+	enc.closeObject()
+}
+
+// RefOfJSONArrayExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONArrayExpr(data json.RawMessage) *JSONArrayExpr {
+	fields := dec.object(data, "JSONArrayExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONArrayExpr{}
+	out.Params = dec.Exprs(fields["Params"])
+	return out
+}
+
+// RefOfJSONAttributesExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONAttributesExpr(n *JSONAttributesExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONAttributesExpr")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("JSONDoc")
+	enc.Expr(n.JSONDoc)
+	enc.field("Path")
+	enc.Expr(n.Path)
+	enc.closeObject()
+}
+
+// RefOfJSONAttributesExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONAttributesExpr(data json.RawMessage) *JSONAttributesExpr {
+	fields := dec.object(data, "JSONAttributesExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONAttributesExpr{}
+	dec.value(fields["Type"], &out.Type)
+	out.JSONDoc = dec.Expr(fields["JSONDoc"])
+	out.Path = dec.Expr(fields["Path"])
+	return out
+}
+
+// RefOfJSONContainsExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONContainsExpr(n *JSONContainsExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONContainsExpr")
+	enc.field("Target")
+	enc.Expr(n.Target)
+	enc.field("Candidate")
+	enc.Expr(n.Candidate)
+	enc.field("PathList")
+	enc.SliceOfExpr(n.PathList)
+	enc.closeObject()
+}
+
+// RefOfJSONContainsExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONContainsExpr(data json.RawMessage) *JSONContainsExpr {
+	fields := dec.object(data, "JSONContainsExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONContainsExpr{}
+	out.Target = dec.Expr(fields["Target"])
+	out.Candidate = dec.Expr(fields["Candidate"])
+	out.PathList = dec.SliceOfExpr(fields["PathList"])
+	return out
+}
+
+// RefOfJSONContainsPathExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONContainsPathExpr(n *JSONContainsPathExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONContainsPathExpr")
+	enc.field("JSONDoc")
+	enc.Expr(n.JSONDoc)
+	enc.field("OneOrAll")
+	enc.Expr(n.OneOrAll)
+	enc.field("PathList")
+	enc.SliceOfExpr(n.PathList)
+	enc.closeObject()
+}
+
+// RefOfJSONContainsPathExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONContainsPathExpr(data json.RawMessage) *JSONContainsPathExpr {
+	fields := dec.object(data, "JSONContainsPathExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONContainsPathExpr{}
+	out.JSONDoc = dec.Expr(fields["JSONDoc"])
+	out.OneOrAll = dec.Expr(fields["OneOrAll"])
+	out.PathList = dec.SliceOfExpr(fields["PathList"])
+	return out
+}
+
+// RefOfJSONExtractExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONExtractExpr(n *JSONExtractExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONExtractExpr")
+	enc.field("JSONDoc")
+	enc.Expr(n.JSONDoc)
+	enc.field("PathList")
+	enc.SliceOfExpr(n.PathList)
+	enc.closeObject()
+}
+
+// RefOfJSONExtractExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONExtractExpr(data json.RawMessage) *JSONExtractExpr {
+	fields := dec.object(data, "JSONExtractExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONExtractExpr{}
+	out.JSONDoc = dec.Expr(fields["JSONDoc"])
+	out.PathList = dec.SliceOfExpr(fields["PathList"])
+	return out
+}
+
+// RefOfJSONKeysExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONKeysExpr(n *JSONKeysExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONKeysExpr")
+	enc.field("JSONDoc")
+	enc.Expr(n.JSONDoc)
+	enc.field("Path")
+	enc.Expr(n.Path)
+	enc.closeObject()
+}
+
+// RefOfJSONKeysExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONKeysExpr(data json.RawMessage) *JSONKeysExpr {
+	fields := dec.object(data, "JSONKeysExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONKeysExpr{}
+	out.JSONDoc = dec.Expr(fields["JSONDoc"])
+	out.Path = dec.Expr(fields["Path"])
+	return out
+}
+
+// RefOfJSONObjectExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONObjectExpr(n *JSONObjectExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONObjectExpr")
+	enc.field("Params")
+	enc.SliceOfRefOfJSONObjectParam(n.Params)
+	enc.closeObject()
+}
+
+// RefOfJSONObjectExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONObjectExpr(data json.RawMessage) *JSONObjectExpr {
+	fields := dec.object(data, "JSONObjectExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONObjectExpr{}
+	out.Params = dec.SliceOfRefOfJSONObjectParam(fields["Params"])
+	return out
+}
+
+// RefOfJSONObjectParam encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONObjectParam(n *JSONObjectParam) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONObjectParam")
+	enc.field("Key")
+	enc.Expr(n.Key)
+	enc.field("Value")
+	enc.Expr(n.Value)
+	enc.closeObject()
+}
+
+// RefOfJSONObjectParam decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONObjectParam(data json.RawMessage) *JSONObjectParam {
+	fields := dec.object(data, "JSONObjectParam")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONObjectParam{}
+	out.Key = dec.Expr(fields["Key"])
+	out.Value = dec.Expr(fields["Value"])
+	return out
+}
+
+// RefOfJSONOverlapsExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONOverlapsExpr(n *JSONOverlapsExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONOverlapsExpr")
+	enc.field("JSONDoc1")
+	enc.Expr(n.JSONDoc1)
+	enc.field("JSONDoc2")
+	enc.Expr(n.JSONDoc2)
+	enc.closeObject()
+}
+
+// RefOfJSONOverlapsExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONOverlapsExpr(data json.RawMessage) *JSONOverlapsExpr {
+	fields := dec.object(data, "JSONOverlapsExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONOverlapsExpr{}
+	out.JSONDoc1 = dec.Expr(fields["JSONDoc1"])
+	out.JSONDoc2 = dec.Expr(fields["JSONDoc2"])
+	return out
+}
+
+// RefOfJSONPrettyExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONPrettyExpr(n *JSONPrettyExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONPrettyExpr")
+	enc.field("JSONVal")
+	enc.Expr(n.JSONVal)
+	enc.closeObject()
+}
+
+// RefOfJSONPrettyExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONPrettyExpr(data json.RawMessage) *JSONPrettyExpr {
+	fields := dec.object(data, "JSONPrettyExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONPrettyExpr{}
+	out.JSONVal = dec.Expr(fields["JSONVal"])
+	return out
+}
+
+// RefOfJSONQuoteExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONQuoteExpr(n *JSONQuoteExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONQuoteExpr")
+	enc.field("StringArg")
+	enc.Expr(n.StringArg)
+	enc.closeObject()
+}
+
+// RefOfJSONQuoteExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONQuoteExpr(data json.RawMessage) *JSONQuoteExpr {
+	fields := dec.object(data, "JSONQuoteExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONQuoteExpr{}
+	out.StringArg = dec.Expr(fields["StringArg"])
+	return out
+}
+
+// RefOfJSONRemoveExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONRemoveExpr(n *JSONRemoveExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONRemoveExpr")
+	enc.field("JSONDoc")
+	enc.Expr(n.JSONDoc)
+	enc.field("PathList")
+	enc.Exprs(n.PathList)
+	enc.closeObject()
+}
+
+// RefOfJSONRemoveExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONRemoveExpr(data json.RawMessage) *JSONRemoveExpr {
+	fields := dec.object(data, "JSONRemoveExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONRemoveExpr{}
+	out.JSONDoc = dec.Expr(fields["JSONDoc"])
+	out.PathList = dec.Exprs(fields["PathList"])
+	return out
+}
+
+// RefOfJSONSchemaValidFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONSchemaValidFuncExpr(n *JSONSchemaValidFuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONSchemaValidFuncExpr")
+	enc.field("Schema")
+	enc.Expr(n.Schema)
+	enc.field("Document")
+	enc.Expr(n.Document)
+	enc.closeObject()
+}
+
+// RefOfJSONSchemaValidFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONSchemaValidFuncExpr(data json.RawMessage) *JSONSchemaValidFuncExpr {
+	fields := dec.object(data, "JSONSchemaValidFuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONSchemaValidFuncExpr{}
+	out.Schema = dec.Expr(fields["Schema"])
+	out.Document = dec.Expr(fields["Document"])
+	return out
+}
+
+// RefOfJSONSchemaValidationReportFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONSchemaValidationReportFuncExpr(n *JSONSchemaValidationReportFuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONSchemaValidationReportFuncExpr")
+	enc.field("Schema")
+	enc.Expr(n.Schema)
+	enc.field("Document")
+	enc.Expr(n.Document)
+	enc.closeObject()
+}
+
+// RefOfJSONSchemaValidationReportFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONSchemaValidationReportFuncExpr(data json.RawMessage) *JSONSchemaValidationReportFuncExpr {
+	fields := dec.object(data, "JSONSchemaValidationReportFuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONSchemaValidationReportFuncExpr{}
+	out.Schema = dec.Expr(fields["Schema"])
+	out.Document = dec.Expr(fields["Document"])
+	return out
+}
+
+// RefOfJSONSearchExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONSearchExpr(n *JSONSearchExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONSearchExpr")
+	enc.field("JSONDoc")
+	enc.Expr(n.JSONDoc)
+	enc.field("OneOrAll")
+	enc.Expr(n.OneOrAll)
+	enc.field("SearchStr")
+	enc.Expr(n.SearchStr)
+	enc.field("EscapeChar")
+	enc.Expr(n.EscapeChar)
+	enc.field("PathList")
+	enc.SliceOfExpr(n.PathList)
+	enc.closeObject()
+}
+
+// RefOfJSONSearchExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONSearchExpr(data json.RawMessage) *JSONSearchExpr {
+	fields := dec.object(data, "JSONSearchExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONSearchExpr{}
+	out.JSONDoc = dec.Expr(fields["JSONDoc"])
+	out.OneOrAll = dec.Expr(fields["OneOrAll"])
+	out.SearchStr = dec.Expr(fields["SearchStr"])
+	out.EscapeChar = dec.Expr(fields["EscapeChar"])
+	out.PathList = dec.SliceOfExpr(fields["PathList"])
+	return out
+}
+
+// RefOfJSONStorageFreeExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONStorageFreeExpr(n *JSONStorageFreeExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONStorageFreeExpr")
+	enc.field("JSONVal")
+	enc.Expr(n.JSONVal)
+	enc.closeObject()
+}
+
+// RefOfJSONStorageFreeExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONStorageFreeExpr(data json.RawMessage) *JSONStorageFreeExpr {
+	fields := dec.object(data, "JSONStorageFreeExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONStorageFreeExpr{}
+	out.JSONVal = dec.Expr(fields["JSONVal"])
+	return out
+}
+
+// RefOfJSONStorageSizeExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONStorageSizeExpr(n *JSONStorageSizeExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONStorageSizeExpr")
+	enc.field("JSONVal")
+	enc.Expr(n.JSONVal)
+	enc.closeObject()
+}
+
+// RefOfJSONStorageSizeExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONStorageSizeExpr(data json.RawMessage) *JSONStorageSizeExpr {
+	fields := dec.object(data, "JSONStorageSizeExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONStorageSizeExpr{}
+	out.JSONVal = dec.Expr(fields["JSONVal"])
+	return out
+}
+
+// RefOfJSONTableExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONTableExpr(n *JSONTableExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONTableExpr")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Alias")
+	enc.value(n.Alias)
+	enc.field("Filter")
+	enc.Expr(n.Filter)
+	enc.field("Columns")
+	enc.SliceOfRefOfJtColumnDefinition(n.Columns)
+	enc.closeObject()
+}
+
+// RefOfJSONTableExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONTableExpr(data json.RawMessage) *JSONTableExpr {
+	fields := dec.object(data, "JSONTableExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONTableExpr{}
+	out.Expr = dec.Expr(fields["Expr"])
+	dec.value(fields["Alias"], &out.Alias)
+	out.Filter = dec.Expr(fields["Filter"])
+	out.Columns = dec.SliceOfRefOfJtColumnDefinition(fields["Columns"])
+	return out
+}
+
+// RefOfJSONUnquoteExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONUnquoteExpr(n *JSONUnquoteExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONUnquoteExpr")
+	enc.field("JSONValue")
+	enc.Expr(n.JSONValue)
+	enc.closeObject()
+}
+
+// RefOfJSONUnquoteExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONUnquoteExpr(data json.RawMessage) *JSONUnquoteExpr {
+	fields := dec.object(data, "JSONUnquoteExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONUnquoteExpr{}
+	out.JSONValue = dec.Expr(fields["JSONValue"])
+	return out
+}
+
+// RefOfJSONValueExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONValueExpr(n *JSONValueExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONValueExpr")
+	enc.field("JSONDoc")
+	enc.Expr(n.JSONDoc)
+	enc.field("Path")
+	enc.Expr(n.Path)
+	enc.field("ReturningType")
+	enc.RefOfConvertType(n.ReturningType)
+	enc.field("EmptyOnResponse")
+	enc.RefOfJtOnResponse(n.EmptyOnResponse)
+	enc.field("ErrorOnResponse")
+	enc.RefOfJtOnResponse(n.ErrorOnResponse)
+	enc.closeObject()
+}
+
+// RefOfJSONValueExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONValueExpr(data json.RawMessage) *JSONValueExpr {
+	fields := dec.object(data, "JSONValueExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONValueExpr{}
+	out.JSONDoc = dec.Expr(fields["JSONDoc"])
+	out.Path = dec.Expr(fields["Path"])
+	out.ReturningType = dec.RefOfConvertType(fields["ReturningType"])
+	out.EmptyOnResponse = dec.RefOfJtOnResponse(fields["EmptyOnResponse"])
+	out.ErrorOnResponse = dec.RefOfJtOnResponse(fields["ErrorOnResponse"])
+	return out
+}
+
+// RefOfJSONValueMergeExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONValueMergeExpr(n *JSONValueMergeExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONValueMergeExpr")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("JSONDoc")
+	enc.Expr(n.JSONDoc)
+	enc.field("JSONDocList")
+	enc.Exprs(n.JSONDocList)
+	enc.closeObject()
+}
+
+// RefOfJSONValueMergeExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONValueMergeExpr(data json.RawMessage) *JSONValueMergeExpr {
+	fields := dec.object(data, "JSONValueMergeExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONValueMergeExpr{}
+	dec.value(fields["Type"], &out.Type)
+	out.JSONDoc = dec.Expr(fields["JSONDoc"])
+	out.JSONDocList = dec.Exprs(fields["JSONDocList"])
+	return out
+}
+
+// RefOfJSONValueModifierExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJSONValueModifierExpr(n *JSONValueModifierExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JSONValueModifierExpr")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("JSONDoc")
+	enc.Expr(n.JSONDoc)
+	enc.field("Params")
+	enc.SliceOfRefOfJSONObjectParam(n.Params)
+	enc.closeObject()
+}
+
+// RefOfJSONValueModifierExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJSONValueModifierExpr(data json.RawMessage) *JSONValueModifierExpr {
+	fields := dec.object(data, "JSONValueModifierExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JSONValueModifierExpr{}
+	dec.value(fields["Type"], &out.Type)
+	out.JSONDoc = dec.Expr(fields["JSONDoc"])
+	out.Params = dec.SliceOfRefOfJSONObjectParam(fields["Params"])
+	return out
+}
+
+// RefOfJoinCondition encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJoinCondition(n *JoinCondition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JoinCondition")
+	enc.field("On")
+	enc.Expr(n.On)
+	enc.field("Using")
+	enc.Columns(n.Using)
+	enc.closeObject()
+}
+
+// RefOfJoinCondition decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJoinCondition(data json.RawMessage) *JoinCondition {
+	fields := dec.object(data, "JoinCondition")
+	if fields == nil {
+		return nil
+	}
+	out := &JoinCondition{}
+	out.On = dec.Expr(fields["On"])
+	out.Using = dec.Columns(fields["Using"])
+	return out
+}
+
+// RefOfJoinTableExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJoinTableExpr(n *JoinTableExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JoinTableExpr")
+	enc.field("LeftExpr")
+	enc.TableExpr(n.LeftExpr)
+	enc.field("Join")
+	enc.value(n.Join)
+	enc.field("RightExpr")
+	enc.TableExpr(n.RightExpr)
+	enc.field("Condition")
+	enc.RefOfJoinCondition(n.Condition)
+	enc.closeObject()
+}
+
+// RefOfJoinTableExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJoinTableExpr(data json.RawMessage) *JoinTableExpr {
+	fields := dec.object(data, "JoinTableExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &JoinTableExpr{}
+	out.LeftExpr = dec.TableExpr(fields["LeftExpr"])
+	dec.value(fields["Join"], &out.Join)
+	out.RightExpr = dec.TableExpr(fields["RightExpr"])
+	out.Condition = dec.RefOfJoinCondition(fields["Condition"])
+	return out
+}
+
+// RefOfJtColumnDefinition encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJtColumnDefinition(n *JtColumnDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JtColumnDefinition")
+	enc.field("JtOrdinal")
+	enc.RefOfJtOrdinalColDef(n.JtOrdinal)
+	enc.field("JtPath")
+	enc.RefOfJtPathColDef(n.JtPath)
+	enc.field("JtNestedPath")
+	enc.RefOfJtNestedPathColDef(n.JtNestedPath)
+	enc.closeObject()
+}
+
+// RefOfJtColumnDefinition decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJtColumnDefinition(data json.RawMessage) *JtColumnDefinition {
+	fields := dec.object(data, "JtColumnDefinition")
+	if fields == nil {
+		return nil
+	}
+	out := &JtColumnDefinition{}
+	out.JtOrdinal = dec.RefOfJtOrdinalColDef(fields["JtOrdinal"])
+	out.JtPath = dec.RefOfJtPathColDef(fields["JtPath"])
+	out.JtNestedPath = dec.RefOfJtNestedPathColDef(fields["JtNestedPath"])
+	return out
+}
+
+// RefOfJtOnResponse encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJtOnResponse(n *JtOnResponse) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("JtOnResponse")
+	enc.field("ResponseType")
+	enc.value(n.ResponseType)
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.closeObject()
+}
+
+// RefOfJtOnResponse decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJtOnResponse(data json.RawMessage) *JtOnResponse {
+	fields := dec.object(data, "JtOnResponse")
+	if fields == nil {
+		return nil
+	}
+	out := &JtOnResponse{}
+	dec.value(fields["ResponseType"], &out.ResponseType)
+	out.Expr = dec.Expr(fields["Expr"])
+	return out
+}
+
+// RefOfKeyState encodes the value as JSON.
+func (enc *jsonEncoder) RefOfKeyState(n *KeyState) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("KeyState")
+	enc.field("Enable")
+	enc.value(n.Enable)
+	enc.closeObject()
+}
+
+// RefOfKeyState decodes the value from JSON.
+func (dec *jsonDecoder) RefOfKeyState(data json.RawMessage) *KeyState {
+	fields := dec.object(data, "KeyState")
+	if fields == nil {
+		return nil
+	}
+	out := &KeyState{}
+	dec.value(fields["Enable"], &out.Enable)
+	return out
+}
+
+// RefOfLagLeadExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfLagLeadExpr(n *LagLeadExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("LagLeadExpr")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("N")
+	enc.Expr(n.N)
+	enc.field("Default")
+	enc.Expr(n.Default)
+	enc.field("OverClause")
+	enc.RefOfOverClause(n.OverClause)
+	enc.field("NullTreatmentClause")
+	enc.RefOfNullTreatmentClause(n.NullTreatmentClause)
+	enc.closeObject()
+}
+
+// RefOfLagLeadExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfLagLeadExpr(data json.RawMessage) *LagLeadExpr {
+	fields := dec.object(data, "LagLeadExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &LagLeadExpr{}
+	dec.value(fields["Type"], &out.Type)
+	out.Expr = dec.Expr(fields["Expr"])
+	out.N = dec.Expr(fields["N"])
+	out.Default = dec.Expr(fields["Default"])
+	out.OverClause = dec.RefOfOverClause(fields["OverClause"])
+	out.NullTreatmentClause = dec.RefOfNullTreatmentClause(fields["NullTreatmentClause"])
+	return out
+}
+
+// RefOfLeaveStatement encodes the value as JSON.
+func (enc *jsonEncoder) RefOfLeaveStatement(n *LeaveStatement) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("LeaveStatement")
+	enc.field("Label")
+	enc.value(n.Label)
+	enc.closeObject()
+}
+
+// RefOfLeaveStatement decodes the value from JSON.
+func (dec *jsonDecoder) RefOfLeaveStatement(data json.RawMessage) *LeaveStatement {
+	fields := dec.object(data, "LeaveStatement")
+	if fields == nil {
+		return nil
+	}
+	out := &LeaveStatement{}
+	dec.value(fields["Label"], &out.Label)
+	return out
+}
+
+// RefOfLimit encodes the value as JSON.
+func (enc *jsonEncoder) RefOfLimit(n *Limit) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Limit")
+	enc.field("Offset")
+	enc.Expr(n.Offset)
+	enc.field("Rowcount")
+	enc.Expr(n.Rowcount)
+	enc.closeObject()
+}
+
+// RefOfLimit decodes the value from JSON.
+func (dec *jsonDecoder) RefOfLimit(data json.RawMessage) *Limit {
+	fields := dec.object(data, "Limit")
+	if fields == nil {
+		return nil
+	}
+	out := &Limit{}
+	out.Offset = dec.Expr(fields["Offset"])
+	out.Rowcount = dec.Expr(fields["Rowcount"])
+	return out
+}
+
+// RefOfLineStringExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfLineStringExpr(n *LineStringExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("LineStringExpr")
+	enc.field("PointParams")
+	enc.Exprs(n.PointParams)
+	enc.closeObject()
+}
+
+// RefOfLineStringExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfLineStringExpr(data json.RawMessage) *LineStringExpr {
+	fields := dec.object(data, "LineStringExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &LineStringExpr{}
+	out.PointParams = dec.Exprs(fields["PointParams"])
+	return out
+}
+
+// RefOfLinestrPropertyFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfLinestrPropertyFuncExpr(n *LinestrPropertyFuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("LinestrPropertyFuncExpr")
+	enc.field("Property")
+	enc.value(n.Property)
+	enc.field("Linestring")
+	enc.Expr(n.Linestring)
+	enc.field("PropertyDefArg")
+	enc.Expr(n.PropertyDefArg)
+	enc.closeObject()
+}
+
+// RefOfLinestrPropertyFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfLinestrPropertyFuncExpr(data json.RawMessage) *LinestrPropertyFuncExpr {
+	fields := dec.object(data, "LinestrPropertyFuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &LinestrPropertyFuncExpr{}
+	dec.value(fields["Property"], &out.Property)
+	out.Linestring = dec.Expr(fields["Linestring"])
+	out.PropertyDefArg = dec.Expr(fields["PropertyDefArg"])
+	return out
+}
+
+// RefOfLiteral encodes the value as JSON.
+func (enc *jsonEncoder) RefOfLiteral(n *Literal) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Literal")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Val")
+	enc.value(n.Val)
+	enc.closeObject()
+}
+
+// RefOfLiteral decodes the value from JSON.
+func (dec *jsonDecoder) RefOfLiteral(data json.RawMessage) *Literal {
+	fields := dec.object(data, "Literal")
+	if fields == nil {
+		return nil
+	}
+	out := &Literal{}
+	dec.value(fields["Type"], &out.Type)
+	dec.value(fields["Val"], &out.Val)
+	return out
+}
+
+// RefOfLoad encodes the value as JSON.
+func (enc *jsonEncoder) RefOfLoad(n *Load) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Load")
+	enc.field("Priority")
+	enc.value(n.Priority)
+	enc.field("Local")
+	enc.value(n.Local)
+	enc.field("FileName")
+	enc.value(n.FileName)
+	enc.field("Replace")
+	enc.value(n.Replace)
+	enc.field("Ignore")
+	enc.value(n.Ignore)
+	enc.field("Table")
+	enc.TableName(n.Table)
+	enc.field("Partitions")
+	enc.Partitions(n.Partitions)
+	enc.field("Charset")
+	enc.ColumnCharset(n.Charset)
+	enc.field("Fields")
+	enc.RefOfLoadFields(n.Fields)
+	enc.field("Lines")
+	enc.RefOfLoadLines(n.Lines)
+	enc.field("IgnoreLines")
+	enc.RefOfLiteral(n.IgnoreLines)
+	enc.field("Columns")
+	enc.Exprs(n.Columns)
+	enc.field("SetExprs")
+	enc.UpdateExprs(n.SetExprs)
+	enc.closeObject()
+}
+
+// RefOfLoad decodes the value from JSON.
+func (dec *jsonDecoder) RefOfLoad(data json.RawMessage) *Load {
+	fields := dec.object(data, "Load")
+	if fields == nil {
+		return nil
+	}
+	out := &Load{}
+	dec.value(fields["Priority"], &out.Priority)
+	dec.value(fields["Local"], &out.Local)
+	dec.value(fields["FileName"], &out.FileName)
+	dec.value(fields["Replace"], &out.Replace)
+	dec.value(fields["Ignore"], &out.Ignore)
+	out.Table = dec.TableName(fields["Table"])
+	out.Partitions = dec.Partitions(fields["Partitions"])
+	out.Charset = dec.ColumnCharset(fields["Charset"])
+	out.Fields = dec.RefOfLoadFields(fields["Fields"])
+	out.Lines = dec.RefOfLoadLines(fields["Lines"])
+	out.IgnoreLines = dec.RefOfLiteral(fields["IgnoreLines"])
+	out.Columns = dec.Exprs(fields["Columns"])
+	out.SetExprs = dec.UpdateExprs(fields["SetExprs"])
+	return out
+}
+
+// RefOfLoadFields encodes the value as JSON.
+func (enc *jsonEncoder) RefOfLoadFields(n *LoadFields) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("LoadFields")
+	enc.field("TerminatedBy")
+	enc.RefOfLiteral(n.TerminatedBy)
+	enc.field("OptionallyEnclosed")
+	enc.value(n.OptionallyEnclosed)
+	enc.field("EnclosedBy")
+	enc.RefOfLiteral(n.EnclosedBy)
+	enc.field("EscapedBy")
+	enc.RefOfLiteral(n.EscapedBy)
+	enc.closeObject()
+}
+
+// RefOfLoadFields decodes the value from JSON.
+func (dec *jsonDecoder) RefOfLoadFields(data json.RawMessage) *LoadFields {
+	fields := dec.object(data, "LoadFields")
+	if fields == nil {
+		return nil
+	}
+	out := &LoadFields{}
+	out.TerminatedBy = dec.RefOfLiteral(fields["TerminatedBy"])
+	dec.value(fields["OptionallyEnclosed"], &out.OptionallyEnclosed)
+	out.EnclosedBy = dec.RefOfLiteral(fields["EnclosedBy"])
+	out.EscapedBy = dec.RefOfLiteral(fields["EscapedBy"])
+	return out
+}
+
+// RefOfLoadLines encodes the value as JSON.
+func (enc *jsonEncoder) RefOfLoadLines(n *LoadLines) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("LoadLines")
+	enc.field("StartingBy")
+	enc.RefOfLiteral(n.StartingBy)
+	enc.field("TerminatedBy")
+	enc.RefOfLiteral(n.TerminatedBy)
+	enc.closeObject()
+}
+
+// RefOfLoadLines decodes the value from JSON.
+func (dec *jsonDecoder) RefOfLoadLines(data json.RawMessage) *LoadLines {
+	fields := dec.object(data, "LoadLines")
+	if fields == nil {
+		return nil
+	}
+	out := &LoadLines{}
+	out.StartingBy = dec.RefOfLiteral(fields["StartingBy"])
+	out.TerminatedBy = dec.RefOfLiteral(fields["TerminatedBy"])
+	return out
+}
+
+// RefOfLocateExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfLocateExpr(n *LocateExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("LocateExpr")
+	enc.field("SubStr")
+	enc.Expr(n.SubStr)
+	enc.field("Str")
+	enc.Expr(n.Str)
+	enc.field("Pos")
+	enc.Expr(n.Pos)
+	enc.closeObject()
+}
+
+// RefOfLocateExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfLocateExpr(data json.RawMessage) *LocateExpr {
+	fields := dec.object(data, "LocateExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &LocateExpr{}
+	out.SubStr = dec.Expr(fields["SubStr"])
+	out.Str = dec.Expr(fields["Str"])
+	out.Pos = dec.Expr(fields["Pos"])
+	return out
+}
+
+// RefOfLockOption encodes the value as JSON.
+func (enc *jsonEncoder) RefOfLockOption(n *LockOption) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("LockOption")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.closeObject()
+}
+
+// RefOfLockOption decodes the value from JSON.
+func (dec *jsonDecoder) RefOfLockOption(data json.RawMessage) *LockOption {
+	fields := dec.object(data, "LockOption")
+	if fields == nil {
+		return nil
+	}
+	out := &LockOption{}
+	dec.value(fields["Type"], &out.Type)
+	return out
+}
+
+// RefOfLockTables encodes the value as JSON.
+func (enc *jsonEncoder) RefOfLockTables(n *LockTables) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("LockTables")
+	enc.field("Tables")
+	enc.TableAndLockTypes(n.Tables)
+	enc.closeObject()
+}
+
+// RefOfLockTables decodes the value from JSON.
+func (dec *jsonDecoder) RefOfLockTables(data json.RawMessage) *LockTables {
+	fields := dec.object(data, "LockTables")
+	if fields == nil {
+		return nil
+	}
+	out := &LockTables{}
+	out.Tables = dec.TableAndLockTypes(fields["Tables"])
+	return out
+}
+
+// RefOfLockingFunc encodes the value as JSON.
+func (enc *jsonEncoder) RefOfLockingFunc(n *LockingFunc) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("LockingFunc")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Name")
+	enc.Expr(n.Name)
+	enc.field("Timeout")
+	enc.Expr(n.Timeout)
+	enc.closeObject()
+}
+
+// RefOfLockingFunc decodes the value from JSON.
+func (dec *jsonDecoder) RefOfLockingFunc(data json.RawMessage) *LockingFunc {
+	fields := dec.object(data, "LockingFunc")
+	if fields == nil {
+		return nil
+	}
+	out := &LockingFunc{}
+	dec.value(fields["Type"], &out.Type)
+	out.Name = dec.Expr(fields["Name"])
+	out.Timeout = dec.Expr(fields["Timeout"])
+	return out
+}
+
+// RefOfLoopStatement encodes the value as JSON.
+func (enc *jsonEncoder) RefOfLoopStatement(n *LoopStatement) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("LoopStatement")
+	enc.field("Label")
+	enc.value(n.Label)
+	enc.field("Statements")
+	enc.StatementList(n.Statements)
+	enc.closeObject()
+}
+
+// RefOfLoopStatement decodes the value from JSON.
+func (dec *jsonDecoder) RefOfLoopStatement(data json.RawMessage) *LoopStatement {
+	fields := dec.object(data, "LoopStatement")
+	if fields == nil {
+		return nil
+	}
+	out := &LoopStatement{}
+	dec.value(fields["Label"], &out.Label)
+	out.Statements = dec.StatementList(fields["Statements"])
+	return out
+}
+
+// RefOfMatchExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfMatchExpr(n *MatchExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("MatchExpr")
+	enc.field("Columns")
+	enc.SliceOfRefOfColName(n.Columns)
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Option")
+	enc.value(n.Option)
+	enc.closeObject()
+}
+
+// RefOfMatchExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfMatchExpr(data json.RawMessage) *MatchExpr {
+	fields := dec.object(data, "MatchExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &MatchExpr{}
+	out.Columns = dec.SliceOfRefOfColName(fields["Columns"])
+	out.Expr = dec.Expr(fields["Expr"])
+	dec.value(fields["Option"], &out.Option)
+	return out
+}
+
+// RefOfMax encodes the value as JSON.
+func (enc *jsonEncoder) RefOfMax(n *Max) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Max")
+	enc.field("Arg")
+	enc.Expr(n.Arg)
+	enc.field("Distinct")
+	enc.value(n.Distinct)
+	enc.closeObject()
+}
+
+// RefOfMax decodes the value from JSON.
+func (dec *jsonDecoder) RefOfMax(data json.RawMessage) *Max {
+	fields := dec.object(data, "Max")
+	if fields == nil {
+		return nil
+	}
+	out := &Max{}
+	out.Arg = dec.Expr(fields["Arg"])
+	dec.value(fields["Distinct"], &out.Distinct)
+	return out
+}
+
+// RefOfMemberOfExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfMemberOfExpr(n *MemberOfExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("MemberOfExpr")
+	enc.field("Value")
+	enc.Expr(n.Value)
+	enc.field("JSONArr")
+	enc.Expr(n.JSONArr)
+	enc.closeObject()
+}
+
+// RefOfMemberOfExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfMemberOfExpr(data json.RawMessage) *MemberOfExpr {
+	fields := dec.object(data, "MemberOfExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &MemberOfExpr{}
+	out.Value = dec.Expr(fields["Value"])
+	out.JSONArr = dec.Expr(fields["JSONArr"])
+	return out
+}
+
+// RefOfMin encodes the value as JSON.
+func (enc *jsonEncoder) RefOfMin(n *Min) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Min")
+	enc.field("Arg")
+	enc.Expr(n.Arg)
+	enc.field("Distinct")
+	enc.value(n.Distinct)
+	enc.closeObject()
+}
+
+// RefOfMin decodes the value from JSON.
+func (dec *jsonDecoder) RefOfMin(data json.RawMessage) *Min {
+	fields := dec.object(data, "Min")
+	if fields == nil {
+		return nil
+	}
+	out := &Min{}
+	out.Arg = dec.Expr(fields["Arg"])
+	dec.value(fields["Distinct"], &out.Distinct)
+	return out
+}
+
+// RefOfModifyColumn encodes the value as JSON.
+func (enc *jsonEncoder) RefOfModifyColumn(n *ModifyColumn) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ModifyColumn")
+	enc.field("NewColDefinition")
+	enc.RefOfColumnDefinition(n.NewColDefinition)
+	enc.field("First")
+	enc.value(n.First)
+	enc.field("After")
+	enc.RefOfColName(n.After)
+	enc.closeObject()
+}
+
+// RefOfModifyColumn decodes the value from JSON.
+func (dec *jsonDecoder) RefOfModifyColumn(data json.RawMessage) *ModifyColumn {
+	fields := dec.object(data, "ModifyColumn")
+	if fields == nil {
+		return nil
+	}
+	out := &ModifyColumn{}
+	out.NewColDefinition = dec.RefOfColumnDefinition(fields["NewColDefinition"])
+	dec.value(fields["First"], &out.First)
+	out.After = dec.RefOfColName(fields["After"])
+	return out
+}
+
+// RefOfMultiLinestringExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfMultiLinestringExpr(n *MultiLinestringExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("MultiLinestringExpr")
+	enc.field("LinestringParams")
+	enc.Exprs(n.LinestringParams)
+	enc.closeObject()
+}
+
+// RefOfMultiLinestringExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfMultiLinestringExpr(data json.RawMessage) *MultiLinestringExpr {
+	fields := dec.object(data, "MultiLinestringExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &MultiLinestringExpr{}
+	out.LinestringParams = dec.Exprs(fields["LinestringParams"])
+	return out
+}
+
+// RefOfMultiPointExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfMultiPointExpr(n *MultiPointExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("MultiPointExpr")
+	enc.field("PointParams")
+	enc.Exprs(n.PointParams)
+	enc.closeObject()
+}
+
+// RefOfMultiPointExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfMultiPointExpr(data json.RawMessage) *MultiPointExpr {
+	fields := dec.object(data, "MultiPointExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &MultiPointExpr{}
+	out.PointParams = dec.Exprs(fields["PointParams"])
+	return out
+}
+
+// RefOfMultiPolygonExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfMultiPolygonExpr(n *MultiPolygonExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("MultiPolygonExpr")
+	enc.field("PolygonParams")
+	enc.Exprs(n.PolygonParams)
+	enc.closeObject()
+}
+
+// RefOfMultiPolygonExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfMultiPolygonExpr(data json.RawMessage) *MultiPolygonExpr {
+	fields := dec.object(data, "MultiPolygonExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &MultiPolygonExpr{}
+	out.PolygonParams = dec.Exprs(fields["PolygonParams"])
+	return out
+}
+
+// RefOfNTHValueExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfNTHValueExpr(n *NTHValueExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("NTHValueExpr")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("N")
+	enc.Expr(n.N)
+	enc.field("OverClause")
+	enc.RefOfOverClause(n.OverClause)
+	enc.field("FromFirstLastClause")
+	enc.RefOfFromFirstLastClause(n.FromFirstLastClause)
+	enc.field("NullTreatmentClause")
+	enc.RefOfNullTreatmentClause(n.NullTreatmentClause)
+	enc.closeObject()
+}
+
+// RefOfNTHValueExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfNTHValueExpr(data json.RawMessage) *NTHValueExpr {
+	fields := dec.object(data, "NTHValueExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &NTHValueExpr{}
+	out.Expr = dec.Expr(fields["Expr"])
+	out.N = dec.Expr(fields["N"])
+	out.OverClause = dec.RefOfOverClause(fields["OverClause"])
+	out.FromFirstLastClause = dec.RefOfFromFirstLastClause(fields["FromFirstLastClause"])
+	out.NullTreatmentClause = dec.RefOfNullTreatmentClause(fields["NullTreatmentClause"])
+	return out
+}
+
+// RefOfNamedWindow encodes the value as JSON.
+func (enc *jsonEncoder) RefOfNamedWindow(n *NamedWindow) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("NamedWindow")
+	enc.field("Windows")
+	enc.WindowDefinitions(n.Windows)
+	enc.closeObject()
+}
+
+// RefOfNamedWindow decodes the value from JSON.
+func (dec *jsonDecoder) RefOfNamedWindow(data json.RawMessage) *NamedWindow {
+	fields := dec.object(data, "NamedWindow")
+	if fields == nil {
+		return nil
+	}
+	out := &NamedWindow{}
+	out.Windows = dec.WindowDefinitions(fields["Windows"])
+	return out
+}
+
+// NamedWindows encodes the value as JSON.
+func (enc *jsonEncoder) NamedWindows(n NamedWindows) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfNamedWindow(el)
+	}
+	enc.closeArray()
+}
+
+// NamedWindows decodes the value from JSON.
+func (dec *jsonDecoder) NamedWindows(data json.RawMessage) NamedWindows {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(NamedWindows, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfNamedWindow(el)
+	}
+	return out
+}
+
+// RefOfNextval encodes the value as JSON.
+func (enc *jsonEncoder) RefOfNextval(n *Nextval) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Nextval")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.closeObject()
+}
+
+// RefOfNextval decodes the value from JSON.
+func (dec *jsonDecoder) RefOfNextval(data json.RawMessage) *Nextval {
+	fields := dec.object(data, "Nextval")
+	if fields == nil {
+		return nil
+	}
+	out := &Nextval{}
+	out.Expr = dec.Expr(fields["Expr"])
+	return out
+}
+
+// RefOfNotExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfNotExpr(n *NotExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("NotExpr")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.closeObject()
+}
+
+// RefOfNotExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfNotExpr(data json.RawMessage) *NotExpr {
+	fields := dec.object(data, "NotExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &NotExpr{}
+	out.Expr = dec.Expr(fields["Expr"])
+	return out
+}
+
+// RefOfNtileExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfNtileExpr(n *NtileExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("NtileExpr")
+	enc.field("N")
+	enc.Expr(n.N)
+	enc.field("OverClause")
+	enc.RefOfOverClause(n.OverClause)
+	enc.closeObject()
+}
+
+// RefOfNtileExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfNtileExpr(data json.RawMessage) *NtileExpr {
+	fields := dec.object(data, "NtileExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &NtileExpr{}
+	out.N = dec.Expr(fields["N"])
+	out.OverClause = dec.RefOfOverClause(fields["OverClause"])
+	return out
+}
+
+// RefOfNullTreatmentClause encodes the value as JSON.
+func (enc *jsonEncoder) RefOfNullTreatmentClause(n *NullTreatmentClause) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("NullTreatmentClause")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.closeObject()
+}
+
+// RefOfNullTreatmentClause decodes the value from JSON.
+func (dec *jsonDecoder) RefOfNullTreatmentClause(data json.RawMessage) *NullTreatmentClause {
+	fields := dec.object(data, "NullTreatmentClause")
+	if fields == nil {
+		return nil
+	}
+	out := &NullTreatmentClause{}
+	dec.value(fields["Type"], &out.Type)
+	return out
+}
+
+// RefOfNullVal encodes the value as JSON.
+func (enc *jsonEncoder) RefOfNullVal(n *NullVal) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("NullVal")
+	enc.closeObject()
+}
+
+// RefOfNullVal decodes the value from JSON.
+func (dec *jsonDecoder) RefOfNullVal(data json.RawMessage) *NullVal {
+	if dec.object(data, "NullVal") == nil {
+		return nil
+	}
+	out := &NullVal{}
+	return out
+}
+
+// RefOfOffset encodes the value as JSON.
+func (enc *jsonEncoder) RefOfOffset(n *Offset) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Offset")
+	enc.field("V")
+	enc.value(n.V)
+	enc.field("Original")
+	enc.Expr(n.Original)
+	enc.closeObject()
+}
+
+// RefOfOffset decodes the value from JSON.
+func (dec *jsonDecoder) RefOfOffset(data json.RawMessage) *Offset {
+	fields := dec.object(data, "Offset")
+	if fields == nil {
+		return nil
+	}
+	out := &Offset{}
+	dec.value(fields["V"], &out.V)
+	out.Original = dec.Expr(fields["Original"])
+	return out
+}
+
+// RefOfOnConflict encodes the value as JSON.
+func (enc *jsonEncoder) RefOfOnConflict(n *OnConflict) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("OnConflict")
+	enc.field("Columns")
+	enc.Columns(n.Columns)
+	enc.field("Constraint")
+	enc.value(n.Constraint)
+	enc.field("DoNothing")
+	enc.value(n.DoNothing)
+	enc.field("Exprs")
+	enc.UpdateExprs(n.Exprs)
+	enc.field("Where")
+	enc.RefOfWhere(n.Where)
+	enc.closeObject()
+}
+
+// RefOfOnConflict decodes the value from JSON.
+func (dec *jsonDecoder) RefOfOnConflict(data json.RawMessage) *OnConflict {
+	fields := dec.object(data, "OnConflict")
+	if fields == nil {
+		return nil
+	}
+	out := &OnConflict{}
+	out.Columns = dec.Columns(fields["Columns"])
+	dec.value(fields["Constraint"], &out.Constraint)
+	dec.value(fields["DoNothing"], &out.DoNothing)
+	out.Exprs = dec.UpdateExprs(fields["Exprs"])
+	out.Where = dec.RefOfWhere(fields["Where"])
+	return out
+}
+
+// OnDup encodes the value as JSON.
+func (enc *jsonEncoder) OnDup(n OnDup) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfUpdateExpr(el)
+	}
+	enc.closeArray()
+}
+
+// OnDup decodes the value from JSON.
+func (dec *jsonDecoder) OnDup(data json.RawMessage) OnDup {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(OnDup, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfUpdateExpr(el)
+	}
+	return out
+}
+
+// RefOfOpenCursor encodes the value as JSON.
+func (enc *jsonEncoder) RefOfOpenCursor(n *OpenCursor) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("OpenCursor")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.closeObject()
+}
+
+// RefOfOpenCursor decodes the value from JSON.
+func (dec *jsonDecoder) RefOfOpenCursor(data json.RawMessage) *OpenCursor {
+	fields := dec.object(data, "OpenCursor")
+	if fields == nil {
+		return nil
+	}
+	out := &OpenCursor{}
+	dec.value(fields["Name"], &out.Name)
+	return out
+}
+
+// RefOfOptLike encodes the value as JSON.
+func (enc *jsonEncoder) RefOfOptLike(n *OptLike) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("OptLike")
+	enc.field("LikeTable")
+	enc.TableName(n.LikeTable)
+	enc.closeObject()
+}
+
+// RefOfOptLike decodes the value from JSON.
+func (dec *jsonDecoder) RefOfOptLike(data json.RawMessage) *OptLike {
+	fields := dec.object(data, "OptLike")
+	if fields == nil {
+		return nil
+	}
+	out := &OptLike{}
+	out.LikeTable = dec.TableName(fields["LikeTable"])
+	return out
+}
+
+// RefOfOrExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfOrExpr(n *OrExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("OrExpr")
+	enc.field("Left")
+	enc.Expr(n.Left)
+	enc.field("Right")
+	enc.Expr(n.Right)
+	enc.closeObject()
+}
+
+// RefOfOrExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfOrExpr(data json.RawMessage) *OrExpr {
+	fields := dec.object(data, "OrExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &OrExpr{}
+	out.Left = dec.Expr(fields["Left"])
+	out.Right = dec.Expr(fields["Right"])
+	return out
+}
+
+// RefOfOrder encodes the value as JSON.
+func (enc *jsonEncoder) RefOfOrder(n *Order) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Order")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Direction")
+	enc.value(n.Direction)
+	enc.closeObject()
+}
+
+// RefOfOrder decodes the value from JSON.
+func (dec *jsonDecoder) RefOfOrder(data json.RawMessage) *Order {
+	fields := dec.object(data, "Order")
+	if fields == nil {
+		return nil
+	}
+	out := &Order{}
+	out.Expr = dec.Expr(fields["Expr"])
+	dec.value(fields["Direction"], &out.Direction)
+	return out
+}
+
+// OrderBy encodes the value as JSON.
+func (enc *jsonEncoder) OrderBy(n OrderBy) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfOrder(el)
+	}
+	enc.closeArray()
+}
+
+// OrderBy decodes the value from JSON.
+func (dec *jsonDecoder) OrderBy(data json.RawMessage) OrderBy {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(OrderBy, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfOrder(el)
+	}
+	return out
+}
+
+// RefOfOrderByOption encodes the value as JSON.
+func (enc *jsonEncoder) RefOfOrderByOption(n *OrderByOption) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("OrderByOption")
+	enc.field("Cols")
+	enc.Columns(n.Cols)
+	enc.closeObject()
+}
+
+// RefOfOrderByOption decodes the value from JSON.
+func (dec *jsonDecoder) RefOfOrderByOption(data json.RawMessage) *OrderByOption {
+	fields := dec.object(data, "OrderByOption")
+	if fields == nil {
+		return nil
+	}
+	out := &OrderByOption{}
+	out.Cols = dec.Columns(fields["Cols"])
+	return out
+}
+
+// RefOfOtherAdmin encodes the value as JSON.
+func (enc *jsonEncoder) RefOfOtherAdmin(n *OtherAdmin) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("OtherAdmin")
+	enc.closeObject()
+}
+
+// RefOfOtherAdmin decodes the value from JSON.
+func (dec *jsonDecoder) RefOfOtherAdmin(data json.RawMessage) *OtherAdmin {
+	if dec.object(data, "OtherAdmin") == nil {
+		return nil
+	}
+	out := &OtherAdmin{}
+	return out
+}
+
+// RefOfOtherRead encodes the value as JSON.
+func (enc *jsonEncoder) RefOfOtherRead(n *OtherRead) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("OtherRead")
+	enc.closeObject()
+}
+
+// RefOfOtherRead decodes the value from JSON.
+func (dec *jsonDecoder) RefOfOtherRead(data json.RawMessage) *OtherRead {
+	if dec.object(data, "OtherRead") == nil {
+		return nil
+	}
+	out := &OtherRead{}
+	return out
+}
+
+// RefOfOverClause encodes the value as JSON.
+func (enc *jsonEncoder) RefOfOverClause(n *OverClause) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("OverClause")
+	enc.field("WindowName")
+	enc.value(n.WindowName)
+	enc.field("WindowSpec")
+	enc.RefOfWindowSpecification(n.WindowSpec)
+	enc.closeObject()
+}
+
+// RefOfOverClause decodes the value from JSON.
+func (dec *jsonDecoder) RefOfOverClause(data json.RawMessage) *OverClause {
+	fields := dec.object(data, "OverClause")
+	if fields == nil {
+		return nil
+	}
+	out := &OverClause{}
+	dec.value(fields["WindowName"], &out.WindowName)
+	out.WindowSpec = dec.RefOfWindowSpecification(fields["WindowSpec"])
+	return out
+}
+
+// RefOfParenTableExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfParenTableExpr(n *ParenTableExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ParenTableExpr")
+	enc.field("Exprs")
+	enc.TableExprs(n.Exprs)
+	enc.closeObject()
+}
+
+// RefOfParenTableExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfParenTableExpr(data json.RawMessage) *ParenTableExpr {
+	fields := dec.object(data, "ParenTableExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &ParenTableExpr{}
+	out.Exprs = dec.TableExprs(fields["Exprs"])
+	return out
+}
+
+// RefOfParsedComments encodes the value as JSON.
+func (enc *jsonEncoder) RefOfParsedComments(n *ParsedComments) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ParsedComments")
+	enc.field("comments")
+	enc.Comments(n.comments)
+	enc.closeObject()
+}
+
+// RefOfParsedComments decodes the value from JSON.
+func (dec *jsonDecoder) RefOfParsedComments(data json.RawMessage) *ParsedComments {
+	fields := dec.object(data, "ParsedComments")
+	if fields == nil {
+		return nil
+	}
+	out := &ParsedComments{}
+	out.comments = dec.Comments(fields["comments"])
+	return out
+}
+
+// RefOfPartitionDefinition encodes the value as JSON.
+func (enc *jsonEncoder) RefOfPartitionDefinition(n *PartitionDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("PartitionDefinition")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Options")
+	enc.RefOfPartitionDefinitionOptions(n.Options)
+	enc.closeObject()
+}
+
+// RefOfPartitionDefinition decodes the value from JSON.
+func (dec *jsonDecoder) RefOfPartitionDefinition(data json.RawMessage) *PartitionDefinition {
+	fields := dec.object(data, "PartitionDefinition")
+	if fields == nil {
+		return nil
+	}
+	out := &PartitionDefinition{}
+	dec.value(fields["Name"], &out.Name)
+	out.Options = dec.RefOfPartitionDefinitionOptions(fields["Options"])
+	return out
+}
+
+// RefOfPartitionDefinitionOptions encodes the value as JSON.
+func (enc *jsonEncoder) RefOfPartitionDefinitionOptions(n *PartitionDefinitionOptions) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("PartitionDefinitionOptions")
+	enc.field("ValueRange")
+	enc.RefOfPartitionValueRange(n.ValueRange)
+	enc.field("Comment")
+	enc.RefOfLiteral(n.Comment)
+	enc.field("Engine")
+	enc.RefOfPartitionEngine(n.Engine)
+	enc.field("DataDirectory")
+	enc.RefOfLiteral(n.DataDirectory)
+	enc.field("IndexDirectory")
+	enc.RefOfLiteral(n.IndexDirectory)
+	enc.field("MaxRows")
+	enc.RefOfInt(n.MaxRows)
+	enc.field("MinRows")
+	enc.RefOfInt(n.MinRows)
+	enc.field("TableSpace")
+	enc.value(n.TableSpace)
+	enc.field("SubPartitionDefinitions")
+	enc.SubPartitionDefinitions(n.SubPartitionDefinitions)
+	enc.closeObject()
+}
+
+// RefOfPartitionDefinitionOptions decodes the value from JSON.
+func (dec *jsonDecoder) RefOfPartitionDefinitionOptions(data json.RawMessage) *PartitionDefinitionOptions {
+	fields := dec.object(data, "PartitionDefinitionOptions")
+	if fields == nil {
+		return nil
+	}
+	out := &PartitionDefinitionOptions{}
+	out.ValueRange = dec.RefOfPartitionValueRange(fields["ValueRange"])
+	out.Comment = dec.RefOfLiteral(fields["Comment"])
+	out.Engine = dec.RefOfPartitionEngine(fields["Engine"])
+	out.DataDirectory = dec.RefOfLiteral(fields["DataDirectory"])
+	out.IndexDirectory = dec.RefOfLiteral(fields["IndexDirectory"])
+	out.MaxRows = dec.RefOfInt(fields["MaxRows"])
+	out.MinRows = dec.RefOfInt(fields["MinRows"])
+	dec.value(fields["TableSpace"], &out.TableSpace)
+	out.SubPartitionDefinitions = dec.SubPartitionDefinitions(fields["SubPartitionDefinitions"])
+	return out
+}
+
+// RefOfPartitionEngine encodes the value as JSON.
+func (enc *jsonEncoder) RefOfPartitionEngine(n *PartitionEngine) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("PartitionEngine")
+	enc.field("Storage")
+	enc.value(n.Storage)
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.closeObject()
+}
+
+// RefOfPartitionEngine decodes the value from JSON.
+func (dec *jsonDecoder) RefOfPartitionEngine(data json.RawMessage) *PartitionEngine {
+	fields := dec.object(data, "PartitionEngine")
+	if fields == nil {
+		return nil
+	}
+	out := &PartitionEngine{}
+	dec.value(fields["Storage"], &out.Storage)
+	dec.value(fields["Name"], &out.Name)
+	return out
+}
+
+// RefOfPartitionOption encodes the value as JSON.
+func (enc *jsonEncoder) RefOfPartitionOption(n *PartitionOption) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("PartitionOption")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("IsLinear")
+	enc.value(n.IsLinear)
+	enc.field("KeyAlgorithm")
+	enc.value(n.KeyAlgorithm)
+	enc.field("ColList")
+	enc.Columns(n.ColList)
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Partitions")
+	enc.value(n.Partitions)
+	enc.field("SubPartition")
+	enc.RefOfSubPartition(n.SubPartition)
+	enc.field("Definitions")
+	enc.SliceOfRefOfPartitionDefinition(n.Definitions)
+	enc.closeObject()
+}
+
+// RefOfPartitionOption decodes the value from JSON.
+func (dec *jsonDecoder) RefOfPartitionOption(data json.RawMessage) *PartitionOption {
+	fields := dec.object(data, "PartitionOption")
+	if fields == nil {
+		return nil
+	}
+	out := &PartitionOption{}
+	dec.value(fields["Type"], &out.Type)
+	dec.value(fields["IsLinear"], &out.IsLinear)
+	dec.value(fields["KeyAlgorithm"], &out.KeyAlgorithm)
+	out.ColList = dec.Columns(fields["ColList"])
+	out.Expr = dec.Expr(fields["Expr"])
+	dec.value(fields["Partitions"], &out.Partitions)
+	out.SubPartition = dec.RefOfSubPartition(fields["SubPartition"])
+	out.Definitions = dec.SliceOfRefOfPartitionDefinition(fields["Definitions"])
+	return out
+}
+
+// RefOfPartitionSpec encodes the value as JSON.
+func (enc *jsonEncoder) RefOfPartitionSpec(n *PartitionSpec) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("PartitionSpec")
+	enc.field("Action")
+	enc.value(n.Action)
+	enc.field("Names")
+	enc.Partitions(n.Names)
+	enc.field("Number")
+	enc.RefOfLiteral(n.Number)
+	enc.field("IsAll")
+	enc.value(n.IsAll)
+	enc.field("TableName")
+	enc.TableName(n.TableName)
+	enc.field("WithoutValidation")
+	enc.value(n.WithoutValidation)
+	enc.field("Definitions")
+	enc.SliceOfRefOfPartitionDefinition(n.Definitions)
+	enc.closeObject()
+}
+
+// RefOfPartitionSpec decodes the value from JSON.
+func (dec *jsonDecoder) RefOfPartitionSpec(data json.RawMessage) *PartitionSpec {
+	fields := dec.object(data, "PartitionSpec")
+	if fields == nil {
+		return nil
+	}
+	out := &PartitionSpec{}
+	dec.value(fields["Action"], &out.Action)
+	out.Names = dec.Partitions(fields["Names"])
+	out.Number = dec.RefOfLiteral(fields["Number"])
+	dec.value(fields["IsAll"], &out.IsAll)
+	out.TableName = dec.TableName(fields["TableName"])
+	dec.value(fields["WithoutValidation"], &out.WithoutValidation)
+	out.Definitions = dec.SliceOfRefOfPartitionDefinition(fields["Definitions"])
+	return out
+}
+
+// RefOfPartitionValueRange encodes the value as JSON.
+func (enc *jsonEncoder) RefOfPartitionValueRange(n *PartitionValueRange) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("PartitionValueRange")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Range")
+	enc.ValTuple(n.Range)
+	enc.field("Maxvalue")
+	enc.value(n.Maxvalue)
+	enc.closeObject()
+}
+
+// RefOfPartitionValueRange decodes the value from JSON.
+func (dec *jsonDecoder) RefOfPartitionValueRange(data json.RawMessage) *PartitionValueRange {
+	fields := dec.object(data, "PartitionValueRange")
+	if fields == nil {
+		return nil
+	}
+	out := &PartitionValueRange{}
+	dec.value(fields["Type"], &out.Type)
+	out.Range = dec.ValTuple(fields["Range"])
+	dec.value(fields["Maxvalue"], &out.Maxvalue)
+	return out
+}
+
+// Partitions encodes the value as JSON.
+func (enc *jsonEncoder) Partitions(n Partitions) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.value(el)
+	}
+	enc.closeArray()
+}
+
+// Partitions decodes the value from JSON.
+func (dec *jsonDecoder) Partitions(data json.RawMessage) Partitions {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(Partitions, len(elements))
+	for i, el := range elements {
+		dec.value(el, &out[i])
+	}
+	return out
+}
+
+// RefOfPasswordExpire encodes the value as JSON.
+func (enc *jsonEncoder) RefOfPasswordExpire(n *PasswordExpire) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("PasswordExpire")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Days")
+	enc.RefOfLiteral(n.Days)
+	enc.closeObject()
+}
+
+// RefOfPasswordExpire decodes the value from JSON.
+func (dec *jsonDecoder) RefOfPasswordExpire(data json.RawMessage) *PasswordExpire {
+	fields := dec.object(data, "PasswordExpire")
+	if fields == nil {
+		return nil
+	}
+	out := &PasswordExpire{}
+	dec.value(fields["Type"], &out.Type)
+	out.Days = dec.RefOfLiteral(fields["Days"])
+	return out
+}
+
+// RefOfPerformanceSchemaFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfPerformanceSchemaFuncExpr(n *PerformanceSchemaFuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("PerformanceSchemaFuncExpr")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Argument")
+	enc.Expr(n.Argument)
+	enc.closeObject()
+}
+
+// RefOfPerformanceSchemaFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfPerformanceSchemaFuncExpr(data json.RawMessage) *PerformanceSchemaFuncExpr {
+	fields := dec.object(data, "PerformanceSchemaFuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &PerformanceSchemaFuncExpr{}
+	dec.value(fields["Type"], &out.Type)
+	out.Argument = dec.Expr(fields["Argument"])
+	return out
+}
+
+// RefOfPointExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfPointExpr(n *PointExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("PointExpr")
+	enc.field("XCordinate")
+	enc.Expr(n.XCordinate)
+	enc.field("YCordinate")
+	enc.Expr(n.YCordinate)
+	enc.closeObject()
+}
+
+// RefOfPointExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfPointExpr(data json.RawMessage) *PointExpr {
+	fields := dec.object(data, "PointExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &PointExpr{}
+	out.XCordinate = dec.Expr(fields["XCordinate"])
+	out.YCordinate = dec.Expr(fields["YCordinate"])
+	return out
+}
+
+// RefOfPointPropertyFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfPointPropertyFuncExpr(n *PointPropertyFuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("PointPropertyFuncExpr")
+	enc.field("Property")
+	enc.value(n.Property)
+	enc.field("Point")
+	enc.Expr(n.Point)
+	enc.field("ValueToSet")
+	enc.Expr(n.ValueToSet)
+	enc.closeObject()
+}
+
+// RefOfPointPropertyFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfPointPropertyFuncExpr(data json.RawMessage) *PointPropertyFuncExpr {
+	fields := dec.object(data, "PointPropertyFuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &PointPropertyFuncExpr{}
+	dec.value(fields["Property"], &out.Property)
+	out.Point = dec.Expr(fields["Point"])
+	out.ValueToSet = dec.Expr(fields["ValueToSet"])
+	return out
+}
+
+// RefOfPolygonExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfPolygonExpr(n *PolygonExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("PolygonExpr")
+	enc.field("LinestringParams")
+	enc.Exprs(n.LinestringParams)
+	enc.closeObject()
+}
+
+// RefOfPolygonExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfPolygonExpr(data json.RawMessage) *PolygonExpr {
+	fields := dec.object(data, "PolygonExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &PolygonExpr{}
+	out.LinestringParams = dec.Exprs(fields["LinestringParams"])
+	return out
+}
+
+// RefOfPolygonPropertyFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfPolygonPropertyFuncExpr(n *PolygonPropertyFuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("PolygonPropertyFuncExpr")
+	enc.field("Property")
+	enc.value(n.Property)
+	enc.field("Polygon")
+	enc.Expr(n.Polygon)
+	enc.field("PropertyDefArg")
+	enc.Expr(n.PropertyDefArg)
+	enc.closeObject()
+}
+
+// RefOfPolygonPropertyFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfPolygonPropertyFuncExpr(data json.RawMessage) *PolygonPropertyFuncExpr {
+	fields := dec.object(data, "PolygonPropertyFuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &PolygonPropertyFuncExpr{}
+	dec.value(fields["Property"], &out.Property)
+	out.Polygon = dec.Expr(fields["Polygon"])
+	out.PropertyDefArg = dec.Expr(fields["PropertyDefArg"])
+	return out
+}
+
+// RefOfPositionalArg encodes the value as JSON.
+func (enc *jsonEncoder) RefOfPositionalArg(n *PositionalArg) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("PositionalArg")
+	enc.field("Index")
+	enc.value(n.Index)
+	enc.closeObject()
+}
+
+// RefOfPositionalArg decodes the value from JSON.
+func (dec *jsonDecoder) RefOfPositionalArg(data json.RawMessage) *PositionalArg {
+	fields := dec.object(data, "PositionalArg")
+	if fields == nil {
+		return nil
+	}
+	out := &PositionalArg{}
+	dec.value(fields["Index"], &out.Index)
+	return out
+}
+
+// RefOfPrepareStmt encodes the value as JSON.
+func (enc *jsonEncoder) RefOfPrepareStmt(n *PrepareStmt) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("PrepareStmt")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Statement")
+	enc.Expr(n.Statement)
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.closeObject()
+}
+
+// RefOfPrepareStmt decodes the value from JSON.
+func (dec *jsonDecoder) RefOfPrepareStmt(data json.RawMessage) *PrepareStmt {
+	fields := dec.object(data, "PrepareStmt")
+	if fields == nil {
+		return nil
+	}
+	out := &PrepareStmt{}
+	dec.value(fields["Name"], &out.Name)
+	out.Statement = dec.Expr(fields["Statement"])
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	return out
+}
+
+// RefOfPrivilegeLevel encodes the value as JSON.
+func (enc *jsonEncoder) RefOfPrivilegeLevel(n *PrivilegeLevel) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("PrivilegeLevel")
+	enc.field("ObjectType")
+	enc.value(n.ObjectType)
+	enc.field("Global")
+	enc.value(n.Global)
+	enc.field("DB")
+	enc.value(n.DB)
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.closeObject()
+}
+
+// RefOfPrivilegeLevel decodes the value from JSON.
+func (dec *jsonDecoder) RefOfPrivilegeLevel(data json.RawMessage) *PrivilegeLevel {
+	fields := dec.object(data, "PrivilegeLevel")
+	if fields == nil {
+		return nil
+	}
+	out := &PrivilegeLevel{}
+	dec.value(fields["ObjectType"], &out.ObjectType)
+	dec.value(fields["Global"], &out.Global)
+	dec.value(fields["DB"], &out.DB)
+	dec.value(fields["Name"], &out.Name)
+	return out
+}
+
+// RefOfProcParameter encodes the value as JSON.
+func (enc *jsonEncoder) RefOfProcParameter(n *ProcParameter) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ProcParameter")
+	enc.field("Mode")
+	enc.value(n.Mode)
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Type")
+	enc.RefOfColumnType(n.Type)
+	enc.closeObject()
+}
+
+// RefOfProcParameter decodes the value from JSON.
+func (dec *jsonDecoder) RefOfProcParameter(data json.RawMessage) *ProcParameter {
+	fields := dec.object(data, "ProcParameter")
+	if fields == nil {
+		return nil
+	}
+	out := &ProcParameter{}
+	dec.value(fields["Mode"], &out.Mode)
+	dec.value(fields["Name"], &out.Name)
+	out.Type = dec.RefOfColumnType(fields["Type"])
+	return out
+}
+
+// RefOfPurgeBinaryLogs encodes the value as JSON.
+func (enc *jsonEncoder) RefOfPurgeBinaryLogs(n *PurgeBinaryLogs) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("PurgeBinaryLogs")
+	enc.field("To")
+	enc.value(n.To)
+	enc.field("Before")
+	enc.value(n.Before)
+	enc.closeObject()
+}
+
+// RefOfPurgeBinaryLogs decodes the value from JSON.
+func (dec *jsonDecoder) RefOfPurgeBinaryLogs(data json.RawMessage) *PurgeBinaryLogs {
+	fields := dec.object(data, "PurgeBinaryLogs")
+	if fields == nil {
+		return nil
+	}
+	out := &PurgeBinaryLogs{}
+	dec.value(fields["To"], &out.To)
+	dec.value(fields["Before"], &out.Before)
+	return out
+}
+
+// RefOfReferenceDefinition encodes the value as JSON.
+func (enc *jsonEncoder) RefOfReferenceDefinition(n *ReferenceDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ReferenceDefinition")
+	enc.field("ReferencedTable")
+	enc.TableName(n.ReferencedTable)
+	enc.field("ReferencedColumns")
+	enc.Columns(n.ReferencedColumns)
+	enc.field("Match")
+	enc.value(n.Match)
+	enc.field("OnDelete")
+	enc.value(n.OnDelete)
+	enc.field("OnUpdate")
+	enc.value(n.OnUpdate)
+	enc.closeObject()
+}
+
+// RefOfReferenceDefinition decodes the value from JSON.
+func (dec *jsonDecoder) RefOfReferenceDefinition(data json.RawMessage) *ReferenceDefinition {
+	fields := dec.object(data, "ReferenceDefinition")
+	if fields == nil {
+		return nil
+	}
+	out := &ReferenceDefinition{}
+	out.ReferencedTable = dec.TableName(fields["ReferencedTable"])
+	out.ReferencedColumns = dec.Columns(fields["ReferencedColumns"])
+	dec.value(fields["Match"], &out.Match)
+	dec.value(fields["OnDelete"], &out.OnDelete)
+	dec.value(fields["OnUpdate"], &out.OnUpdate)
+	return out
+}
+
+// RefOfRegexpInstrExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRegexpInstrExpr(n *RegexpInstrExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("RegexpInstrExpr")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Pattern")
+	enc.Expr(n.Pattern)
+	enc.field("Position")
+	enc.Expr(n.Position)
+	enc.field("Occurrence")
+	enc.Expr(n.Occurrence)
+	enc.field("ReturnOption")
+	enc.Expr(n.ReturnOption)
+	enc.field("MatchType")
+	enc.Expr(n.MatchType)
+	enc.closeObject()
+}
+
+// RefOfRegexpInstrExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRegexpInstrExpr(data json.RawMessage) *RegexpInstrExpr {
+	fields := dec.object(data, "RegexpInstrExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &RegexpInstrExpr{}
+	out.Expr = dec.Expr(fields["Expr"])
+	out.Pattern = dec.Expr(fields["Pattern"])
+	out.Position = dec.Expr(fields["Position"])
+	out.Occurrence = dec.Expr(fields["Occurrence"])
+	out.ReturnOption = dec.Expr(fields["ReturnOption"])
+	out.MatchType = dec.Expr(fields["MatchType"])
+	return out
+}
+
+// RefOfRegexpLikeExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRegexpLikeExpr(n *RegexpLikeExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("RegexpLikeExpr")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Pattern")
+	enc.Expr(n.Pattern)
+	enc.field("MatchType")
+	enc.Expr(n.MatchType)
+	enc.closeObject()
+}
+
+// RefOfRegexpLikeExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRegexpLikeExpr(data json.RawMessage) *RegexpLikeExpr {
+	fields := dec.object(data, "RegexpLikeExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &RegexpLikeExpr{}
+	out.Expr = dec.Expr(fields["Expr"])
+	out.Pattern = dec.Expr(fields["Pattern"])
+	out.MatchType = dec.Expr(fields["MatchType"])
+	return out
+}
+
+// RefOfRegexpReplaceExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRegexpReplaceExpr(n *RegexpReplaceExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("RegexpReplaceExpr")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Pattern")
+	enc.Expr(n.Pattern)
+	enc.field("Repl")
+	enc.Expr(n.Repl)
+	enc.field("Occurrence")
+	enc.Expr(n.Occurrence)
+	enc.field("Position")
+	enc.Expr(n.Position)
+	enc.field("MatchType")
+	enc.Expr(n.MatchType)
+	enc.closeObject()
+}
+
+// RefOfRegexpReplaceExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRegexpReplaceExpr(data json.RawMessage) *RegexpReplaceExpr {
+	fields := dec.object(data, "RegexpReplaceExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &RegexpReplaceExpr{}
+	out.Expr = dec.Expr(fields["Expr"])
+	out.Pattern = dec.Expr(fields["Pattern"])
+	out.Repl = dec.Expr(fields["Repl"])
+	out.Occurrence = dec.Expr(fields["Occurrence"])
+	out.Position = dec.Expr(fields["Position"])
+	out.MatchType = dec.Expr(fields["MatchType"])
+	return out
+}
+
+// RefOfRegexpSubstrExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRegexpSubstrExpr(n *RegexpSubstrExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("RegexpSubstrExpr")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Pattern")
+	enc.Expr(n.Pattern)
+	enc.field("Occurrence")
+	enc.Expr(n.Occurrence)
+	enc.field("Position")
+	enc.Expr(n.Position)
+	enc.field("MatchType")
+	enc.Expr(n.MatchType)
+	enc.closeObject()
+}
+
+// RefOfRegexpSubstrExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRegexpSubstrExpr(data json.RawMessage) *RegexpSubstrExpr {
+	fields := dec.object(data, "RegexpSubstrExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &RegexpSubstrExpr{}
+	out.Expr = dec.Expr(fields["Expr"])
+	out.Pattern = dec.Expr(fields["Pattern"])
+	out.Occurrence = dec.Expr(fields["Occurrence"])
+	out.Position = dec.Expr(fields["Position"])
+	out.MatchType = dec.Expr(fields["MatchType"])
+	return out
+}
+
+// RefOfRelease encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRelease(n *Release) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Release")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.closeObject()
+}
+
+// RefOfRelease decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRelease(data json.RawMessage) *Release {
+	fields := dec.object(data, "Release")
+	if fields == nil {
+		return nil
+	}
+	out := &Release{}
+	dec.value(fields["Name"], &out.Name)
+	return out
+}
+
+// RefOfRenameColumn encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRenameColumn(n *RenameColumn) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("RenameColumn")
+	enc.field("OldName")
+	enc.RefOfColName(n.OldName)
+	enc.field("NewName")
+	enc.RefOfColName(n.NewName)
+	enc.closeObject()
+}
+
+// RefOfRenameColumn decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRenameColumn(data json.RawMessage) *RenameColumn {
+	fields := dec.object(data, "RenameColumn")
+	if fields == nil {
+		return nil
+	}
+	out := &RenameColumn{}
+	out.OldName = dec.RefOfColName(fields["OldName"])
+	out.NewName = dec.RefOfColName(fields["NewName"])
+	return out
+}
+
+// RefOfRenameIndex encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRenameIndex(n *RenameIndex) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("RenameIndex")
+	enc.field("OldName")
+	enc.value(n.OldName)
+	enc.field("NewName")
+	enc.value(n.NewName)
+	enc.closeObject()
+}
+
+// RefOfRenameIndex decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRenameIndex(data json.RawMessage) *RenameIndex {
+	fields := dec.object(data, "RenameIndex")
+	if fields == nil {
+		return nil
+	}
+	out := &RenameIndex{}
+	dec.value(fields["OldName"], &out.OldName)
+	dec.value(fields["NewName"], &out.NewName)
+	return out
+}
+
+// RefOfRenameTable encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRenameTable(n *RenameTable) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("RenameTable")
+	enc.field("TablePairs")
+	enc.SliceOfRefOfRenameTablePair(n.TablePairs)
+	enc.closeObject()
+}
+
+// RefOfRenameTable decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRenameTable(data json.RawMessage) *RenameTable {
+	fields := dec.object(data, "RenameTable")
+	if fields == nil {
+		return nil
+	}
+	out := &RenameTable{}
+	out.TablePairs = dec.SliceOfRefOfRenameTablePair(fields["TablePairs"])
+	return out
+}
+
+// RefOfRenameTableName encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRenameTableName(n *RenameTableName) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("RenameTableName")
+	enc.field("Table")
+	enc.TableName(n.Table)
+	enc.closeObject()
+}
+
+// RefOfRenameTableName decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRenameTableName(data json.RawMessage) *RenameTableName {
+	fields := dec.object(data, "RenameTableName")
+	if fields == nil {
+		return nil
+	}
+	out := &RenameTableName{}
+	out.Table = dec.TableName(fields["Table"])
+	return out
+}
+
+// RefOfRenameUser encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRenameUser(n *RenameUser) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("RenameUser")
+	enc.field("UserPairs")
+	enc.SliceOfRefOfRenameUserPair(n.UserPairs)
+	enc.closeObject()
+}
+
+// RefOfRenameUser decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRenameUser(data json.RawMessage) *RenameUser {
+	fields := dec.object(data, "RenameUser")
+	if fields == nil {
+		return nil
+	}
+	out := &RenameUser{}
+	out.UserPairs = dec.SliceOfRefOfRenameUserPair(fields["UserPairs"])
+	return out
+}
+
+// RefOfRenameUserPair encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRenameUserPair(n *RenameUserPair) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("RenameUserPair")
+	enc.field("FromUser")
+	enc.RefOfAccount(n.FromUser)
+	enc.field("ToUser")
+	enc.RefOfAccount(n.ToUser)
+	enc.closeObject()
+}
+
+// RefOfRenameUserPair decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRenameUserPair(data json.RawMessage) *RenameUserPair {
+	fields := dec.object(data, "RenameUserPair")
+	if fields == nil {
+		return nil
+	}
+	out := &RenameUserPair{}
+	out.FromUser = dec.RefOfAccount(fields["FromUser"])
+	out.ToUser = dec.RefOfAccount(fields["ToUser"])
+	return out
+}
+
+// RefOfRepeatStatement encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRepeatStatement(n *RepeatStatement) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("RepeatStatement")
+	enc.field("Label")
+	enc.value(n.Label)
+	enc.field("Statements")
+	enc.StatementList(n.Statements)
+	enc.field("Until")
+	enc.Expr(n.Until)
+	enc.closeObject()
+}
+
+// RefOfRepeatStatement decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRepeatStatement(data json.RawMessage) *RepeatStatement {
+	fields := dec.object(data, "RepeatStatement")
+	if fields == nil {
+		return nil
+	}
+	out := &RepeatStatement{}
+	dec.value(fields["Label"], &out.Label)
+	out.Statements = dec.StatementList(fields["Statements"])
+	out.Until = dec.Expr(fields["Until"])
+	return out
+}
+
+// RefOfReturnStatement encodes the value as JSON.
+func (enc *jsonEncoder) RefOfReturnStatement(n *ReturnStatement) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ReturnStatement")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.closeObject()
+}
+
+// RefOfReturnStatement decodes the value from JSON.
+func (dec *jsonDecoder) RefOfReturnStatement(data json.RawMessage) *ReturnStatement {
+	fields := dec.object(data, "ReturnStatement")
+	if fields == nil {
+		return nil
+	}
+	out := &ReturnStatement{}
+	out.Expr = dec.Expr(fields["Expr"])
+	return out
+}
+
+// RefOfRevertMigration encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRevertMigration(n *RevertMigration) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("RevertMigration")
+	enc.field("UUID")
+	enc.value(n.UUID)
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.closeObject()
+}
+
+// RefOfRevertMigration decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRevertMigration(data json.RawMessage) *RevertMigration {
+	fields := dec.object(data, "RevertMigration")
+	if fields == nil {
+		return nil
+	}
+	out := &RevertMigration{}
+	dec.value(fields["UUID"], &out.UUID)
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	return out
+}
+
+// RefOfRevoke encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRevoke(n *Revoke) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Revoke")
+	enc.field("IfExists")
+	enc.value(n.IfExists)
+	enc.field("Privileges")
+	enc.SliceOfRefOfGrantPrivilege(n.Privileges)
+	enc.field("On")
+	enc.RefOfPrivilegeLevel(n.On)
+	enc.field("Roles")
+	enc.SliceOfRefOfAccount(n.Roles)
+	enc.field("Proxy")
+	enc.RefOfAccount(n.Proxy)
+	enc.field("From")
+	enc.SliceOfRefOfAccount(n.From)
+	enc.field("IgnoreUnknownUser")
+	enc.value(n.IgnoreUnknownUser)
+	enc.closeObject()
+}
+
+// RefOfRevoke decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRevoke(data json.RawMessage) *Revoke {
+	fields := dec.object(data, "Revoke")
+	if fields == nil {
+		return nil
+	}
+	out := &Revoke{}
+	dec.value(fields["IfExists"], &out.IfExists)
+	out.Privileges = dec.SliceOfRefOfGrantPrivilege(fields["Privileges"])
+	out.On = dec.RefOfPrivilegeLevel(fields["On"])
+	out.Roles = dec.SliceOfRefOfAccount(fields["Roles"])
+	out.Proxy = dec.RefOfAccount(fields["Proxy"])
+	out.From = dec.SliceOfRefOfAccount(fields["From"])
+	dec.value(fields["IgnoreUnknownUser"], &out.IgnoreUnknownUser)
+	return out
+}
+
+// RefOfRollback encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRollback(n *Rollback) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Rollback")
+	enc.closeObject()
+}
+
+// RefOfRollback decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRollback(data json.RawMessage) *Rollback {
+	if dec.object(data, "Rollback") == nil {
+		return nil
+	}
+	out := &Rollback{}
+	return out
+}
+
+// RootNode encodes the value as JSON.
+func (enc *jsonEncoder) RootNode(n RootNode) {
+	enc.openNode("RootNode")
+	enc.field("SQLNode")
+	enc.SQLNode(n.SQLNode)
+	enc.closeObject()
+}
+
+// RootNode decodes the value from JSON.
+func (dec *jsonDecoder) RootNode(data json.RawMessage) (out RootNode) {
+	fields := dec.object(data, "RootNode")
+	if fields == nil {
+		return
+	}
+	out.SQLNode = dec.SQLNode(fields["SQLNode"])
+	return
+}
+
+// RefOfRoutineCharacteristic encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRoutineCharacteristic(n *RoutineCharacteristic) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("RoutineCharacteristic")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Comment")
+	enc.value(n.Comment)
+	enc.closeObject()
+}
+
+// RefOfRoutineCharacteristic decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRoutineCharacteristic(data json.RawMessage) *RoutineCharacteristic {
+	fields := dec.object(data, "RoutineCharacteristic")
+	if fields == nil {
+		return nil
+	}
+	out := &RoutineCharacteristic{}
+	dec.value(fields["Type"], &out.Type)
+	dec.value(fields["Comment"], &out.Comment)
+	return out
+}
+
+// RefOfRoutineSet encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRoutineSet(n *RoutineSet) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("RoutineSet")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("Exprs")
+	enc.RoutineSetExprs(n.Exprs)
+	enc.closeObject()
+}
+
+// RefOfRoutineSet decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRoutineSet(data json.RawMessage) *RoutineSet {
+	fields := dec.object(data, "RoutineSet")
+	if fields == nil {
+		return nil
+	}
+	out := &RoutineSet{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	out.Exprs = dec.RoutineSetExprs(fields["Exprs"])
+	return out
+}
+
+// RefOfRoutineSetExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRoutineSetExpr(n *RoutineSetExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("RoutineSetExpr")
+	enc.field("Var")
+	enc.Expr(n.Var)
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.closeObject()
+}
+
+// RefOfRoutineSetExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRoutineSetExpr(data json.RawMessage) *RoutineSetExpr {
+	fields := dec.object(data, "RoutineSetExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &RoutineSetExpr{}
+	out.Var = dec.Expr(fields["Var"])
+	out.Expr = dec.Expr(fields["Expr"])
+	return out
+}
+
+// RoutineSetExprs encodes the value as JSON.
+func (enc *jsonEncoder) RoutineSetExprs(n RoutineSetExprs) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfRoutineSetExpr(el)
+	}
+	enc.closeArray()
+}
+
+// RoutineSetExprs decodes the value from JSON.
+func (dec *jsonDecoder) RoutineSetExprs(data json.RawMessage) RoutineSetExprs {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(RoutineSetExprs, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfRoutineSetExpr(el)
+	}
+	return out
+}
+
+// RefOfSRollback encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSRollback(n *SRollback) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("SRollback")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.closeObject()
+}
+
+// RefOfSRollback decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSRollback(data json.RawMessage) *SRollback {
+	fields := dec.object(data, "SRollback")
+	if fields == nil {
+		return nil
+	}
+	out := &SRollback{}
+	dec.value(fields["Name"], &out.Name)
+	return out
+}
+
+// RefOfSavepoint encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSavepoint(n *Savepoint) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Savepoint")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.closeObject()
+}
+
+// RefOfSavepoint decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSavepoint(data json.RawMessage) *Savepoint {
+	fields := dec.object(data, "Savepoint")
+	if fields == nil {
+		return nil
+	}
+	out := &Savepoint{}
+	dec.value(fields["Name"], &out.Name)
+	return out
+}
+
+// RefOfSelect encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSelect(n *Select) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Select")
+	enc.field("Cache")
+	enc.RefOfBool(n.Cache)
+	enc.field("Distinct")
+	enc.value(n.Distinct)
+	enc.field("StraightJoinHint")
+	enc.value(n.StraightJoinHint)
+	enc.field("SQLCalcFoundRows")
+	enc.value(n.SQLCalcFoundRows)
+	enc.field("From")
+	enc.SliceOfTableExpr(n.From)
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("SelectExprs")
+	enc.SelectExprs(n.SelectExprs)
+	enc.field("Where")
+	enc.RefOfWhere(n.Where)
+	enc.field("With")
+	enc.RefOfWith(n.With)
+	enc.field("GroupBy")
+	enc.GroupBy(n.GroupBy)
+	enc.field("Having")
+	enc.RefOfWhere(n.Having)
+	enc.field("Windows")
+	enc.NamedWindows(n.Windows)
+	enc.field("OrderBy")
+	enc.OrderBy(n.OrderBy)
+	enc.field("Limit")
+	enc.RefOfLimit(n.Limit)
+	enc.field("Lock")
+	enc.value(n.Lock)
+	enc.field("Into")
+	enc.RefOfSelectInto(n.Into)
+	enc.closeObject()
+}
+
+// RefOfSelect decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSelect(data json.RawMessage) *Select {
+	fields := dec.object(data, "Select")
+	if fields == nil {
+		return nil
+	}
+	out := &Select{}
+	out.Cache = dec.RefOfBool(fields["Cache"])
+	dec.value(fields["Distinct"], &out.Distinct)
+	dec.value(fields["StraightJoinHint"], &out.StraightJoinHint)
+	dec.value(fields["SQLCalcFoundRows"], &out.SQLCalcFoundRows)
+	out.From = dec.SliceOfTableExpr(fields["From"])
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	out.SelectExprs = dec.SelectExprs(fields["SelectExprs"])
+	out.Where = dec.RefOfWhere(fields["Where"])
+	out.With = dec.RefOfWith(fields["With"])
+	out.GroupBy = dec.GroupBy(fields["GroupBy"])
+	out.Having = dec.RefOfWhere(fields["Having"])
+	out.Windows = dec.NamedWindows(fields["Windows"])
+	out.OrderBy = dec.OrderBy(fields["OrderBy"])
+	out.Limit = dec.RefOfLimit(fields["Limit"])
+	dec.value(fields["Lock"], &out.Lock)
+	out.Into = dec.RefOfSelectInto(fields["Into"])
+	return out
+}
+
+// SelectExprs encodes the value as JSON.
+func (enc *jsonEncoder) SelectExprs(n SelectExprs) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.SelectExpr(el)
+	}
+	enc.closeArray()
+}
+
+// SelectExprs decodes the value from JSON.
+func (dec *jsonDecoder) SelectExprs(data json.RawMessage) SelectExprs {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(SelectExprs, len(elements))
+	for i, el := range elements {
+		out[i] = dec.SelectExpr(el)
+	}
+	return out
+}
+
+// RefOfSelectInto encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSelectInto(n *SelectInto) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("SelectInto")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("FileName")
+	enc.value(n.FileName)
+	enc.field("Charset")
+	enc.ColumnCharset(n.Charset)
+	enc.field("FormatOption")
+	enc.value(n.FormatOption)
+	enc.field("ExportOption")
+	enc.value(n.ExportOption)
+	enc.field("Manifest")
+	enc.value(n.Manifest)
+	enc.field("Overwrite")
+	enc.value(n.Overwrite)
+	enc.field("Variables")
+	enc.Exprs(n.Variables)
+	enc.closeObject()
+}
+
+// RefOfSelectInto decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSelectInto(data json.RawMessage) *SelectInto {
+	fields := dec.object(data, "SelectInto")
+	if fields == nil {
+		return nil
+	}
+	out := &SelectInto{}
+	dec.value(fields["Type"], &out.Type)
+	dec.value(fields["FileName"], &out.FileName)
+	out.Charset = dec.ColumnCharset(fields["Charset"])
+	dec.value(fields["FormatOption"], &out.FormatOption)
+	dec.value(fields["ExportOption"], &out.ExportOption)
+	dec.value(fields["Manifest"], &out.Manifest)
+	dec.value(fields["Overwrite"], &out.Overwrite)
+	out.Variables = dec.Exprs(fields["Variables"])
+	return out
+}
+
+// RefOfSet encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSet(n *Set) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Set")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("Exprs")
+	enc.SetExprs(n.Exprs)
+	enc.closeObject()
+}
+
+// RefOfSet decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSet(data json.RawMessage) *Set {
+	fields := dec.object(data, "Set")
+	if fields == nil {
+		return nil
+	}
+	out := &Set{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	out.Exprs = dec.SetExprs(fields["Exprs"])
+	return out
+}
+
+// RefOfSetDefaultRole encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSetDefaultRole(n *SetDefaultRole) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("SetDefaultRole")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Roles")
+	enc.SliceOfRefOfAccount(n.Roles)
+	enc.field("To")
+	enc.SliceOfRefOfAccount(n.To)
+	enc.closeObject()
+}
+
+// RefOfSetDefaultRole decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSetDefaultRole(data json.RawMessage) *SetDefaultRole {
+	fields := dec.object(data, "SetDefaultRole")
+	if fields == nil {
+		return nil
+	}
+	out := &SetDefaultRole{}
+	dec.value(fields["Type"], &out.Type)
+	out.Roles = dec.SliceOfRefOfAccount(fields["Roles"])
+	out.To = dec.SliceOfRefOfAccount(fields["To"])
+	return out
+}
+
+// RefOfSetExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSetExpr(n *SetExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("SetExpr")
+	enc.field("Var")
+	enc.RefOfVariable(n.Var)
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.closeObject()
+}
+
+// RefOfSetExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSetExpr(data json.RawMessage) *SetExpr {
+	fields := dec.object(data, "SetExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &SetExpr{}
+	out.Var = dec.RefOfVariable(fields["Var"])
+	out.Expr = dec.Expr(fields["Expr"])
+	return out
+}
+
+// SetExprs encodes the value as JSON.
+func (enc *jsonEncoder) SetExprs(n SetExprs) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfSetExpr(el)
+	}
+	enc.closeArray()
+}
+
+// SetExprs decodes the value from JSON.
+func (dec *jsonDecoder) SetExprs(data json.RawMessage) SetExprs {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(SetExprs, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfSetExpr(el)
+	}
+	return out
+}
+
+// RefOfSetPassword encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSetPassword(n *SetPassword) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("SetPassword")
+	enc.field("For")
+	enc.RefOfAccount(n.For)
+	enc.field("Password")
+	enc.RefOfLiteral(n.Password)
+	enc.closeObject()
+}
+
+// RefOfSetPassword decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSetPassword(data json.RawMessage) *SetPassword {
+	fields := dec.object(data, "SetPassword")
+	if fields == nil {
+		return nil
+	}
+	out := &SetPassword{}
+	out.For = dec.RefOfAccount(fields["For"])
+	out.Password = dec.RefOfLiteral(fields["Password"])
+	return out
+}
+
+// RefOfSetRole encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSetRole(n *SetRole) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("SetRole")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Roles")
+	enc.SliceOfRefOfAccount(n.Roles)
+	enc.closeObject()
+}
+
+// RefOfSetRole decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSetRole(data json.RawMessage) *SetRole {
+	fields := dec.object(data, "SetRole")
+	if fields == nil {
+		return nil
+	}
+	out := &SetRole{}
+	dec.value(fields["Type"], &out.Type)
+	out.Roles = dec.SliceOfRefOfAccount(fields["Roles"])
+	return out
+}
+
+// RefOfShow encodes the value as JSON.
+func (enc *jsonEncoder) RefOfShow(n *Show) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Show")
+	enc.field("Internal")
+	enc.ShowInternal(n.Internal)
+	enc.closeObject()
+}
+
+// RefOfShow decodes the value from JSON.
+func (dec *jsonDecoder) RefOfShow(data json.RawMessage) *Show {
+	fields := dec.object(data, "Show")
+	if fields == nil {
+		return nil
+	}
+	out := &Show{}
+	out.Internal = dec.ShowInternal(fields["Internal"])
+	return out
+}
+
+// RefOfShowBasic encodes the value as JSON.
+func (enc *jsonEncoder) RefOfShowBasic(n *ShowBasic) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ShowBasic")
+	enc.field("Command")
+	enc.value(n.Command)
+	enc.field("Full")
+	enc.value(n.Full)
+	enc.field("Tbl")
+	enc.TableName(n.Tbl)
+	enc.field("DbName")
+	enc.value(n.DbName)
+	enc.field("Filter")
+	enc.RefOfShowFilter(n.Filter)
+	enc.closeObject()
+}
+
+// RefOfShowBasic decodes the value from JSON.
+func (dec *jsonDecoder) RefOfShowBasic(data json.RawMessage) *ShowBasic {
+	fields := dec.object(data, "ShowBasic")
+	if fields == nil {
+		return nil
+	}
+	out := &ShowBasic{}
+	dec.value(fields["Command"], &out.Command)
+	dec.value(fields["Full"], &out.Full)
+	out.Tbl = dec.TableName(fields["Tbl"])
+	dec.value(fields["DbName"], &out.DbName)
+	out.Filter = dec.RefOfShowFilter(fields["Filter"])
+	return out
+}
+
+// RefOfShowCreate encodes the value as JSON.
+func (enc *jsonEncoder) RefOfShowCreate(n *ShowCreate) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ShowCreate")
+	enc.field("Command")
+	enc.value(n.Command)
+	enc.field("Op")
+	enc.TableName(n.Op)
+	enc.closeObject()
+}
+
+// RefOfShowCreate decodes the value from JSON.
+func (dec *jsonDecoder) RefOfShowCreate(data json.RawMessage) *ShowCreate {
+	fields := dec.object(data, "ShowCreate")
+	if fields == nil {
+		return nil
+	}
+	out := &ShowCreate{}
+	dec.value(fields["Command"], &out.Command)
+	out.Op = dec.TableName(fields["Op"])
+	return out
+}
+
+// RefOfShowFilter encodes the value as JSON.
+func (enc *jsonEncoder) RefOfShowFilter(n *ShowFilter) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ShowFilter")
+	enc.field("Like")
+	enc.value(n.Like)
+	enc.field("Filter")
+	enc.Expr(n.Filter)
+	enc.closeObject()
+}
+
+// RefOfShowFilter decodes the value from JSON.
+func (dec *jsonDecoder) RefOfShowFilter(data json.RawMessage) *ShowFilter {
+	fields := dec.object(data, "ShowFilter")
+	if fields == nil {
+		return nil
+	}
+	out := &ShowFilter{}
+	dec.value(fields["Like"], &out.Like)
+	out.Filter = dec.Expr(fields["Filter"])
+	return out
+}
+
+// RefOfShowMigrationLogs encodes the value as JSON.
+func (enc *jsonEncoder) RefOfShowMigrationLogs(n *ShowMigrationLogs) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ShowMigrationLogs")
+	enc.field("UUID")
+	enc.value(n.UUID)
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.closeObject()
+}
+
+// RefOfShowMigrationLogs decodes the value from JSON.
+func (dec *jsonDecoder) RefOfShowMigrationLogs(data json.RawMessage) *ShowMigrationLogs {
+	fields := dec.object(data, "ShowMigrationLogs")
+	if fields == nil {
+		return nil
+	}
+	out := &ShowMigrationLogs{}
+	dec.value(fields["UUID"], &out.UUID)
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	return out
+}
+
+// RefOfShowOther encodes the value as JSON.
+func (enc *jsonEncoder) RefOfShowOther(n *ShowOther) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ShowOther")
+	enc.field("Command")
+	enc.value(n.Command)
+	enc.closeObject()
+}
+
+// RefOfShowOther decodes the value from JSON.
+func (dec *jsonDecoder) RefOfShowOther(data json.RawMessage) *ShowOther {
+	fields := dec.object(data, "ShowOther")
+	if fields == nil {
+		return nil
+	}
+	out := &ShowOther{}
+	dec.value(fields["Command"], &out.Command)
+	return out
+}
+
+// RefOfShowThrottledApps encodes the value as JSON.
+func (enc *jsonEncoder) RefOfShowThrottledApps(n *ShowThrottledApps) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ShowThrottledApps")
+	enc.field("Comments")
+	enc.Comments(n.Comments)
+	enc.closeObject()
+}
+
+// RefOfShowThrottledApps decodes the value from JSON.
+func (dec *jsonDecoder) RefOfShowThrottledApps(data json.RawMessage) *ShowThrottledApps {
+	fields := dec.object(data, "ShowThrottledApps")
+	if fields == nil {
+		return nil
+	}
+	out := &ShowThrottledApps{}
+	out.Comments = dec.Comments(fields["Comments"])
+	return out
+}
+
+// RefOfShowThrottlerStatus encodes the value as JSON.
+func (enc *jsonEncoder) RefOfShowThrottlerStatus(n *ShowThrottlerStatus) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ShowThrottlerStatus")
+	enc.field("Comments")
+	enc.Comments(n.Comments)
+	enc.closeObject()
+}
+
+// RefOfShowThrottlerStatus decodes the value from JSON.
+func (dec *jsonDecoder) RefOfShowThrottlerStatus(data json.RawMessage) *ShowThrottlerStatus {
+	fields := dec.object(data, "ShowThrottlerStatus")
+	if fields == nil {
+		return nil
+	}
+	out := &ShowThrottlerStatus{}
+	out.Comments = dec.Comments(fields["Comments"])
+	return out
+}
+
+// RefOfSignal encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSignal(n *Signal) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Signal")
+	enc.field("Resignal")
+	enc.value(n.Resignal)
+	enc.field("Condition")
+	enc.RefOfConditionValue(n.Condition)
+	enc.field("Items")
+	enc.SliceOfRefOfSignalItem(n.Items)
+	enc.closeObject()
+}
+
+// RefOfSignal decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSignal(data json.RawMessage) *Signal {
+	fields := dec.object(data, "Signal")
+	if fields == nil {
+		return nil
+	}
+	out := &Signal{}
+	dec.value(fields["Resignal"], &out.Resignal)
+	out.Condition = dec.RefOfConditionValue(fields["Condition"])
+	out.Items = dec.SliceOfRefOfSignalItem(fields["Items"])
+	return out
+}
+
+// RefOfSignalItem encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSignalItem(n *SignalItem) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("SignalItem")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Value")
+	enc.Expr(n.Value)
+	enc.closeObject()
+}
+
+// RefOfSignalItem decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSignalItem(data json.RawMessage) *SignalItem {
+	fields := dec.object(data, "SignalItem")
+	if fields == nil {
+		return nil
+	}
+	out := &SignalItem{}
+	dec.value(fields["Name"], &out.Name)
+	out.Value = dec.Expr(fields["Value"])
+	return out
+}
+
+// RefOfStarExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfStarExpr(n *StarExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("StarExpr")
+	enc.field("TableName")
+	enc.TableName(n.TableName)
+	enc.closeObject()
+}
+
+// RefOfStarExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfStarExpr(data json.RawMessage) *StarExpr {
+	fields := dec.object(data, "StarExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &StarExpr{}
+	out.TableName = dec.TableName(fields["TableName"])
+	return out
+}
+
+// StatementList encodes the value as JSON.
+func (enc *jsonEncoder) StatementList(n StatementList) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.Statement(el)
+	}
+	enc.closeArray()
+}
+
+// StatementList decodes the value from JSON.
+func (dec *jsonDecoder) StatementList(data json.RawMessage) StatementList {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(StatementList, len(elements))
+	for i, el := range elements {
+		out[i] = dec.Statement(el)
+	}
+	return out
+}
+
+// RefOfStd encodes the value as JSON.
+func (enc *jsonEncoder) RefOfStd(n *Std) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Std")
+	enc.field("Arg")
+	enc.Expr(n.Arg)
+	enc.closeObject()
+}
+
+// RefOfStd decodes the value from JSON.
+func (dec *jsonDecoder) RefOfStd(data json.RawMessage) *Std {
+	fields := dec.object(data, "Std")
+	if fields == nil {
+		return nil
+	}
+	out := &Std{}
+	out.Arg = dec.Expr(fields["Arg"])
+	return out
+}
+
+// RefOfStdDev encodes the value as JSON.
+func (enc *jsonEncoder) RefOfStdDev(n *StdDev) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("StdDev")
+	enc.field("Arg")
+	enc.Expr(n.Arg)
+	enc.closeObject()
+}
+
+// RefOfStdDev decodes the value from JSON.
+func (dec *jsonDecoder) RefOfStdDev(data json.RawMessage) *StdDev {
+	fields := dec.object(data, "StdDev")
+	if fields == nil {
+		return nil
+	}
+	out := &StdDev{}
+	out.Arg = dec.Expr(fields["Arg"])
+	return out
+}
+
+// RefOfStdPop encodes the value as JSON.
+func (enc *jsonEncoder) RefOfStdPop(n *StdPop) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("StdPop")
+	enc.field("Arg")
+	enc.Expr(n.Arg)
+	enc.closeObject()
+}
+
+// RefOfStdPop decodes the value from JSON.
+func (dec *jsonDecoder) RefOfStdPop(data json.RawMessage) *StdPop {
+	fields := dec.object(data, "StdPop")
+	if fields == nil {
+		return nil
+	}
+	out := &StdPop{}
+	out.Arg = dec.Expr(fields["Arg"])
+	return out
+}
+
+// RefOfStdSamp encodes the value as JSON.
+func (enc *jsonEncoder) RefOfStdSamp(n *StdSamp) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("StdSamp")
+	enc.field("Arg")
+	enc.Expr(n.Arg)
+	enc.closeObject()
+}
+
+// RefOfStdSamp decodes the value from JSON.
+func (dec *jsonDecoder) RefOfStdSamp(data json.RawMessage) *StdSamp {
+	fields := dec.object(data, "StdSamp")
+	if fields == nil {
+		return nil
+	}
+	out := &StdSamp{}
+	out.Arg = dec.Expr(fields["Arg"])
+	return out
+}
+
+// RefOfStream encodes the value as JSON.
+func (enc *jsonEncoder) RefOfStream(n *Stream) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Stream")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("SelectExpr")
+	enc.SelectExpr(n.SelectExpr)
+	enc.field("Table")
+	enc.TableName(n.Table)
+	enc.closeObject()
+}
+
+// RefOfStream decodes the value from JSON.
+func (dec *jsonDecoder) RefOfStream(data json.RawMessage) *Stream {
+	fields := dec.object(data, "Stream")
+	if fields == nil {
+		return nil
+	}
+	out := &Stream{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	out.SelectExpr = dec.SelectExpr(fields["SelectExpr"])
+	out.Table = dec.TableName(fields["Table"])
+	return out
+}
+
+// RefOfSubPartition encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSubPartition(n *SubPartition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("SubPartition")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("IsLinear")
+	enc.value(n.IsLinear)
+	enc.field("KeyAlgorithm")
+	enc.value(n.KeyAlgorithm)
+	enc.field("ColList")
+	enc.Columns(n.ColList)
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("SubPartitions")
+	enc.value(n.SubPartitions)
+	enc.closeObject()
+}
+
+// RefOfSubPartition decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSubPartition(data json.RawMessage) *SubPartition {
+	fields := dec.object(data, "SubPartition")
+	if fields == nil {
+		return nil
+	}
+	out := &SubPartition{}
+	dec.value(fields["Type"], &out.Type)
+	dec.value(fields["IsLinear"], &out.IsLinear)
+	dec.value(fields["KeyAlgorithm"], &out.KeyAlgorithm)
+	out.ColList = dec.Columns(fields["ColList"])
+	out.Expr = dec.Expr(fields["Expr"])
+	dec.value(fields["SubPartitions"], &out.SubPartitions)
+	return out
+}
+
+// RefOfSubPartitionDefinition encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSubPartitionDefinition(n *SubPartitionDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("SubPartitionDefinition")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Options")
+	enc.RefOfSubPartitionDefinitionOptions(n.Options)
+	enc.closeObject()
+}
+
+// RefOfSubPartitionDefinition decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSubPartitionDefinition(data json.RawMessage) *SubPartitionDefinition {
+	fields := dec.object(data, "SubPartitionDefinition")
+	if fields == nil {
+		return nil
+	}
+	out := &SubPartitionDefinition{}
+	dec.value(fields["Name"], &out.Name)
+	out.Options = dec.RefOfSubPartitionDefinitionOptions(fields["Options"])
+	return out
+}
+
+// RefOfSubPartitionDefinitionOptions encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSubPartitionDefinitionOptions(n *SubPartitionDefinitionOptions) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("SubPartitionDefinitionOptions")
+	enc.field("Comment")
+	enc.RefOfLiteral(n.Comment)
+	enc.field("Engine")
+	enc.RefOfPartitionEngine(n.Engine)
+	enc.field("DataDirectory")
+	enc.RefOfLiteral(n.DataDirectory)
+	enc.field("IndexDirectory")
+	enc.RefOfLiteral(n.IndexDirectory)
+	enc.field("MaxRows")
+	enc.RefOfInt(n.MaxRows)
+	enc.field("MinRows")
+	enc.RefOfInt(n.MinRows)
+	enc.field("TableSpace")
+	enc.value(n.TableSpace)
+	enc.closeObject()
+}
+
+// RefOfSubPartitionDefinitionOptions decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSubPartitionDefinitionOptions(data json.RawMessage) *SubPartitionDefinitionOptions {
+	fields := dec.object(data, "SubPartitionDefinitionOptions")
+	if fields == nil {
+		return nil
+	}
+	out := &SubPartitionDefinitionOptions{}
+	out.Comment = dec.RefOfLiteral(fields["Comment"])
+	out.Engine = dec.RefOfPartitionEngine(fields["Engine"])
+	out.DataDirectory = dec.RefOfLiteral(fields["DataDirectory"])
+	out.IndexDirectory = dec.RefOfLiteral(fields["IndexDirectory"])
+	out.MaxRows = dec.RefOfInt(fields["MaxRows"])
+	out.MinRows = dec.RefOfInt(fields["MinRows"])
+	dec.value(fields["TableSpace"], &out.TableSpace)
+	return out
+}
+
+// SubPartitionDefinitions encodes the value as JSON.
+func (enc *jsonEncoder) SubPartitionDefinitions(n SubPartitionDefinitions) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfSubPartitionDefinition(el)
+	}
+	enc.closeArray()
+}
+
+// SubPartitionDefinitions decodes the value from JSON.
+func (dec *jsonDecoder) SubPartitionDefinitions(data json.RawMessage) SubPartitionDefinitions {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(SubPartitionDefinitions, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfSubPartitionDefinition(el)
+	}
+	return out
+}
+
+// RefOfSubquery encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSubquery(n *Subquery) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Subquery")
+	enc.field("Select")
+	enc.SelectStatement(n.Select)
+	enc.closeObject()
+}
+
+// RefOfSubquery decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSubquery(data json.RawMessage) *Subquery {
+	fields := dec.object(data, "Subquery")
+	if fields == nil {
+		return nil
+	}
+	out := &Subquery{}
+	out.Select = dec.SelectStatement(fields["Select"])
+	return out
+}
+
+// RefOfSubstrExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSubstrExpr(n *SubstrExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("SubstrExpr")
+	enc.field("Name")
+	enc.Expr(n.Name)
+	enc.field("From")
+	enc.Expr(n.From)
+	enc.field("To")
+	enc.Expr(n.To)
+	enc.closeObject()
+}
+
+// RefOfSubstrExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSubstrExpr(data json.RawMessage) *SubstrExpr {
+	fields := dec.object(data, "SubstrExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &SubstrExpr{}
+	out.Name = dec.Expr(fields["Name"])
+	out.From = dec.Expr(fields["From"])
+	out.To = dec.Expr(fields["To"])
+	return out
+}
+
+// RefOfSum encodes the value as JSON.
+func (enc *jsonEncoder) RefOfSum(n *Sum) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Sum")
+	enc.field("Arg")
+	enc.Expr(n.Arg)
+	enc.field("Distinct")
+	enc.value(n.Distinct)
+	enc.closeObject()
+}
+
+// RefOfSum decodes the value from JSON.
+func (dec *jsonDecoder) RefOfSum(data json.RawMessage) *Sum {
+	fields := dec.object(data, "Sum")
+	if fields == nil {
+		return nil
+	}
+	out := &Sum{}
+	out.Arg = dec.Expr(fields["Arg"])
+	dec.value(fields["Distinct"], &out.Distinct)
+	return out
+}
+
+// TableExprs encodes the value as JSON.
+func (enc *jsonEncoder) TableExprs(n TableExprs) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.TableExpr(el)
+	}
+	enc.closeArray()
+}
+
+// TableExprs decodes the value from JSON.
+func (dec *jsonDecoder) TableExprs(data json.RawMessage) TableExprs {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(TableExprs, len(elements))
+	for i, el := range elements {
+		out[i] = dec.TableExpr(el)
+	}
+	return out
+}
+
+// TableName encodes the value as JSON.
+func (enc *jsonEncoder) TableName(n TableName) {
+	enc.openNode("TableName")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Qualifier")
+	enc.value(n.Qualifier)
+	enc.closeObject()
+}
+
+// TableName decodes the value from JSON.
+func (dec *jsonDecoder) TableName(data json.RawMessage) (out TableName) {
+	fields := dec.object(data, "TableName")
+	if fields == nil {
+		return
+	}
+	dec.value(fields["Name"], &out.Name)
+	dec.value(fields["Qualifier"], &out.Qualifier)
+	return
+}
+
+// TableNames encodes the value as JSON.
+func (enc *jsonEncoder) TableNames(n TableNames) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.TableName(el)
+	}
+	enc.closeArray()
+}
+
+// TableNames decodes the value from JSON.
+func (dec *jsonDecoder) TableNames(data json.RawMessage) TableNames {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(TableNames, len(elements))
+	for i, el := range elements {
+		out[i] = dec.TableName(el)
+	}
+	return out
+}
+
+// TableOptions encodes the value as JSON.
+func (enc *jsonEncoder) TableOptions(n TableOptions) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfTableOption(el)
+	}
+	enc.closeArray()
+}
+
+// TableOptions decodes the value from JSON.
+func (dec *jsonDecoder) TableOptions(data json.RawMessage) TableOptions {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(TableOptions, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfTableOption(el)
+	}
+	return out
+}
+
+// RefOfTableSpec encodes the value as JSON.
+func (enc *jsonEncoder) RefOfTableSpec(n *TableSpec) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("TableSpec")
+	enc.field("Columns")
+	enc.SliceOfRefOfColumnDefinition(n.Columns)
+	enc.field("Indexes")
+	enc.SliceOfRefOfIndexDefinition(n.Indexes)
+	enc.field("Constraints")
+	enc.SliceOfRefOfConstraintDefinition(n.Constraints)
+	enc.field("Options")
+	enc.TableOptions(n.Options)
+	enc.field("PartitionOption")
+	enc.RefOfPartitionOption(n.PartitionOption)
+	enc.closeObject()
+}
+
+// RefOfTableSpec decodes the value from JSON.
+func (dec *jsonDecoder) RefOfTableSpec(data json.RawMessage) *TableSpec {
+	fields := dec.object(data, "TableSpec")
+	if fields == nil {
+		return nil
+	}
+	out := &TableSpec{}
+	out.Columns = dec.SliceOfRefOfColumnDefinition(fields["Columns"])
+	out.Indexes = dec.SliceOfRefOfIndexDefinition(fields["Indexes"])
+	out.Constraints = dec.SliceOfRefOfConstraintDefinition(fields["Constraints"])
+	out.Options = dec.TableOptions(fields["Options"])
+	out.PartitionOption = dec.RefOfPartitionOption(fields["PartitionOption"])
+	return out
+}
+
+// RefOfTablespaceOperation encodes the value as JSON.
+func (enc *jsonEncoder) RefOfTablespaceOperation(n *TablespaceOperation) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("TablespaceOperation")
+	enc.field("Import")
+	enc.value(n.Import)
+	enc.closeObject()
+}
+
+// RefOfTablespaceOperation decodes the value from JSON.
+func (dec *jsonDecoder) RefOfTablespaceOperation(data json.RawMessage) *TablespaceOperation {
+	fields := dec.object(data, "TablespaceOperation")
+	if fields == nil {
+		return nil
+	}
+	out := &TablespaceOperation{}
+	dec.value(fields["Import"], &out.Import)
+	return out
+}
+
+// RefOfTimestampFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfTimestampFuncExpr(n *TimestampFuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("TimestampFuncExpr")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Expr1")
+	enc.Expr(n.Expr1)
+	enc.field("Expr2")
+	enc.Expr(n.Expr2)
+	enc.field("Unit")
+	enc.value(n.Unit)
+	enc.closeObject()
+}
+
+// RefOfTimestampFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfTimestampFuncExpr(data json.RawMessage) *TimestampFuncExpr {
+	fields := dec.object(data, "TimestampFuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &TimestampFuncExpr{}
+	dec.value(fields["Name"], &out.Name)
+	out.Expr1 = dec.Expr(fields["Expr1"])
+	out.Expr2 = dec.Expr(fields["Expr2"])
+	dec.value(fields["Unit"], &out.Unit)
+	return out
+}
+
+// RefOfTriggerOrder encodes the value as JSON.
+func (enc *jsonEncoder) RefOfTriggerOrder(n *TriggerOrder) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("TriggerOrder")
+	enc.field("Precedes")
+	enc.value(n.Precedes)
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.closeObject()
+}
+
+// RefOfTriggerOrder decodes the value from JSON.
+func (dec *jsonDecoder) RefOfTriggerOrder(data json.RawMessage) *TriggerOrder {
+	fields := dec.object(data, "TriggerOrder")
+	if fields == nil {
+		return nil
+	}
+	out := &TriggerOrder{}
+	dec.value(fields["Precedes"], &out.Precedes)
+	dec.value(fields["Name"], &out.Name)
+	return out
+}
+
+// RefOfTrimFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfTrimFuncExpr(n *TrimFuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("TrimFuncExpr")
+	enc.field("TrimFuncType")
+	enc.value(n.TrimFuncType)
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("TrimArg")
+	enc.Expr(n.TrimArg)
+	enc.field("StringArg")
+	enc.Expr(n.StringArg)
+	enc.closeObject()
+}
+
+// RefOfTrimFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfTrimFuncExpr(data json.RawMessage) *TrimFuncExpr {
+	fields := dec.object(data, "TrimFuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &TrimFuncExpr{}
+	dec.value(fields["TrimFuncType"], &out.TrimFuncType)
+	dec.value(fields["Type"], &out.Type)
+	out.TrimArg = dec.Expr(fields["TrimArg"])
+	out.StringArg = dec.Expr(fields["StringArg"])
+	return out
+}
+
+// RefOfTruncateTable encodes the value as JSON.
+func (enc *jsonEncoder) RefOfTruncateTable(n *TruncateTable) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("TruncateTable")
+	enc.field("Table")
+	enc.TableName(n.Table)
+	enc.closeObject()
+}
+
+// RefOfTruncateTable decodes the value from JSON.
+func (dec *jsonDecoder) RefOfTruncateTable(data json.RawMessage) *TruncateTable {
+	fields := dec.object(data, "TruncateTable")
+	if fields == nil {
+		return nil
+	}
+	out := &TruncateTable{}
+	out.Table = dec.TableName(fields["Table"])
+	return out
+}
+
+// RefOfTypeCastExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfTypeCastExpr(n *TypeCastExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("TypeCastExpr")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("Type")
+	enc.RefOfConvertType(n.Type)
+	enc.field("Array")
+	enc.value(n.Array)
+	enc.closeObject()
+}
+
+// RefOfTypeCastExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfTypeCastExpr(data json.RawMessage) *TypeCastExpr {
+	fields := dec.object(data, "TypeCastExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &TypeCastExpr{}
+	out.Expr = dec.Expr(fields["Expr"])
+	out.Type = dec.RefOfConvertType(fields["Type"])
+	dec.value(fields["Array"], &out.Array)
+	return out
+}
+
+// RefOfUnaryExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfUnaryExpr(n *UnaryExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("UnaryExpr")
+	enc.field("Operator")
+	enc.value(n.Operator)
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.closeObject()
+}
+
+// RefOfUnaryExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfUnaryExpr(data json.RawMessage) *UnaryExpr {
+	fields := dec.object(data, "UnaryExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &UnaryExpr{}
+	dec.value(fields["Operator"], &out.Operator)
+	out.Expr = dec.Expr(fields["Expr"])
+	return out
+}
+
+// RefOfUnion encodes the value as JSON.
+func (enc *jsonEncoder) RefOfUnion(n *Union) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Union")
+	enc.field("Left")
+	enc.SelectStatement(n.Left)
+	enc.field("Right")
+	enc.SelectStatement(n.Right)
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Distinct")
+	enc.value(n.Distinct)
+	enc.field("OrderBy")
+	enc.OrderBy(n.OrderBy)
+	enc.field("With")
+	enc.RefOfWith(n.With)
+	enc.field("Limit")
+	enc.RefOfLimit(n.Limit)
+	enc.field("Lock")
+	enc.value(n.Lock)
+	enc.field("Into")
+	enc.RefOfSelectInto(n.Into)
+	enc.closeObject()
+}
+
+// RefOfUnion decodes the value from JSON.
+func (dec *jsonDecoder) RefOfUnion(data json.RawMessage) *Union {
+	fields := dec.object(data, "Union")
+	if fields == nil {
+		return nil
+	}
+	out := &Union{}
+	out.Left = dec.SelectStatement(fields["Left"])
+	out.Right = dec.SelectStatement(fields["Right"])
+	dec.value(fields["Type"], &out.Type)
+	dec.value(fields["Distinct"], &out.Distinct)
+	out.OrderBy = dec.OrderBy(fields["OrderBy"])
+	out.With = dec.RefOfWith(fields["With"])
+	out.Limit = dec.RefOfLimit(fields["Limit"])
+	dec.value(fields["Lock"], &out.Lock)
+	out.Into = dec.RefOfSelectInto(fields["Into"])
+	return out
+}
+
+// RefOfUnlockTables encodes the value as JSON.
+func (enc *jsonEncoder) RefOfUnlockTables(n *UnlockTables) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("UnlockTables")
+	enc.closeObject()
+}
+
+// RefOfUnlockTables decodes the value from JSON.
+func (dec *jsonDecoder) RefOfUnlockTables(data json.RawMessage) *UnlockTables {
+	if dec.object(data, "UnlockTables") == nil {
+		return nil
+	}
+	out := &UnlockTables{}
+	return out
+}
+
+// RefOfUpdate encodes the value as JSON.
+func (enc *jsonEncoder) RefOfUpdate(n *Update) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Update")
+	enc.field("With")
+	enc.RefOfWith(n.With)
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("Ignore")
+	enc.value(n.Ignore)
+	enc.field("TableExprs")
+	enc.TableExprs(n.TableExprs)
+	enc.field("Exprs")
+	enc.UpdateExprs(n.Exprs)
+	enc.field("Where")
+	enc.RefOfWhere(n.Where)
+	enc.field("OrderBy")
+	enc.OrderBy(n.OrderBy)
+	enc.field("Limit")
+	enc.RefOfLimit(n.Limit)
+	enc.field("Returning")
+	enc.SelectExprs(n.Returning)
+	enc.closeObject()
+}
+
+// RefOfUpdate decodes the value from JSON.
+func (dec *jsonDecoder) RefOfUpdate(data json.RawMessage) *Update {
+	fields := dec.object(data, "Update")
+	if fields == nil {
+		return nil
+	}
+	out := &Update{}
+	out.With = dec.RefOfWith(fields["With"])
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	dec.value(fields["Ignore"], &out.Ignore)
+	out.TableExprs = dec.TableExprs(fields["TableExprs"])
+	out.Exprs = dec.UpdateExprs(fields["Exprs"])
+	out.Where = dec.RefOfWhere(fields["Where"])
+	out.OrderBy = dec.OrderBy(fields["OrderBy"])
+	out.Limit = dec.RefOfLimit(fields["Limit"])
+	out.Returning = dec.SelectExprs(fields["Returning"])
+	return out
+}
+
+// RefOfUpdateExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfUpdateExpr(n *UpdateExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("UpdateExpr")
+	enc.field("Name")
+	enc.RefOfColName(n.Name)
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.closeObject()
+}
+
+// RefOfUpdateExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfUpdateExpr(data json.RawMessage) *UpdateExpr {
+	fields := dec.object(data, "UpdateExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &UpdateExpr{}
+	out.Name = dec.RefOfColName(fields["Name"])
+	out.Expr = dec.Expr(fields["Expr"])
+	return out
+}
+
+// UpdateExprs encodes the value as JSON.
+func (enc *jsonEncoder) UpdateExprs(n UpdateExprs) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfUpdateExpr(el)
+	}
+	enc.closeArray()
+}
+
+// UpdateExprs decodes the value from JSON.
+func (dec *jsonDecoder) UpdateExprs(data json.RawMessage) UpdateExprs {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(UpdateExprs, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfUpdateExpr(el)
+	}
+	return out
+}
+
+// RefOfUpdateXMLExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfUpdateXMLExpr(n *UpdateXMLExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("UpdateXMLExpr")
+	enc.field("Target")
+	enc.Expr(n.Target)
+	enc.field("XPathExpr")
+	enc.Expr(n.XPathExpr)
+	enc.field("NewXML")
+	enc.Expr(n.NewXML)
+	enc.closeObject()
+}
+
+// RefOfUpdateXMLExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfUpdateXMLExpr(data json.RawMessage) *UpdateXMLExpr {
+	fields := dec.object(data, "UpdateXMLExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &UpdateXMLExpr{}
+	out.Target = dec.Expr(fields["Target"])
+	out.XPathExpr = dec.Expr(fields["XPathExpr"])
+	out.NewXML = dec.Expr(fields["NewXML"])
+	return out
+}
+
+// RefOfUse encodes the value as JSON.
+func (enc *jsonEncoder) RefOfUse(n *Use) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Use")
+	enc.field("DBName")
+	enc.value(n.DBName)
+	enc.closeObject()
+}
+
+// RefOfUse decodes the value from JSON.
+func (dec *jsonDecoder) RefOfUse(data json.RawMessage) *Use {
+	fields := dec.object(data, "Use")
+	if fields == nil {
+		return nil
+	}
+	out := &Use{}
+	dec.value(fields["DBName"], &out.DBName)
+	return out
+}
+
+// RefOfUserSpec encodes the value as JSON.
+func (enc *jsonEncoder) RefOfUserSpec(n *UserSpec) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("UserSpec")
+	enc.field("Account")
+	enc.RefOfAccount(n.Account)
+	enc.field("Auth")
+	enc.RefOfAuthentication(n.Auth)
+	enc.closeObject()
+}
+
+// RefOfUserSpec decodes the value from JSON.
+func (dec *jsonDecoder) RefOfUserSpec(data json.RawMessage) *UserSpec {
+	fields := dec.object(data, "UserSpec")
+	if fields == nil {
+		return nil
+	}
+	out := &UserSpec{}
+	out.Account = dec.RefOfAccount(fields["Account"])
+	out.Auth = dec.RefOfAuthentication(fields["Auth"])
+	return out
+}
+
+// RefOfVExplainStmt encodes the value as JSON.
+func (enc *jsonEncoder) RefOfVExplainStmt(n *VExplainStmt) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("VExplainStmt")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Statement")
+	enc.Statement(n.Statement)
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.closeObject()
+}
+
+// RefOfVExplainStmt decodes the value from JSON.
+func (dec *jsonDecoder) RefOfVExplainStmt(data json.RawMessage) *VExplainStmt {
+	fields := dec.object(data, "VExplainStmt")
+	if fields == nil {
+		return nil
+	}
+	out := &VExplainStmt{}
+	dec.value(fields["Type"], &out.Type)
+	out.Statement = dec.Statement(fields["Statement"])
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	return out
+}
+
+// RefOfVStream encodes the value as JSON.
+func (enc *jsonEncoder) RefOfVStream(n *VStream) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("VStream")
+	enc.field("Comments")
+	enc.RefOfParsedComments(n.Comments)
+	enc.field("SelectExpr")
+	enc.SelectExpr(n.SelectExpr)
+	enc.field("Table")
+	enc.TableName(n.Table)
+	enc.field("Where")
+	enc.RefOfWhere(n.Where)
+	enc.field("Limit")
+	enc.RefOfLimit(n.Limit)
+	enc.closeObject()
+}
+
+// RefOfVStream decodes the value from JSON.
+func (dec *jsonDecoder) RefOfVStream(data json.RawMessage) *VStream {
+	fields := dec.object(data, "VStream")
+	if fields == nil {
+		return nil
+	}
+	out := &VStream{}
+	out.Comments = dec.RefOfParsedComments(fields["Comments"])
+	out.SelectExpr = dec.SelectExpr(fields["SelectExpr"])
+	out.Table = dec.TableName(fields["Table"])
+	out.Where = dec.RefOfWhere(fields["Where"])
+	out.Limit = dec.RefOfLimit(fields["Limit"])
+	return out
+}
+
+// ValTuple encodes the value as JSON.
+func (enc *jsonEncoder) ValTuple(n ValTuple) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.Expr(el)
+	}
+	enc.closeArray()
+}
+
+// ValTuple decodes the value from JSON.
+func (dec *jsonDecoder) ValTuple(data json.RawMessage) ValTuple {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(ValTuple, len(elements))
+	for i, el := range elements {
+		out[i] = dec.Expr(el)
+	}
+	return out
+}
+
+// RefOfValidation encodes the value as JSON.
+func (enc *jsonEncoder) RefOfValidation(n *Validation) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Validation")
+	enc.field("With")
+	enc.value(n.With)
+	enc.closeObject()
+}
+
+// RefOfValidation decodes the value from JSON.
+func (dec *jsonDecoder) RefOfValidation(data json.RawMessage) *Validation {
+	fields := dec.object(data, "Validation")
+	if fields == nil {
+		return nil
+	}
+	out := &Validation{}
+	dec.value(fields["With"], &out.With)
+	return out
+}
+
+// Values encodes the value as JSON.
+func (enc *jsonEncoder) Values(n Values) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.ValTuple(el)
+	}
+	enc.closeArray()
+}
+
+// Values decodes the value from JSON.
+func (dec *jsonDecoder) Values(data json.RawMessage) Values {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(Values, len(elements))
+	for i, el := range elements {
+		out[i] = dec.ValTuple(el)
+	}
+	return out
+}
+
+// RefOfValuesFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfValuesFuncExpr(n *ValuesFuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("ValuesFuncExpr")
+	enc.field("Name")
+	enc.RefOfColName(n.Name)
+	enc.closeObject()
+}
+
+// RefOfValuesFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfValuesFuncExpr(data json.RawMessage) *ValuesFuncExpr {
+	fields := dec.object(data, "ValuesFuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &ValuesFuncExpr{}
+	out.Name = dec.RefOfColName(fields["Name"])
+	return out
+}
+
+// RefOfVarPop encodes the value as JSON.
+func (enc *jsonEncoder) RefOfVarPop(n *VarPop) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("VarPop")
+	enc.field("Arg")
+	enc.Expr(n.Arg)
+	enc.closeObject()
+}
+
+// RefOfVarPop decodes the value from JSON.
+func (dec *jsonDecoder) RefOfVarPop(data json.RawMessage) *VarPop {
+	fields := dec.object(data, "VarPop")
+	if fields == nil {
+		return nil
+	}
+	out := &VarPop{}
+	out.Arg = dec.Expr(fields["Arg"])
+	return out
+}
+
+// RefOfVarSamp encodes the value as JSON.
+func (enc *jsonEncoder) RefOfVarSamp(n *VarSamp) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("VarSamp")
+	enc.field("Arg")
+	enc.Expr(n.Arg)
+	enc.closeObject()
+}
+
+// RefOfVarSamp decodes the value from JSON.
+func (dec *jsonDecoder) RefOfVarSamp(data json.RawMessage) *VarSamp {
+	fields := dec.object(data, "VarSamp")
+	if fields == nil {
+		return nil
+	}
+	out := &VarSamp{}
+	out.Arg = dec.Expr(fields["Arg"])
+	return out
+}
+
+// RefOfVariable encodes the value as JSON.
+func (enc *jsonEncoder) RefOfVariable(n *Variable) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Variable")
+	enc.field("Scope")
+	enc.value(n.Scope)
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.closeObject()
+}
+
+// RefOfVariable decodes the value from JSON.
+func (dec *jsonDecoder) RefOfVariable(data json.RawMessage) *Variable {
+	fields := dec.object(data, "Variable")
+	if fields == nil {
+		return nil
+	}
+	out := &Variable{}
+	dec.value(fields["Scope"], &out.Scope)
+	dec.value(fields["Name"], &out.Name)
+	return out
+}
+
+// RefOfVariance encodes the value as JSON.
+func (enc *jsonEncoder) RefOfVariance(n *Variance) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Variance")
+	enc.field("Arg")
+	enc.Expr(n.Arg)
+	enc.closeObject()
+}
+
+// RefOfVariance decodes the value from JSON.
+func (dec *jsonDecoder) RefOfVariance(data json.RawMessage) *Variance {
+	fields := dec.object(data, "Variance")
+	if fields == nil {
+		return nil
+	}
+	out := &Variance{}
+	out.Arg = dec.Expr(fields["Arg"])
+	return out
+}
+
+// VindexParam encodes the value as JSON.
+func (enc *jsonEncoder) VindexParam(n VindexParam) {
+	enc.openNode("VindexParam")
+	enc.field("Key")
+	enc.value(n.Key)
+	enc.field("Val")
+	enc.value(n.Val)
+	enc.closeObject()
+}
+
+// VindexParam decodes the value from JSON.
+func (dec *jsonDecoder) VindexParam(data json.RawMessage) (out VindexParam) {
+	fields := dec.object(data, "VindexParam")
+	if fields == nil {
+		return
+	}
+	dec.value(fields["Key"], &out.Key)
+	dec.value(fields["Val"], &out.Val)
+	return
+}
+
+// RefOfVindexSpec encodes the value as JSON.
+func (enc *jsonEncoder) RefOfVindexSpec(n *VindexSpec) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("VindexSpec")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Params")
+	enc.SliceOfVindexParam(n.Params)
+	enc.closeObject()
+}
+
+// RefOfVindexSpec decodes the value from JSON.
+func (dec *jsonDecoder) RefOfVindexSpec(data json.RawMessage) *VindexSpec {
+	fields := dec.object(data, "VindexSpec")
+	if fields == nil {
+		return nil
+	}
+	out := &VindexSpec{}
+	dec.value(fields["Name"], &out.Name)
+	dec.value(fields["Type"], &out.Type)
+	out.Params = dec.SliceOfVindexParam(fields["Params"])
+	return out
+}
+
+// RefOfWeightStringFuncExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfWeightStringFuncExpr(n *WeightStringFuncExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("WeightStringFuncExpr")
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.field("As")
+	enc.RefOfConvertType(n.As)
+	enc.closeObject()
+}
+
+// RefOfWeightStringFuncExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfWeightStringFuncExpr(data json.RawMessage) *WeightStringFuncExpr {
+	fields := dec.object(data, "WeightStringFuncExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &WeightStringFuncExpr{}
+	out.Expr = dec.Expr(fields["Expr"])
+	out.As = dec.RefOfConvertType(fields["As"])
+	return out
+}
+
+// RefOfWhen encodes the value as JSON.
+func (enc *jsonEncoder) RefOfWhen(n *When) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("When")
+	enc.field("Cond")
+	enc.Expr(n.Cond)
+	enc.field("Val")
+	enc.Expr(n.Val)
+	enc.closeObject()
+}
+
+// RefOfWhen decodes the value from JSON.
+func (dec *jsonDecoder) RefOfWhen(data json.RawMessage) *When {
+	fields := dec.object(data, "When")
+	if fields == nil {
+		return nil
+	}
+	out := &When{}
+	out.Cond = dec.Expr(fields["Cond"])
+	out.Val = dec.Expr(fields["Val"])
+	return out
+}
+
+// RefOfWhere encodes the value as JSON.
+func (enc *jsonEncoder) RefOfWhere(n *Where) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("Where")
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("Expr")
+	enc.Expr(n.Expr)
+	enc.closeObject()
+}
+
+// RefOfWhere decodes the value from JSON.
+func (dec *jsonDecoder) RefOfWhere(data json.RawMessage) *Where {
+	fields := dec.object(data, "Where")
+	if fields == nil {
+		return nil
+	}
+	out := &Where{}
+	dec.value(fields["Type"], &out.Type)
+	out.Expr = dec.Expr(fields["Expr"])
+	return out
+}
+
+// RefOfWhileStatement encodes the value as JSON.
+func (enc *jsonEncoder) RefOfWhileStatement(n *WhileStatement) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("WhileStatement")
+	enc.field("Label")
+	enc.value(n.Label)
+	enc.field("Cond")
+	enc.Expr(n.Cond)
+	enc.field("Statements")
+	enc.StatementList(n.Statements)
+	enc.closeObject()
+}
+
+// RefOfWhileStatement decodes the value from JSON.
+func (dec *jsonDecoder) RefOfWhileStatement(data json.RawMessage) *WhileStatement {
+	fields := dec.object(data, "WhileStatement")
+	if fields == nil {
+		return nil
+	}
+	out := &WhileStatement{}
+	dec.value(fields["Label"], &out.Label)
+	out.Cond = dec.Expr(fields["Cond"])
+	out.Statements = dec.StatementList(fields["Statements"])
+	return out
+}
+
+// RefOfWindowDefinition encodes the value as JSON.
+func (enc *jsonEncoder) RefOfWindowDefinition(n *WindowDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("WindowDefinition")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("WindowSpec")
+	enc.RefOfWindowSpecification(n.WindowSpec)
+	enc.closeObject()
+}
+
+// RefOfWindowDefinition decodes the value from JSON.
+func (dec *jsonDecoder) RefOfWindowDefinition(data json.RawMessage) *WindowDefinition {
+	fields := dec.object(data, "WindowDefinition")
+	if fields == nil {
+		return nil
+	}
+	out := &WindowDefinition{}
+	dec.value(fields["Name"], &out.Name)
+	out.WindowSpec = dec.RefOfWindowSpecification(fields["WindowSpec"])
+	return out
+}
+
+// WindowDefinitions encodes the value as JSON.
+func (enc *jsonEncoder) WindowDefinitions(n WindowDefinitions) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfWindowDefinition(el)
+	}
+	enc.closeArray()
+}
+
+// WindowDefinitions decodes the value from JSON.
+func (dec *jsonDecoder) WindowDefinitions(data json.RawMessage) WindowDefinitions {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(WindowDefinitions, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfWindowDefinition(el)
+	}
+	return out
+}
+
+// RefOfWindowSpecification encodes the value as JSON.
+func (enc *jsonEncoder) RefOfWindowSpecification(n *WindowSpecification) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("WindowSpecification")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("PartitionClause")
+	enc.Exprs(n.PartitionClause)
+	enc.field("OrderClause")
+	enc.OrderBy(n.OrderClause)
+	enc.field("FrameClause")
+	enc.RefOfFrameClause(n.FrameClause)
+	enc.closeObject()
+}
+
+// RefOfWindowSpecification decodes the value from JSON.
+func (dec *jsonDecoder) RefOfWindowSpecification(data json.RawMessage) *WindowSpecification {
+	fields := dec.object(data, "WindowSpecification")
+	if fields == nil {
+		return nil
+	}
+	out := &WindowSpecification{}
+	dec.value(fields["Name"], &out.Name)
+	out.PartitionClause = dec.Exprs(fields["PartitionClause"])
+	out.OrderClause = dec.OrderBy(fields["OrderClause"])
+	out.FrameClause = dec.RefOfFrameClause(fields["FrameClause"])
+	return out
+}
+
+// RefOfWith encodes the value as JSON.
+func (enc *jsonEncoder) RefOfWith(n *With) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("With")
+	enc.field("ctes")
+	enc.SliceOfRefOfCommonTableExpr(n.ctes)
+	enc.field("Recursive")
+	enc.value(n.Recursive)
+	enc.closeObject()
+}
+
+// RefOfWith decodes the value from JSON.
+func (dec *jsonDecoder) RefOfWith(data json.RawMessage) *With {
+	fields := dec.object(data, "With")
+	if fields == nil {
+		return nil
+	}
+	out := &With{}
+	out.ctes = dec.SliceOfRefOfCommonTableExpr(fields["ctes"])
+	dec.value(fields["Recursive"], &out.Recursive)
+	return out
+}
+
+// RefOfXorExpr encodes the value as JSON.
+func (enc *jsonEncoder) RefOfXorExpr(n *XorExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("XorExpr")
+	enc.field("Left")
+	enc.Expr(n.Left)
+	enc.field("Right")
+	enc.Expr(n.Right)
+	enc.closeObject()
+}
+
+// RefOfXorExpr decodes the value from JSON.
+func (dec *jsonDecoder) RefOfXorExpr(data json.RawMessage) *XorExpr {
+	fields := dec.object(data, "XorExpr")
+	if fields == nil {
+		return nil
+	}
+	out := &XorExpr{}
+	out.Left = dec.Expr(fields["Left"])
+	out.Right = dec.Expr(fields["Right"])
+	return out
+}
+
+// AggrFunc encodes the value as JSON.
+func (enc *jsonEncoder) AggrFunc(n AggrFunc) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case *Avg:
+		enc.RefOfAvg(n)
+	case *BitAnd:
+		enc.RefOfBitAnd(n)
+	case *BitOr:
+		enc.RefOfBitOr(n)
+	case *BitXor:
+		enc.RefOfBitXor(n)
+	case *Count:
+		enc.RefOfCount(n)
+	case *CountStar:
+		enc.RefOfCountStar(n)
+	case *GroupConcatExpr:
+		enc.RefOfGroupConcatExpr(n)
+	case *Max:
+		enc.RefOfMax(n)
+	case *Min:
+		enc.RefOfMin(n)
+	case *Std:
+		enc.RefOfStd(n)
+	case *StdDev:
+		enc.RefOfStdDev(n)
+	case *StdPop:
+		enc.RefOfStdPop(n)
+	case *StdSamp:
+		enc.RefOfStdSamp(n)
+	case *Sum:
+		enc.RefOfSum(n)
+	case *VarPop:
+		enc.RefOfVarPop(n)
+	case *VarSamp:
+		enc.RefOfVarSamp(n)
+	case *Variance:
+		enc.RefOfVariance(n)
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// AggrFunc decodes the value from JSON.
+func (dec *jsonDecoder) AggrFunc(data json.RawMessage) AggrFunc {
+	tag, _ := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "Avg":
+		return dec.RefOfAvg(data)
+	case "BitAnd":
+		return dec.RefOfBitAnd(data)
+	case "BitOr":
+		return dec.RefOfBitOr(data)
+	case "BitXor":
+		return dec.RefOfBitXor(data)
+	case "Count":
+		return dec.RefOfCount(data)
+	case "CountStar":
+		return dec.RefOfCountStar(data)
+	case "GroupConcatExpr":
+		return dec.RefOfGroupConcatExpr(data)
+	case "Max":
+		return dec.RefOfMax(data)
+	case "Min":
+		return dec.RefOfMin(data)
+	case "Std":
+		return dec.RefOfStd(data)
+	case "StdDev":
+		return dec.RefOfStdDev(data)
+	case "StdPop":
+		return dec.RefOfStdPop(data)
+	case "StdSamp":
+		return dec.RefOfStdSamp(data)
+	case "Sum":
+		return dec.RefOfSum(data)
+	case "VarPop":
+		return dec.RefOfVarPop(data)
+	case "VarSamp":
+		return dec.RefOfVarSamp(data)
+	case "Variance":
+		return dec.RefOfVariance(data)
+	}
+	dec.unknownTag(tag, "AggrFunc")
+	return nil
+}
+
+// AlterOption encodes the value as JSON.
+func (enc *jsonEncoder) AlterOption(n AlterOption) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case *AddColumns:
+		enc.RefOfAddColumns(n)
+	case *AddConstraintDefinition:
+		enc.RefOfAddConstraintDefinition(n)
+	case *AddIndexDefinition:
+		enc.RefOfAddIndexDefinition(n)
+	case AlgorithmValue:
+		enc.openNode("AlgorithmValue")
+		enc.field("Value")
+		enc.value(n)
+		enc.closeObject()
+	case *AlterCharset:
+		enc.RefOfAlterCharset(n)
+	case *AlterCheck:
+		enc.RefOfAlterCheck(n)
+	case *AlterColumn:
+		enc.RefOfAlterColumn(n)
+	case *AlterIndex:
+		enc.RefOfAlterIndex(n)
+	case *ChangeColumn:
+		enc.RefOfChangeColumn(n)
+	case *DropColumn:
+		enc.RefOfDropColumn(n)
+	case *DropKey:
+		enc.RefOfDropKey(n)
+	case *Force:
+		enc.RefOfForce(n)
+	case *KeyState:
+		enc.RefOfKeyState(n)
+	case *LockOption:
+		enc.RefOfLockOption(n)
+	case *ModifyColumn:
+		enc.RefOfModifyColumn(n)
+	case *OrderByOption:
+		enc.RefOfOrderByOption(n)
+	case *RenameColumn:
+		enc.RefOfRenameColumn(n)
+	case *RenameIndex:
+		enc.RefOfRenameIndex(n)
+	case *RenameTableName:
+		enc.RefOfRenameTableName(n)
+	case TableOptions:
+		enc.openNode("TableOptions")
+		enc.field("Value")
+		enc.TableOptions(n)
+		enc.closeObject()
+	case *TablespaceOperation:
+		enc.RefOfTablespaceOperation(n)
+	case *Validation:
+		enc.RefOfValidation(n)
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// AlterOption decodes the value from JSON.
+func (dec *jsonDecoder) AlterOption(data json.RawMessage) AlterOption {
+	tag, fields := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "AddColumns":
+		return dec.RefOfAddColumns(data)
+	case "AddConstraintDefinition":
+		return dec.RefOfAddConstraintDefinition(data)
+	case "AddIndexDefinition":
+		return dec.RefOfAddIndexDefinition(data)
+	case "AlgorithmValue":
+		var out AlgorithmValue
+		dec.value(fields["Value"], &out)
+		return out
+	case "AlterCharset":
+		return dec.RefOfAlterCharset(data)
+	case "AlterCheck":
+		return dec.RefOfAlterCheck(data)
+	case "AlterColumn":
+		return dec.RefOfAlterColumn(data)
+	case "AlterIndex":
+		return dec.RefOfAlterIndex(data)
+	case "ChangeColumn":
+		return dec.RefOfChangeColumn(data)
+	case "DropColumn":
+		return dec.RefOfDropColumn(data)
+	case "DropKey":
+		return dec.RefOfDropKey(data)
+	case "Force":
+		return dec.RefOfForce(data)
+	case "KeyState":
+		return dec.RefOfKeyState(data)
+	case "LockOption":
+		return dec.RefOfLockOption(data)
+	case "ModifyColumn":
+		return dec.RefOfModifyColumn(data)
+	case "OrderByOption":
+		return dec.RefOfOrderByOption(data)
+	case "RenameColumn":
+		return dec.RefOfRenameColumn(data)
+	case "RenameIndex":
+		return dec.RefOfRenameIndex(data)
+	case "RenameTableName":
+		return dec.RefOfRenameTableName(data)
+	case "TableOptions":
+		var out TableOptions
+		out = dec.TableOptions(fields["Value"])
+		return out
+	case "TablespaceOperation":
+		return dec.RefOfTablespaceOperation(data)
+	case "Validation":
+		return dec.RefOfValidation(data)
+	}
+	dec.unknownTag(tag, "AlterOption")
+	return nil
+}
+
+// Callable encodes the value as JSON.
+func (enc *jsonEncoder) Callable(n Callable) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case *ArgumentLessWindowExpr:
+		enc.RefOfArgumentLessWindowExpr(n)
+	case *Avg:
+		enc.RefOfAvg(n)
+	case *CharExpr:
+		enc.RefOfCharExpr(n)
+	case *ConvertExpr:
+		enc.RefOfConvertExpr(n)
+	case *ConvertUsingExpr:
+		enc.RefOfConvertUsingExpr(n)
+	case *Count:
+		enc.RefOfCount(n)
+	case *CountStar:
+		enc.RefOfCountStar(n)
+	case *CurTimeFuncExpr:
+		enc.RefOfCurTimeFuncExpr(n)
+	case *DateAddExpr:
+		enc.RefOfDateAddExpr(n)
+	case *DateSubExpr:
+		enc.RefOfDateSubExpr(n)
+	case *ExtractFuncExpr:
+		enc.RefOfExtractFuncExpr(n)
+	case *ExtractValueExpr:
+		enc.RefOfExtractValueExpr(n)
+	case *FirstOrLastValueExpr:
+		enc.RefOfFirstOrLastValueExpr(n)
+	case *FuncExpr:
+		enc.RefOfFuncExpr(n)
+	case *GTIDFuncExpr:
+		enc.RefOfGTIDFuncExpr(n)
+	case *GeoHashFromLatLongExpr:
+		enc.RefOfGeoHashFromLatLongExpr(n)
+	case *GeoHashFromPointExpr:
+		enc.RefOfGeoHashFromPointExpr(n)
+	case *GeoJSONFromGeomExpr:
+		enc.RefOfGeoJSONFromGeomExpr(n)
+	case *GeomCollPropertyFuncExpr:
+		enc.RefOfGeomCollPropertyFuncExpr(n)
+	case *GeomFormatExpr:
+		enc.RefOfGeomFormatExpr(n)
+	case *GeomFromGeoHashExpr:
+		enc.RefOfGeomFromGeoHashExpr(n)
+	case *GeomFromGeoJSONExpr:
+		enc.RefOfGeomFromGeoJSONExpr(n)
+	case *GeomFromTextExpr:
+		enc.RefOfGeomFromTextExpr(n)
+	case *GeomFromWKBExpr:
+		enc.RefOfGeomFromWKBExpr(n)
+	case *GeomPropertyFuncExpr:
+		enc.RefOfGeomPropertyFuncExpr(n)
+	case *GroupConcatExpr:
+		enc.RefOfGroupConcatExpr(n)
+	case *InsertExpr:
+		enc.RefOfInsertExpr(n)
+	case *IntervalFuncExpr:
+		enc.RefOfIntervalFuncExpr(n)
+	case *JSONArrayExpr:
+		enc.RefOfJSONArrayExpr(n)
+	case *JSONAttributesExpr:
+		enc.RefOfJSONAttributesExpr(n)
+	case *JSONContainsExpr:
+		enc.RefOfJSONContainsExpr(n)
+	case *JSONContainsPathExpr:
+		enc.RefOfJSONContainsPathExpr(n)
+	case *JSONExtractExpr:
+		enc.RefOfJSONExtractExpr(n)
+	case *JSONKeysExpr:
+		enc.RefOfJSONKeysExpr(n)
+	case *JSONObjectExpr:
+		enc.RefOfJSONObjectExpr(n)
+	case *JSONOverlapsExpr:
+		enc.RefOfJSONOverlapsExpr(n)
+	case *JSONPrettyExpr:
+		enc.RefOfJSONPrettyExpr(n)
+	case *JSONQuoteExpr:
+		enc.RefOfJSONQuoteExpr(n)
+	case *JSONRemoveExpr:
+		enc.RefOfJSONRemoveExpr(n)
+	case *JSONSchemaValidFuncExpr:
+		enc.RefOfJSONSchemaValidFuncExpr(n)
+	case *JSONSchemaValidationReportFuncExpr:
+		enc.RefOfJSONSchemaValidationReportFuncExpr(n)
+	case *JSONSearchExpr:
+		enc.RefOfJSONSearchExpr(n)
+	case *JSONStorageFreeExpr:
+		enc.RefOfJSONStorageFreeExpr(n)
+	case *JSONStorageSizeExpr:
+		enc.RefOfJSONStorageSizeExpr(n)
+	case *JSONUnquoteExpr:
+		enc.RefOfJSONUnquoteExpr(n)
+	case *JSONValueExpr:
+		enc.RefOfJSONValueExpr(n)
+	case *JSONValueMergeExpr:
+		enc.RefOfJSONValueMergeExpr(n)
+	case *JSONValueModifierExpr:
+		enc.RefOfJSONValueModifierExpr(n)
+	case *LagLeadExpr:
+		enc.RefOfLagLeadExpr(n)
+	case *LineStringExpr:
+		enc.RefOfLineStringExpr(n)
+	case *LinestrPropertyFuncExpr:
+		enc.RefOfLinestrPropertyFuncExpr(n)
+	case *LocateExpr:
+		enc.RefOfLocateExpr(n)
+	case *MatchExpr:
+		enc.RefOfMatchExpr(n)
+	case *Max:
+		enc.RefOfMax(n)
+	case *MemberOfExpr:
+		enc.RefOfMemberOfExpr(n)
+	case *Min:
+		enc.RefOfMin(n)
+	case *MultiLinestringExpr:
+		enc.RefOfMultiLinestringExpr(n)
+	case *MultiPointExpr:
+		enc.RefOfMultiPointExpr(n)
+	case *MultiPolygonExpr:
+		enc.RefOfMultiPolygonExpr(n)
+	case *NTHValueExpr:
+		enc.RefOfNTHValueExpr(n)
+	case *NamedWindow:
+		enc.RefOfNamedWindow(n)
+	case *NtileExpr:
+		enc.RefOfNtileExpr(n)
+	case *PerformanceSchemaFuncExpr:
+		enc.RefOfPerformanceSchemaFuncExpr(n)
+	case *PointExpr:
+		enc.RefOfPointExpr(n)
+	case *PointPropertyFuncExpr:
+		enc.RefOfPointPropertyFuncExpr(n)
+	case *PolygonExpr:
+		enc.RefOfPolygonExpr(n)
+	case *PolygonPropertyFuncExpr:
+		enc.RefOfPolygonPropertyFuncExpr(n)
+	case *RegexpInstrExpr:
+		enc.RefOfRegexpInstrExpr(n)
+	case *RegexpLikeExpr:
+		enc.RefOfRegexpLikeExpr(n)
+	case *RegexpReplaceExpr:
+		enc.RefOfRegexpReplaceExpr(n)
+	case *RegexpSubstrExpr:
+		enc.RefOfRegexpSubstrExpr(n)
+	case *SubstrExpr:
+		enc.RefOfSubstrExpr(n)
+	case *Sum:
+		enc.RefOfSum(n)
+	case *TimestampFuncExpr:
+		enc.RefOfTimestampFuncExpr(n)
+	case *TrimFuncExpr:
+		enc.RefOfTrimFuncExpr(n)
+	case *UpdateXMLExpr:
+		enc.RefOfUpdateXMLExpr(n)
+	case *ValuesFuncExpr:
+		enc.RefOfValuesFuncExpr(n)
+	case *WeightStringFuncExpr:
+		enc.RefOfWeightStringFuncExpr(n)
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// Callable decodes the value from JSON.
+func (dec *jsonDecoder) Callable(data json.RawMessage) Callable {
+	tag, _ := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "ArgumentLessWindowExpr":
+		return dec.RefOfArgumentLessWindowExpr(data)
+	case "Avg":
+		return dec.RefOfAvg(data)
+	case "CharExpr":
+		return dec.RefOfCharExpr(data)
+	case "ConvertExpr":
+		return dec.RefOfConvertExpr(data)
+	case "ConvertUsingExpr":
+		return dec.RefOfConvertUsingExpr(data)
+	case "Count":
+		return dec.RefOfCount(data)
+	case "CountStar":
+		return dec.RefOfCountStar(data)
+	case "CurTimeFuncExpr":
+		return dec.RefOfCurTimeFuncExpr(data)
+	case "DateAddExpr":
+		return dec.RefOfDateAddExpr(data)
+	case "DateSubExpr":
+		return dec.RefOfDateSubExpr(data)
+	case "ExtractFuncExpr":
+		return dec.RefOfExtractFuncExpr(data)
+	case "ExtractValueExpr":
+		return dec.RefOfExtractValueExpr(data)
+	case "FirstOrLastValueExpr":
+		return dec.RefOfFirstOrLastValueExpr(data)
+	case "FuncExpr":
+		return dec.RefOfFuncExpr(data)
+	case "GTIDFuncExpr":
+		return dec.RefOfGTIDFuncExpr(data)
+	case "GeoHashFromLatLongExpr":
+		return dec.RefOfGeoHashFromLatLongExpr(data)
+	case "GeoHashFromPointExpr":
+		return dec.RefOfGeoHashFromPointExpr(data)
+	case "GeoJSONFromGeomExpr":
+		return dec.RefOfGeoJSONFromGeomExpr(data)
+	case "GeomCollPropertyFuncExpr":
+		return dec.RefOfGeomCollPropertyFuncExpr(data)
+	case "GeomFormatExpr":
+		return dec.RefOfGeomFormatExpr(data)
+	case "GeomFromGeoHashExpr":
+		return dec.RefOfGeomFromGeoHashExpr(data)
+	case "GeomFromGeoJSONExpr":
+		return dec.RefOfGeomFromGeoJSONExpr(data)
+	case "GeomFromTextExpr":
+		return dec.RefOfGeomFromTextExpr(data)
+	case "GeomFromWKBExpr":
+		return dec.RefOfGeomFromWKBExpr(data)
+	case "GeomPropertyFuncExpr":
+		return dec.RefOfGeomPropertyFuncExpr(data)
+	case "GroupConcatExpr":
+		return dec.RefOfGroupConcatExpr(data)
+	case "InsertExpr":
+		return dec.RefOfInsertExpr(data)
+	case "IntervalFuncExpr":
+		return dec.RefOfIntervalFuncExpr(data)
+	case "JSONArrayExpr":
+		return dec.RefOfJSONArrayExpr(data)
+	case "JSONAttributesExpr":
+		return dec.RefOfJSONAttributesExpr(data)
+	case "JSONContainsExpr":
+		return dec.RefOfJSONContainsExpr(data)
+	case "JSONContainsPathExpr":
+		return dec.RefOfJSONContainsPathExpr(data)
+	case "JSONExtractExpr":
+		return dec.RefOfJSONExtractExpr(data)
+	case "JSONKeysExpr":
+		return dec.RefOfJSONKeysExpr(data)
+	case "JSONObjectExpr":
+		return dec.RefOfJSONObjectExpr(data)
+	case "JSONOverlapsExpr":
+		return dec.RefOfJSONOverlapsExpr(data)
+	case "JSONPrettyExpr":
+		return dec.RefOfJSONPrettyExpr(data)
+	case "JSONQuoteExpr":
+		return dec.RefOfJSONQuoteExpr(data)
+	case "JSONRemoveExpr":
+		return dec.RefOfJSONRemoveExpr(data)
+	case "JSONSchemaValidFuncExpr":
+		return dec.RefOfJSONSchemaValidFuncExpr(data)
+	case "JSONSchemaValidationReportFuncExpr":
+		return dec.RefOfJSONSchemaValidationReportFuncExpr(data)
+	case "JSONSearchExpr":
+		return dec.RefOfJSONSearchExpr(data)
+	case "JSONStorageFreeExpr":
+		return dec.RefOfJSONStorageFreeExpr(data)
+	case "JSONStorageSizeExpr":
+		return dec.RefOfJSONStorageSizeExpr(data)
+	case "JSONUnquoteExpr":
+		return dec.RefOfJSONUnquoteExpr(data)
+	case "JSONValueExpr":
+		return dec.RefOfJSONValueExpr(data)
+	case "JSONValueMergeExpr":
+		return dec.RefOfJSONValueMergeExpr(data)
+	case "JSONValueModifierExpr":
+		return dec.RefOfJSONValueModifierExpr(data)
+	case "LagLeadExpr":
+		return dec.RefOfLagLeadExpr(data)
+	case "LineStringExpr":
+		return dec.RefOfLineStringExpr(data)
+	case "LinestrPropertyFuncExpr":
+		return dec.RefOfLinestrPropertyFuncExpr(data)
+	case "LocateExpr":
+		return dec.RefOfLocateExpr(data)
+	case "MatchExpr":
+		return dec.RefOfMatchExpr(data)
+	case "Max":
+		return dec.RefOfMax(data)
+	case "MemberOfExpr":
+		return dec.RefOfMemberOfExpr(data)
+	case "Min":
+		return dec.RefOfMin(data)
+	case "MultiLinestringExpr":
+		return dec.RefOfMultiLinestringExpr(data)
+	case "MultiPointExpr":
+		return dec.RefOfMultiPointExpr(data)
+	case "MultiPolygonExpr":
+		return dec.RefOfMultiPolygonExpr(data)
+	case "NTHValueExpr":
+		return dec.RefOfNTHValueExpr(data)
+	case "NamedWindow":
+		return dec.RefOfNamedWindow(data)
+	case "NtileExpr":
+		return dec.RefOfNtileExpr(data)
+	case "PerformanceSchemaFuncExpr":
+		return dec.RefOfPerformanceSchemaFuncExpr(data)
+	case "PointExpr":
+		return dec.RefOfPointExpr(data)
+	case "PointPropertyFuncExpr":
+		return dec.RefOfPointPropertyFuncExpr(data)
+	case "PolygonExpr":
+		return dec.RefOfPolygonExpr(data)
+	case "PolygonPropertyFuncExpr":
+		return dec.RefOfPolygonPropertyFuncExpr(data)
+	case "RegexpInstrExpr":
+		return dec.RefOfRegexpInstrExpr(data)
+	case "RegexpLikeExpr":
+		return dec.RefOfRegexpLikeExpr(data)
+	case "RegexpReplaceExpr":
+		return dec.RefOfRegexpReplaceExpr(data)
+	case "RegexpSubstrExpr":
+		return dec.RefOfRegexpSubstrExpr(data)
+	case "SubstrExpr":
+		return dec.RefOfSubstrExpr(data)
+	case "Sum":
+		return dec.RefOfSum(data)
+	case "TimestampFuncExpr":
+		return dec.RefOfTimestampFuncExpr(data)
+	case "TrimFuncExpr":
+		return dec.RefOfTrimFuncExpr(data)
+	case "UpdateXMLExpr":
+		return dec.RefOfUpdateXMLExpr(data)
+	case "ValuesFuncExpr":
+		return dec.RefOfValuesFuncExpr(data)
+	case "WeightStringFuncExpr":
+		return dec.RefOfWeightStringFuncExpr(data)
+	}
+	dec.unknownTag(tag, "Callable")
+	return nil
+}
+
+// ColTuple encodes the value as JSON.
+func (enc *jsonEncoder) ColTuple(n ColTuple) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case ListArg:
+		enc.openNode("ListArg")
+		enc.field("Value")
+		enc.value(n)
+		enc.closeObject()
+	case *Subquery:
+		enc.RefOfSubquery(n)
+	case ValTuple:
+		enc.openNode("ValTuple")
+		enc.field("Value")
+		enc.ValTuple(n)
+		enc.closeObject()
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// ColTuple decodes the value from JSON.
+func (dec *jsonDecoder) ColTuple(data json.RawMessage) ColTuple {
+	tag, fields := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "ListArg":
+		var out ListArg
+		dec.value(fields["Value"], &out)
+		return out
+	case "Subquery":
+		return dec.RefOfSubquery(data)
+	case "ValTuple":
+		var out ValTuple
+		out = dec.ValTuple(fields["Value"])
+		return out
+	}
+	dec.unknownTag(tag, "ColTuple")
+	return nil
+}
+
+// ConstraintInfo encodes the value as JSON.
+func (enc *jsonEncoder) ConstraintInfo(n ConstraintInfo) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case *CheckConstraintDefinition:
+		enc.RefOfCheckConstraintDefinition(n)
+	case *ForeignKeyDefinition:
+		enc.RefOfForeignKeyDefinition(n)
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// ConstraintInfo decodes the value from JSON.
+func (dec *jsonDecoder) ConstraintInfo(data json.RawMessage) ConstraintInfo {
+	tag, _ := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "CheckConstraintDefinition":
+		return dec.RefOfCheckConstraintDefinition(data)
+	case "ForeignKeyDefinition":
+		return dec.RefOfForeignKeyDefinition(data)
+	}
+	dec.unknownTag(tag, "ConstraintInfo")
+	return nil
+}
+
+// DBDDLStatement encodes the value as JSON.
+func (enc *jsonEncoder) DBDDLStatement(n DBDDLStatement) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case *AlterDatabase:
+		enc.RefOfAlterDatabase(n)
+	case *CreateDatabase:
+		enc.RefOfCreateDatabase(n)
+	case *DropDatabase:
+		enc.RefOfDropDatabase(n)
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// DBDDLStatement decodes the value from JSON.
+func (dec *jsonDecoder) DBDDLStatement(data json.RawMessage) DBDDLStatement {
+	tag, _ := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "AlterDatabase":
+		return dec.RefOfAlterDatabase(data)
+	case "CreateDatabase":
+		return dec.RefOfCreateDatabase(data)
+	case "DropDatabase":
+		return dec.RefOfDropDatabase(data)
+	}
+	dec.unknownTag(tag, "DBDDLStatement")
+	return nil
+}
+
+// DDLStatement encodes the value as JSON.
+func (enc *jsonEncoder) DDLStatement(n DDLStatement) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case *AlterEvent:
+		enc.RefOfAlterEvent(n)
+	case *AlterFunction:
+		enc.RefOfAlterFunction(n)
+	case *AlterProcedure:
+		enc.RefOfAlterProcedure(n)
+	case *AlterTable:
+		enc.RefOfAlterTable(n)
+	case *AlterView:
+		enc.RefOfAlterView(n)
+	case *CreateEvent:
+		enc.RefOfCreateEvent(n)
+	case *CreateFunction:
+		enc.RefOfCreateFunction(n)
+	case *CreateProcedure:
+		enc.RefOfCreateProcedure(n)
+	case *CreateTable:
+		enc.RefOfCreateTable(n)
+	case *CreateTrigger:
+		enc.RefOfCreateTrigger(n)
+	case *CreateView:
+		enc.RefOfCreateView(n)
+	case *DropEvent:
+		enc.RefOfDropEvent(n)
+	case *DropFunction:
+		enc.RefOfDropFunction(n)
+	case *DropProcedure:
+		enc.RefOfDropProcedure(n)
+	case *DropTable:
+		enc.RefOfDropTable(n)
+	case *DropTrigger:
+		enc.RefOfDropTrigger(n)
+	case *DropView:
+		enc.RefOfDropView(n)
+	case *RenameTable:
+		enc.RefOfRenameTable(n)
+	case *TruncateTable:
+		enc.RefOfTruncateTable(n)
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// DDLStatement decodes the value from JSON.
+func (dec *jsonDecoder) DDLStatement(data json.RawMessage) DDLStatement {
+	tag, _ := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "AlterEvent":
+		return dec.RefOfAlterEvent(data)
+	case "AlterFunction":
+		return dec.RefOfAlterFunction(data)
+	case "AlterProcedure":
+		return dec.RefOfAlterProcedure(data)
+	case "AlterTable":
+		return dec.RefOfAlterTable(data)
+	case "AlterView":
+		return dec.RefOfAlterView(data)
+	case "CreateEvent":
+		return dec.RefOfCreateEvent(data)
+	case "CreateFunction":
+		return dec.RefOfCreateFunction(data)
+	case "CreateProcedure":
+		return dec.RefOfCreateProcedure(data)
+	case "CreateTable":
+		return dec.RefOfCreateTable(data)
+	case "CreateTrigger":
+		return dec.RefOfCreateTrigger(data)
+	case "CreateView":
+		return dec.RefOfCreateView(data)
+	case "DropEvent":
+		return dec.RefOfDropEvent(data)
+	case "DropFunction":
+		return dec.RefOfDropFunction(data)
+	case "DropProcedure":
+		return dec.RefOfDropProcedure(data)
+	case "DropTable":
+		return dec.RefOfDropTable(data)
+	case "DropTrigger":
+		return dec.RefOfDropTrigger(data)
+	case "DropView":
+		return dec.RefOfDropView(data)
+	case "RenameTable":
+		return dec.RefOfRenameTable(data)
+	case "TruncateTable":
+		return dec.RefOfTruncateTable(data)
+	}
+	dec.unknownTag(tag, "DDLStatement")
+	return nil
+}
+
+// Explain encodes the value as JSON.
+func (enc *jsonEncoder) Explain(n Explain) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case *ExplainStmt:
+		enc.RefOfExplainStmt(n)
+	case *ExplainTab:
+		enc.RefOfExplainTab(n)
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// Explain decodes the value from JSON.
+func (dec *jsonDecoder) Explain(data json.RawMessage) Explain {
+	tag, _ := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "ExplainStmt":
+		return dec.RefOfExplainStmt(data)
+	case "ExplainTab":
+		return dec.RefOfExplainTab(data)
+	}
+	dec.unknownTag(tag, "Explain")
+	return nil
+}
+
+// Expr encodes the value as JSON.
+func (enc *jsonEncoder) Expr(n Expr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case *AndExpr:
+		enc.RefOfAndExpr(n)
+	case *Argument:
+		enc.RefOfArgument(n)
+	case *ArgumentLessWindowExpr:
+		enc.RefOfArgumentLessWindowExpr(n)
+	case *AssignmentExpr:
+		enc.RefOfAssignmentExpr(n)
+	case *Avg:
+		enc.RefOfAvg(n)
+	case *BetweenExpr:
+		enc.RefOfBetweenExpr(n)
+	case *BinaryExpr:
+		enc.RefOfBinaryExpr(n)
+	case *BitAnd:
+		enc.RefOfBitAnd(n)
+	case *BitOr:
+		enc.RefOfBitOr(n)
+	case *BitXor:
+		enc.RefOfBitXor(n)
+	case BoolVal:
+		enc.openNode("BoolVal")
+		enc.field("Value")
+		enc.value(n)
+		enc.closeObject()
+	case *CaseExpr:
+		enc.RefOfCaseExpr(n)
+	case *CastExpr:
+		enc.RefOfCastExpr(n)
+	case *CharExpr:
+		enc.RefOfCharExpr(n)
+	case *ColName:
+		enc.RefOfColName(n)
+	case *CollateExpr:
+		enc.RefOfCollateExpr(n)
+	case *ComparisonExpr:
+		enc.RefOfComparisonExpr(n)
+	case *ConvertExpr:
+		enc.RefOfConvertExpr(n)
+	case *ConvertUsingExpr:
+		enc.RefOfConvertUsingExpr(n)
+	case *Count:
+		enc.RefOfCount(n)
+	case *CountStar:
+		enc.RefOfCountStar(n)
+	case *CurTimeFuncExpr:
+		enc.RefOfCurTimeFuncExpr(n)
+	case *DateAddExpr:
+		enc.RefOfDateAddExpr(n)
+	case *DateSubExpr:
+		enc.RefOfDateSubExpr(n)
+	case *Default:
+		enc.RefOfDefault(n)
+	case *ExistsExpr:
+		enc.RefOfExistsExpr(n)
+	case *ExtractFuncExpr:
+		enc.RefOfExtractFuncExpr(n)
+	case *ExtractValueExpr:
+		enc.RefOfExtractValueExpr(n)
+	case *ExtractedSubquery:
+		enc.RefOfExtractedSubquery(n)
+	case *FirstOrLastValueExpr:
+		enc.RefOfFirstOrLastValueExpr(n)
+	case *FuncExpr:
+		enc.RefOfFuncExpr(n)
+	case *GTIDFuncExpr:
+		enc.RefOfGTIDFuncExpr(n)
+	case *GeoHashFromLatLongExpr:
+		enc.RefOfGeoHashFromLatLongExpr(n)
+	case *GeoHashFromPointExpr:
+		enc.RefOfGeoHashFromPointExpr(n)
+	case *GeoJSONFromGeomExpr:
+		enc.RefOfGeoJSONFromGeomExpr(n)
+	case *GeomCollPropertyFuncExpr:
+		enc.RefOfGeomCollPropertyFuncExpr(n)
+	case *GeomFormatExpr:
+		enc.RefOfGeomFormatExpr(n)
+	case *GeomFromGeoHashExpr:
+		enc.RefOfGeomFromGeoHashExpr(n)
+	case *GeomFromGeoJSONExpr:
+		enc.RefOfGeomFromGeoJSONExpr(n)
+	case *GeomFromTextExpr:
+		enc.RefOfGeomFromTextExpr(n)
+	case *GeomFromWKBExpr:
+		enc.RefOfGeomFromWKBExpr(n)
+	case *GeomPropertyFuncExpr:
+		enc.RefOfGeomPropertyFuncExpr(n)
+	case *GroupConcatExpr:
+		enc.RefOfGroupConcatExpr(n)
+	case *InsertExpr:
+		enc.RefOfInsertExpr(n)
+	case *IntervalFuncExpr:
+		enc.RefOfIntervalFuncExpr(n)
+	case *IntroducerExpr:
+		enc.RefOfIntroducerExpr(n)
+	case *IsExpr:
+		enc.RefOfIsExpr(n)
+	case *JSONArrayExpr:
+		enc.RefOfJSONArrayExpr(n)
+	case *JSONAttributesExpr:
+		enc.RefOfJSONAttributesExpr(n)
+	case *JSONContainsExpr:
+		enc.RefOfJSONContainsExpr(n)
+	case *JSONContainsPathExpr:
+		enc.RefOfJSONContainsPathExpr(n)
+	case *JSONExtractExpr:
+		enc.RefOfJSONExtractExpr(n)
+	case *JSONKeysExpr:
+		enc.RefOfJSONKeysExpr(n)
+	case *JSONObjectExpr:
+		enc.RefOfJSONObjectExpr(n)
+	case *JSONOverlapsExpr:
+		enc.RefOfJSONOverlapsExpr(n)
+	case *JSONPrettyExpr:
+		enc.RefOfJSONPrettyExpr(n)
+	case *JSONQuoteExpr:
+		enc.RefOfJSONQuoteExpr(n)
+	case *JSONRemoveExpr:
+		enc.RefOfJSONRemoveExpr(n)
+	case *JSONSchemaValidFuncExpr:
+		enc.RefOfJSONSchemaValidFuncExpr(n)
+	case *JSONSchemaValidationReportFuncExpr:
+		enc.RefOfJSONSchemaValidationReportFuncExpr(n)
+	case *JSONSearchExpr:
+		enc.RefOfJSONSearchExpr(n)
+	case *JSONStorageFreeExpr:
+		enc.RefOfJSONStorageFreeExpr(n)
+	case *JSONStorageSizeExpr:
+		enc.RefOfJSONStorageSizeExpr(n)
+	case *JSONUnquoteExpr:
+		enc.RefOfJSONUnquoteExpr(n)
+	case *JSONValueExpr:
+		enc.RefOfJSONValueExpr(n)
+	case *JSONValueMergeExpr:
+		enc.RefOfJSONValueMergeExpr(n)
+	case *JSONValueModifierExpr:
+		enc.RefOfJSONValueModifierExpr(n)
+	case *LagLeadExpr:
+		enc.RefOfLagLeadExpr(n)
+	case *LineStringExpr:
+		enc.RefOfLineStringExpr(n)
+	case *LinestrPropertyFuncExpr:
+		enc.RefOfLinestrPropertyFuncExpr(n)
+	case ListArg:
+		enc.openNode("ListArg")
+		enc.field("Value")
+		enc.value(n)
+		enc.closeObject()
+	case *Literal:
+		enc.RefOfLiteral(n)
+	case *LocateExpr:
+		enc.RefOfLocateExpr(n)
+	case *LockingFunc:
+		enc.RefOfLockingFunc(n)
+	case *MatchExpr:
+		enc.RefOfMatchExpr(n)
+	case *Max:
+		enc.RefOfMax(n)
+	case *MemberOfExpr:
+		enc.RefOfMemberOfExpr(n)
+	case *Min:
+		enc.RefOfMin(n)
+	case *MultiLinestringExpr:
+		enc.RefOfMultiLinestringExpr(n)
+	case *MultiPointExpr:
+		enc.RefOfMultiPointExpr(n)
+	case *MultiPolygonExpr:
+		enc.RefOfMultiPolygonExpr(n)
+	case *NTHValueExpr:
+		enc.RefOfNTHValueExpr(n)
+	case *NamedWindow:
+		enc.RefOfNamedWindow(n)
+	case *NotExpr:
+		enc.RefOfNotExpr(n)
+	case *NtileExpr:
+		enc.RefOfNtileExpr(n)
+	case *NullVal:
+		enc.RefOfNullVal(n)
+	case *Offset:
+		enc.RefOfOffset(n)
+	case *OrExpr:
+		enc.RefOfOrExpr(n)
+	case *PerformanceSchemaFuncExpr:
+		enc.RefOfPerformanceSchemaFuncExpr(n)
+	case *PointExpr:
+		enc.RefOfPointExpr(n)
+	case *PointPropertyFuncExpr:
+		enc.RefOfPointPropertyFuncExpr(n)
+	case *PolygonExpr:
+		enc.RefOfPolygonExpr(n)
+	case *PolygonPropertyFuncExpr:
+		enc.RefOfPolygonPropertyFuncExpr(n)
+	case *PositionalArg:
+		enc.RefOfPositionalArg(n)
+	case *RegexpInstrExpr:
+		enc.RefOfRegexpInstrExpr(n)
+	case *RegexpLikeExpr:
+		enc.RefOfRegexpLikeExpr(n)
+	case *RegexpReplaceExpr:
+		enc.RefOfRegexpReplaceExpr(n)
+	case *RegexpSubstrExpr:
+		enc.RefOfRegexpSubstrExpr(n)
+	case *Std:
+		enc.RefOfStd(n)
+	case *StdDev:
+		enc.RefOfStdDev(n)
+	case *StdPop:
+		enc.RefOfStdPop(n)
+	case *StdSamp:
+		enc.RefOfStdSamp(n)
+	case *Subquery:
+		enc.RefOfSubquery(n)
+	case *SubstrExpr:
+		enc.RefOfSubstrExpr(n)
+	case *Sum:
+		enc.RefOfSum(n)
+	case *TimestampFuncExpr:
+		enc.RefOfTimestampFuncExpr(n)
+	case *TrimFuncExpr:
+		enc.RefOfTrimFuncExpr(n)
+	case *TypeCastExpr:
+		enc.RefOfTypeCastExpr(n)
+	case *UnaryExpr:
+		enc.RefOfUnaryExpr(n)
+	case *UpdateXMLExpr:
+		enc.RefOfUpdateXMLExpr(n)
+	case ValTuple:
+		enc.openNode("ValTuple")
+		enc.field("Value")
+		enc.ValTuple(n)
+		enc.closeObject()
+	case *ValuesFuncExpr:
+		enc.RefOfValuesFuncExpr(n)
+	case *VarPop:
+		enc.RefOfVarPop(n)
+	case *VarSamp:
+		enc.RefOfVarSamp(n)
+	case *Variable:
+		enc.RefOfVariable(n)
+	case *Variance:
+		enc.RefOfVariance(n)
+	case *WeightStringFuncExpr:
+		enc.RefOfWeightStringFuncExpr(n)
+	case *XorExpr:
+		enc.RefOfXorExpr(n)
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// Expr decodes the value from JSON.
+func (dec *jsonDecoder) Expr(data json.RawMessage) Expr {
+	tag, fields := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "AndExpr":
+		return dec.RefOfAndExpr(data)
+	case "Argument":
+		return dec.RefOfArgument(data)
+	case "ArgumentLessWindowExpr":
+		return dec.RefOfArgumentLessWindowExpr(data)
+	case "AssignmentExpr":
+		return dec.RefOfAssignmentExpr(data)
+	case "Avg":
+		return dec.RefOfAvg(data)
+	case "BetweenExpr":
+		return dec.RefOfBetweenExpr(data)
+	case "BinaryExpr":
+		return dec.RefOfBinaryExpr(data)
+	case "BitAnd":
+		return dec.RefOfBitAnd(data)
+	case "BitOr":
+		return dec.RefOfBitOr(data)
+	case "BitXor":
+		return dec.RefOfBitXor(data)
+	case "BoolVal":
+		var out BoolVal
+		dec.value(fields["Value"], &out)
+		return out
+	case "CaseExpr":
+		return dec.RefOfCaseExpr(data)
+	case "CastExpr":
+		return dec.RefOfCastExpr(data)
+	case "CharExpr":
+		return dec.RefOfCharExpr(data)
+	case "ColName":
+		return dec.RefOfColName(data)
+	case "CollateExpr":
+		return dec.RefOfCollateExpr(data)
+	case "ComparisonExpr":
+		return dec.RefOfComparisonExpr(data)
+	case "ConvertExpr":
+		return dec.RefOfConvertExpr(data)
+	case "ConvertUsingExpr":
+		return dec.RefOfConvertUsingExpr(data)
+	case "Count":
+		return dec.RefOfCount(data)
+	case "CountStar":
+		return dec.RefOfCountStar(data)
+	case "CurTimeFuncExpr":
+		return dec.RefOfCurTimeFuncExpr(data)
+	case "DateAddExpr":
+		return dec.RefOfDateAddExpr(data)
+	case "DateSubExpr":
+		return dec.RefOfDateSubExpr(data)
+	case "Default":
+		return dec.RefOfDefault(data)
+	case "ExistsExpr":
+		return dec.RefOfExistsExpr(data)
+	case "ExtractFuncExpr":
+		return dec.RefOfExtractFuncExpr(data)
+	case "ExtractValueExpr":
+		return dec.RefOfExtractValueExpr(data)
+	case "ExtractedSubquery":
+		return dec.RefOfExtractedSubquery(data)
+	case "FirstOrLastValueExpr":
+		return dec.RefOfFirstOrLastValueExpr(data)
+	case "FuncExpr":
+		return dec.RefOfFuncExpr(data)
+	case "GTIDFuncExpr":
+		return dec.RefOfGTIDFuncExpr(data)
+	case "GeoHashFromLatLongExpr":
+		return dec.RefOfGeoHashFromLatLongExpr(data)
+	case "GeoHashFromPointExpr":
+		return dec.RefOfGeoHashFromPointExpr(data)
+	case "GeoJSONFromGeomExpr":
+		return dec.RefOfGeoJSONFromGeomExpr(data)
+	case "GeomCollPropertyFuncExpr":
+		return dec.RefOfGeomCollPropertyFuncExpr(data)
+	case "GeomFormatExpr":
+		return dec.RefOfGeomFormatExpr(data)
+	case "GeomFromGeoHashExpr":
+		return dec.RefOfGeomFromGeoHashExpr(data)
+	case "GeomFromGeoJSONExpr":
+		return dec.RefOfGeomFromGeoJSONExpr(data)
+	case "GeomFromTextExpr":
+		return dec.RefOfGeomFromTextExpr(data)
+	case "GeomFromWKBExpr":
+		return dec.RefOfGeomFromWKBExpr(data)
+	case "GeomPropertyFuncExpr":
+		return dec.RefOfGeomPropertyFuncExpr(data)
+	case "GroupConcatExpr":
+		return dec.RefOfGroupConcatExpr(data)
+	case "InsertExpr":
+		return dec.RefOfInsertExpr(data)
+	case "IntervalFuncExpr":
+		return dec.RefOfIntervalFuncExpr(data)
+	case "IntroducerExpr":
+		return dec.RefOfIntroducerExpr(data)
+	case "IsExpr":
+		return dec.RefOfIsExpr(data)
+	case "JSONArrayExpr":
+		return dec.RefOfJSONArrayExpr(data)
+	case "JSONAttributesExpr":
+		return dec.RefOfJSONAttributesExpr(data)
+	case "JSONContainsExpr":
+		return dec.RefOfJSONContainsExpr(data)
+	case "JSONContainsPathExpr":
+		return dec.RefOfJSONContainsPathExpr(data)
+	case "JSONExtractExpr":
+		return dec.RefOfJSONExtractExpr(data)
+	case "JSONKeysExpr":
+		return dec.RefOfJSONKeysExpr(data)
+	case "JSONObjectExpr":
+		return dec.RefOfJSONObjectExpr(data)
+	case "JSONOverlapsExpr":
+		return dec.RefOfJSONOverlapsExpr(data)
+	case "JSONPrettyExpr":
+		return dec.RefOfJSONPrettyExpr(data)
+	case "JSONQuoteExpr":
+		return dec.RefOfJSONQuoteExpr(data)
+	case "JSONRemoveExpr":
+		return dec.RefOfJSONRemoveExpr(data)
+	case "JSONSchemaValidFuncExpr":
+		return dec.RefOfJSONSchemaValidFuncExpr(data)
+	case "JSONSchemaValidationReportFuncExpr":
+		return dec.RefOfJSONSchemaValidationReportFuncExpr(data)
+	case "JSONSearchExpr":
+		return dec.RefOfJSONSearchExpr(data)
+	case "JSONStorageFreeExpr":
+		return dec.RefOfJSONStorageFreeExpr(data)
+	case "JSONStorageSizeExpr":
+		return dec.RefOfJSONStorageSizeExpr(data)
+	case "JSONUnquoteExpr":
+		return dec.RefOfJSONUnquoteExpr(data)
+	case "JSONValueExpr":
+		return dec.RefOfJSONValueExpr(data)
+	case "JSONValueMergeExpr":
+		return dec.RefOfJSONValueMergeExpr(data)
+	case "JSONValueModifierExpr":
+		return dec.RefOfJSONValueModifierExpr(data)
+	case "LagLeadExpr":
+		return dec.RefOfLagLeadExpr(data)
+	case "LineStringExpr":
+		return dec.RefOfLineStringExpr(data)
+	case "LinestrPropertyFuncExpr":
+		return dec.RefOfLinestrPropertyFuncExpr(data)
+	case "ListArg":
+		var out ListArg
+		dec.value(fields["Value"], &out)
+		return out
+	case "Literal":
+		return dec.RefOfLiteral(data)
+	case "LocateExpr":
+		return dec.RefOfLocateExpr(data)
+	case "LockingFunc":
+		return dec.RefOfLockingFunc(data)
+	case "MatchExpr":
+		return dec.RefOfMatchExpr(data)
+	case "Max":
+		return dec.RefOfMax(data)
+	case "MemberOfExpr":
+		return dec.RefOfMemberOfExpr(data)
+	case "Min":
+		return dec.RefOfMin(data)
+	case "MultiLinestringExpr":
+		return dec.RefOfMultiLinestringExpr(data)
+	case "MultiPointExpr":
+		return dec.RefOfMultiPointExpr(data)
+	case "MultiPolygonExpr":
+		return dec.RefOfMultiPolygonExpr(data)
+	case "NTHValueExpr":
+		return dec.RefOfNTHValueExpr(data)
+	case "NamedWindow":
+		return dec.RefOfNamedWindow(data)
+	case "NotExpr":
+		return dec.RefOfNotExpr(data)
+	case "NtileExpr":
+		return dec.RefOfNtileExpr(data)
+	case "NullVal":
+		return dec.RefOfNullVal(data)
+	case "Offset":
+		return dec.RefOfOffset(data)
+	case "OrExpr":
+		return dec.RefOfOrExpr(data)
+	case "PerformanceSchemaFuncExpr":
+		return dec.RefOfPerformanceSchemaFuncExpr(data)
+	case "PointExpr":
+		return dec.RefOfPointExpr(data)
+	case "PointPropertyFuncExpr":
+		return dec.RefOfPointPropertyFuncExpr(data)
+	case "PolygonExpr":
+		return dec.RefOfPolygonExpr(data)
+	case "PolygonPropertyFuncExpr":
+		return dec.RefOfPolygonPropertyFuncExpr(data)
+	case "PositionalArg":
+		return dec.RefOfPositionalArg(data)
+	case "RegexpInstrExpr":
+		return dec.RefOfRegexpInstrExpr(data)
+	case "RegexpLikeExpr":
+		return dec.RefOfRegexpLikeExpr(data)
+	case "RegexpReplaceExpr":
+		return dec.RefOfRegexpReplaceExpr(data)
+	case "RegexpSubstrExpr":
+		return dec.RefOfRegexpSubstrExpr(data)
+	case "Std":
+		return dec.RefOfStd(data)
+	case "StdDev":
+		return dec.RefOfStdDev(data)
+	case "StdPop":
+		return dec.RefOfStdPop(data)
+	case "StdSamp":
+		return dec.RefOfStdSamp(data)
+	case "Subquery":
+		return dec.RefOfSubquery(data)
+	case "SubstrExpr":
+		return dec.RefOfSubstrExpr(data)
+	case "Sum":
+		return dec.RefOfSum(data)
+	case "TimestampFuncExpr":
+		return dec.RefOfTimestampFuncExpr(data)
+	case "TrimFuncExpr":
+		return dec.RefOfTrimFuncExpr(data)
+	case "TypeCastExpr":
+		return dec.RefOfTypeCastExpr(data)
+	case "UnaryExpr":
+		return dec.RefOfUnaryExpr(data)
+	case "UpdateXMLExpr":
+		return dec.RefOfUpdateXMLExpr(data)
+	case "ValTuple":
+		var out ValTuple
+		out = dec.ValTuple(fields["Value"])
+		return out
+	case "ValuesFuncExpr":
+		return dec.RefOfValuesFuncExpr(data)
+	case "VarPop":
+		return dec.RefOfVarPop(data)
+	case "VarSamp":
+		return dec.RefOfVarSamp(data)
+	case "Variable":
+		return dec.RefOfVariable(data)
+	case "Variance":
+		return dec.RefOfVariance(data)
+	case "WeightStringFuncExpr":
+		return dec.RefOfWeightStringFuncExpr(data)
+	case "XorExpr":
+		return dec.RefOfXorExpr(data)
+	}
+	dec.unknownTag(tag, "Expr")
+	return nil
+}
+
+// InsertRows encodes the value as JSON.
+func (enc *jsonEncoder) InsertRows(n InsertRows) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case *Select:
+		enc.RefOfSelect(n)
+	case *Union:
+		enc.RefOfUnion(n)
+	case Values:
+		enc.openNode("Values")
+		enc.field("Value")
+		enc.Values(n)
+		enc.closeObject()
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// InsertRows decodes the value from JSON.
+func (dec *jsonDecoder) InsertRows(data json.RawMessage) InsertRows {
+	tag, fields := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "Select":
+		return dec.RefOfSelect(data)
+	case "Union":
+		return dec.RefOfUnion(data)
+	case "Values":
+		var out Values
+		out = dec.Values(fields["Value"])
+		return out
+	}
+	dec.unknownTag(tag, "InsertRows")
+	return nil
+}
+
+// SelectExpr encodes the value as JSON.
+func (enc *jsonEncoder) SelectExpr(n SelectExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case *AliasedExpr:
+		enc.RefOfAliasedExpr(n)
+	case *Nextval:
+		enc.RefOfNextval(n)
+	case *StarExpr:
+		enc.RefOfStarExpr(n)
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// SelectExpr decodes the value from JSON.
+func (dec *jsonDecoder) SelectExpr(data json.RawMessage) SelectExpr {
+	tag, _ := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "AliasedExpr":
+		return dec.RefOfAliasedExpr(data)
+	case "Nextval":
+		return dec.RefOfNextval(data)
+	case "StarExpr":
+		return dec.RefOfStarExpr(data)
+	}
+	dec.unknownTag(tag, "SelectExpr")
+	return nil
+}
+
+// SelectStatement encodes the value as JSON.
+func (enc *jsonEncoder) SelectStatement(n SelectStatement) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case *Select:
+		enc.RefOfSelect(n)
+	case *Union:
+		enc.RefOfUnion(n)
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// SelectStatement decodes the value from JSON.
+func (dec *jsonDecoder) SelectStatement(data json.RawMessage) SelectStatement {
+	tag, _ := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "Select":
+		return dec.RefOfSelect(data)
+	case "Union":
+		return dec.RefOfUnion(data)
+	}
+	dec.unknownTag(tag, "SelectStatement")
+	return nil
+}
+
+// ShowInternal encodes the value as JSON.
+func (enc *jsonEncoder) ShowInternal(n ShowInternal) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case *ShowBasic:
+		enc.RefOfShowBasic(n)
+	case *ShowCreate:
+		enc.RefOfShowCreate(n)
+	case *ShowOther:
+		enc.RefOfShowOther(n)
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// ShowInternal decodes the value from JSON.
+func (dec *jsonDecoder) ShowInternal(data json.RawMessage) ShowInternal {
+	tag, _ := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "ShowBasic":
+		return dec.RefOfShowBasic(data)
+	case "ShowCreate":
+		return dec.RefOfShowCreate(data)
+	case "ShowOther":
+		return dec.RefOfShowOther(data)
+	}
+	dec.unknownTag(tag, "ShowInternal")
+	return nil
+}
+
+// SimpleTableExpr encodes the value as JSON.
+func (enc *jsonEncoder) SimpleTableExpr(n SimpleTableExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case *DerivedTable:
+		enc.RefOfDerivedTable(n)
+	case TableName:
+		enc.TableName(n)
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// SimpleTableExpr decodes the value from JSON.
+func (dec *jsonDecoder) SimpleTableExpr(data json.RawMessage) SimpleTableExpr {
+	tag, _ := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "DerivedTable":
+		return dec.RefOfDerivedTable(data)
+	case "TableName":
+		return dec.TableName(data)
+	}
+	dec.unknownTag(tag, "SimpleTableExpr")
+	return nil
+}
+
+// Statement encodes the value as JSON.
+func (enc *jsonEncoder) Statement(n Statement) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case *AlterDatabase:
+		enc.RefOfAlterDatabase(n)
+	case *AlterEvent:
+		enc.RefOfAlterEvent(n)
+	case *AlterFunction:
+		enc.RefOfAlterFunction(n)
+	case *AlterMigration:
+		enc.RefOfAlterMigration(n)
+	case *AlterProcedure:
+		enc.RefOfAlterProcedure(n)
+	case *AlterTable:
+		enc.RefOfAlterTable(n)
+	case *AlterUser:
+		enc.RefOfAlterUser(n)
+	case *AlterView:
+		enc.RefOfAlterView(n)
+	case *AlterVschema:
+		enc.RefOfAlterVschema(n)
+	case *BadStatement:
+		enc.RefOfBadStatement(n)
+	case *Begin:
+		enc.RefOfBegin(n)
+	case *BeginEndBlock:
+		enc.RefOfBeginEndBlock(n)
+	case *CallProc:
+		enc.RefOfCallProc(n)
+	case *CaseStatement:
+		enc.RefOfCaseStatement(n)
+	case *CloseCursor:
+		enc.RefOfCloseCursor(n)
+	case *CommentOnly:
+		enc.RefOfCommentOnly(n)
+	case *Commit:
+		enc.RefOfCommit(n)
+	case *CreateDatabase:
+		enc.RefOfCreateDatabase(n)
+	case *CreateEvent:
+		enc.RefOfCreateEvent(n)
+	case *CreateFunction:
+		enc.RefOfCreateFunction(n)
+	case *CreateProcedure:
+		enc.RefOfCreateProcedure(n)
+	case *CreateRole:
+		enc.RefOfCreateRole(n)
+	case *CreateTable:
+		enc.RefOfCreateTable(n)
+	case *CreateTrigger:
+		enc.RefOfCreateTrigger(n)
+	case *CreateUser:
+		enc.RefOfCreateUser(n)
+	case *CreateView:
+		enc.RefOfCreateView(n)
+	case *DeallocateStmt:
+		enc.RefOfDeallocateStmt(n)
+	case *DeclareCondition:
+		enc.RefOfDeclareCondition(n)
+	case *DeclareCursor:
+		enc.RefOfDeclareCursor(n)
+	case *DeclareHandler:
+		enc.RefOfDeclareHandler(n)
+	case *DeclareVar:
+		enc.RefOfDeclareVar(n)
+	case *Delete:
+		enc.RefOfDelete(n)
+	case *DropDatabase:
+		enc.RefOfDropDatabase(n)
+	case *DropEvent:
+		enc.RefOfDropEvent(n)
+	case *DropFunction:
+		enc.RefOfDropFunction(n)
+	case *DropProcedure:
+		enc.RefOfDropProcedure(n)
+	case *DropRole:
+		enc.RefOfDropRole(n)
+	case *DropTable:
+		enc.RefOfDropTable(n)
+	case *DropTrigger:
+		enc.RefOfDropTrigger(n)
+	case *DropUser:
+		enc.RefOfDropUser(n)
+	case *DropView:
+		enc.RefOfDropView(n)
+	case *ExecuteStmt:
+		enc.RefOfExecuteStmt(n)
+	case *ExplainStmt:
+		enc.RefOfExplainStmt(n)
+	case *ExplainTab:
+		enc.RefOfExplainTab(n)
+	case *FetchCursor:
+		enc.RefOfFetchCursor(n)
+	case *Flush:
+		enc.RefOfFlush(n)
+	case *Grant:
+		enc.RefOfGrant(n)
+	case *IfStatement:
+		enc.RefOfIfStatement(n)
+	case *Insert:
+		enc.RefOfInsert(n)
+	case *IterateStatement:
+		enc.RefOfIterateStatement(n)
+	case *LeaveStatement:
+		enc.RefOfLeaveStatement(n)
+	case *Load:
+		enc.RefOfLoad(n)
+	case *LockTables:
+		enc.RefOfLockTables(n)
+	case *LoopStatement:
+		enc.RefOfLoopStatement(n)
+	case *OpenCursor:
+		enc.RefOfOpenCursor(n)
+	case *OtherAdmin:
+		enc.RefOfOtherAdmin(n)
+	case *OtherRead:
+		enc.RefOfOtherRead(n)
+	case *PrepareStmt:
+		enc.RefOfPrepareStmt(n)
+	case *PurgeBinaryLogs:
+		enc.RefOfPurgeBinaryLogs(n)
+	case *Release:
+		enc.RefOfRelease(n)
+	case *RenameTable:
+		enc.RefOfRenameTable(n)
+	case *RenameUser:
+		enc.RefOfRenameUser(n)
+	case *RepeatStatement:
+		enc.RefOfRepeatStatement(n)
+	case *ReturnStatement:
+		enc.RefOfReturnStatement(n)
+	case *RevertMigration:
+		enc.RefOfRevertMigration(n)
+	case *Revoke:
+		enc.RefOfRevoke(n)
+	case *Rollback:
+		enc.RefOfRollback(n)
+	case *RoutineSet:
+		enc.RefOfRoutineSet(n)
+	case *SRollback:
+		enc.RefOfSRollback(n)
+	case *Savepoint:
+		enc.RefOfSavepoint(n)
+	case *Select:
+		enc.RefOfSelect(n)
+	case *Set:
+		enc.RefOfSet(n)
+	case *SetDefaultRole:
+		enc.RefOfSetDefaultRole(n)
+	case *SetPassword:
+		enc.RefOfSetPassword(n)
+	case *SetRole:
+		enc.RefOfSetRole(n)
+	case *Show:
+		enc.RefOfShow(n)
+	case *ShowMigrationLogs:
+		enc.RefOfShowMigrationLogs(n)
+	case *ShowThrottledApps:
+		enc.RefOfShowThrottledApps(n)
+	case *ShowThrottlerStatus:
+		enc.RefOfShowThrottlerStatus(n)
+	case *Signal:
+		enc.RefOfSignal(n)
+	case *Stream:
+		enc.RefOfStream(n)
+	case *TruncateTable:
+		enc.RefOfTruncateTable(n)
+	case *Union:
+		enc.RefOfUnion(n)
+	case *UnlockTables:
+		enc.RefOfUnlockTables(n)
+	case *Update:
+		enc.RefOfUpdate(n)
+	case *Use:
+		enc.RefOfUse(n)
+	case *VExplainStmt:
+		enc.RefOfVExplainStmt(n)
+	case *VStream:
+		enc.RefOfVStream(n)
+	case *WhileStatement:
+		enc.RefOfWhileStatement(n)
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// Statement decodes the value from JSON.
+func (dec *jsonDecoder) Statement(data json.RawMessage) Statement {
+	tag, _ := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "AlterDatabase":
+		return dec.RefOfAlterDatabase(data)
+	case "AlterEvent":
+		return dec.RefOfAlterEvent(data)
+	case "AlterFunction":
+		return dec.RefOfAlterFunction(data)
+	case "AlterMigration":
+		return dec.RefOfAlterMigration(data)
+	case "AlterProcedure":
+		return dec.RefOfAlterProcedure(data)
+	case "AlterTable":
+		return dec.RefOfAlterTable(data)
+	case "AlterUser":
+		return dec.RefOfAlterUser(data)
+	case "AlterView":
+		return dec.RefOfAlterView(data)
+	case "AlterVschema":
+		return dec.RefOfAlterVschema(data)
+	case "BadStatement":
+		return dec.RefOfBadStatement(data)
+	case "Begin":
+		return dec.RefOfBegin(data)
+	case "BeginEndBlock":
+		return dec.RefOfBeginEndBlock(data)
+	case "CallProc":
+		return dec.RefOfCallProc(data)
+	case "CaseStatement":
+		return dec.RefOfCaseStatement(data)
+	case "CloseCursor":
+		return dec.RefOfCloseCursor(data)
+	case "CommentOnly":
+		return dec.RefOfCommentOnly(data)
+	case "Commit":
+		return dec.RefOfCommit(data)
+	case "CreateDatabase":
+		return dec.RefOfCreateDatabase(data)
+	case "CreateEvent":
+		return dec.RefOfCreateEvent(data)
+	case "CreateFunction":
+		return dec.RefOfCreateFunction(data)
+	case "CreateProcedure":
+		return dec.RefOfCreateProcedure(data)
+	case "CreateRole":
+		return dec.RefOfCreateRole(data)
+	case "CreateTable":
+		return dec.RefOfCreateTable(data)
+	case "CreateTrigger":
+		return dec.RefOfCreateTrigger(data)
+	case "CreateUser":
+		return dec.RefOfCreateUser(data)
+	case "CreateView":
+		return dec.RefOfCreateView(data)
+	case "DeallocateStmt":
+		return dec.RefOfDeallocateStmt(data)
+	case "DeclareCondition":
+		return dec.RefOfDeclareCondition(data)
+	case "DeclareCursor":
+		return dec.RefOfDeclareCursor(data)
+	case "DeclareHandler":
+		return dec.RefOfDeclareHandler(data)
+	case "DeclareVar":
+		return dec.RefOfDeclareVar(data)
+	case "Delete":
+		return dec.RefOfDelete(data)
+	case "DropDatabase":
+		return dec.RefOfDropDatabase(data)
+	case "DropEvent":
+		return dec.RefOfDropEvent(data)
+	case "DropFunction":
+		return dec.RefOfDropFunction(data)
+	case "DropProcedure":
+		return dec.RefOfDropProcedure(data)
+	case "DropRole":
+		return dec.RefOfDropRole(data)
+	case "DropTable":
+		return dec.RefOfDropTable(data)
+	case "DropTrigger":
+		return dec.RefOfDropTrigger(data)
+	case "DropUser":
+		return dec.RefOfDropUser(data)
+	case "DropView":
+		return dec.RefOfDropView(data)
+	case "ExecuteStmt":
+		return dec.RefOfExecuteStmt(data)
+	case "ExplainStmt":
+		return dec.RefOfExplainStmt(data)
+	case "ExplainTab":
+		return dec.RefOfExplainTab(data)
+	case "FetchCursor":
+		return dec.RefOfFetchCursor(data)
+	case "Flush":
+		return dec.RefOfFlush(data)
+	case "Grant":
+		return dec.RefOfGrant(data)
+	case "IfStatement":
+		return dec.RefOfIfStatement(data)
+	case "Insert":
+		return dec.RefOfInsert(data)
+	case "IterateStatement":
+		return dec.RefOfIterateStatement(data)
+	case "LeaveStatement":
+		return dec.RefOfLeaveStatement(data)
+	case "Load":
+		return dec.RefOfLoad(data)
+	case "LockTables":
+		return dec.RefOfLockTables(data)
+	case "LoopStatement":
+		return dec.RefOfLoopStatement(data)
+	case "OpenCursor":
+		return dec.RefOfOpenCursor(data)
+	case "OtherAdmin":
+		return dec.RefOfOtherAdmin(data)
+	case "OtherRead":
+		return dec.RefOfOtherRead(data)
+	case "PrepareStmt":
+		return dec.RefOfPrepareStmt(data)
+	case "PurgeBinaryLogs":
+		return dec.RefOfPurgeBinaryLogs(data)
+	case "Release":
+		return dec.RefOfRelease(data)
+	case "RenameTable":
+		return dec.RefOfRenameTable(data)
+	case "RenameUser":
+		return dec.RefOfRenameUser(data)
+	case "RepeatStatement":
+		return dec.RefOfRepeatStatement(data)
+	case "ReturnStatement":
+		return dec.RefOfReturnStatement(data)
+	case "RevertMigration":
+		return dec.RefOfRevertMigration(data)
+	case "Revoke":
+		return dec.RefOfRevoke(data)
+	case "Rollback":
+		return dec.RefOfRollback(data)
+	case "RoutineSet":
+		return dec.RefOfRoutineSet(data)
+	case "SRollback":
+		return dec.RefOfSRollback(data)
+	case "Savepoint":
+		return dec.RefOfSavepoint(data)
+	case "Select":
+		return dec.RefOfSelect(data)
+	case "Set":
+		return dec.RefOfSet(data)
+	case "SetDefaultRole":
+		return dec.RefOfSetDefaultRole(data)
+	case "SetPassword":
+		return dec.RefOfSetPassword(data)
+	case "SetRole":
+		return dec.RefOfSetRole(data)
+	case "Show":
+		return dec.RefOfShow(data)
+	case "ShowMigrationLogs":
+		return dec.RefOfShowMigrationLogs(data)
+	case "ShowThrottledApps":
+		return dec.RefOfShowThrottledApps(data)
+	case "ShowThrottlerStatus":
+		return dec.RefOfShowThrottlerStatus(data)
+	case "Signal":
+		return dec.RefOfSignal(data)
+	case "Stream":
+		return dec.RefOfStream(data)
+	case "TruncateTable":
+		return dec.RefOfTruncateTable(data)
+	case "Union":
+		return dec.RefOfUnion(data)
+	case "UnlockTables":
+		return dec.RefOfUnlockTables(data)
+	case "Update":
+		return dec.RefOfUpdate(data)
+	case "Use":
+		return dec.RefOfUse(data)
+	case "VExplainStmt":
+		return dec.RefOfVExplainStmt(data)
+	case "VStream":
+		return dec.RefOfVStream(data)
+	case "WhileStatement":
+		return dec.RefOfWhileStatement(data)
+	}
+	dec.unknownTag(tag, "Statement")
+	return nil
+}
+
+// TableExpr encodes the value as JSON.
+func (enc *jsonEncoder) TableExpr(n TableExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	switch n := n.(type) {
+	case *AliasedTableExpr:
+		enc.RefOfAliasedTableExpr(n)
+	case *JSONTableExpr:
+		enc.RefOfJSONTableExpr(n)
+	case *JoinTableExpr:
+		enc.RefOfJoinTableExpr(n)
+	case *ParenTableExpr:
+		enc.RefOfParenTableExpr(n)
+	default:
+		// this should never happen
+		enc.unknownType(n)
+	}
+}
+
+// TableExpr decodes the value from JSON.
+func (dec *jsonDecoder) TableExpr(data json.RawMessage) TableExpr {
+	tag, _ := dec.node(data)
+	switch tag {
+	case "":
+		return nil
+	case "AliasedTableExpr":
+		return dec.RefOfAliasedTableExpr(data)
+	case "JSONTableExpr":
+		return dec.RefOfJSONTableExpr(data)
+	case "JoinTableExpr":
+		return dec.RefOfJoinTableExpr(data)
+	case "ParenTableExpr":
+		return dec.RefOfParenTableExpr(data)
+	}
+	dec.unknownTag(tag, "TableExpr")
+	return nil
+}
+
+// SliceOfRefOfColumnDefinition encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfColumnDefinition(n []*ColumnDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfColumnDefinition(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfColumnDefinition decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfColumnDefinition(data json.RawMessage) []*ColumnDefinition {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*ColumnDefinition, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfColumnDefinition(el)
+	}
+	return out
+}
+
+// RefOfBool encodes the value as JSON.
+func (enc *jsonEncoder) RefOfBool(n *bool) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.value(*n)
+}
+
+// RefOfBool decodes the value from JSON.
+func (dec *jsonDecoder) RefOfBool(data json.RawMessage) *bool {
+	if isNull(data) {
+		return nil
+	}
+	var out bool
+	dec.value(data, &out)
+	return &out
+}
+
+// SliceOfDatabaseOption encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfDatabaseOption(n []DatabaseOption) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.DatabaseOption(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfDatabaseOption decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfDatabaseOption(data json.RawMessage) []DatabaseOption {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]DatabaseOption, len(elements))
+	for i, el := range elements {
+		out[i] = dec.DatabaseOption(el)
+	}
+	return out
+}
+
+// SliceOfRefOfRoutineCharacteristic encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfRoutineCharacteristic(n []*RoutineCharacteristic) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfRoutineCharacteristic(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfRoutineCharacteristic decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfRoutineCharacteristic(data json.RawMessage) []*RoutineCharacteristic {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*RoutineCharacteristic, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfRoutineCharacteristic(el)
+	}
+	return out
+}
+
+// SliceOfAlterOption encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfAlterOption(n []AlterOption) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.AlterOption(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfAlterOption decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfAlterOption(data json.RawMessage) []AlterOption {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]AlterOption, len(elements))
+	for i, el := range elements {
+		out[i] = dec.AlterOption(el)
+	}
+	return out
+}
+
+// SliceOfRefOfUserSpec encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfUserSpec(n []*UserSpec) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfUserSpec(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfUserSpec decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfUserSpec(data json.RawMessage) []*UserSpec {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*UserSpec, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfUserSpec(el)
+	}
+	return out
+}
+
+// SliceOfIdentifierCI encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfIdentifierCI(n []IdentifierCI) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.value(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfIdentifierCI decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfIdentifierCI(data json.RawMessage) []IdentifierCI {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]IdentifierCI, len(elements))
+	for i, el := range elements {
+		dec.value(el, &out[i])
+	}
+	return out
+}
+
+// SliceOfTxAccessMode encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfTxAccessMode(n []TxAccessMode) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.value(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfTxAccessMode decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfTxAccessMode(data json.RawMessage) []TxAccessMode {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]TxAccessMode, len(elements))
+	for i, el := range elements {
+		dec.value(el, &out[i])
+	}
+	return out
+}
+
+// SliceOfRefOfWhen encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfWhen(n []*When) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfWhen(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfWhen decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfWhen(data json.RawMessage) []*When {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*When, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfWhen(el)
+	}
+	return out
+}
+
+// SliceOfRefOfCaseStatementWhen encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfCaseStatementWhen(n []*CaseStatementWhen) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfCaseStatementWhen(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfCaseStatementWhen decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfCaseStatementWhen(data json.RawMessage) []*CaseStatementWhen {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*CaseStatementWhen, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfCaseStatementWhen(el)
+	}
+	return out
+}
+
+// RefOfColumnTypeOptions encodes the value as JSON.
+func (enc *jsonEncoder) RefOfColumnTypeOptions(n *ColumnTypeOptions) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openObject()
+	enc.field("Null")
+	enc.RefOfBool(n.Null)
+	enc.field("Autoincrement")
+	enc.value(n.Autoincrement)
+	enc.field("Default")
+	enc.Expr(n.Default)
+	enc.field("OnUpdate")
+	enc.Expr(n.OnUpdate)
+	enc.field("As")
+	enc.Expr(n.As)
+	enc.field("Comment")
+	enc.RefOfLiteral(n.Comment)
+	enc.field("Storage")
+	enc.value(n.Storage)
+	enc.field("Collate")
+	enc.value(n.Collate)
+	enc.field("Reference")
+	enc.RefOfReferenceDefinition(n.Reference)
+	enc.field("KeyOpt")
+	enc.value(n.KeyOpt)
+	enc.field("Invisible")
+	enc.RefOfBool(n.Invisible)
+	enc.field("Format")
+	enc.value(n.Format)
+	enc.field("EngineAttribute")
+	enc.RefOfLiteral(n.EngineAttribute)
+	enc.field("SecondaryEngineAttribute")
+	enc.RefOfLiteral(n.SecondaryEngineAttribute)
+	enc.field("SRID")
+	enc.RefOfLiteral(n.SRID)
+	enc.closeObject()
+}
+
+// RefOfColumnTypeOptions decodes the value from JSON.
+func (dec *jsonDecoder) RefOfColumnTypeOptions(data json.RawMessage) *ColumnTypeOptions {
+	fields := dec.object(data, "")
+	if fields == nil {
+		return nil
+	}
+	out := &ColumnTypeOptions{}
+	out.Null = dec.RefOfBool(fields["Null"])
+	dec.value(fields["Autoincrement"], &out.Autoincrement)
+	out.Default = dec.Expr(fields["Default"])
+	out.OnUpdate = dec.Expr(fields["OnUpdate"])
+	out.As = dec.Expr(fields["As"])
+	out.Comment = dec.RefOfLiteral(fields["Comment"])
+	dec.value(fields["Storage"], &out.Storage)
+	dec.value(fields["Collate"], &out.Collate)
+	out.Reference = dec.RefOfReferenceDefinition(fields["Reference"])
+	dec.value(fields["KeyOpt"], &out.KeyOpt)
+	out.Invisible = dec.RefOfBool(fields["Invisible"])
+	dec.value(fields["Format"], &out.Format)
+	out.EngineAttribute = dec.RefOfLiteral(fields["EngineAttribute"])
+	out.SecondaryEngineAttribute = dec.RefOfLiteral(fields["SecondaryEngineAttribute"])
+	out.SRID = dec.RefOfLiteral(fields["SRID"])
+	return out
+}
+
+// ColumnCharset encodes the value as JSON.
+func (enc *jsonEncoder) ColumnCharset(n ColumnCharset) {
+	enc.openObject()
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Binary")
+	enc.value(n.Binary)
+	enc.closeObject()
+}
+
+// ColumnCharset decodes the value from JSON.
+func (dec *jsonDecoder) ColumnCharset(data json.RawMessage) (out ColumnCharset) {
+	fields := dec.object(data, "")
+	if fields == nil {
+		return
+	}
+	dec.value(fields["Name"], &out.Name)
+	dec.value(fields["Binary"], &out.Binary)
+	return
+}
+
+// SliceOfString encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfString(n []string) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.value(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfString decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfString(data json.RawMessage) []string {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]string, len(elements))
+	for i, el := range elements {
+		dec.value(el, &out[i])
+	}
+	return out
+}
+
+// SliceOfRefOfProcParameter encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfProcParameter(n []*ProcParameter) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfProcParameter(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfProcParameter decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfProcParameter(data json.RawMessage) []*ProcParameter {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*ProcParameter, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfProcParameter(el)
+	}
+	return out
+}
+
+// SliceOfRefOfAccount encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfAccount(n []*Account) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfAccount(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfAccount decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfAccount(data json.RawMessage) []*Account {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*Account, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfAccount(el)
+	}
+	return out
+}
+
+// SliceOfRefOfConditionValue encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfConditionValue(n []*ConditionValue) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfConditionValue(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfConditionValue decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfConditionValue(data json.RawMessage) []*ConditionValue {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*ConditionValue, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfConditionValue(el)
+	}
+	return out
+}
+
+// SliceOfRefOfVariable encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfVariable(n []*Variable) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfVariable(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfVariable decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfVariable(data json.RawMessage) []*Variable {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*Variable, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfVariable(el)
+	}
+	return out
+}
+
+// SliceOfRefOfGrantPrivilege encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfGrantPrivilege(n []*GrantPrivilege) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfGrantPrivilege(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfGrantPrivilege decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfGrantPrivilege(data json.RawMessage) []*GrantPrivilege {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*GrantPrivilege, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfGrantPrivilege(el)
+	}
+	return out
+}
+
+// RefOfIdentifierCI encodes the value as JSON.
+func (enc *jsonEncoder) RefOfIdentifierCI(n *IdentifierCI) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("IdentifierCI")
+	enc.field("val")
+	enc.value(n.val)
+	enc.field("lowered")
+	enc.value(n.lowered)
+	enc.closeObject()
+}
+
+// RefOfIdentifierCI decodes the value from JSON.
+func (dec *jsonDecoder) RefOfIdentifierCI(data json.RawMessage) *IdentifierCI {
+	fields := dec.object(data, "IdentifierCI")
+	if fields == nil {
+		return nil
+	}
+	out := &IdentifierCI{}
+	dec.value(fields["val"], &out.val)
+	dec.value(fields["lowered"], &out.lowered)
+	return out
+}
+
+// RefOfIdentifierCS encodes the value as JSON.
+func (enc *jsonEncoder) RefOfIdentifierCS(n *IdentifierCS) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("IdentifierCS")
+	enc.field("v")
+	enc.value(n.v)
+	enc.closeObject()
+}
+
+// RefOfIdentifierCS decodes the value from JSON.
+func (dec *jsonDecoder) RefOfIdentifierCS(data json.RawMessage) *IdentifierCS {
+	fields := dec.object(data, "IdentifierCS")
+	if fields == nil {
+		return nil
+	}
+	out := &IdentifierCS{}
+	dec.value(fields["v"], &out.v)
+	return out
+}
+
+// SliceOfRefOfElseIfBlock encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfElseIfBlock(n []*ElseIfBlock) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfElseIfBlock(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfElseIfBlock decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfElseIfBlock(data json.RawMessage) []*ElseIfBlock {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*ElseIfBlock, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfElseIfBlock(el)
+	}
+	return out
+}
+
+// SliceOfRefOfIndexColumn encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfIndexColumn(n []*IndexColumn) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfIndexColumn(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfIndexColumn decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfIndexColumn(data json.RawMessage) []*IndexColumn {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*IndexColumn, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfIndexColumn(el)
+	}
+	return out
+}
+
+// SliceOfRefOfIndexOption encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfIndexOption(n []*IndexOption) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfIndexOption(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfIndexOption decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfIndexOption(data json.RawMessage) []*IndexOption {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*IndexOption, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfIndexOption(el)
+	}
+	return out
+}
+
+// SliceOfExpr encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfExpr(n []Expr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.Expr(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfExpr decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfExpr(data json.RawMessage) []Expr {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]Expr, len(elements))
+	for i, el := range elements {
+		out[i] = dec.Expr(el)
+	}
+	return out
+}
+
+// SliceOfRefOfJSONObjectParam encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfJSONObjectParam(n []*JSONObjectParam) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfJSONObjectParam(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfJSONObjectParam decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfJSONObjectParam(data json.RawMessage) []*JSONObjectParam {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*JSONObjectParam, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfJSONObjectParam(el)
+	}
+	return out
+}
+
+// SliceOfRefOfJtColumnDefinition encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfJtColumnDefinition(n []*JtColumnDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfJtColumnDefinition(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfJtColumnDefinition decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfJtColumnDefinition(data json.RawMessage) []*JtColumnDefinition {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*JtColumnDefinition, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfJtColumnDefinition(el)
+	}
+	return out
+}
+
+// RefOfJtOrdinalColDef encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJtOrdinalColDef(n *JtOrdinalColDef) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openObject()
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.closeObject()
+}
+
+// RefOfJtOrdinalColDef decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJtOrdinalColDef(data json.RawMessage) *JtOrdinalColDef {
+	fields := dec.object(data, "")
+	if fields == nil {
+		return nil
+	}
+	out := &JtOrdinalColDef{}
+	dec.value(fields["Name"], &out.Name)
+	return out
+}
+
+// RefOfJtPathColDef encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJtPathColDef(n *JtPathColDef) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openObject()
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Type")
+	enc.RefOfColumnType(n.Type)
+	enc.field("JtColExists")
+	enc.value(n.JtColExists)
+	enc.field("Path")
+	enc.Expr(n.Path)
+	enc.field("EmptyOnResponse")
+	enc.RefOfJtOnResponse(n.EmptyOnResponse)
+	enc.field("ErrorOnResponse")
+	enc.RefOfJtOnResponse(n.ErrorOnResponse)
+	enc.closeObject()
+}
+
+// RefOfJtPathColDef decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJtPathColDef(data json.RawMessage) *JtPathColDef {
+	fields := dec.object(data, "")
+	if fields == nil {
+		return nil
+	}
+	out := &JtPathColDef{}
+	dec.value(fields["Name"], &out.Name)
+	out.Type = dec.RefOfColumnType(fields["Type"])
+	dec.value(fields["JtColExists"], &out.JtColExists)
+	out.Path = dec.Expr(fields["Path"])
+	out.EmptyOnResponse = dec.RefOfJtOnResponse(fields["EmptyOnResponse"])
+	out.ErrorOnResponse = dec.RefOfJtOnResponse(fields["ErrorOnResponse"])
+	return out
+}
+
+// RefOfJtNestedPathColDef encodes the value as JSON.
+func (enc *jsonEncoder) RefOfJtNestedPathColDef(n *JtNestedPathColDef) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openObject()
+	enc.field("Path")
+	enc.Expr(n.Path)
+	enc.field("Columns")
+	enc.SliceOfRefOfJtColumnDefinition(n.Columns)
+	enc.closeObject()
+}
+
+// RefOfJtNestedPathColDef decodes the value from JSON.
+func (dec *jsonDecoder) RefOfJtNestedPathColDef(data json.RawMessage) *JtNestedPathColDef {
+	fields := dec.object(data, "")
+	if fields == nil {
+		return nil
+	}
+	out := &JtNestedPathColDef{}
+	out.Path = dec.Expr(fields["Path"])
+	out.Columns = dec.SliceOfRefOfJtColumnDefinition(fields["Columns"])
+	return out
+}
+
+// TableAndLockTypes encodes the value as JSON.
+func (enc *jsonEncoder) TableAndLockTypes(n TableAndLockTypes) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfTableAndLockType(el)
+	}
+	enc.closeArray()
+}
+
+// TableAndLockTypes decodes the value from JSON.
+func (dec *jsonDecoder) TableAndLockTypes(data json.RawMessage) TableAndLockTypes {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(TableAndLockTypes, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfTableAndLockType(el)
+	}
+	return out
+}
+
+// SliceOfRefOfColName encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfColName(n []*ColName) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfColName(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfColName decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfColName(data json.RawMessage) []*ColName {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*ColName, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfColName(el)
+	}
+	return out
+}
+
+// Comments encodes the value as JSON.
+func (enc *jsonEncoder) Comments(n Comments) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.value(el)
+	}
+	enc.closeArray()
+}
+
+// Comments decodes the value from JSON.
+func (dec *jsonDecoder) Comments(data json.RawMessage) Comments {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make(Comments, len(elements))
+	for i, el := range elements {
+		dec.value(el, &out[i])
+	}
+	return out
+}
+
+// RefOfInt encodes the value as JSON.
+func (enc *jsonEncoder) RefOfInt(n *int) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.value(*n)
+}
+
+// RefOfInt decodes the value from JSON.
+func (dec *jsonDecoder) RefOfInt(data json.RawMessage) *int {
+	if isNull(data) {
+		return nil
+	}
+	var out int
+	dec.value(data, &out)
+	return &out
+}
+
+// SliceOfRefOfPartitionDefinition encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfPartitionDefinition(n []*PartitionDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfPartitionDefinition(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfPartitionDefinition decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfPartitionDefinition(data json.RawMessage) []*PartitionDefinition {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*PartitionDefinition, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfPartitionDefinition(el)
+	}
+	return out
+}
+
+// SliceOfRefOfRenameTablePair encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfRenameTablePair(n []*RenameTablePair) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfRenameTablePair(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfRenameTablePair decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfRenameTablePair(data json.RawMessage) []*RenameTablePair {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*RenameTablePair, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfRenameTablePair(el)
+	}
+	return out
+}
+
+// SliceOfRefOfRenameUserPair encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfRenameUserPair(n []*RenameUserPair) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfRenameUserPair(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfRenameUserPair decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfRenameUserPair(data json.RawMessage) []*RenameUserPair {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*RenameUserPair, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfRenameUserPair(el)
+	}
+	return out
+}
+
+// RefOfRootNode encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRootNode(n *RootNode) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("RootNode")
+	enc.field("SQLNode")
+	enc.SQLNode(n.SQLNode)
+	enc.closeObject()
+}
+
+// RefOfRootNode decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRootNode(data json.RawMessage) *RootNode {
+	fields := dec.object(data, "RootNode")
+	if fields == nil {
+		return nil
+	}
+	out := &RootNode{}
+	out.SQLNode = dec.SQLNode(fields["SQLNode"])
+	return out
+}
+
+// SliceOfTableExpr encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfTableExpr(n []TableExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.TableExpr(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfTableExpr decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfTableExpr(data json.RawMessage) []TableExpr {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]TableExpr, len(elements))
+	for i, el := range elements {
+		out[i] = dec.TableExpr(el)
+	}
+	return out
+}
+
+// SliceOfRefOfSignalItem encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfSignalItem(n []*SignalItem) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfSignalItem(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfSignalItem decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfSignalItem(data json.RawMessage) []*SignalItem {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*SignalItem, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfSignalItem(el)
+	}
+	return out
+}
+
+// RefOfTableName encodes the value as JSON.
+func (enc *jsonEncoder) RefOfTableName(n *TableName) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("TableName")
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Qualifier")
+	enc.value(n.Qualifier)
+	enc.closeObject()
+}
+
+// RefOfTableName decodes the value from JSON.
+func (dec *jsonDecoder) RefOfTableName(data json.RawMessage) *TableName {
+	fields := dec.object(data, "TableName")
+	if fields == nil {
+		return nil
+	}
+	out := &TableName{}
+	dec.value(fields["Name"], &out.Name)
+	dec.value(fields["Qualifier"], &out.Qualifier)
+	return out
+}
+
+// RefOfTableOption encodes the value as JSON.
+func (enc *jsonEncoder) RefOfTableOption(n *TableOption) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openObject()
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Value")
+	enc.RefOfLiteral(n.Value)
+	enc.field("String")
+	enc.value(n.String)
+	enc.field("Tables")
+	enc.TableNames(n.Tables)
+	enc.field("CaseSensitive")
+	enc.value(n.CaseSensitive)
+	enc.closeObject()
+}
+
+// RefOfTableOption decodes the value from JSON.
+func (dec *jsonDecoder) RefOfTableOption(data json.RawMessage) *TableOption {
+	fields := dec.object(data, "")
+	if fields == nil {
+		return nil
+	}
+	out := &TableOption{}
+	dec.value(fields["Name"], &out.Name)
+	out.Value = dec.RefOfLiteral(fields["Value"])
+	dec.value(fields["String"], &out.String)
+	out.Tables = dec.TableNames(fields["Tables"])
+	dec.value(fields["CaseSensitive"], &out.CaseSensitive)
+	return out
+}
+
+// SliceOfRefOfIndexDefinition encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfIndexDefinition(n []*IndexDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfIndexDefinition(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfIndexDefinition decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfIndexDefinition(data json.RawMessage) []*IndexDefinition {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*IndexDefinition, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfIndexDefinition(el)
+	}
+	return out
+}
+
+// SliceOfRefOfConstraintDefinition encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfConstraintDefinition(n []*ConstraintDefinition) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfConstraintDefinition(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfConstraintDefinition decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfConstraintDefinition(data json.RawMessage) []*ConstraintDefinition {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*ConstraintDefinition, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfConstraintDefinition(el)
+	}
+	return out
+}
+
+// RefOfVindexParam encodes the value as JSON.
+func (enc *jsonEncoder) RefOfVindexParam(n *VindexParam) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openNode("VindexParam")
+	enc.field("Key")
+	enc.value(n.Key)
+	enc.field("Val")
+	enc.value(n.Val)
+	enc.closeObject()
+}
+
+// RefOfVindexParam decodes the value from JSON.
+func (dec *jsonDecoder) RefOfVindexParam(data json.RawMessage) *VindexParam {
+	fields := dec.object(data, "VindexParam")
+	if fields == nil {
+		return nil
+	}
+	out := &VindexParam{}
+	dec.value(fields["Key"], &out.Key)
+	dec.value(fields["Val"], &out.Val)
+	return out
+}
+
+// SliceOfVindexParam encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfVindexParam(n []VindexParam) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.VindexParam(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfVindexParam decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfVindexParam(data json.RawMessage) []VindexParam {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]VindexParam, len(elements))
+	for i, el := range elements {
+		out[i] = dec.VindexParam(el)
+	}
+	return out
+}
+
+// SliceOfRefOfCommonTableExpr encodes the value as JSON.
+func (enc *jsonEncoder) SliceOfRefOfCommonTableExpr(n []*CommonTableExpr) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openArray()
+	for i, el := range n {
+		enc.element(i)
+		enc.RefOfCommonTableExpr(el)
+	}
+	enc.closeArray()
+}
+
+// SliceOfRefOfCommonTableExpr decodes the value from JSON.
+func (dec *jsonDecoder) SliceOfRefOfCommonTableExpr(data json.RawMessage) []*CommonTableExpr {
+	elements := dec.array(data)
+	if elements == nil {
+		return nil
+	}
+	out := make([]*CommonTableExpr, len(elements))
+	for i, el := range elements {
+		out[i] = dec.RefOfCommonTableExpr(el)
+	}
+	return out
+}
+
+// DatabaseOption encodes the value as JSON.
+func (enc *jsonEncoder) DatabaseOption(n DatabaseOption) {
+	enc.openObject()
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("IsDefault")
+	enc.value(n.IsDefault)
+	enc.field("Value")
+	enc.value(n.Value)
+	enc.closeObject()
+}
+
+// DatabaseOption decodes the value from JSON.
+func (dec *jsonDecoder) DatabaseOption(data json.RawMessage) (out DatabaseOption) {
+	fields := dec.object(data, "")
+	if fields == nil {
+		return
+	}
+	dec.value(fields["Type"], &out.Type)
+	dec.value(fields["IsDefault"], &out.IsDefault)
+	dec.value(fields["Value"], &out.Value)
+	return
+}
+
+// RefOfColumnCharset encodes the value as JSON.
+func (enc *jsonEncoder) RefOfColumnCharset(n *ColumnCharset) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openObject()
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Binary")
+	enc.value(n.Binary)
+	enc.closeObject()
+}
+
+// RefOfColumnCharset decodes the value from JSON.
+func (dec *jsonDecoder) RefOfColumnCharset(data json.RawMessage) *ColumnCharset {
+	fields := dec.object(data, "")
+	if fields == nil {
+		return nil
+	}
+	out := &ColumnCharset{}
+	dec.value(fields["Name"], &out.Name)
+	dec.value(fields["Binary"], &out.Binary)
+	return out
+}
+
+// RefOfIndexColumn encodes the value as JSON.
+func (enc *jsonEncoder) RefOfIndexColumn(n *IndexColumn) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openObject()
+	enc.field("Column")
+	enc.value(n.Column)
+	enc.field("Length")
+	enc.RefOfLiteral(n.Length)
+	enc.field("Expression")
+	enc.Expr(n.Expression)
+	enc.field("Direction")
+	enc.value(n.Direction)
+	enc.closeObject()
+}
+
+// RefOfIndexColumn decodes the value from JSON.
+func (dec *jsonDecoder) RefOfIndexColumn(data json.RawMessage) *IndexColumn {
+	fields := dec.object(data, "")
+	if fields == nil {
+		return nil
+	}
+	out := &IndexColumn{}
+	dec.value(fields["Column"], &out.Column)
+	out.Length = dec.RefOfLiteral(fields["Length"])
+	out.Expression = dec.Expr(fields["Expression"])
+	dec.value(fields["Direction"], &out.Direction)
+	return out
+}
+
+// RefOfIndexOption encodes the value as JSON.
+func (enc *jsonEncoder) RefOfIndexOption(n *IndexOption) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openObject()
+	enc.field("Name")
+	enc.value(n.Name)
+	enc.field("Value")
+	enc.RefOfLiteral(n.Value)
+	enc.field("String")
+	enc.value(n.String)
+	enc.closeObject()
+}
+
+// RefOfIndexOption decodes the value from JSON.
+func (dec *jsonDecoder) RefOfIndexOption(data json.RawMessage) *IndexOption {
+	fields := dec.object(data, "")
+	if fields == nil {
+		return nil
+	}
+	out := &IndexOption{}
+	dec.value(fields["Name"], &out.Name)
+	out.Value = dec.RefOfLiteral(fields["Value"])
+	dec.value(fields["String"], &out.String)
+	return out
+}
+
+// RefOfTableAndLockType encodes the value as JSON.
+func (enc *jsonEncoder) RefOfTableAndLockType(n *TableAndLockType) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openObject()
+	enc.field("Table")
+	enc.TableExpr(n.Table)
+	enc.field("Lock")
+	enc.value(n.Lock)
+	enc.closeObject()
+}
+
+// RefOfTableAndLockType decodes the value from JSON.
+func (dec *jsonDecoder) RefOfTableAndLockType(data json.RawMessage) *TableAndLockType {
+	fields := dec.object(data, "")
+	if fields == nil {
+		return nil
+	}
+	out := &TableAndLockType{}
+	out.Table = dec.TableExpr(fields["Table"])
+	dec.value(fields["Lock"], &out.Lock)
+	return out
+}
+
+// RefOfRenameTablePair encodes the value as JSON.
+func (enc *jsonEncoder) RefOfRenameTablePair(n *RenameTablePair) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openObject()
+	enc.field("FromTable")
+	enc.TableName(n.FromTable)
+	enc.field("ToTable")
+	enc.TableName(n.ToTable)
+	enc.closeObject()
+}
+
+// RefOfRenameTablePair decodes the value from JSON.
+func (dec *jsonDecoder) RefOfRenameTablePair(data json.RawMessage) *RenameTablePair {
+	fields := dec.object(data, "")
+	if fields == nil {
+		return nil
+	}
+	out := &RenameTablePair{}
+	out.FromTable = dec.TableName(fields["FromTable"])
+	out.ToTable = dec.TableName(fields["ToTable"])
+	return out
+}
+
+// RefOfDatabaseOption encodes the value as JSON.
+func (enc *jsonEncoder) RefOfDatabaseOption(n *DatabaseOption) {
+	if n == nil {
+		enc.null()
+		return
+	}
+	enc.openObject()
+	enc.field("Type")
+	enc.value(n.Type)
+	enc.field("IsDefault")
+	enc.value(n.IsDefault)
+	enc.field("Value")
+	enc.value(n.Value)
+	enc.closeObject()
+}
+
+// RefOfDatabaseOption decodes the value from JSON.
+func (dec *jsonDecoder) RefOfDatabaseOption(data json.RawMessage) *DatabaseOption {
+	fields := dec.object(data, "")
+	if fields == nil {
+		return nil
+	}
+	out := &DatabaseOption{}
+	dec.value(fields["Type"], &out.Type)
+	dec.value(fields["IsDefault"], &out.IsDefault)
+	dec.value(fields["Value"], &out.Value)
+	return out
+}
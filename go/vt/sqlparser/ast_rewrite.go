@@ -22,6 +22,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return true
 	}
 	switch node := node.(type) {
+	case *Account:
+		return a.rewriteRefOfAccount(parent, node, replacer)
 	case *AddColumns:
 		return a.rewriteRefOfAddColumns(parent, node, replacer)
 	case *AddConstraintDefinition:
@@ -42,12 +44,20 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfAlterColumn(parent, node, replacer)
 	case *AlterDatabase:
 		return a.rewriteRefOfAlterDatabase(parent, node, replacer)
+	case *AlterEvent:
+		return a.rewriteRefOfAlterEvent(parent, node, replacer)
+	case *AlterFunction:
+		return a.rewriteRefOfAlterFunction(parent, node, replacer)
 	case *AlterIndex:
 		return a.rewriteRefOfAlterIndex(parent, node, replacer)
 	case *AlterMigration:
 		return a.rewriteRefOfAlterMigration(parent, node, replacer)
+	case *AlterProcedure:
+		return a.rewriteRefOfAlterProcedure(parent, node, replacer)
 	case *AlterTable:
 		return a.rewriteRefOfAlterTable(parent, node, replacer)
+	case *AlterUser:
+		return a.rewriteRefOfAlterUser(parent, node, replacer)
 	case *AlterView:
 		return a.rewriteRefOfAlterView(parent, node, replacer)
 	case *AlterVschema:
@@ -60,12 +70,18 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfArgumentLessWindowExpr(parent, node, replacer)
 	case *AssignmentExpr:
 		return a.rewriteRefOfAssignmentExpr(parent, node, replacer)
+	case *Authentication:
+		return a.rewriteRefOfAuthentication(parent, node, replacer)
 	case *AutoIncSpec:
 		return a.rewriteRefOfAutoIncSpec(parent, node, replacer)
 	case *Avg:
 		return a.rewriteRefOfAvg(parent, node, replacer)
+	case *BadStatement:
+		return a.rewriteRefOfBadStatement(parent, node, replacer)
 	case *Begin:
 		return a.rewriteRefOfBegin(parent, node, replacer)
+	case *BeginEndBlock:
+		return a.rewriteRefOfBeginEndBlock(parent, node, replacer)
 	case *BetweenExpr:
 		return a.rewriteRefOfBetweenExpr(parent, node, replacer)
 	case *BinaryExpr:
@@ -82,6 +98,10 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfCallProc(parent, node, replacer)
 	case *CaseExpr:
 		return a.rewriteRefOfCaseExpr(parent, node, replacer)
+	case *CaseStatement:
+		return a.rewriteRefOfCaseStatement(parent, node, replacer)
+	case *CaseStatementWhen:
+		return a.rewriteRefOfCaseStatementWhen(parent, node, replacer)
 	case *CastExpr:
 		return a.rewriteRefOfCastExpr(parent, node, replacer)
 	case *ChangeColumn:
@@ -90,6 +110,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfCharExpr(parent, node, replacer)
 	case *CheckConstraintDefinition:
 		return a.rewriteRefOfCheckConstraintDefinition(parent, node, replacer)
+	case *CloseCursor:
+		return a.rewriteRefOfCloseCursor(parent, node, replacer)
 	case *ColName:
 		return a.rewriteRefOfColName(parent, node, replacer)
 	case *CollateExpr:
@@ -108,6 +130,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfCommonTableExpr(parent, node, replacer)
 	case *ComparisonExpr:
 		return a.rewriteRefOfComparisonExpr(parent, node, replacer)
+	case *ConditionValue:
+		return a.rewriteRefOfConditionValue(parent, node, replacer)
 	case *ConstraintDefinition:
 		return a.rewriteRefOfConstraintDefinition(parent, node, replacer)
 	case *ConvertExpr:
@@ -122,8 +146,20 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfCountStar(parent, node, replacer)
 	case *CreateDatabase:
 		return a.rewriteRefOfCreateDatabase(parent, node, replacer)
+	case *CreateEvent:
+		return a.rewriteRefOfCreateEvent(parent, node, replacer)
+	case *CreateFunction:
+		return a.rewriteRefOfCreateFunction(parent, node, replacer)
+	case *CreateProcedure:
+		return a.rewriteRefOfCreateProcedure(parent, node, replacer)
+	case *CreateRole:
+		return a.rewriteRefOfCreateRole(parent, node, replacer)
 	case *CreateTable:
 		return a.rewriteRefOfCreateTable(parent, node, replacer)
+	case *CreateTrigger:
+		return a.rewriteRefOfCreateTrigger(parent, node, replacer)
+	case *CreateUser:
+		return a.rewriteRefOfCreateUser(parent, node, replacer)
 	case *CreateView:
 		return a.rewriteRefOfCreateView(parent, node, replacer)
 	case *CurTimeFuncExpr:
@@ -134,6 +170,14 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfDateSubExpr(parent, node, replacer)
 	case *DeallocateStmt:
 		return a.rewriteRefOfDeallocateStmt(parent, node, replacer)
+	case *DeclareCondition:
+		return a.rewriteRefOfDeclareCondition(parent, node, replacer)
+	case *DeclareCursor:
+		return a.rewriteRefOfDeclareCursor(parent, node, replacer)
+	case *DeclareHandler:
+		return a.rewriteRefOfDeclareHandler(parent, node, replacer)
+	case *DeclareVar:
+		return a.rewriteRefOfDeclareVar(parent, node, replacer)
 	case *Default:
 		return a.rewriteRefOfDefault(parent, node, replacer)
 	case *Definer:
@@ -146,12 +190,28 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfDropColumn(parent, node, replacer)
 	case *DropDatabase:
 		return a.rewriteRefOfDropDatabase(parent, node, replacer)
+	case *DropEvent:
+		return a.rewriteRefOfDropEvent(parent, node, replacer)
+	case *DropFunction:
+		return a.rewriteRefOfDropFunction(parent, node, replacer)
 	case *DropKey:
 		return a.rewriteRefOfDropKey(parent, node, replacer)
+	case *DropProcedure:
+		return a.rewriteRefOfDropProcedure(parent, node, replacer)
+	case *DropRole:
+		return a.rewriteRefOfDropRole(parent, node, replacer)
 	case *DropTable:
 		return a.rewriteRefOfDropTable(parent, node, replacer)
+	case *DropTrigger:
+		return a.rewriteRefOfDropTrigger(parent, node, replacer)
+	case *DropUser:
+		return a.rewriteRefOfDropUser(parent, node, replacer)
 	case *DropView:
 		return a.rewriteRefOfDropView(parent, node, replacer)
+	case *ElseIfBlock:
+		return a.rewriteRefOfElseIfBlock(parent, node, replacer)
+	case *EventSchedule:
+		return a.rewriteRefOfEventSchedule(parent, node, replacer)
 	case *ExecuteStmt:
 		return a.rewriteRefOfExecuteStmt(parent, node, replacer)
 	case *ExistsExpr:
@@ -168,6 +228,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfExtractValueExpr(parent, node, replacer)
 	case *ExtractedSubquery:
 		return a.rewriteRefOfExtractedSubquery(parent, node, replacer)
+	case *FetchCursor:
+		return a.rewriteRefOfFetchCursor(parent, node, replacer)
 	case *FirstOrLastValueExpr:
 		return a.rewriteRefOfFirstOrLastValueExpr(parent, node, replacer)
 	case *Flush:
@@ -206,6 +268,10 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfGeomFromWKBExpr(parent, node, replacer)
 	case *GeomPropertyFuncExpr:
 		return a.rewriteRefOfGeomPropertyFuncExpr(parent, node, replacer)
+	case *Grant:
+		return a.rewriteRefOfGrant(parent, node, replacer)
+	case *GrantPrivilege:
+		return a.rewriteRefOfGrantPrivilege(parent, node, replacer)
 	case GroupBy:
 		return a.rewriteGroupBy(parent, node, replacer)
 	case *GroupConcatExpr:
@@ -214,6 +280,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteIdentifierCI(parent, node, replacer)
 	case IdentifierCS:
 		return a.rewriteIdentifierCS(parent, node, replacer)
+	case *IfStatement:
+		return a.rewriteRefOfIfStatement(parent, node, replacer)
 	case *IndexDefinition:
 		return a.rewriteRefOfIndexDefinition(parent, node, replacer)
 	case *IndexHint:
@@ -232,6 +300,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfIntroducerExpr(parent, node, replacer)
 	case *IsExpr:
 		return a.rewriteRefOfIsExpr(parent, node, replacer)
+	case *IterateStatement:
+		return a.rewriteRefOfIterateStatement(parent, node, replacer)
 	case *JSONArrayExpr:
 		return a.rewriteRefOfJSONArrayExpr(parent, node, replacer)
 	case *JSONAttributesExpr:
@@ -288,6 +358,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfKeyState(parent, node, replacer)
 	case *LagLeadExpr:
 		return a.rewriteRefOfLagLeadExpr(parent, node, replacer)
+	case *LeaveStatement:
+		return a.rewriteRefOfLeaveStatement(parent, node, replacer)
 	case *Limit:
 		return a.rewriteRefOfLimit(parent, node, replacer)
 	case *LineStringExpr:
@@ -300,6 +372,10 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfLiteral(parent, node, replacer)
 	case *Load:
 		return a.rewriteRefOfLoad(parent, node, replacer)
+	case *LoadFields:
+		return a.rewriteRefOfLoadFields(parent, node, replacer)
+	case *LoadLines:
+		return a.rewriteRefOfLoadLines(parent, node, replacer)
 	case *LocateExpr:
 		return a.rewriteRefOfLocateExpr(parent, node, replacer)
 	case *LockOption:
@@ -308,6 +384,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfLockTables(parent, node, replacer)
 	case *LockingFunc:
 		return a.rewriteRefOfLockingFunc(parent, node, replacer)
+	case *LoopStatement:
+		return a.rewriteRefOfLoopStatement(parent, node, replacer)
 	case MatchAction:
 		return a.rewriteMatchAction(parent, node, replacer)
 	case *MatchExpr:
@@ -344,8 +422,12 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfNullVal(parent, node, replacer)
 	case *Offset:
 		return a.rewriteRefOfOffset(parent, node, replacer)
+	case *OnConflict:
+		return a.rewriteRefOfOnConflict(parent, node, replacer)
 	case OnDup:
 		return a.rewriteOnDup(parent, node, replacer)
+	case *OpenCursor:
+		return a.rewriteRefOfOpenCursor(parent, node, replacer)
 	case *OptLike:
 		return a.rewriteRefOfOptLike(parent, node, replacer)
 	case *OrExpr:
@@ -380,6 +462,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfPartitionValueRange(parent, node, replacer)
 	case Partitions:
 		return a.rewritePartitions(parent, node, replacer)
+	case *PasswordExpire:
+		return a.rewriteRefOfPasswordExpire(parent, node, replacer)
 	case *PerformanceSchemaFuncExpr:
 		return a.rewriteRefOfPerformanceSchemaFuncExpr(parent, node, replacer)
 	case *PointExpr:
@@ -390,8 +474,14 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfPolygonExpr(parent, node, replacer)
 	case *PolygonPropertyFuncExpr:
 		return a.rewriteRefOfPolygonPropertyFuncExpr(parent, node, replacer)
+	case *PositionalArg:
+		return a.rewriteRefOfPositionalArg(parent, node, replacer)
 	case *PrepareStmt:
 		return a.rewriteRefOfPrepareStmt(parent, node, replacer)
+	case *PrivilegeLevel:
+		return a.rewriteRefOfPrivilegeLevel(parent, node, replacer)
+	case *ProcParameter:
+		return a.rewriteRefOfProcParameter(parent, node, replacer)
 	case *PurgeBinaryLogs:
 		return a.rewriteRefOfPurgeBinaryLogs(parent, node, replacer)
 	case ReferenceAction:
@@ -416,12 +506,30 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfRenameTable(parent, node, replacer)
 	case *RenameTableName:
 		return a.rewriteRefOfRenameTableName(parent, node, replacer)
+	case *RenameUser:
+		return a.rewriteRefOfRenameUser(parent, node, replacer)
+	case *RenameUserPair:
+		return a.rewriteRefOfRenameUserPair(parent, node, replacer)
+	case *RepeatStatement:
+		return a.rewriteRefOfRepeatStatement(parent, node, replacer)
+	case *ReturnStatement:
+		return a.rewriteRefOfReturnStatement(parent, node, replacer)
 	case *RevertMigration:
 		return a.rewriteRefOfRevertMigration(parent, node, replacer)
+	case *Revoke:
+		return a.rewriteRefOfRevoke(parent, node, replacer)
 	case *Rollback:
 		return a.rewriteRefOfRollback(parent, node, replacer)
 	case RootNode:
 		return a.rewriteRootNode(parent, node, replacer)
+	case *RoutineCharacteristic:
+		return a.rewriteRefOfRoutineCharacteristic(parent, node, replacer)
+	case *RoutineSet:
+		return a.rewriteRefOfRoutineSet(parent, node, replacer)
+	case *RoutineSetExpr:
+		return a.rewriteRefOfRoutineSetExpr(parent, node, replacer)
+	case RoutineSetExprs:
+		return a.rewriteRoutineSetExprs(parent, node, replacer)
 	case *SRollback:
 		return a.rewriteRefOfSRollback(parent, node, replacer)
 	case *Savepoint:
@@ -434,10 +542,16 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfSelectInto(parent, node, replacer)
 	case *Set:
 		return a.rewriteRefOfSet(parent, node, replacer)
+	case *SetDefaultRole:
+		return a.rewriteRefOfSetDefaultRole(parent, node, replacer)
 	case *SetExpr:
 		return a.rewriteRefOfSetExpr(parent, node, replacer)
 	case SetExprs:
 		return a.rewriteSetExprs(parent, node, replacer)
+	case *SetPassword:
+		return a.rewriteRefOfSetPassword(parent, node, replacer)
+	case *SetRole:
+		return a.rewriteRefOfSetRole(parent, node, replacer)
 	case *Show:
 		return a.rewriteRefOfShow(parent, node, replacer)
 	case *ShowBasic:
@@ -454,8 +568,14 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfShowThrottledApps(parent, node, replacer)
 	case *ShowThrottlerStatus:
 		return a.rewriteRefOfShowThrottlerStatus(parent, node, replacer)
+	case *Signal:
+		return a.rewriteRefOfSignal(parent, node, replacer)
+	case *SignalItem:
+		return a.rewriteRefOfSignalItem(parent, node, replacer)
 	case *StarExpr:
 		return a.rewriteRefOfStarExpr(parent, node, replacer)
+	case StatementList:
+		return a.rewriteStatementList(parent, node, replacer)
 	case *Std:
 		return a.rewriteRefOfStd(parent, node, replacer)
 	case *StdDev:
@@ -494,10 +614,14 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfTablespaceOperation(parent, node, replacer)
 	case *TimestampFuncExpr:
 		return a.rewriteRefOfTimestampFuncExpr(parent, node, replacer)
+	case *TriggerOrder:
+		return a.rewriteRefOfTriggerOrder(parent, node, replacer)
 	case *TrimFuncExpr:
 		return a.rewriteRefOfTrimFuncExpr(parent, node, replacer)
 	case *TruncateTable:
 		return a.rewriteRefOfTruncateTable(parent, node, replacer)
+	case *TypeCastExpr:
+		return a.rewriteRefOfTypeCastExpr(parent, node, replacer)
 	case *UnaryExpr:
 		return a.rewriteRefOfUnaryExpr(parent, node, replacer)
 	case *Union:
@@ -514,6 +638,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfUpdateXMLExpr(parent, node, replacer)
 	case *Use:
 		return a.rewriteRefOfUse(parent, node, replacer)
+	case *UserSpec:
+		return a.rewriteRefOfUserSpec(parent, node, replacer)
 	case *VExplainStmt:
 		return a.rewriteRefOfVExplainStmt(parent, node, replacer)
 	case *VStream:
@@ -544,6 +670,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfWhen(parent, node, replacer)
 	case *Where:
 		return a.rewriteRefOfWhere(parent, node, replacer)
+	case *WhileStatement:
+		return a.rewriteRefOfWhileStatement(parent, node, replacer)
 	case *WindowDefinition:
 		return a.rewriteRefOfWindowDefinition(parent, node, replacer)
 	case WindowDefinitions:
@@ -559,6 +687,30 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return true
 	}
 }
+func (a *application) rewriteRefOfAccount(parent SQLNode, node *Account, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfAddColumns(parent SQLNode, node *AddColumns, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -838,6 +990,104 @@ func (a *application) rewriteRefOfAlterDatabase(parent SQLNode, node *AlterDatab
 	}
 	return true
 }
+func (a *application) rewriteRefOfAlterEvent(parent SQLNode, node *AlterEvent, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*AlterEvent).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfDefiner(node, node.Definer, func(newNode, parent SQLNode) {
+		parent.(*AlterEvent).Definer = newNode.(*Definer)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*AlterEvent).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfEventSchedule(node, node.Schedule, func(newNode, parent SQLNode) {
+		parent.(*AlterEvent).Schedule = newNode.(*EventSchedule)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.RenameTo, func(newNode, parent SQLNode) {
+		parent.(*AlterEvent).RenameTo = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.Comment, func(newNode, parent SQLNode) {
+		parent.(*AlterEvent).Comment = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteStatement(node, node.Body, func(newNode, parent SQLNode) {
+		parent.(*AlterEvent).Body = newNode.(Statement)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfAlterFunction(parent SQLNode, node *AlterFunction, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*AlterFunction).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*AlterFunction).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	for x, el := range node.Characteristics {
+		if !a.rewriteRefOfRoutineCharacteristic(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*AlterFunction).Characteristics[idx] = newNode.(*RoutineCharacteristic)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfAlterIndex(parent SQLNode, node *AlterIndex, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -892,6 +1142,47 @@ func (a *application) rewriteRefOfAlterMigration(parent SQLNode, node *AlterMigr
 	}
 	return true
 }
+func (a *application) rewriteRefOfAlterProcedure(parent SQLNode, node *AlterProcedure, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*AlterProcedure).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*AlterProcedure).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	for x, el := range node.Characteristics {
+		if !a.rewriteRefOfRoutineCharacteristic(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*AlterProcedure).Characteristics[idx] = newNode.(*RoutineCharacteristic)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfAlterTable(parent SQLNode, node *AlterTable, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -943,6 +1234,42 @@ func (a *application) rewriteRefOfAlterTable(parent SQLNode, node *AlterTable, r
 	}
 	return true
 }
+func (a *application) rewriteRefOfAlterUser(parent SQLNode, node *AlterUser, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	for x, el := range node.Users {
+		if !a.rewriteRefOfUserSpec(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*AlterUser).Users[idx] = newNode.(*UserSpec)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteRefOfPasswordExpire(node, node.PasswordExpire, func(newNode, parent SQLNode) {
+		parent.(*AlterUser).PasswordExpire = newNode.(*PasswordExpire)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfAlterView(parent SQLNode, node *AlterView, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -1151,6 +1478,43 @@ func (a *application) rewriteRefOfAssignmentExpr(parent SQLNode, node *Assignmen
 	}
 	return true
 }
+func (a *application) rewriteRefOfAuthentication(parent SQLNode, node *Authentication, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteIdentifierCI(node, node.Plugin, func(newNode, parent SQLNode) {
+		parent.(*Authentication).Plugin = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.Password, func(newNode, parent SQLNode) {
+		parent.(*Authentication).Password = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.CurrentPassword, func(newNode, parent SQLNode) {
+		parent.(*Authentication).CurrentPassword = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfAutoIncSpec(parent SQLNode, node *AutoIncSpec, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -1210,6 +1574,30 @@ func (a *application) rewriteRefOfAvg(parent SQLNode, node *Avg, replacer replac
 	}
 	return true
 }
+func (a *application) rewriteRefOfBadStatement(parent SQLNode, node *BadStatement, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfBegin(parent SQLNode, node *Begin, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -1234,6 +1622,38 @@ func (a *application) rewriteRefOfBegin(parent SQLNode, node *Begin, replacer re
 	}
 	return true
 }
+func (a *application) rewriteRefOfBeginEndBlock(parent SQLNode, node *BeginEndBlock, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteIdentifierCI(node, node.Label, func(newNode, parent SQLNode) {
+		parent.(*BeginEndBlock).Label = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
+	if !a.rewriteStatementList(node, node.Statements, func(newNode, parent SQLNode) {
+		parent.(*BeginEndBlock).Statements = newNode.(StatementList)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfBetweenExpr(parent SQLNode, node *BetweenExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -1457,7 +1877,7 @@ func (a *application) rewriteRefOfCaseExpr(parent SQLNode, node *CaseExpr, repla
 	}
 	return true
 }
-func (a *application) rewriteRefOfCastExpr(parent SQLNode, node *CastExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCaseStatement(parent SQLNode, node *CaseStatement, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1470,12 +1890,85 @@ func (a *application) rewriteRefOfCastExpr(parent SQLNode, node *CastExpr, repla
 		}
 	}
 	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*CastExpr).Expr = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfConvertType(node, node.Type, func(newNode, parent SQLNode) {
-		parent.(*CastExpr).Type = newNode.(*ConvertType)
+		parent.(*CaseStatement).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	for x, el := range node.Whens {
+		if !a.rewriteRefOfCaseStatementWhen(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CaseStatement).Whens[idx] = newNode.(*CaseStatementWhen)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteStatementList(node, node.Else, func(newNode, parent SQLNode) {
+		parent.(*CaseStatement).Else = newNode.(StatementList)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfCaseStatementWhen(parent SQLNode, node *CaseStatementWhen, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Cond, func(newNode, parent SQLNode) {
+		parent.(*CaseStatementWhen).Cond = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteStatementList(node, node.Statements, func(newNode, parent SQLNode) {
+		parent.(*CaseStatementWhen).Statements = newNode.(StatementList)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfCastExpr(parent SQLNode, node *CastExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*CastExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfConvertType(node, node.Type, func(newNode, parent SQLNode) {
+		parent.(*CastExpr).Type = newNode.(*ConvertType)
 	}) {
 		return false
 	}
@@ -1580,6 +2073,33 @@ func (a *application) rewriteRefOfCheckConstraintDefinition(parent SQLNode, node
 	}
 	return true
 }
+func (a *application) rewriteRefOfCloseCursor(parent SQLNode, node *CloseCursor, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CloseCursor).Name = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfColName(parent SQLNode, node *ColName, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -1862,6 +2382,33 @@ func (a *application) rewriteRefOfComparisonExpr(parent SQLNode, node *Compariso
 	}
 	return true
 }
+func (a *application) rewriteRefOfConditionValue(parent SQLNode, node *ConditionValue, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*ConditionValue).Name = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfConstraintDefinition(parent SQLNode, node *ConstraintDefinition, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -2068,7 +2615,7 @@ func (a *application) rewriteRefOfCreateDatabase(parent SQLNode, node *CreateDat
 	}
 	return true
 }
-func (a *application) rewriteRefOfCreateTable(parent SQLNode, node *CreateTable, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateEvent(parent SQLNode, node *CreateEvent, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2080,23 +2627,33 @@ func (a *application) rewriteRefOfCreateTable(parent SQLNode, node *CreateTable,
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
-		parent.(*CreateTable).Table = newNode.(TableName)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateEvent).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfTableSpec(node, node.TableSpec, func(newNode, parent SQLNode) {
-		parent.(*CreateTable).TableSpec = newNode.(*TableSpec)
+	if !a.rewriteRefOfDefiner(node, node.Definer, func(newNode, parent SQLNode) {
+		parent.(*CreateEvent).Definer = newNode.(*Definer)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfOptLike(node, node.OptLike, func(newNode, parent SQLNode) {
-		parent.(*CreateTable).OptLike = newNode.(*OptLike)
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreateEvent).Name = newNode.(TableName)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*CreateTable).Comments = newNode.(*ParsedComments)
+	if !a.rewriteRefOfEventSchedule(node, node.Schedule, func(newNode, parent SQLNode) {
+		parent.(*CreateEvent).Schedule = newNode.(*EventSchedule)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.Comment, func(newNode, parent SQLNode) {
+		parent.(*CreateEvent).Comment = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteStatement(node, node.Body, func(newNode, parent SQLNode) {
+		parent.(*CreateEvent).Body = newNode.(Statement)
 	}) {
 		return false
 	}
@@ -2110,7 +2667,7 @@ func (a *application) rewriteRefOfCreateTable(parent SQLNode, node *CreateTable,
 	}
 	return true
 }
-func (a *application) rewriteRefOfCreateView(parent SQLNode, node *CreateView, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateFunction(parent SQLNode, node *CreateFunction, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2122,28 +2679,46 @@ func (a *application) rewriteRefOfCreateView(parent SQLNode, node *CreateView, r
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.ViewName, func(newNode, parent SQLNode) {
-		parent.(*CreateView).ViewName = newNode.(TableName)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateFunction).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
 	if !a.rewriteRefOfDefiner(node, node.Definer, func(newNode, parent SQLNode) {
-		parent.(*CreateView).Definer = newNode.(*Definer)
+		parent.(*CreateFunction).Definer = newNode.(*Definer)
 	}) {
 		return false
 	}
-	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
-		parent.(*CreateView).Columns = newNode.(Columns)
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreateFunction).Name = newNode.(TableName)
 	}) {
 		return false
 	}
-	if !a.rewriteSelectStatement(node, node.Select, func(newNode, parent SQLNode) {
-		parent.(*CreateView).Select = newNode.(SelectStatement)
+	for x, el := range node.Params {
+		if !a.rewriteRefOfProcParameter(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateFunction).Params[idx] = newNode.(*ProcParameter)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteRefOfColumnType(node, node.Returns, func(newNode, parent SQLNode) {
+		parent.(*CreateFunction).Returns = newNode.(*ColumnType)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*CreateView).Comments = newNode.(*ParsedComments)
+	for x, el := range node.Characteristics {
+		if !a.rewriteRefOfRoutineCharacteristic(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateFunction).Characteristics[idx] = newNode.(*RoutineCharacteristic)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteStatement(node, node.Body, func(newNode, parent SQLNode) {
+		parent.(*CreateFunction).Body = newNode.(Statement)
 	}) {
 		return false
 	}
@@ -2157,7 +2732,7 @@ func (a *application) rewriteRefOfCreateView(parent SQLNode, node *CreateView, r
 	}
 	return true
 }
-func (a *application) rewriteRefOfCurTimeFuncExpr(parent SQLNode, node *CurTimeFuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateProcedure(parent SQLNode, node *CreateProcedure, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2169,8 +2744,41 @@ func (a *application) rewriteRefOfCurTimeFuncExpr(parent SQLNode, node *CurTimeF
 			return true
 		}
 	}
-	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*CurTimeFuncExpr).Name = newNode.(IdentifierCI)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateProcedure).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfDefiner(node, node.Definer, func(newNode, parent SQLNode) {
+		parent.(*CreateProcedure).Definer = newNode.(*Definer)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreateProcedure).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	for x, el := range node.Params {
+		if !a.rewriteRefOfProcParameter(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateProcedure).Params[idx] = newNode.(*ProcParameter)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	for x, el := range node.Characteristics {
+		if !a.rewriteRefOfRoutineCharacteristic(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateProcedure).Characteristics[idx] = newNode.(*RoutineCharacteristic)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteStatement(node, node.Body, func(newNode, parent SQLNode) {
+		parent.(*CreateProcedure).Body = newNode.(Statement)
 	}) {
 		return false
 	}
@@ -2184,7 +2792,7 @@ func (a *application) rewriteRefOfCurTimeFuncExpr(parent SQLNode, node *CurTimeF
 	}
 	return true
 }
-func (a *application) rewriteRefOfDateAddExpr(parent SQLNode, node *DateAddExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateRole(parent SQLNode, node *CreateRole, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2196,15 +2804,14 @@ func (a *application) rewriteRefOfDateAddExpr(parent SQLNode, node *DateAddExpr,
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Date, func(newNode, parent SQLNode) {
-		parent.(*DateAddExpr).Date = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*DateAddExpr).Expr = newNode.(Expr)
-	}) {
-		return false
+	for x, el := range node.Roles {
+		if !a.rewriteRefOfAccount(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateRole).Roles[idx] = newNode.(*Account)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -2216,7 +2823,7 @@ func (a *application) rewriteRefOfDateAddExpr(parent SQLNode, node *DateAddExpr,
 	}
 	return true
 }
-func (a *application) rewriteRefOfDateSubExpr(parent SQLNode, node *DateSubExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateTable(parent SQLNode, node *CreateTable, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2228,13 +2835,23 @@ func (a *application) rewriteRefOfDateSubExpr(parent SQLNode, node *DateSubExpr,
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Date, func(newNode, parent SQLNode) {
-		parent.(*DateSubExpr).Date = newNode.(Expr)
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*CreateTable).Table = newNode.(TableName)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*DateSubExpr).Expr = newNode.(Expr)
+	if !a.rewriteRefOfTableSpec(node, node.TableSpec, func(newNode, parent SQLNode) {
+		parent.(*CreateTable).TableSpec = newNode.(*TableSpec)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfOptLike(node, node.OptLike, func(newNode, parent SQLNode) {
+		parent.(*CreateTable).OptLike = newNode.(*OptLike)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateTable).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
@@ -2248,7 +2865,7 @@ func (a *application) rewriteRefOfDateSubExpr(parent SQLNode, node *DateSubExpr,
 	}
 	return true
 }
-func (a *application) rewriteRefOfDeallocateStmt(parent SQLNode, node *DeallocateStmt, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateTrigger(parent SQLNode, node *CreateTrigger, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2261,12 +2878,32 @@ func (a *application) rewriteRefOfDeallocateStmt(parent SQLNode, node *Deallocat
 		}
 	}
 	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*DeallocateStmt).Comments = newNode.(*ParsedComments)
+		parent.(*CreateTrigger).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
-	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*DeallocateStmt).Name = newNode.(IdentifierCI)
+	if !a.rewriteRefOfDefiner(node, node.Definer, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).Definer = newNode.(*Definer)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).Table = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfTriggerOrder(node, node.Order, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).Order = newNode.(*TriggerOrder)
+	}) {
+		return false
+	}
+	if !a.rewriteStatement(node, node.Body, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).Body = newNode.(Statement)
 	}) {
 		return false
 	}
@@ -2280,7 +2917,7 @@ func (a *application) rewriteRefOfDeallocateStmt(parent SQLNode, node *Deallocat
 	}
 	return true
 }
-func (a *application) rewriteRefOfDefault(parent SQLNode, node *Default, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateUser(parent SQLNode, node *CreateUser, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2292,43 +2929,40 @@ func (a *application) rewriteRefOfDefault(parent SQLNode, node *Default, replace
 			return true
 		}
 	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
+	for x, el := range node.Users {
+		if !a.rewriteRefOfUserSpec(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateUser).Users[idx] = newNode.(*UserSpec)
+			}
+		}(x)) {
+			return false
 		}
-		if !a.post(&a.cur) {
+	}
+	for x, el := range node.DefaultRoles {
+		if !a.rewriteRefOfAccount(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateUser).DefaultRoles[idx] = newNode.(*Account)
+			}
+		}(x)) {
 			return false
 		}
 	}
-	return true
-}
-func (a *application) rewriteRefOfDefiner(parent SQLNode, node *Definer, replacer replacerFunc) bool {
-	if node == nil {
-		return true
+	if !a.rewriteRefOfPasswordExpire(node, node.PasswordExpire, func(newNode, parent SQLNode) {
+		parent.(*CreateUser).PasswordExpire = newNode.(*PasswordExpire)
+	}) {
+		return false
 	}
-	if a.pre != nil {
+	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
-			return true
-		}
-	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfDelete(parent SQLNode, node *Delete, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateView(parent SQLNode, node *CreateView, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2340,43 +2974,28 @@ func (a *application) rewriteRefOfDelete(parent SQLNode, node *Delete, replacer
 			return true
 		}
 	}
-	if !a.rewriteRefOfWith(node, node.With, func(newNode, parent SQLNode) {
-		parent.(*Delete).With = newNode.(*With)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*Delete).Comments = newNode.(*ParsedComments)
-	}) {
-		return false
-	}
-	if !a.rewriteTableNames(node, node.Targets, func(newNode, parent SQLNode) {
-		parent.(*Delete).Targets = newNode.(TableNames)
-	}) {
-		return false
-	}
-	if !a.rewriteTableExprs(node, node.TableExprs, func(newNode, parent SQLNode) {
-		parent.(*Delete).TableExprs = newNode.(TableExprs)
+	if !a.rewriteTableName(node, node.ViewName, func(newNode, parent SQLNode) {
+		parent.(*CreateView).ViewName = newNode.(TableName)
 	}) {
 		return false
 	}
-	if !a.rewritePartitions(node, node.Partitions, func(newNode, parent SQLNode) {
-		parent.(*Delete).Partitions = newNode.(Partitions)
+	if !a.rewriteRefOfDefiner(node, node.Definer, func(newNode, parent SQLNode) {
+		parent.(*CreateView).Definer = newNode.(*Definer)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfWhere(node, node.Where, func(newNode, parent SQLNode) {
-		parent.(*Delete).Where = newNode.(*Where)
+	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*CreateView).Columns = newNode.(Columns)
 	}) {
 		return false
 	}
-	if !a.rewriteOrderBy(node, node.OrderBy, func(newNode, parent SQLNode) {
-		parent.(*Delete).OrderBy = newNode.(OrderBy)
+	if !a.rewriteSelectStatement(node, node.Select, func(newNode, parent SQLNode) {
+		parent.(*CreateView).Select = newNode.(SelectStatement)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfLimit(node, node.Limit, func(newNode, parent SQLNode) {
-		parent.(*Delete).Limit = newNode.(*Limit)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateView).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
@@ -2390,7 +3009,7 @@ func (a *application) rewriteRefOfDelete(parent SQLNode, node *Delete, replacer
 	}
 	return true
 }
-func (a *application) rewriteRefOfDerivedTable(parent SQLNode, node *DerivedTable, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCurTimeFuncExpr(parent SQLNode, node *CurTimeFuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2402,8 +3021,8 @@ func (a *application) rewriteRefOfDerivedTable(parent SQLNode, node *DerivedTabl
 			return true
 		}
 	}
-	if !a.rewriteSelectStatement(node, node.Select, func(newNode, parent SQLNode) {
-		parent.(*DerivedTable).Select = newNode.(SelectStatement)
+	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CurTimeFuncExpr).Name = newNode.(IdentifierCI)
 	}) {
 		return false
 	}
@@ -2417,7 +3036,7 @@ func (a *application) rewriteRefOfDerivedTable(parent SQLNode, node *DerivedTabl
 	}
 	return true
 }
-func (a *application) rewriteRefOfDropColumn(parent SQLNode, node *DropColumn, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDateAddExpr(parent SQLNode, node *DateAddExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2429,8 +3048,13 @@ func (a *application) rewriteRefOfDropColumn(parent SQLNode, node *DropColumn, r
 			return true
 		}
 	}
-	if !a.rewriteRefOfColName(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*DropColumn).Name = newNode.(*ColName)
+	if !a.rewriteExpr(node, node.Date, func(newNode, parent SQLNode) {
+		parent.(*DateAddExpr).Date = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*DateAddExpr).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -2444,7 +3068,7 @@ func (a *application) rewriteRefOfDropColumn(parent SQLNode, node *DropColumn, r
 	}
 	return true
 }
-func (a *application) rewriteRefOfDropDatabase(parent SQLNode, node *DropDatabase, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDateSubExpr(parent SQLNode, node *DateSubExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2456,13 +3080,13 @@ func (a *application) rewriteRefOfDropDatabase(parent SQLNode, node *DropDatabas
 			return true
 		}
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*DropDatabase).Comments = newNode.(*ParsedComments)
+	if !a.rewriteExpr(node, node.Date, func(newNode, parent SQLNode) {
+		parent.(*DateSubExpr).Date = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteIdentifierCS(node, node.DBName, func(newNode, parent SQLNode) {
-		parent.(*DropDatabase).DBName = newNode.(IdentifierCS)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*DateSubExpr).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -2476,7 +3100,7 @@ func (a *application) rewriteRefOfDropDatabase(parent SQLNode, node *DropDatabas
 	}
 	return true
 }
-func (a *application) rewriteRefOfDropKey(parent SQLNode, node *DropKey, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDeallocateStmt(parent SQLNode, node *DeallocateStmt, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2488,8 +3112,13 @@ func (a *application) rewriteRefOfDropKey(parent SQLNode, node *DropKey, replace
 			return true
 		}
 	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*DeallocateStmt).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
 	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*DropKey).Name = newNode.(IdentifierCI)
+		parent.(*DeallocateStmt).Name = newNode.(IdentifierCI)
 	}) {
 		return false
 	}
@@ -2503,7 +3132,7 @@ func (a *application) rewriteRefOfDropKey(parent SQLNode, node *DropKey, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfDropTable(parent SQLNode, node *DropTable, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDeclareCondition(parent SQLNode, node *DeclareCondition, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2515,13 +3144,13 @@ func (a *application) rewriteRefOfDropTable(parent SQLNode, node *DropTable, rep
 			return true
 		}
 	}
-	if !a.rewriteTableNames(node, node.FromTables, func(newNode, parent SQLNode) {
-		parent.(*DropTable).FromTables = newNode.(TableNames)
+	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*DeclareCondition).Name = newNode.(IdentifierCI)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*DropTable).Comments = newNode.(*ParsedComments)
+	if !a.rewriteRefOfConditionValue(node, node.Value, func(newNode, parent SQLNode) {
+		parent.(*DeclareCondition).Value = newNode.(*ConditionValue)
 	}) {
 		return false
 	}
@@ -2535,7 +3164,7 @@ func (a *application) rewriteRefOfDropTable(parent SQLNode, node *DropTable, rep
 	}
 	return true
 }
-func (a *application) rewriteRefOfDropView(parent SQLNode, node *DropView, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDeclareCursor(parent SQLNode, node *DeclareCursor, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2547,13 +3176,13 @@ func (a *application) rewriteRefOfDropView(parent SQLNode, node *DropView, repla
 			return true
 		}
 	}
-	if !a.rewriteTableNames(node, node.FromTables, func(newNode, parent SQLNode) {
-		parent.(*DropView).FromTables = newNode.(TableNames)
+	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*DeclareCursor).Name = newNode.(IdentifierCI)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*DropView).Comments = newNode.(*ParsedComments)
+	if !a.rewriteSelectStatement(node, node.Select, func(newNode, parent SQLNode) {
+		parent.(*DeclareCursor).Select = newNode.(SelectStatement)
 	}) {
 		return false
 	}
@@ -2567,7 +3196,7 @@ func (a *application) rewriteRefOfDropView(parent SQLNode, node *DropView, repla
 	}
 	return true
 }
-func (a *application) rewriteRefOfExecuteStmt(parent SQLNode, node *ExecuteStmt, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDeclareHandler(parent SQLNode, node *DeclareHandler, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2579,25 +3208,20 @@ func (a *application) rewriteRefOfExecuteStmt(parent SQLNode, node *ExecuteStmt,
 			return true
 		}
 	}
-	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*ExecuteStmt).Name = newNode.(IdentifierCI)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*ExecuteStmt).Comments = newNode.(*ParsedComments)
-	}) {
-		return false
-	}
-	for x, el := range node.Arguments {
-		if !a.rewriteRefOfVariable(node, el, func(idx int) replacerFunc {
+	for x, el := range node.Conditions {
+		if !a.rewriteRefOfConditionValue(node, el, func(idx int) replacerFunc {
 			return func(newNode, parent SQLNode) {
-				parent.(*ExecuteStmt).Arguments[idx] = newNode.(*Variable)
+				parent.(*DeclareHandler).Conditions[idx] = newNode.(*ConditionValue)
 			}
 		}(x)) {
 			return false
 		}
 	}
+	if !a.rewriteStatement(node, node.Statement, func(newNode, parent SQLNode) {
+		parent.(*DeclareHandler).Statement = newNode.(Statement)
+	}) {
+		return false
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -2608,7 +3232,7 @@ func (a *application) rewriteRefOfExecuteStmt(parent SQLNode, node *ExecuteStmt,
 	}
 	return true
 }
-func (a *application) rewriteRefOfExistsExpr(parent SQLNode, node *ExistsExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDeclareVar(parent SQLNode, node *DeclareVar, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2620,8 +3244,18 @@ func (a *application) rewriteRefOfExistsExpr(parent SQLNode, node *ExistsExpr, r
 			return true
 		}
 	}
-	if !a.rewriteRefOfSubquery(node, node.Subquery, func(newNode, parent SQLNode) {
-		parent.(*ExistsExpr).Subquery = newNode.(*Subquery)
+	if !a.rewriteColumns(node, node.Names, func(newNode, parent SQLNode) {
+		parent.(*DeclareVar).Names = newNode.(Columns)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfColumnType(node, node.Type, func(newNode, parent SQLNode) {
+		parent.(*DeclareVar).Type = newNode.(*ColumnType)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Default, func(newNode, parent SQLNode) {
+		parent.(*DeclareVar).Default = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -2635,7 +3269,7 @@ func (a *application) rewriteRefOfExistsExpr(parent SQLNode, node *ExistsExpr, r
 	}
 	return true
 }
-func (a *application) rewriteRefOfExplainStmt(parent SQLNode, node *ExplainStmt, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDefault(parent SQLNode, node *Default, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2647,27 +3281,19 @@ func (a *application) rewriteRefOfExplainStmt(parent SQLNode, node *ExplainStmt,
 			return true
 		}
 	}
-	if !a.rewriteStatement(node, node.Statement, func(newNode, parent SQLNode) {
-		parent.(*ExplainStmt).Statement = newNode.(Statement)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*ExplainStmt).Comments = newNode.(*ParsedComments)
-	}) {
-		return false
-	}
 	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfExplainTab(parent SQLNode, node *ExplainTab, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDefiner(parent SQLNode, node *Definer, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2679,22 +3305,19 @@ func (a *application) rewriteRefOfExplainTab(parent SQLNode, node *ExplainTab, r
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
-		parent.(*ExplainTab).Table = newNode.(TableName)
-	}) {
-		return false
-	}
 	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteExprs(parent SQLNode, node Exprs, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDelete(parent SQLNode, node *Delete, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2702,24 +3325,54 @@ func (a *application) rewriteExprs(parent SQLNode, node Exprs, replacer replacer
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(Exprs)
-			a.cur.revisit = false
-			return a.rewriteExprs(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteExpr(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(Exprs)[idx] = newNode.(Expr)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteRefOfWith(node, node.With, func(newNode, parent SQLNode) {
+		parent.(*Delete).With = newNode.(*With)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*Delete).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteTableNames(node, node.Targets, func(newNode, parent SQLNode) {
+		parent.(*Delete).Targets = newNode.(TableNames)
+	}) {
+		return false
+	}
+	if !a.rewriteTableExprs(node, node.TableExprs, func(newNode, parent SQLNode) {
+		parent.(*Delete).TableExprs = newNode.(TableExprs)
+	}) {
+		return false
+	}
+	if !a.rewritePartitions(node, node.Partitions, func(newNode, parent SQLNode) {
+		parent.(*Delete).Partitions = newNode.(Partitions)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfWhere(node, node.Where, func(newNode, parent SQLNode) {
+		parent.(*Delete).Where = newNode.(*Where)
+	}) {
+		return false
+	}
+	if !a.rewriteOrderBy(node, node.OrderBy, func(newNode, parent SQLNode) {
+		parent.(*Delete).OrderBy = newNode.(OrderBy)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLimit(node, node.Limit, func(newNode, parent SQLNode) {
+		parent.(*Delete).Limit = newNode.(*Limit)
+	}) {
+		return false
+	}
+	if !a.rewriteSelectExprs(node, node.Returning, func(newNode, parent SQLNode) {
+		parent.(*Delete).Returning = newNode.(SelectExprs)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -2731,7 +3384,7 @@ func (a *application) rewriteExprs(parent SQLNode, node Exprs, replacer replacer
 	}
 	return true
 }
-func (a *application) rewriteRefOfExtractFuncExpr(parent SQLNode, node *ExtractFuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDerivedTable(parent SQLNode, node *DerivedTable, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2743,8 +3396,8 @@ func (a *application) rewriteRefOfExtractFuncExpr(parent SQLNode, node *ExtractF
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*ExtractFuncExpr).Expr = newNode.(Expr)
+	if !a.rewriteSelectStatement(node, node.Select, func(newNode, parent SQLNode) {
+		parent.(*DerivedTable).Select = newNode.(SelectStatement)
 	}) {
 		return false
 	}
@@ -2758,7 +3411,7 @@ func (a *application) rewriteRefOfExtractFuncExpr(parent SQLNode, node *ExtractF
 	}
 	return true
 }
-func (a *application) rewriteRefOfExtractValueExpr(parent SQLNode, node *ExtractValueExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropColumn(parent SQLNode, node *DropColumn, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2770,13 +3423,8 @@ func (a *application) rewriteRefOfExtractValueExpr(parent SQLNode, node *Extract
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Fragment, func(newNode, parent SQLNode) {
-		parent.(*ExtractValueExpr).Fragment = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.XPathExpr, func(newNode, parent SQLNode) {
-		parent.(*ExtractValueExpr).XPathExpr = newNode.(Expr)
+	if !a.rewriteRefOfColName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*DropColumn).Name = newNode.(*ColName)
 	}) {
 		return false
 	}
@@ -2790,7 +3438,7 @@ func (a *application) rewriteRefOfExtractValueExpr(parent SQLNode, node *Extract
 	}
 	return true
 }
-func (a *application) rewriteRefOfExtractedSubquery(parent SQLNode, node *ExtractedSubquery, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropDatabase(parent SQLNode, node *DropDatabase, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2802,23 +3450,13 @@ func (a *application) rewriteRefOfExtractedSubquery(parent SQLNode, node *Extrac
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Original, func(newNode, parent SQLNode) {
-		parent.(*ExtractedSubquery).Original = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfSubquery(node, node.Subquery, func(newNode, parent SQLNode) {
-		parent.(*ExtractedSubquery).Subquery = newNode.(*Subquery)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.OtherSide, func(newNode, parent SQLNode) {
-		parent.(*ExtractedSubquery).OtherSide = newNode.(Expr)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*DropDatabase).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.alternative, func(newNode, parent SQLNode) {
-		parent.(*ExtractedSubquery).alternative = newNode.(Expr)
+	if !a.rewriteIdentifierCS(node, node.DBName, func(newNode, parent SQLNode) {
+		parent.(*DropDatabase).DBName = newNode.(IdentifierCS)
 	}) {
 		return false
 	}
@@ -2832,7 +3470,7 @@ func (a *application) rewriteRefOfExtractedSubquery(parent SQLNode, node *Extrac
 	}
 	return true
 }
-func (a *application) rewriteRefOfFirstOrLastValueExpr(parent SQLNode, node *FirstOrLastValueExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropEvent(parent SQLNode, node *DropEvent, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2844,18 +3482,13 @@ func (a *application) rewriteRefOfFirstOrLastValueExpr(parent SQLNode, node *Fir
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*FirstOrLastValueExpr).Expr = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfNullTreatmentClause(node, node.NullTreatmentClause, func(newNode, parent SQLNode) {
-		parent.(*FirstOrLastValueExpr).NullTreatmentClause = newNode.(*NullTreatmentClause)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*DropEvent).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfOverClause(node, node.OverClause, func(newNode, parent SQLNode) {
-		parent.(*FirstOrLastValueExpr).OverClause = newNode.(*OverClause)
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*DropEvent).Name = newNode.(TableName)
 	}) {
 		return false
 	}
@@ -2869,7 +3502,7 @@ func (a *application) rewriteRefOfFirstOrLastValueExpr(parent SQLNode, node *Fir
 	}
 	return true
 }
-func (a *application) rewriteRefOfFlush(parent SQLNode, node *Flush, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropFunction(parent SQLNode, node *DropFunction, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2881,8 +3514,13 @@ func (a *application) rewriteRefOfFlush(parent SQLNode, node *Flush, replacer re
 			return true
 		}
 	}
-	if !a.rewriteTableNames(node, node.TableNames, func(newNode, parent SQLNode) {
-		parent.(*Flush).TableNames = newNode.(TableNames)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*DropFunction).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*DropFunction).Name = newNode.(TableName)
 	}) {
 		return false
 	}
@@ -2896,7 +3534,7 @@ func (a *application) rewriteRefOfFlush(parent SQLNode, node *Flush, replacer re
 	}
 	return true
 }
-func (a *application) rewriteRefOfForce(parent SQLNode, node *Force, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropKey(parent SQLNode, node *DropKey, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2908,19 +3546,22 @@ func (a *application) rewriteRefOfForce(parent SQLNode, node *Force, replacer re
 			return true
 		}
 	}
+	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*DropKey).Name = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfForeignKeyDefinition(parent SQLNode, node *ForeignKeyDefinition, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropProcedure(parent SQLNode, node *DropProcedure, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2932,18 +3573,13 @@ func (a *application) rewriteRefOfForeignKeyDefinition(parent SQLNode, node *For
 			return true
 		}
 	}
-	if !a.rewriteColumns(node, node.Source, func(newNode, parent SQLNode) {
-		parent.(*ForeignKeyDefinition).Source = newNode.(Columns)
-	}) {
-		return false
-	}
-	if !a.rewriteIdentifierCI(node, node.IndexName, func(newNode, parent SQLNode) {
-		parent.(*ForeignKeyDefinition).IndexName = newNode.(IdentifierCI)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*DropProcedure).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfReferenceDefinition(node, node.ReferenceDefinition, func(newNode, parent SQLNode) {
-		parent.(*ForeignKeyDefinition).ReferenceDefinition = newNode.(*ReferenceDefinition)
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*DropProcedure).Name = newNode.(TableName)
 	}) {
 		return false
 	}
@@ -2957,7 +3593,7 @@ func (a *application) rewriteRefOfForeignKeyDefinition(parent SQLNode, node *For
 	}
 	return true
 }
-func (a *application) rewriteRefOfFrameClause(parent SQLNode, node *FrameClause, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropRole(parent SQLNode, node *DropRole, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2969,15 +3605,14 @@ func (a *application) rewriteRefOfFrameClause(parent SQLNode, node *FrameClause,
 			return true
 		}
 	}
-	if !a.rewriteRefOfFramePoint(node, node.Start, func(newNode, parent SQLNode) {
-		parent.(*FrameClause).Start = newNode.(*FramePoint)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfFramePoint(node, node.End, func(newNode, parent SQLNode) {
-		parent.(*FrameClause).End = newNode.(*FramePoint)
-	}) {
-		return false
+	for x, el := range node.Roles {
+		if !a.rewriteRefOfAccount(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*DropRole).Roles[idx] = newNode.(*Account)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -2989,7 +3624,7 @@ func (a *application) rewriteRefOfFrameClause(parent SQLNode, node *FrameClause,
 	}
 	return true
 }
-func (a *application) rewriteRefOfFramePoint(parent SQLNode, node *FramePoint, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropTable(parent SQLNode, node *DropTable, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3001,8 +3636,13 @@ func (a *application) rewriteRefOfFramePoint(parent SQLNode, node *FramePoint, r
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*FramePoint).Expr = newNode.(Expr)
+	if !a.rewriteTableNames(node, node.FromTables, func(newNode, parent SQLNode) {
+		parent.(*DropTable).FromTables = newNode.(TableNames)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*DropTable).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
@@ -3016,7 +3656,7 @@ func (a *application) rewriteRefOfFramePoint(parent SQLNode, node *FramePoint, r
 	}
 	return true
 }
-func (a *application) rewriteRefOfFromFirstLastClause(parent SQLNode, node *FromFirstLastClause, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropTrigger(parent SQLNode, node *DropTrigger, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3028,19 +3668,27 @@ func (a *application) rewriteRefOfFromFirstLastClause(parent SQLNode, node *From
 			return true
 		}
 	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*DropTrigger).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*DropTrigger).Name = newNode.(TableName)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfFuncExpr(parent SQLNode, node *FuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropUser(parent SQLNode, node *DropUser, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3052,20 +3700,14 @@ func (a *application) rewriteRefOfFuncExpr(parent SQLNode, node *FuncExpr, repla
 			return true
 		}
 	}
-	if !a.rewriteIdentifierCS(node, node.Qualifier, func(newNode, parent SQLNode) {
-		parent.(*FuncExpr).Qualifier = newNode.(IdentifierCS)
-	}) {
-		return false
-	}
-	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*FuncExpr).Name = newNode.(IdentifierCI)
-	}) {
-		return false
-	}
-	if !a.rewriteSelectExprs(node, node.Exprs, func(newNode, parent SQLNode) {
-		parent.(*FuncExpr).Exprs = newNode.(SelectExprs)
-	}) {
-		return false
+	for x, el := range node.Users {
+		if !a.rewriteRefOfAccount(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*DropUser).Users[idx] = newNode.(*Account)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -3077,7 +3719,7 @@ func (a *application) rewriteRefOfFuncExpr(parent SQLNode, node *FuncExpr, repla
 	}
 	return true
 }
-func (a *application) rewriteRefOfGTIDFuncExpr(parent SQLNode, node *GTIDFuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfDropView(parent SQLNode, node *DropView, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3089,23 +3731,13 @@ func (a *application) rewriteRefOfGTIDFuncExpr(parent SQLNode, node *GTIDFuncExp
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Set1, func(newNode, parent SQLNode) {
-		parent.(*GTIDFuncExpr).Set1 = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Set2, func(newNode, parent SQLNode) {
-		parent.(*GTIDFuncExpr).Set2 = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Timeout, func(newNode, parent SQLNode) {
-		parent.(*GTIDFuncExpr).Timeout = newNode.(Expr)
+	if !a.rewriteTableNames(node, node.FromTables, func(newNode, parent SQLNode) {
+		parent.(*DropView).FromTables = newNode.(TableNames)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Channel, func(newNode, parent SQLNode) {
-		parent.(*GTIDFuncExpr).Channel = newNode.(Expr)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*DropView).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
@@ -3119,7 +3751,7 @@ func (a *application) rewriteRefOfGTIDFuncExpr(parent SQLNode, node *GTIDFuncExp
 	}
 	return true
 }
-func (a *application) rewriteRefOfGeoHashFromLatLongExpr(parent SQLNode, node *GeoHashFromLatLongExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfElseIfBlock(parent SQLNode, node *ElseIfBlock, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3131,18 +3763,13 @@ func (a *application) rewriteRefOfGeoHashFromLatLongExpr(parent SQLNode, node *G
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Latitude, func(newNode, parent SQLNode) {
-		parent.(*GeoHashFromLatLongExpr).Latitude = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Longitude, func(newNode, parent SQLNode) {
-		parent.(*GeoHashFromLatLongExpr).Longitude = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Cond, func(newNode, parent SQLNode) {
+		parent.(*ElseIfBlock).Cond = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.MaxLength, func(newNode, parent SQLNode) {
-		parent.(*GeoHashFromLatLongExpr).MaxLength = newNode.(Expr)
+	if !a.rewriteStatementList(node, node.Statements, func(newNode, parent SQLNode) {
+		parent.(*ElseIfBlock).Statements = newNode.(StatementList)
 	}) {
 		return false
 	}
@@ -3156,7 +3783,7 @@ func (a *application) rewriteRefOfGeoHashFromLatLongExpr(parent SQLNode, node *G
 	}
 	return true
 }
-func (a *application) rewriteRefOfGeoHashFromPointExpr(parent SQLNode, node *GeoHashFromPointExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfEventSchedule(parent SQLNode, node *EventSchedule, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3168,13 +3795,23 @@ func (a *application) rewriteRefOfGeoHashFromPointExpr(parent SQLNode, node *Geo
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Point, func(newNode, parent SQLNode) {
-		parent.(*GeoHashFromPointExpr).Point = newNode.(Expr)
+	if !a.rewriteExpr(node, node.At, func(newNode, parent SQLNode) {
+		parent.(*EventSchedule).At = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.MaxLength, func(newNode, parent SQLNode) {
-		parent.(*GeoHashFromPointExpr).MaxLength = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Every, func(newNode, parent SQLNode) {
+		parent.(*EventSchedule).Every = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Starts, func(newNode, parent SQLNode) {
+		parent.(*EventSchedule).Starts = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Ends, func(newNode, parent SQLNode) {
+		parent.(*EventSchedule).Ends = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -3188,7 +3825,7 @@ func (a *application) rewriteRefOfGeoHashFromPointExpr(parent SQLNode, node *Geo
 	}
 	return true
 }
-func (a *application) rewriteRefOfGeoJSONFromGeomExpr(parent SQLNode, node *GeoJSONFromGeomExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfExecuteStmt(parent SQLNode, node *ExecuteStmt, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3200,20 +3837,24 @@ func (a *application) rewriteRefOfGeoJSONFromGeomExpr(parent SQLNode, node *GeoJ
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Geom, func(newNode, parent SQLNode) {
-		parent.(*GeoJSONFromGeomExpr).Geom = newNode.(Expr)
+	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*ExecuteStmt).Name = newNode.(IdentifierCI)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.MaxDecimalDigits, func(newNode, parent SQLNode) {
-		parent.(*GeoJSONFromGeomExpr).MaxDecimalDigits = newNode.(Expr)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*ExecuteStmt).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Bitmask, func(newNode, parent SQLNode) {
-		parent.(*GeoJSONFromGeomExpr).Bitmask = newNode.(Expr)
-	}) {
-		return false
+	for x, el := range node.Arguments {
+		if !a.rewriteRefOfVariable(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*ExecuteStmt).Arguments[idx] = newNode.(*Variable)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -3225,7 +3866,7 @@ func (a *application) rewriteRefOfGeoJSONFromGeomExpr(parent SQLNode, node *GeoJ
 	}
 	return true
 }
-func (a *application) rewriteRefOfGeomCollPropertyFuncExpr(parent SQLNode, node *GeomCollPropertyFuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfExistsExpr(parent SQLNode, node *ExistsExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3237,13 +3878,8 @@ func (a *application) rewriteRefOfGeomCollPropertyFuncExpr(parent SQLNode, node
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.GeomColl, func(newNode, parent SQLNode) {
-		parent.(*GeomCollPropertyFuncExpr).GeomColl = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.PropertyDefArg, func(newNode, parent SQLNode) {
-		parent.(*GeomCollPropertyFuncExpr).PropertyDefArg = newNode.(Expr)
+	if !a.rewriteRefOfSubquery(node, node.Subquery, func(newNode, parent SQLNode) {
+		parent.(*ExistsExpr).Subquery = newNode.(*Subquery)
 	}) {
 		return false
 	}
@@ -3257,7 +3893,7 @@ func (a *application) rewriteRefOfGeomCollPropertyFuncExpr(parent SQLNode, node
 	}
 	return true
 }
-func (a *application) rewriteRefOfGeomFormatExpr(parent SQLNode, node *GeomFormatExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfExplainStmt(parent SQLNode, node *ExplainStmt, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3269,13 +3905,13 @@ func (a *application) rewriteRefOfGeomFormatExpr(parent SQLNode, node *GeomForma
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Geom, func(newNode, parent SQLNode) {
-		parent.(*GeomFormatExpr).Geom = newNode.(Expr)
+	if !a.rewriteStatement(node, node.Statement, func(newNode, parent SQLNode) {
+		parent.(*ExplainStmt).Statement = newNode.(Statement)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.AxisOrderOpt, func(newNode, parent SQLNode) {
-		parent.(*GeomFormatExpr).AxisOrderOpt = newNode.(Expr)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*ExplainStmt).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
@@ -3289,7 +3925,7 @@ func (a *application) rewriteRefOfGeomFormatExpr(parent SQLNode, node *GeomForma
 	}
 	return true
 }
-func (a *application) rewriteRefOfGeomFromGeoHashExpr(parent SQLNode, node *GeomFromGeoHashExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfExplainTab(parent SQLNode, node *ExplainTab, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3301,13 +3937,8 @@ func (a *application) rewriteRefOfGeomFromGeoHashExpr(parent SQLNode, node *Geom
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.GeoHash, func(newNode, parent SQLNode) {
-		parent.(*GeomFromGeoHashExpr).GeoHash = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.SridOpt, func(newNode, parent SQLNode) {
-		parent.(*GeomFromGeoHashExpr).SridOpt = newNode.(Expr)
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*ExplainTab).Table = newNode.(TableName)
 	}) {
 		return false
 	}
@@ -3321,7 +3952,7 @@ func (a *application) rewriteRefOfGeomFromGeoHashExpr(parent SQLNode, node *Geom
 	}
 	return true
 }
-func (a *application) rewriteRefOfGeomFromGeoJSONExpr(parent SQLNode, node *GeomFromGeoJSONExpr, replacer replacerFunc) bool {
+func (a *application) rewriteExprs(parent SQLNode, node Exprs, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3329,24 +3960,24 @@ func (a *application) rewriteRefOfGeomFromGeoJSONExpr(parent SQLNode, node *Geom
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(Exprs)
+			a.cur.revisit = false
+			return a.rewriteExprs(parent, node, replacer)
+		}
+		if kontinue {
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.GeoJSON, func(newNode, parent SQLNode) {
-		parent.(*GeomFromGeoJSONExpr).GeoJSON = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.HigherDimHandlerOpt, func(newNode, parent SQLNode) {
-		parent.(*GeomFromGeoJSONExpr).HigherDimHandlerOpt = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Srid, func(newNode, parent SQLNode) {
-		parent.(*GeomFromGeoJSONExpr).Srid = newNode.(Expr)
-	}) {
-		return false
+	for x, el := range node {
+		if !a.rewriteExpr(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(Exprs)[idx] = newNode.(Expr)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -3358,7 +3989,7 @@ func (a *application) rewriteRefOfGeomFromGeoJSONExpr(parent SQLNode, node *Geom
 	}
 	return true
 }
-func (a *application) rewriteRefOfGeomFromTextExpr(parent SQLNode, node *GeomFromTextExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfExtractFuncExpr(parent SQLNode, node *ExtractFuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3370,18 +4001,8 @@ func (a *application) rewriteRefOfGeomFromTextExpr(parent SQLNode, node *GeomFro
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.WktText, func(newNode, parent SQLNode) {
-		parent.(*GeomFromTextExpr).WktText = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Srid, func(newNode, parent SQLNode) {
-		parent.(*GeomFromTextExpr).Srid = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.AxisOrderOpt, func(newNode, parent SQLNode) {
-		parent.(*GeomFromTextExpr).AxisOrderOpt = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*ExtractFuncExpr).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -3395,7 +4016,7 @@ func (a *application) rewriteRefOfGeomFromTextExpr(parent SQLNode, node *GeomFro
 	}
 	return true
 }
-func (a *application) rewriteRefOfGeomFromWKBExpr(parent SQLNode, node *GeomFromWKBExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfExtractValueExpr(parent SQLNode, node *ExtractValueExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3407,18 +4028,13 @@ func (a *application) rewriteRefOfGeomFromWKBExpr(parent SQLNode, node *GeomFrom
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.WkbBlob, func(newNode, parent SQLNode) {
-		parent.(*GeomFromWKBExpr).WkbBlob = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Srid, func(newNode, parent SQLNode) {
-		parent.(*GeomFromWKBExpr).Srid = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Fragment, func(newNode, parent SQLNode) {
+		parent.(*ExtractValueExpr).Fragment = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.AxisOrderOpt, func(newNode, parent SQLNode) {
-		parent.(*GeomFromWKBExpr).AxisOrderOpt = newNode.(Expr)
+	if !a.rewriteExpr(node, node.XPathExpr, func(newNode, parent SQLNode) {
+		parent.(*ExtractValueExpr).XPathExpr = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -3432,7 +4048,7 @@ func (a *application) rewriteRefOfGeomFromWKBExpr(parent SQLNode, node *GeomFrom
 	}
 	return true
 }
-func (a *application) rewriteRefOfGeomPropertyFuncExpr(parent SQLNode, node *GeomPropertyFuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfExtractedSubquery(parent SQLNode, node *ExtractedSubquery, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3444,8 +4060,23 @@ func (a *application) rewriteRefOfGeomPropertyFuncExpr(parent SQLNode, node *Geo
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Geom, func(newNode, parent SQLNode) {
-		parent.(*GeomPropertyFuncExpr).Geom = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Original, func(newNode, parent SQLNode) {
+		parent.(*ExtractedSubquery).Original = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfSubquery(node, node.Subquery, func(newNode, parent SQLNode) {
+		parent.(*ExtractedSubquery).Subquery = newNode.(*Subquery)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.OtherSide, func(newNode, parent SQLNode) {
+		parent.(*ExtractedSubquery).OtherSide = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.alternative, func(newNode, parent SQLNode) {
+		parent.(*ExtractedSubquery).alternative = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -3459,7 +4090,7 @@ func (a *application) rewriteRefOfGeomPropertyFuncExpr(parent SQLNode, node *Geo
 	}
 	return true
 }
-func (a *application) rewriteGroupBy(parent SQLNode, node GroupBy, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFetchCursor(parent SQLNode, node *FetchCursor, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3467,24 +4098,19 @@ func (a *application) rewriteGroupBy(parent SQLNode, node GroupBy, replacer repl
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(GroupBy)
-			a.cur.revisit = false
-			return a.rewriteGroupBy(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteExpr(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(GroupBy)[idx] = newNode.(Expr)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*FetchCursor).Name = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
+	if !a.rewriteColumns(node, node.Variables, func(newNode, parent SQLNode) {
+		parent.(*FetchCursor).Variables = newNode.(Columns)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -3496,7 +4122,7 @@ func (a *application) rewriteGroupBy(parent SQLNode, node GroupBy, replacer repl
 	}
 	return true
 }
-func (a *application) rewriteRefOfGroupConcatExpr(parent SQLNode, node *GroupConcatExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFirstOrLastValueExpr(parent SQLNode, node *FirstOrLastValueExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3508,18 +4134,18 @@ func (a *application) rewriteRefOfGroupConcatExpr(parent SQLNode, node *GroupCon
 			return true
 		}
 	}
-	if !a.rewriteExprs(node, node.Exprs, func(newNode, parent SQLNode) {
-		parent.(*GroupConcatExpr).Exprs = newNode.(Exprs)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*FirstOrLastValueExpr).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteOrderBy(node, node.OrderBy, func(newNode, parent SQLNode) {
-		parent.(*GroupConcatExpr).OrderBy = newNode.(OrderBy)
+	if !a.rewriteRefOfNullTreatmentClause(node, node.NullTreatmentClause, func(newNode, parent SQLNode) {
+		parent.(*FirstOrLastValueExpr).NullTreatmentClause = newNode.(*NullTreatmentClause)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfLimit(node, node.Limit, func(newNode, parent SQLNode) {
-		parent.(*GroupConcatExpr).Limit = newNode.(*Limit)
+	if !a.rewriteRefOfOverClause(node, node.OverClause, func(newNode, parent SQLNode) {
+		parent.(*FirstOrLastValueExpr).OverClause = newNode.(*OverClause)
 	}) {
 		return false
 	}
@@ -3533,7 +4159,10 @@ func (a *application) rewriteRefOfGroupConcatExpr(parent SQLNode, node *GroupCon
 	}
 	return true
 }
-func (a *application) rewriteIdentifierCI(parent SQLNode, node IdentifierCI, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFlush(parent SQLNode, node *Flush, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
 	if a.pre != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -3542,19 +4171,25 @@ func (a *application) rewriteIdentifierCI(parent SQLNode, node IdentifierCI, rep
 			return true
 		}
 	}
+	if !a.rewriteTableNames(node, node.TableNames, func(newNode, parent SQLNode) {
+		parent.(*Flush).TableNames = newNode.(TableNames)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteIdentifierCS(parent SQLNode, node IdentifierCS, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfForce(parent SQLNode, node *Force, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
 	if a.pre != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -3575,7 +4210,7 @@ func (a *application) rewriteIdentifierCS(parent SQLNode, node IdentifierCS, rep
 	}
 	return true
 }
-func (a *application) rewriteRefOfIndexDefinition(parent SQLNode, node *IndexDefinition, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfForeignKeyDefinition(parent SQLNode, node *ForeignKeyDefinition, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3587,8 +4222,18 @@ func (a *application) rewriteRefOfIndexDefinition(parent SQLNode, node *IndexDef
 			return true
 		}
 	}
-	if !a.rewriteRefOfIndexInfo(node, node.Info, func(newNode, parent SQLNode) {
-		parent.(*IndexDefinition).Info = newNode.(*IndexInfo)
+	if !a.rewriteColumns(node, node.Source, func(newNode, parent SQLNode) {
+		parent.(*ForeignKeyDefinition).Source = newNode.(Columns)
+	}) {
+		return false
+	}
+	if !a.rewriteIdentifierCI(node, node.IndexName, func(newNode, parent SQLNode) {
+		parent.(*ForeignKeyDefinition).IndexName = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfReferenceDefinition(node, node.ReferenceDefinition, func(newNode, parent SQLNode) {
+		parent.(*ForeignKeyDefinition).ReferenceDefinition = newNode.(*ReferenceDefinition)
 	}) {
 		return false
 	}
@@ -3602,7 +4247,7 @@ func (a *application) rewriteRefOfIndexDefinition(parent SQLNode, node *IndexDef
 	}
 	return true
 }
-func (a *application) rewriteRefOfIndexHint(parent SQLNode, node *IndexHint, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFrameClause(parent SQLNode, node *FrameClause, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3614,14 +4259,15 @@ func (a *application) rewriteRefOfIndexHint(parent SQLNode, node *IndexHint, rep
 			return true
 		}
 	}
-	for x, el := range node.Indexes {
-		if !a.rewriteIdentifierCI(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*IndexHint).Indexes[idx] = newNode.(IdentifierCI)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteRefOfFramePoint(node, node.Start, func(newNode, parent SQLNode) {
+		parent.(*FrameClause).Start = newNode.(*FramePoint)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfFramePoint(node, node.End, func(newNode, parent SQLNode) {
+		parent.(*FrameClause).End = newNode.(*FramePoint)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -3633,7 +4279,7 @@ func (a *application) rewriteRefOfIndexHint(parent SQLNode, node *IndexHint, rep
 	}
 	return true
 }
-func (a *application) rewriteIndexHints(parent SQLNode, node IndexHints, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFramePoint(parent SQLNode, node *FramePoint, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3641,24 +4287,14 @@ func (a *application) rewriteIndexHints(parent SQLNode, node IndexHints, replace
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(IndexHints)
-			a.cur.revisit = false
-			return a.rewriteIndexHints(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteRefOfIndexHint(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(IndexHints)[idx] = newNode.(*IndexHint)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*FramePoint).Expr = newNode.(Expr)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -3670,7 +4306,7 @@ func (a *application) rewriteIndexHints(parent SQLNode, node IndexHints, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfIndexInfo(parent SQLNode, node *IndexInfo, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFromFirstLastClause(parent SQLNode, node *FromFirstLastClause, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3682,27 +4318,19 @@ func (a *application) rewriteRefOfIndexInfo(parent SQLNode, node *IndexInfo, rep
 			return true
 		}
 	}
-	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*IndexInfo).Name = newNode.(IdentifierCI)
-	}) {
-		return false
-	}
-	if !a.rewriteIdentifierCI(node, node.ConstraintName, func(newNode, parent SQLNode) {
-		parent.(*IndexInfo).ConstraintName = newNode.(IdentifierCI)
-	}) {
-		return false
-	}
 	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfInsert(parent SQLNode, node *Insert, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFuncExpr(parent SQLNode, node *FuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3714,33 +4342,18 @@ func (a *application) rewriteRefOfInsert(parent SQLNode, node *Insert, replacer
 			return true
 		}
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*Insert).Comments = newNode.(*ParsedComments)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfAliasedTableExpr(node, node.Table, func(newNode, parent SQLNode) {
-		parent.(*Insert).Table = newNode.(*AliasedTableExpr)
-	}) {
-		return false
-	}
-	if !a.rewritePartitions(node, node.Partitions, func(newNode, parent SQLNode) {
-		parent.(*Insert).Partitions = newNode.(Partitions)
-	}) {
-		return false
-	}
-	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
-		parent.(*Insert).Columns = newNode.(Columns)
+	if !a.rewriteIdentifierCS(node, node.Qualifier, func(newNode, parent SQLNode) {
+		parent.(*FuncExpr).Qualifier = newNode.(IdentifierCS)
 	}) {
 		return false
 	}
-	if !a.rewriteInsertRows(node, node.Rows, func(newNode, parent SQLNode) {
-		parent.(*Insert).Rows = newNode.(InsertRows)
+	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*FuncExpr).Name = newNode.(IdentifierCI)
 	}) {
 		return false
 	}
-	if !a.rewriteOnDup(node, node.OnDup, func(newNode, parent SQLNode) {
-		parent.(*Insert).OnDup = newNode.(OnDup)
+	if !a.rewriteSelectExprs(node, node.Exprs, func(newNode, parent SQLNode) {
+		parent.(*FuncExpr).Exprs = newNode.(SelectExprs)
 	}) {
 		return false
 	}
@@ -3754,7 +4367,7 @@ func (a *application) rewriteRefOfInsert(parent SQLNode, node *Insert, replacer
 	}
 	return true
 }
-func (a *application) rewriteRefOfInsertExpr(parent SQLNode, node *InsertExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGTIDFuncExpr(parent SQLNode, node *GTIDFuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3766,23 +4379,23 @@ func (a *application) rewriteRefOfInsertExpr(parent SQLNode, node *InsertExpr, r
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Str, func(newNode, parent SQLNode) {
-		parent.(*InsertExpr).Str = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Set1, func(newNode, parent SQLNode) {
+		parent.(*GTIDFuncExpr).Set1 = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Pos, func(newNode, parent SQLNode) {
-		parent.(*InsertExpr).Pos = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Set2, func(newNode, parent SQLNode) {
+		parent.(*GTIDFuncExpr).Set2 = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Len, func(newNode, parent SQLNode) {
-		parent.(*InsertExpr).Len = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Timeout, func(newNode, parent SQLNode) {
+		parent.(*GTIDFuncExpr).Timeout = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.NewStr, func(newNode, parent SQLNode) {
-		parent.(*InsertExpr).NewStr = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Channel, func(newNode, parent SQLNode) {
+		parent.(*GTIDFuncExpr).Channel = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -3796,7 +4409,7 @@ func (a *application) rewriteRefOfInsertExpr(parent SQLNode, node *InsertExpr, r
 	}
 	return true
 }
-func (a *application) rewriteRefOfIntervalFuncExpr(parent SQLNode, node *IntervalFuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGeoHashFromLatLongExpr(parent SQLNode, node *GeoHashFromLatLongExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3808,13 +4421,18 @@ func (a *application) rewriteRefOfIntervalFuncExpr(parent SQLNode, node *Interva
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*IntervalFuncExpr).Expr = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Latitude, func(newNode, parent SQLNode) {
+		parent.(*GeoHashFromLatLongExpr).Latitude = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExprs(node, node.Exprs, func(newNode, parent SQLNode) {
-		parent.(*IntervalFuncExpr).Exprs = newNode.(Exprs)
+	if !a.rewriteExpr(node, node.Longitude, func(newNode, parent SQLNode) {
+		parent.(*GeoHashFromLatLongExpr).Longitude = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.MaxLength, func(newNode, parent SQLNode) {
+		parent.(*GeoHashFromLatLongExpr).MaxLength = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -3828,7 +4446,7 @@ func (a *application) rewriteRefOfIntervalFuncExpr(parent SQLNode, node *Interva
 	}
 	return true
 }
-func (a *application) rewriteRefOfIntroducerExpr(parent SQLNode, node *IntroducerExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGeoHashFromPointExpr(parent SQLNode, node *GeoHashFromPointExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3840,13 +4458,18 @@ func (a *application) rewriteRefOfIntroducerExpr(parent SQLNode, node *Introduce
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*IntroducerExpr).Expr = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Point, func(newNode, parent SQLNode) {
+		parent.(*GeoHashFromPointExpr).Point = newNode.(Expr)
 	}) {
 		return false
 	}
-	if a.post != nil {
-		a.cur.replacer = replacer
+	if !a.rewriteExpr(node, node.MaxLength, func(newNode, parent SQLNode) {
+		parent.(*GeoHashFromPointExpr).MaxLength = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
 		if !a.post(&a.cur) {
@@ -3855,7 +4478,7 @@ func (a *application) rewriteRefOfIntroducerExpr(parent SQLNode, node *Introduce
 	}
 	return true
 }
-func (a *application) rewriteRefOfIsExpr(parent SQLNode, node *IsExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGeoJSONFromGeomExpr(parent SQLNode, node *GeoJSONFromGeomExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3867,8 +4490,18 @@ func (a *application) rewriteRefOfIsExpr(parent SQLNode, node *IsExpr, replacer
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Left, func(newNode, parent SQLNode) {
-		parent.(*IsExpr).Left = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Geom, func(newNode, parent SQLNode) {
+		parent.(*GeoJSONFromGeomExpr).Geom = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.MaxDecimalDigits, func(newNode, parent SQLNode) {
+		parent.(*GeoJSONFromGeomExpr).MaxDecimalDigits = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Bitmask, func(newNode, parent SQLNode) {
+		parent.(*GeoJSONFromGeomExpr).Bitmask = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -3882,7 +4515,7 @@ func (a *application) rewriteRefOfIsExpr(parent SQLNode, node *IsExpr, replacer
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONArrayExpr(parent SQLNode, node *JSONArrayExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGeomCollPropertyFuncExpr(parent SQLNode, node *GeomCollPropertyFuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3894,8 +4527,13 @@ func (a *application) rewriteRefOfJSONArrayExpr(parent SQLNode, node *JSONArrayE
 			return true
 		}
 	}
-	if !a.rewriteExprs(node, node.Params, func(newNode, parent SQLNode) {
-		parent.(*JSONArrayExpr).Params = newNode.(Exprs)
+	if !a.rewriteExpr(node, node.GeomColl, func(newNode, parent SQLNode) {
+		parent.(*GeomCollPropertyFuncExpr).GeomColl = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.PropertyDefArg, func(newNode, parent SQLNode) {
+		parent.(*GeomCollPropertyFuncExpr).PropertyDefArg = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -3909,7 +4547,7 @@ func (a *application) rewriteRefOfJSONArrayExpr(parent SQLNode, node *JSONArrayE
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONAttributesExpr(parent SQLNode, node *JSONAttributesExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGeomFormatExpr(parent SQLNode, node *GeomFormatExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3921,13 +4559,13 @@ func (a *application) rewriteRefOfJSONAttributesExpr(parent SQLNode, node *JSONA
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONAttributesExpr).JSONDoc = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Geom, func(newNode, parent SQLNode) {
+		parent.(*GeomFormatExpr).Geom = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Path, func(newNode, parent SQLNode) {
-		parent.(*JSONAttributesExpr).Path = newNode.(Expr)
+	if !a.rewriteExpr(node, node.AxisOrderOpt, func(newNode, parent SQLNode) {
+		parent.(*GeomFormatExpr).AxisOrderOpt = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -3941,7 +4579,7 @@ func (a *application) rewriteRefOfJSONAttributesExpr(parent SQLNode, node *JSONA
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONContainsExpr(parent SQLNode, node *JSONContainsExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGeomFromGeoHashExpr(parent SQLNode, node *GeomFromGeoHashExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3953,25 +4591,16 @@ func (a *application) rewriteRefOfJSONContainsExpr(parent SQLNode, node *JSONCon
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Target, func(newNode, parent SQLNode) {
-		parent.(*JSONContainsExpr).Target = newNode.(Expr)
+	if !a.rewriteExpr(node, node.GeoHash, func(newNode, parent SQLNode) {
+		parent.(*GeomFromGeoHashExpr).GeoHash = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Candidate, func(newNode, parent SQLNode) {
-		parent.(*JSONContainsExpr).Candidate = newNode.(Expr)
+	if !a.rewriteExpr(node, node.SridOpt, func(newNode, parent SQLNode) {
+		parent.(*GeomFromGeoHashExpr).SridOpt = newNode.(Expr)
 	}) {
 		return false
 	}
-	for x, el := range node.PathList {
-		if !a.rewriteExpr(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*JSONContainsExpr).PathList[idx] = newNode.(Expr)
-			}
-		}(x)) {
-			return false
-		}
-	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -3982,7 +4611,7 @@ func (a *application) rewriteRefOfJSONContainsExpr(parent SQLNode, node *JSONCon
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONContainsPathExpr(parent SQLNode, node *JSONContainsPathExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGeomFromGeoJSONExpr(parent SQLNode, node *GeomFromGeoJSONExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -3994,24 +4623,20 @@ func (a *application) rewriteRefOfJSONContainsPathExpr(parent SQLNode, node *JSO
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONContainsPathExpr).JSONDoc = newNode.(Expr)
+	if !a.rewriteExpr(node, node.GeoJSON, func(newNode, parent SQLNode) {
+		parent.(*GeomFromGeoJSONExpr).GeoJSON = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.OneOrAll, func(newNode, parent SQLNode) {
-		parent.(*JSONContainsPathExpr).OneOrAll = newNode.(Expr)
+	if !a.rewriteExpr(node, node.HigherDimHandlerOpt, func(newNode, parent SQLNode) {
+		parent.(*GeomFromGeoJSONExpr).HigherDimHandlerOpt = newNode.(Expr)
 	}) {
 		return false
 	}
-	for x, el := range node.PathList {
-		if !a.rewriteExpr(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*JSONContainsPathExpr).PathList[idx] = newNode.(Expr)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteExpr(node, node.Srid, func(newNode, parent SQLNode) {
+		parent.(*GeomFromGeoJSONExpr).Srid = newNode.(Expr)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -4023,7 +4648,7 @@ func (a *application) rewriteRefOfJSONContainsPathExpr(parent SQLNode, node *JSO
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONExtractExpr(parent SQLNode, node *JSONExtractExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGeomFromTextExpr(parent SQLNode, node *GeomFromTextExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4035,19 +4660,20 @@ func (a *application) rewriteRefOfJSONExtractExpr(parent SQLNode, node *JSONExtr
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONExtractExpr).JSONDoc = newNode.(Expr)
+	if !a.rewriteExpr(node, node.WktText, func(newNode, parent SQLNode) {
+		parent.(*GeomFromTextExpr).WktText = newNode.(Expr)
 	}) {
 		return false
 	}
-	for x, el := range node.PathList {
-		if !a.rewriteExpr(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*JSONExtractExpr).PathList[idx] = newNode.(Expr)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteExpr(node, node.Srid, func(newNode, parent SQLNode) {
+		parent.(*GeomFromTextExpr).Srid = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.AxisOrderOpt, func(newNode, parent SQLNode) {
+		parent.(*GeomFromTextExpr).AxisOrderOpt = newNode.(Expr)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -4059,7 +4685,7 @@ func (a *application) rewriteRefOfJSONExtractExpr(parent SQLNode, node *JSONExtr
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONKeysExpr(parent SQLNode, node *JSONKeysExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGeomFromWKBExpr(parent SQLNode, node *GeomFromWKBExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4071,13 +4697,18 @@ func (a *application) rewriteRefOfJSONKeysExpr(parent SQLNode, node *JSONKeysExp
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONKeysExpr).JSONDoc = newNode.(Expr)
+	if !a.rewriteExpr(node, node.WkbBlob, func(newNode, parent SQLNode) {
+		parent.(*GeomFromWKBExpr).WkbBlob = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Path, func(newNode, parent SQLNode) {
-		parent.(*JSONKeysExpr).Path = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Srid, func(newNode, parent SQLNode) {
+		parent.(*GeomFromWKBExpr).Srid = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.AxisOrderOpt, func(newNode, parent SQLNode) {
+		parent.(*GeomFromWKBExpr).AxisOrderOpt = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -4091,7 +4722,7 @@ func (a *application) rewriteRefOfJSONKeysExpr(parent SQLNode, node *JSONKeysExp
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONObjectExpr(parent SQLNode, node *JSONObjectExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGeomPropertyFuncExpr(parent SQLNode, node *GeomPropertyFuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4103,14 +4734,10 @@ func (a *application) rewriteRefOfJSONObjectExpr(parent SQLNode, node *JSONObjec
 			return true
 		}
 	}
-	for x, el := range node.Params {
-		if !a.rewriteRefOfJSONObjectParam(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*JSONObjectExpr).Params[idx] = newNode.(*JSONObjectParam)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteExpr(node, node.Geom, func(newNode, parent SQLNode) {
+		parent.(*GeomPropertyFuncExpr).Geom = newNode.(Expr)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -4122,7 +4749,7 @@ func (a *application) rewriteRefOfJSONObjectExpr(parent SQLNode, node *JSONObjec
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONObjectParam(parent SQLNode, node *JSONObjectParam, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGrant(parent SQLNode, node *Grant, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4134,16 +4761,43 @@ func (a *application) rewriteRefOfJSONObjectParam(parent SQLNode, node *JSONObje
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Key, func(newNode, parent SQLNode) {
-		parent.(*JSONObjectParam).Key = newNode.(Expr)
+	for x, el := range node.Privileges {
+		if !a.rewriteRefOfGrantPrivilege(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*Grant).Privileges[idx] = newNode.(*GrantPrivilege)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteRefOfPrivilegeLevel(node, node.On, func(newNode, parent SQLNode) {
+		parent.(*Grant).On = newNode.(*PrivilegeLevel)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Value, func(newNode, parent SQLNode) {
-		parent.(*JSONObjectParam).Value = newNode.(Expr)
+	for x, el := range node.Roles {
+		if !a.rewriteRefOfAccount(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*Grant).Roles[idx] = newNode.(*Account)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteRefOfAccount(node, node.Proxy, func(newNode, parent SQLNode) {
+		parent.(*Grant).Proxy = newNode.(*Account)
 	}) {
 		return false
 	}
+	for x, el := range node.To {
+		if !a.rewriteRefOfAccount(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*Grant).To[idx] = newNode.(*Account)
+			}
+		}(x)) {
+			return false
+		}
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -4154,7 +4808,7 @@ func (a *application) rewriteRefOfJSONObjectParam(parent SQLNode, node *JSONObje
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONOverlapsExpr(parent SQLNode, node *JSONOverlapsExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGrantPrivilege(parent SQLNode, node *GrantPrivilege, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4166,13 +4820,8 @@ func (a *application) rewriteRefOfJSONOverlapsExpr(parent SQLNode, node *JSONOve
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc1, func(newNode, parent SQLNode) {
-		parent.(*JSONOverlapsExpr).JSONDoc1 = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.JSONDoc2, func(newNode, parent SQLNode) {
-		parent.(*JSONOverlapsExpr).JSONDoc2 = newNode.(Expr)
+	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*GrantPrivilege).Columns = newNode.(Columns)
 	}) {
 		return false
 	}
@@ -4186,7 +4835,7 @@ func (a *application) rewriteRefOfJSONOverlapsExpr(parent SQLNode, node *JSONOve
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONPrettyExpr(parent SQLNode, node *JSONPrettyExpr, replacer replacerFunc) bool {
+func (a *application) rewriteGroupBy(parent SQLNode, node GroupBy, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4194,14 +4843,24 @@ func (a *application) rewriteRefOfJSONPrettyExpr(parent SQLNode, node *JSONPrett
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(GroupBy)
+			a.cur.revisit = false
+			return a.rewriteGroupBy(parent, node, replacer)
+		}
+		if kontinue {
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONVal, func(newNode, parent SQLNode) {
-		parent.(*JSONPrettyExpr).JSONVal = newNode.(Expr)
-	}) {
-		return false
+	for x, el := range node {
+		if !a.rewriteExpr(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(GroupBy)[idx] = newNode.(Expr)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -4213,7 +4872,7 @@ func (a *application) rewriteRefOfJSONPrettyExpr(parent SQLNode, node *JSONPrett
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONQuoteExpr(parent SQLNode, node *JSONQuoteExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGroupConcatExpr(parent SQLNode, node *GroupConcatExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4225,8 +4884,18 @@ func (a *application) rewriteRefOfJSONQuoteExpr(parent SQLNode, node *JSONQuoteE
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.StringArg, func(newNode, parent SQLNode) {
-		parent.(*JSONQuoteExpr).StringArg = newNode.(Expr)
+	if !a.rewriteExprs(node, node.Exprs, func(newNode, parent SQLNode) {
+		parent.(*GroupConcatExpr).Exprs = newNode.(Exprs)
+	}) {
+		return false
+	}
+	if !a.rewriteOrderBy(node, node.OrderBy, func(newNode, parent SQLNode) {
+		parent.(*GroupConcatExpr).OrderBy = newNode.(OrderBy)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLimit(node, node.Limit, func(newNode, parent SQLNode) {
+		parent.(*GroupConcatExpr).Limit = newNode.(*Limit)
 	}) {
 		return false
 	}
@@ -4240,10 +4909,7 @@ func (a *application) rewriteRefOfJSONQuoteExpr(parent SQLNode, node *JSONQuoteE
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONRemoveExpr(parent SQLNode, node *JSONRemoveExpr, replacer replacerFunc) bool {
-	if node == nil {
-		return true
-	}
+func (a *application) rewriteIdentifierCI(parent SQLNode, node IdentifierCI, replacer replacerFunc) bool {
 	if a.pre != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -4252,30 +4918,19 @@ func (a *application) rewriteRefOfJSONRemoveExpr(parent SQLNode, node *JSONRemov
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONRemoveExpr).JSONDoc = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExprs(node, node.PathList, func(newNode, parent SQLNode) {
-		parent.(*JSONRemoveExpr).PathList = newNode.(Exprs)
-	}) {
-		return false
-	}
 	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONSchemaValidFuncExpr(parent SQLNode, node *JSONSchemaValidFuncExpr, replacer replacerFunc) bool {
-	if node == nil {
-		return true
-	}
+func (a *application) rewriteIdentifierCS(parent SQLNode, node IdentifierCS, replacer replacerFunc) bool {
 	if a.pre != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -4284,27 +4939,19 @@ func (a *application) rewriteRefOfJSONSchemaValidFuncExpr(parent SQLNode, node *
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Schema, func(newNode, parent SQLNode) {
-		parent.(*JSONSchemaValidFuncExpr).Schema = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Document, func(newNode, parent SQLNode) {
-		parent.(*JSONSchemaValidFuncExpr).Document = newNode.(Expr)
-	}) {
-		return false
-	}
 	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONSchemaValidationReportFuncExpr(parent SQLNode, node *JSONSchemaValidationReportFuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfIfStatement(parent SQLNode, node *IfStatement, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4316,67 +4963,30 @@ func (a *application) rewriteRefOfJSONSchemaValidationReportFuncExpr(parent SQLN
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Schema, func(newNode, parent SQLNode) {
-		parent.(*JSONSchemaValidationReportFuncExpr).Schema = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Document, func(newNode, parent SQLNode) {
-		parent.(*JSONSchemaValidationReportFuncExpr).Document = newNode.(Expr)
-	}) {
-		return false
-	}
-	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
-		if !a.post(&a.cur) {
-			return false
-		}
-	}
-	return true
-}
-func (a *application) rewriteRefOfJSONSearchExpr(parent SQLNode, node *JSONSearchExpr, replacer replacerFunc) bool {
-	if node == nil {
-		return true
-	}
-	if a.pre != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
-		if !a.pre(&a.cur) {
-			return true
-		}
-	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONSearchExpr).JSONDoc = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.OneOrAll, func(newNode, parent SQLNode) {
-		parent.(*JSONSearchExpr).OneOrAll = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.SearchStr, func(newNode, parent SQLNode) {
-		parent.(*JSONSearchExpr).SearchStr = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Cond, func(newNode, parent SQLNode) {
+		parent.(*IfStatement).Cond = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.EscapeChar, func(newNode, parent SQLNode) {
-		parent.(*JSONSearchExpr).EscapeChar = newNode.(Expr)
+	if !a.rewriteStatementList(node, node.Statements, func(newNode, parent SQLNode) {
+		parent.(*IfStatement).Statements = newNode.(StatementList)
 	}) {
 		return false
 	}
-	for x, el := range node.PathList {
-		if !a.rewriteExpr(node, el, func(idx int) replacerFunc {
+	for x, el := range node.ElseIfs {
+		if !a.rewriteRefOfElseIfBlock(node, el, func(idx int) replacerFunc {
 			return func(newNode, parent SQLNode) {
-				parent.(*JSONSearchExpr).PathList[idx] = newNode.(Expr)
+				parent.(*IfStatement).ElseIfs[idx] = newNode.(*ElseIfBlock)
 			}
 		}(x)) {
 			return false
 		}
 	}
+	if !a.rewriteStatementList(node, node.Else, func(newNode, parent SQLNode) {
+		parent.(*IfStatement).Else = newNode.(StatementList)
+	}) {
+		return false
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -4387,7 +4997,7 @@ func (a *application) rewriteRefOfJSONSearchExpr(parent SQLNode, node *JSONSearc
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONStorageFreeExpr(parent SQLNode, node *JSONStorageFreeExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfIndexDefinition(parent SQLNode, node *IndexDefinition, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4399,8 +5009,8 @@ func (a *application) rewriteRefOfJSONStorageFreeExpr(parent SQLNode, node *JSON
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONVal, func(newNode, parent SQLNode) {
-		parent.(*JSONStorageFreeExpr).JSONVal = newNode.(Expr)
+	if !a.rewriteRefOfIndexInfo(node, node.Info, func(newNode, parent SQLNode) {
+		parent.(*IndexDefinition).Info = newNode.(*IndexInfo)
 	}) {
 		return false
 	}
@@ -4414,7 +5024,7 @@ func (a *application) rewriteRefOfJSONStorageFreeExpr(parent SQLNode, node *JSON
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONStorageSizeExpr(parent SQLNode, node *JSONStorageSizeExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfIndexHint(parent SQLNode, node *IndexHint, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4426,10 +5036,14 @@ func (a *application) rewriteRefOfJSONStorageSizeExpr(parent SQLNode, node *JSON
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONVal, func(newNode, parent SQLNode) {
-		parent.(*JSONStorageSizeExpr).JSONVal = newNode.(Expr)
-	}) {
-		return false
+	for x, el := range node.Indexes {
+		if !a.rewriteIdentifierCI(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*IndexHint).Indexes[idx] = newNode.(IdentifierCI)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -4441,7 +5055,7 @@ func (a *application) rewriteRefOfJSONStorageSizeExpr(parent SQLNode, node *JSON
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONTableExpr(parent SQLNode, node *JSONTableExpr, replacer replacerFunc) bool {
+func (a *application) rewriteIndexHints(parent SQLNode, node IndexHints, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4449,29 +5063,20 @@ func (a *application) rewriteRefOfJSONTableExpr(parent SQLNode, node *JSONTableE
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(IndexHints)
+			a.cur.revisit = false
+			return a.rewriteIndexHints(parent, node, replacer)
+		}
+		if kontinue {
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*JSONTableExpr).Expr = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteIdentifierCS(node, node.Alias, func(newNode, parent SQLNode) {
-		parent.(*JSONTableExpr).Alias = newNode.(IdentifierCS)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Filter, func(newNode, parent SQLNode) {
-		parent.(*JSONTableExpr).Filter = newNode.(Expr)
-	}) {
-		return false
-	}
-	for x, el := range node.Columns {
-		if !a.rewriteRefOfJtColumnDefinition(node, el, func(idx int) replacerFunc {
+	for x, el := range node {
+		if !a.rewriteRefOfIndexHint(node, el, func(idx int) replacerFunc {
 			return func(newNode, parent SQLNode) {
-				parent.(*JSONTableExpr).Columns[idx] = newNode.(*JtColumnDefinition)
+				parent.(IndexHints)[idx] = newNode.(*IndexHint)
 			}
 		}(x)) {
 			return false
@@ -4487,7 +5092,7 @@ func (a *application) rewriteRefOfJSONTableExpr(parent SQLNode, node *JSONTableE
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONUnquoteExpr(parent SQLNode, node *JSONUnquoteExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfIndexInfo(parent SQLNode, node *IndexInfo, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4499,8 +5104,13 @@ func (a *application) rewriteRefOfJSONUnquoteExpr(parent SQLNode, node *JSONUnqu
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONValue, func(newNode, parent SQLNode) {
-		parent.(*JSONUnquoteExpr).JSONValue = newNode.(Expr)
+	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*IndexInfo).Name = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
+	if !a.rewriteIdentifierCI(node, node.ConstraintName, func(newNode, parent SQLNode) {
+		parent.(*IndexInfo).ConstraintName = newNode.(IdentifierCI)
 	}) {
 		return false
 	}
@@ -4514,7 +5124,7 @@ func (a *application) rewriteRefOfJSONUnquoteExpr(parent SQLNode, node *JSONUnqu
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONValueExpr(parent SQLNode, node *JSONValueExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfInsert(parent SQLNode, node *Insert, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4526,28 +5136,43 @@ func (a *application) rewriteRefOfJSONValueExpr(parent SQLNode, node *JSONValueE
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONValueExpr).JSONDoc = newNode.(Expr)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*Insert).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Path, func(newNode, parent SQLNode) {
-		parent.(*JSONValueExpr).Path = newNode.(Expr)
+	if !a.rewriteRefOfAliasedTableExpr(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*Insert).Table = newNode.(*AliasedTableExpr)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfConvertType(node, node.ReturningType, func(newNode, parent SQLNode) {
-		parent.(*JSONValueExpr).ReturningType = newNode.(*ConvertType)
+	if !a.rewritePartitions(node, node.Partitions, func(newNode, parent SQLNode) {
+		parent.(*Insert).Partitions = newNode.(Partitions)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfJtOnResponse(node, node.EmptyOnResponse, func(newNode, parent SQLNode) {
-		parent.(*JSONValueExpr).EmptyOnResponse = newNode.(*JtOnResponse)
+	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*Insert).Columns = newNode.(Columns)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfJtOnResponse(node, node.ErrorOnResponse, func(newNode, parent SQLNode) {
-		parent.(*JSONValueExpr).ErrorOnResponse = newNode.(*JtOnResponse)
+	if !a.rewriteInsertRows(node, node.Rows, func(newNode, parent SQLNode) {
+		parent.(*Insert).Rows = newNode.(InsertRows)
+	}) {
+		return false
+	}
+	if !a.rewriteOnDup(node, node.OnDup, func(newNode, parent SQLNode) {
+		parent.(*Insert).OnDup = newNode.(OnDup)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfOnConflict(node, node.OnConflict, func(newNode, parent SQLNode) {
+		parent.(*Insert).OnConflict = newNode.(*OnConflict)
+	}) {
+		return false
+	}
+	if !a.rewriteSelectExprs(node, node.Returning, func(newNode, parent SQLNode) {
+		parent.(*Insert).Returning = newNode.(SelectExprs)
 	}) {
 		return false
 	}
@@ -4561,7 +5186,7 @@ func (a *application) rewriteRefOfJSONValueExpr(parent SQLNode, node *JSONValueE
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONValueMergeExpr(parent SQLNode, node *JSONValueMergeExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfInsertExpr(parent SQLNode, node *InsertExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4573,13 +5198,23 @@ func (a *application) rewriteRefOfJSONValueMergeExpr(parent SQLNode, node *JSONV
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONValueMergeExpr).JSONDoc = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Str, func(newNode, parent SQLNode) {
+		parent.(*InsertExpr).Str = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExprs(node, node.JSONDocList, func(newNode, parent SQLNode) {
-		parent.(*JSONValueMergeExpr).JSONDocList = newNode.(Exprs)
+	if !a.rewriteExpr(node, node.Pos, func(newNode, parent SQLNode) {
+		parent.(*InsertExpr).Pos = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Len, func(newNode, parent SQLNode) {
+		parent.(*InsertExpr).Len = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.NewStr, func(newNode, parent SQLNode) {
+		parent.(*InsertExpr).NewStr = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -4593,7 +5228,7 @@ func (a *application) rewriteRefOfJSONValueMergeExpr(parent SQLNode, node *JSONV
 	}
 	return true
 }
-func (a *application) rewriteRefOfJSONValueModifierExpr(parent SQLNode, node *JSONValueModifierExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfIntervalFuncExpr(parent SQLNode, node *IntervalFuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4605,19 +5240,15 @@ func (a *application) rewriteRefOfJSONValueModifierExpr(parent SQLNode, node *JS
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
-		parent.(*JSONValueModifierExpr).JSONDoc = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*IntervalFuncExpr).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
-	for x, el := range node.Params {
-		if !a.rewriteRefOfJSONObjectParam(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*JSONValueModifierExpr).Params[idx] = newNode.(*JSONObjectParam)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteExprs(node, node.Exprs, func(newNode, parent SQLNode) {
+		parent.(*IntervalFuncExpr).Exprs = newNode.(Exprs)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -4629,7 +5260,7 @@ func (a *application) rewriteRefOfJSONValueModifierExpr(parent SQLNode, node *JS
 	}
 	return true
 }
-func (a *application) rewriteRefOfJoinCondition(parent SQLNode, node *JoinCondition, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfIntroducerExpr(parent SQLNode, node *IntroducerExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4641,13 +5272,8 @@ func (a *application) rewriteRefOfJoinCondition(parent SQLNode, node *JoinCondit
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.On, func(newNode, parent SQLNode) {
-		parent.(*JoinCondition).On = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteColumns(node, node.Using, func(newNode, parent SQLNode) {
-		parent.(*JoinCondition).Using = newNode.(Columns)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*IntroducerExpr).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -4661,7 +5287,7 @@ func (a *application) rewriteRefOfJoinCondition(parent SQLNode, node *JoinCondit
 	}
 	return true
 }
-func (a *application) rewriteRefOfJoinTableExpr(parent SQLNode, node *JoinTableExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfIsExpr(parent SQLNode, node *IsExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4673,18 +5299,8 @@ func (a *application) rewriteRefOfJoinTableExpr(parent SQLNode, node *JoinTableE
 			return true
 		}
 	}
-	if !a.rewriteTableExpr(node, node.LeftExpr, func(newNode, parent SQLNode) {
-		parent.(*JoinTableExpr).LeftExpr = newNode.(TableExpr)
-	}) {
-		return false
-	}
-	if !a.rewriteTableExpr(node, node.RightExpr, func(newNode, parent SQLNode) {
-		parent.(*JoinTableExpr).RightExpr = newNode.(TableExpr)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfJoinCondition(node, node.Condition, func(newNode, parent SQLNode) {
-		parent.(*JoinTableExpr).Condition = newNode.(*JoinCondition)
+	if !a.rewriteExpr(node, node.Left, func(newNode, parent SQLNode) {
+		parent.(*IsExpr).Left = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -4698,7 +5314,7 @@ func (a *application) rewriteRefOfJoinTableExpr(parent SQLNode, node *JoinTableE
 	}
 	return true
 }
-func (a *application) rewriteRefOfJtColumnDefinition(parent SQLNode, node *JtColumnDefinition, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfIterateStatement(parent SQLNode, node *IterateStatement, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4710,19 +5326,22 @@ func (a *application) rewriteRefOfJtColumnDefinition(parent SQLNode, node *JtCol
 			return true
 		}
 	}
+	if !a.rewriteIdentifierCI(node, node.Label, func(newNode, parent SQLNode) {
+		parent.(*IterateStatement).Label = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfJtOnResponse(parent SQLNode, node *JtOnResponse, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONArrayExpr(parent SQLNode, node *JSONArrayExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4734,8 +5353,8 @@ func (a *application) rewriteRefOfJtOnResponse(parent SQLNode, node *JtOnRespons
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*JtOnResponse).Expr = newNode.(Expr)
+	if !a.rewriteExprs(node, node.Params, func(newNode, parent SQLNode) {
+		parent.(*JSONArrayExpr).Params = newNode.(Exprs)
 	}) {
 		return false
 	}
@@ -4749,7 +5368,7 @@ func (a *application) rewriteRefOfJtOnResponse(parent SQLNode, node *JtOnRespons
 	}
 	return true
 }
-func (a *application) rewriteRefOfKeyState(parent SQLNode, node *KeyState, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONAttributesExpr(parent SQLNode, node *JSONAttributesExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4761,19 +5380,27 @@ func (a *application) rewriteRefOfKeyState(parent SQLNode, node *KeyState, repla
 			return true
 		}
 	}
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONAttributesExpr).JSONDoc = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Path, func(newNode, parent SQLNode) {
+		parent.(*JSONAttributesExpr).Path = newNode.(Expr)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfLagLeadExpr(parent SQLNode, node *LagLeadExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONContainsExpr(parent SQLNode, node *JSONContainsExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4785,30 +5412,24 @@ func (a *application) rewriteRefOfLagLeadExpr(parent SQLNode, node *LagLeadExpr,
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*LagLeadExpr).Expr = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Target, func(newNode, parent SQLNode) {
+		parent.(*JSONContainsExpr).Target = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.N, func(newNode, parent SQLNode) {
-		parent.(*LagLeadExpr).N = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Default, func(newNode, parent SQLNode) {
-		parent.(*LagLeadExpr).Default = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfOverClause(node, node.OverClause, func(newNode, parent SQLNode) {
-		parent.(*LagLeadExpr).OverClause = newNode.(*OverClause)
+	if !a.rewriteExpr(node, node.Candidate, func(newNode, parent SQLNode) {
+		parent.(*JSONContainsExpr).Candidate = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfNullTreatmentClause(node, node.NullTreatmentClause, func(newNode, parent SQLNode) {
-		parent.(*LagLeadExpr).NullTreatmentClause = newNode.(*NullTreatmentClause)
-	}) {
-		return false
+	for x, el := range node.PathList {
+		if !a.rewriteExpr(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*JSONContainsExpr).PathList[idx] = newNode.(Expr)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -4820,7 +5441,7 @@ func (a *application) rewriteRefOfLagLeadExpr(parent SQLNode, node *LagLeadExpr,
 	}
 	return true
 }
-func (a *application) rewriteRefOfLimit(parent SQLNode, node *Limit, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONContainsPathExpr(parent SQLNode, node *JSONContainsPathExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4832,16 +5453,25 @@ func (a *application) rewriteRefOfLimit(parent SQLNode, node *Limit, replacer re
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Offset, func(newNode, parent SQLNode) {
-		parent.(*Limit).Offset = newNode.(Expr)
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONContainsPathExpr).JSONDoc = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Rowcount, func(newNode, parent SQLNode) {
-		parent.(*Limit).Rowcount = newNode.(Expr)
+	if !a.rewriteExpr(node, node.OneOrAll, func(newNode, parent SQLNode) {
+		parent.(*JSONContainsPathExpr).OneOrAll = newNode.(Expr)
 	}) {
 		return false
 	}
+	for x, el := range node.PathList {
+		if !a.rewriteExpr(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*JSONContainsPathExpr).PathList[idx] = newNode.(Expr)
+			}
+		}(x)) {
+			return false
+		}
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -4852,7 +5482,7 @@ func (a *application) rewriteRefOfLimit(parent SQLNode, node *Limit, replacer re
 	}
 	return true
 }
-func (a *application) rewriteRefOfLineStringExpr(parent SQLNode, node *LineStringExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONExtractExpr(parent SQLNode, node *JSONExtractExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4864,11 +5494,20 @@ func (a *application) rewriteRefOfLineStringExpr(parent SQLNode, node *LineStrin
 			return true
 		}
 	}
-	if !a.rewriteExprs(node, node.PointParams, func(newNode, parent SQLNode) {
-		parent.(*LineStringExpr).PointParams = newNode.(Exprs)
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONExtractExpr).JSONDoc = newNode.(Expr)
 	}) {
 		return false
 	}
+	for x, el := range node.PathList {
+		if !a.rewriteExpr(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*JSONExtractExpr).PathList[idx] = newNode.(Expr)
+			}
+		}(x)) {
+			return false
+		}
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -4879,7 +5518,7 @@ func (a *application) rewriteRefOfLineStringExpr(parent SQLNode, node *LineStrin
 	}
 	return true
 }
-func (a *application) rewriteRefOfLinestrPropertyFuncExpr(parent SQLNode, node *LinestrPropertyFuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONKeysExpr(parent SQLNode, node *JSONKeysExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4891,13 +5530,13 @@ func (a *application) rewriteRefOfLinestrPropertyFuncExpr(parent SQLNode, node *
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Linestring, func(newNode, parent SQLNode) {
-		parent.(*LinestrPropertyFuncExpr).Linestring = newNode.(Expr)
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONKeysExpr).JSONDoc = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.PropertyDefArg, func(newNode, parent SQLNode) {
-		parent.(*LinestrPropertyFuncExpr).PropertyDefArg = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Path, func(newNode, parent SQLNode) {
+		parent.(*JSONKeysExpr).Path = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -4911,7 +5550,7 @@ func (a *application) rewriteRefOfLinestrPropertyFuncExpr(parent SQLNode, node *
 	}
 	return true
 }
-func (a *application) rewriteRefOfLiteral(parent SQLNode, node *Literal, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONObjectExpr(parent SQLNode, node *JSONObjectExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4923,43 +5562,26 @@ func (a *application) rewriteRefOfLiteral(parent SQLNode, node *Literal, replace
 			return true
 		}
 	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
-		if !a.post(&a.cur) {
+	for x, el := range node.Params {
+		if !a.rewriteRefOfJSONObjectParam(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*JSONObjectExpr).Params[idx] = newNode.(*JSONObjectParam)
+			}
+		}(x)) {
 			return false
 		}
 	}
-	return true
-}
-func (a *application) rewriteRefOfLoad(parent SQLNode, node *Load, replacer replacerFunc) bool {
-	if node == nil {
-		return true
-	}
-	if a.pre != nil {
+	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
-			return true
-		}
-	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfLocateExpr(parent SQLNode, node *LocateExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONObjectParam(parent SQLNode, node *JSONObjectParam, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4971,18 +5593,13 @@ func (a *application) rewriteRefOfLocateExpr(parent SQLNode, node *LocateExpr, r
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.SubStr, func(newNode, parent SQLNode) {
-		parent.(*LocateExpr).SubStr = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Str, func(newNode, parent SQLNode) {
-		parent.(*LocateExpr).Str = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Key, func(newNode, parent SQLNode) {
+		parent.(*JSONObjectParam).Key = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Pos, func(newNode, parent SQLNode) {
-		parent.(*LocateExpr).Pos = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Value, func(newNode, parent SQLNode) {
+		parent.(*JSONObjectParam).Value = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -4996,7 +5613,7 @@ func (a *application) rewriteRefOfLocateExpr(parent SQLNode, node *LocateExpr, r
 	}
 	return true
 }
-func (a *application) rewriteRefOfLockOption(parent SQLNode, node *LockOption, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONOverlapsExpr(parent SQLNode, node *JSONOverlapsExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5008,43 +5625,27 @@ func (a *application) rewriteRefOfLockOption(parent SQLNode, node *LockOption, r
 			return true
 		}
 	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
-		if !a.post(&a.cur) {
-			return false
-		}
+	if !a.rewriteExpr(node, node.JSONDoc1, func(newNode, parent SQLNode) {
+		parent.(*JSONOverlapsExpr).JSONDoc1 = newNode.(Expr)
+	}) {
+		return false
 	}
-	return true
-}
-func (a *application) rewriteRefOfLockTables(parent SQLNode, node *LockTables, replacer replacerFunc) bool {
-	if node == nil {
-		return true
+	if !a.rewriteExpr(node, node.JSONDoc2, func(newNode, parent SQLNode) {
+		parent.(*JSONOverlapsExpr).JSONDoc2 = newNode.(Expr)
+	}) {
+		return false
 	}
-	if a.pre != nil {
+	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
-			return true
-		}
-	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfLockingFunc(parent SQLNode, node *LockingFunc, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONPrettyExpr(parent SQLNode, node *JSONPrettyExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5056,13 +5657,8 @@ func (a *application) rewriteRefOfLockingFunc(parent SQLNode, node *LockingFunc,
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*LockingFunc).Name = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Timeout, func(newNode, parent SQLNode) {
-		parent.(*LockingFunc).Timeout = newNode.(Expr)
+	if !a.rewriteExpr(node, node.JSONVal, func(newNode, parent SQLNode) {
+		parent.(*JSONPrettyExpr).JSONVal = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -5076,7 +5672,7 @@ func (a *application) rewriteRefOfLockingFunc(parent SQLNode, node *LockingFunc,
 	}
 	return true
 }
-func (a *application) rewriteRefOfMatchExpr(parent SQLNode, node *MatchExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONQuoteExpr(parent SQLNode, node *JSONQuoteExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5088,17 +5684,8 @@ func (a *application) rewriteRefOfMatchExpr(parent SQLNode, node *MatchExpr, rep
 			return true
 		}
 	}
-	for x, el := range node.Columns {
-		if !a.rewriteRefOfColName(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*MatchExpr).Columns[idx] = newNode.(*ColName)
-			}
-		}(x)) {
-			return false
-		}
-	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*MatchExpr).Expr = newNode.(Expr)
+	if !a.rewriteExpr(node, node.StringArg, func(newNode, parent SQLNode) {
+		parent.(*JSONQuoteExpr).StringArg = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -5112,7 +5699,7 @@ func (a *application) rewriteRefOfMatchExpr(parent SQLNode, node *MatchExpr, rep
 	}
 	return true
 }
-func (a *application) rewriteRefOfMax(parent SQLNode, node *Max, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONRemoveExpr(parent SQLNode, node *JSONRemoveExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5124,8 +5711,13 @@ func (a *application) rewriteRefOfMax(parent SQLNode, node *Max, replacer replac
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Arg, func(newNode, parent SQLNode) {
-		parent.(*Max).Arg = newNode.(Expr)
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONRemoveExpr).JSONDoc = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExprs(node, node.PathList, func(newNode, parent SQLNode) {
+		parent.(*JSONRemoveExpr).PathList = newNode.(Exprs)
 	}) {
 		return false
 	}
@@ -5139,7 +5731,7 @@ func (a *application) rewriteRefOfMax(parent SQLNode, node *Max, replacer replac
 	}
 	return true
 }
-func (a *application) rewriteRefOfMemberOfExpr(parent SQLNode, node *MemberOfExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONSchemaValidFuncExpr(parent SQLNode, node *JSONSchemaValidFuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5151,13 +5743,13 @@ func (a *application) rewriteRefOfMemberOfExpr(parent SQLNode, node *MemberOfExp
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Value, func(newNode, parent SQLNode) {
-		parent.(*MemberOfExpr).Value = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Schema, func(newNode, parent SQLNode) {
+		parent.(*JSONSchemaValidFuncExpr).Schema = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.JSONArr, func(newNode, parent SQLNode) {
-		parent.(*MemberOfExpr).JSONArr = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Document, func(newNode, parent SQLNode) {
+		parent.(*JSONSchemaValidFuncExpr).Document = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -5171,7 +5763,7 @@ func (a *application) rewriteRefOfMemberOfExpr(parent SQLNode, node *MemberOfExp
 	}
 	return true
 }
-func (a *application) rewriteRefOfMin(parent SQLNode, node *Min, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONSchemaValidationReportFuncExpr(parent SQLNode, node *JSONSchemaValidationReportFuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5183,8 +5775,13 @@ func (a *application) rewriteRefOfMin(parent SQLNode, node *Min, replacer replac
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Arg, func(newNode, parent SQLNode) {
-		parent.(*Min).Arg = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Schema, func(newNode, parent SQLNode) {
+		parent.(*JSONSchemaValidationReportFuncExpr).Schema = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Document, func(newNode, parent SQLNode) {
+		parent.(*JSONSchemaValidationReportFuncExpr).Document = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -5198,7 +5795,7 @@ func (a *application) rewriteRefOfMin(parent SQLNode, node *Min, replacer replac
 	}
 	return true
 }
-func (a *application) rewriteRefOfModifyColumn(parent SQLNode, node *ModifyColumn, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONSearchExpr(parent SQLNode, node *JSONSearchExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5210,16 +5807,35 @@ func (a *application) rewriteRefOfModifyColumn(parent SQLNode, node *ModifyColum
 			return true
 		}
 	}
-	if !a.rewriteRefOfColumnDefinition(node, node.NewColDefinition, func(newNode, parent SQLNode) {
-		parent.(*ModifyColumn).NewColDefinition = newNode.(*ColumnDefinition)
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONSearchExpr).JSONDoc = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfColName(node, node.After, func(newNode, parent SQLNode) {
-		parent.(*ModifyColumn).After = newNode.(*ColName)
+	if !a.rewriteExpr(node, node.OneOrAll, func(newNode, parent SQLNode) {
+		parent.(*JSONSearchExpr).OneOrAll = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.SearchStr, func(newNode, parent SQLNode) {
+		parent.(*JSONSearchExpr).SearchStr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.EscapeChar, func(newNode, parent SQLNode) {
+		parent.(*JSONSearchExpr).EscapeChar = newNode.(Expr)
 	}) {
 		return false
 	}
+	for x, el := range node.PathList {
+		if !a.rewriteExpr(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*JSONSearchExpr).PathList[idx] = newNode.(Expr)
+			}
+		}(x)) {
+			return false
+		}
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -5230,7 +5846,7 @@ func (a *application) rewriteRefOfModifyColumn(parent SQLNode, node *ModifyColum
 	}
 	return true
 }
-func (a *application) rewriteRefOfMultiLinestringExpr(parent SQLNode, node *MultiLinestringExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONStorageFreeExpr(parent SQLNode, node *JSONStorageFreeExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5242,8 +5858,8 @@ func (a *application) rewriteRefOfMultiLinestringExpr(parent SQLNode, node *Mult
 			return true
 		}
 	}
-	if !a.rewriteExprs(node, node.LinestringParams, func(newNode, parent SQLNode) {
-		parent.(*MultiLinestringExpr).LinestringParams = newNode.(Exprs)
+	if !a.rewriteExpr(node, node.JSONVal, func(newNode, parent SQLNode) {
+		parent.(*JSONStorageFreeExpr).JSONVal = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -5257,7 +5873,7 @@ func (a *application) rewriteRefOfMultiLinestringExpr(parent SQLNode, node *Mult
 	}
 	return true
 }
-func (a *application) rewriteRefOfMultiPointExpr(parent SQLNode, node *MultiPointExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONStorageSizeExpr(parent SQLNode, node *JSONStorageSizeExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5269,8 +5885,8 @@ func (a *application) rewriteRefOfMultiPointExpr(parent SQLNode, node *MultiPoin
 			return true
 		}
 	}
-	if !a.rewriteExprs(node, node.PointParams, func(newNode, parent SQLNode) {
-		parent.(*MultiPointExpr).PointParams = newNode.(Exprs)
+	if !a.rewriteExpr(node, node.JSONVal, func(newNode, parent SQLNode) {
+		parent.(*JSONStorageSizeExpr).JSONVal = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -5284,7 +5900,7 @@ func (a *application) rewriteRefOfMultiPointExpr(parent SQLNode, node *MultiPoin
 	}
 	return true
 }
-func (a *application) rewriteRefOfMultiPolygonExpr(parent SQLNode, node *MultiPolygonExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONTableExpr(parent SQLNode, node *JSONTableExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5296,11 +5912,30 @@ func (a *application) rewriteRefOfMultiPolygonExpr(parent SQLNode, node *MultiPo
 			return true
 		}
 	}
-	if !a.rewriteExprs(node, node.PolygonParams, func(newNode, parent SQLNode) {
-		parent.(*MultiPolygonExpr).PolygonParams = newNode.(Exprs)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*JSONTableExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteIdentifierCS(node, node.Alias, func(newNode, parent SQLNode) {
+		parent.(*JSONTableExpr).Alias = newNode.(IdentifierCS)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Filter, func(newNode, parent SQLNode) {
+		parent.(*JSONTableExpr).Filter = newNode.(Expr)
 	}) {
 		return false
 	}
+	for x, el := range node.Columns {
+		if !a.rewriteRefOfJtColumnDefinition(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*JSONTableExpr).Columns[idx] = newNode.(*JtColumnDefinition)
+			}
+		}(x)) {
+			return false
+		}
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -5311,7 +5946,7 @@ func (a *application) rewriteRefOfMultiPolygonExpr(parent SQLNode, node *MultiPo
 	}
 	return true
 }
-func (a *application) rewriteRefOfNTHValueExpr(parent SQLNode, node *NTHValueExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONUnquoteExpr(parent SQLNode, node *JSONUnquoteExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5323,28 +5958,55 @@ func (a *application) rewriteRefOfNTHValueExpr(parent SQLNode, node *NTHValueExp
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*NTHValueExpr).Expr = newNode.(Expr)
+	if !a.rewriteExpr(node, node.JSONValue, func(newNode, parent SQLNode) {
+		parent.(*JSONUnquoteExpr).JSONValue = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.N, func(newNode, parent SQLNode) {
-		parent.(*NTHValueExpr).N = newNode.(Expr)
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfJSONValueExpr(parent SQLNode, node *JSONValueExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONValueExpr).JSONDoc = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfOverClause(node, node.OverClause, func(newNode, parent SQLNode) {
-		parent.(*NTHValueExpr).OverClause = newNode.(*OverClause)
+	if !a.rewriteExpr(node, node.Path, func(newNode, parent SQLNode) {
+		parent.(*JSONValueExpr).Path = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfFromFirstLastClause(node, node.FromFirstLastClause, func(newNode, parent SQLNode) {
-		parent.(*NTHValueExpr).FromFirstLastClause = newNode.(*FromFirstLastClause)
+	if !a.rewriteRefOfConvertType(node, node.ReturningType, func(newNode, parent SQLNode) {
+		parent.(*JSONValueExpr).ReturningType = newNode.(*ConvertType)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfNullTreatmentClause(node, node.NullTreatmentClause, func(newNode, parent SQLNode) {
-		parent.(*NTHValueExpr).NullTreatmentClause = newNode.(*NullTreatmentClause)
+	if !a.rewriteRefOfJtOnResponse(node, node.EmptyOnResponse, func(newNode, parent SQLNode) {
+		parent.(*JSONValueExpr).EmptyOnResponse = newNode.(*JtOnResponse)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfJtOnResponse(node, node.ErrorOnResponse, func(newNode, parent SQLNode) {
+		parent.(*JSONValueExpr).ErrorOnResponse = newNode.(*JtOnResponse)
 	}) {
 		return false
 	}
@@ -5358,7 +6020,7 @@ func (a *application) rewriteRefOfNTHValueExpr(parent SQLNode, node *NTHValueExp
 	}
 	return true
 }
-func (a *application) rewriteRefOfNamedWindow(parent SQLNode, node *NamedWindow, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONValueMergeExpr(parent SQLNode, node *JSONValueMergeExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5370,8 +6032,13 @@ func (a *application) rewriteRefOfNamedWindow(parent SQLNode, node *NamedWindow,
 			return true
 		}
 	}
-	if !a.rewriteWindowDefinitions(node, node.Windows, func(newNode, parent SQLNode) {
-		parent.(*NamedWindow).Windows = newNode.(WindowDefinitions)
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONValueMergeExpr).JSONDoc = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExprs(node, node.JSONDocList, func(newNode, parent SQLNode) {
+		parent.(*JSONValueMergeExpr).JSONDocList = newNode.(Exprs)
 	}) {
 		return false
 	}
@@ -5385,7 +6052,7 @@ func (a *application) rewriteRefOfNamedWindow(parent SQLNode, node *NamedWindow,
 	}
 	return true
 }
-func (a *application) rewriteNamedWindows(parent SQLNode, node NamedWindows, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJSONValueModifierExpr(parent SQLNode, node *JSONValueModifierExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5393,20 +6060,19 @@ func (a *application) rewriteNamedWindows(parent SQLNode, node NamedWindows, rep
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(NamedWindows)
-			a.cur.revisit = false
-			return a.rewriteNamedWindows(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteRefOfNamedWindow(node, el, func(idx int) replacerFunc {
+	if !a.rewriteExpr(node, node.JSONDoc, func(newNode, parent SQLNode) {
+		parent.(*JSONValueModifierExpr).JSONDoc = newNode.(Expr)
+	}) {
+		return false
+	}
+	for x, el := range node.Params {
+		if !a.rewriteRefOfJSONObjectParam(node, el, func(idx int) replacerFunc {
 			return func(newNode, parent SQLNode) {
-				parent.(NamedWindows)[idx] = newNode.(*NamedWindow)
+				parent.(*JSONValueModifierExpr).Params[idx] = newNode.(*JSONObjectParam)
 			}
 		}(x)) {
 			return false
@@ -5422,7 +6088,7 @@ func (a *application) rewriteNamedWindows(parent SQLNode, node NamedWindows, rep
 	}
 	return true
 }
-func (a *application) rewriteRefOfNextval(parent SQLNode, node *Nextval, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJoinCondition(parent SQLNode, node *JoinCondition, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5434,8 +6100,13 @@ func (a *application) rewriteRefOfNextval(parent SQLNode, node *Nextval, replace
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*Nextval).Expr = newNode.(Expr)
+	if !a.rewriteExpr(node, node.On, func(newNode, parent SQLNode) {
+		parent.(*JoinCondition).On = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteColumns(node, node.Using, func(newNode, parent SQLNode) {
+		parent.(*JoinCondition).Using = newNode.(Columns)
 	}) {
 		return false
 	}
@@ -5449,7 +6120,7 @@ func (a *application) rewriteRefOfNextval(parent SQLNode, node *Nextval, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfNotExpr(parent SQLNode, node *NotExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJoinTableExpr(parent SQLNode, node *JoinTableExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5461,8 +6132,18 @@ func (a *application) rewriteRefOfNotExpr(parent SQLNode, node *NotExpr, replace
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*NotExpr).Expr = newNode.(Expr)
+	if !a.rewriteTableExpr(node, node.LeftExpr, func(newNode, parent SQLNode) {
+		parent.(*JoinTableExpr).LeftExpr = newNode.(TableExpr)
+	}) {
+		return false
+	}
+	if !a.rewriteTableExpr(node, node.RightExpr, func(newNode, parent SQLNode) {
+		parent.(*JoinTableExpr).RightExpr = newNode.(TableExpr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfJoinCondition(node, node.Condition, func(newNode, parent SQLNode) {
+		parent.(*JoinTableExpr).Condition = newNode.(*JoinCondition)
 	}) {
 		return false
 	}
@@ -5476,7 +6157,7 @@ func (a *application) rewriteRefOfNotExpr(parent SQLNode, node *NotExpr, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfNtileExpr(parent SQLNode, node *NtileExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJtColumnDefinition(parent SQLNode, node *JtColumnDefinition, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5488,27 +6169,19 @@ func (a *application) rewriteRefOfNtileExpr(parent SQLNode, node *NtileExpr, rep
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.N, func(newNode, parent SQLNode) {
-		parent.(*NtileExpr).N = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfOverClause(node, node.OverClause, func(newNode, parent SQLNode) {
-		parent.(*NtileExpr).OverClause = newNode.(*OverClause)
-	}) {
-		return false
-	}
 	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfNullTreatmentClause(parent SQLNode, node *NullTreatmentClause, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfJtOnResponse(parent SQLNode, node *JtOnResponse, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5520,19 +6193,22 @@ func (a *application) rewriteRefOfNullTreatmentClause(parent SQLNode, node *Null
 			return true
 		}
 	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*JtOnResponse).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfNullVal(parent SQLNode, node *NullVal, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfKeyState(parent SQLNode, node *KeyState, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5556,7 +6232,7 @@ func (a *application) rewriteRefOfNullVal(parent SQLNode, node *NullVal, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfOffset(parent SQLNode, node *Offset, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfLagLeadExpr(parent SQLNode, node *LagLeadExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5568,8 +6244,28 @@ func (a *application) rewriteRefOfOffset(parent SQLNode, node *Offset, replacer
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Original, func(newNode, parent SQLNode) {
-		parent.(*Offset).Original = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*LagLeadExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.N, func(newNode, parent SQLNode) {
+		parent.(*LagLeadExpr).N = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Default, func(newNode, parent SQLNode) {
+		parent.(*LagLeadExpr).Default = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfOverClause(node, node.OverClause, func(newNode, parent SQLNode) {
+		parent.(*LagLeadExpr).OverClause = newNode.(*OverClause)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfNullTreatmentClause(node, node.NullTreatmentClause, func(newNode, parent SQLNode) {
+		parent.(*LagLeadExpr).NullTreatmentClause = newNode.(*NullTreatmentClause)
 	}) {
 		return false
 	}
@@ -5583,7 +6279,7 @@ func (a *application) rewriteRefOfOffset(parent SQLNode, node *Offset, replacer
 	}
 	return true
 }
-func (a *application) rewriteOnDup(parent SQLNode, node OnDup, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfLeaveStatement(parent SQLNode, node *LeaveStatement, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5591,24 +6287,14 @@ func (a *application) rewriteOnDup(parent SQLNode, node OnDup, replacer replacer
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(OnDup)
-			a.cur.revisit = false
-			return a.rewriteOnDup(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteRefOfUpdateExpr(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(OnDup)[idx] = newNode.(*UpdateExpr)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteIdentifierCI(node, node.Label, func(newNode, parent SQLNode) {
+		parent.(*LeaveStatement).Label = newNode.(IdentifierCI)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -5620,7 +6306,7 @@ func (a *application) rewriteOnDup(parent SQLNode, node OnDup, replacer replacer
 	}
 	return true
 }
-func (a *application) rewriteRefOfOptLike(parent SQLNode, node *OptLike, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfLimit(parent SQLNode, node *Limit, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5632,8 +6318,13 @@ func (a *application) rewriteRefOfOptLike(parent SQLNode, node *OptLike, replace
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.LikeTable, func(newNode, parent SQLNode) {
-		parent.(*OptLike).LikeTable = newNode.(TableName)
+	if !a.rewriteExpr(node, node.Offset, func(newNode, parent SQLNode) {
+		parent.(*Limit).Offset = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Rowcount, func(newNode, parent SQLNode) {
+		parent.(*Limit).Rowcount = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -5647,7 +6338,7 @@ func (a *application) rewriteRefOfOptLike(parent SQLNode, node *OptLike, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfOrExpr(parent SQLNode, node *OrExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfLineStringExpr(parent SQLNode, node *LineStringExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5659,13 +6350,8 @@ func (a *application) rewriteRefOfOrExpr(parent SQLNode, node *OrExpr, replacer
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Left, func(newNode, parent SQLNode) {
-		parent.(*OrExpr).Left = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Right, func(newNode, parent SQLNode) {
-		parent.(*OrExpr).Right = newNode.(Expr)
+	if !a.rewriteExprs(node, node.PointParams, func(newNode, parent SQLNode) {
+		parent.(*LineStringExpr).PointParams = newNode.(Exprs)
 	}) {
 		return false
 	}
@@ -5679,7 +6365,7 @@ func (a *application) rewriteRefOfOrExpr(parent SQLNode, node *OrExpr, replacer
 	}
 	return true
 }
-func (a *application) rewriteRefOfOrder(parent SQLNode, node *Order, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfLinestrPropertyFuncExpr(parent SQLNode, node *LinestrPropertyFuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5691,8 +6377,13 @@ func (a *application) rewriteRefOfOrder(parent SQLNode, node *Order, replacer re
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*Order).Expr = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Linestring, func(newNode, parent SQLNode) {
+		parent.(*LinestrPropertyFuncExpr).Linestring = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.PropertyDefArg, func(newNode, parent SQLNode) {
+		parent.(*LinestrPropertyFuncExpr).PropertyDefArg = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -5706,7 +6397,7 @@ func (a *application) rewriteRefOfOrder(parent SQLNode, node *Order, replacer re
 	}
 	return true
 }
-func (a *application) rewriteOrderBy(parent SQLNode, node OrderBy, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfLiteral(parent SQLNode, node *Literal, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5714,36 +6405,23 @@ func (a *application) rewriteOrderBy(parent SQLNode, node OrderBy, replacer repl
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(OrderBy)
-			a.cur.revisit = false
-			return a.rewriteOrderBy(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteRefOfOrder(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(OrderBy)[idx] = newNode.(*Order)
-			}
-		}(x)) {
-			return false
-		}
-	}
 	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfOrderByOption(parent SQLNode, node *OrderByOption, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfLoad(parent SQLNode, node *Load, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5755,8 +6433,38 @@ func (a *application) rewriteRefOfOrderByOption(parent SQLNode, node *OrderByOpt
 			return true
 		}
 	}
-	if !a.rewriteColumns(node, node.Cols, func(newNode, parent SQLNode) {
-		parent.(*OrderByOption).Cols = newNode.(Columns)
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*Load).Table = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewritePartitions(node, node.Partitions, func(newNode, parent SQLNode) {
+		parent.(*Load).Partitions = newNode.(Partitions)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLoadFields(node, node.Fields, func(newNode, parent SQLNode) {
+		parent.(*Load).Fields = newNode.(*LoadFields)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLoadLines(node, node.Lines, func(newNode, parent SQLNode) {
+		parent.(*Load).Lines = newNode.(*LoadLines)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.IgnoreLines, func(newNode, parent SQLNode) {
+		parent.(*Load).IgnoreLines = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteExprs(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*Load).Columns = newNode.(Exprs)
+	}) {
+		return false
+	}
+	if !a.rewriteUpdateExprs(node, node.SetExprs, func(newNode, parent SQLNode) {
+		parent.(*Load).SetExprs = newNode.(UpdateExprs)
 	}) {
 		return false
 	}
@@ -5770,7 +6478,7 @@ func (a *application) rewriteRefOfOrderByOption(parent SQLNode, node *OrderByOpt
 	}
 	return true
 }
-func (a *application) rewriteRefOfOtherAdmin(parent SQLNode, node *OtherAdmin, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfLoadFields(parent SQLNode, node *LoadFields, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5782,19 +6490,32 @@ func (a *application) rewriteRefOfOtherAdmin(parent SQLNode, node *OtherAdmin, r
 			return true
 		}
 	}
+	if !a.rewriteRefOfLiteral(node, node.TerminatedBy, func(newNode, parent SQLNode) {
+		parent.(*LoadFields).TerminatedBy = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.EnclosedBy, func(newNode, parent SQLNode) {
+		parent.(*LoadFields).EnclosedBy = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.EscapedBy, func(newNode, parent SQLNode) {
+		parent.(*LoadFields).EscapedBy = newNode.(*Literal)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfOtherRead(parent SQLNode, node *OtherRead, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfLoadLines(parent SQLNode, node *LoadLines, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5806,19 +6527,27 @@ func (a *application) rewriteRefOfOtherRead(parent SQLNode, node *OtherRead, rep
 			return true
 		}
 	}
+	if !a.rewriteRefOfLiteral(node, node.StartingBy, func(newNode, parent SQLNode) {
+		parent.(*LoadLines).StartingBy = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.TerminatedBy, func(newNode, parent SQLNode) {
+		parent.(*LoadLines).TerminatedBy = newNode.(*Literal)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfOverClause(parent SQLNode, node *OverClause, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfLocateExpr(parent SQLNode, node *LocateExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5830,13 +6559,18 @@ func (a *application) rewriteRefOfOverClause(parent SQLNode, node *OverClause, r
 			return true
 		}
 	}
-	if !a.rewriteIdentifierCI(node, node.WindowName, func(newNode, parent SQLNode) {
-		parent.(*OverClause).WindowName = newNode.(IdentifierCI)
+	if !a.rewriteExpr(node, node.SubStr, func(newNode, parent SQLNode) {
+		parent.(*LocateExpr).SubStr = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfWindowSpecification(node, node.WindowSpec, func(newNode, parent SQLNode) {
-		parent.(*OverClause).WindowSpec = newNode.(*WindowSpecification)
+	if !a.rewriteExpr(node, node.Str, func(newNode, parent SQLNode) {
+		parent.(*LocateExpr).Str = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Pos, func(newNode, parent SQLNode) {
+		parent.(*LocateExpr).Pos = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -5850,7 +6584,7 @@ func (a *application) rewriteRefOfOverClause(parent SQLNode, node *OverClause, r
 	}
 	return true
 }
-func (a *application) rewriteRefOfParenTableExpr(parent SQLNode, node *ParenTableExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfLockOption(parent SQLNode, node *LockOption, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5862,22 +6596,19 @@ func (a *application) rewriteRefOfParenTableExpr(parent SQLNode, node *ParenTabl
 			return true
 		}
 	}
-	if !a.rewriteTableExprs(node, node.Exprs, func(newNode, parent SQLNode) {
-		parent.(*ParenTableExpr).Exprs = newNode.(TableExprs)
-	}) {
-		return false
-	}
 	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfParsedComments(parent SQLNode, node *ParsedComments, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfLockTables(parent SQLNode, node *LockTables, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5901,7 +6632,7 @@ func (a *application) rewriteRefOfParsedComments(parent SQLNode, node *ParsedCom
 	}
 	return true
 }
-func (a *application) rewriteRefOfPartitionDefinition(parent SQLNode, node *PartitionDefinition, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfLockingFunc(parent SQLNode, node *LockingFunc, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5913,13 +6644,13 @@ func (a *application) rewriteRefOfPartitionDefinition(parent SQLNode, node *Part
 			return true
 		}
 	}
-	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*PartitionDefinition).Name = newNode.(IdentifierCI)
+	if !a.rewriteExpr(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*LockingFunc).Name = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfPartitionDefinitionOptions(node, node.Options, func(newNode, parent SQLNode) {
-		parent.(*PartitionDefinition).Options = newNode.(*PartitionDefinitionOptions)
+	if !a.rewriteExpr(node, node.Timeout, func(newNode, parent SQLNode) {
+		parent.(*LockingFunc).Timeout = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -5933,7 +6664,7 @@ func (a *application) rewriteRefOfPartitionDefinition(parent SQLNode, node *Part
 	}
 	return true
 }
-func (a *application) rewriteRefOfPartitionDefinitionOptions(parent SQLNode, node *PartitionDefinitionOptions, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfLoopStatement(parent SQLNode, node *LoopStatement, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5945,33 +6676,13 @@ func (a *application) rewriteRefOfPartitionDefinitionOptions(parent SQLNode, nod
 			return true
 		}
 	}
-	if !a.rewriteRefOfPartitionValueRange(node, node.ValueRange, func(newNode, parent SQLNode) {
-		parent.(*PartitionDefinitionOptions).ValueRange = newNode.(*PartitionValueRange)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfLiteral(node, node.Comment, func(newNode, parent SQLNode) {
-		parent.(*PartitionDefinitionOptions).Comment = newNode.(*Literal)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfPartitionEngine(node, node.Engine, func(newNode, parent SQLNode) {
-		parent.(*PartitionDefinitionOptions).Engine = newNode.(*PartitionEngine)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfLiteral(node, node.DataDirectory, func(newNode, parent SQLNode) {
-		parent.(*PartitionDefinitionOptions).DataDirectory = newNode.(*Literal)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfLiteral(node, node.IndexDirectory, func(newNode, parent SQLNode) {
-		parent.(*PartitionDefinitionOptions).IndexDirectory = newNode.(*Literal)
+	if !a.rewriteIdentifierCI(node, node.Label, func(newNode, parent SQLNode) {
+		parent.(*LoopStatement).Label = newNode.(IdentifierCI)
 	}) {
 		return false
 	}
-	if !a.rewriteSubPartitionDefinitions(node, node.SubPartitionDefinitions, func(newNode, parent SQLNode) {
-		parent.(*PartitionDefinitionOptions).SubPartitionDefinitions = newNode.(SubPartitionDefinitions)
+	if !a.rewriteStatementList(node, node.Statements, func(newNode, parent SQLNode) {
+		parent.(*LoopStatement).Statements = newNode.(StatementList)
 	}) {
 		return false
 	}
@@ -5985,7 +6696,7 @@ func (a *application) rewriteRefOfPartitionDefinitionOptions(parent SQLNode, nod
 	}
 	return true
 }
-func (a *application) rewriteRefOfPartitionEngine(parent SQLNode, node *PartitionEngine, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfMatchExpr(parent SQLNode, node *MatchExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -5997,19 +6708,31 @@ func (a *application) rewriteRefOfPartitionEngine(parent SQLNode, node *Partitio
 			return true
 		}
 	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
+	for x, el := range node.Columns {
+		if !a.rewriteRefOfColName(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*MatchExpr).Columns[idx] = newNode.(*ColName)
+			}
+		}(x)) {
+			return false
 		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*MatchExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfPartitionOption(parent SQLNode, node *PartitionOption, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfMax(parent SQLNode, node *Max, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6021,30 +6744,11 @@ func (a *application) rewriteRefOfPartitionOption(parent SQLNode, node *Partitio
 			return true
 		}
 	}
-	if !a.rewriteColumns(node, node.ColList, func(newNode, parent SQLNode) {
-		parent.(*PartitionOption).ColList = newNode.(Columns)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*PartitionOption).Expr = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfSubPartition(node, node.SubPartition, func(newNode, parent SQLNode) {
-		parent.(*PartitionOption).SubPartition = newNode.(*SubPartition)
+	if !a.rewriteExpr(node, node.Arg, func(newNode, parent SQLNode) {
+		parent.(*Max).Arg = newNode.(Expr)
 	}) {
 		return false
 	}
-	for x, el := range node.Definitions {
-		if !a.rewriteRefOfPartitionDefinition(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*PartitionOption).Definitions[idx] = newNode.(*PartitionDefinition)
-			}
-		}(x)) {
-			return false
-		}
-	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -6055,7 +6759,7 @@ func (a *application) rewriteRefOfPartitionOption(parent SQLNode, node *Partitio
 	}
 	return true
 }
-func (a *application) rewriteRefOfPartitionSpec(parent SQLNode, node *PartitionSpec, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfMemberOfExpr(parent SQLNode, node *MemberOfExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6067,30 +6771,16 @@ func (a *application) rewriteRefOfPartitionSpec(parent SQLNode, node *PartitionS
 			return true
 		}
 	}
-	if !a.rewritePartitions(node, node.Names, func(newNode, parent SQLNode) {
-		parent.(*PartitionSpec).Names = newNode.(Partitions)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfLiteral(node, node.Number, func(newNode, parent SQLNode) {
-		parent.(*PartitionSpec).Number = newNode.(*Literal)
+	if !a.rewriteExpr(node, node.Value, func(newNode, parent SQLNode) {
+		parent.(*MemberOfExpr).Value = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteTableName(node, node.TableName, func(newNode, parent SQLNode) {
-		parent.(*PartitionSpec).TableName = newNode.(TableName)
+	if !a.rewriteExpr(node, node.JSONArr, func(newNode, parent SQLNode) {
+		parent.(*MemberOfExpr).JSONArr = newNode.(Expr)
 	}) {
 		return false
 	}
-	for x, el := range node.Definitions {
-		if !a.rewriteRefOfPartitionDefinition(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(*PartitionSpec).Definitions[idx] = newNode.(*PartitionDefinition)
-			}
-		}(x)) {
-			return false
-		}
-	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -6101,7 +6791,7 @@ func (a *application) rewriteRefOfPartitionSpec(parent SQLNode, node *PartitionS
 	}
 	return true
 }
-func (a *application) rewriteRefOfPartitionValueRange(parent SQLNode, node *PartitionValueRange, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfMin(parent SQLNode, node *Min, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6113,8 +6803,8 @@ func (a *application) rewriteRefOfPartitionValueRange(parent SQLNode, node *Part
 			return true
 		}
 	}
-	if !a.rewriteValTuple(node, node.Range, func(newNode, parent SQLNode) {
-		parent.(*PartitionValueRange).Range = newNode.(ValTuple)
+	if !a.rewriteExpr(node, node.Arg, func(newNode, parent SQLNode) {
+		parent.(*Min).Arg = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -6128,7 +6818,7 @@ func (a *application) rewriteRefOfPartitionValueRange(parent SQLNode, node *Part
 	}
 	return true
 }
-func (a *application) rewritePartitions(parent SQLNode, node Partitions, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfModifyColumn(parent SQLNode, node *ModifyColumn, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6136,24 +6826,19 @@ func (a *application) rewritePartitions(parent SQLNode, node Partitions, replace
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(Partitions)
-			a.cur.revisit = false
-			return a.rewritePartitions(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteIdentifierCI(node, el, func(idx int) replacerFunc {
-			return func(newNode, parent SQLNode) {
-				parent.(Partitions)[idx] = newNode.(IdentifierCI)
-			}
-		}(x)) {
-			return false
-		}
+	if !a.rewriteRefOfColumnDefinition(node, node.NewColDefinition, func(newNode, parent SQLNode) {
+		parent.(*ModifyColumn).NewColDefinition = newNode.(*ColumnDefinition)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfColName(node, node.After, func(newNode, parent SQLNode) {
+		parent.(*ModifyColumn).After = newNode.(*ColName)
+	}) {
+		return false
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -6165,7 +6850,7 @@ func (a *application) rewritePartitions(parent SQLNode, node Partitions, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfPerformanceSchemaFuncExpr(parent SQLNode, node *PerformanceSchemaFuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfMultiLinestringExpr(parent SQLNode, node *MultiLinestringExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6177,8 +6862,8 @@ func (a *application) rewriteRefOfPerformanceSchemaFuncExpr(parent SQLNode, node
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Argument, func(newNode, parent SQLNode) {
-		parent.(*PerformanceSchemaFuncExpr).Argument = newNode.(Expr)
+	if !a.rewriteExprs(node, node.LinestringParams, func(newNode, parent SQLNode) {
+		parent.(*MultiLinestringExpr).LinestringParams = newNode.(Exprs)
 	}) {
 		return false
 	}
@@ -6192,7 +6877,7 @@ func (a *application) rewriteRefOfPerformanceSchemaFuncExpr(parent SQLNode, node
 	}
 	return true
 }
-func (a *application) rewriteRefOfPointExpr(parent SQLNode, node *PointExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfMultiPointExpr(parent SQLNode, node *MultiPointExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6204,13 +6889,35 @@ func (a *application) rewriteRefOfPointExpr(parent SQLNode, node *PointExpr, rep
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.XCordinate, func(newNode, parent SQLNode) {
-		parent.(*PointExpr).XCordinate = newNode.(Expr)
-	}) {
+	if !a.rewriteExprs(node, node.PointParams, func(newNode, parent SQLNode) {
+		parent.(*MultiPointExpr).PointParams = newNode.(Exprs)
+	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.YCordinate, func(newNode, parent SQLNode) {
-		parent.(*PointExpr).YCordinate = newNode.(Expr)
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfMultiPolygonExpr(parent SQLNode, node *MultiPolygonExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExprs(node, node.PolygonParams, func(newNode, parent SQLNode) {
+		parent.(*MultiPolygonExpr).PolygonParams = newNode.(Exprs)
 	}) {
 		return false
 	}
@@ -6224,7 +6931,7 @@ func (a *application) rewriteRefOfPointExpr(parent SQLNode, node *PointExpr, rep
 	}
 	return true
 }
-func (a *application) rewriteRefOfPointPropertyFuncExpr(parent SQLNode, node *PointPropertyFuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfNTHValueExpr(parent SQLNode, node *NTHValueExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6236,13 +6943,28 @@ func (a *application) rewriteRefOfPointPropertyFuncExpr(parent SQLNode, node *Po
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Point, func(newNode, parent SQLNode) {
-		parent.(*PointPropertyFuncExpr).Point = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*NTHValueExpr).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.ValueToSet, func(newNode, parent SQLNode) {
-		parent.(*PointPropertyFuncExpr).ValueToSet = newNode.(Expr)
+	if !a.rewriteExpr(node, node.N, func(newNode, parent SQLNode) {
+		parent.(*NTHValueExpr).N = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfOverClause(node, node.OverClause, func(newNode, parent SQLNode) {
+		parent.(*NTHValueExpr).OverClause = newNode.(*OverClause)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfFromFirstLastClause(node, node.FromFirstLastClause, func(newNode, parent SQLNode) {
+		parent.(*NTHValueExpr).FromFirstLastClause = newNode.(*FromFirstLastClause)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfNullTreatmentClause(node, node.NullTreatmentClause, func(newNode, parent SQLNode) {
+		parent.(*NTHValueExpr).NullTreatmentClause = newNode.(*NullTreatmentClause)
 	}) {
 		return false
 	}
@@ -6256,7 +6978,7 @@ func (a *application) rewriteRefOfPointPropertyFuncExpr(parent SQLNode, node *Po
 	}
 	return true
 }
-func (a *application) rewriteRefOfPolygonExpr(parent SQLNode, node *PolygonExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfNamedWindow(parent SQLNode, node *NamedWindow, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6268,8 +6990,8 @@ func (a *application) rewriteRefOfPolygonExpr(parent SQLNode, node *PolygonExpr,
 			return true
 		}
 	}
-	if !a.rewriteExprs(node, node.LinestringParams, func(newNode, parent SQLNode) {
-		parent.(*PolygonExpr).LinestringParams = newNode.(Exprs)
+	if !a.rewriteWindowDefinitions(node, node.Windows, func(newNode, parent SQLNode) {
+		parent.(*NamedWindow).Windows = newNode.(WindowDefinitions)
 	}) {
 		return false
 	}
@@ -6283,7 +7005,7 @@ func (a *application) rewriteRefOfPolygonExpr(parent SQLNode, node *PolygonExpr,
 	}
 	return true
 }
-func (a *application) rewriteRefOfPolygonPropertyFuncExpr(parent SQLNode, node *PolygonPropertyFuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteNamedWindows(parent SQLNode, node NamedWindows, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6291,17 +7013,49 @@ func (a *application) rewriteRefOfPolygonPropertyFuncExpr(parent SQLNode, node *
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(NamedWindows)
+			a.cur.revisit = false
+			return a.rewriteNamedWindows(parent, node, replacer)
+		}
+		if kontinue {
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Polygon, func(newNode, parent SQLNode) {
-		parent.(*PolygonPropertyFuncExpr).Polygon = newNode.(Expr)
-	}) {
-		return false
+	for x, el := range node {
+		if !a.rewriteRefOfNamedWindow(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(NamedWindows)[idx] = newNode.(*NamedWindow)
+			}
+		}(x)) {
+			return false
+		}
 	}
-	if !a.rewriteExpr(node, node.PropertyDefArg, func(newNode, parent SQLNode) {
-		parent.(*PolygonPropertyFuncExpr).PropertyDefArg = newNode.(Expr)
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfNextval(parent SQLNode, node *Nextval, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*Nextval).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -6315,7 +7069,7 @@ func (a *application) rewriteRefOfPolygonPropertyFuncExpr(parent SQLNode, node *
 	}
 	return true
 }
-func (a *application) rewriteRefOfPrepareStmt(parent SQLNode, node *PrepareStmt, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfNotExpr(parent SQLNode, node *NotExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6327,18 +7081,40 @@ func (a *application) rewriteRefOfPrepareStmt(parent SQLNode, node *PrepareStmt,
 			return true
 		}
 	}
-	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*PrepareStmt).Name = newNode.(IdentifierCI)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*NotExpr).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Statement, func(newNode, parent SQLNode) {
-		parent.(*PrepareStmt).Statement = newNode.(Expr)
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfNtileExpr(parent SQLNode, node *NtileExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.N, func(newNode, parent SQLNode) {
+		parent.(*NtileExpr).N = newNode.(Expr)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*PrepareStmt).Comments = newNode.(*ParsedComments)
+	if !a.rewriteRefOfOverClause(node, node.OverClause, func(newNode, parent SQLNode) {
+		parent.(*NtileExpr).OverClause = newNode.(*OverClause)
 	}) {
 		return false
 	}
@@ -6352,7 +7128,7 @@ func (a *application) rewriteRefOfPrepareStmt(parent SQLNode, node *PrepareStmt,
 	}
 	return true
 }
-func (a *application) rewriteRefOfPurgeBinaryLogs(parent SQLNode, node *PurgeBinaryLogs, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfNullTreatmentClause(parent SQLNode, node *NullTreatmentClause, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6376,7 +7152,7 @@ func (a *application) rewriteRefOfPurgeBinaryLogs(parent SQLNode, node *PurgeBin
 	}
 	return true
 }
-func (a *application) rewriteRefOfReferenceDefinition(parent SQLNode, node *ReferenceDefinition, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfNullVal(parent SQLNode, node *NullVal, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6388,28 +7164,32 @@ func (a *application) rewriteRefOfReferenceDefinition(parent SQLNode, node *Refe
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.ReferencedTable, func(newNode, parent SQLNode) {
-		parent.(*ReferenceDefinition).ReferencedTable = newNode.(TableName)
-	}) {
-		return false
-	}
-	if !a.rewriteColumns(node, node.ReferencedColumns, func(newNode, parent SQLNode) {
-		parent.(*ReferenceDefinition).ReferencedColumns = newNode.(Columns)
-	}) {
-		return false
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
 	}
-	if !a.rewriteMatchAction(node, node.Match, func(newNode, parent SQLNode) {
-		parent.(*ReferenceDefinition).Match = newNode.(MatchAction)
-	}) {
-		return false
+	return true
+}
+func (a *application) rewriteRefOfOffset(parent SQLNode, node *Offset, replacer replacerFunc) bool {
+	if node == nil {
+		return true
 	}
-	if !a.rewriteReferenceAction(node, node.OnDelete, func(newNode, parent SQLNode) {
-		parent.(*ReferenceDefinition).OnDelete = newNode.(ReferenceAction)
-	}) {
-		return false
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
 	}
-	if !a.rewriteReferenceAction(node, node.OnUpdate, func(newNode, parent SQLNode) {
-		parent.(*ReferenceDefinition).OnUpdate = newNode.(ReferenceAction)
+	if !a.rewriteExpr(node, node.Original, func(newNode, parent SQLNode) {
+		parent.(*Offset).Original = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -6423,7 +7203,7 @@ func (a *application) rewriteRefOfReferenceDefinition(parent SQLNode, node *Refe
 	}
 	return true
 }
-func (a *application) rewriteRefOfRegexpInstrExpr(parent SQLNode, node *RegexpInstrExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfOnConflict(parent SQLNode, node *OnConflict, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6435,33 +7215,23 @@ func (a *application) rewriteRefOfRegexpInstrExpr(parent SQLNode, node *RegexpIn
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*RegexpInstrExpr).Expr = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Pattern, func(newNode, parent SQLNode) {
-		parent.(*RegexpInstrExpr).Pattern = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Position, func(newNode, parent SQLNode) {
-		parent.(*RegexpInstrExpr).Position = newNode.(Expr)
+	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*OnConflict).Columns = newNode.(Columns)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Occurrence, func(newNode, parent SQLNode) {
-		parent.(*RegexpInstrExpr).Occurrence = newNode.(Expr)
+	if !a.rewriteIdentifierCI(node, node.Constraint, func(newNode, parent SQLNode) {
+		parent.(*OnConflict).Constraint = newNode.(IdentifierCI)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.ReturnOption, func(newNode, parent SQLNode) {
-		parent.(*RegexpInstrExpr).ReturnOption = newNode.(Expr)
+	if !a.rewriteUpdateExprs(node, node.Exprs, func(newNode, parent SQLNode) {
+		parent.(*OnConflict).Exprs = newNode.(UpdateExprs)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.MatchType, func(newNode, parent SQLNode) {
-		parent.(*RegexpInstrExpr).MatchType = newNode.(Expr)
+	if !a.rewriteRefOfWhere(node, node.Where, func(newNode, parent SQLNode) {
+		parent.(*OnConflict).Where = newNode.(*Where)
 	}) {
 		return false
 	}
@@ -6475,7 +7245,7 @@ func (a *application) rewriteRefOfRegexpInstrExpr(parent SQLNode, node *RegexpIn
 	}
 	return true
 }
-func (a *application) rewriteRefOfRegexpLikeExpr(parent SQLNode, node *RegexpLikeExpr, replacer replacerFunc) bool {
+func (a *application) rewriteOnDup(parent SQLNode, node OnDup, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6483,24 +7253,24 @@ func (a *application) rewriteRefOfRegexpLikeExpr(parent SQLNode, node *RegexpLik
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(OnDup)
+			a.cur.revisit = false
+			return a.rewriteOnDup(parent, node, replacer)
+		}
+		if kontinue {
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*RegexpLikeExpr).Expr = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Pattern, func(newNode, parent SQLNode) {
-		parent.(*RegexpLikeExpr).Pattern = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.MatchType, func(newNode, parent SQLNode) {
-		parent.(*RegexpLikeExpr).MatchType = newNode.(Expr)
-	}) {
-		return false
+	for x, el := range node {
+		if !a.rewriteRefOfUpdateExpr(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(OnDup)[idx] = newNode.(*UpdateExpr)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -6512,7 +7282,7 @@ func (a *application) rewriteRefOfRegexpLikeExpr(parent SQLNode, node *RegexpLik
 	}
 	return true
 }
-func (a *application) rewriteRefOfRegexpReplaceExpr(parent SQLNode, node *RegexpReplaceExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfOpenCursor(parent SQLNode, node *OpenCursor, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6524,33 +7294,1319 @@ func (a *application) rewriteRefOfRegexpReplaceExpr(parent SQLNode, node *Regexp
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*RegexpReplaceExpr).Expr = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Pattern, func(newNode, parent SQLNode) {
-		parent.(*RegexpReplaceExpr).Pattern = newNode.(Expr)
+	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*OpenCursor).Name = newNode.(IdentifierCI)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Repl, func(newNode, parent SQLNode) {
-		parent.(*RegexpReplaceExpr).Repl = newNode.(Expr)
-	}) {
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfOptLike(parent SQLNode, node *OptLike, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.LikeTable, func(newNode, parent SQLNode) {
+		parent.(*OptLike).LikeTable = newNode.(TableName)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfOrExpr(parent SQLNode, node *OrExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Left, func(newNode, parent SQLNode) {
+		parent.(*OrExpr).Left = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Right, func(newNode, parent SQLNode) {
+		parent.(*OrExpr).Right = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfOrder(parent SQLNode, node *Order, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*Order).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteOrderBy(parent SQLNode, node OrderBy, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(OrderBy)
+			a.cur.revisit = false
+			return a.rewriteOrderBy(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	for x, el := range node {
+		if !a.rewriteRefOfOrder(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(OrderBy)[idx] = newNode.(*Order)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfOrderByOption(parent SQLNode, node *OrderByOption, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColumns(node, node.Cols, func(newNode, parent SQLNode) {
+		parent.(*OrderByOption).Cols = newNode.(Columns)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfOtherAdmin(parent SQLNode, node *OtherAdmin, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfOtherRead(parent SQLNode, node *OtherRead, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfOverClause(parent SQLNode, node *OverClause, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteIdentifierCI(node, node.WindowName, func(newNode, parent SQLNode) {
+		parent.(*OverClause).WindowName = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfWindowSpecification(node, node.WindowSpec, func(newNode, parent SQLNode) {
+		parent.(*OverClause).WindowSpec = newNode.(*WindowSpecification)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfParenTableExpr(parent SQLNode, node *ParenTableExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableExprs(node, node.Exprs, func(newNode, parent SQLNode) {
+		parent.(*ParenTableExpr).Exprs = newNode.(TableExprs)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfParsedComments(parent SQLNode, node *ParsedComments, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPartitionDefinition(parent SQLNode, node *PartitionDefinition, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*PartitionDefinition).Name = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfPartitionDefinitionOptions(node, node.Options, func(newNode, parent SQLNode) {
+		parent.(*PartitionDefinition).Options = newNode.(*PartitionDefinitionOptions)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPartitionDefinitionOptions(parent SQLNode, node *PartitionDefinitionOptions, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfPartitionValueRange(node, node.ValueRange, func(newNode, parent SQLNode) {
+		parent.(*PartitionDefinitionOptions).ValueRange = newNode.(*PartitionValueRange)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.Comment, func(newNode, parent SQLNode) {
+		parent.(*PartitionDefinitionOptions).Comment = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfPartitionEngine(node, node.Engine, func(newNode, parent SQLNode) {
+		parent.(*PartitionDefinitionOptions).Engine = newNode.(*PartitionEngine)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.DataDirectory, func(newNode, parent SQLNode) {
+		parent.(*PartitionDefinitionOptions).DataDirectory = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.IndexDirectory, func(newNode, parent SQLNode) {
+		parent.(*PartitionDefinitionOptions).IndexDirectory = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteSubPartitionDefinitions(node, node.SubPartitionDefinitions, func(newNode, parent SQLNode) {
+		parent.(*PartitionDefinitionOptions).SubPartitionDefinitions = newNode.(SubPartitionDefinitions)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPartitionEngine(parent SQLNode, node *PartitionEngine, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPartitionOption(parent SQLNode, node *PartitionOption, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColumns(node, node.ColList, func(newNode, parent SQLNode) {
+		parent.(*PartitionOption).ColList = newNode.(Columns)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*PartitionOption).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfSubPartition(node, node.SubPartition, func(newNode, parent SQLNode) {
+		parent.(*PartitionOption).SubPartition = newNode.(*SubPartition)
+	}) {
+		return false
+	}
+	for x, el := range node.Definitions {
+		if !a.rewriteRefOfPartitionDefinition(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*PartitionOption).Definitions[idx] = newNode.(*PartitionDefinition)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPartitionSpec(parent SQLNode, node *PartitionSpec, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewritePartitions(node, node.Names, func(newNode, parent SQLNode) {
+		parent.(*PartitionSpec).Names = newNode.(Partitions)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfLiteral(node, node.Number, func(newNode, parent SQLNode) {
+		parent.(*PartitionSpec).Number = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.TableName, func(newNode, parent SQLNode) {
+		parent.(*PartitionSpec).TableName = newNode.(TableName)
+	}) {
+		return false
+	}
+	for x, el := range node.Definitions {
+		if !a.rewriteRefOfPartitionDefinition(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*PartitionSpec).Definitions[idx] = newNode.(*PartitionDefinition)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPartitionValueRange(parent SQLNode, node *PartitionValueRange, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteValTuple(node, node.Range, func(newNode, parent SQLNode) {
+		parent.(*PartitionValueRange).Range = newNode.(ValTuple)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewritePartitions(parent SQLNode, node Partitions, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(Partitions)
+			a.cur.revisit = false
+			return a.rewritePartitions(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	for x, el := range node {
+		if !a.rewriteIdentifierCI(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(Partitions)[idx] = newNode.(IdentifierCI)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPasswordExpire(parent SQLNode, node *PasswordExpire, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfLiteral(node, node.Days, func(newNode, parent SQLNode) {
+		parent.(*PasswordExpire).Days = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPerformanceSchemaFuncExpr(parent SQLNode, node *PerformanceSchemaFuncExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Argument, func(newNode, parent SQLNode) {
+		parent.(*PerformanceSchemaFuncExpr).Argument = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPointExpr(parent SQLNode, node *PointExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.XCordinate, func(newNode, parent SQLNode) {
+		parent.(*PointExpr).XCordinate = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.YCordinate, func(newNode, parent SQLNode) {
+		parent.(*PointExpr).YCordinate = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPointPropertyFuncExpr(parent SQLNode, node *PointPropertyFuncExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Point, func(newNode, parent SQLNode) {
+		parent.(*PointPropertyFuncExpr).Point = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.ValueToSet, func(newNode, parent SQLNode) {
+		parent.(*PointPropertyFuncExpr).ValueToSet = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPolygonExpr(parent SQLNode, node *PolygonExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExprs(node, node.LinestringParams, func(newNode, parent SQLNode) {
+		parent.(*PolygonExpr).LinestringParams = newNode.(Exprs)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPolygonPropertyFuncExpr(parent SQLNode, node *PolygonPropertyFuncExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Polygon, func(newNode, parent SQLNode) {
+		parent.(*PolygonPropertyFuncExpr).Polygon = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.PropertyDefArg, func(newNode, parent SQLNode) {
+		parent.(*PolygonPropertyFuncExpr).PropertyDefArg = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPositionalArg(parent SQLNode, node *PositionalArg, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPrepareStmt(parent SQLNode, node *PrepareStmt, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*PrepareStmt).Name = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Statement, func(newNode, parent SQLNode) {
+		parent.(*PrepareStmt).Statement = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*PrepareStmt).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPrivilegeLevel(parent SQLNode, node *PrivilegeLevel, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteIdentifierCS(node, node.DB, func(newNode, parent SQLNode) {
+		parent.(*PrivilegeLevel).DB = newNode.(IdentifierCS)
+	}) {
+		return false
+	}
+	if !a.rewriteIdentifierCS(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*PrivilegeLevel).Name = newNode.(IdentifierCS)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfProcParameter(parent SQLNode, node *ProcParameter, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*ProcParameter).Name = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfColumnType(node, node.Type, func(newNode, parent SQLNode) {
+		parent.(*ProcParameter).Type = newNode.(*ColumnType)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPurgeBinaryLogs(parent SQLNode, node *PurgeBinaryLogs, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfReferenceDefinition(parent SQLNode, node *ReferenceDefinition, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.ReferencedTable, func(newNode, parent SQLNode) {
+		parent.(*ReferenceDefinition).ReferencedTable = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteColumns(node, node.ReferencedColumns, func(newNode, parent SQLNode) {
+		parent.(*ReferenceDefinition).ReferencedColumns = newNode.(Columns)
+	}) {
+		return false
+	}
+	if !a.rewriteMatchAction(node, node.Match, func(newNode, parent SQLNode) {
+		parent.(*ReferenceDefinition).Match = newNode.(MatchAction)
+	}) {
+		return false
+	}
+	if !a.rewriteReferenceAction(node, node.OnDelete, func(newNode, parent SQLNode) {
+		parent.(*ReferenceDefinition).OnDelete = newNode.(ReferenceAction)
+	}) {
+		return false
+	}
+	if !a.rewriteReferenceAction(node, node.OnUpdate, func(newNode, parent SQLNode) {
+		parent.(*ReferenceDefinition).OnUpdate = newNode.(ReferenceAction)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRegexpInstrExpr(parent SQLNode, node *RegexpInstrExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*RegexpInstrExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Pattern, func(newNode, parent SQLNode) {
+		parent.(*RegexpInstrExpr).Pattern = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Position, func(newNode, parent SQLNode) {
+		parent.(*RegexpInstrExpr).Position = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Occurrence, func(newNode, parent SQLNode) {
+		parent.(*RegexpInstrExpr).Occurrence = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.ReturnOption, func(newNode, parent SQLNode) {
+		parent.(*RegexpInstrExpr).ReturnOption = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.MatchType, func(newNode, parent SQLNode) {
+		parent.(*RegexpInstrExpr).MatchType = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRegexpLikeExpr(parent SQLNode, node *RegexpLikeExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*RegexpLikeExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Pattern, func(newNode, parent SQLNode) {
+		parent.(*RegexpLikeExpr).Pattern = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.MatchType, func(newNode, parent SQLNode) {
+		parent.(*RegexpLikeExpr).MatchType = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRegexpReplaceExpr(parent SQLNode, node *RegexpReplaceExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*RegexpReplaceExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Pattern, func(newNode, parent SQLNode) {
+		parent.(*RegexpReplaceExpr).Pattern = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Repl, func(newNode, parent SQLNode) {
+		parent.(*RegexpReplaceExpr).Repl = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Occurrence, func(newNode, parent SQLNode) {
+		parent.(*RegexpReplaceExpr).Occurrence = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Position, func(newNode, parent SQLNode) {
+		parent.(*RegexpReplaceExpr).Position = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.MatchType, func(newNode, parent SQLNode) {
+		parent.(*RegexpReplaceExpr).MatchType = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRegexpSubstrExpr(parent SQLNode, node *RegexpSubstrExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*RegexpSubstrExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Pattern, func(newNode, parent SQLNode) {
+		parent.(*RegexpSubstrExpr).Pattern = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Occurrence, func(newNode, parent SQLNode) {
+		parent.(*RegexpSubstrExpr).Occurrence = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Position, func(newNode, parent SQLNode) {
+		parent.(*RegexpSubstrExpr).Position = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.MatchType, func(newNode, parent SQLNode) {
+		parent.(*RegexpSubstrExpr).MatchType = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRelease(parent SQLNode, node *Release, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*Release).Name = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRenameColumn(parent SQLNode, node *RenameColumn, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfColName(node, node.OldName, func(newNode, parent SQLNode) {
+		parent.(*RenameColumn).OldName = newNode.(*ColName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfColName(node, node.NewName, func(newNode, parent SQLNode) {
+		parent.(*RenameColumn).NewName = newNode.(*ColName)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRenameIndex(parent SQLNode, node *RenameIndex, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteIdentifierCI(node, node.OldName, func(newNode, parent SQLNode) {
+		parent.(*RenameIndex).OldName = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
+	if !a.rewriteIdentifierCI(node, node.NewName, func(newNode, parent SQLNode) {
+		parent.(*RenameIndex).NewName = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRenameTable(parent SQLNode, node *RenameTable, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRenameTableName(parent SQLNode, node *RenameTableName, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*RenameTableName).Table = newNode.(TableName)
+	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Occurrence, func(newNode, parent SQLNode) {
-		parent.(*RegexpReplaceExpr).Occurrence = newNode.(Expr)
-	}) {
-		return false
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRenameUser(parent SQLNode, node *RenameUser, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	for x, el := range node.UserPairs {
+		if !a.rewriteRefOfRenameUserPair(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*RenameUser).UserPairs[idx] = newNode.(*RenameUserPair)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRenameUserPair(parent SQLNode, node *RenameUserPair, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
 	}
-	if !a.rewriteExpr(node, node.Position, func(newNode, parent SQLNode) {
-		parent.(*RegexpReplaceExpr).Position = newNode.(Expr)
+	if !a.rewriteRefOfAccount(node, node.FromUser, func(newNode, parent SQLNode) {
+		parent.(*RenameUserPair).FromUser = newNode.(*Account)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.MatchType, func(newNode, parent SQLNode) {
-		parent.(*RegexpReplaceExpr).MatchType = newNode.(Expr)
+	if !a.rewriteRefOfAccount(node, node.ToUser, func(newNode, parent SQLNode) {
+		parent.(*RenameUserPair).ToUser = newNode.(*Account)
 	}) {
 		return false
 	}
@@ -6564,7 +8620,7 @@ func (a *application) rewriteRefOfRegexpReplaceExpr(parent SQLNode, node *Regexp
 	}
 	return true
 }
-func (a *application) rewriteRefOfRegexpSubstrExpr(parent SQLNode, node *RegexpSubstrExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfRepeatStatement(parent SQLNode, node *RepeatStatement, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6576,28 +8632,18 @@ func (a *application) rewriteRefOfRegexpSubstrExpr(parent SQLNode, node *RegexpS
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*RegexpSubstrExpr).Expr = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Pattern, func(newNode, parent SQLNode) {
-		parent.(*RegexpSubstrExpr).Pattern = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteExpr(node, node.Occurrence, func(newNode, parent SQLNode) {
-		parent.(*RegexpSubstrExpr).Occurrence = newNode.(Expr)
+	if !a.rewriteIdentifierCI(node, node.Label, func(newNode, parent SQLNode) {
+		parent.(*RepeatStatement).Label = newNode.(IdentifierCI)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Position, func(newNode, parent SQLNode) {
-		parent.(*RegexpSubstrExpr).Position = newNode.(Expr)
+	if !a.rewriteStatementList(node, node.Statements, func(newNode, parent SQLNode) {
+		parent.(*RepeatStatement).Statements = newNode.(StatementList)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.MatchType, func(newNode, parent SQLNode) {
-		parent.(*RegexpSubstrExpr).MatchType = newNode.(Expr)
+	if !a.rewriteExpr(node, node.Until, func(newNode, parent SQLNode) {
+		parent.(*RepeatStatement).Until = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -6611,7 +8657,7 @@ func (a *application) rewriteRefOfRegexpSubstrExpr(parent SQLNode, node *RegexpS
 	}
 	return true
 }
-func (a *application) rewriteRefOfRelease(parent SQLNode, node *Release, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfReturnStatement(parent SQLNode, node *ReturnStatement, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6623,8 +8669,8 @@ func (a *application) rewriteRefOfRelease(parent SQLNode, node *Release, replace
 			return true
 		}
 	}
-	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*Release).Name = newNode.(IdentifierCI)
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*ReturnStatement).Expr = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -6638,7 +8684,7 @@ func (a *application) rewriteRefOfRelease(parent SQLNode, node *Release, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfRenameColumn(parent SQLNode, node *RenameColumn, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfRevertMigration(parent SQLNode, node *RevertMigration, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6650,13 +8696,8 @@ func (a *application) rewriteRefOfRenameColumn(parent SQLNode, node *RenameColum
 			return true
 		}
 	}
-	if !a.rewriteRefOfColName(node, node.OldName, func(newNode, parent SQLNode) {
-		parent.(*RenameColumn).OldName = newNode.(*ColName)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfColName(node, node.NewName, func(newNode, parent SQLNode) {
-		parent.(*RenameColumn).NewName = newNode.(*ColName)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*RevertMigration).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
@@ -6670,7 +8711,7 @@ func (a *application) rewriteRefOfRenameColumn(parent SQLNode, node *RenameColum
 	}
 	return true
 }
-func (a *application) rewriteRefOfRenameIndex(parent SQLNode, node *RenameIndex, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfRevoke(parent SQLNode, node *Revoke, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6682,16 +8723,43 @@ func (a *application) rewriteRefOfRenameIndex(parent SQLNode, node *RenameIndex,
 			return true
 		}
 	}
-	if !a.rewriteIdentifierCI(node, node.OldName, func(newNode, parent SQLNode) {
-		parent.(*RenameIndex).OldName = newNode.(IdentifierCI)
+	for x, el := range node.Privileges {
+		if !a.rewriteRefOfGrantPrivilege(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*Revoke).Privileges[idx] = newNode.(*GrantPrivilege)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteRefOfPrivilegeLevel(node, node.On, func(newNode, parent SQLNode) {
+		parent.(*Revoke).On = newNode.(*PrivilegeLevel)
 	}) {
 		return false
 	}
-	if !a.rewriteIdentifierCI(node, node.NewName, func(newNode, parent SQLNode) {
-		parent.(*RenameIndex).NewName = newNode.(IdentifierCI)
+	for x, el := range node.Roles {
+		if !a.rewriteRefOfAccount(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*Revoke).Roles[idx] = newNode.(*Account)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteRefOfAccount(node, node.Proxy, func(newNode, parent SQLNode) {
+		parent.(*Revoke).Proxy = newNode.(*Account)
 	}) {
 		return false
 	}
+	for x, el := range node.From {
+		if !a.rewriteRefOfAccount(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*Revoke).From[idx] = newNode.(*Account)
+			}
+		}(x)) {
+			return false
+		}
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -6702,7 +8770,7 @@ func (a *application) rewriteRefOfRenameIndex(parent SQLNode, node *RenameIndex,
 	}
 	return true
 }
-func (a *application) rewriteRefOfRenameTable(parent SQLNode, node *RenameTable, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfRollback(parent SQLNode, node *Rollback, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6726,10 +8794,7 @@ func (a *application) rewriteRefOfRenameTable(parent SQLNode, node *RenameTable,
 	}
 	return true
 }
-func (a *application) rewriteRefOfRenameTableName(parent SQLNode, node *RenameTableName, replacer replacerFunc) bool {
-	if node == nil {
-		return true
-	}
+func (a *application) rewriteRootNode(parent SQLNode, node RootNode, replacer replacerFunc) bool {
 	if a.pre != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -6738,8 +8803,8 @@ func (a *application) rewriteRefOfRenameTableName(parent SQLNode, node *RenameTa
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
-		parent.(*RenameTableName).Table = newNode.(TableName)
+	if !a.rewriteSQLNode(node, node.SQLNode, func(newNode, parent SQLNode) {
+		panic("[BUG] tried to replace 'SQLNode' on 'RootNode'")
 	}) {
 		return false
 	}
@@ -6753,7 +8818,31 @@ func (a *application) rewriteRefOfRenameTableName(parent SQLNode, node *RenameTa
 	}
 	return true
 }
-func (a *application) rewriteRefOfRevertMigration(parent SQLNode, node *RevertMigration, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfRoutineCharacteristic(parent SQLNode, node *RoutineCharacteristic, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRoutineSet(parent SQLNode, node *RoutineSet, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6766,7 +8855,12 @@ func (a *application) rewriteRefOfRevertMigration(parent SQLNode, node *RevertMi
 		}
 	}
 	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*RevertMigration).Comments = newNode.(*ParsedComments)
+		parent.(*RoutineSet).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteRoutineSetExprs(node, node.Exprs, func(newNode, parent SQLNode) {
+		parent.(*RoutineSet).Exprs = newNode.(RoutineSetExprs)
 	}) {
 		return false
 	}
@@ -6780,7 +8874,7 @@ func (a *application) rewriteRefOfRevertMigration(parent SQLNode, node *RevertMi
 	}
 	return true
 }
-func (a *application) rewriteRefOfRollback(parent SQLNode, node *Rollback, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfRoutineSetExpr(parent SQLNode, node *RoutineSetExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -6792,31 +8886,52 @@ func (a *application) rewriteRefOfRollback(parent SQLNode, node *Rollback, repla
 			return true
 		}
 	}
+	if !a.rewriteExpr(node, node.Var, func(newNode, parent SQLNode) {
+		parent.(*RoutineSetExpr).Var = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*RoutineSetExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
 	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRootNode(parent SQLNode, node RootNode, replacer replacerFunc) bool {
+func (a *application) rewriteRoutineSetExprs(parent SQLNode, node RoutineSetExprs, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
 	if a.pre != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(RoutineSetExprs)
+			a.cur.revisit = false
+			return a.rewriteRoutineSetExprs(parent, node, replacer)
+		}
+		if kontinue {
 			return true
 		}
 	}
-	if !a.rewriteSQLNode(node, node.SQLNode, func(newNode, parent SQLNode) {
-		panic("[BUG] tried to replace 'SQLNode' on 'RootNode'")
-	}) {
-		return false
+	for x, el := range node {
+		if !a.rewriteRefOfRoutineSetExpr(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(RoutineSetExprs)[idx] = newNode.(*RoutineSetExpr)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -7008,23 +9123,130 @@ func (a *application) rewriteRefOfSelectInto(parent SQLNode, node *SelectInto, r
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
-			return true
-		}
-	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExprs(node, node.Variables, func(newNode, parent SQLNode) {
+		parent.(*SelectInto).Variables = newNode.(Exprs)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfSet(parent SQLNode, node *Set, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*Set).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteSetExprs(node, node.Exprs, func(newNode, parent SQLNode) {
+		parent.(*Set).Exprs = newNode.(SetExprs)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfSetDefaultRole(parent SQLNode, node *SetDefaultRole, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	for x, el := range node.Roles {
+		if !a.rewriteRefOfAccount(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*SetDefaultRole).Roles[idx] = newNode.(*Account)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	for x, el := range node.To {
+		if !a.rewriteRefOfAccount(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*SetDefaultRole).To[idx] = newNode.(*Account)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfSetExpr(parent SQLNode, node *SetExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfVariable(node, node.Var, func(newNode, parent SQLNode) {
+		parent.(*SetExpr).Var = newNode.(*Variable)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*SetExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfSet(parent SQLNode, node *Set, replacer replacerFunc) bool {
+func (a *application) rewriteSetExprs(parent SQLNode, node SetExprs, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -7032,19 +9254,24 @@ func (a *application) rewriteRefOfSet(parent SQLNode, node *Set, replacer replac
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		if !a.pre(&a.cur) {
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(SetExprs)
+			a.cur.revisit = false
+			return a.rewriteSetExprs(parent, node, replacer)
+		}
+		if kontinue {
 			return true
 		}
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*Set).Comments = newNode.(*ParsedComments)
-	}) {
-		return false
-	}
-	if !a.rewriteSetExprs(node, node.Exprs, func(newNode, parent SQLNode) {
-		parent.(*Set).Exprs = newNode.(SetExprs)
-	}) {
-		return false
+	for x, el := range node {
+		if !a.rewriteRefOfSetExpr(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(SetExprs)[idx] = newNode.(*SetExpr)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -7056,7 +9283,7 @@ func (a *application) rewriteRefOfSet(parent SQLNode, node *Set, replacer replac
 	}
 	return true
 }
-func (a *application) rewriteRefOfSetExpr(parent SQLNode, node *SetExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfSetPassword(parent SQLNode, node *SetPassword, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -7068,13 +9295,13 @@ func (a *application) rewriteRefOfSetExpr(parent SQLNode, node *SetExpr, replace
 			return true
 		}
 	}
-	if !a.rewriteRefOfVariable(node, node.Var, func(newNode, parent SQLNode) {
-		parent.(*SetExpr).Var = newNode.(*Variable)
+	if !a.rewriteRefOfAccount(node, node.For, func(newNode, parent SQLNode) {
+		parent.(*SetPassword).For = newNode.(*Account)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*SetExpr).Expr = newNode.(Expr)
+	if !a.rewriteRefOfLiteral(node, node.Password, func(newNode, parent SQLNode) {
+		parent.(*SetPassword).Password = newNode.(*Literal)
 	}) {
 		return false
 	}
@@ -7088,7 +9315,7 @@ func (a *application) rewriteRefOfSetExpr(parent SQLNode, node *SetExpr, replace
 	}
 	return true
 }
-func (a *application) rewriteSetExprs(parent SQLNode, node SetExprs, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfSetRole(parent SQLNode, node *SetRole, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -7096,20 +9323,14 @@ func (a *application) rewriteSetExprs(parent SQLNode, node SetExprs, replacer re
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(SetExprs)
-			a.cur.revisit = false
-			return a.rewriteSetExprs(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteRefOfSetExpr(node, el, func(idx int) replacerFunc {
+	for x, el := range node.Roles {
+		if !a.rewriteRefOfAccount(node, el, func(idx int) replacerFunc {
 			return func(newNode, parent SQLNode) {
-				parent.(SetExprs)[idx] = newNode.(*SetExpr)
+				parent.(*SetRole).Roles[idx] = newNode.(*Account)
 			}
 		}(x)) {
 			return false
@@ -7342,6 +9563,69 @@ func (a *application) rewriteRefOfShowThrottlerStatus(parent SQLNode, node *Show
 	}
 	return true
 }
+func (a *application) rewriteRefOfSignal(parent SQLNode, node *Signal, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfConditionValue(node, node.Condition, func(newNode, parent SQLNode) {
+		parent.(*Signal).Condition = newNode.(*ConditionValue)
+	}) {
+		return false
+	}
+	for x, el := range node.Items {
+		if !a.rewriteRefOfSignalItem(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*Signal).Items[idx] = newNode.(*SignalItem)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfSignalItem(parent SQLNode, node *SignalItem, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Value, func(newNode, parent SQLNode) {
+		parent.(*SignalItem).Value = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfStarExpr(parent SQLNode, node *StarExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -7369,6 +9653,43 @@ func (a *application) rewriteRefOfStarExpr(parent SQLNode, node *StarExpr, repla
 	}
 	return true
 }
+func (a *application) rewriteStatementList(parent SQLNode, node StatementList, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(StatementList)
+			a.cur.revisit = false
+			return a.rewriteStatementList(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	for x, el := range node {
+		if !a.rewriteStatement(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(StatementList)[idx] = newNode.(Statement)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfStd(parent SQLNode, node *Std, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -7996,6 +10317,33 @@ func (a *application) rewriteRefOfTimestampFuncExpr(parent SQLNode, node *Timest
 	}
 	return true
 }
+func (a *application) rewriteRefOfTriggerOrder(parent SQLNode, node *TriggerOrder, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteIdentifierCI(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*TriggerOrder).Name = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfTrimFuncExpr(parent SQLNode, node *TrimFuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -8055,6 +10403,38 @@ func (a *application) rewriteRefOfTruncateTable(parent SQLNode, node *TruncateTa
 	}
 	return true
 }
+func (a *application) rewriteRefOfTypeCastExpr(parent SQLNode, node *TypeCastExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*TypeCastExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfConvertType(node, node.Type, func(newNode, parent SQLNode) {
+		parent.(*TypeCastExpr).Type = newNode.(*ConvertType)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfUnaryExpr(parent SQLNode, node *UnaryExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -8205,6 +10585,11 @@ func (a *application) rewriteRefOfUpdate(parent SQLNode, node *Update, replacer
 	}) {
 		return false
 	}
+	if !a.rewriteSelectExprs(node, node.Returning, func(newNode, parent SQLNode) {
+		parent.(*Update).Returning = newNode.(SelectExprs)
+	}) {
+		return false
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -8348,6 +10733,38 @@ func (a *application) rewriteRefOfUse(parent SQLNode, node *Use, replacer replac
 	}
 	return true
 }
+func (a *application) rewriteRefOfUserSpec(parent SQLNode, node *UserSpec, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfAccount(node, node.Account, func(newNode, parent SQLNode) {
+		parent.(*UserSpec).Account = newNode.(*Account)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfAuthentication(node, node.Auth, func(newNode, parent SQLNode) {
+		parent.(*UserSpec).Auth = newNode.(*Authentication)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfVExplainStmt(parent SQLNode, node *VExplainStmt, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -8816,6 +11233,43 @@ func (a *application) rewriteRefOfWhere(parent SQLNode, node *Where, replacer re
 	}
 	return true
 }
+func (a *application) rewriteRefOfWhileStatement(parent SQLNode, node *WhileStatement, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteIdentifierCI(node, node.Label, func(newNode, parent SQLNode) {
+		parent.(*WhileStatement).Label = newNode.(IdentifierCI)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Cond, func(newNode, parent SQLNode) {
+		parent.(*WhileStatement).Cond = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteStatementList(node, node.Statements, func(newNode, parent SQLNode) {
+		parent.(*WhileStatement).Statements = newNode.(StatementList)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfWindowDefinition(parent SQLNode, node *WindowDefinition, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -9305,16 +11759,38 @@ func (a *application) rewriteDDLStatement(parent SQLNode, node DDLStatement, rep
 		return true
 	}
 	switch node := node.(type) {
+	case *AlterEvent:
+		return a.rewriteRefOfAlterEvent(parent, node, replacer)
+	case *AlterFunction:
+		return a.rewriteRefOfAlterFunction(parent, node, replacer)
+	case *AlterProcedure:
+		return a.rewriteRefOfAlterProcedure(parent, node, replacer)
 	case *AlterTable:
 		return a.rewriteRefOfAlterTable(parent, node, replacer)
 	case *AlterView:
 		return a.rewriteRefOfAlterView(parent, node, replacer)
+	case *CreateEvent:
+		return a.rewriteRefOfCreateEvent(parent, node, replacer)
+	case *CreateFunction:
+		return a.rewriteRefOfCreateFunction(parent, node, replacer)
+	case *CreateProcedure:
+		return a.rewriteRefOfCreateProcedure(parent, node, replacer)
 	case *CreateTable:
 		return a.rewriteRefOfCreateTable(parent, node, replacer)
+	case *CreateTrigger:
+		return a.rewriteRefOfCreateTrigger(parent, node, replacer)
 	case *CreateView:
 		return a.rewriteRefOfCreateView(parent, node, replacer)
+	case *DropEvent:
+		return a.rewriteRefOfDropEvent(parent, node, replacer)
+	case *DropFunction:
+		return a.rewriteRefOfDropFunction(parent, node, replacer)
+	case *DropProcedure:
+		return a.rewriteRefOfDropProcedure(parent, node, replacer)
 	case *DropTable:
 		return a.rewriteRefOfDropTable(parent, node, replacer)
+	case *DropTrigger:
+		return a.rewriteRefOfDropTrigger(parent, node, replacer)
 	case *DropView:
 		return a.rewriteRefOfDropView(parent, node, replacer)
 	case *RenameTable:
@@ -9531,6 +12007,8 @@ func (a *application) rewriteExpr(parent SQLNode, node Expr, replacer replacerFu
 		return a.rewriteRefOfPolygonExpr(parent, node, replacer)
 	case *PolygonPropertyFuncExpr:
 		return a.rewriteRefOfPolygonPropertyFuncExpr(parent, node, replacer)
+	case *PositionalArg:
+		return a.rewriteRefOfPositionalArg(parent, node, replacer)
 	case *RegexpInstrExpr:
 		return a.rewriteRefOfRegexpInstrExpr(parent, node, replacer)
 	case *RegexpLikeExpr:
@@ -9557,6 +12035,8 @@ func (a *application) rewriteExpr(parent SQLNode, node Expr, replacer replacerFu
 		return a.rewriteRefOfTimestampFuncExpr(parent, node, replacer)
 	case *TrimFuncExpr:
 		return a.rewriteRefOfTrimFuncExpr(parent, node, replacer)
+	case *TypeCastExpr:
+		return a.rewriteRefOfTypeCastExpr(parent, node, replacer)
 	case *UnaryExpr:
 		return a.rewriteRefOfUnaryExpr(parent, node, replacer)
 	case *UpdateXMLExpr:
@@ -9665,36 +12145,84 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 	switch node := node.(type) {
 	case *AlterDatabase:
 		return a.rewriteRefOfAlterDatabase(parent, node, replacer)
+	case *AlterEvent:
+		return a.rewriteRefOfAlterEvent(parent, node, replacer)
+	case *AlterFunction:
+		return a.rewriteRefOfAlterFunction(parent, node, replacer)
 	case *AlterMigration:
 		return a.rewriteRefOfAlterMigration(parent, node, replacer)
+	case *AlterProcedure:
+		return a.rewriteRefOfAlterProcedure(parent, node, replacer)
 	case *AlterTable:
 		return a.rewriteRefOfAlterTable(parent, node, replacer)
+	case *AlterUser:
+		return a.rewriteRefOfAlterUser(parent, node, replacer)
 	case *AlterView:
 		return a.rewriteRefOfAlterView(parent, node, replacer)
 	case *AlterVschema:
 		return a.rewriteRefOfAlterVschema(parent, node, replacer)
+	case *BadStatement:
+		return a.rewriteRefOfBadStatement(parent, node, replacer)
 	case *Begin:
 		return a.rewriteRefOfBegin(parent, node, replacer)
+	case *BeginEndBlock:
+		return a.rewriteRefOfBeginEndBlock(parent, node, replacer)
 	case *CallProc:
 		return a.rewriteRefOfCallProc(parent, node, replacer)
+	case *CaseStatement:
+		return a.rewriteRefOfCaseStatement(parent, node, replacer)
+	case *CloseCursor:
+		return a.rewriteRefOfCloseCursor(parent, node, replacer)
 	case *CommentOnly:
 		return a.rewriteRefOfCommentOnly(parent, node, replacer)
 	case *Commit:
 		return a.rewriteRefOfCommit(parent, node, replacer)
 	case *CreateDatabase:
 		return a.rewriteRefOfCreateDatabase(parent, node, replacer)
+	case *CreateEvent:
+		return a.rewriteRefOfCreateEvent(parent, node, replacer)
+	case *CreateFunction:
+		return a.rewriteRefOfCreateFunction(parent, node, replacer)
+	case *CreateProcedure:
+		return a.rewriteRefOfCreateProcedure(parent, node, replacer)
+	case *CreateRole:
+		return a.rewriteRefOfCreateRole(parent, node, replacer)
 	case *CreateTable:
 		return a.rewriteRefOfCreateTable(parent, node, replacer)
+	case *CreateTrigger:
+		return a.rewriteRefOfCreateTrigger(parent, node, replacer)
+	case *CreateUser:
+		return a.rewriteRefOfCreateUser(parent, node, replacer)
 	case *CreateView:
 		return a.rewriteRefOfCreateView(parent, node, replacer)
 	case *DeallocateStmt:
 		return a.rewriteRefOfDeallocateStmt(parent, node, replacer)
+	case *DeclareCondition:
+		return a.rewriteRefOfDeclareCondition(parent, node, replacer)
+	case *DeclareCursor:
+		return a.rewriteRefOfDeclareCursor(parent, node, replacer)
+	case *DeclareHandler:
+		return a.rewriteRefOfDeclareHandler(parent, node, replacer)
+	case *DeclareVar:
+		return a.rewriteRefOfDeclareVar(parent, node, replacer)
 	case *Delete:
 		return a.rewriteRefOfDelete(parent, node, replacer)
 	case *DropDatabase:
 		return a.rewriteRefOfDropDatabase(parent, node, replacer)
+	case *DropEvent:
+		return a.rewriteRefOfDropEvent(parent, node, replacer)
+	case *DropFunction:
+		return a.rewriteRefOfDropFunction(parent, node, replacer)
+	case *DropProcedure:
+		return a.rewriteRefOfDropProcedure(parent, node, replacer)
+	case *DropRole:
+		return a.rewriteRefOfDropRole(parent, node, replacer)
 	case *DropTable:
 		return a.rewriteRefOfDropTable(parent, node, replacer)
+	case *DropTrigger:
+		return a.rewriteRefOfDropTrigger(parent, node, replacer)
+	case *DropUser:
+		return a.rewriteRefOfDropUser(parent, node, replacer)
 	case *DropView:
 		return a.rewriteRefOfDropView(parent, node, replacer)
 	case *ExecuteStmt:
@@ -9703,14 +12231,28 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 		return a.rewriteRefOfExplainStmt(parent, node, replacer)
 	case *ExplainTab:
 		return a.rewriteRefOfExplainTab(parent, node, replacer)
+	case *FetchCursor:
+		return a.rewriteRefOfFetchCursor(parent, node, replacer)
 	case *Flush:
 		return a.rewriteRefOfFlush(parent, node, replacer)
+	case *Grant:
+		return a.rewriteRefOfGrant(parent, node, replacer)
+	case *IfStatement:
+		return a.rewriteRefOfIfStatement(parent, node, replacer)
 	case *Insert:
 		return a.rewriteRefOfInsert(parent, node, replacer)
+	case *IterateStatement:
+		return a.rewriteRefOfIterateStatement(parent, node, replacer)
+	case *LeaveStatement:
+		return a.rewriteRefOfLeaveStatement(parent, node, replacer)
 	case *Load:
 		return a.rewriteRefOfLoad(parent, node, replacer)
 	case *LockTables:
 		return a.rewriteRefOfLockTables(parent, node, replacer)
+	case *LoopStatement:
+		return a.rewriteRefOfLoopStatement(parent, node, replacer)
+	case *OpenCursor:
+		return a.rewriteRefOfOpenCursor(parent, node, replacer)
 	case *OtherAdmin:
 		return a.rewriteRefOfOtherAdmin(parent, node, replacer)
 	case *OtherRead:
@@ -9723,10 +12265,20 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 		return a.rewriteRefOfRelease(parent, node, replacer)
 	case *RenameTable:
 		return a.rewriteRefOfRenameTable(parent, node, replacer)
+	case *RenameUser:
+		return a.rewriteRefOfRenameUser(parent, node, replacer)
+	case *RepeatStatement:
+		return a.rewriteRefOfRepeatStatement(parent, node, replacer)
+	case *ReturnStatement:
+		return a.rewriteRefOfReturnStatement(parent, node, replacer)
 	case *RevertMigration:
 		return a.rewriteRefOfRevertMigration(parent, node, replacer)
+	case *Revoke:
+		return a.rewriteRefOfRevoke(parent, node, replacer)
 	case *Rollback:
 		return a.rewriteRefOfRollback(parent, node, replacer)
+	case *RoutineSet:
+		return a.rewriteRefOfRoutineSet(parent, node, replacer)
 	case *SRollback:
 		return a.rewriteRefOfSRollback(parent, node, replacer)
 	case *Savepoint:
@@ -9735,6 +12287,12 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 		return a.rewriteRefOfSelect(parent, node, replacer)
 	case *Set:
 		return a.rewriteRefOfSet(parent, node, replacer)
+	case *SetDefaultRole:
+		return a.rewriteRefOfSetDefaultRole(parent, node, replacer)
+	case *SetPassword:
+		return a.rewriteRefOfSetPassword(parent, node, replacer)
+	case *SetRole:
+		return a.rewriteRefOfSetRole(parent, node, replacer)
 	case *Show:
 		return a.rewriteRefOfShow(parent, node, replacer)
 	case *ShowMigrationLogs:
@@ -9743,6 +12301,8 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 		return a.rewriteRefOfShowThrottledApps(parent, node, replacer)
 	case *ShowThrottlerStatus:
 		return a.rewriteRefOfShowThrottlerStatus(parent, node, replacer)
+	case *Signal:
+		return a.rewriteRefOfSignal(parent, node, replacer)
 	case *Stream:
 		return a.rewriteRefOfStream(parent, node, replacer)
 	case *TruncateTable:
@@ -9759,6 +12319,8 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 		return a.rewriteRefOfVExplainStmt(parent, node, replacer)
 	case *VStream:
 		return a.rewriteRefOfVStream(parent, node, replacer)
+	case *WhileStatement:
+		return a.rewriteRefOfWhileStatement(parent, node, replacer)
 	default:
 		// this should never happen
 		return true
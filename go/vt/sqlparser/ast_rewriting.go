@@ -515,6 +515,10 @@ func inverseOp(i ComparisonExprOperator) (bool, ComparisonExprOperator) {
 		return true, NotRegexpOp
 	case NotRegexpOp:
 		return true, RegexpOp
+	case ILikeOp:
+		return true, NotILikeOp
+	case NotILikeOp:
+		return true, ILikeOp
 	}
 
 	return false, i
@@ -159,6 +159,40 @@ func TestSetLimit(t *testing.T) {
 	require.Equal(t, "select * from t union select * from s limit 4", buf.String())
 }
 
+func TestSetOperations(t *testing.T) {
+	stmt, err := Parse("select a, b from t union select c, d from u intersect select e, f from v")
+	require.NoError(t, err)
+	union := stmt.(*Union)
+	require.Equal(t, UnionType, union.Type)
+	require.True(t, union.Distinct)
+	intersect := union.Right.(*Union)
+	require.Equal(t, IntersectType, intersect.Type)
+	require.Equal(t, "select a, b from t", String(union.Left))
+	require.Equal(t, 2, union.GetColumnCount())
+	require.Equal(t, "a, b", String(union.GetColumns()))
+
+	stmt, err = Parse("select a from t except all select b from u")
+	require.NoError(t, err)
+	except := stmt.(*Union)
+	require.Equal(t, ExceptType, except.Type)
+	require.False(t, except.Distinct)
+	except.SetOrderBy(OrderBy{&Order{Expr: NewColName("a"), Direction: DescOrder}})
+	except.SetLimit(&Limit{Rowcount: NewIntLiteral("1")})
+	require.Equal(t, "select a from t except all select b from u order by a desc limit 1", String(except))
+
+	// operands are put in parenthesis where precedence requires it
+	left, err := Parse("select 1 from dual union select 2 from dual")
+	require.NoError(t, err)
+	right, err := Parse("select 3 from dual except select 4 from dual")
+	require.NoError(t, err)
+	intersect = &Union{Left: left.(SelectStatement), Right: right.(SelectStatement), Type: IntersectType, Distinct: true}
+	want := "(select 1 from dual union select 2 from dual) intersect (select 3 from dual except select 4 from dual)"
+	require.Equal(t, want, String(intersect))
+	buf := NewTrackedBuffer(FormatImpossibleQuery)
+	buf.Myprintf("%v", intersect)
+	require.Equal(t, "(select 1 from dual where 1 != 1 union select 2 from dual where 1 != 1) intersect (select 3 from dual where 1 != 1 except select 4 from dual where 1 != 1)", buf.String())
+}
+
 func TestDDL(t *testing.T) {
 	testcases := []struct {
 		query    string
@@ -736,6 +770,11 @@ func TestSplitStatementToPieces(t *testing.T) {
 	}, {
 		input:  "select * from table1;-- comment;\nselect * from table2;",
 		output: "select * from table1;select * from table2",
+	}, {
+		input:  "DELIMITER $$\nselect * from table1$$\nDELIMITER ;",
+		output: "select * from table1",
+	}, {
+		input: "select delimiter from table1",
 	}, {
 		input: "CREATE TABLE `total_data` (`id` int(11) NOT NULL AUTO_INCREMENT COMMENT 'id', " +
 			"`region` varchar(32) NOT NULL COMMENT 'region name, like zh; th; kepler'," +
@@ -22,6 +22,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return nil
 	}
 	switch in := in.(type) {
+	case *Account:
+		return VisitRefOfAccount(in, f)
 	case *AddColumns:
 		return VisitRefOfAddColumns(in, f)
 	case *AddConstraintDefinition:
@@ -42,12 +44,20 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfAlterColumn(in, f)
 	case *AlterDatabase:
 		return VisitRefOfAlterDatabase(in, f)
+	case *AlterEvent:
+		return VisitRefOfAlterEvent(in, f)
+	case *AlterFunction:
+		return VisitRefOfAlterFunction(in, f)
 	case *AlterIndex:
 		return VisitRefOfAlterIndex(in, f)
 	case *AlterMigration:
 		return VisitRefOfAlterMigration(in, f)
+	case *AlterProcedure:
+		return VisitRefOfAlterProcedure(in, f)
 	case *AlterTable:
 		return VisitRefOfAlterTable(in, f)
+	case *AlterUser:
+		return VisitRefOfAlterUser(in, f)
 	case *AlterView:
 		return VisitRefOfAlterView(in, f)
 	case *AlterVschema:
@@ -60,12 +70,18 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfArgumentLessWindowExpr(in, f)
 	case *AssignmentExpr:
 		return VisitRefOfAssignmentExpr(in, f)
+	case *Authentication:
+		return VisitRefOfAuthentication(in, f)
 	case *AutoIncSpec:
 		return VisitRefOfAutoIncSpec(in, f)
 	case *Avg:
 		return VisitRefOfAvg(in, f)
+	case *BadStatement:
+		return VisitRefOfBadStatement(in, f)
 	case *Begin:
 		return VisitRefOfBegin(in, f)
+	case *BeginEndBlock:
+		return VisitRefOfBeginEndBlock(in, f)
 	case *BetweenExpr:
 		return VisitRefOfBetweenExpr(in, f)
 	case *BinaryExpr:
@@ -82,6 +98,10 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfCallProc(in, f)
 	case *CaseExpr:
 		return VisitRefOfCaseExpr(in, f)
+	case *CaseStatement:
+		return VisitRefOfCaseStatement(in, f)
+	case *CaseStatementWhen:
+		return VisitRefOfCaseStatementWhen(in, f)
 	case *CastExpr:
 		return VisitRefOfCastExpr(in, f)
 	case *ChangeColumn:
@@ -90,6 +110,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfCharExpr(in, f)
 	case *CheckConstraintDefinition:
 		return VisitRefOfCheckConstraintDefinition(in, f)
+	case *CloseCursor:
+		return VisitRefOfCloseCursor(in, f)
 	case *ColName:
 		return VisitRefOfColName(in, f)
 	case *CollateExpr:
@@ -108,6 +130,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfCommonTableExpr(in, f)
 	case *ComparisonExpr:
 		return VisitRefOfComparisonExpr(in, f)
+	case *ConditionValue:
+		return VisitRefOfConditionValue(in, f)
 	case *ConstraintDefinition:
 		return VisitRefOfConstraintDefinition(in, f)
 	case *ConvertExpr:
@@ -122,8 +146,20 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfCountStar(in, f)
 	case *CreateDatabase:
 		return VisitRefOfCreateDatabase(in, f)
+	case *CreateEvent:
+		return VisitRefOfCreateEvent(in, f)
+	case *CreateFunction:
+		return VisitRefOfCreateFunction(in, f)
+	case *CreateProcedure:
+		return VisitRefOfCreateProcedure(in, f)
+	case *CreateRole:
+		return VisitRefOfCreateRole(in, f)
 	case *CreateTable:
 		return VisitRefOfCreateTable(in, f)
+	case *CreateTrigger:
+		return VisitRefOfCreateTrigger(in, f)
+	case *CreateUser:
+		return VisitRefOfCreateUser(in, f)
 	case *CreateView:
 		return VisitRefOfCreateView(in, f)
 	case *CurTimeFuncExpr:
@@ -134,6 +170,14 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfDateSubExpr(in, f)
 	case *DeallocateStmt:
 		return VisitRefOfDeallocateStmt(in, f)
+	case *DeclareCondition:
+		return VisitRefOfDeclareCondition(in, f)
+	case *DeclareCursor:
+		return VisitRefOfDeclareCursor(in, f)
+	case *DeclareHandler:
+		return VisitRefOfDeclareHandler(in, f)
+	case *DeclareVar:
+		return VisitRefOfDeclareVar(in, f)
 	case *Default:
 		return VisitRefOfDefault(in, f)
 	case *Definer:
@@ -146,12 +190,28 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfDropColumn(in, f)
 	case *DropDatabase:
 		return VisitRefOfDropDatabase(in, f)
+	case *DropEvent:
+		return VisitRefOfDropEvent(in, f)
+	case *DropFunction:
+		return VisitRefOfDropFunction(in, f)
 	case *DropKey:
 		return VisitRefOfDropKey(in, f)
+	case *DropProcedure:
+		return VisitRefOfDropProcedure(in, f)
+	case *DropRole:
+		return VisitRefOfDropRole(in, f)
 	case *DropTable:
 		return VisitRefOfDropTable(in, f)
+	case *DropTrigger:
+		return VisitRefOfDropTrigger(in, f)
+	case *DropUser:
+		return VisitRefOfDropUser(in, f)
 	case *DropView:
 		return VisitRefOfDropView(in, f)
+	case *ElseIfBlock:
+		return VisitRefOfElseIfBlock(in, f)
+	case *EventSchedule:
+		return VisitRefOfEventSchedule(in, f)
 	case *ExecuteStmt:
 		return VisitRefOfExecuteStmt(in, f)
 	case *ExistsExpr:
@@ -168,6 +228,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfExtractValueExpr(in, f)
 	case *ExtractedSubquery:
 		return VisitRefOfExtractedSubquery(in, f)
+	case *FetchCursor:
+		return VisitRefOfFetchCursor(in, f)
 	case *FirstOrLastValueExpr:
 		return VisitRefOfFirstOrLastValueExpr(in, f)
 	case *Flush:
@@ -206,6 +268,10 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfGeomFromWKBExpr(in, f)
 	case *GeomPropertyFuncExpr:
 		return VisitRefOfGeomPropertyFuncExpr(in, f)
+	case *Grant:
+		return VisitRefOfGrant(in, f)
+	case *GrantPrivilege:
+		return VisitRefOfGrantPrivilege(in, f)
 	case GroupBy:
 		return VisitGroupBy(in, f)
 	case *GroupConcatExpr:
@@ -214,6 +280,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitIdentifierCI(in, f)
 	case IdentifierCS:
 		return VisitIdentifierCS(in, f)
+	case *IfStatement:
+		return VisitRefOfIfStatement(in, f)
 	case *IndexDefinition:
 		return VisitRefOfIndexDefinition(in, f)
 	case *IndexHint:
@@ -232,6 +300,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfIntroducerExpr(in, f)
 	case *IsExpr:
 		return VisitRefOfIsExpr(in, f)
+	case *IterateStatement:
+		return VisitRefOfIterateStatement(in, f)
 	case *JSONArrayExpr:
 		return VisitRefOfJSONArrayExpr(in, f)
 	case *JSONAttributesExpr:
@@ -288,6 +358,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfKeyState(in, f)
 	case *LagLeadExpr:
 		return VisitRefOfLagLeadExpr(in, f)
+	case *LeaveStatement:
+		return VisitRefOfLeaveStatement(in, f)
 	case *Limit:
 		return VisitRefOfLimit(in, f)
 	case *LineStringExpr:
@@ -300,6 +372,10 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfLiteral(in, f)
 	case *Load:
 		return VisitRefOfLoad(in, f)
+	case *LoadFields:
+		return VisitRefOfLoadFields(in, f)
+	case *LoadLines:
+		return VisitRefOfLoadLines(in, f)
 	case *LocateExpr:
 		return VisitRefOfLocateExpr(in, f)
 	case *LockOption:
@@ -308,6 +384,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfLockTables(in, f)
 	case *LockingFunc:
 		return VisitRefOfLockingFunc(in, f)
+	case *LoopStatement:
+		return VisitRefOfLoopStatement(in, f)
 	case MatchAction:
 		return VisitMatchAction(in, f)
 	case *MatchExpr:
@@ -344,8 +422,12 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfNullVal(in, f)
 	case *Offset:
 		return VisitRefOfOffset(in, f)
+	case *OnConflict:
+		return VisitRefOfOnConflict(in, f)
 	case OnDup:
 		return VisitOnDup(in, f)
+	case *OpenCursor:
+		return VisitRefOfOpenCursor(in, f)
 	case *OptLike:
 		return VisitRefOfOptLike(in, f)
 	case *OrExpr:
@@ -380,6 +462,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfPartitionValueRange(in, f)
 	case Partitions:
 		return VisitPartitions(in, f)
+	case *PasswordExpire:
+		return VisitRefOfPasswordExpire(in, f)
 	case *PerformanceSchemaFuncExpr:
 		return VisitRefOfPerformanceSchemaFuncExpr(in, f)
 	case *PointExpr:
@@ -390,8 +474,14 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfPolygonExpr(in, f)
 	case *PolygonPropertyFuncExpr:
 		return VisitRefOfPolygonPropertyFuncExpr(in, f)
+	case *PositionalArg:
+		return VisitRefOfPositionalArg(in, f)
 	case *PrepareStmt:
 		return VisitRefOfPrepareStmt(in, f)
+	case *PrivilegeLevel:
+		return VisitRefOfPrivilegeLevel(in, f)
+	case *ProcParameter:
+		return VisitRefOfProcParameter(in, f)
 	case *PurgeBinaryLogs:
 		return VisitRefOfPurgeBinaryLogs(in, f)
 	case ReferenceAction:
@@ -416,12 +506,30 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfRenameTable(in, f)
 	case *RenameTableName:
 		return VisitRefOfRenameTableName(in, f)
+	case *RenameUser:
+		return VisitRefOfRenameUser(in, f)
+	case *RenameUserPair:
+		return VisitRefOfRenameUserPair(in, f)
+	case *RepeatStatement:
+		return VisitRefOfRepeatStatement(in, f)
+	case *ReturnStatement:
+		return VisitRefOfReturnStatement(in, f)
 	case *RevertMigration:
 		return VisitRefOfRevertMigration(in, f)
+	case *Revoke:
+		return VisitRefOfRevoke(in, f)
 	case *Rollback:
 		return VisitRefOfRollback(in, f)
 	case RootNode:
 		return VisitRootNode(in, f)
+	case *RoutineCharacteristic:
+		return VisitRefOfRoutineCharacteristic(in, f)
+	case *RoutineSet:
+		return VisitRefOfRoutineSet(in, f)
+	case *RoutineSetExpr:
+		return VisitRefOfRoutineSetExpr(in, f)
+	case RoutineSetExprs:
+		return VisitRoutineSetExprs(in, f)
 	case *SRollback:
 		return VisitRefOfSRollback(in, f)
 	case *Savepoint:
@@ -434,10 +542,16 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfSelectInto(in, f)
 	case *Set:
 		return VisitRefOfSet(in, f)
+	case *SetDefaultRole:
+		return VisitRefOfSetDefaultRole(in, f)
 	case *SetExpr:
 		return VisitRefOfSetExpr(in, f)
 	case SetExprs:
 		return VisitSetExprs(in, f)
+	case *SetPassword:
+		return VisitRefOfSetPassword(in, f)
+	case *SetRole:
+		return VisitRefOfSetRole(in, f)
 	case *Show:
 		return VisitRefOfShow(in, f)
 	case *ShowBasic:
@@ -454,8 +568,14 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfShowThrottledApps(in, f)
 	case *ShowThrottlerStatus:
 		return VisitRefOfShowThrottlerStatus(in, f)
+	case *Signal:
+		return VisitRefOfSignal(in, f)
+	case *SignalItem:
+		return VisitRefOfSignalItem(in, f)
 	case *StarExpr:
 		return VisitRefOfStarExpr(in, f)
+	case StatementList:
+		return VisitStatementList(in, f)
 	case *Std:
 		return VisitRefOfStd(in, f)
 	case *StdDev:
@@ -494,10 +614,14 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfTablespaceOperation(in, f)
 	case *TimestampFuncExpr:
 		return VisitRefOfTimestampFuncExpr(in, f)
+	case *TriggerOrder:
+		return VisitRefOfTriggerOrder(in, f)
 	case *TrimFuncExpr:
 		return VisitRefOfTrimFuncExpr(in, f)
 	case *TruncateTable:
 		return VisitRefOfTruncateTable(in, f)
+	case *TypeCastExpr:
+		return VisitRefOfTypeCastExpr(in, f)
 	case *UnaryExpr:
 		return VisitRefOfUnaryExpr(in, f)
 	case *Union:
@@ -514,6 +638,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfUpdateXMLExpr(in, f)
 	case *Use:
 		return VisitRefOfUse(in, f)
+	case *UserSpec:
+		return VisitRefOfUserSpec(in, f)
 	case *VExplainStmt:
 		return VisitRefOfVExplainStmt(in, f)
 	case *VStream:
@@ -544,6 +670,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfWhen(in, f)
 	case *Where:
 		return VisitRefOfWhere(in, f)
+	case *WhileStatement:
+		return VisitRefOfWhileStatement(in, f)
 	case *WindowDefinition:
 		return VisitRefOfWindowDefinition(in, f)
 	case WindowDefinitions:
@@ -559,6 +687,15 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return nil
 	}
 }
+func VisitRefOfAccount(in *Account, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
 func VisitRefOfAddColumns(in *AddColumns, f Visit) error {
 	if in == nil {
 		return nil
@@ -687,6 +824,56 @@ func VisitRefOfAlterDatabase(in *AlterDatabase, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfAlterEvent(in *AlterEvent, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfDefiner(in.Definer, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfEventSchedule(in.Schedule, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.RenameTo, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Comment, f); err != nil {
+		return err
+	}
+	if err := VisitStatement(in.Body, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfAlterFunction(in *AlterFunction, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	for _, el := range in.Characteristics {
+		if err := VisitRefOfRoutineCharacteristic(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfAlterIndex(in *AlterIndex, f Visit) error {
 	if in == nil {
 		return nil
@@ -711,6 +898,26 @@ func VisitRefOfAlterMigration(in *AlterMigration, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfAlterProcedure(in *AlterProcedure, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	for _, el := range in.Characteristics {
+		if err := VisitRefOfRoutineCharacteristic(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfAlterTable(in *AlterTable, f Visit) error {
 	if in == nil {
 		return nil
@@ -737,6 +944,23 @@ func VisitRefOfAlterTable(in *AlterTable, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfAlterUser(in *AlterUser, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in.Users {
+		if err := VisitRefOfUserSpec(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitRefOfPasswordExpire(in.PasswordExpire, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfAlterView(in *AlterView, f Visit) error {
 	if in == nil {
 		return nil
@@ -835,6 +1059,24 @@ func VisitRefOfAssignmentExpr(in *AssignmentExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfAuthentication(in *Authentication, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Plugin, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Password, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.CurrentPassword, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfAutoIncSpec(in *AutoIncSpec, f Visit) error {
 	if in == nil {
 		return nil
@@ -862,6 +1104,15 @@ func VisitRefOfAvg(in *Avg, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfBadStatement(in *BadStatement, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
 func VisitRefOfBegin(in *Begin, f Visit) error {
 	if in == nil {
 		return nil
@@ -871,6 +1122,21 @@ func VisitRefOfBegin(in *Begin, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfBeginEndBlock(in *BeginEndBlock, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Label, f); err != nil {
+		return err
+	}
+	if err := VisitStatementList(in.Statements, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfBetweenExpr(in *BetweenExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -975,6 +1241,41 @@ func VisitRefOfCaseExpr(in *CaseExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfCaseStatement(in *CaseStatement, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	for _, el := range in.Whens {
+		if err := VisitRefOfCaseStatementWhen(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitStatementList(in.Else, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCaseStatementWhen(in *CaseStatementWhen, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Cond, f); err != nil {
+		return err
+	}
+	if err := VisitStatementList(in.Statements, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfCastExpr(in *CastExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -1032,6 +1333,18 @@ func VisitRefOfCheckConstraintDefinition(in *CheckConstraintDefinition, f Visit)
 	}
 	return nil
 }
+func VisitRefOfCloseCursor(in *CloseCursor, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Name, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfColName(in *ColName, f Visit) error {
 	if in == nil {
 		return nil
@@ -1157,6 +1470,18 @@ func VisitRefOfComparisonExpr(in *ComparisonExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfConditionValue(in *ConditionValue, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Name, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfConstraintDefinition(in *ConstraintDefinition, f Visit) error {
 	if in == nil {
 		return nil
@@ -1250,6 +1575,112 @@ func VisitRefOfCreateDatabase(in *CreateDatabase, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfCreateEvent(in *CreateEvent, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfDefiner(in.Definer, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfEventSchedule(in.Schedule, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Comment, f); err != nil {
+		return err
+	}
+	if err := VisitStatement(in.Body, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCreateFunction(in *CreateFunction, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfDefiner(in.Definer, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	for _, el := range in.Params {
+		if err := VisitRefOfProcParameter(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitRefOfColumnType(in.Returns, f); err != nil {
+		return err
+	}
+	for _, el := range in.Characteristics {
+		if err := VisitRefOfRoutineCharacteristic(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitStatement(in.Body, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCreateProcedure(in *CreateProcedure, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfDefiner(in.Definer, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	for _, el := range in.Params {
+		if err := VisitRefOfProcParameter(el, f); err != nil {
+			return err
+		}
+	}
+	for _, el := range in.Characteristics {
+		if err := VisitRefOfRoutineCharacteristic(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitStatement(in.Body, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCreateRole(in *CreateRole, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in.Roles {
+		if err := VisitRefOfAccount(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfCreateTable(in *CreateTable, f Visit) error {
 	if in == nil {
 		return nil
@@ -1271,6 +1702,55 @@ func VisitRefOfCreateTable(in *CreateTable, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfCreateTrigger(in *CreateTrigger, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfDefiner(in.Definer, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Table, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfTriggerOrder(in.Order, f); err != nil {
+		return err
+	}
+	if err := VisitStatement(in.Body, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCreateUser(in *CreateUser, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in.Users {
+		if err := VisitRefOfUserSpec(el, f); err != nil {
+			return err
+		}
+	}
+	for _, el := range in.DefaultRoles {
+		if err := VisitRefOfAccount(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitRefOfPasswordExpire(in.PasswordExpire, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfCreateView(in *CreateView, f Visit) error {
 	if in == nil {
 		return nil
@@ -1352,6 +1832,71 @@ func VisitRefOfDeallocateStmt(in *DeallocateStmt, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfDeclareCondition(in *DeclareCondition, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfConditionValue(in.Value, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfDeclareCursor(in *DeclareCursor, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitSelectStatement(in.Select, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfDeclareHandler(in *DeclareHandler, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in.Conditions {
+		if err := VisitRefOfConditionValue(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitStatement(in.Statement, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfDeclareVar(in *DeclareVar, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColumns(in.Names, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfColumnType(in.Type, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Default, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfDefault(in *Default, f Visit) error {
 	if in == nil {
 		return nil
@@ -1401,6 +1946,9 @@ func VisitRefOfDelete(in *Delete, f Visit) error {
 	if err := VisitRefOfLimit(in.Limit, f); err != nil {
 		return err
 	}
+	if err := VisitSelectExprs(in.Returning, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfDerivedTable(in *DerivedTable, f Visit) error {
@@ -1410,76 +1958,200 @@ func VisitRefOfDerivedTable(in *DerivedTable, f Visit) error {
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitSelectStatement(in.Select, f); err != nil {
+	if err := VisitSelectStatement(in.Select, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfDropColumn(in *DropColumn, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfColName(in.Name, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfDropDatabase(in *DropDatabase, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitIdentifierCS(in.DBName, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfDropEvent(in *DropEvent, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfDropFunction(in *DropFunction, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfDropKey(in *DropKey, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Name, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfDropProcedure(in *DropProcedure, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfDropRole(in *DropRole, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in.Roles {
+		if err := VisitRefOfAccount(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfDropTable(in *DropTable, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableNames(in.FromTables, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfDropColumn(in *DropColumn, f Visit) error {
+func VisitRefOfDropTrigger(in *DropTrigger, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitRefOfColName(in.Name, f); err != nil {
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfDropDatabase(in *DropDatabase, f Visit) error {
+func VisitRefOfDropUser(in *DropUser, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
-		return err
-	}
-	if err := VisitIdentifierCS(in.DBName, f); err != nil {
-		return err
+	for _, el := range in.Users {
+		if err := VisitRefOfAccount(el, f); err != nil {
+			return err
+		}
 	}
 	return nil
 }
-func VisitRefOfDropKey(in *DropKey, f Visit) error {
+func VisitRefOfDropView(in *DropView, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitIdentifierCI(in.Name, f); err != nil {
+	if err := VisitTableNames(in.FromTables, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfDropTable(in *DropTable, f Visit) error {
+func VisitRefOfElseIfBlock(in *ElseIfBlock, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitTableNames(in.FromTables, f); err != nil {
+	if err := VisitExpr(in.Cond, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+	if err := VisitStatementList(in.Statements, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfDropView(in *DropView, f Visit) error {
+func VisitRefOfEventSchedule(in *EventSchedule, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitTableNames(in.FromTables, f); err != nil {
+	if err := VisitExpr(in.At, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+	if err := VisitExpr(in.Every, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Starts, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Ends, f); err != nil {
 		return err
 	}
 	return nil
@@ -1605,6 +2277,21 @@ func VisitRefOfExtractedSubquery(in *ExtractedSubquery, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfFetchCursor(in *FetchCursor, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitColumns(in.Variables, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfFirstOrLastValueExpr(in *FirstOrLastValueExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -1899,6 +2586,48 @@ func VisitRefOfGeomPropertyFuncExpr(in *GeomPropertyFuncExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfGrant(in *Grant, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in.Privileges {
+		if err := VisitRefOfGrantPrivilege(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitRefOfPrivilegeLevel(in.On, f); err != nil {
+		return err
+	}
+	for _, el := range in.Roles {
+		if err := VisitRefOfAccount(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitRefOfAccount(in.Proxy, f); err != nil {
+		return err
+	}
+	for _, el := range in.To {
+		if err := VisitRefOfAccount(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfGrantPrivilege(in *GrantPrivilege, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColumns(in.Columns, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitGroupBy(in GroupBy, f Visit) error {
 	if in == nil {
 		return nil
@@ -1943,6 +2672,29 @@ func VisitIdentifierCS(in IdentifierCS, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfIfStatement(in *IfStatement, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Cond, f); err != nil {
+		return err
+	}
+	if err := VisitStatementList(in.Statements, f); err != nil {
+		return err
+	}
+	for _, el := range in.ElseIfs {
+		if err := VisitRefOfElseIfBlock(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitStatementList(in.Else, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfIndexDefinition(in *IndexDefinition, f Visit) error {
 	if in == nil {
 		return nil
@@ -2023,6 +2775,12 @@ func VisitRefOfInsert(in *Insert, f Visit) error {
 	if err := VisitOnDup(in.OnDup, f); err != nil {
 		return err
 	}
+	if err := VisitRefOfOnConflict(in.OnConflict, f); err != nil {
+		return err
+	}
+	if err := VisitSelectExprs(in.Returning, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfInsertExpr(in *InsertExpr, f Visit) error {
@@ -2085,6 +2843,18 @@ func VisitRefOfIsExpr(in *IsExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfIterateStatement(in *IterateStatement, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Label, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfJSONArrayExpr(in *JSONArrayExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -2525,6 +3295,18 @@ func VisitRefOfLagLeadExpr(in *LagLeadExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfLeaveStatement(in *LeaveStatement, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Label, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfLimit(in *Limit, f Visit) error {
 	if in == nil {
 		return nil
@@ -2583,6 +3365,60 @@ func VisitRefOfLoad(in *Load, f Visit) error {
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitTableName(in.Table, f); err != nil {
+		return err
+	}
+	if err := VisitPartitions(in.Partitions, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLoadFields(in.Fields, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLoadLines(in.Lines, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.IgnoreLines, f); err != nil {
+		return err
+	}
+	if err := VisitExprs(in.Columns, f); err != nil {
+		return err
+	}
+	if err := VisitUpdateExprs(in.SetExprs, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfLoadFields(in *LoadFields, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.TerminatedBy, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.EnclosedBy, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.EscapedBy, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfLoadLines(in *LoadLines, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.StartingBy, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.TerminatedBy, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfLocateExpr(in *LocateExpr, f Visit) error {
@@ -2636,6 +3472,21 @@ func VisitRefOfLockingFunc(in *LockingFunc, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfLoopStatement(in *LoopStatement, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Label, f); err != nil {
+		return err
+	}
+	if err := VisitStatementList(in.Statements, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfMatchExpr(in *MatchExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -2862,6 +3713,27 @@ func VisitRefOfOffset(in *Offset, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfOnConflict(in *OnConflict, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColumns(in.Columns, f); err != nil {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Constraint, f); err != nil {
+		return err
+	}
+	if err := VisitUpdateExprs(in.Exprs, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfWhere(in.Where, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitOnDup(in OnDup, f Visit) error {
 	if in == nil {
 		return nil
@@ -2876,6 +3748,18 @@ func VisitOnDup(in OnDup, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfOpenCursor(in *OpenCursor, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Name, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfOptLike(in *OptLike, f Visit) error {
 	if in == nil {
 		return nil
@@ -3118,6 +4002,18 @@ func VisitPartitions(in Partitions, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfPasswordExpire(in *PasswordExpire, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Days, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfPerformanceSchemaFuncExpr(in *PerformanceSchemaFuncExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -3172,22 +4068,64 @@ func VisitRefOfPolygonExpr(in *PolygonExpr, f Visit) error {
 	}
 	return nil
 }
-func VisitRefOfPolygonPropertyFuncExpr(in *PolygonPropertyFuncExpr, f Visit) error {
+func VisitRefOfPolygonPropertyFuncExpr(in *PolygonPropertyFuncExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Polygon, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.PropertyDefArg, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfPositionalArg(in *PositionalArg, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfPrepareStmt(in *PrepareStmt, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Statement, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfPrivilegeLevel(in *PrivilegeLevel, f Visit) error {
 	if in == nil {
 		return nil
 	}
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitExpr(in.Polygon, f); err != nil {
+	if err := VisitIdentifierCS(in.DB, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.PropertyDefArg, f); err != nil {
+	if err := VisitIdentifierCS(in.Name, f); err != nil {
 		return err
 	}
 	return nil
 }
-func VisitRefOfPrepareStmt(in *PrepareStmt, f Visit) error {
+func VisitRefOfProcParameter(in *ProcParameter, f Visit) error {
 	if in == nil {
 		return nil
 	}
@@ -3197,10 +4135,7 @@ func VisitRefOfPrepareStmt(in *PrepareStmt, f Visit) error {
 	if err := VisitIdentifierCI(in.Name, f); err != nil {
 		return err
 	}
-	if err := VisitExpr(in.Statement, f); err != nil {
-		return err
-	}
-	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+	if err := VisitRefOfColumnType(in.Type, f); err != nil {
 		return err
 	}
 	return nil
@@ -3397,6 +4332,65 @@ func VisitRefOfRenameTableName(in *RenameTableName, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfRenameUser(in *RenameUser, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in.UserPairs {
+		if err := VisitRefOfRenameUserPair(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfRenameUserPair(in *RenameUserPair, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfAccount(in.FromUser, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfAccount(in.ToUser, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfRepeatStatement(in *RepeatStatement, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Label, f); err != nil {
+		return err
+	}
+	if err := VisitStatementList(in.Statements, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Until, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfReturnStatement(in *ReturnStatement, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfRevertMigration(in *RevertMigration, f Visit) error {
 	if in == nil {
 		return nil
@@ -3409,6 +4403,36 @@ func VisitRefOfRevertMigration(in *RevertMigration, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfRevoke(in *Revoke, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in.Privileges {
+		if err := VisitRefOfGrantPrivilege(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitRefOfPrivilegeLevel(in.On, f); err != nil {
+		return err
+	}
+	for _, el := range in.Roles {
+		if err := VisitRefOfAccount(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitRefOfAccount(in.Proxy, f); err != nil {
+		return err
+	}
+	for _, el := range in.From {
+		if err := VisitRefOfAccount(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfRollback(in *Rollback, f Visit) error {
 	if in == nil {
 		return nil
@@ -3427,6 +4451,59 @@ func VisitRootNode(in RootNode, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfRoutineCharacteristic(in *RoutineCharacteristic, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfRoutineSet(in *RoutineSet, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitRoutineSetExprs(in.Exprs, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfRoutineSetExpr(in *RoutineSetExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Var, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRoutineSetExprs(in RoutineSetExprs, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in {
+		if err := VisitRefOfRoutineSetExpr(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfSRollback(in *SRollback, f Visit) error {
 	if in == nil {
 		return nil
@@ -3516,6 +4593,9 @@ func VisitRefOfSelectInto(in *SelectInto, f Visit) error {
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitExprs(in.Variables, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfSet(in *Set, f Visit) error {
@@ -3533,6 +4613,25 @@ func VisitRefOfSet(in *Set, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfSetDefaultRole(in *SetDefaultRole, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in.Roles {
+		if err := VisitRefOfAccount(el, f); err != nil {
+			return err
+		}
+	}
+	for _, el := range in.To {
+		if err := VisitRefOfAccount(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfSetExpr(in *SetExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -3562,6 +4661,35 @@ func VisitSetExprs(in SetExprs, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfSetPassword(in *SetPassword, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfAccount(in.For, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Password, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfSetRole(in *SetRole, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in.Roles {
+		if err := VisitRefOfAccount(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfShow(in *Show, f Visit) error {
 	if in == nil {
 		return nil
@@ -3655,6 +4783,35 @@ func VisitRefOfShowThrottlerStatus(in *ShowThrottlerStatus, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfSignal(in *Signal, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfConditionValue(in.Condition, f); err != nil {
+		return err
+	}
+	for _, el := range in.Items {
+		if err := VisitRefOfSignalItem(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfSignalItem(in *SignalItem, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Value, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfStarExpr(in *StarExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -3667,6 +4824,20 @@ func VisitRefOfStarExpr(in *StarExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitStatementList(in StatementList, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in {
+		if err := VisitStatement(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfStd(in *Std, f Visit) error {
 	if in == nil {
 		return nil
@@ -3938,6 +5109,18 @@ func VisitRefOfTimestampFuncExpr(in *TimestampFuncExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfTriggerOrder(in *TriggerOrder, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Name, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfTrimFuncExpr(in *TrimFuncExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -3965,6 +5148,21 @@ func VisitRefOfTruncateTable(in *TruncateTable, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfTypeCastExpr(in *TypeCastExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfConvertType(in.Type, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfUnaryExpr(in *UnaryExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -4041,6 +5239,9 @@ func VisitRefOfUpdate(in *Update, f Visit) error {
 	if err := VisitRefOfLimit(in.Limit, f); err != nil {
 		return err
 	}
+	if err := VisitSelectExprs(in.Returning, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfUpdateExpr(in *UpdateExpr, f Visit) error {
@@ -4102,6 +5303,21 @@ func VisitRefOfUse(in *Use, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfUserSpec(in *UserSpec, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfAccount(in.Account, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfAuthentication(in.Auth, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfVExplainStmt(in *VExplainStmt, f Visit) error {
 	if in == nil {
 		return nil
@@ -4309,6 +5525,24 @@ func VisitRefOfWhere(in *Where, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfWhileStatement(in *WhileStatement, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitIdentifierCI(in.Label, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Cond, f); err != nil {
+		return err
+	}
+	if err := VisitStatementList(in.Statements, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfWindowDefinition(in *WindowDefinition, f Visit) error {
 	if in == nil {
 		return nil
@@ -4703,16 +5937,38 @@ func VisitDDLStatement(in DDLStatement, f Visit) error {
 		return nil
 	}
 	switch in := in.(type) {
+	case *AlterEvent:
+		return VisitRefOfAlterEvent(in, f)
+	case *AlterFunction:
+		return VisitRefOfAlterFunction(in, f)
+	case *AlterProcedure:
+		return VisitRefOfAlterProcedure(in, f)
 	case *AlterTable:
 		return VisitRefOfAlterTable(in, f)
 	case *AlterView:
 		return VisitRefOfAlterView(in, f)
+	case *CreateEvent:
+		return VisitRefOfCreateEvent(in, f)
+	case *CreateFunction:
+		return VisitRefOfCreateFunction(in, f)
+	case *CreateProcedure:
+		return VisitRefOfCreateProcedure(in, f)
 	case *CreateTable:
 		return VisitRefOfCreateTable(in, f)
+	case *CreateTrigger:
+		return VisitRefOfCreateTrigger(in, f)
 	case *CreateView:
 		return VisitRefOfCreateView(in, f)
+	case *DropEvent:
+		return VisitRefOfDropEvent(in, f)
+	case *DropFunction:
+		return VisitRefOfDropFunction(in, f)
+	case *DropProcedure:
+		return VisitRefOfDropProcedure(in, f)
 	case *DropTable:
 		return VisitRefOfDropTable(in, f)
+	case *DropTrigger:
+		return VisitRefOfDropTrigger(in, f)
 	case *DropView:
 		return VisitRefOfDropView(in, f)
 	case *RenameTable:
@@ -4929,6 +6185,8 @@ func VisitExpr(in Expr, f Visit) error {
 		return VisitRefOfPolygonExpr(in, f)
 	case *PolygonPropertyFuncExpr:
 		return VisitRefOfPolygonPropertyFuncExpr(in, f)
+	case *PositionalArg:
+		return VisitRefOfPositionalArg(in, f)
 	case *RegexpInstrExpr:
 		return VisitRefOfRegexpInstrExpr(in, f)
 	case *RegexpLikeExpr:
@@ -4955,6 +6213,8 @@ func VisitExpr(in Expr, f Visit) error {
 		return VisitRefOfTimestampFuncExpr(in, f)
 	case *TrimFuncExpr:
 		return VisitRefOfTrimFuncExpr(in, f)
+	case *TypeCastExpr:
+		return VisitRefOfTypeCastExpr(in, f)
 	case *UnaryExpr:
 		return VisitRefOfUnaryExpr(in, f)
 	case *UpdateXMLExpr:
@@ -5063,36 +6323,84 @@ func VisitStatement(in Statement, f Visit) error {
 	switch in := in.(type) {
 	case *AlterDatabase:
 		return VisitRefOfAlterDatabase(in, f)
+	case *AlterEvent:
+		return VisitRefOfAlterEvent(in, f)
+	case *AlterFunction:
+		return VisitRefOfAlterFunction(in, f)
 	case *AlterMigration:
 		return VisitRefOfAlterMigration(in, f)
+	case *AlterProcedure:
+		return VisitRefOfAlterProcedure(in, f)
 	case *AlterTable:
 		return VisitRefOfAlterTable(in, f)
+	case *AlterUser:
+		return VisitRefOfAlterUser(in, f)
 	case *AlterView:
 		return VisitRefOfAlterView(in, f)
 	case *AlterVschema:
 		return VisitRefOfAlterVschema(in, f)
+	case *BadStatement:
+		return VisitRefOfBadStatement(in, f)
 	case *Begin:
 		return VisitRefOfBegin(in, f)
+	case *BeginEndBlock:
+		return VisitRefOfBeginEndBlock(in, f)
 	case *CallProc:
 		return VisitRefOfCallProc(in, f)
+	case *CaseStatement:
+		return VisitRefOfCaseStatement(in, f)
+	case *CloseCursor:
+		return VisitRefOfCloseCursor(in, f)
 	case *CommentOnly:
 		return VisitRefOfCommentOnly(in, f)
 	case *Commit:
 		return VisitRefOfCommit(in, f)
 	case *CreateDatabase:
 		return VisitRefOfCreateDatabase(in, f)
+	case *CreateEvent:
+		return VisitRefOfCreateEvent(in, f)
+	case *CreateFunction:
+		return VisitRefOfCreateFunction(in, f)
+	case *CreateProcedure:
+		return VisitRefOfCreateProcedure(in, f)
+	case *CreateRole:
+		return VisitRefOfCreateRole(in, f)
 	case *CreateTable:
 		return VisitRefOfCreateTable(in, f)
+	case *CreateTrigger:
+		return VisitRefOfCreateTrigger(in, f)
+	case *CreateUser:
+		return VisitRefOfCreateUser(in, f)
 	case *CreateView:
 		return VisitRefOfCreateView(in, f)
 	case *DeallocateStmt:
 		return VisitRefOfDeallocateStmt(in, f)
+	case *DeclareCondition:
+		return VisitRefOfDeclareCondition(in, f)
+	case *DeclareCursor:
+		return VisitRefOfDeclareCursor(in, f)
+	case *DeclareHandler:
+		return VisitRefOfDeclareHandler(in, f)
+	case *DeclareVar:
+		return VisitRefOfDeclareVar(in, f)
 	case *Delete:
 		return VisitRefOfDelete(in, f)
 	case *DropDatabase:
 		return VisitRefOfDropDatabase(in, f)
+	case *DropEvent:
+		return VisitRefOfDropEvent(in, f)
+	case *DropFunction:
+		return VisitRefOfDropFunction(in, f)
+	case *DropProcedure:
+		return VisitRefOfDropProcedure(in, f)
+	case *DropRole:
+		return VisitRefOfDropRole(in, f)
 	case *DropTable:
 		return VisitRefOfDropTable(in, f)
+	case *DropTrigger:
+		return VisitRefOfDropTrigger(in, f)
+	case *DropUser:
+		return VisitRefOfDropUser(in, f)
 	case *DropView:
 		return VisitRefOfDropView(in, f)
 	case *ExecuteStmt:
@@ -5101,14 +6409,28 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfExplainStmt(in, f)
 	case *ExplainTab:
 		return VisitRefOfExplainTab(in, f)
+	case *FetchCursor:
+		return VisitRefOfFetchCursor(in, f)
 	case *Flush:
 		return VisitRefOfFlush(in, f)
+	case *Grant:
+		return VisitRefOfGrant(in, f)
+	case *IfStatement:
+		return VisitRefOfIfStatement(in, f)
 	case *Insert:
 		return VisitRefOfInsert(in, f)
+	case *IterateStatement:
+		return VisitRefOfIterateStatement(in, f)
+	case *LeaveStatement:
+		return VisitRefOfLeaveStatement(in, f)
 	case *Load:
 		return VisitRefOfLoad(in, f)
 	case *LockTables:
 		return VisitRefOfLockTables(in, f)
+	case *LoopStatement:
+		return VisitRefOfLoopStatement(in, f)
+	case *OpenCursor:
+		return VisitRefOfOpenCursor(in, f)
 	case *OtherAdmin:
 		return VisitRefOfOtherAdmin(in, f)
 	case *OtherRead:
@@ -5121,10 +6443,20 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfRelease(in, f)
 	case *RenameTable:
 		return VisitRefOfRenameTable(in, f)
+	case *RenameUser:
+		return VisitRefOfRenameUser(in, f)
+	case *RepeatStatement:
+		return VisitRefOfRepeatStatement(in, f)
+	case *ReturnStatement:
+		return VisitRefOfReturnStatement(in, f)
 	case *RevertMigration:
 		return VisitRefOfRevertMigration(in, f)
+	case *Revoke:
+		return VisitRefOfRevoke(in, f)
 	case *Rollback:
 		return VisitRefOfRollback(in, f)
+	case *RoutineSet:
+		return VisitRefOfRoutineSet(in, f)
 	case *SRollback:
 		return VisitRefOfSRollback(in, f)
 	case *Savepoint:
@@ -5133,6 +6465,12 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfSelect(in, f)
 	case *Set:
 		return VisitRefOfSet(in, f)
+	case *SetDefaultRole:
+		return VisitRefOfSetDefaultRole(in, f)
+	case *SetPassword:
+		return VisitRefOfSetPassword(in, f)
+	case *SetRole:
+		return VisitRefOfSetRole(in, f)
 	case *Show:
 		return VisitRefOfShow(in, f)
 	case *ShowMigrationLogs:
@@ -5141,6 +6479,8 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfShowThrottledApps(in, f)
 	case *ShowThrottlerStatus:
 		return VisitRefOfShowThrottlerStatus(in, f)
+	case *Signal:
+		return VisitRefOfSignal(in, f)
 	case *Stream:
 		return VisitRefOfStream(in, f)
 	case *TruncateTable:
@@ -5157,6 +6497,8 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfVExplainStmt(in, f)
 	case *VStream:
 		return VisitRefOfVStream(in, f)
+	case *WhileStatement:
+		return VisitRefOfWhileStatement(in, f)
 	default:
 		// this should never happen
 		return nil
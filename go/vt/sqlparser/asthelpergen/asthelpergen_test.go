@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asthelpergen
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSQLParserHelpers checks that the helpers of the sqlparser AST are up
+// to date, as `go run ./asthelpergen/main --verify` does.
+func TestSQLParserHelpers(t *testing.T) {
+	if _, err := exec.LookPath("goimports"); err != nil {
+		// CI must verify the helpers, so only a local run may skip them
+		if os.Getenv("CI") != "" {
+			t.Fatal("goimports is needed to format the generated files: go install golang.org/x/tools/cmd/goimports@latest")
+		}
+		t.Skip("goimports is not installed, so the generated files cannot be formatted and verified")
+	}
+	result, err := GenerateASTHelpers(&Options{
+		Packages:      []string{".."},
+		RootInterface: "github.com/kanzihuang/vitess/go/vt/sqlparser.SQLNode",
+		Clone: CloneOptions{
+			Exclude: []string{"*ColName"},
+		},
+		Equals: EqualsOptions{
+			AllowCustom: []string{"*ColName"},
+		},
+	})
+	require.NoError(t, err)
+
+	verifyErrors := VerifyFilesOnDisk(result)
+	require.Empty(t, verifyErrors)
+}
@@ -0,0 +1,438 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asthelpergen
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+)
+
+const (
+	jsonEncoder = "jsonEncoder"
+	jsonDecoder = "jsonDecoder"
+)
+
+// jsonGen generates the methods of jsonEncoder and jsonDecoder, which
+// encode and decode the AST as JSON. The encoder and decoder themselves are
+// written by hand.
+type jsonGen struct {
+	file *jen.File
+}
+
+var _ generator = (*jsonGen)(nil)
+
+func newJSONGen(pkgname string) *jsonGen {
+	file := jen.NewFile(pkgname)
+	file.HeaderComment(licenseFileHeader)
+	file.HeaderComment("Code generated by ASTHelperGen. DO NOT EDIT.")
+	return &jsonGen{file: file}
+}
+
+func (j *jsonGen) genFile() (string, *jen.File) {
+	return "ast_json.go", j.file
+}
+
+func (j *jsonGen) addFuncs(name string, encode, decode *jen.Statement) {
+	j.file.Add(jen.Comment(fmt.Sprintf("%s encodes the value as JSON.", name)))
+	j.file.Add(encode)
+	j.file.Add(jen.Comment(fmt.Sprintf("%s decodes the value from JSON.", name)))
+	j.file.Add(decode)
+}
+
+func (j *jsonGen) declareFuncs(t types.Type) (encode, decode *jen.Statement, name string) {
+	typeString := types.TypeString(t, noQualifier)
+	name = printableTypeName(t)
+	encode = jen.Func().Params(jen.Id("enc").Op("*").Id(jsonEncoder)).Id(name).Call(jen.Id("n").Id(typeString))
+	decode = jen.Func().Params(jen.Id("dec").Op("*").Id(jsonDecoder)).Id(name).Call(jen.Id("data").Qual("encoding/json", "RawMessage")).Id(typeString)
+	return encode, decode, name
+}
+
+// isJSONValue returns whether the type is encoded by encoding/json: the
+// basic types and the types with their own MarshalJSON and UnmarshalJSON.
+func isJSONValue(t types.Type) bool {
+	if isBasic(t) {
+		return true
+	}
+	hasMethod := func(t types.Type, name string) bool {
+		return types.NewMethodSet(t).Lookup(nil, name) != nil
+	}
+	return hasMethod(t, "MarshalJSON") && hasMethod(types.NewPointer(t), "UnmarshalJSON")
+}
+
+// isJSONObject returns whether the type is encoded as an object with its
+// fields.
+func isJSONObject(t types.Type) bool {
+	if isJSONValue(t) {
+		return false
+	}
+	if ptr, ok := t.Underlying().(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	_, ok := t.Underlying().(*types.Struct)
+	return ok
+}
+
+// jsonTag returns the type tag of a node.
+func jsonTag(t types.Type) string {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	return types.TypeString(t, noQualifier)
+}
+
+// encodeValue returns the statement encoding a value of the type.
+func (j *jsonGen) encodeValue(t types.Type, value jen.Code, spi generatorSPI) jen.Code {
+	if isJSONValue(t) {
+		return jen.Id("enc").Dot("value").Call(value)
+	}
+	spi.addType(t)
+	return jen.Id("enc").Dot(printableTypeName(t)).Call(value)
+}
+
+// decodeValue returns the statement decoding a value of the type into the
+// target.
+func (j *jsonGen) decodeValue(t types.Type, target *jen.Statement, data jen.Code, spi generatorSPI) jen.Code {
+	if isJSONValue(t) {
+		return jen.Id("dec").Dot("value").Call(data, jen.Op("&").Add(target))
+	}
+	spi.addType(t)
+	return target.Op("=").Id("dec").Dot(printableTypeName(t)).Call(data)
+}
+
+func (j *jsonGen) interfaceMethod(t types.Type, iface *types.Interface, spi generatorSPI) error {
+	/*
+		func (enc *jsonEncoder) AST(n AST) {
+			if n == nil {
+				enc.null()
+				return
+			}
+			switch n := n.(type) {
+			case *Struct:
+				enc.RefOfStruct(n)
+			case Basic:
+				enc.openNode("Basic")
+				enc.field("Value")
+				enc.value(n)
+				enc.closeObject()
+			}
+		}
+
+		func (dec *jsonDecoder) AST(data json.RawMessage) AST {
+			tag, fields := dec.node(data)
+			switch tag {
+			case "":
+				return nil
+			case "Struct":
+				return dec.RefOfStruct(data)
+			case "Basic":
+				var out Basic
+				dec.value(fields["Value"], &out)
+				return out
+			}
+			dec.unknownTag(tag, "AST")
+			return nil
+		}
+	*/
+	var encodeCases, decodeCases []jen.Code
+	usesFields := false
+	decodeCases = append(decodeCases, jen.Case(jen.Lit("")).Block(jen.Return(jen.Nil())))
+	_ = spi.findImplementations(iface, func(t types.Type) error {
+		if _, ok := t.Underlying().(*types.Interface); ok {
+			return nil
+		}
+		typeString := types.TypeString(t, noQualifier)
+		tag := jsonTag(t)
+		if isJSONObject(t) {
+			encodeCases = append(encodeCases, jen.Case(jen.Id(typeString)).Block(j.encodeValue(t, jen.Id("n"), spi)))
+			spi.addType(t)
+			decodeCases = append(decodeCases, jen.Case(jen.Lit(tag)).Block(
+				jen.Return(jen.Id("dec").Dot(printableTypeName(t)).Call(jen.Id("data")))))
+			return nil
+		}
+		usesFields = true
+		encodeCases = append(encodeCases, jen.Case(jen.Id(typeString)).Block(
+			jen.Id("enc").Dot("openNode").Call(jen.Lit(tag)),
+			jen.Id("enc").Dot("field").Call(jen.Lit("Value")),
+			j.encodeValue(t, jen.Id("n"), spi),
+			jen.Id("enc").Dot("closeObject").Call(),
+		))
+		decodeCases = append(decodeCases, jen.Case(jen.Lit(tag)).Block(
+			jen.Var().Id("out").Id(typeString),
+			j.decodeValue(t, jen.Id("out"), jen.Id("fields").Index(jen.Lit("Value")), spi),
+			jen.Return(jen.Id("out")),
+		))
+		return nil
+	})
+	encodeCases = append(encodeCases, jen.Default().Block(
+		jen.Comment("this should never happen"),
+		jen.Id("enc").Dot("unknownType").Call(jen.Id("n")),
+	))
+
+	encode, decode, name := j.declareFuncs(t)
+	encode = encode.Block(
+		jen.If(jen.Id("n").Op("==").Nil()).Block(
+			jen.Id("enc").Dot("null").Call(),
+			jen.Return(),
+		),
+		jen.Switch(jen.Id("n").Op(":=").Id("n").Assert(jen.Id("type"))).Block(encodeCases...),
+	)
+	fields := jen.Id("_")
+	if usesFields {
+		fields = jen.Id("fields")
+	}
+	decode = decode.Block(
+		jen.List(jen.Id("tag"), fields).Op(":=").Id("dec").Dot("node").Call(jen.Id("data")),
+		jen.Switch(jen.Id("tag")).Block(decodeCases...),
+		jen.Id("dec").Dot("unknownTag").Call(jen.Id("tag"), jen.Lit(name)),
+		jen.Return(jen.Nil()),
+	)
+	j.addFuncs(name, encode, decode)
+	return nil
+}
+
+// structFields returns the statements encoding and decoding the fields of
+// a struct, from n and into out.
+func (j *jsonGen) structFields(strct *types.Struct, spi generatorSPI) (encode, decode []jen.Code) {
+	for i := 0; i < strct.NumFields(); i++ {
+		field := strct.Field(i)
+		if field.Type().Underlying().String() == "any" || strings.HasPrefix(field.Name(), "_") {
+			continue
+		}
+		encode = append(encode,
+			jen.Id("enc").Dot("field").Call(jen.Lit(field.Name())),
+			j.encodeValue(field.Type(), jen.Id("n").Dot(field.Name()), spi))
+		decode = append(decode,
+			j.decodeValue(field.Type(), jen.Id("out").Dot(field.Name()), jen.Id("fields").Index(jen.Lit(field.Name())), spi))
+	}
+	return encode, decode
+}
+
+// openObject returns the statement opening the object of a struct, tagged
+// with its type if it is a node.
+func (j *jsonGen) openObject(t types.Type, spi generatorSPI) jen.Code {
+	if types.Implements(t, spi.iface()) {
+		return jen.Id("enc").Dot("openNode").Call(jen.Lit(jsonTag(t)))
+	}
+	return jen.Id("enc").Dot("openObject").Call()
+}
+
+// objectTag returns the tag the decoded object must have.
+func (j *jsonGen) objectTag(t types.Type, spi generatorSPI) string {
+	if types.Implements(t, spi.iface()) {
+		return jsonTag(t)
+	}
+	return ""
+}
+
+// decodeObject returns the statements reading the fields of an object, and
+// returning if it is null.
+func (j *jsonGen) decodeObject(t types.Type, hasFields bool, ret jen.Code, spi generatorSPI) jen.Code {
+	object := jen.Id("dec").Dot("object").Call(jen.Id("data"), jen.Lit(j.objectTag(t, spi)))
+	if !hasFields {
+		return jen.If(object.Op("==").Nil()).Block(ret)
+	}
+	return jen.Id("fields").Op(":=").Add(object).Line().If(jen.Id("fields").Op("==").Nil()).Block(ret)
+}
+
+func (j *jsonGen) structMethod(t types.Type, strct *types.Struct, spi generatorSPI) error {
+	/*
+		func (enc *jsonEncoder) Struct(n Struct) {
+			enc.openNode("Struct")
+			enc.field("Field")
+			enc.Type(n.Field)
+			enc.closeObject()
+		}
+
+		func (dec *jsonDecoder) Struct(data json.RawMessage) (out Struct) {
+			fields := dec.object(data, "Struct")
+			if fields == nil {
+				return
+			}
+			out.Field = dec.Type(fields["Field"])
+			return
+		}
+	*/
+	if isJSONValue(t) {
+		return nil
+	}
+	encodeFields, decodeFields := j.structFields(strct, spi)
+	encode, _, name := j.declareFuncs(t)
+	typeString := types.TypeString(t, noQualifier)
+
+	stmts := []jen.Code{j.openObject(t, spi)}
+	stmts = append(stmts, encodeFields...)
+	stmts = append(stmts, jen.Id("enc").Dot("closeObject").Call())
+	encode = encode.Block(stmts...)
+
+	stmts = []jen.Code{j.decodeObject(t, len(decodeFields) > 0, jen.Return(), spi)}
+	stmts = append(stmts, decodeFields...)
+	stmts = append(stmts, jen.Return())
+	decode := jen.Func().Params(jen.Id("dec").Op("*").Id(jsonDecoder)).Id(name).
+		Call(jen.Id("data").Qual("encoding/json", "RawMessage")).Params(jen.Id("out").Id(typeString)).Block(stmts...)
+
+	j.addFuncs(name, encode, decode)
+	return nil
+}
+
+func (j *jsonGen) ptrToStructMethod(t types.Type, strct *types.Struct, spi generatorSPI) error {
+	/*
+		func (enc *jsonEncoder) RefOfStruct(n *Struct) {
+			if n == nil {
+				enc.null()
+				return
+			}
+			enc.openNode("Struct")
+			enc.field("Field")
+			enc.Type(n.Field)
+			enc.closeObject()
+		}
+
+		func (dec *jsonDecoder) RefOfStruct(data json.RawMessage) *Struct {
+			fields := dec.object(data, "Struct")
+			if fields == nil {
+				return nil
+			}
+			out := &Struct{}
+			out.Field = dec.Type(fields["Field"])
+			return out
+		}
+	*/
+	encodeFields, decodeFields := j.structFields(strct, spi)
+	encode, decode, name := j.declareFuncs(t)
+	elem := t.Underlying().(*types.Pointer).Elem()
+
+	stmts := []jen.Code{
+		jen.If(jen.Id("n").Op("==").Nil()).Block(
+			jen.Id("enc").Dot("null").Call(),
+			jen.Return(),
+		),
+		j.openObject(t, spi),
+	}
+	stmts = append(stmts, encodeFields...)
+	stmts = append(stmts, jen.Id("enc").Dot("closeObject").Call())
+	encode = encode.Block(stmts...)
+
+	stmts = []jen.Code{
+		j.decodeObject(t, len(decodeFields) > 0, jen.Return(jen.Nil()), spi),
+		jen.Id("out").Op(":=").Op("&").Id(types.TypeString(elem, noQualifier)).Values(),
+	}
+	stmts = append(stmts, decodeFields...)
+	stmts = append(stmts, jen.Return(jen.Id("out")))
+	decode = decode.Block(stmts...)
+
+	j.addFuncs(name, encode, decode)
+	return nil
+}
+
+func (j *jsonGen) ptrToBasicMethod(t types.Type, basic *types.Basic, spi generatorSPI) error {
+	/*
+		func (enc *jsonEncoder) RefOfBool(n *bool) {
+			if n == nil {
+				enc.null()
+				return
+			}
+			enc.value(*n)
+		}
+
+		func (dec *jsonDecoder) RefOfBool(data json.RawMessage) *bool {
+			if isNull(data) {
+				return nil
+			}
+			var out bool
+			dec.value(data, &out)
+			return &out
+		}
+	*/
+	encode, decode, name := j.declareFuncs(t)
+	elem := t.Underlying().(*types.Pointer).Elem()
+	encode = encode.Block(
+		jen.If(jen.Id("n").Op("==").Nil()).Block(
+			jen.Id("enc").Dot("null").Call(),
+			jen.Return(),
+		),
+		jen.Id("enc").Dot("value").Call(jen.Op("*").Id("n")),
+	)
+	decode = decode.Block(
+		jen.If(jen.Id("isNull").Call(jen.Id("data"))).Block(jen.Return(jen.Nil())),
+		jen.Var().Id("out").Id(types.TypeString(elem, noQualifier)),
+		jen.Id("dec").Dot("value").Call(jen.Id("data"), jen.Op("&").Id("out")),
+		jen.Return(jen.Op("&").Id("out")),
+	)
+	j.addFuncs(name, encode, decode)
+	return nil
+}
+
+func (j *jsonGen) sliceMethod(t types.Type, slice *types.Slice, spi generatorSPI) error {
+	/*
+		func (enc *jsonEncoder) SliceOfAST(n []AST) {
+			if n == nil {
+				enc.null()
+				return
+			}
+			enc.openArray()
+			for i, el := range n {
+				enc.element(i)
+				enc.AST(el)
+			}
+			enc.closeArray()
+		}
+
+		func (dec *jsonDecoder) SliceOfAST(data json.RawMessage) []AST {
+			elements := dec.array(data)
+			if elements == nil {
+				return nil
+			}
+			out := make([]AST, len(elements))
+			for i, el := range elements {
+				out[i] = dec.AST(el)
+			}
+			return out
+		}
+	*/
+	encode, decode, name := j.declareFuncs(t)
+	typeString := types.TypeString(t, noQualifier)
+	encode = encode.Block(
+		jen.If(jen.Id("n").Op("==").Nil()).Block(
+			jen.Id("enc").Dot("null").Call(),
+			jen.Return(),
+		),
+		jen.Id("enc").Dot("openArray").Call(),
+		jen.For(jen.List(jen.Id("i"), jen.Id("el")).Op(":=").Range().Id("n")).Block(
+			jen.Id("enc").Dot("element").Call(jen.Id("i")),
+			j.encodeValue(slice.Elem(), jen.Id("el"), spi),
+		),
+		jen.Id("enc").Dot("closeArray").Call(),
+	)
+	decode = decode.Block(
+		jen.Id("elements").Op(":=").Id("dec").Dot("array").Call(jen.Id("data")),
+		jen.If(jen.Id("elements").Op("==").Nil()).Block(jen.Return(jen.Nil())),
+		jen.Id("out").Op(":=").Make(jen.Id(typeString), jen.Len(jen.Id("elements"))),
+		jen.For(jen.List(jen.Id("i"), jen.Id("el")).Op(":=").Range().Id("elements")).Block(
+			j.decodeValue(slice.Elem(), jen.Id("out").Index(jen.Id("i")), jen.Id("el"), spi),
+		),
+		jen.Return(jen.Id("out")),
+	)
+	j.addFuncs(name, encode, decode)
+	return nil
+}
+
+func (j *jsonGen) basicMethod(types.Type, *types.Basic, generatorSPI) error {
+	return nil
+}
@@ -29,6 +29,20 @@ type cachedObject interface {
 	CachedSize(alloc bool) int64
 }
 
+func (cached *Account) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field User string
+	size += hack.RuntimeAllocSize(int64(len(cached.User)))
+	// field Host string
+	size += hack.RuntimeAllocSize(int64(len(cached.Host)))
+	return size
+}
 func (cached *AddColumns) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -188,6 +202,53 @@ func (cached *AlterDatabase) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *AlterEvent) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(128)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Definer *vitess.io/vitess/go/vt/sqlparser.Definer
+	size += cached.Definer.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Schedule *vitess.io/vitess/go/vt/sqlparser.EventSchedule
+	size += cached.Schedule.CachedSize(true)
+	// field RenameTo vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.RenameTo.CachedSize(false)
+	// field Comment *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Comment.CachedSize(true)
+	// field Body vitess.io/vitess/go/vt/sqlparser.Statement
+	if cc, ok := cached.Body.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *AlterFunction) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Characteristics []*vitess.io/vitess/go/vt/sqlparser.RoutineCharacteristic
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Characteristics)) * int64(8))
+		for _, elem := range cached.Characteristics {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
 func (cached *AlterIndex) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -218,6 +279,27 @@ func (cached *AlterMigration) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.Shards)))
 	return size
 }
+func (cached *AlterProcedure) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Characteristics []*vitess.io/vitess/go/vt/sqlparser.RoutineCharacteristic
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Characteristics)) * int64(8))
+		for _, elem := range cached.Characteristics {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
 func (cached *AlterTable) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -245,6 +327,25 @@ func (cached *AlterTable) CachedSize(alloc bool) int64 {
 	size += cached.Comments.CachedSize(true)
 	return size
 }
+func (cached *AlterUser) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Users []*vitess.io/vitess/go/vt/sqlparser.UserSpec
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Users)) * int64(8))
+		for _, elem := range cached.Users {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field PasswordExpire *vitess.io/vitess/go/vt/sqlparser.PasswordExpire
+	size += cached.PasswordExpire.CachedSize(true)
+	return size
+}
 func (cached *AlterView) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -361,6 +462,22 @@ func (cached *AssignmentExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *Authentication) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(56)
+	}
+	// field Plugin vitess.io/vitess/go/vt/sqlparser.IdentifierCI
+	size += cached.Plugin.CachedSize(false)
+	// field Password *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Password.CachedSize(true)
+	// field CurrentPassword *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.CurrentPassword.CachedSize(true)
+	return size
+}
 func (cached *AutoIncSpec) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -389,6 +506,18 @@ func (cached *Avg) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *BadStatement) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field SQL string
+	size += hack.RuntimeAllocSize(int64(len(cached.SQL)))
+	return size
+}
 func (cached *Begin) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -403,6 +532,27 @@ func (cached *Begin) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *BeginEndBlock) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Label vitess.io/vitess/go/vt/sqlparser.IdentifierCI
+	size += cached.Label.CachedSize(false)
+	// field Statements vitess.io/vitess/go/vt/sqlparser.StatementList
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Statements)) * int64(16))
+		for _, elem := range cached.Statements {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
 func (cached *BetweenExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -562,6 +712,59 @@ func (cached *CaseExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *CaseStatement) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Whens []*vitess.io/vitess/go/vt/sqlparser.CaseStatementWhen
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Whens)) * int64(8))
+		for _, elem := range cached.Whens {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Else vitess.io/vitess/go/vt/sqlparser.StatementList
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Else)) * int64(16))
+		for _, elem := range cached.Else {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
+func (cached *CaseStatementWhen) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Cond vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Cond.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Statements vitess.io/vitess/go/vt/sqlparser.StatementList
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Statements)) * int64(16))
+		for _, elem := range cached.Statements {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
 func (cached *CastExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -629,6 +832,18 @@ func (cached *CheckConstraintDefinition) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *CloseCursor) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Name vitess.io/vitess/go/vt/sqlparser.IdentifierCI
+	size += cached.Name.CachedSize(false)
+	return size
+}
 func (cached *ColName) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -837,6 +1052,20 @@ func (cached *ComparisonExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *ConditionValue) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Value string
+	size += hack.RuntimeAllocSize(int64(len(cached.Value)))
+	// field Name vitess.io/vitess/go/vt/sqlparser.IdentifierCI
+	size += cached.Name.CachedSize(false)
+	return size
+}
 func (cached *ConstraintDefinition) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -953,6 +1182,117 @@ func (cached *CreateDatabase) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *CreateEvent) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Definer *vitess.io/vitess/go/vt/sqlparser.Definer
+	size += cached.Definer.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Schedule *vitess.io/vitess/go/vt/sqlparser.EventSchedule
+	size += cached.Schedule.CachedSize(true)
+	// field Comment *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Comment.CachedSize(true)
+	// field Body vitess.io/vitess/go/vt/sqlparser.Statement
+	if cc, ok := cached.Body.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *CreateFunction) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(128)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Definer *vitess.io/vitess/go/vt/sqlparser.Definer
+	size += cached.Definer.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Params []*vitess.io/vitess/go/vt/sqlparser.ProcParameter
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Params)) * int64(8))
+		for _, elem := range cached.Params {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Returns *vitess.io/vitess/go/vt/sqlparser.ColumnType
+	size += cached.Returns.CachedSize(true)
+	// field Characteristics []*vitess.io/vitess/go/vt/sqlparser.RoutineCharacteristic
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Characteristics)) * int64(8))
+		for _, elem := range cached.Characteristics {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Body vitess.io/vitess/go/vt/sqlparser.Statement
+	if cc, ok := cached.Body.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *CreateProcedure) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(128)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Definer *vitess.io/vitess/go/vt/sqlparser.Definer
+	size += cached.Definer.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Params []*vitess.io/vitess/go/vt/sqlparser.ProcParameter
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Params)) * int64(8))
+		for _, elem := range cached.Params {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Characteristics []*vitess.io/vitess/go/vt/sqlparser.RoutineCharacteristic
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Characteristics)) * int64(8))
+		for _, elem := range cached.Characteristics {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Body vitess.io/vitess/go/vt/sqlparser.Statement
+	if cc, ok := cached.Body.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *CreateRole) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Roles []*vitess.io/vitess/go/vt/sqlparser.Account
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Roles)) * int64(8))
+		for _, elem := range cached.Roles {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
 func (cached *CreateTable) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -971,6 +1311,56 @@ func (cached *CreateTable) CachedSize(alloc bool) int64 {
 	size += cached.Comments.CachedSize(true)
 	return size
 }
+func (cached *CreateTrigger) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(128)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Definer *vitess.io/vitess/go/vt/sqlparser.Definer
+	size += cached.Definer.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Table.CachedSize(false)
+	// field Order *vitess.io/vitess/go/vt/sqlparser.TriggerOrder
+	size += cached.Order.CachedSize(true)
+	// field Body vitess.io/vitess/go/vt/sqlparser.Statement
+	if cc, ok := cached.Body.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *CreateUser) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Users []*vitess.io/vitess/go/vt/sqlparser.UserSpec
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Users)) * int64(8))
+		for _, elem := range cached.Users {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field DefaultRoles []*vitess.io/vitess/go/vt/sqlparser.Account
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.DefaultRoles)) * int64(8))
+		for _, elem := range cached.DefaultRoles {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field PasswordExpire *vitess.io/vitess/go/vt/sqlparser.PasswordExpire
+	size += cached.PasswordExpire.CachedSize(true)
+	return size
+}
 func (cached *CreateView) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1078,6 +1468,80 @@ func (cached *DeallocateStmt) CachedSize(alloc bool) int64 {
 	size += cached.Name.CachedSize(false)
 	return size
 }
+func (cached *DeclareCondition) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Name vitess.io/vitess/go/vt/sqlparser.IdentifierCI
+	size += cached.Name.CachedSize(false)
+	// field Value *vitess.io/vitess/go/vt/sqlparser.ConditionValue
+	size += cached.Value.CachedSize(true)
+	return size
+}
+func (cached *DeclareCursor) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Name vitess.io/vitess/go/vt/sqlparser.IdentifierCI
+	size += cached.Name.CachedSize(false)
+	// field Select vitess.io/vitess/go/vt/sqlparser.SelectStatement
+	if cc, ok := cached.Select.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *DeclareHandler) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Conditions []*vitess.io/vitess/go/vt/sqlparser.ConditionValue
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Conditions)) * int64(8))
+		for _, elem := range cached.Conditions {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Statement vitess.io/vitess/go/vt/sqlparser.Statement
+	if cc, ok := cached.Statement.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *DeclareVar) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Names vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Names)) * int64(32))
+		for _, elem := range cached.Names {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Type *vitess.io/vitess/go/vt/sqlparser.ColumnType
+	size += cached.Type.CachedSize(true)
+	// field Default vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Default.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *Default) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1110,7 +1574,7 @@ func (cached *Delete) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(160)
 	}
 	// field With *vitess.io/vitess/go/vt/sqlparser.With
 	size += cached.With.CachedSize(true)
@@ -1150,6 +1614,15 @@ func (cached *Delete) CachedSize(alloc bool) int64 {
 	}
 	// field Limit *vitess.io/vitess/go/vt/sqlparser.Limit
 	size += cached.Limit.CachedSize(true)
+	// field Returning vitess.io/vitess/go/vt/sqlparser.SelectExprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Returning)) * int64(16))
+		for _, elem := range cached.Returning {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
 	return size
 }
 func (cached *DerivedTable) CachedSize(alloc bool) int64 {
@@ -1192,6 +1665,34 @@ func (cached *DropDatabase) CachedSize(alloc bool) int64 {
 	size += cached.DBName.CachedSize(false)
 	return size
 }
+func (cached *DropEvent) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	return size
+}
+func (cached *DropFunction) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	return size
+}
 func (cached *DropKey) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1204,6 +1705,37 @@ func (cached *DropKey) CachedSize(alloc bool) int64 {
 	size += cached.Name.CachedSize(false)
 	return size
 }
+func (cached *DropProcedure) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	return size
+}
+func (cached *DropRole) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Roles []*vitess.io/vitess/go/vt/sqlparser.Account
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Roles)) * int64(8))
+		for _, elem := range cached.Roles {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
 func (cached *DropTable) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1223,6 +1755,37 @@ func (cached *DropTable) CachedSize(alloc bool) int64 {
 	size += cached.Comments.CachedSize(true)
 	return size
 }
+func (cached *DropTrigger) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Name.CachedSize(false)
+	return size
+}
+func (cached *DropUser) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Users []*vitess.io/vitess/go/vt/sqlparser.Account
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Users)) * int64(8))
+		for _, elem := range cached.Users {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
 func (cached *DropView) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1238,8 +1801,57 @@ func (cached *DropView) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(false)
 		}
 	}
-	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
-	size += cached.Comments.CachedSize(true)
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *ElseIfBlock) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Cond vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Cond.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Statements vitess.io/vitess/go/vt/sqlparser.StatementList
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Statements)) * int64(16))
+		for _, elem := range cached.Statements {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
+func (cached *EventSchedule) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field At vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.At.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Every vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Every.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Starts vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Starts.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Ends vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Ends.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
 	return size
 }
 func (cached *ExecuteStmt) CachedSize(alloc bool) int64 {
@@ -1365,6 +1977,25 @@ func (cached *ExtractedSubquery) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *FetchCursor) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Name vitess.io/vitess/go/vt/sqlparser.IdentifierCI
+	size += cached.Name.CachedSize(false)
+	// field Variables vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Variables)) * int64(32))
+		for _, elem := range cached.Variables {
+			size += elem.CachedSize(false)
+		}
+	}
+	return size
+}
 func (cached *FirstOrLastValueExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1703,6 +2334,60 @@ func (cached *GeomPropertyFuncExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *Grant) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field Privileges []*vitess.io/vitess/go/vt/sqlparser.GrantPrivilege
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Privileges)) * int64(8))
+		for _, elem := range cached.Privileges {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field On *vitess.io/vitess/go/vt/sqlparser.PrivilegeLevel
+	size += cached.On.CachedSize(true)
+	// field Roles []*vitess.io/vitess/go/vt/sqlparser.Account
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Roles)) * int64(8))
+		for _, elem := range cached.Roles {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Proxy *vitess.io/vitess/go/vt/sqlparser.Account
+	size += cached.Proxy.CachedSize(true)
+	// field To []*vitess.io/vitess/go/vt/sqlparser.Account
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.To)) * int64(8))
+		for _, elem := range cached.To {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *GrantPrivilege) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field Columns vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(32))
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	return size
+}
 func (cached *GroupConcatExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1759,6 +2444,45 @@ func (cached *IdentifierCS) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.v)))
 	return size
 }
+func (cached *IfStatement) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field Cond vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Cond.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Statements vitess.io/vitess/go/vt/sqlparser.StatementList
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Statements)) * int64(16))
+		for _, elem := range cached.Statements {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field ElseIfs []*vitess.io/vitess/go/vt/sqlparser.ElseIfBlock
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ElseIfs)) * int64(8))
+		for _, elem := range cached.ElseIfs {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Else vitess.io/vitess/go/vt/sqlparser.StatementList
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Else)) * int64(16))
+		for _, elem := range cached.Else {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
 func (cached *IndexColumn) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1858,7 +2582,7 @@ func (cached *Insert) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(128)
+		size += int64(160)
 	}
 	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
 	size += cached.Comments.CachedSize(true)
@@ -1889,6 +2613,17 @@ func (cached *Insert) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field OnConflict *vitess.io/vitess/go/vt/sqlparser.OnConflict
+	size += cached.OnConflict.CachedSize(true)
+	// field Returning vitess.io/vitess/go/vt/sqlparser.SelectExprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Returning)) * int64(16))
+		for _, elem := range cached.Returning {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
 	return size
 }
 func (cached *InsertExpr) CachedSize(alloc bool) int64 {
@@ -1970,6 +2705,18 @@ func (cached *IsExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *IterateStatement) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Label vitess.io/vitess/go/vt/sqlparser.IdentifierCI
+	size += cached.Label.CachedSize(false)
+	return size
+}
 func (cached *JSONArrayExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -2578,6 +3325,18 @@ func (cached *LagLeadExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *LeaveStatement) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Label vitess.io/vitess/go/vt/sqlparser.IdentifierCI
+	size += cached.Label.CachedSize(false)
+	return size
+}
 func (cached *Limit) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -2645,6 +3404,81 @@ func (cached *Literal) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.Val)))
 	return size
 }
+func (cached *Load) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(192)
+	}
+	// field FileName string
+	size += hack.RuntimeAllocSize(int64(len(cached.FileName)))
+	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Table.CachedSize(false)
+	// field Partitions vitess.io/vitess/go/vt/sqlparser.Partitions
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Partitions)) * int64(32))
+		for _, elem := range cached.Partitions {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Charset vitess.io/vitess/go/vt/sqlparser.ColumnCharset
+	size += cached.Charset.CachedSize(false)
+	// field Fields *vitess.io/vitess/go/vt/sqlparser.LoadFields
+	size += cached.Fields.CachedSize(true)
+	// field Lines *vitess.io/vitess/go/vt/sqlparser.LoadLines
+	size += cached.Lines.CachedSize(true)
+	// field IgnoreLines *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.IgnoreLines.CachedSize(true)
+	// field Columns vitess.io/vitess/go/vt/sqlparser.Exprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(16))
+		for _, elem := range cached.Columns {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field SetExprs vitess.io/vitess/go/vt/sqlparser.UpdateExprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.SetExprs)) * int64(8))
+		for _, elem := range cached.SetExprs {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *LoadFields) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field TerminatedBy *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.TerminatedBy.CachedSize(true)
+	// field EnclosedBy *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.EnclosedBy.CachedSize(true)
+	// field EscapedBy *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.EscapedBy.CachedSize(true)
+	return size
+}
+func (cached *LoadLines) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field StartingBy *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.StartingBy.CachedSize(true)
+	// field TerminatedBy *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.TerminatedBy.CachedSize(true)
+	return size
+}
 func (cached *LocateExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -2712,6 +3546,27 @@ func (cached *LockingFunc) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *LoopStatement) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Label vitess.io/vitess/go/vt/sqlparser.IdentifierCI
+	size += cached.Label.CachedSize(false)
+	// field Statements vitess.io/vitess/go/vt/sqlparser.StatementList
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Statements)) * int64(16))
+		for _, elem := range cached.Statements {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
 func (cached *MatchExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -2953,6 +3808,46 @@ func (cached *Offset) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *OnConflict) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field Columns vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(32))
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Constraint vitess.io/vitess/go/vt/sqlparser.IdentifierCI
+	size += cached.Constraint.CachedSize(false)
+	// field Exprs vitess.io/vitess/go/vt/sqlparser.UpdateExprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Exprs)) * int64(8))
+		for _, elem := range cached.Exprs {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Where *vitess.io/vitess/go/vt/sqlparser.Where
+	size += cached.Where.CachedSize(true)
+	return size
+}
+func (cached *OpenCursor) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Name vitess.io/vitess/go/vt/sqlparser.IdentifierCI
+	size += cached.Name.CachedSize(false)
+	return size
+}
 func (cached *OptLike) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -3218,6 +4113,18 @@ func (cached *PartitionValueRange) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *PasswordExpire) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field Days *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Days.CachedSize(true)
+	return size
+}
 func (cached *PerformanceSchemaFuncExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -3305,6 +4212,16 @@ func (cached *PolygonPropertyFuncExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *PositionalArg) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(8)
+	}
+	return size
+}
 func (cached *PrepareStmt) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -3323,6 +4240,34 @@ func (cached *PrepareStmt) CachedSize(alloc bool) int64 {
 	size += cached.Comments.CachedSize(true)
 	return size
 }
+func (cached *PrivilegeLevel) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field DB vitess.io/vitess/go/vt/sqlparser.IdentifierCS
+	size += cached.DB.CachedSize(false)
+	// field Name vitess.io/vitess/go/vt/sqlparser.IdentifierCS
+	size += cached.Name.CachedSize(false)
+	return size
+}
+func (cached *ProcParameter) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Name vitess.io/vitess/go/vt/sqlparser.IdentifierCI
+	size += cached.Name.CachedSize(false)
+	// field Type *vitess.io/vitess/go/vt/sqlparser.ColumnType
+	size += cached.Type.CachedSize(true)
+	return size
+}
 func (cached *PurgeBinaryLogs) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -3559,6 +4504,76 @@ func (cached *RenameTablePair) CachedSize(alloc bool) int64 {
 	size += cached.ToTable.CachedSize(false)
 	return size
 }
+func (cached *RenameUser) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field UserPairs []*vitess.io/vitess/go/vt/sqlparser.RenameUserPair
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.UserPairs)) * int64(8))
+		for _, elem := range cached.UserPairs {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *RenameUserPair) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field FromUser *vitess.io/vitess/go/vt/sqlparser.Account
+	size += cached.FromUser.CachedSize(true)
+	// field ToUser *vitess.io/vitess/go/vt/sqlparser.Account
+	size += cached.ToUser.CachedSize(true)
+	return size
+}
+func (cached *RepeatStatement) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Label vitess.io/vitess/go/vt/sqlparser.IdentifierCI
+	size += cached.Label.CachedSize(false)
+	// field Statements vitess.io/vitess/go/vt/sqlparser.StatementList
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Statements)) * int64(16))
+		for _, elem := range cached.Statements {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field Until vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Until.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *ReturnStatement) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *RevertMigration) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -3573,6 +4588,90 @@ func (cached *RevertMigration) CachedSize(alloc bool) int64 {
 	size += cached.Comments.CachedSize(true)
 	return size
 }
+func (cached *Revoke) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field Privileges []*vitess.io/vitess/go/vt/sqlparser.GrantPrivilege
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Privileges)) * int64(8))
+		for _, elem := range cached.Privileges {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field On *vitess.io/vitess/go/vt/sqlparser.PrivilegeLevel
+	size += cached.On.CachedSize(true)
+	// field Roles []*vitess.io/vitess/go/vt/sqlparser.Account
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Roles)) * int64(8))
+		for _, elem := range cached.Roles {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Proxy *vitess.io/vitess/go/vt/sqlparser.Account
+	size += cached.Proxy.CachedSize(true)
+	// field From []*vitess.io/vitess/go/vt/sqlparser.Account
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.From)) * int64(8))
+		for _, elem := range cached.From {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *RoutineCharacteristic) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Comment string
+	size += hack.RuntimeAllocSize(int64(len(cached.Comment)))
+	return size
+}
+func (cached *RoutineSet) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sqlparser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Exprs vitess.io/vitess/go/vt/sqlparser.RoutineSetExprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Exprs)) * int64(8))
+		for _, elem := range cached.Exprs {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *RoutineSetExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Var vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Var.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *SRollback) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -3668,7 +4767,7 @@ func (cached *SelectInto) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(144)
 	}
 	// field FileName string
 	size += hack.RuntimeAllocSize(int64(len(cached.FileName)))
@@ -3682,6 +4781,15 @@ func (cached *SelectInto) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.Manifest)))
 	// field Overwrite string
 	size += hack.RuntimeAllocSize(int64(len(cached.Overwrite)))
+	// field Variables vitess.io/vitess/go/vt/sqlparser.Exprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Variables)) * int64(16))
+		for _, elem := range cached.Variables {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
 	return size
 }
 func (cached *Set) CachedSize(alloc bool) int64 {
@@ -3703,6 +4811,30 @@ func (cached *Set) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *SetDefaultRole) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Roles []*vitess.io/vitess/go/vt/sqlparser.Account
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Roles)) * int64(8))
+		for _, elem := range cached.Roles {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field To []*vitess.io/vitess/go/vt/sqlparser.Account
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.To)) * int64(8))
+		for _, elem := range cached.To {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
 func (cached *SetExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -3719,6 +4851,37 @@ func (cached *SetExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *SetPassword) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field For *vitess.io/vitess/go/vt/sqlparser.Account
+	size += cached.For.CachedSize(true)
+	// field Password *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Password.CachedSize(true)
+	return size
+}
+func (cached *SetRole) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Roles []*vitess.io/vitess/go/vt/sqlparser.Account
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Roles)) * int64(8))
+		for _, elem := range cached.Roles {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
 func (cached *Show) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -3837,6 +5000,41 @@ func (cached *ShowThrottlerStatus) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *Signal) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Condition *vitess.io/vitess/go/vt/sqlparser.ConditionValue
+	size += cached.Condition.CachedSize(true)
+	// field Items []*vitess.io/vitess/go/vt/sqlparser.SignalItem
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Items)) * int64(8))
+		for _, elem := range cached.Items {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *SignalItem) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field Value vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Value.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *StarExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -4155,6 +5353,18 @@ func (cached *TimestampFuncExpr) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.Unit)))
 	return size
 }
+func (cached *TriggerOrder) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Name vitess.io/vitess/go/vt/sqlparser.IdentifierCI
+	size += cached.Name.CachedSize(false)
+	return size
+}
 func (cached *TrimFuncExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -4185,6 +5395,22 @@ func (cached *TruncateTable) CachedSize(alloc bool) int64 {
 	size += cached.Table.CachedSize(false)
 	return size
 }
+func (cached *TypeCastExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Type *vitess.io/vitess/go/vt/sqlparser.ConvertType
+	size += cached.Type.CachedSize(true)
+	return size
+}
 func (cached *UnaryExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -4236,7 +5462,7 @@ func (cached *Update) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(144)
 	}
 	// field With *vitess.io/vitess/go/vt/sqlparser.With
 	size += cached.With.CachedSize(true)
@@ -4269,6 +5495,15 @@ func (cached *Update) CachedSize(alloc bool) int64 {
 	}
 	// field Limit *vitess.io/vitess/go/vt/sqlparser.Limit
 	size += cached.Limit.CachedSize(true)
+	// field Returning vitess.io/vitess/go/vt/sqlparser.SelectExprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Returning)) * int64(16))
+		for _, elem := range cached.Returning {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
 	return size
 }
 func (cached *UpdateExpr) CachedSize(alloc bool) int64 {
@@ -4321,6 +5556,20 @@ func (cached *Use) CachedSize(alloc bool) int64 {
 	size += cached.DBName.CachedSize(false)
 	return size
 }
+func (cached *UserSpec) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field Account *vitess.io/vitess/go/vt/sqlparser.Account
+	size += cached.Account.CachedSize(true)
+	// field Auth *vitess.io/vitess/go/vt/sqlparser.Authentication
+	size += cached.Auth.CachedSize(true)
+	return size
+}
 func (cached *VExplainStmt) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -4518,6 +5767,31 @@ func (cached *Where) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *WhileStatement) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Label vitess.io/vitess/go/vt/sqlparser.IdentifierCI
+	size += cached.Label.CachedSize(false)
+	// field Cond vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Cond.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Statements vitess.io/vitess/go/vt/sqlparser.StatementList
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Statements)) * int64(16))
+		for _, elem := range cached.Statements {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
 func (cached *WindowDefinition) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
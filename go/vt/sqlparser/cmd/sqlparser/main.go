@@ -0,0 +1,423 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command sqlparser formats, splits, redacts, normalizes, fingerprints,
+// dumps and checks SQL statements.
+//
+//	sqlparser [flags] <command> [file ...]
+//
+// The statements are read from the files, or from the standard input when
+// there are none or the file is "-". They are streamed: the input is never
+// held in memory, so multi-GB dumps can be processed. With --output json,
+// every statement is written as a JSON object on its own line.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+
+	"github.com/kanzihuang/vitess/go/vt/sqlparser"
+)
+
+const usage = `usage: sqlparser [flags] <command> [file ...]
+
+Commands:
+  fmt           pretty print the statements
+  split         split the input into statements
+  redact        replace the literals of the statements with bind variables
+  normalize     replace the literals with bind variables, and print their values
+  fingerprint   print the digest of the statements
+  ast           dump the syntax tree of the statements as JSON
+  check         only parse the statements, and report their errors
+
+Flags:
+`
+
+// record is a statement written with --output json.
+type record struct {
+	File string `json:"file,omitempty"`
+	// Statement is the 1-based index of the statement in its file.
+	Statement   int                `json:"statement"`
+	SQL         string             `json:"sql,omitempty"`
+	BindVars    map[string]bindVar `json:"bind_vars,omitempty"`
+	Fingerprint string             `json:"fingerprint,omitempty"`
+	Digest      string             `json:"digest,omitempty"`
+	AST         json.RawMessage    `json:"ast,omitempty"`
+	Error       *errorRecord       `json:"error,omitempty"`
+}
+
+type bindVar struct {
+	Type   string   `json:"type"`
+	Value  string   `json:"value,omitempty"`
+	Values []string `json:"values,omitempty"`
+}
+
+type errorRecord struct {
+	Message string `json:"message"`
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+	// Offset is the 0-based byte offset of the error in the file.
+	Offset *int `json:"offset,omitempty"`
+}
+
+// command processes the statements of a file.
+type command func(cli *cli, file string, reader io.Reader)
+
+var commands = map[string]command{
+	"fmt":         (*cli).fmt,
+	"split":       (*cli).split,
+	"redact":      (*cli).redact,
+	"normalize":   (*cli).normalize,
+	"fingerprint": (*cli).fingerprint,
+	"ast":         (*cli).ast,
+	"check":       (*cli).check,
+}
+
+type cli struct {
+	parser *sqlparser.Parser
+	json   bool
+	stdin  io.Reader
+	stdout *bufio.Writer
+	stderr io.Writer
+	// failed is set when a statement is in error.
+	failed bool
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs the command line, and returns the exit code: 1 if a statement is
+// in error, 2 if the command line is.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := pflag.NewFlagSet("sqlparser", pflag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+	dialect := flags.String("dialect", "mysql", "SQL dialect of the statements: mysql or postgres")
+	version := flags.String("mysql-version", "", "MySQL version the parser emulates, like 8.0.30")
+	output := flags.String("output", "text", "output format: text, or json for one JSON object per statement")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, pflag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+	cmd, ok := commands[flags.Arg(0)]
+	if !ok {
+		fmt.Fprintf(stderr, "sqlparser: unknown command %q\n", flags.Arg(0))
+		return 2
+	}
+
+	c := &cli{stdin: stdin, stdout: bufio.NewWriter(stdout), stderr: stderr}
+	opts := sqlparser.Options{MySQLServerVersion: *version}
+	switch *dialect {
+	case "mysql":
+		opts.Dialect = sqlparser.MysqlDialect{}
+	case "postgres", "postgresql":
+		opts.Dialect = sqlparser.PostgresDialect{}
+	default:
+		fmt.Fprintf(stderr, "sqlparser: unknown dialect %q\n", *dialect)
+		return 2
+	}
+	parser, err := sqlparser.New(opts)
+	if err != nil {
+		fmt.Fprintf(stderr, "sqlparser: invalid MySQL version %q: %v\n", *version, err)
+		return 2
+	}
+	c.parser = parser
+	switch *output {
+	case "text":
+	case "json":
+		c.json = true
+	default:
+		fmt.Fprintf(stderr, "sqlparser: unknown output format %q\n", *output)
+		return 2
+	}
+
+	files := flags.Args()[1:]
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+	for _, file := range files {
+		if !c.process(cmd, file) {
+			break
+		}
+	}
+	if err := c.stdout.Flush(); err != nil {
+		fmt.Fprintf(stderr, "sqlparser: %v\n", err)
+		return 1
+	}
+	if c.failed {
+		return 1
+	}
+	return 0
+}
+
+// process runs the command on a file, and returns false if the output
+// cannot be written.
+func (c *cli) process(cmd command, file string) bool {
+	reader := c.stdin
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			c.failed = true
+			fmt.Fprintf(c.stderr, "sqlparser: %v\n", err)
+			return true
+		}
+		defer f.Close()
+		reader = f
+	}
+	cmd(c, file, reader)
+	// bufio.Writer keeps the first error, and ignores the next writes
+	return c.stdout.Flush() == nil
+}
+
+func (c *cli) tokenizer(reader io.Reader, opts ...sqlparser.TokenizerOpt) *sqlparser.Tokenizer {
+	return c.parser.NewReaderTokenizer(reader, opts...)
+}
+
+// write writes a record as JSON, or its text.
+func (c *cli) write(rec *record, text string) {
+	if rec.File == "-" {
+		rec.File = ""
+	}
+	if c.json {
+		data, err := json.Marshal(rec)
+		if err != nil {
+			rec = &record{File: rec.File, Statement: rec.Statement, Error: &errorRecord{Message: err.Error()}}
+			data, _ = json.Marshal(rec)
+		}
+		c.stdout.Write(data)
+		c.stdout.WriteByte('\n')
+		return
+	}
+	c.stdout.WriteString(text)
+}
+
+// fail reports a statement in error.
+func (c *cli) fail(rec *record, err error) {
+	c.failed = true
+	rec.Error = &errorRecord{Message: err.Error()}
+	if c.json {
+		c.write(rec, "")
+		return
+	}
+	fmt.Fprintf(c.stderr, "%s: statement %d: %s\n", displayName(rec.File), rec.Statement, rec.Error.Message)
+}
+
+func displayName(file string) string {
+	if file == "" || file == "-" {
+		return "<stdin>"
+	}
+	return file
+}
+
+// isFatal returns whether an error stops the reading of a file: the
+// parser recovers from syntax errors at the end of the statement, but not
+// from the errors of the reader.
+func isFatal(err error) bool {
+	var readErr *sqlparser.ReadError
+	var cacheErr *sqlparser.CacheError
+	return errors.As(err, &readErr) || errors.As(err, &cacheErr)
+}
+
+// parse calls fn with the parsed statements of a file, and the comments
+// attached to their nodes when parsing WithComments.
+func (c *cli) parse(file string, reader io.Reader, fn func(rec *record, stmt sqlparser.Statement, comments *sqlparser.NodeComments), opts ...sqlparser.TokenizerOpt) {
+	tokenizer := c.tokenizer(reader, opts...)
+	for i := 1; ; i++ {
+		stmt, err := c.parser.ParseNext(tokenizer)
+		if err == io.EOF {
+			return
+		}
+		rec := &record{File: file, Statement: i}
+		if err != nil {
+			c.fail(rec, err)
+			if isFatal(err) {
+				return
+			}
+			continue
+		}
+		c.statement(rec, func() { fn(rec, stmt, tokenizer.Comments()) })
+	}
+}
+
+// splitStatements calls fn with the statements of a file, as they are
+// written in the file.
+func (c *cli) splitStatements(file string, reader io.Reader, fn func(rec *record, sql string)) {
+	tokenizer := c.tokenizer(reader, sqlparser.WithCacheInBuffer())
+	for i := 1; ; i++ {
+		sql, err := sqlparser.SplitNext(tokenizer)
+		if err == io.EOF {
+			return
+		}
+		rec := &record{File: file, Statement: i}
+		if err != nil {
+			// the tokenizer cannot recover from an error of SplitNext
+			c.fail(rec, err)
+			return
+		}
+		c.statement(rec, func() { fn(rec, sql) })
+	}
+}
+
+// statement runs the command on a statement. A panic while processing the
+// statement is reported as an error of the statement, instead of stopping
+// the command.
+func (c *cli) statement(rec *record, fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			c.fail(rec, fmt.Errorf("internal error: %v", r))
+		}
+	}()
+	fn()
+}
+
+func (c *cli) fmt(file string, reader io.Reader) {
+	c.parse(file, reader, func(rec *record, stmt sqlparser.Statement, comments *sqlparser.NodeComments) {
+		style := sqlparser.DefaultPrettyStyle
+		style.Dialect = c.parser.Dialect()
+		rec.SQL = style.FormatWithComments(stmt, comments)
+		c.write(rec, rec.SQL+";\n")
+	}, sqlparser.WithComments())
+}
+
+func (c *cli) split(file string, reader io.Reader) {
+	c.splitStatements(file, reader, func(rec *record, sql string) {
+		rec.SQL = sql
+		c.write(rec, sql+";\n")
+	})
+}
+
+func (c *cli) redact(file string, reader io.Reader) {
+	c.splitStatements(file, reader, func(rec *record, sql string) {
+		redacted, err := c.parser.RedactSQLQuery(sql)
+		if err != nil {
+			c.fail(rec, err)
+			return
+		}
+		rec.SQL = redacted
+		c.write(rec, redacted+";\n")
+	})
+}
+
+func (c *cli) normalize(file string, reader io.Reader) {
+	c.splitStatements(file, reader, func(rec *record, sql string) {
+		stmt, known, err := c.parser.Parse2(sql)
+		if err != nil {
+			c.fail(rec, err)
+			return
+		}
+		bindVars := make(map[string]*querypb.BindVariable)
+		if err := sqlparser.Normalize(stmt, sqlparser.NewReservedVars("bv", known), bindVars); err != nil {
+			c.fail(rec, err)
+			return
+		}
+		rec.SQL = sqlparser.StringWithDialect(stmt, c.parser.Dialect())
+		rec.BindVars = make(map[string]bindVar, len(bindVars))
+		for name, bv := range bindVars {
+			rec.BindVars[name] = newBindVar(bv)
+		}
+		c.write(rec, rec.SQL+";\n")
+	})
+}
+
+func newBindVar(bv *querypb.BindVariable) bindVar {
+	if bv.Type != querypb.Type_TUPLE {
+		return bindVar{Type: bv.Type.String(), Value: string(bv.Value)}
+	}
+	values := make([]string, 0, len(bv.Values))
+	for _, value := range bv.Values {
+		values = append(values, string(value.Value))
+	}
+	return bindVar{Type: bv.Type.String(), Values: values}
+}
+
+func (c *cli) fingerprint(file string, reader io.Reader) {
+	c.splitStatements(file, reader, func(rec *record, sql string) {
+		digest, err := c.parser.FingerprintString(sql)
+		if err != nil {
+			c.fail(rec, err)
+			return
+		}
+		rec.Fingerprint, rec.Digest = digest.String(), digest.Text
+		c.write(rec, rec.Fingerprint+"\t"+rec.Digest+"\n")
+	})
+}
+
+func (c *cli) ast(file string, reader io.Reader) {
+	c.parse(file, reader, func(rec *record, stmt sqlparser.Statement, _ *sqlparser.NodeComments) {
+		data, err := sqlparser.MarshalNode(stmt)
+		if err != nil {
+			c.fail(rec, err)
+			return
+		}
+		rec.AST = data
+		c.write(rec, string(data)+"\n")
+	})
+}
+
+// check parses the statements of a file, and reports their errors with
+// the line and column of the token they were found at, like the other
+// commands on stderr. Partially parsed DDL statements are errors.
+func (c *cli) check(file string, reader io.Reader) {
+	tokenizer := c.tokenizer(reader, sqlparser.WithPositions())
+	for i := 1; ; i++ {
+		_, err := sqlparser.ParseNextStrictDDL(tokenizer)
+		if err == io.EOF {
+			return
+		}
+		if err == nil {
+			continue
+		}
+		c.failed = true
+		rec := &record{File: file, Statement: i, Error: &errorRecord{Message: err.Error()}}
+		var posErr sqlparser.PositionedErr
+		if errors.As(err, &posErr) {
+			pos := tokenizer.ErrorPosition()
+			rec.Error = &errorRecord{Message: posErr.Err, Line: pos.Line, Column: pos.Column, Offset: &pos.Offset}
+			if posErr.Near != "" {
+				rec.Error.Message = fmt.Sprintf("%s near '%s'", posErr.Err, posErr.Near)
+			}
+		}
+		if c.json {
+			c.write(rec, "")
+		} else if rec.Error.Line > 0 {
+			fmt.Fprintf(c.stderr, "%s:%d:%d: %s\n", displayName(file), rec.Error.Line, rec.Error.Column, rec.Error.Message)
+		} else {
+			fmt.Fprintf(c.stderr, "%s: statement %d: %s\n", displayName(file), i, strings.TrimSpace(rec.Error.Message))
+		}
+		if isFatal(err) {
+			return
+		}
+	}
+}
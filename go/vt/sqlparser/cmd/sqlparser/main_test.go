@@ -0,0 +1,196 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const input = "select * from t where a = 1 and b in (1, 2);\n" +
+	"-- bump\nupdate t set a = 'x' where id = 5;\n" +
+	"select from;\n" +
+	"insert into t values (1)"
+
+func runCLI(t *testing.T, stdin string, args ...string) (int, string, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestCommands(t *testing.T) {
+	testcases := []struct {
+		args   []string
+		stdout string
+		stderr string
+	}{{
+		args:   []string{"fmt"},
+		stdout: "select *\nfrom t\nwhere a = 1 and b in (1, 2);\n-- bump\nupdate t\nset a = 'x'\nwhere id = 5;\ninsert into t\nvalues (1);\n",
+		stderr: "<stdin>: statement 3: syntax error at position 100 near 'from'\n",
+	}, {
+		args:   []string{"split"},
+		stdout: "select * from t where a = 1 and b in (1, 2);\nupdate t set a = 'x' where id = 5;\nselect from;\ninsert into t values (1);\n",
+	}, {
+		args:   []string{"redact"},
+		stdout: "select * from t where a = :a /* INT64 */ and b in ::redacted1;\nupdate t set a = :a /* VARCHAR */ where id = :id /* INT64 */;\ninsert into t values (:redacted1 /* INT64 */);\n",
+		stderr: "<stdin>: statement 3: syntax error at position 12 near 'from'\n",
+	}, {
+		args: []string{"--output", "json", "normalize"},
+		stdout: `{"statement":1,"sql":"select * from t where a = :a /* INT64 */ and b in ::bv1","bind_vars":{"a":{"type":"INT64","value":"1"},"bv1":{"type":"TUPLE","values":["1","2"]}}}` + "\n" +
+			`{"statement":2,"sql":"update t set a = :a /* VARCHAR */ where id = :id /* INT64 */","bind_vars":{"a":{"type":"VARCHAR","value":"x"},"id":{"type":"INT64","value":"5"}}}` + "\n" +
+			`{"statement":3,"error":{"message":"syntax error at position 12 near 'from'"}}` + "\n" +
+			`{"statement":4,"sql":"insert into t values (:bv1 /* INT64 */)","bind_vars":{"bv1":{"type":"INT64","value":"1"}}}` + "\n",
+	}, {
+		args: []string{"fingerprint"},
+		stdout: "f2f2cbba4c63750ebb2cab8f3dbb26cfda9184a356042096c981a355d2f10805\tSELECT * FROM `t` WHERE `a` = ? AND `b` IN (...)\n" +
+			"da56403f331b2da48f007360ea7c968b1986a0eb7a66910ff1ad0595ed99c65f\tUPDATE `t` SET `a` = ? WHERE `id` = ?\n" +
+			"33484d15149ca73925fd710bc32441f6cf4fdd782ea00a7562f04a600771be74\tSELECT FROM\n" +
+			"18caeede36982467510744aa73058b3a75dda0cb1134069139ec0b257279adfa\tINSERT INTO `t` VALUES (...)\n",
+	}, {
+		args:   []string{"check"},
+		stderr: "<stdin>:4:8: syntax error near 'from'\n",
+	}, {
+		args:   []string{"--output", "json", "check"},
+		stdout: `{"statement":3,"error":{"message":"syntax error near 'from'","line":4,"column":8,"offset":95}}` + "\n",
+	}}
+	for _, tcase := range testcases {
+		t.Run(strings.Join(tcase.args, " "), func(t *testing.T) {
+			code, stdout, stderr := runCLI(t, input, tcase.args...)
+			assert.Equal(t, tcase.stdout, stdout)
+			assert.Equal(t, tcase.stderr, stderr)
+			failed := tcase.args[len(tcase.args)-1] != "split" && tcase.args[len(tcase.args)-1] != "fingerprint"
+			assert.Equal(t, failed, code == 1, "exit code %d", code)
+		})
+	}
+}
+
+func TestLoadData(t *testing.T) {
+	code, stdout, stderr := runCLI(t, "select 1 from t;\nload data infile 'a' into table t fields terminated by ',' ignore 3 lines", "redact")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, "select :redacted1 /* INT64 */ from t;\nload data infile 'a' into table t fields terminated by ',' ignore 3 lines;\n", stdout)
+}
+
+func TestStatementPanic(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	c := &cli{stdout: bufio.NewWriter(&stdout), stderr: &stderr}
+	c.statement(&record{Statement: 1}, func() { c.write(&record{Statement: 1}, "select 1;\n") })
+	c.statement(&record{Statement: 2}, func() { panic("boom") })
+	require.NoError(t, c.stdout.Flush())
+	assert.True(t, c.failed)
+	assert.Equal(t, "select 1;\n", stdout.String())
+	assert.Equal(t, "<stdin>: statement 2: internal error: boom\n", stderr.String())
+}
+
+func TestCheckColumn(t *testing.T) {
+	// the error is located at the start of the token it was found at
+	code, stdout, stderr := runCLI(t, "select 1;\nselec 2 from t;\nselect 3 from t wher a = 1", "check")
+	assert.Equal(t, 1, code)
+	assert.Empty(t, stdout)
+	assert.Equal(t, "<stdin>:2:1: syntax error near 'selec'\n<stdin>:3:22: syntax error near 'a'\n", stderr)
+}
+
+func TestAST(t *testing.T) {
+	code, stdout, _ := runCLI(t, "select 1 from dual", "ast")
+	require.Equal(t, 0, code)
+	assert.True(t, strings.HasPrefix(stdout, `{"@type":"Select",`), stdout)
+	assert.True(t, strings.HasSuffix(stdout, "}\n"), stdout)
+}
+
+func TestFiles(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.sql")
+	require.NoError(t, os.WriteFile(first, []byte("select 1;\nselect 2 from;"), 0o644))
+	second := filepath.Join(dir, "second.sql")
+	require.NoError(t, os.WriteFile(second, []byte("\n\nselect 3 where"), 0o644))
+
+	code, stdout, stderr := runCLI(t, "", "check", first, second)
+	assert.Equal(t, 1, code)
+	assert.Empty(t, stdout)
+	assert.Equal(t, first+":2:14: syntax error\n"+second+":3:15: syntax error\n", stderr)
+
+	code, stdout, _ = runCLI(t, "select 4", "--output=json", "split", first, "-")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, `{"file":"`+first+`","statement":1,"sql":"select 1"}`+"\n"+
+		`{"file":"`+first+`","statement":2,"sql":"select 2 from"}`+"\n"+
+		`{"statement":1,"sql":"select 4"}`+"\n", stdout)
+
+	code, _, stderr = runCLI(t, "", "check", filepath.Join(dir, "missing.sql"))
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "missing.sql")
+}
+
+func TestDialect(t *testing.T) {
+	code, stdout, _ := runCLI(t, `select "a" from t`, "--dialect", "postgres", "fmt")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "select a\nfrom t;\n", stdout)
+
+	code, stdout, _ = runCLI(t, `select "Col" from t where a = 'x\'`, "--dialect", "postgres", "fmt")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "select \"Col\"\nfrom t\nwhere a = 'x\\';\n", stdout)
+
+	code, stdout, _ = runCLI(t, `select "Col" from t where a = 'x\'`, "--dialect", "postgres", "redact")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "select \"Col\" from t where a = :a /* VARCHAR */;\n", stdout)
+
+	// the statement is fingerprinted from the tokens of the dialect
+	code, stdout, _ = runCLI(t, `select 'x\' from`, "--dialect", "postgres", "fingerprint")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "13cf452e76ae69418f400cd143901db2401a4cc72082b38a66c44f8761cc98e0\tSELECT ? FROM\n", stdout)
+}
+
+func TestUsage(t *testing.T) {
+	code, _, stderr := runCLI(t, "")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "usage: sqlparser")
+
+	code, _, stderr = runCLI(t, "", "frobnicate")
+	assert.Equal(t, 2, code)
+	assert.Equal(t, "sqlparser: unknown command \"frobnicate\"\n", stderr)
+
+	code, _, stderr = runCLI(t, "", "--dialect", "oracle", "fmt")
+	assert.Equal(t, 2, code)
+	assert.Equal(t, "sqlparser: unknown dialect \"oracle\"\n", stderr)
+
+	code, _, _ = runCLI(t, "", "--mysql-version", "x", "fmt")
+	assert.Equal(t, 2, code)
+}
+
+func TestMySQLVersion(t *testing.T) {
+	code, stdout, _ := runCLI(t, "select /*!80040 1 + */ 2 from dual", "--mysql-version", "8.0.40", "fmt")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "select 1 + 2\nfrom dual;\n", stdout)
+
+	code, stdout, _ = runCLI(t, "select /*!80040 1 + */ 2 from dual", "--mysql-version", "8.0.30", "fmt")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "select 2\nfrom dual;\n", stdout)
+
+	code, stdout, _ = runCLI(t, "select /*!80040 1 + */ 2 from dual", "--mysql-version", "8.0.40", "redact")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "select :redacted1 /* INT64 */ + :redacted2 /* INT64 */ from dual;\n", stdout)
+
+	code, stdout, _ = runCLI(t, "select /*!80040 1 + */ 2 from dual", "--mysql-version", "8.0.40", "fingerprint")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "75d40cf161c1ac6f1346cbf1609747c6d0dfba5aa4efc715e049eb2f43bda8a3\tSELECT ? + ? FROM `dual`\n", stdout)
+}
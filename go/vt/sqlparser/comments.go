@@ -56,6 +56,10 @@ const (
 	// DirectivePriority specifies the priority of a workload. It should be an integer between 0 and MaxPriorityValue,
 	// where 0 is the highest priority, and MaxPriorityValue is the lowest one.
 	DirectivePriority = "PRIORITY"
+	// DirectiveLintRules lists the only lint rules checking the statement, separated by commas.
+	DirectiveLintRules = "LINT_RULES"
+	// DirectiveLintDisable lists the lint rules not checking the statement, separated by commas.
+	DirectiveLintDisable = "LINT_DISABLE"
 
 	// MaxPriorityValue specifies the maximum value allowed for the priority query directive. Valid priority values are
 	// between zero and MaxPriorityValue.
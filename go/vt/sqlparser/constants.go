@@ -37,6 +37,10 @@ const (
 	UnionStr         = "union"
 	UnionAllStr      = "union all"
 	UnionDistinctStr = "union distinct"
+	IntersectStr     = "intersect"
+	IntersectAllStr  = "intersect all"
+	ExceptStr        = "except"
+	ExceptAllStr     = "except all"
 
 	// DDL strings.
 	InsertStr  = "insert"
@@ -141,6 +145,8 @@ const (
 	NotLikeStr       = "not like"
 	RegexpStr        = "regexp"
 	NotRegexpStr     = "not regexp"
+	ILikeStr         = "ilike"
+	NotILikeStr      = "not ilike"
 
 	// IsExpr.Operator
 	IsNullStr     = "is null"
@@ -164,6 +170,7 @@ const (
 	ShiftRightStr           = ">>"
 	JSONExtractOpStr        = "->"
 	JSONUnquoteExtractOpStr = "->>"
+	ConcatStr               = "||"
 
 	// UnaryExpr.Operator
 	UPlusStr    = "+"
@@ -229,6 +236,7 @@ const (
 	IntoOutfileStr   = " into outfile "
 	IntoOutfileS3Str = " into outfile s3 "
 	IntoDumpfileStr  = " into dumpfile "
+	IntoVariablesStr = " into "
 
 	// Order.Direction
 	AscScr  = "asc"
@@ -267,6 +275,33 @@ const (
 	WriteStr            = "write"
 	LowPriorityWriteStr = "low_priority write"
 
+	// LoadPriority strings
+	LowPriorityLoadStr = "low_priority "
+	ConcurrentLoadStr  = "concurrent "
+
+	// PrivilegeObjectType strings
+	TableObjectTypeStr     = "table "
+	FunctionObjectTypeStr  = "function "
+	ProcedureObjectTypeStr = "procedure "
+
+	// AccountLock strings
+	AccountLockedStr   = " account lock"
+	AccountUnlockedStr = " account unlock"
+
+	// PasswordExpireType strings
+	PasswordExpireNowStr      = "password expire"
+	PasswordExpireDefaultStr  = "password expire default"
+	PasswordExpireNeverStr    = "password expire never"
+	PasswordExpireIntervalStr = "password expire interval"
+
+	// SetRoleType strings
+	DefaultRoleStr = "default"
+	NoRolesStr     = "none"
+	AllRolesStr    = "all"
+
+	// RedactedPassword replaces the passwords redacted by RedactSQLQuery
+	RedactedPassword = "<redacted>"
+
 	// ShowCommand Types
 	CharsetStr                 = " charset"
 	CollationStr               = " collation"
@@ -483,6 +518,51 @@ const (
 	LatitudeFromHashStr  = "st_latfromgeohash"
 	LongitudeFromHashStr = "st_longfromgeohash"
 	PointFromHashStr     = "st_pointfromgeohash"
+
+	// ProcParameter.Mode strings
+	InParameterStr    = "in"
+	OutParameterStr   = "out"
+	InOutParameterStr = "inout"
+
+	// RoutineCharacteristic.Type strings
+	CommentCharacteristicStr          = "comment"
+	LanguageSQLCharacteristicStr      = "language sql"
+	DeterministicCharacteristicStr    = "deterministic"
+	NotDeterministicCharacteristicStr = "not deterministic"
+	ContainsSQLCharacteristicStr      = "contains sql"
+	NoSQLCharacteristicStr            = "no sql"
+	ReadsSQLDataCharacteristicStr     = "reads sql data"
+	ModifiesSQLDataCharacteristicStr  = "modifies sql data"
+	SQLSecurityDefinerStr             = "sql security definer"
+	SQLSecurityInvokerStr             = "sql security invoker"
+
+	// CreateTrigger.Timing strings
+	BeforeTriggerStr = "before"
+	AfterTriggerStr  = "after"
+
+	// CreateTrigger.Event strings
+	InsertTriggerStr = "insert"
+	UpdateTriggerStr = "update"
+	DeleteTriggerStr = "delete"
+
+	// EventOnCompletion strings
+	OnCompletionPreserveStr    = "on completion preserve"
+	OnCompletionNotPreserveStr = "on completion not preserve"
+
+	// EventStatus strings
+	EnableEventStatusStr           = "enable"
+	DisableEventStatusStr          = "disable"
+	DisableOnReplicaEventStatusStr = "disable on slave"
+
+	// DeclareHandler.Action strings
+	ContinueHandlerStr = "continue"
+	ExitHandlerStr     = "exit"
+	UndoHandlerStr     = "undo"
+
+	// ConditionValue.Type strings
+	SQLWarningConditionStr   = "sqlwarning"
+	NotFoundConditionStr     = "not found"
+	SQLExceptionConditionStr = "sqlexception"
 )
 
 // Constants for Enum Type - Insert.Action
@@ -529,6 +609,13 @@ const (
 	ShareModeLock
 )
 
+// Constants for Enum Type - SetOpType
+const (
+	UnionType SetOpType = iota
+	IntersectType
+	ExceptType
+)
+
 // Constants for Enum Type - TrimType
 const (
 	NoTrimType TrimType = iota
@@ -673,6 +760,8 @@ const (
 	NotLikeOp
 	RegexpOp
 	NotRegexpOp
+	ILikeOp
+	NotILikeOp
 )
 
 // Constant for Enum Type - IsExprOperator
@@ -700,6 +789,7 @@ const (
 	ShiftRightOp
 	JSONExtractOp
 	JSONUnquoteExtractOp
+	ConcatOp
 )
 
 // Constant for Enum Type - UnaryExprOperator
@@ -797,6 +887,7 @@ const (
 	IntoOutfile SelectIntoType = iota
 	IntoOutfileS3
 	IntoDumpfile
+	IntoVariables
 )
 
 // Constant for Enum Type - JtOnResponseType
@@ -1037,3 +1128,108 @@ const (
 	LongitudeFromHash
 	PointFromHash
 )
+
+// Constants for Enum Type - ProcParameterMode
+const (
+	DefaultParameterMode ProcParameterMode = iota
+	InParameterMode
+	OutParameterMode
+	InOutParameterMode
+)
+
+// Constants for Enum Type - RoutineCharacteristicType
+const (
+	CommentCharacteristic RoutineCharacteristicType = iota
+	LanguageSQLCharacteristic
+	DeterministicCharacteristic
+	NotDeterministicCharacteristic
+	ContainsSQLCharacteristic
+	NoSQLCharacteristic
+	ReadsSQLDataCharacteristic
+	ModifiesSQLDataCharacteristic
+	SQLSecurityDefinerCharacteristic
+	SQLSecurityInvokerCharacteristic
+)
+
+// Constants for Enum Type - TriggerTiming
+const (
+	BeforeTrigger TriggerTiming = iota
+	AfterTrigger
+)
+
+// Constants for Enum Type - TriggerEvent
+const (
+	InsertTrigger TriggerEvent = iota
+	UpdateTrigger
+	DeleteTrigger
+)
+
+// Constants for Enum Type - EventOnCompletion
+const (
+	NoOnCompletion EventOnCompletion = iota
+	OnCompletionPreserve
+	OnCompletionNotPreserve
+)
+
+// Constants for Enum Type - EventStatus
+const (
+	NoEventStatus EventStatus = iota
+	EnableEventStatus
+	DisableEventStatus
+	DisableOnReplicaEventStatus
+)
+
+// Constants for Enum Type - HandlerAction
+const (
+	ContinueHandler HandlerAction = iota
+	ExitHandler
+	UndoHandler
+)
+
+// Constants for Enum Type - ConditionValueType
+const (
+	ErrorCodeCondition ConditionValueType = iota
+	SQLStateCondition
+	NamedCondition
+	SQLWarningCondition
+	NotFoundCondition
+	SQLExceptionCondition
+)
+
+// Constants for Enum Type - LoadPriority
+const (
+	NoLoadPriority LoadPriority = iota
+	LowPriorityLoad
+	ConcurrentLoad
+)
+
+// Constants for Enum Type - PrivilegeObjectType
+const (
+	NoObjectType PrivilegeObjectType = iota
+	TableObjectType
+	FunctionObjectType
+	ProcedureObjectType
+)
+
+// Constants for Enum Type - AccountLock
+const (
+	NoAccountLock AccountLock = iota
+	AccountLocked
+	AccountUnlocked
+)
+
+// Constants for Enum Type - PasswordExpireType
+const (
+	PasswordExpireNow PasswordExpireType = iota
+	PasswordExpireDefault
+	PasswordExpireNever
+	PasswordExpireInterval
+)
+
+// Constants for Enum Type - SetRoleType
+const (
+	ListRoles SetRoleType = iota
+	DefaultRole
+	NoRoles
+	AllRoles
+)
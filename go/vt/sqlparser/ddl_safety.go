@@ -0,0 +1,802 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DDLAlgorithm is the algorithm MySQL executes a DDL operation with.
+type DDLAlgorithm int8
+
+// Constants for Enum Type - DDLAlgorithm
+const (
+	AlgorithmInstant DDLAlgorithm = iota
+	AlgorithmInplace
+	AlgorithmCopy
+)
+
+// ToString returns the algorithm as a string, like "INSTANT".
+func (algorithm DDLAlgorithm) ToString() string {
+	switch algorithm {
+	case AlgorithmInstant:
+		return "INSTANT"
+	case AlgorithmInplace:
+		return "INPLACE"
+	default:
+		return "COPY"
+	}
+}
+
+// DDLLock is the lock a DDL operation holds on its table while it runs.
+type DDLLock int8
+
+// Constants for Enum Type - DDLLock
+const (
+	// LockNone permits concurrent reads and writes.
+	LockNone DDLLock = iota
+	// LockShared permits concurrent reads, but blocks writes.
+	LockShared
+	// LockExclusive blocks reads and writes.
+	LockExclusive
+)
+
+// ToString returns the lock as a string, like "NONE".
+func (lock DDLLock) ToString() string {
+	switch lock {
+	case LockNone:
+		return "NONE"
+	case LockShared:
+		return "SHARED"
+	default:
+		return "EXCLUSIVE"
+	}
+}
+
+// DDLRisk is how risky a DDL operation is for a production database.
+type DDLRisk int8
+
+// Constants for Enum Type - DDLRisk
+const (
+	RiskLow DDLRisk = iota
+	RiskMedium
+	RiskHigh
+)
+
+// ToString returns the risk as a string, like "low".
+func (risk DDLRisk) ToString() string {
+	switch risk {
+	case RiskLow:
+		return "low"
+	case RiskMedium:
+		return "medium"
+	default:
+		return "high"
+	}
+}
+
+// DDLOperation is the analysis of an operation of a DDL statement.
+type DDLOperation struct {
+	// Node is the AlterOption, the *PartitionSpec or the *PartitionOption of
+	// an ALTER TABLE, or the statement for the other DDL statements.
+	Node      SQLNode
+	Algorithm DDLAlgorithm
+	Lock      DDLLock
+	// Rebuild is set when the operation rebuilds the table.
+	Rebuild bool
+	Risk    DDLRisk
+	// Reasons explain the algorithm, the lock and the risk.
+	Reasons []string
+}
+
+// DDLSafety is the analysis of a DDL statement. Its algorithm, lock,
+// rebuild and risk are the strongest ones of its operations, or the ones
+// requested by the ALGORITHM and LOCK options of an ALTER TABLE when they
+// are stronger.
+type DDLSafety struct {
+	Statement  DDLStatement
+	Algorithm  DDLAlgorithm
+	Lock       DDLLock
+	Rebuild    bool
+	Risk       DDLRisk
+	Reasons    []string
+	Operations []*DDLOperation
+}
+
+// AnalyzeDDLSafety classifies the operations of a DDL statement by the
+// algorithm and the lock MySQL 8.0.29 and later executes them with, and
+// reports their risks: the operations that rebuild or copy the table,
+// block writes, lose data, or fail on the existing rows.
+//
+// The schema holds the current definition of the tables, and may be nil.
+// Some operations, like changing a column, are only known to be done in
+// place or instantly when the current definition of the table is known:
+// otherwise the analysis assumes they copy the table.
+func AnalyzeDDLSafety(stmt DDLStatement, schema *Schema) *DDLSafety {
+	safety := &DDLSafety{Statement: stmt}
+	switch stmt := stmt.(type) {
+	case *AlterTable:
+		a := &ddlAnalyzer{stmt: stmt}
+		if schema != nil {
+			a.table = schema.Table(stmt.Table)
+			a.known = true
+		}
+		safety.Operations = a.alterTable()
+	default:
+		safety.Operations = []*DDLOperation{analyzeStatement(stmt)}
+	}
+	for _, op := range safety.Operations {
+		safety.Algorithm = stronger(safety.Algorithm, op.Algorithm)
+		safety.Lock = stronger(safety.Lock, op.Lock)
+		safety.Rebuild = safety.Rebuild || op.Rebuild
+		safety.Risk = stronger(safety.Risk, op.Risk)
+	}
+	if alter, ok := stmt.(*AlterTable); ok {
+		safety.applyRequested(alter)
+	}
+	return safety
+}
+
+// applyRequested applies the ALGORITHM and LOCK options of an ALTER TABLE:
+// a weaker one than required makes the statement fail, a stronger one is
+// used instead.
+func (safety *DDLSafety) applyRequested(stmt *AlterTable) {
+	for _, option := range stmt.AlterOptions {
+		switch option := option.(type) {
+		case AlgorithmValue:
+			var algorithm DDLAlgorithm
+			switch strings.ToLower(string(option)) {
+			case "instant":
+				algorithm = AlgorithmInstant
+			case "inplace":
+				algorithm = AlgorithmInplace
+			case "copy":
+				algorithm = AlgorithmCopy
+			default:
+				continue
+			}
+			switch {
+			case algorithm < safety.Algorithm:
+				safety.fail(fmt.Sprintf("ALGORITHM=%s is not supported: the statement requires %s", algorithm.ToString(), safety.Algorithm.ToString()))
+			case algorithm == AlgorithmCopy && safety.Algorithm != AlgorithmCopy:
+				safety.Algorithm, safety.Rebuild, safety.Lock = AlgorithmCopy, true, stronger(safety.Lock, LockShared)
+				safety.Risk = stronger(safety.Risk, RiskMedium)
+				safety.Reasons = append(safety.Reasons, "ALGORITHM=COPY copies the table and blocks writes")
+			default:
+				safety.Algorithm = algorithm
+			}
+		case *LockOption:
+			var lock DDLLock
+			switch option.Type {
+			case NoneType:
+				lock = LockNone
+			case SharedType:
+				lock = LockShared
+			case ExclusiveType:
+				lock = LockExclusive
+			default:
+				continue
+			}
+			if lock < safety.Lock {
+				safety.fail(fmt.Sprintf("LOCK=%s is not supported: the statement requires LOCK=%s", lock.ToString(), safety.Lock.ToString()))
+			} else {
+				safety.Lock = lock
+			}
+		}
+	}
+}
+
+// stronger returns the stronger of two algorithms, locks or risks.
+func stronger[T DDLAlgorithm | DDLLock | DDLRisk](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func (safety *DDLSafety) fail(reason string) {
+	safety.Risk = RiskHigh
+	safety.Reasons = append(safety.Reasons, "fails: "+reason)
+}
+
+// set raises the algorithm and the lock of the operation.
+func (op *DDLOperation) set(algorithm DDLAlgorithm, lock DDLLock, reason string) {
+	op.Algorithm = stronger(op.Algorithm, algorithm)
+	op.Lock = stronger(op.Lock, lock)
+	if reason != "" {
+		op.Reasons = append(op.Reasons, reason)
+	}
+}
+
+// rebuild marks the operation as rebuilding the table, in place or by
+// copying it.
+func (op *DDLOperation) rebuild(algorithm DDLAlgorithm, lock DDLLock, reason string) {
+	op.Rebuild = true
+	op.set(algorithm, lock, reason)
+}
+
+// warn raises the risk of the operation.
+func (op *DDLOperation) warn(risk DDLRisk, reason string) {
+	op.Risk = stronger(op.Risk, risk)
+	op.Reasons = append(op.Reasons, reason)
+}
+
+// analyzeStatement analyzes a DDL statement other than ALTER TABLE.
+func analyzeStatement(stmt DDLStatement) *DDLOperation {
+	op := &DDLOperation{Node: stmt}
+	switch stmt.(type) {
+	case *CreateTable:
+		op.set(AlgorithmInstant, LockNone, "creating a table only changes metadata")
+	case *DropTable:
+		op.set(AlgorithmInstant, LockExclusive, "")
+		op.warn(RiskHigh, "drops the tables and their data")
+	case *TruncateTable:
+		op.set(AlgorithmInstant, LockExclusive, "")
+		op.warn(RiskHigh, "deletes the rows of the table")
+	case *RenameTable:
+		op.set(AlgorithmInstant, LockExclusive, "renaming tables only changes metadata")
+		op.warn(RiskMedium, "queries using the old names of the tables fail")
+	case *DropView:
+		op.set(AlgorithmInstant, LockNone, "")
+		op.warn(RiskMedium, "queries using the views fail")
+	default:
+		op.set(AlgorithmInstant, LockNone, "only changes metadata")
+	}
+	return op
+}
+
+// ddlAnalyzer analyzes the options of an ALTER TABLE.
+type ddlAnalyzer struct {
+	stmt *AlterTable
+	// table is the current definition of the table, if it is known.
+	table *CreateTable
+	// known is set when the tables of the schema are known: a missing table
+	// makes the statement fail.
+	known bool
+}
+
+func (a *ddlAnalyzer) alterTable() []*DDLOperation {
+	var ops []*DDLOperation
+	if a.known && a.table == nil {
+		op := &DDLOperation{Node: a.stmt}
+		op.warn(RiskHigh, fmt.Sprintf("fails: table '%s' doesn't exist", String(a.stmt.Table)))
+		return []*DDLOperation{op}
+	}
+	for _, option := range a.stmt.AlterOptions {
+		switch option.(type) {
+		case AlgorithmValue, *LockOption, *Validation:
+			// they only request how the other operations are executed
+			continue
+		}
+		op := &DDLOperation{Node: option}
+		a.option(op, option)
+		ops = append(ops, op)
+	}
+	if a.stmt.PartitionSpec != nil {
+		op := &DDLOperation{Node: a.stmt.PartitionSpec}
+		a.partitionSpec(op, a.stmt.PartitionSpec)
+		ops = append(ops, op)
+	}
+	if a.stmt.PartitionOption != nil {
+		op := &DDLOperation{Node: a.stmt.PartitionOption}
+		op.rebuild(AlgorithmCopy, LockShared, "partitioning the table copies it")
+		ops = append(ops, op)
+	}
+	return ops
+}
+
+// column returns the current definition of a column, or nil.
+func (a *ddlAnalyzer) column(name IdentifierCI) *ColumnDefinition {
+	if a.table == nil {
+		return nil
+	}
+	for _, col := range a.table.TableSpec.Columns {
+		if col.Name.Equal(name) {
+			return col
+		}
+	}
+	return nil
+}
+
+// indexes returns the names of the current indexes using a column.
+func (a *ddlAnalyzer) indexes(name IdentifierCI) []string {
+	if a.table == nil {
+		return nil
+	}
+	var names []string
+	for _, idx := range a.table.TableSpec.Indexes {
+		for _, col := range idx.Columns {
+			if col.Expression == nil && col.Column.Equal(name) {
+				names = append(names, idx.Info.Name.String())
+				break
+			}
+		}
+	}
+	return names
+}
+
+// foreignKey returns the name of a current foreign key using a column, or
+// an empty string.
+func (a *ddlAnalyzer) foreignKey(name IdentifierCI) string {
+	if a.table == nil {
+		return ""
+	}
+	for _, constraint := range a.table.TableSpec.Constraints {
+		if fk, ok := constraint.Details.(*ForeignKeyDefinition); ok {
+			for _, col := range fk.Source {
+				if col.Equal(name) {
+					return constraint.Name.String()
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// isPrimary returns whether a column is part of the current primary key.
+func (a *ddlAnalyzer) isPrimary(name IdentifierCI) bool {
+	for _, idx := range a.indexes(name) {
+		if idx == "PRIMARY" {
+			return true
+		}
+	}
+	return false
+}
+
+// engine returns the current engine of the table, InnoDB unless its
+// definition sets another one.
+func (a *ddlAnalyzer) engine() string {
+	for _, opt := range a.table.TableSpec.Options {
+		if tableOptionName(opt.Name) == "engine" {
+			return opt.String
+		}
+	}
+	return "InnoDB"
+}
+
+// nullable returns whether a column definition allows NULL.
+func nullable(col *ColumnDefinition) bool {
+	opts := col.Type.Options
+	return opts == nil || opts.Null == nil || *opts.Null
+}
+
+// addsPrimaryKey returns whether the statement adds a primary key.
+func (a *ddlAnalyzer) addsPrimaryKey() bool {
+	for _, option := range a.stmt.AlterOptions {
+		if add, ok := option.(*AddIndexDefinition); ok && add.IndexDefinition.Info.Primary {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *ddlAnalyzer) unknownColumn(op *DDLOperation, name IdentifierCI) {
+	if a.table != nil {
+		op.warn(RiskHigh, fmt.Sprintf("fails: unknown column '%s'", name.String()))
+	}
+}
+
+func (a *ddlAnalyzer) option(op *DDLOperation, option AlterOption) {
+	switch option := option.(type) {
+	case *AddColumns:
+		for _, col := range option.Columns {
+			a.addColumn(op, col)
+		}
+	case *DropColumn:
+		a.dropColumn(op, option.Name.Name)
+	case *RenameColumn:
+		a.renameColumn(op, option.OldName.Name)
+		if a.table != nil && a.column(option.OldName.Name) == nil {
+			a.unknownColumn(op, option.OldName.Name)
+		}
+		op.warn(RiskMedium, "queries using the old name of the column fail")
+	case *AlterColumn:
+		op.set(AlgorithmInstant, LockNone, "changing the default or the visibility of a column only changes metadata")
+		if a.table != nil && a.column(option.Column.Name) == nil {
+			a.unknownColumn(op, option.Column.Name)
+		}
+	case *ChangeColumn:
+		a.modifyColumn(op, option.OldColumn.Name, option.NewColDefinition, option.First || option.After != nil)
+	case *ModifyColumn:
+		a.modifyColumn(op, option.NewColDefinition.Name, option.NewColDefinition, option.First || option.After != nil)
+	case *AddIndexDefinition:
+		a.addIndex(op, option.IndexDefinition)
+	case *AddConstraintDefinition:
+		switch details := option.ConstraintDefinition.Details.(type) {
+		case *ForeignKeyDefinition:
+			op.set(AlgorithmCopy, LockShared, "adding a foreign key copies the table, unless foreign_key_checks is disabled")
+			op.warn(RiskMedium, "fails if existing rows violate the foreign key")
+		case *CheckConstraintDefinition:
+			if details.Enforced {
+				op.set(AlgorithmCopy, LockShared, "adding an enforced check constraint copies the table to validate the existing rows")
+				op.warn(RiskMedium, "fails if existing rows violate the check constraint")
+			} else {
+				op.set(AlgorithmInstant, LockNone, "adding a check constraint that is not enforced only changes metadata")
+			}
+		}
+	case *DropKey:
+		a.dropKey(op, option)
+	case *RenameIndex:
+		op.set(AlgorithmInstant, LockNone, "renaming an index only changes metadata")
+	case *AlterIndex:
+		op.set(AlgorithmInstant, LockNone, "changing the visibility of an index only changes metadata")
+		if option.Invisible {
+			op.warn(RiskMedium, "queries using the index may become slower")
+		}
+	case *AlterCheck:
+		if option.Enforced {
+			op.set(AlgorithmCopy, LockShared, "enforcing a check constraint copies the table to validate the existing rows")
+			op.warn(RiskMedium, "fails if existing rows violate the check constraint")
+		} else {
+			op.set(AlgorithmInstant, LockNone, "no longer enforcing a check constraint only changes metadata")
+		}
+	case *AlterCharset:
+		op.rebuild(AlgorithmCopy, LockShared, "converting the character set of the columns copies the table")
+		op.warn(RiskHigh, "the data of the text columns is converted")
+	case TableOptions:
+		for _, opt := range option {
+			a.tableOption(op, opt)
+		}
+	case *Force:
+		op.rebuild(AlgorithmInplace, LockNone, "FORCE rebuilds the table")
+	case *RenameTableName:
+		op.set(AlgorithmInstant, LockNone, "renaming a table only changes metadata")
+		op.warn(RiskMedium, "queries using the old name of the table fail")
+	case *OrderByOption:
+		op.rebuild(AlgorithmCopy, LockShared, "ORDER BY copies the table")
+	case *KeyState:
+		op.set(AlgorithmInstant, LockNone, "enabling or disabling keys has no effect on InnoDB tables")
+	case *TablespaceOperation:
+		op.set(AlgorithmInplace, LockExclusive, "discarding or importing a tablespace blocks the table")
+		op.warn(RiskHigh, "the data of the table is replaced by the tablespace")
+	default:
+		op.rebuild(AlgorithmCopy, LockShared, "the operation is not known to be done in place")
+	}
+}
+
+func (a *ddlAnalyzer) addColumn(op *DDLOperation, col *ColumnDefinition) {
+	opts := col.Type.Options
+	if opts == nil {
+		opts = &ColumnTypeOptions{}
+	}
+	switch {
+	case opts.As != nil && opts.Storage == StoredStorage:
+		op.rebuild(AlgorithmCopy, LockShared, "adding a stored generated column copies the table")
+	case opts.Autoincrement:
+		op.rebuild(AlgorithmInplace, LockShared, "adding an AUTO_INCREMENT column rebuilds the table and blocks writes")
+	case opts.KeyOpt == ColKeyPrimary || opts.KeyOpt == ColKey:
+		op.rebuild(AlgorithmInplace, LockNone, "adding a primary key column rebuilds the table")
+	case a.hasFulltextIndex():
+		op.rebuild(AlgorithmInplace, LockNone, "a column cannot be added instantly to a table with a fulltext index")
+	case opts.KeyOpt != ColKeyNone:
+		op.set(AlgorithmInplace, LockNone, "the index of the column is built in place")
+	default:
+		op.set(AlgorithmInstant, LockNone, "adding a column only changes metadata")
+	}
+	if a.column(col.Name) != nil {
+		op.warn(RiskHigh, fmt.Sprintf("fails: duplicate column name '%s'", col.Name.String()))
+	}
+	if opts.Null != nil && !*opts.Null && opts.Default == nil && opts.As == nil && !opts.Autoincrement {
+		op.warn(RiskMedium, "the column is NOT NULL without a default: the existing rows get the implicit default of its type")
+	}
+}
+
+// hasFulltextIndex returns whether the table currently has a fulltext index.
+func (a *ddlAnalyzer) hasFulltextIndex() bool {
+	if a.table == nil {
+		return false
+	}
+	for _, idx := range a.table.TableSpec.Indexes {
+		if idx.Info.Fulltext {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *ddlAnalyzer) dropColumn(op *DDLOperation, name IdentifierCI) {
+	if a.table != nil && a.column(name) == nil {
+		a.unknownColumn(op, name)
+		return
+	}
+	if indexes := a.indexes(name); len(indexes) > 0 {
+		op.rebuild(AlgorithmInplace, LockNone, fmt.Sprintf("the column is part of the indexes %s, which are rebuilt or dropped", strings.Join(indexes, ", ")))
+	} else {
+		op.set(AlgorithmInstant, LockNone, "dropping a column only changes metadata")
+	}
+	if fk := a.foreignKey(name); fk != "" {
+		op.warn(RiskHigh, fmt.Sprintf("fails: the column is used by foreign key '%s'", fk))
+	}
+	op.warn(RiskHigh, "the data of the column is lost")
+}
+
+// renameColumn records the algorithm of renaming a column, which is only
+// done instantly when no foreign key uses it.
+func (a *ddlAnalyzer) renameColumn(op *DDLOperation, name IdentifierCI) {
+	if fk := a.foreignKey(name); fk != "" {
+		op.set(AlgorithmInplace, LockNone, fmt.Sprintf("renaming a column used by foreign key '%s' is done in place", fk))
+	} else {
+		op.set(AlgorithmInstant, LockNone, "renaming a column only changes metadata")
+	}
+}
+
+// modifyColumn analyzes the change of the definition of a column.
+func (a *ddlAnalyzer) modifyColumn(op *DDLOperation, name IdentifierCI, col *ColumnDefinition, moved bool) {
+	old := a.column(name)
+	if old == nil {
+		if a.table != nil {
+			a.unknownColumn(op, name)
+			return
+		}
+		op.rebuild(AlgorithmCopy, LockShared, "the current definition of the column is unknown: changing it may copy the table")
+		return
+	}
+	op.set(AlgorithmInstant, LockNone, "")
+	changed := false
+	oldType, newType := columnTypeString(old.Type), columnTypeString(col.Type)
+	if oldType != newType || columnCollation(old) != columnCollation(col) {
+		changed = true
+		switch {
+		case extendsVarchar(old.Type, col.Type, columnCollation(old) == columnCollation(col)):
+			op.set(AlgorithmInplace, LockNone, "extending a VARCHAR column without changing its length bytes is done in place")
+		case appendsValues(old.Type, col.Type):
+			op.set(AlgorithmInstant, LockNone, "adding values at the end of an ENUM or SET column only changes metadata")
+		default:
+			op.rebuild(AlgorithmCopy, LockShared, fmt.Sprintf("changing the type of the column from %s to %s copies the table", oldType, newType))
+			op.warn(RiskHigh, "the data of the column is converted, and writes are blocked while the table is copied")
+		}
+	}
+	oldNull, newNull := nullable(old) && !a.isPrimary(name), nullable(col) && !a.isPrimary(name)
+	switch {
+	case oldNull && !newNull:
+		changed = true
+		op.rebuild(AlgorithmInplace, LockNone, "making the column NOT NULL rebuilds the table")
+		op.warn(RiskMedium, "fails if existing rows hold NULL in the column")
+	case !oldNull && newNull:
+		changed = true
+		op.rebuild(AlgorithmInplace, LockNone, "making the column nullable rebuilds the table")
+	}
+	if moved {
+		changed = true
+		op.rebuild(AlgorithmInplace, LockNone, "reordering the columns rebuilds the table")
+	}
+	if !old.Name.Equal(col.Name) {
+		if fk := a.foreignKey(name); fk != "" || !changed {
+			a.renameColumn(op, name)
+		}
+		op.warn(RiskMedium, "queries using the old name of the column fail")
+	} else if !changed {
+		op.Reasons = append(op.Reasons, "changing the default, the comment or the visibility of a column only changes metadata")
+	}
+}
+
+// columnTypeString returns the type of a column without its options, with
+// the synonyms of types and the display width of integer types replaced.
+func columnTypeString(typ *ColumnType) string {
+	typ = CloneRefOfColumnType(typ)
+	typ.Options = nil
+	typ.Type = strings.ToLower(typ.Type)
+	if canonical, ok := canonicalTypes[typ.Type]; ok {
+		typ.Type = canonical
+	}
+	if integerTypes[typ.Type] && !typ.Zerofill {
+		typ.Length = nil
+	}
+	typ.Charset.Name = canonicalCharset(typ.Charset.Name)
+	return String(typ)
+}
+
+func columnCollation(col *ColumnDefinition) string {
+	if col.Type.Options == nil {
+		return ""
+	}
+	return strings.ToLower(col.Type.Options.Collate)
+}
+
+// maxCharBytes returns the maximum number of bytes of a character in a
+// character set, 4 when it is not known.
+func maxCharBytes(charset string) int {
+	switch canonicalCharset(charset) {
+	case "latin1", "ascii", "binary":
+		return 1
+	case "ucs2":
+		return 2
+	case "utf8mb3":
+		return 3
+	default:
+		return 4
+	}
+}
+
+// extendsVarchar returns whether a VARCHAR column is made longer, with its
+// length still held by the same number of bytes.
+func extendsVarchar(old, typ *ColumnType, sameCollation bool) bool {
+	if !sameCollation || !strings.EqualFold(old.Type, "varchar") || !strings.EqualFold(typ.Type, "varchar") {
+		return false
+	}
+	if canonicalCharset(old.Charset.Name) != canonicalCharset(typ.Charset.Name) || old.Length == nil || typ.Length == nil {
+		return false
+	}
+	var oldLength, newLength int
+	if _, err := fmt.Sscan(old.Length.Val, &oldLength); err != nil {
+		return false
+	}
+	if _, err := fmt.Sscan(typ.Length.Val, &newLength); err != nil {
+		return false
+	}
+	bytes := maxCharBytes(typ.Charset.Name)
+	lengthBytes := func(length int) int {
+		if length*bytes < 256 {
+			return 1
+		}
+		return 2
+	}
+	return newLength >= oldLength && lengthBytes(oldLength) == lengthBytes(newLength)
+}
+
+// appendsValues returns whether values are added at the end of the values
+// of an ENUM or SET column, without changing its storage size.
+func appendsValues(old, typ *ColumnType) bool {
+	kind := strings.ToLower(old.Type)
+	if (kind != "enum" && kind != "set") || !strings.EqualFold(typ.Type, kind) || len(typ.EnumValues) < len(old.EnumValues) {
+		return false
+	}
+	for i, value := range old.EnumValues {
+		if typ.EnumValues[i] != value {
+			return false
+		}
+	}
+	size := func(values int) int {
+		if kind == "enum" {
+			if values < 256 {
+				return 1
+			}
+			return 2
+		}
+		return (values + 7) / 8
+	}
+	return size(len(old.EnumValues)) == size(len(typ.EnumValues))
+}
+
+func (a *ddlAnalyzer) addIndex(op *DDLOperation, idx *IndexDefinition) {
+	info := idx.Info
+	switch {
+	case info.Primary:
+		copied := false
+		for _, col := range idx.Columns {
+			if old := a.column(col.Column); old != nil && nullable(old) {
+				copied = true
+				op.rebuild(AlgorithmCopy, LockShared, fmt.Sprintf("the column '%s' of the primary key is made NOT NULL, which copies the table", col.Column.String()))
+			}
+		}
+		if !copied {
+			op.rebuild(AlgorithmInplace, LockNone, "adding a primary key rebuilds the table")
+			if a.table == nil {
+				op.Reasons = append(op.Reasons, "the columns of the primary key must be NOT NULL to rebuild the table in place")
+			}
+		}
+	case info.Fulltext:
+		op.set(AlgorithmInplace, LockShared, "adding a fulltext index blocks writes")
+	case info.Spatial:
+		op.set(AlgorithmInplace, LockShared, "adding a spatial index blocks writes")
+	default:
+		op.set(AlgorithmInplace, LockNone, "adding a secondary index is done in place")
+	}
+	if a.table != nil {
+		for _, col := range idx.Columns {
+			if col.Expression == nil && a.column(col.Column) == nil && !a.addsColumn(col.Column) {
+				op.warn(RiskHigh, fmt.Sprintf("fails: key column '%s' doesn't exist in table", col.Column.String()))
+			}
+		}
+	}
+	if info.Unique {
+		op.warn(RiskMedium, "fails if existing rows hold duplicate keys")
+	}
+}
+
+// addsColumn returns whether the statement adds a column.
+func (a *ddlAnalyzer) addsColumn(name IdentifierCI) bool {
+	for _, option := range a.stmt.AlterOptions {
+		var columns []*ColumnDefinition
+		switch option := option.(type) {
+		case *AddColumns:
+			columns = option.Columns
+		case *ChangeColumn:
+			columns = []*ColumnDefinition{option.NewColDefinition}
+		}
+		for _, col := range columns {
+			if col.Name.Equal(name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (a *ddlAnalyzer) dropKey(op *DDLOperation, key *DropKey) {
+	switch key.Type {
+	case PrimaryKeyType:
+		if a.addsPrimaryKey() {
+			op.rebuild(AlgorithmInplace, LockNone, "replacing the primary key rebuilds the table")
+		} else {
+			op.rebuild(AlgorithmCopy, LockShared, "dropping the primary key without adding one copies the table")
+			op.warn(RiskMedium, "the table gets a hidden primary key, which replication and online schema change tools may not support")
+		}
+	case NormalKeyType:
+		op.set(AlgorithmInplace, LockNone, "dropping an index only changes metadata")
+		op.warn(RiskMedium, "queries using the index may become slower")
+	case ForeignKeyType:
+		op.set(AlgorithmInplace, LockNone, "dropping a foreign key only changes metadata")
+	case CheckKeyType:
+		op.set(AlgorithmInstant, LockNone, "dropping a check constraint only changes metadata")
+	}
+}
+
+func (a *ddlAnalyzer) tableOption(op *DDLOperation, opt *TableOption) {
+	switch tableOptionName(opt.Name) {
+	case "engine":
+		if a.table != nil && strings.EqualFold(opt.String, a.engine()) {
+			op.rebuild(AlgorithmInplace, LockNone, "setting the current engine rebuilds the table in place")
+		} else {
+			op.rebuild(AlgorithmCopy, LockShared, "changing the engine copies the table")
+		}
+	case "charset", "collate":
+		op.rebuild(AlgorithmInplace, LockNone, "changing the default character set or collation rebuilds the table")
+	case "auto_increment", "stats_persistent", "stats_auto_recalc", "stats_sample_pages", "comment":
+		op.set(AlgorithmInplace, LockNone, fmt.Sprintf("changing %s is done in place", strings.ToUpper(opt.Name)))
+	case "encryption":
+		op.rebuild(AlgorithmCopy, LockShared, "changing the encryption copies the table")
+	default:
+		op.rebuild(AlgorithmInplace, LockNone, fmt.Sprintf("changing %s rebuilds the table", strings.ToUpper(opt.Name)))
+	}
+}
+
+func (a *ddlAnalyzer) partitionSpec(op *DDLOperation, spec *PartitionSpec) {
+	switch spec.Action {
+	case AddAction:
+		partitions := (*PartitionOption)(nil)
+		if a.table != nil {
+			partitions = a.table.TableSpec.PartitionOption
+		}
+		if partitions != nil && (partitions.Type == RangeType || partitions.Type == ListType) {
+			op.set(AlgorithmInplace, LockNone, "adding a RANGE or LIST partition is done in place")
+		} else {
+			op.set(AlgorithmInplace, LockShared, "adding a HASH or KEY partition copies the rows of the table and blocks writes")
+		}
+	case DropAction:
+		op.set(AlgorithmInplace, LockNone, "dropping a partition is done in place")
+		op.warn(RiskHigh, "the data of the partitions is lost")
+	case TruncateAction:
+		op.set(AlgorithmInplace, LockExclusive, "")
+		op.warn(RiskHigh, "deletes the rows of the partitions")
+	case DiscardAction, ImportAction:
+		op.set(AlgorithmInplace, LockExclusive, "discarding or importing a tablespace blocks the table")
+		op.warn(RiskHigh, "the data of the partitions is replaced by the tablespace")
+	case CoalesceAction, ReorganizeAction:
+		op.set(AlgorithmInplace, LockShared, "the rows of the partitions are copied, which blocks writes")
+	case ExchangeAction:
+		op.set(AlgorithmInplace, LockExclusive, "exchanging a partition blocks both tables")
+		op.warn(RiskMedium, "the data of the partition and of the table are swapped")
+	case OptimizeAction, RebuildAction:
+		op.rebuild(AlgorithmInplace, LockShared, "the partitions are rebuilt, which blocks writes")
+	case RemoveAction:
+		op.rebuild(AlgorithmCopy, LockShared, "removing the partitioning copies the table")
+	default:
+		op.set(AlgorithmInplace, LockNone, "only reads the partitions")
+	}
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeDDLSafety(t *testing.T) {
+	const setup = "create table t (" +
+		"id int primary key, a int, b varchar(50) charset utf8mb4, c enum('x', 'y'), d int not null, " +
+		"pid int, key k (a), foreign key (pid) references t (id)" +
+		") partition by range (id) (partition p0 values less than (10))"
+	testcases := []struct {
+		sql string
+		// operations are the algorithm, the lock, the rebuild and the risk of
+		// each operation
+		operations []string
+		safety     string
+		reasons    []string
+	}{{
+		sql:        "alter table t add column x int, add column y int not null",
+		operations: []string{"INSTANT NONE low", "INSTANT NONE medium"},
+		safety:     "INSTANT NONE medium",
+	}, {
+		sql:        "alter table t add column x int auto_increment unique",
+		operations: []string{"INPLACE SHARED rebuild low"},
+		safety:     "INPLACE SHARED rebuild low",
+	}, {
+		sql:        "alter table t add column x int as (id + 1) stored",
+		operations: []string{"COPY SHARED rebuild low"},
+		safety:     "COPY SHARED rebuild low",
+	}, {
+		sql:        "alter table t drop column d, drop column a, drop column pid",
+		operations: []string{"INSTANT NONE high", "INPLACE NONE rebuild high", "INPLACE NONE rebuild high"},
+		safety:     "INPLACE NONE rebuild high",
+	}, {
+		sql:        "alter table t drop column a",
+		operations: []string{"INPLACE NONE rebuild high"},
+		safety:     "INPLACE NONE rebuild high",
+		reasons:    []string{"the column is part of the indexes k, which are rebuilt or dropped", "the data of the column is lost"},
+	}, {
+		sql:        "alter table t rename column pid to x",
+		operations: []string{"INPLACE NONE medium"},
+		safety:     "INPLACE NONE medium",
+		reasons:    []string{"renaming a column used by foreign key 't_ibfk_1' is done in place", "queries using the old name of the column fail"},
+	}, {
+		sql:        "alter table t rename column a to x, alter column d set default 1",
+		operations: []string{"INSTANT NONE medium", "INSTANT NONE low"},
+		safety:     "INSTANT NONE medium",
+	}, {
+		sql:        "alter table t modify column d int not null default 2 comment 'd', change column a x int",
+		operations: []string{"INSTANT NONE low", "INSTANT NONE medium"},
+		safety:     "INSTANT NONE medium",
+	}, {
+		sql:        "alter table t modify column b varchar(60) charset utf8mb4, modify column c enum('x', 'y', 'z')",
+		operations: []string{"INPLACE NONE low", "INSTANT NONE low"},
+		safety:     "INPLACE NONE low",
+	}, {
+		sql:        "alter table t modify column b varchar(100) charset utf8mb4",
+		operations: []string{"COPY SHARED rebuild high"},
+		safety:     "COPY SHARED rebuild high",
+		reasons: []string{
+			"changing the type of the column from varchar(50) character set utf8mb4 to varchar(100) character set utf8mb4 copies the table",
+			"the data of the column is converted, and writes are blocked while the table is copied",
+		},
+	}, {
+		sql:        "alter table t modify column a int not null, modify column d int after id",
+		operations: []string{"INPLACE NONE rebuild medium", "INPLACE NONE rebuild low"},
+		safety:     "INPLACE NONE rebuild medium",
+	}, {
+		sql:        "alter table t add index k2 (a, d), add unique key u (d), add fulltext key f (b)",
+		operations: []string{"INPLACE NONE low", "INPLACE NONE medium", "INPLACE SHARED low"},
+		safety:     "INPLACE SHARED medium",
+	}, {
+		sql:        "alter table t drop primary key, add primary key (id, a)",
+		operations: []string{"INPLACE NONE rebuild low", "COPY SHARED rebuild medium"},
+		safety:     "COPY SHARED rebuild medium",
+	}, {
+		sql:        "alter table t add primary key (id, a)",
+		operations: []string{"COPY SHARED rebuild medium"},
+		safety:     "COPY SHARED rebuild medium",
+		reasons:    []string{"the column 'a' of the primary key is made NOT NULL, which copies the table", "fails if existing rows hold duplicate keys"},
+	}, {
+		sql:        "alter table t drop index k, drop foreign key t_ibfk_1, add constraint c check (a > 0)",
+		operations: []string{"INPLACE NONE medium", "INPLACE NONE low", "COPY SHARED medium"},
+		safety:     "COPY SHARED medium",
+	}, {
+		sql:        "alter table t engine = InnoDB, comment 'x', convert to character set utf8mb4",
+		operations: []string{"INPLACE NONE rebuild low", "INPLACE NONE low", "COPY SHARED rebuild high"},
+		safety:     "COPY SHARED rebuild high",
+	}, {
+		sql:        "alter table t engine = innodb",
+		operations: []string{"INPLACE NONE rebuild low"},
+		safety:     "INPLACE NONE rebuild low",
+		reasons:    []string{"setting the current engine rebuilds the table in place"},
+	}, {
+		sql:        "alter table t engine = MyISAM",
+		operations: []string{"COPY SHARED rebuild low"},
+		safety:     "COPY SHARED rebuild low",
+		reasons:    []string{"changing the engine copies the table"},
+	}, {
+		sql:        "alter table t add partition (partition p1 values less than (20))",
+		operations: []string{"INPLACE NONE low"},
+		safety:     "INPLACE NONE low",
+	}, {
+		sql:        "alter table t drop partition p0",
+		operations: []string{"INPLACE NONE high"},
+		safety:     "INPLACE NONE high",
+	}, {
+		sql:        "alter table t modify column b text, algorithm = inplace, lock = none",
+		operations: []string{"COPY SHARED rebuild high"},
+		safety:     "COPY SHARED rebuild high",
+		reasons: []string{
+			"fails: ALGORITHM=INPLACE is not supported: the statement requires COPY",
+			"fails: LOCK=NONE is not supported: the statement requires LOCK=SHARED",
+		},
+	}, {
+		sql:        "alter table t add column x int, algorithm = copy",
+		operations: []string{"INSTANT NONE low"},
+		safety:     "COPY SHARED rebuild medium",
+		reasons:    []string{"ALGORITHM=COPY copies the table and blocks writes"},
+	}, {
+		sql:        "alter table u add column x int",
+		operations: []string{"INSTANT NONE high"},
+		safety:     "INSTANT NONE high",
+	}, {
+		sql:        "drop table t",
+		operations: []string{"INSTANT EXCLUSIVE high"},
+		safety:     "INSTANT EXCLUSIVE high",
+	}, {
+		sql:        "rename table t to u",
+		operations: []string{"INSTANT EXCLUSIVE medium"},
+		safety:     "INSTANT EXCLUSIVE medium",
+	}, {
+		sql:        "create table u (id int)",
+		operations: []string{"INSTANT NONE low"},
+		safety:     "INSTANT NONE low",
+	}}
+	s := NewSchema()
+	require.NoError(t, s.ApplySQL(setup))
+	for _, tcase := range testcases {
+		t.Run(tcase.sql, func(t *testing.T) {
+			stmt, err := Parse(tcase.sql)
+			require.NoError(t, err)
+			safety := AnalyzeDDLSafety(stmt.(DDLStatement), s)
+			var operations []string
+			for _, op := range safety.Operations {
+				operations = append(operations, ddlSafetyString(op.Algorithm, op.Lock, op.Rebuild, op.Risk))
+			}
+			assert.Equal(t, tcase.operations, operations)
+			assert.Equal(t, tcase.safety, ddlSafetyString(safety.Algorithm, safety.Lock, safety.Rebuild, safety.Risk))
+			if tcase.reasons != nil {
+				reasons := safety.Reasons
+				if len(reasons) == 0 && len(safety.Operations) == 1 {
+					reasons = safety.Operations[0].Reasons
+				}
+				assert.Equal(t, tcase.reasons, reasons)
+			}
+		})
+	}
+}
+
+func TestAnalyzeDDLSafetyWithoutSchema(t *testing.T) {
+	stmt, err := Parse("alter table t modify column a bigint, add column b int")
+	require.NoError(t, err)
+	safety := AnalyzeDDLSafety(stmt.(DDLStatement), nil)
+	require.Len(t, safety.Operations, 2)
+	assert.Equal(t, []string{"the current definition of the column is unknown: changing it may copy the table"}, safety.Operations[0].Reasons)
+	assert.Equal(t, "COPY SHARED rebuild low", ddlSafetyString(safety.Algorithm, safety.Lock, safety.Rebuild, safety.Risk))
+}
+
+func ddlSafetyString(algorithm DDLAlgorithm, lock DDLLock, rebuild bool, risk DDLRisk) string {
+	if rebuild {
+		return fmt.Sprintf("%s %s rebuild %s", algorithm.ToString(), lock.ToString(), risk.ToString())
+	}
+	return fmt.Sprintf("%s %s %s", algorithm.ToString(), lock.ToString(), risk.ToString())
+}
@@ -0,0 +1,1286 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"encoding/hex"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"vitess.io/vitess/go/mysql/decimal"
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// evalType is the type of an evaluated value.
+type evalType int8
+
+// Constants for Enum Type - evalType
+const (
+	evalNull evalType = iota
+	evalInt64
+	evalUint64
+	evalDecimal
+	evalFloat64
+	evalString
+	evalDate
+	evalDatetime
+	evalTime
+)
+
+// evalValue is the value of a constant expression.
+type evalValue struct {
+	typ evalType
+	i   int64
+	u   uint64
+	f   float64
+	dec decimal.Decimal
+	str string
+	// binary is set on the strings of the binary character set.
+	binary bool
+	// hexNum is set on the hexadecimal and bit literals, which are numbers
+	// in a numeric context.
+	hexNum bool
+	// caseSensitive is set on the strings with a binary or case-sensitive
+	// collation.
+	caseSensitive bool
+	// boolean is set on the results of the boolean operators.
+	boolean bool
+	// t is the value of a date or a datetime, and dur the value of a time.
+	t   time.Time
+	dur time.Duration
+	// fsp is the fractional seconds precision of a datetime or a time.
+	fsp int
+}
+
+var evalNULL = evalValue{typ: evalNull}
+
+func newEvalInt64(i int64) evalValue {
+	return evalValue{typ: evalInt64, i: i}
+}
+
+func newEvalUint64(u uint64) evalValue {
+	return evalValue{typ: evalUint64, u: u}
+}
+
+func newEvalDecimal(dec decimal.Decimal) evalValue {
+	return evalValue{typ: evalDecimal, dec: dec}
+}
+
+// newEvalFloat64 returns a DOUBLE value, or NULL for the results that
+// MySQL cannot represent.
+func newEvalFloat64(f float64) evalValue {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return evalNULL
+	}
+	return evalValue{typ: evalFloat64, f: f}
+}
+
+func newEvalString(s string, binary bool) evalValue {
+	return evalValue{typ: evalString, str: s, binary: binary}
+}
+
+func newEvalBool(b bool) evalValue {
+	v := newEvalInt64(0)
+	if b {
+		v.i = 1
+	}
+	v.boolean = true
+	return v
+}
+
+func (v evalValue) isNull() bool {
+	return v.typ == evalNull
+}
+
+func (t evalType) isNumeric() bool {
+	return t >= evalInt64 && t <= evalFloat64
+}
+
+func (v evalValue) isNumeric() bool {
+	return v.typ.isNumeric()
+}
+
+func (v evalValue) isTemporal() bool {
+	return v.typ >= evalDate
+}
+
+// Evaluate evaluates a constant expression with the semantics of MySQL:
+// NULL propagates through the operators and the functions, strings are
+// compared case-insensitively unless they are binary or have a binary or
+// case-sensitive collation, and the operands of the arithmetic operators
+// and of the comparisons are coerced to numbers, dates or strings the way
+// MySQL coerces them. Booleans evaluate to the integers 0 and 1.
+//
+// It returns an error if the expression is not constant, for example
+// because it references a column or a bind variable, or calls a
+// non-deterministic or unsupported function.
+func Evaluate(expr Expr) (sqltypes.Value, error) {
+	v, err := evaluate(expr)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return v.value(), nil
+}
+
+// FoldConstants replaces the constant expressions of the node by their
+// value, so that for example `a = 1 + 1` becomes `a = 2` and
+// `NOT (1 = 1)` becomes `false`. It returns the rewritten node, which is
+// the node itself unless the node is a constant expression.
+//
+// The expressions that MySQL interprets by position, like the literals of
+// ORDER BY and GROUP BY, are left alone, and the select expressions keep
+// their column name by being aliased to their original text.
+func FoldConstants(node SQLNode) SQLNode {
+	names := map[*AliasedExpr]string{}
+	return Rewrite(node, func(cursor *Cursor) bool {
+		if sel, ok := cursor.Node().(*Select); ok {
+			for _, expr := range sel.SelectExprs {
+				if aliased, ok := expr.(*AliasedExpr); ok && aliased.As.IsEmpty() {
+					names[aliased] = String(aliased.Expr)
+				}
+			}
+		}
+		return true
+	}, func(cursor *Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *AliasedExpr:
+			if name, ok := names[node]; ok && String(node.Expr) != name {
+				node.As = NewIdentifierCI(name)
+			}
+		case Expr:
+			switch cursor.Parent().(type) {
+			case *Order, GroupBy:
+				return true
+			}
+			if folded, ok := foldConstant(node); ok {
+				cursor.Replace(folded)
+			}
+		}
+		return true
+	})
+}
+
+// foldConstant returns the literal with the value of a constant expression,
+// or false if the expression is not constant, is already a literal, is NULL
+// without a NULL operand or its value cannot be written as a literal of the
+// same type.
+func foldConstant(expr Expr) (Expr, bool) {
+	switch expr := expr.(type) {
+	case *Literal, *NullVal, BoolVal, *IntroducerExpr:
+		return nil, false
+	case *UnaryExpr:
+		if expr.Operator == NStringOp {
+			return nil, false
+		}
+	}
+	v, err := evaluate(expr)
+	if err != nil {
+		return nil, false
+	}
+	// a NULL from non-NULL operands, like 1 / 0, is a warning or, in strict
+	// mode, an error of MySQL that folding would hide
+	if v.isNull() && !containsNull(expr) {
+		return nil, false
+	}
+	literal, ok := v.literal()
+	if !ok || String(literal) == String(expr) {
+		return nil, false
+	}
+	return literal, true
+}
+
+// containsNull reports whether the expression has a NULL operand.
+func containsNull(expr Expr) bool {
+	found := false
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if _, ok := node.(*NullVal); ok {
+			found = true
+		}
+		return !found, nil
+	}, expr)
+	return found
+}
+
+// literal returns the literal with the value, or false if the value
+// cannot be written as a literal that parses back to the same type.
+func (v evalValue) literal() (Expr, bool) {
+	switch v.typ {
+	case evalNull:
+		return &NullVal{}, true
+	case evalInt64:
+		if v.boolean {
+			return BoolVal(v.i != 0), true
+		}
+		return NewIntLiteral(strconv.FormatInt(v.i, 10)), true
+	case evalUint64:
+		// an unsigned integer that fits in a BIGINT would parse back as a
+		// signed integer
+		if v.u <= math.MaxInt64 {
+			return nil, false
+		}
+		return NewIntLiteral(strconv.FormatUint(v.u, 10)), true
+	case evalDecimal:
+		s := v.dec.StringMySQL()
+		if !strings.Contains(s, ".") {
+			return nil, false
+		}
+		return NewDecimalLiteral(s), true
+	case evalFloat64:
+		// the exponent keeps a DOUBLE from parsing back as a DECIMAL
+		s := formatFloat(v.f)
+		if !strings.Contains(s, "e") {
+			s += "e0"
+		}
+		return NewFloatLiteral(s), true
+	case evalString:
+		if v.hexNum {
+			return NewHexLiteral(strings.ToUpper(hex.EncodeToString([]byte(v.str)))), true
+		}
+		if v.binary || v.caseSensitive {
+			return nil, false
+		}
+		return NewStrLiteral(v.str), true
+	case evalDate:
+		return NewDateLiteral(v.toString()), true
+	case evalDatetime:
+		return NewTimestampLiteral(v.toString()), true
+	case evalTime:
+		return NewTimeLiteral(v.toString()), true
+	}
+	return nil, false
+}
+
+// value returns the value as a sqltypes.Value.
+func (v evalValue) value() sqltypes.Value {
+	switch v.typ {
+	case evalInt64:
+		return sqltypes.NewInt64(v.i)
+	case evalUint64:
+		return sqltypes.NewUint64(v.u)
+	case evalDecimal:
+		return sqltypes.NewDecimal(v.dec.StringMySQL())
+	case evalFloat64:
+		return sqltypes.NewFloat64(v.f)
+	case evalString:
+		if v.binary {
+			return sqltypes.NewVarBinary(v.str)
+		}
+		return sqltypes.NewVarChar(v.str)
+	case evalDate:
+		return sqltypes.NewDate(v.toString())
+	case evalDatetime:
+		return sqltypes.NewDatetime(v.toString())
+	case evalTime:
+		return sqltypes.NewTime(v.toString())
+	}
+	return sqltypes.NULL
+}
+
+func errNotConstant(expr Expr) error {
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "expression is not constant: %s", String(expr))
+}
+
+func errUnsupportedEval(expr Expr) error {
+	return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported expression: %s", String(expr))
+}
+
+func errOutOfRange(expr Expr) error {
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "BIGINT value is out of range in '%s'", String(expr))
+}
+
+func errDoubleOutOfRange(expr Expr) error {
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "DOUBLE value is out of range in '%s'", String(expr))
+}
+
+// evalDouble returns the DOUBLE result of an expression: NULL for the
+// results that MySQL cannot represent, like the square root of a negative
+// number, and an error for the results that overflow a DOUBLE.
+func evalDouble(expr Expr, f float64) (evalValue, error) {
+	if math.IsInf(f, 0) {
+		return evalNULL, errDoubleOutOfRange(expr)
+	}
+	return newEvalFloat64(f), nil
+}
+
+func evaluate(expr Expr) (evalValue, error) {
+	switch node := expr.(type) {
+	case *Literal:
+		return evalLiteral(node)
+	case *NullVal:
+		return evalNULL, nil
+	case BoolVal:
+		return newEvalBool(bool(node)), nil
+	case *IntroducerExpr:
+		v, err := evaluate(node.Expr)
+		if err != nil || v.isNull() {
+			return v, err
+		}
+		return newEvalString(v.toString(), strings.EqualFold(node.CharacterSet, "_binary")), nil
+	case *CollateExpr:
+		v, err := evaluate(node.Expr)
+		if err != nil || v.typ != evalString {
+			return v, err
+		}
+		collation := strings.ToLower(node.Collation)
+		v.caseSensitive = collation == "binary" || strings.HasSuffix(collation, "_bin") || strings.HasSuffix(collation, "_cs")
+		return v, nil
+	case *UnaryExpr:
+		return evalUnary(node)
+	case *BinaryExpr:
+		return evalBinary(node)
+	case *ComparisonExpr:
+		return evalComparison(node)
+	case *AndExpr:
+		return evalLogical(node.Left, node.Right, false)
+	case *OrExpr:
+		return evalLogical(node.Left, node.Right, true)
+	case *XorExpr:
+		l, r, err := evaluate2(node.Left, node.Right)
+		if err != nil {
+			return evalNULL, err
+		}
+		lt, lnull := l.truth()
+		rt, rnull := r.truth()
+		if lnull || rnull {
+			return evalNULL, nil
+		}
+		return newEvalBool(lt != rt), nil
+	case *NotExpr:
+		v, err := evaluate(node.Expr)
+		if err != nil {
+			return evalNULL, err
+		}
+		return evalNot(v), nil
+	case *IsExpr:
+		v, err := evaluate(node.Left)
+		if err != nil {
+			return evalNULL, err
+		}
+		truth, null := v.truth()
+		switch node.Right {
+		case IsNullOp:
+			return newEvalBool(null), nil
+		case IsNotNullOp:
+			return newEvalBool(!null), nil
+		case IsTrueOp:
+			return newEvalBool(!null && truth), nil
+		case IsNotTrueOp:
+			return newEvalBool(null || !truth), nil
+		case IsFalseOp:
+			return newEvalBool(!null && !truth), nil
+		case IsNotFalseOp:
+			return newEvalBool(null || truth), nil
+		}
+	case *BetweenExpr:
+		return evalBetween(node)
+	case *CaseExpr:
+		return evalCase(node)
+	case *CastExpr:
+		if node.Array {
+			return evalNULL, errUnsupportedEval(node)
+		}
+		v, err := evaluate(node.Expr)
+		if err != nil {
+			return evalNULL, err
+		}
+		return evalConvert(node, v, node.Type)
+	case *ConvertExpr:
+		v, err := evaluate(node.Expr)
+		if err != nil {
+			return evalNULL, err
+		}
+		return evalConvert(node, v, node.Type)
+	case *ConvertUsingExpr:
+		v, err := evaluate(node.Expr)
+		if err != nil || v.isNull() {
+			return v, err
+		}
+		return newEvalString(v.toString(), strings.EqualFold(node.Type, "binary")), nil
+	case *FuncExpr:
+		return evalFuncExpr(node)
+	case *SubstrExpr, *LocateExpr, *TrimFuncExpr, *InsertExpr, *CharExpr:
+		return evalStringExpr(node)
+	case *DateAddExpr:
+		return evalDateArithmetic(node.Date, node.Expr, node.Unit, false)
+	case *DateSubExpr:
+		return evalDateArithmetic(node.Date, node.Expr, node.Unit, true)
+	case *ExtractFuncExpr:
+		return evalExtract(node)
+	}
+	return evalNULL, errNotConstant(expr)
+}
+
+// evaluate2 evaluates two expressions.
+func evaluate2(left, right Expr) (evalValue, evalValue, error) {
+	l, err := evaluate(left)
+	if err != nil {
+		return evalNULL, evalNULL, err
+	}
+	r, err := evaluate(right)
+	if err != nil {
+		return evalNULL, evalNULL, err
+	}
+	return l, r, nil
+}
+
+// evaluateAll evaluates a list of expressions.
+func evaluateAll(exprs ...Expr) ([]evalValue, error) {
+	values := make([]evalValue, 0, len(exprs))
+	for _, expr := range exprs {
+		v, err := evaluate(expr)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+func evalLiteral(lit *Literal) (evalValue, error) {
+	switch lit.Type {
+	case StrVal:
+		return newEvalString(lit.Val, false), nil
+	case IntVal:
+		if i, err := strconv.ParseInt(lit.Val, 10, 64); err == nil {
+			return newEvalInt64(i), nil
+		}
+		if u, err := strconv.ParseUint(lit.Val, 10, 64); err == nil {
+			return newEvalUint64(u), nil
+		}
+		// larger integers are DECIMAL values
+		fallthrough
+	case DecimalVal:
+		dec, err := decimal.NewFromString(lit.Val)
+		if err != nil {
+			return evalNULL, errUnsupportedEval(lit)
+		}
+		return newEvalDecimal(dec), nil
+	case FloatVal:
+		f, err := strconv.ParseFloat(lit.Val, 64)
+		if err != nil {
+			return evalNULL, errUnsupportedEval(lit)
+		}
+		return newEvalFloat64(f), nil
+	case HexNum, HexVal:
+		digits := strings.TrimPrefix(strings.TrimPrefix(lit.Val, "0x"), "0X")
+		if len(digits)%2 == 1 {
+			digits = "0" + digits
+		}
+		bytes, err := hex.DecodeString(digits)
+		if err != nil {
+			return evalNULL, errUnsupportedEval(lit)
+		}
+		v := newEvalString(string(bytes), true)
+		v.hexNum = true
+		return v, nil
+	case BitVal:
+		var bits big.Int
+		if _, ok := bits.SetString(lit.Val, 2); !ok {
+			return evalNULL, errUnsupportedEval(lit)
+		}
+		v := newEvalString(string(bits.Bytes()), true)
+		v.hexNum = true
+		return v, nil
+	case DateVal, TimeVal, TimestampVal:
+		var v evalValue
+		if lit.Type == TimeVal {
+			v = parseTime(lit.Val)
+		} else {
+			v = parseDatetime(lit.Val)
+		}
+		if v.isNull() {
+			return evalNULL, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "incorrect temporal value: %s", String(lit))
+		}
+		switch lit.Type {
+		case DateVal:
+			v = v.toDate()
+		case TimestampVal:
+			v.typ = evalDatetime
+		}
+		return v, nil
+	}
+	return evalNULL, errUnsupportedEval(lit)
+}
+
+// truth returns the truth value of the value, and whether it is NULL.
+func (v evalValue) truth() (truth bool, null bool) {
+	switch v.typ {
+	case evalNull:
+		return false, true
+	case evalInt64:
+		return v.i != 0, false
+	case evalUint64:
+		return v.u != 0, false
+	case evalDecimal:
+		return !v.dec.IsZero(), false
+	case evalFloat64:
+		return v.f != 0, false
+	case evalString:
+		return v.numeric().truth()
+	case evalTime:
+		return v.dur != 0, false
+	}
+	return true, false
+}
+
+func evalNot(v evalValue) evalValue {
+	truth, null := v.truth()
+	if null {
+		return evalNULL
+	}
+	return newEvalBool(!truth)
+}
+
+// evalLogical evaluates AND or OR. One side decides the result on its own
+// if it is false for AND or true for OR, even if the other side is not
+// constant.
+func evalLogical(left, right Expr, or bool) (evalValue, error) {
+	l, lerr := evaluate(left)
+	r, rerr := evaluate(right)
+	lt, lnull := l.truth()
+	rt, rnull := r.truth()
+	if (lerr == nil && !lnull && lt == or) || (rerr == nil && !rnull && rt == or) {
+		return newEvalBool(or), nil
+	}
+	if lerr != nil {
+		return evalNULL, lerr
+	}
+	if rerr != nil {
+		return evalNULL, rerr
+	}
+	if lnull || rnull {
+		return evalNULL, nil
+	}
+	return newEvalBool(!or), nil
+}
+
+func evalUnary(node *UnaryExpr) (evalValue, error) {
+	v, err := evaluate(node.Expr)
+	if err != nil || v.isNull() {
+		return v, err
+	}
+	switch node.Operator {
+	case UPlusOp:
+		return v, nil
+	case UMinusOp:
+		v = v.numeric()
+		switch v.typ {
+		case evalInt64:
+			if v.i == math.MinInt64 {
+				return newEvalDecimal(decimal.NewFromInt(v.i).Neg()), nil
+			}
+			return newEvalInt64(-v.i), nil
+		case evalUint64:
+			if v.u <= 1<<63 {
+				return newEvalInt64(int64(-v.u)), nil
+			}
+			return newEvalDecimal(decimal.NewFromUint(v.u).Neg()), nil
+		case evalDecimal:
+			return newEvalDecimal(v.dec.Neg()), nil
+		default:
+			return newEvalFloat64(-v.f), nil
+		}
+	case TildaOp:
+		return newEvalUint64(^v.bits()), nil
+	case BangOp:
+		return evalNot(v), nil
+	case NStringOp:
+		return newEvalString(v.toString(), false), nil
+	}
+	return evalNULL, errUnsupportedEval(node)
+}
+
+func evalBinary(node *BinaryExpr) (evalValue, error) {
+	l, r, err := evaluate2(node.Left, node.Right)
+	if err != nil {
+		return evalNULL, err
+	}
+	if l.isNull() || r.isNull() {
+		return evalNULL, nil
+	}
+	switch node.Operator {
+	case PlusOp, MinusOp, MultOp, DivOp, IntDivOp, ModOp:
+		return evalArithmetic(node, node.Operator, l, r)
+	case BitAndOp:
+		return newEvalUint64(l.bits() & r.bits()), nil
+	case BitOrOp:
+		return newEvalUint64(l.bits() | r.bits()), nil
+	case BitXorOp:
+		return newEvalUint64(l.bits() ^ r.bits()), nil
+	case ShiftLeftOp, ShiftRightOp:
+		shift := r.bits()
+		if shift >= 64 {
+			return newEvalUint64(0), nil
+		}
+		if node.Operator == ShiftLeftOp {
+			return newEvalUint64(l.bits() << shift), nil
+		}
+		return newEvalUint64(l.bits() >> shift), nil
+	case ConcatOp:
+		return newEvalString(l.toString()+r.toString(), l.binary || r.binary), nil
+	}
+	return evalNULL, errUnsupportedEval(node)
+}
+
+// evalArithmetic evaluates an arithmetic operator on two non-NULL values.
+// Integers overflowing a BIGINT and numbers overflowing a DOUBLE are an
+// error, and divisions by zero return NULL.
+func evalArithmetic(node Expr, op BinaryExprOperator, l, r evalValue) (evalValue, error) {
+	l, r = l.numeric(), r.numeric()
+	switch {
+	case l.typ == evalFloat64 || r.typ == evalFloat64:
+		lf, rf := l.float(), r.float()
+		switch op {
+		case PlusOp:
+			return evalDouble(node, lf+rf)
+		case MinusOp:
+			return evalDouble(node, lf-rf)
+		case MultOp:
+			return evalDouble(node, lf*rf)
+		}
+		if rf == 0 {
+			return evalNULL, nil
+		}
+		switch op {
+		case DivOp:
+			return evalDouble(node, lf/rf)
+		case ModOp:
+			return newEvalFloat64(math.Mod(lf, rf)), nil
+		}
+		q := math.Trunc(lf / rf)
+		if q < math.MinInt64 || q >= math.MaxInt64 {
+			return evalNULL, errOutOfRange(node)
+		}
+		return newEvalInt64(int64(q)), nil
+	case l.typ == evalDecimal || r.typ == evalDecimal || op == DivOp:
+		ld, rd := l.decimal(), r.decimal()
+		switch op {
+		case PlusOp:
+			return newEvalDecimal(ld.Add(rd)), nil
+		case MinusOp:
+			return newEvalDecimal(ld.Sub(rd)), nil
+		case MultOp:
+			return newEvalDecimal(ld.Mul(rd)), nil
+		}
+		if rd.IsZero() {
+			return evalNULL, nil
+		}
+		switch op {
+		case DivOp:
+			// the scale of the quotient is the scale of the dividend
+			// plus div_precision_increment
+			scale := -ld.Exponent()
+			if scale < 0 {
+				scale = 0
+			}
+			return newEvalDecimal(ld.Div(rd, 4).Round(scale + 4)), nil
+		case ModOp:
+			_, rem := ld.QuoRem(rd, 0)
+			return newEvalDecimal(rem), nil
+		}
+		q, _ := ld.QuoRem(rd, 0)
+		i, ok := q.Int64()
+		if !ok {
+			return evalNULL, errOutOfRange(node)
+		}
+		return newEvalInt64(i), nil
+	}
+
+	// integers are computed exactly and must fit in a BIGINT, which is
+	// unsigned if one of the operands is unsigned
+	lb, rb := l.bigInt(), r.bigInt()
+	var result big.Int
+	switch op {
+	case PlusOp:
+		result.Add(lb, rb)
+	case MinusOp:
+		result.Sub(lb, rb)
+	case MultOp:
+		result.Mul(lb, rb)
+	default:
+		if rb.Sign() == 0 {
+			return evalNULL, nil
+		}
+		if op == ModOp {
+			result.Rem(lb, rb)
+		} else {
+			result.Quo(lb, rb)
+		}
+	}
+	if l.typ == evalUint64 || r.typ == evalUint64 {
+		if result.Sign() < 0 || !result.IsUint64() {
+			return evalNULL, errOutOfRange(node)
+		}
+		return newEvalUint64(result.Uint64()), nil
+	}
+	if !result.IsInt64() {
+		return evalNULL, errOutOfRange(node)
+	}
+	return newEvalInt64(result.Int64()), nil
+}
+
+func evalComparison(node *ComparisonExpr) (evalValue, error) {
+	l, err := evaluate(node.Left)
+	if err != nil {
+		return evalNULL, err
+	}
+	switch node.Operator {
+	case InOp, NotInOp:
+		tuple, ok := node.Right.(ValTuple)
+		if !ok {
+			return evalNULL, errNotConstant(node.Right)
+		}
+		values, err := evaluateAll(tuple...)
+		if err != nil {
+			return evalNULL, err
+		}
+		result := newEvalBool(false)
+		for _, v := range values {
+			eq, err := compareEqual(l, v)
+			if err != nil {
+				return evalNULL, err
+			}
+			if eq.isNull() {
+				result = evalNULL
+			} else if eq.i == 1 {
+				result = eq
+				break
+			}
+		}
+		if node.Operator == NotInOp {
+			return evalNot(result), nil
+		}
+		return result, nil
+	}
+
+	r, err := evaluate(node.Right)
+	if err != nil {
+		return evalNULL, err
+	}
+	if node.Operator == NullSafeEqualOp {
+		if l.isNull() || r.isNull() {
+			return newEvalBool(l.isNull() && r.isNull()), nil
+		}
+		return compareEqual(l, r)
+	}
+	if l.isNull() || r.isNull() {
+		return evalNULL, nil
+	}
+	switch node.Operator {
+	case LikeOp, NotLikeOp, ILikeOp, NotILikeOp:
+		escape := '\\'
+		if node.Escape != nil {
+			e, err := evaluate(node.Escape)
+			if err != nil {
+				return evalNULL, err
+			}
+			if runes := []rune(e.toString()); len(runes) > 0 {
+				escape = runes[0]
+			}
+		}
+		fold := !l.binary && !r.binary && !l.caseSensitive && !r.caseSensitive
+		matched := likeMatch(l.toString(), r.toString(), escape, fold || node.Operator == ILikeOp || node.Operator == NotILikeOp)
+		return newEvalBool(matched == (node.Operator == LikeOp || node.Operator == ILikeOp)), nil
+	case RegexpOp, NotRegexpOp:
+		pattern := r.toString()
+		if !l.binary && !r.binary && !l.caseSensitive && !r.caseSensitive {
+			pattern = "(?i)" + pattern
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return evalNULL, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid regular expression: %s", String(node.Right))
+		}
+		return newEvalBool(re.MatchString(l.toString()) == (node.Operator == RegexpOp)), nil
+	}
+
+	cmp, err := compare(l, r)
+	if err != nil {
+		return evalNULL, err
+	}
+	switch node.Operator {
+	case EqualOp:
+		return newEvalBool(cmp == 0), nil
+	case LessThanOp:
+		return newEvalBool(cmp < 0), nil
+	case GreaterThanOp:
+		return newEvalBool(cmp > 0), nil
+	case LessEqualOp:
+		return newEvalBool(cmp <= 0), nil
+	case GreaterEqualOp:
+		return newEvalBool(cmp >= 0), nil
+	case NotEqualOp:
+		return newEvalBool(cmp != 0), nil
+	}
+	return evalNULL, errUnsupportedEval(node)
+}
+
+// compareEqual returns whether two values are equal, or NULL if one of
+// them is NULL.
+func compareEqual(l, r evalValue) (evalValue, error) {
+	if l.isNull() || r.isNull() {
+		return evalNULL, nil
+	}
+	cmp, err := compare(l, r)
+	if err != nil {
+		return evalNULL, err
+	}
+	return newEvalBool(cmp == 0), nil
+}
+
+// compare compares two non-NULL values. Two strings are compared as
+// strings, a temporal value and a string or another temporal value as
+// temporal values, and the other values as numbers: as integers or
+// decimals if both are, and as DOUBLE otherwise.
+func compare(l, r evalValue) (int, error) {
+	switch {
+	case l.typ == evalString && r.typ == evalString:
+		if l.binary || r.binary || l.caseSensitive || r.caseSensitive {
+			return strings.Compare(l.str, r.str), nil
+		}
+		return strings.Compare(foldCase(l.str), foldCase(r.str)), nil
+	case (l.isTemporal() || r.isTemporal()) && !l.isNumeric() && !r.isNumeric():
+		if l.typ == evalTime && !r.isTemporal() || r.typ == evalTime && !l.isTemporal() || l.typ == evalTime && r.typ == evalTime {
+			lt, rt := l.toTime(), r.toTime()
+			if lt.isNull() || rt.isNull() {
+				return compareNumbers(l.numeric(), r.numeric()), nil
+			}
+			return compareInt64(int64(lt.dur), int64(rt.dur)), nil
+		}
+		lt, err := l.toDatetime()
+		if err != nil {
+			return 0, err
+		}
+		rt, err := r.toDatetime()
+		if err != nil {
+			return 0, err
+		}
+		if lt.isNull() || rt.isNull() {
+			return compareNumbers(l.numeric(), r.numeric()), nil
+		}
+		return lt.t.Compare(rt.t), nil
+	}
+	return compareNumbers(l.numeric(), r.numeric()), nil
+}
+
+func compareNumbers(l, r evalValue) int {
+	switch {
+	case l.typ == evalFloat64 || r.typ == evalFloat64:
+		lf, rf := l.float(), r.float()
+		switch {
+		case lf < rf:
+			return -1
+		case lf > rf:
+			return 1
+		}
+		return 0
+	case l.typ == evalDecimal || r.typ == evalDecimal:
+		return l.decimal().Cmp(r.decimal())
+	}
+	return l.bigInt().Cmp(r.bigInt())
+}
+
+func compareInt64(l, r int64) int {
+	switch {
+	case l < r:
+		return -1
+	case l > r:
+		return 1
+	}
+	return 0
+}
+
+// foldCase returns the string in the form compared by a case-insensitive
+// collation.
+func foldCase(s string) string {
+	return strings.Map(unicode.ToLower, s)
+}
+
+// likeMatch returns whether the string matches the pattern of a LIKE
+// expression.
+func likeMatch(s, pattern string, escape rune, fold bool) bool {
+	if fold {
+		s, pattern = foldCase(s), foldCase(pattern)
+	}
+	const (
+		likeChar = iota
+		likeOne
+		likeAny
+	)
+	type token struct {
+		kind int
+		r    rune
+	}
+	var tokens []token
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		switch {
+		case runes[i] == escape && i+1 < len(runes):
+			i++
+			tokens = append(tokens, token{kind: likeChar, r: runes[i]})
+		case runes[i] == '%':
+			tokens = append(tokens, token{kind: likeAny})
+		case runes[i] == '_':
+			tokens = append(tokens, token{kind: likeOne})
+		default:
+			tokens = append(tokens, token{kind: likeChar, r: runes[i]})
+		}
+	}
+
+	// match greedily, backtracking to the last % on a mismatch
+	str := []rune(s)
+	si, ti := 0, 0
+	star, mark := -1, 0
+	for si < len(str) {
+		switch {
+		case ti < len(tokens) && (tokens[ti].kind == likeOne || tokens[ti].kind == likeChar && tokens[ti].r == str[si]):
+			si++
+			ti++
+		case ti < len(tokens) && tokens[ti].kind == likeAny:
+			star, mark = ti, si
+			ti++
+		case star >= 0:
+			mark++
+			si, ti = mark, star+1
+		default:
+			return false
+		}
+	}
+	for ti < len(tokens) && tokens[ti].kind == likeAny {
+		ti++
+	}
+	return ti == len(tokens)
+}
+
+func evalBetween(node *BetweenExpr) (evalValue, error) {
+	values, err := evaluateAll(node.Left, node.From, node.To)
+	if err != nil {
+		return evalNULL, err
+	}
+	bound := func(v evalValue, lower bool) (evalValue, error) {
+		if values[0].isNull() || v.isNull() {
+			return evalNULL, nil
+		}
+		cmp, err := compare(values[0], v)
+		if err != nil {
+			return evalNULL, err
+		}
+		return newEvalBool(lower && cmp >= 0 || !lower && cmp <= 0), nil
+	}
+	from, err := bound(values[1], true)
+	if err != nil {
+		return evalNULL, err
+	}
+	to, err := bound(values[2], false)
+	if err != nil {
+		return evalNULL, err
+	}
+	result := newEvalBool(true)
+	for _, v := range []evalValue{from, to} {
+		if v.isNull() {
+			result = evalNULL
+		} else if v.i == 0 {
+			result = v
+			break
+		}
+	}
+	if !node.IsBetween {
+		return evalNot(result), nil
+	}
+	return result, nil
+}
+
+func evalCase(node *CaseExpr) (evalValue, error) {
+	var base evalValue
+	if node.Expr != nil {
+		var err error
+		if base, err = evaluate(node.Expr); err != nil {
+			return evalNULL, err
+		}
+	}
+	branches := make([]Expr, 0, len(node.Whens)+1)
+	chosen := -1
+	for i, when := range node.Whens {
+		cond, err := evaluate(when.Cond)
+		if err != nil {
+			return evalNULL, err
+		}
+		if node.Expr != nil {
+			if cond, err = compareEqual(base, cond); err != nil {
+				return evalNULL, err
+			}
+		}
+		if truth, _ := cond.truth(); truth && chosen < 0 {
+			chosen = i
+		}
+		branches = append(branches, when.Val)
+	}
+	if node.Else != nil {
+		branches = append(branches, node.Else)
+	}
+	if chosen < 0 {
+		chosen = len(node.Whens)
+	}
+	return evalBranches(branches, chosen)
+}
+
+// evalBranches returns the value of the chosen branch of a control flow
+// expression, converted to the type MySQL aggregates from all the
+// branches. The chosen branch may be past the end of the branches for a
+// NULL result.
+func evalBranches(branches []Expr, chosen int) (evalValue, error) {
+	values, err := evaluateAll(branches...)
+	if err != nil {
+		return evalNULL, err
+	}
+	if chosen >= len(values) {
+		return evalNULL, nil
+	}
+	return aggregate(values[chosen], values), nil
+}
+
+// aggregate converts the value to the type aggregated from the values: a
+// string if the values mix strings, numbers or temporal types, the widest
+// numeric type if they are all numbers, or a datetime if they mix dates
+// and datetimes. NULL values do not take part in the aggregation.
+func aggregate(v evalValue, values []evalValue) evalValue {
+	if v.isNull() {
+		return v
+	}
+	typ := v.typ
+	for _, other := range values {
+		if !other.isNull() && other.typ != typ {
+			typ = aggregateType(typ, other.typ)
+		}
+	}
+	switch {
+	case typ == v.typ:
+		return v
+	case typ == evalString:
+		return newEvalString(v.toString(), v.binary)
+	case typ == evalDecimal:
+		return newEvalDecimal(v.decimal())
+	case typ == evalFloat64:
+		return newEvalFloat64(v.float())
+	}
+	v.typ = typ
+	return v
+}
+
+func aggregateType(a, b evalType) evalType {
+	switch {
+	case a.isNumeric() && b.isNumeric():
+		if a == evalInt64 && b == evalUint64 || a == evalUint64 && b == evalInt64 {
+			return evalDecimal
+		}
+		if a > b {
+			return a
+		}
+		return b
+	case a == evalDate && b == evalDatetime, a == evalDatetime && b == evalDate:
+		return evalDatetime
+	}
+	return evalString
+}
+
+// numeric converts a string or a temporal value to a number: hexadecimal
+// literals are unsigned integers, the other strings are DOUBLE values of
+// their numeric prefix, and temporal values are integers like 20200102 or
+// decimals if they have fractional seconds.
+func (v evalValue) numeric() evalValue {
+	switch v.typ {
+	case evalNull:
+		return newEvalInt64(0)
+	case evalString:
+		if v.hexNum {
+			var u uint64
+			for _, b := range []byte(v.str) {
+				u = u<<8 | uint64(b)
+			}
+			return newEvalUint64(u)
+		}
+		return newEvalFloat64(parseFloatPrefix(v.str))
+	case evalDate, evalDatetime, evalTime:
+		s := strings.NewReplacer("-", "", ":", "", " ", "").Replace(v.toString())
+		if v.fsp > 0 {
+			return newEvalDecimal(decimal.RequireFromString(s))
+		}
+		i, _ := strconv.ParseInt(s, 10, 64)
+		return newEvalInt64(i)
+	}
+	return v
+}
+
+// float returns a number as a float64.
+func (v evalValue) float() float64 {
+	switch v.typ {
+	case evalInt64:
+		return float64(v.i)
+	case evalUint64:
+		return float64(v.u)
+	case evalDecimal:
+		f, _ := v.dec.Float64()
+		return f
+	case evalFloat64:
+		return v.f
+	}
+	return v.numeric().float()
+}
+
+// decimal returns a number as a decimal.
+func (v evalValue) decimal() decimal.Decimal {
+	switch v.typ {
+	case evalInt64:
+		return decimal.NewFromInt(v.i)
+	case evalUint64:
+		return decimal.NewFromUint(v.u)
+	case evalDecimal:
+		return v.dec
+	case evalFloat64:
+		return decimal.NewFromFloatMySQL(v.f)
+	}
+	return v.numeric().decimal()
+}
+
+// bigInt returns an integer as a big.Int.
+func (v evalValue) bigInt() *big.Int {
+	if v.typ == evalUint64 {
+		return new(big.Int).SetUint64(v.u)
+	}
+	return big.NewInt(v.i)
+}
+
+// integer returns the value rounded to an integer, clamped to the range
+// of a BIGINT.
+func (v evalValue) integer() int64 {
+	v = v.numeric()
+	switch v.typ {
+	case evalInt64:
+		return v.i
+	case evalUint64:
+		if v.u > math.MaxInt64 {
+			return math.MaxInt64
+		}
+		return int64(v.u)
+	}
+	f := math.Round(v.float())
+	switch {
+	case f >= math.MaxInt64:
+		return math.MaxInt64
+	case f <= math.MinInt64:
+		return math.MinInt64
+	}
+	return int64(f)
+}
+
+// bits returns the value as the unsigned integer of the bit operators.
+func (v evalValue) bits() uint64 {
+	v = v.numeric()
+	switch v.typ {
+	case evalUint64:
+		return v.u
+	case evalInt64:
+		return uint64(v.i)
+	}
+	f := math.Round(v.float())
+	switch {
+	case f >= math.MaxUint64:
+		return math.MaxUint64
+	case f < 0:
+		return uint64(v.integer())
+	}
+	return uint64(f)
+}
+
+// toString returns the value as a string.
+func (v evalValue) toString() string {
+	switch v.typ {
+	case evalInt64:
+		return strconv.FormatInt(v.i, 10)
+	case evalUint64:
+		return strconv.FormatUint(v.u, 10)
+	case evalDecimal:
+		return v.dec.StringMySQL()
+	case evalFloat64:
+		return formatFloat(v.f)
+	case evalString:
+		return v.str
+	case evalDate:
+		return v.t.Format("2006-01-02")
+	case evalDatetime:
+		return v.t.Format("2006-01-02 15:04:05") + formatFraction(v.t.Nanosecond()/1000, v.fsp)
+	case evalTime:
+		return formatTime(v.dur, v.fsp)
+	}
+	return ""
+}
+
+// formatFloat formats a DOUBLE value like MySQL does.
+func formatFloat(f float64) string {
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if i := strings.IndexByte(s, 'e'); i >= 0 {
+		exp := strings.TrimLeft(strings.TrimPrefix(s[i+1:], "+"), "0")
+		if strings.HasPrefix(exp, "-") {
+			exp = "-" + strings.TrimLeft(exp[1:], "0")
+		}
+		s = s[:i+1] + exp
+	}
+	return s
+}
+
+// parseFloatPrefix returns the value of the longest prefix of the string
+// that is a number, after leading spaces, or 0.
+func parseFloatPrefix(s string) float64 {
+	s = strings.TrimLeft(s, " \t\n\r")
+	end := 0
+	digits := func() bool {
+		start := end
+		for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+			end++
+		}
+		return end > start
+	}
+	if end < len(s) && (s[end] == '+' || s[end] == '-') {
+		end++
+	}
+	mantissa := digits()
+	if end < len(s) && s[end] == '.' {
+		end++
+		mantissa = digits() || mantissa
+	}
+	if !mantissa {
+		return 0
+	}
+	if end < len(s) && (s[end] == 'e' || s[end] == 'E') {
+		exp := end
+		end++
+		if end < len(s) && (s[end] == '+' || s[end] == '-') {
+			end++
+		}
+		if !digits() {
+			end = exp
+		}
+	}
+	f, _ := strconv.ParseFloat(s[:end], 64)
+	return f
+}
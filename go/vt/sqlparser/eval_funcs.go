@@ -0,0 +1,1463 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/mysql/decimal"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// evalFunc is a deterministic function that can be evaluated.
+type evalFunc struct {
+	minArgs, maxArgs int
+	// handlesNull is set on the functions that do not simply return NULL
+	// when one of their arguments is NULL.
+	handlesNull bool
+	eval        func(node *FuncExpr, args []evalValue) (evalValue, error)
+}
+
+// variadic is the maxArgs of the functions without a maximum number of
+// arguments.
+const variadic = -1
+
+// maxEvalStringLength is the length of the longest string a function
+// returns, like max_allowed_packet, beyond which it returns NULL.
+const maxEvalStringLength = 64 << 20
+
+var evalFuncs = map[string]evalFunc{
+	// string functions
+	"concat":           {minArgs: 1, maxArgs: variadic, eval: evalConcat},
+	"concat_ws":        {minArgs: 2, maxArgs: variadic, handlesNull: true, eval: evalConcatWs},
+	"lower":            {minArgs: 1, maxArgs: 1, eval: evalLower},
+	"lcase":            {minArgs: 1, maxArgs: 1, eval: evalLower},
+	"upper":            {minArgs: 1, maxArgs: 1, eval: evalUpper},
+	"ucase":            {minArgs: 1, maxArgs: 1, eval: evalUpper},
+	"length":           {minArgs: 1, maxArgs: 1, eval: evalLength},
+	"octet_length":     {minArgs: 1, maxArgs: 1, eval: evalLength},
+	"char_length":      {minArgs: 1, maxArgs: 1, eval: evalCharLength},
+	"character_length": {minArgs: 1, maxArgs: 1, eval: evalCharLength},
+	"reverse":          {minArgs: 1, maxArgs: 1, eval: evalReverse},
+	"repeat":           {minArgs: 2, maxArgs: 2, eval: evalRepeat},
+	"space":            {minArgs: 1, maxArgs: 1, eval: evalSpace},
+	"replace":          {minArgs: 3, maxArgs: 3, eval: evalReplace},
+	"left":             {minArgs: 2, maxArgs: 2, eval: evalLeft},
+	"right":            {minArgs: 2, maxArgs: 2, eval: evalRight},
+	"lpad":             {minArgs: 3, maxArgs: 3, eval: evalLpad},
+	"rpad":             {minArgs: 3, maxArgs: 3, eval: evalRpad},
+	"ascii":            {minArgs: 1, maxArgs: 1, eval: evalASCII},
+	"strcmp":           {minArgs: 2, maxArgs: 2, eval: evalStrcmp},
+	"instr":            {minArgs: 2, maxArgs: 2, eval: evalInstr},
+	"substring_index":  {minArgs: 3, maxArgs: 3, eval: evalSubstringIndex},
+	"hex":              {minArgs: 1, maxArgs: 1, eval: evalHex},
+
+	// numeric functions
+	"abs":      {minArgs: 1, maxArgs: 1, eval: evalAbs},
+	"ceil":     {minArgs: 1, maxArgs: 1, eval: evalCeil},
+	"ceiling":  {minArgs: 1, maxArgs: 1, eval: evalCeil},
+	"floor":    {minArgs: 1, maxArgs: 1, eval: evalFloor},
+	"round":    {minArgs: 1, maxArgs: 2, eval: evalRound},
+	"truncate": {minArgs: 2, maxArgs: 2, eval: evalTruncate},
+	"sign":     {minArgs: 1, maxArgs: 1, eval: evalSign},
+	"mod":      {minArgs: 2, maxArgs: 2, eval: evalMod},
+	"pow":      {minArgs: 2, maxArgs: 2, eval: evalPow},
+	"power":    {minArgs: 2, maxArgs: 2, eval: evalPow},
+	"log":      {minArgs: 1, maxArgs: 2, eval: evalLog},
+	"atan":     {minArgs: 1, maxArgs: 2, eval: evalAtan},
+	"pi":       {eval: evalPi},
+	"sqrt":     {minArgs: 1, maxArgs: 1, eval: evalMath(math.Sqrt)},
+	"exp":      {minArgs: 1, maxArgs: 1, eval: evalMath(math.Exp)},
+	"ln":       {minArgs: 1, maxArgs: 1, eval: evalMath(logarithm(math.Log))},
+	"log2":     {minArgs: 1, maxArgs: 1, eval: evalMath(logarithm(math.Log2))},
+	"log10":    {minArgs: 1, maxArgs: 1, eval: evalMath(logarithm(math.Log10))},
+	"sin":      {minArgs: 1, maxArgs: 1, eval: evalMath(math.Sin)},
+	"cos":      {minArgs: 1, maxArgs: 1, eval: evalMath(math.Cos)},
+	"tan":      {minArgs: 1, maxArgs: 1, eval: evalMath(math.Tan)},
+	"cot":      {minArgs: 1, maxArgs: 1, eval: evalMath(func(f float64) float64 { return 1 / math.Tan(f) })},
+	"asin":     {minArgs: 1, maxArgs: 1, eval: evalMath(math.Asin)},
+	"acos":     {minArgs: 1, maxArgs: 1, eval: evalMath(math.Acos)},
+	"degrees":  {minArgs: 1, maxArgs: 1, eval: evalMath(func(f float64) float64 { return f * 180 / math.Pi })},
+	"radians":  {minArgs: 1, maxArgs: 1, eval: evalMath(func(f float64) float64 { return f * math.Pi / 180 })},
+	"greatest": {minArgs: 2, maxArgs: variadic, eval: evalGreatest},
+	"least":    {minArgs: 2, maxArgs: variadic, eval: evalLeast},
+
+	// control flow functions
+	"if":       {minArgs: 3, maxArgs: 3, handlesNull: true, eval: evalIf},
+	"ifnull":   {minArgs: 2, maxArgs: 2, handlesNull: true, eval: evalCoalesce},
+	"coalesce": {minArgs: 1, maxArgs: variadic, handlesNull: true, eval: evalCoalesce},
+	"nullif":   {minArgs: 2, maxArgs: 2, handlesNull: true, eval: evalNullif},
+	"isnull":   {minArgs: 1, maxArgs: 1, handlesNull: true, eval: evalIsNull},
+
+	// date and time functions
+	"date":        {minArgs: 1, maxArgs: 1, eval: evalDateFunc},
+	"time":        {minArgs: 1, maxArgs: 1, eval: evalTimeFunc},
+	"year":        {minArgs: 1, maxArgs: 1, eval: evalDatePart(func(t time.Time) int64 { return int64(t.Year()) })},
+	"quarter":     {minArgs: 1, maxArgs: 1, eval: evalDatePart(func(t time.Time) int64 { return int64(t.Month()+2) / 3 })},
+	"month":       {minArgs: 1, maxArgs: 1, eval: evalDatePart(func(t time.Time) int64 { return int64(t.Month()) })},
+	"day":         {minArgs: 1, maxArgs: 1, eval: evalDatePart(func(t time.Time) int64 { return int64(t.Day()) })},
+	"dayofmonth":  {minArgs: 1, maxArgs: 1, eval: evalDatePart(func(t time.Time) int64 { return int64(t.Day()) })},
+	"dayofweek":   {minArgs: 1, maxArgs: 1, eval: evalDatePart(func(t time.Time) int64 { return int64(t.Weekday()) + 1 })},
+	"weekday":     {minArgs: 1, maxArgs: 1, eval: evalDatePart(func(t time.Time) int64 { return int64(t.Weekday()+6) % 7 })},
+	"dayofyear":   {minArgs: 1, maxArgs: 1, eval: evalDatePart(func(t time.Time) int64 { return int64(t.YearDay()) })},
+	"monthname":   {minArgs: 1, maxArgs: 1, eval: evalDateName(func(t time.Time) string { return t.Month().String() })},
+	"dayname":     {minArgs: 1, maxArgs: 1, eval: evalDateName(func(t time.Time) string { return t.Weekday().String() })},
+	"hour":        {minArgs: 1, maxArgs: 1, eval: evalTimePart(time.Hour, 0)},
+	"minute":      {minArgs: 1, maxArgs: 1, eval: evalTimePart(time.Minute, 60)},
+	"second":      {minArgs: 1, maxArgs: 1, eval: evalTimePart(time.Second, 60)},
+	"microsecond": {minArgs: 1, maxArgs: 1, eval: evalTimePart(time.Microsecond, 1000000)},
+	"datediff":    {minArgs: 2, maxArgs: 2, eval: evalDatediff},
+	"last_day":    {minArgs: 1, maxArgs: 1, eval: evalLastDay},
+	"date_format": {minArgs: 2, maxArgs: 2, eval: evalDateFormat},
+}
+
+func evalFuncExpr(node *FuncExpr) (evalValue, error) {
+	fn, ok := evalFuncs[node.Name.Lowered()]
+	if !ok || !node.Qualifier.IsEmpty() {
+		return evalNULL, errNotConstant(node)
+	}
+	if len(node.Exprs) < fn.minArgs || fn.maxArgs != variadic && len(node.Exprs) > fn.maxArgs {
+		return evalNULL, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "incorrect parameter count in the call to native function '%s'", node.Name.String())
+	}
+	args := make([]evalValue, 0, len(node.Exprs))
+	for _, expr := range node.Exprs {
+		aliased, ok := expr.(*AliasedExpr)
+		if !ok {
+			return evalNULL, errNotConstant(node)
+		}
+		v, err := evaluate(aliased.Expr)
+		if err != nil {
+			return evalNULL, err
+		}
+		if v.isNull() && !fn.handlesNull {
+			return evalNULL, nil
+		}
+		args = append(args, v)
+	}
+	return fn.eval(node, args)
+}
+
+// chars returns the characters of a string, which are its bytes if it is
+// binary.
+func (v evalValue) chars() []rune {
+	if !v.binary {
+		return []rune(v.toString())
+	}
+	s := v.toString()
+	chars := make([]rune, len(s))
+	for i := 0; i < len(s); i++ {
+		chars[i] = rune(s[i])
+	}
+	return chars
+}
+
+// stringOf returns the string of the characters of a value returned by
+// chars.
+func (v evalValue) stringOf(chars []rune) evalValue {
+	if !v.binary {
+		return newEvalString(string(chars), false)
+	}
+	bytes := make([]byte, len(chars))
+	for i, c := range chars {
+		bytes[i] = byte(c)
+	}
+	return newEvalString(string(bytes), true)
+}
+
+// limitString returns NULL for the strings longer than
+// maxEvalStringLength.
+func limitString(v evalValue) evalValue {
+	if len(v.str) > maxEvalStringLength {
+		return evalNULL
+	}
+	return v
+}
+
+func evalConcat(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	var b strings.Builder
+	binary := false
+	for _, arg := range args {
+		b.WriteString(arg.toString())
+		binary = binary || arg.binary
+	}
+	return limitString(newEvalString(b.String(), binary)), nil
+}
+
+func evalConcatWs(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	if args[0].isNull() {
+		return evalNULL, nil
+	}
+	var parts []string
+	binary := args[0].binary
+	for _, arg := range args[1:] {
+		if !arg.isNull() {
+			parts = append(parts, arg.toString())
+			binary = binary || arg.binary
+		}
+	}
+	return limitString(newEvalString(strings.Join(parts, args[0].toString()), binary)), nil
+}
+
+func evalLower(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	if args[0].binary {
+		return args[0], nil
+	}
+	return newEvalString(strings.ToLower(args[0].toString()), false), nil
+}
+
+func evalUpper(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	if args[0].binary {
+		return args[0], nil
+	}
+	return newEvalString(strings.ToUpper(args[0].toString()), false), nil
+}
+
+func evalLength(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	return newEvalInt64(int64(len(args[0].toString()))), nil
+}
+
+func evalCharLength(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	if args[0].binary {
+		return evalLength(nil, args)
+	}
+	return newEvalInt64(int64(utf8.RuneCountInString(args[0].toString()))), nil
+}
+
+func evalReverse(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	chars := args[0].chars()
+	for i, j := 0, len(chars)-1; i < j; i, j = i+1, j-1 {
+		chars[i], chars[j] = chars[j], chars[i]
+	}
+	return args[0].stringOf(chars), nil
+}
+
+func evalRepeat(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	s, count := args[0].toString(), args[1].integer()
+	if count <= 0 || s == "" {
+		return newEvalString("", args[0].binary), nil
+	}
+	if count > int64(maxEvalStringLength/len(s)) {
+		return evalNULL, nil
+	}
+	return newEvalString(strings.Repeat(s, int(count)), args[0].binary), nil
+}
+
+func evalSpace(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	count := args[0].integer()
+	if count > maxEvalStringLength {
+		return evalNULL, nil
+	}
+	if count < 0 {
+		count = 0
+	}
+	return newEvalString(strings.Repeat(" ", int(count)), false), nil
+}
+
+func evalReplace(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	s, from, to := args[0].toString(), args[1].toString(), args[2].toString()
+	binary := args[0].binary || args[1].binary || args[2].binary
+	if from == "" {
+		return newEvalString(s, binary), nil
+	}
+	return limitString(newEvalString(strings.ReplaceAll(s, from, to), binary)), nil
+}
+
+func evalLeft(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	chars, n := args[0].chars(), args[1].integer()
+	if n < 0 {
+		n = 0
+	}
+	if n < int64(len(chars)) {
+		chars = chars[:n]
+	}
+	return args[0].stringOf(chars), nil
+}
+
+func evalRight(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	chars, n := args[0].chars(), args[1].integer()
+	if n < 0 {
+		n = 0
+	}
+	if n < int64(len(chars)) {
+		chars = chars[int64(len(chars))-n:]
+	}
+	return args[0].stringOf(chars), nil
+}
+
+func evalLpad(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	return evalPad(args, true)
+}
+
+func evalRpad(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	return evalPad(args, false)
+}
+
+// evalPad pads a string to a length, or truncates it if it is longer.
+func evalPad(args []evalValue, left bool) (evalValue, error) {
+	s, n := args[0], args[1].integer()
+	pad := args[2]
+	pad.binary = pad.binary || s.binary
+	s.binary = pad.binary
+	chars, padChars := s.chars(), pad.chars()
+	switch {
+	case n < 0 || n > maxEvalStringLength:
+		return evalNULL, nil
+	case n <= int64(len(chars)):
+		return s.stringOf(chars[:n]), nil
+	case len(padChars) == 0:
+		return evalNULL, nil
+	}
+	padding := make([]rune, 0, n-int64(len(chars)))
+	for int64(len(padding)+len(chars)) < n {
+		padding = append(padding, padChars[len(padding)%len(padChars)])
+	}
+	if left {
+		return s.stringOf(append(padding, chars...)), nil
+	}
+	return s.stringOf(append(chars, padding...)), nil
+}
+
+func evalASCII(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	s := args[0].toString()
+	if s == "" {
+		return newEvalInt64(0), nil
+	}
+	return newEvalInt64(int64(s[0])), nil
+}
+
+func evalStrcmp(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	l, r := args[0], args[1]
+	if l.typ != evalString {
+		l = newEvalString(l.toString(), false)
+	}
+	if r.typ != evalString {
+		r = newEvalString(r.toString(), false)
+	}
+	cmp, err := compare(l, r)
+	return newEvalInt64(int64(cmp)), err
+}
+
+func evalInstr(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	return newEvalInt64(locate(args[1], args[0], 1)), nil
+}
+
+// locate returns the position of the first occurrence of a substring in a
+// string at or after a position, counted in characters from 1, or 0.
+func locate(sub, s evalValue, pos int64) int64 {
+	binary := sub.binary || s.binary || sub.caseSensitive || s.caseSensitive
+	sub.binary, s.binary = sub.binary || s.binary, sub.binary || s.binary
+	subChars, chars := sub.chars(), s.chars()
+	if pos < 1 || pos > int64(len(chars))+1 {
+		return 0
+	}
+	subStr := string(subChars)
+	if !binary {
+		subStr = foldCase(subStr)
+	}
+	for i := int(pos - 1); i+len(subChars) <= len(chars); i++ {
+		candidate := string(chars[i : i+len(subChars)])
+		if !binary {
+			candidate = foldCase(candidate)
+		}
+		if candidate == subStr {
+			return int64(i + 1)
+		}
+	}
+	return 0
+}
+
+func evalSubstringIndex(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	s, delim, count := args[0].toString(), args[1].toString(), args[2].integer()
+	binary := args[0].binary || args[1].binary
+	if delim == "" || count == 0 {
+		return newEvalString("", binary), nil
+	}
+	parts := strings.Split(s, delim)
+	if count > 0 && count < int64(len(parts)) {
+		parts = parts[:count]
+	} else if count < 0 && -count < int64(len(parts)) {
+		parts = parts[int64(len(parts))+count:]
+	}
+	return newEvalString(strings.Join(parts, delim), binary), nil
+}
+
+func evalHex(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	v := args[0]
+	if v.typ == evalString && !v.hexNum {
+		return newEvalString(fmt.Sprintf("%X", v.str), false), nil
+	}
+	return newEvalString(strings.ToUpper(strconv.FormatUint(v.bits(), 16)), false), nil
+}
+
+func evalAbs(node *FuncExpr, args []evalValue) (evalValue, error) {
+	v := args[0].numeric()
+	switch v.typ {
+	case evalInt64:
+		if v.i == math.MinInt64 {
+			return evalNULL, errOutOfRange(node)
+		}
+		if v.i < 0 {
+			v.i = -v.i
+		}
+		return v, nil
+	case evalDecimal:
+		return newEvalDecimal(v.dec.Abs()), nil
+	case evalFloat64:
+		return newEvalFloat64(math.Abs(v.f)), nil
+	}
+	return v, nil
+}
+
+func evalCeil(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	v := args[0].numeric()
+	switch v.typ {
+	case evalDecimal:
+		return integralDecimal(v.dec.Ceil()), nil
+	case evalFloat64:
+		return newEvalFloat64(math.Ceil(v.f)), nil
+	}
+	return v, nil
+}
+
+func evalFloor(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	v := args[0].numeric()
+	switch v.typ {
+	case evalDecimal:
+		return integralDecimal(v.dec.Floor()), nil
+	case evalFloat64:
+		return newEvalFloat64(math.Floor(v.f)), nil
+	}
+	return v, nil
+}
+
+// integralDecimal returns an integral decimal as a BIGINT if it fits.
+func integralDecimal(dec decimal.Decimal) evalValue {
+	if i, ok := dec.Int64(); ok {
+		return newEvalInt64(i)
+	}
+	return newEvalDecimal(dec)
+}
+
+func evalRound(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	var places int64
+	if len(args) > 1 {
+		places = args[1].integer()
+	}
+	return roundNumber(args[0].numeric(), places, false), nil
+}
+
+func evalTruncate(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	return roundNumber(args[0].numeric(), args[1].integer(), true), nil
+}
+
+// roundNumber rounds or truncates a number to a number of decimal places,
+// which may be negative. Decimals and integers are rounded half away from
+// zero, and DOUBLE values half to even.
+func roundNumber(v evalValue, places int64, truncate bool) evalValue {
+	if places > 30 {
+		places = 30
+	} else if places < -30 {
+		places = -30
+	}
+	switch v.typ {
+	case evalFloat64:
+		scale := math.Pow10(int(places))
+		f := v.f * scale
+		if truncate {
+			f = math.Trunc(f)
+		} else {
+			f = math.RoundToEven(f)
+		}
+		return newEvalFloat64(f / scale)
+	case evalDecimal:
+		if truncate {
+			return newEvalDecimal(v.dec.Truncate(int32(places)))
+		}
+		return newEvalDecimal(v.dec.Round(int32(places)))
+	}
+	if places >= 0 {
+		return v
+	}
+	dec := v.decimal()
+	if truncate {
+		dec = dec.Truncate(int32(places))
+	} else {
+		dec = dec.Round(int32(places))
+	}
+	if v.typ == evalUint64 {
+		u, _ := dec.Uint64()
+		return newEvalUint64(u)
+	}
+	i, _ := dec.Int64()
+	return newEvalInt64(i)
+}
+
+func evalSign(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	v := args[0].numeric()
+	switch v.typ {
+	case evalInt64:
+		return newEvalInt64(int64(compareInt64(v.i, 0))), nil
+	case evalUint64:
+		if v.u == 0 {
+			return newEvalInt64(0), nil
+		}
+		return newEvalInt64(1), nil
+	case evalDecimal:
+		return newEvalInt64(int64(v.dec.Sign())), nil
+	}
+	return newEvalInt64(int64(compareNumbers(v, newEvalFloat64(0)))), nil
+}
+
+func evalMod(node *FuncExpr, args []evalValue) (evalValue, error) {
+	return evalArithmetic(node, ModOp, args[0], args[1])
+}
+
+func evalPow(node *FuncExpr, args []evalValue) (evalValue, error) {
+	return evalDouble(node, math.Pow(args[0].float(), args[1].float()))
+}
+
+func evalLog(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	if len(args) == 1 {
+		return newEvalFloat64(logarithm(math.Log)(args[0].float())), nil
+	}
+	base, x := args[0].float(), args[1].float()
+	if base <= 0 || base == 1 || x <= 0 {
+		return evalNULL, nil
+	}
+	return newEvalFloat64(math.Log(x) / math.Log(base)), nil
+}
+
+func evalAtan(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	if len(args) == 1 {
+		return newEvalFloat64(math.Atan(args[0].float())), nil
+	}
+	return newEvalFloat64(math.Atan2(args[0].float(), args[1].float())), nil
+}
+
+func evalPi(_ *FuncExpr, _ []evalValue) (evalValue, error) {
+	return newEvalFloat64(math.Pi), nil
+}
+
+// evalMath returns the evaluation of a function of a DOUBLE value.
+func evalMath(fn func(float64) float64) func(*FuncExpr, []evalValue) (evalValue, error) {
+	return func(node *FuncExpr, args []evalValue) (evalValue, error) {
+		return evalDouble(node, fn(args[0].float()))
+	}
+}
+
+// logarithm returns a logarithm function that is NULL for the values that
+// are not positive.
+func logarithm(fn func(float64) float64) func(float64) float64 {
+	return func(f float64) float64 {
+		if f <= 0 {
+			return math.NaN()
+		}
+		return fn(f)
+	}
+}
+
+func evalGreatest(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	return evalExtreme(args, 1)
+}
+
+func evalLeast(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	return evalExtreme(args, -1)
+}
+
+// evalExtreme returns the greatest or the least of the values.
+func evalExtreme(args []evalValue, sign int) (evalValue, error) {
+	result := args[0]
+	for _, arg := range args[1:] {
+		cmp, err := compare(arg, result)
+		if err != nil {
+			return evalNULL, err
+		}
+		if cmp*sign > 0 {
+			result = arg
+		}
+	}
+	return aggregate(result, args), nil
+}
+
+func evalIf(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	if truth, _ := args[0].truth(); truth {
+		return aggregate(args[1], args[1:]), nil
+	}
+	return aggregate(args[2], args[1:]), nil
+}
+
+func evalCoalesce(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	for _, arg := range args {
+		if !arg.isNull() {
+			return aggregate(arg, args), nil
+		}
+	}
+	return evalNULL, nil
+}
+
+func evalNullif(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	eq, err := compareEqual(args[0], args[1])
+	if err != nil || eq.i == 1 {
+		return evalNULL, err
+	}
+	return args[0], nil
+}
+
+func evalIsNull(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	return newEvalBool(args[0].isNull()), nil
+}
+
+func evalDateFunc(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	v, err := args[0].toDatetime()
+	if err != nil || v.isNull() {
+		return v, err
+	}
+	return v.toDate(), nil
+}
+
+func evalTimeFunc(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	return args[0].toTime(), nil
+}
+
+// evalDatePart returns the evaluation of a function of the date of a
+// value.
+func evalDatePart(part func(time.Time) int64) func(*FuncExpr, []evalValue) (evalValue, error) {
+	return func(_ *FuncExpr, args []evalValue) (evalValue, error) {
+		v, err := args[0].toDatetime()
+		if err != nil || v.isNull() {
+			return v, err
+		}
+		return newEvalInt64(part(v.t)), nil
+	}
+}
+
+// evalDateName returns the evaluation of a function of the date of a value
+// that returns a name.
+func evalDateName(name func(time.Time) string) func(*FuncExpr, []evalValue) (evalValue, error) {
+	return func(_ *FuncExpr, args []evalValue) (evalValue, error) {
+		v, err := args[0].toDatetime()
+		if err != nil || v.isNull() {
+			return v, err
+		}
+		return newEvalString(name(v.t), false), nil
+	}
+}
+
+// evalTimePart returns the evaluation of a function of the time of a
+// value, which returns a number of units modulo a number, or unbounded if
+// the modulo is 0.
+func evalTimePart(unit time.Duration, modulo int64) func(*FuncExpr, []evalValue) (evalValue, error) {
+	return func(_ *FuncExpr, args []evalValue) (evalValue, error) {
+		v := args[0].toTime()
+		if v.isNull() {
+			return v, nil
+		}
+		d := v.dur
+		if d < 0 {
+			d = -d
+		}
+		n := int64(d / unit)
+		if modulo > 0 {
+			n %= modulo
+		}
+		return newEvalInt64(n), nil
+	}
+}
+
+func evalDatediff(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	l, err := args[0].toDatetime()
+	if err != nil || l.isNull() {
+		return l, err
+	}
+	r, err := args[1].toDatetime()
+	if err != nil || r.isNull() {
+		return r, err
+	}
+	return newEvalInt64(int64(l.toDate().t.Sub(r.toDate().t) / (24 * time.Hour))), nil
+}
+
+func evalLastDay(_ *FuncExpr, args []evalValue) (evalValue, error) {
+	v, err := args[0].toDatetime()
+	if err != nil || v.isNull() {
+		return v, err
+	}
+	v = v.toDate()
+	v.t = v.t.AddDate(0, 1, -v.t.Day())
+	return v, nil
+}
+
+func evalDateFormat(node *FuncExpr, args []evalValue) (evalValue, error) {
+	v, err := args[0].toDatetime()
+	if err != nil || v.isNull() {
+		return v, err
+	}
+	t := v.t
+	format := args[1].toString()
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			b.WriteByte(format[i])
+			continue
+		}
+		i++
+		hour12 := (t.Hour()+11)%12 + 1
+		switch format[i] {
+		case 'a':
+			b.WriteString(t.Weekday().String()[:3])
+		case 'b':
+			b.WriteString(t.Month().String()[:3])
+		case 'c':
+			fmt.Fprintf(&b, "%d", t.Month())
+		case 'D':
+			b.WriteString(ordinal(t.Day()))
+		case 'd':
+			fmt.Fprintf(&b, "%02d", t.Day())
+		case 'e':
+			fmt.Fprintf(&b, "%d", t.Day())
+		case 'f':
+			fmt.Fprintf(&b, "%06d", t.Nanosecond()/1000)
+		case 'H':
+			fmt.Fprintf(&b, "%02d", t.Hour())
+		case 'h', 'I':
+			fmt.Fprintf(&b, "%02d", hour12)
+		case 'i':
+			fmt.Fprintf(&b, "%02d", t.Minute())
+		case 'j':
+			fmt.Fprintf(&b, "%03d", t.YearDay())
+		case 'k':
+			fmt.Fprintf(&b, "%d", t.Hour())
+		case 'l':
+			fmt.Fprintf(&b, "%d", hour12)
+		case 'M':
+			b.WriteString(t.Month().String())
+		case 'm':
+			fmt.Fprintf(&b, "%02d", t.Month())
+		case 'p':
+			b.WriteString(t.Format("PM"))
+		case 'r':
+			fmt.Fprintf(&b, "%02d:%02d:%02d %s", hour12, t.Minute(), t.Second(), t.Format("PM"))
+		case 'S', 's':
+			fmt.Fprintf(&b, "%02d", t.Second())
+		case 'T':
+			b.WriteString(t.Format("15:04:05"))
+		case 'W':
+			b.WriteString(t.Weekday().String())
+		case 'w':
+			fmt.Fprintf(&b, "%d", t.Weekday())
+		case 'Y':
+			fmt.Fprintf(&b, "%04d", t.Year())
+		case 'y':
+			fmt.Fprintf(&b, "%02d", t.Year()%100)
+		case 'U', 'u', 'V', 'v', 'X', 'x':
+			// the week numbers depend on the week modes
+			return evalNULL, errUnsupportedEval(node)
+		default:
+			b.WriteByte(format[i])
+		}
+	}
+	return newEvalString(b.String(), false), nil
+}
+
+// ordinal returns a day of the month with its English suffix.
+func ordinal(day int) string {
+	switch {
+	case day/10 == 1:
+		return strconv.Itoa(day) + "th"
+	case day%10 == 1:
+		return strconv.Itoa(day) + "st"
+	case day%10 == 2:
+		return strconv.Itoa(day) + "nd"
+	case day%10 == 3:
+		return strconv.Itoa(day) + "rd"
+	}
+	return strconv.Itoa(day) + "th"
+}
+
+// evalStringExpr evaluates the string functions with their own AST node.
+func evalStringExpr(node Expr) (evalValue, error) {
+	var exprs []Expr
+	switch node := node.(type) {
+	case *SubstrExpr:
+		exprs = []Expr{node.Name, node.From, node.To}
+	case *LocateExpr:
+		exprs = []Expr{node.SubStr, node.Str, node.Pos}
+	case *TrimFuncExpr:
+		exprs = []Expr{node.StringArg, node.TrimArg}
+	case *InsertExpr:
+		exprs = []Expr{node.Str, node.Pos, node.Len, node.NewStr}
+	case *CharExpr:
+		exprs = node.Exprs
+	}
+	var args []evalValue
+	for _, expr := range exprs {
+		if expr == nil {
+			args = append(args, evalNULL)
+			continue
+		}
+		v, err := evaluate(expr)
+		if err != nil {
+			return evalNULL, err
+		}
+		if v.isNull() {
+			if _, ok := node.(*CharExpr); !ok {
+				return evalNULL, nil
+			}
+		}
+		args = append(args, v)
+	}
+
+	switch node := node.(type) {
+	case *SubstrExpr:
+		chars, pos := args[0].chars(), args[1].integer()
+		if pos < 0 {
+			pos += int64(len(chars)) + 1
+		}
+		if pos < 1 || pos > int64(len(chars)) {
+			return args[0].stringOf(nil), nil
+		}
+		chars = chars[pos-1:]
+		if node.To != nil {
+			if n := args[2].integer(); n < int64(len(chars)) {
+				if n < 0 {
+					n = 0
+				}
+				chars = chars[:n]
+			}
+		}
+		return args[0].stringOf(chars), nil
+	case *LocateExpr:
+		pos := int64(1)
+		if node.Pos != nil {
+			pos = args[2].integer()
+		}
+		return newEvalInt64(locate(args[0], args[1], pos)), nil
+	case *TrimFuncExpr:
+		s, remove := args[0].toString(), " "
+		if node.TrimArg != nil {
+			remove = args[1].toString()
+		}
+		leading := node.TrimFuncType == LTrimType || node.TrimFuncType == NormalTrimType && node.Type != TrailingTrimType
+		trailing := node.TrimFuncType == RTrimType || node.TrimFuncType == NormalTrimType && node.Type != LeadingTrimType
+		for leading && remove != "" && strings.HasPrefix(s, remove) {
+			s = s[len(remove):]
+		}
+		for trailing && remove != "" && strings.HasSuffix(s, remove) {
+			s = s[:len(s)-len(remove)]
+		}
+		return newEvalString(s, args[0].binary), nil
+	case *InsertExpr:
+		s, newStr := args[0], args[3]
+		s.binary = s.binary || newStr.binary
+		newStr.binary = s.binary
+		chars, pos, n := s.chars(), args[1].integer(), args[2].integer()
+		if pos < 1 || pos > int64(len(chars)) {
+			return s, nil
+		}
+		end := int64(len(chars))
+		if n >= 0 && pos-1+n < end {
+			end = pos - 1 + n
+		}
+		result := append(append(append([]rune{}, chars[:pos-1]...), newStr.chars()...), chars[end:]...)
+		return limitString(s.stringOf(result)), nil
+	case *CharExpr:
+		var b []byte
+		for _, arg := range args {
+			if arg.isNull() {
+				continue
+			}
+			code := arg.bits() & math.MaxUint32
+			var bytes []byte
+			for ; code > 0; code >>= 8 {
+				bytes = append([]byte{byte(code)}, bytes...)
+			}
+			if len(bytes) == 0 {
+				bytes = []byte{0}
+			}
+			b = append(b, bytes...)
+		}
+		return newEvalString(string(b), node.Charset == "" || strings.EqualFold(node.Charset, "binary")), nil
+	}
+	return evalNULL, errUnsupportedEval(node)
+}
+
+// evalConvert evaluates a CAST or a CONVERT of a value to a type.
+func evalConvert(node Expr, v evalValue, typ *ConvertType) (evalValue, error) {
+	if v.isNull() {
+		return v, nil
+	}
+	length, scale := int64(-1), int64(0)
+	if typ.Length != nil {
+		length, _ = strconv.ParseInt(typ.Length.Val, 10, 64)
+	}
+	if typ.Scale != nil {
+		scale, _ = strconv.ParseInt(typ.Scale.Val, 10, 64)
+	}
+	switch strings.ToLower(typ.Type) {
+	case "char", "nchar":
+		s := newEvalString(v.toString(), typ.Charset.Binary || strings.EqualFold(typ.Charset.Name, "binary"))
+		if chars := s.chars(); length >= 0 && length < int64(len(chars)) {
+			s = s.stringOf(chars[:length])
+		}
+		return s, nil
+	case "binary":
+		s := v.toString()
+		if length >= 0 && length < int64(len(s)) {
+			s = s[:length]
+		} else if length > int64(len(s)) {
+			s += strings.Repeat("\x00", int(length)-len(s))
+		}
+		return newEvalString(s, true), nil
+	case "signed":
+		if v.typ == evalString && !v.hexNum {
+			v = parseIntPrefix(v.str)
+		}
+		if v.typ == evalUint64 {
+			return newEvalInt64(int64(v.u)), nil
+		}
+		return newEvalInt64(v.integer()), nil
+	case "unsigned":
+		if v.typ == evalString && !v.hexNum {
+			v = parseIntPrefix(v.str)
+		}
+		v = v.numeric()
+		switch {
+		case v.typ == evalUint64:
+			return v, nil
+		case v.typ == evalInt64 || v.float() < 0:
+			return newEvalUint64(uint64(v.integer())), nil
+		}
+		return newEvalUint64(v.bits()), nil
+	case "decimal":
+		precision := int64(10)
+		if length > 0 {
+			precision = length
+		}
+		if precision > decimal.MyMaxPrecision || scale > decimal.MyMaxScale || scale > precision {
+			return evalNULL, errUnsupportedEval(node)
+		}
+		dec := v.decimal().Round(int32(scale))
+		return newEvalDecimal(dec.Clamp(int32(precision-scale), int32(scale))), nil
+	case "double", "real", "float":
+		return newEvalFloat64(v.float()), nil
+	case "date":
+		v, err := v.toDatetime()
+		if err != nil || v.isNull() {
+			return v, err
+		}
+		return v.toDate(), nil
+	case "datetime":
+		v, err := v.toDatetime()
+		if err != nil || v.isNull() {
+			return v, err
+		}
+		v.typ = evalDatetime
+		return v.withPrecision(length), nil
+	case "time":
+		v = v.toTime()
+		if v.isNull() {
+			return v, nil
+		}
+		return v.withPrecision(length), nil
+	}
+	return evalNULL, errUnsupportedEval(node)
+}
+
+var intPrefixRegexp = regexp.MustCompile(`^\s*[+-]?\d+`)
+
+// parseIntPrefix returns the integer of the longest prefix of the string
+// that is an integer, wrapped or clamped to 64 bits.
+func parseIntPrefix(s string) evalValue {
+	prefix := strings.TrimSpace(intPrefixRegexp.FindString(s))
+	if i, err := strconv.ParseInt(prefix, 10, 64); err == nil {
+		return newEvalInt64(i)
+	}
+	if u, err := strconv.ParseUint(strings.TrimPrefix(prefix, "+"), 10, 64); err == nil {
+		return newEvalUint64(u)
+	}
+	if strings.HasPrefix(prefix, "-") {
+		return newEvalInt64(math.MinInt64)
+	}
+	return newEvalUint64(math.MaxUint64)
+}
+
+// withPrecision rounds a datetime or a time to a fractional seconds
+// precision. A negative precision means 0.
+func (v evalValue) withPrecision(fsp int64) evalValue {
+	if fsp < 0 {
+		fsp = 0
+	}
+	if fsp > 6 {
+		fsp = 6
+	}
+	unit := time.Duration(math.Pow10(9 - int(fsp)))
+	v.fsp = int(fsp)
+	if v.typ == evalTime {
+		v.dur = v.dur.Round(unit)
+	} else {
+		v.t = v.t.Round(unit)
+	}
+	return v
+}
+
+var (
+	datetimeRegexp        = regexp.MustCompile(`^(\d{1,4})[[:punct:]](\d{1,2})[[:punct:]](\d{1,2})(?:(?:T| +)(\d{1,2})[[:punct:]](\d{1,2})[[:punct:]](\d{1,2})(?:\.(\d*))?)?$`)
+	compactDatetimeRegexp = regexp.MustCompile(`^(\d{2}|\d{4})(\d{2})(\d{2})(?:(\d{2})(\d{2})(\d{2})(?:\.(\d*))?)?$`)
+	timeRegexp            = regexp.MustCompile(`^(-)?(?:(\d+) +)?(\d+):(\d{1,2})(?::(\d{1,2}))?(?:\.(\d*))?$`)
+	compactTimeRegexp     = regexp.MustCompile(`^(-)?(\d+)(?:\.(\d*))?$`)
+)
+
+// maxTime is the largest absolute value of a TIME.
+const maxTime = 838*time.Hour + 59*time.Minute + 59*time.Second
+
+// parseDatetime parses a date or a datetime, or returns NULL.
+func parseDatetime(s string) evalValue {
+	s = strings.TrimSpace(s)
+	match := datetimeRegexp.FindStringSubmatch(s)
+	if match == nil {
+		match = compactDatetimeRegexp.FindStringSubmatch(s)
+	}
+	if match == nil {
+		return evalNULL
+	}
+	var parts [6]int
+	for i := range parts {
+		parts[i], _ = strconv.Atoi(match[i+1])
+	}
+	if len(match[1]) <= 2 {
+		if parts[0] < 70 {
+			parts[0] += 2000
+		} else {
+			parts[0] += 1900
+		}
+	}
+	micros, fsp := parseFraction(match[7])
+	if parts[0] < 1 || parts[1] < 1 || parts[1] > 12 || parts[2] < 1 || parts[3] > 23 || parts[4] > 59 || parts[5] > 59 {
+		return evalNULL
+	}
+	t := time.Date(parts[0], time.Month(parts[1]), parts[2], parts[3], parts[4], parts[5], micros*1000, time.UTC)
+	if t.Day() != parts[2] {
+		return evalNULL
+	}
+	if match[4] == "" {
+		return evalValue{typ: evalDate, t: t}
+	}
+	return evalValue{typ: evalDatetime, t: t, fsp: fsp}
+}
+
+// parseTime parses a time, or returns NULL.
+func parseTime(s string) evalValue {
+	s = strings.TrimSpace(s)
+	var neg bool
+	var days, hours, minutes, seconds int64
+	var fraction string
+	if match := timeRegexp.FindStringSubmatch(s); match != nil {
+		neg = match[1] != ""
+		days, _ = strconv.ParseInt(match[2], 10, 64)
+		hours, _ = strconv.ParseInt(match[3], 10, 64)
+		minutes, _ = strconv.ParseInt(match[4], 10, 64)
+		seconds, _ = strconv.ParseInt(match[5], 10, 64)
+		fraction = match[6]
+	} else if match := compactTimeRegexp.FindStringSubmatch(s); match != nil {
+		neg = match[1] != ""
+		n, err := strconv.ParseInt(match[2], 10, 64)
+		if err != nil {
+			return evalNULL
+		}
+		hours, minutes, seconds = n/10000, n/100%100, n%100
+		fraction = match[3]
+	} else {
+		return evalNULL
+	}
+	if minutes > 59 || seconds > 59 || days > 34 || hours > 838 {
+		return evalNULL
+	}
+	micros, fsp := parseFraction(fraction)
+	d := time.Duration(days*24+hours)*time.Hour + time.Duration(minutes)*time.Minute +
+		time.Duration(seconds)*time.Second + time.Duration(micros)*time.Microsecond
+	if d > maxTime {
+		return evalNULL
+	}
+	if neg {
+		d = -d
+	}
+	return evalValue{typ: evalTime, dur: d, fsp: fsp}
+}
+
+// parseFraction parses the digits of fractional seconds into microseconds,
+// and returns their precision.
+func parseFraction(digits string) (int, int) {
+	if len(digits) > 6 {
+		digits = digits[:6]
+	}
+	fsp := len(digits)
+	micros, _ := strconv.Atoi((digits + "000000")[:6])
+	return micros, fsp
+}
+
+// formatFraction formats microseconds with a fractional seconds precision.
+func formatFraction(micros int, fsp int) string {
+	if fsp == 0 {
+		return ""
+	}
+	return fmt.Sprintf(".%06d", micros)[:fsp+1]
+}
+
+// formatTime formats a TIME value.
+func formatTime(d time.Duration, fsp int) string {
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	return fmt.Sprintf("%s%02d:%02d:%02d", sign, d/time.Hour, d/time.Minute%60, d/time.Second%60) +
+		formatFraction(int(d/time.Microsecond%1000000), fsp)
+}
+
+// toDatetime converts a value to a date or a datetime, or returns NULL if
+// it is not one. Times are an error, because converting them requires the
+// current date.
+func (v evalValue) toDatetime() (evalValue, error) {
+	switch v.typ {
+	case evalDate, evalDatetime:
+		return v, nil
+	case evalTime:
+		return evalNULL, errTimeToDatetime
+	case evalString, evalInt64, evalUint64:
+		dt := parseDatetime(v.toString())
+		if dt.isNull() && v.typ == evalString && !parseTime(v.str).isNull() {
+			return evalNULL, errTimeToDatetime
+		}
+		return dt, nil
+	}
+	return evalNULL, nil
+}
+
+var errTimeToDatetime = vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "a TIME cannot be converted to a DATETIME without the current date")
+
+// toDate returns the date of a date or a datetime.
+func (v evalValue) toDate() evalValue {
+	y, m, d := v.t.Date()
+	return evalValue{typ: evalDate, t: time.Date(y, m, d, 0, 0, 0, 0, time.UTC)}
+}
+
+// toTime converts a value to a time, or returns NULL if it is not one. The
+// time of a date or a datetime is its time of day.
+func (v evalValue) toTime() evalValue {
+	switch v.typ {
+	case evalTime:
+		return v
+	case evalDate, evalDatetime:
+		y, m, d := v.t.Date()
+		return evalValue{typ: evalTime, dur: v.t.Sub(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)), fsp: v.fsp}
+	case evalNull:
+		return v
+	}
+	s := v.toString()
+	if t := parseTime(s); !t.isNull() {
+		return t
+	}
+	if dt := parseDatetime(s); !dt.isNull() {
+		return dt.toTime()
+	}
+	return evalNULL
+}
+
+// intervalPart is a part of the value of an interval.
+type intervalPart int8
+
+// Constants for Enum Type - intervalPart
+const (
+	yearPart intervalPart = iota
+	monthPart
+	dayPart
+	hourPart
+	minutePart
+	secondPart
+	microsecondPart
+)
+
+// intervalParts are the parts of the values of the interval units, from
+// the largest. The quarters and weeks are months and days.
+var intervalParts = map[IntervalTypes][]intervalPart{
+	IntervalYear:              {yearPart},
+	IntervalQuarter:           {monthPart},
+	IntervalMonth:             {monthPart},
+	IntervalWeek:              {dayPart},
+	IntervalDay:               {dayPart},
+	IntervalHour:              {hourPart},
+	IntervalMinute:            {minutePart},
+	IntervalSecond:            {secondPart},
+	IntervalMicrosecond:       {microsecondPart},
+	IntervalYearMonth:         {yearPart, monthPart},
+	IntervalDayHour:           {dayPart, hourPart},
+	IntervalDayMinute:         {dayPart, hourPart, minutePart},
+	IntervalDaySecond:         {dayPart, hourPart, minutePart, secondPart},
+	IntervalHourMinute:        {hourPart, minutePart},
+	IntervalHourSecond:        {hourPart, minutePart, secondPart},
+	IntervalMinuteSecond:      {minutePart, secondPart},
+	IntervalDayMicrosecond:    {dayPart, hourPart, minutePart, secondPart, microsecondPart},
+	IntervalHourMicrosecond:   {hourPart, minutePart, secondPart, microsecondPart},
+	IntervalMinuteMicrosecond: {minutePart, secondPart, microsecondPart},
+	IntervalSecondMicrosecond: {secondPart, microsecondPart},
+}
+
+// intervalPartMicros are the microseconds of the parts of the intervals
+// that are durations, and intervalPartLimits the largest values of the
+// parts that do not overflow the range of the dates.
+var (
+	intervalPartMicros = map[intervalPart]int64{
+		dayPart:         24 * 3600 * 1000000,
+		hourPart:        3600 * 1000000,
+		minutePart:      60 * 1000000,
+		secondPart:      1000000,
+		microsecondPart: 1,
+	}
+	intervalPartLimits = map[intervalPart]int64{
+		yearPart:        10000,
+		monthPart:       120000,
+		dayPart:         3660000,
+		hourPart:        3660000 * 24,
+		minutePart:      3660000 * 24 * 60,
+		secondPart:      3660000 * 24 * 3600,
+		microsecondPart: 3660000 * 24 * 3600 * 1000000,
+	}
+)
+
+var intervalDigitsRegexp = regexp.MustCompile(`\d+`)
+
+// interval is the value of an INTERVAL expression.
+type interval struct {
+	months  int64
+	micros  int64
+	hasTime bool
+	fsp     int
+}
+
+// parseInterval returns the interval of a value in a unit, or false if the
+// value is not valid or overflows the range of the dates.
+func parseInterval(v evalValue, unit IntervalTypes) (interval, bool) {
+	parts, ok := intervalParts[unit]
+	if !ok {
+		return interval{}, false
+	}
+	values := make([]int64, len(parts))
+	var fsp int
+	switch {
+	case unit == IntervalSecond && v.typ != evalString:
+		// fractional seconds are kept up to the microsecond
+		dec := v.numeric().decimal()
+		seconds := dec.Truncate(0)
+		micros := dec.Sub(seconds).Mul(decimal.NewFromInt(1000000)).Round(0)
+		values[0], _ = seconds.Int64()
+		micros64, _ := micros.Int64()
+		parts = []intervalPart{secondPart, microsecondPart}
+		values = append(values, micros64)
+		if scale := -dec.Exponent(); scale > 0 {
+			fsp = int(scale)
+		}
+	case len(parts) == 1 && v.typ != evalString:
+		values[0] = v.integer()
+	default:
+		s := strings.TrimSpace(v.toString())
+		neg := strings.HasPrefix(s, "-")
+		digits := intervalDigitsRegexp.FindAllString(s, -1)
+		if len(parts) == 1 && len(digits) > 1 {
+			digits = digits[:1]
+		}
+		if len(digits) > len(parts) {
+			return interval{}, false
+		}
+		offset := len(parts) - len(digits)
+		for i, digit := range digits {
+			if parts[offset+i] == microsecondPart && len(digit) < 6 {
+				digit += strings.Repeat("0", 6-len(digit))
+				fsp = 6
+			}
+			n, err := strconv.ParseInt(digit, 10, 64)
+			if err != nil {
+				return interval{}, false
+			}
+			if neg {
+				n = -n
+			}
+			values[offset+i] = n
+		}
+	}
+	if fsp > 6 {
+		fsp = 6
+	}
+
+	iv := interval{fsp: fsp}
+	for i, part := range parts {
+		n := values[i]
+		switch unit {
+		case IntervalQuarter:
+			n *= 3
+		case IntervalWeek:
+			n *= 7
+		}
+		if n > intervalPartLimits[part] || n < -intervalPartLimits[part] {
+			return interval{}, false
+		}
+		switch part {
+		case yearPart:
+			iv.months += n * 12
+		case monthPart:
+			iv.months += n
+		default:
+			iv.micros += n * intervalPartMicros[part]
+			iv.hasTime = iv.hasTime || part != dayPart
+		}
+		if part == microsecondPart && iv.fsp == 0 && n != 0 {
+			iv.fsp = 6
+		}
+	}
+	return iv, true
+}
+
+// add adds the interval to a date or a datetime, or returns false if the
+// result is out of the range of the dates.
+func (iv interval) add(t time.Time) (time.Time, bool) {
+	if iv.months != 0 {
+		y, m, d := t.Date()
+		months := int64(y)*12 + int64(m-1) + iv.months
+		if months < 12 || months >= 10000*12 {
+			return t, false
+		}
+		y, m = int(months/12), time.Month(months%12+1)
+		if last := time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day(); d > last {
+			d = last
+		}
+		t = time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	}
+	days := iv.micros / intervalPartMicros[dayPart]
+	t = t.AddDate(0, 0, int(days)).Add(time.Duration(iv.micros%intervalPartMicros[dayPart]) * time.Microsecond)
+	return t, t.Year() >= 1 && t.Year() <= 9999
+}
+
+// evalDateArithmetic evaluates the addition or the subtraction of an
+// interval to a date. The result is a date if the date is a date and the
+// interval has no time, and a datetime otherwise, as a string if the date
+// is a string.
+func evalDateArithmetic(dateExpr, intervalExpr Expr, unit IntervalTypes, sub bool) (evalValue, error) {
+	date, value, err := evaluate2(dateExpr, intervalExpr)
+	if err != nil || date.isNull() || value.isNull() {
+		return evalNULL, err
+	}
+	if unit == IntervalUnknown {
+		unit = IntervalDay
+	}
+	dt, err := date.toDatetime()
+	if err != nil || dt.isNull() {
+		return evalNULL, err
+	}
+	iv, ok := parseInterval(value, unit)
+	if !ok {
+		return evalNULL, nil
+	}
+	if sub {
+		iv.months, iv.micros = -iv.months, -iv.micros
+	}
+	t, ok := iv.add(dt.t)
+	if !ok {
+		return evalNULL, nil
+	}
+	result := evalValue{typ: evalDate, t: t}
+	if dt.typ == evalDatetime || iv.hasTime {
+		result.typ, result.fsp = evalDatetime, dt.fsp
+		if iv.fsp > result.fsp {
+			result.fsp = iv.fsp
+		}
+	}
+	if !date.isTemporal() {
+		return newEvalString(result.toString(), false), nil
+	}
+	return result, nil
+}
+
+func evalExtract(node *ExtractFuncExpr) (evalValue, error) {
+	v, err := evaluate(node.Expr)
+	if err != nil || v.isNull() {
+		return evalNULL, err
+	}
+	parts, ok := intervalParts[node.IntervalTypes]
+	if !ok || node.IntervalTypes == IntervalWeek {
+		return evalNULL, errUnsupportedEval(node)
+	}
+	var t time.Time
+	var clock time.Duration
+	if parts[0] >= hourPart {
+		// the units of the time of day apply to times as well
+		tv := v.toTime()
+		if tv.isNull() {
+			return evalNULL, nil
+		}
+		clock = tv.dur
+	} else {
+		dt, err := v.toDatetime()
+		if err != nil || dt.isNull() {
+			return evalNULL, err
+		}
+		t = dt.t
+		clock = dt.toTime().dur
+	}
+	neg := clock < 0
+	if neg {
+		clock = -clock
+	}
+	var n int64
+	for _, part := range parts {
+		var value int64
+		switch part {
+		case yearPart:
+			value = int64(t.Year())
+		case monthPart:
+			value = int64(t.Month())
+		case dayPart:
+			value = int64(t.Day())
+		case hourPart:
+			value = int64(clock / time.Hour)
+			if parts[0] != hourPart {
+				value %= 24
+			}
+		case minutePart:
+			value = int64(clock / time.Minute % 60)
+		case secondPart:
+			value = int64(clock / time.Second % 60)
+		case microsecondPart:
+			value = int64(clock / time.Microsecond % 1000000)
+		}
+		if part == microsecondPart {
+			n = n*1000000 + value
+		} else {
+			n = n*100 + value
+		}
+	}
+	if node.IntervalTypes == IntervalQuarter {
+		n = (n + 2) / 3
+	}
+	if neg {
+		n = -n
+	}
+	return newEvalInt64(n), nil
+}
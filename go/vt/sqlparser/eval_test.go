@@ -0,0 +1,272 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluate(t *testing.T) {
+	testcases := []struct {
+		expr string
+		want string
+	}{
+		// literals and arithmetic
+		{expr: "1 + 1", want: "INT64(2)"},
+		{expr: "-9223372036854775808", want: "INT64(-9223372036854775808)"},
+		{expr: "18446744073709551615", want: "UINT64(18446744073709551615)"},
+		{expr: "1.50 * 2", want: `DECIMAL(3.00)`},
+		{expr: "1 / 3", want: `DECIMAL(0.3333)`},
+		{expr: "1.5 / 3", want: `DECIMAL(0.50000)`},
+		{expr: "1 / 0", want: "NULL"},
+		{expr: "7 div 2", want: "INT64(3)"},
+		{expr: "-7 % 3", want: "INT64(-1)"},
+		{expr: "mod(7.5, 2)", want: `DECIMAL(1.5)`},
+		{expr: "1e0 + 1", want: "FLOAT64(2)"},
+		{expr: "'3abc' + 1", want: "FLOAT64(4)"},
+		{expr: "0x41 + 0", want: "UINT64(65)"},
+		{expr: "5 & 3 | 8", want: "UINT64(9)"},
+		{expr: "~0", want: "UINT64(18446744073709551615)"},
+		{expr: "1 << 70", want: "UINT64(0)"},
+		{expr: "null + 1", want: "NULL"},
+
+		// comparisons and logic
+		{expr: "1 = 1", want: "INT64(1)"},
+		{expr: "NOT (1 = 1)", want: "INT64(0)"},
+		{expr: "'abc' = 'ABC'", want: "INT64(1)"},
+		{expr: "'abc' = 'ABC' collate utf8mb4_bin", want: "INT64(0)"},
+		{expr: "_binary 'abc' = 'ABC'", want: "INT64(0)"},
+		{expr: "'10' < '9'", want: "INT64(1)"},
+		{expr: "10 < '9'", want: "INT64(0)"},
+		{expr: "1 = 1.0", want: "INT64(1)"},
+		{expr: "'2020-01-01' = date '2020-01-01'", want: "INT64(1)"},
+		{expr: "null = null", want: "NULL"},
+		{expr: "null <=> null", want: "INT64(1)"},
+		{expr: "1 in (2, null)", want: "NULL"},
+		{expr: "1 in (2, 1, null)", want: "INT64(1)"},
+		{expr: "1 not in (2, 3)", want: "INT64(1)"},
+		{expr: "2 between 1 and 3", want: "INT64(1)"},
+		{expr: "'b' not between 'A' and 'C'", want: "INT64(0)"},
+		{expr: "'Hello' like 'h%o'", want: "INT64(1)"},
+		{expr: "'a_b' like 'a\\_b'", want: "INT64(1)"},
+		{expr: "'axb' like 'a|_b' escape '|'", want: "INT64(0)"},
+		{expr: "'abc' regexp '^A'", want: "INT64(1)"},
+		{expr: "null and 0", want: "INT64(0)"},
+		{expr: "null or 0", want: "NULL"},
+		{expr: "1 xor 1", want: "INT64(0)"},
+		{expr: "null is null", want: "INT64(1)"},
+		{expr: "0 is not false", want: "INT64(0)"},
+
+		// control flow
+		{expr: "case 2 when 1 then 'a' when 2 then 'b' end", want: `VARCHAR("b")`},
+		{expr: "case when 1 > 2 then 'a' end", want: "NULL"},
+		{expr: "case when 1 then 1 else 'x' end", want: `VARCHAR("1")`},
+		{expr: "if(0, 1, 2.5)", want: `DECIMAL(2.5)`},
+		{expr: "ifnull(null, 2)", want: "INT64(2)"},
+		{expr: "nullif(1, 1)", want: "NULL"},
+		{expr: "coalesce(null, null, 'x')", want: `VARCHAR("x")`},
+
+		// casts
+		{expr: "cast('12.7abc' as signed)", want: "INT64(12)"},
+		{expr: "cast(12.7 as signed)", want: "INT64(13)"},
+		{expr: "cast(-1 as unsigned)", want: "UINT64(18446744073709551615)"},
+		{expr: "cast(1.235 as decimal(4, 2))", want: `DECIMAL(1.24)`},
+		{expr: "cast(123.4 as decimal(3, 1))", want: `DECIMAL(99.9)`},
+		{expr: "cast(1 as char)", want: `VARCHAR("1")`},
+		{expr: "cast('abc' as binary(5))", want: `VARBINARY("abc\x00\x00")`},
+		{expr: "convert('2020-01-02 10:11:12.5', datetime(1))", want: `DATETIME("2020-01-02 10:11:12.5")`},
+		{expr: "convert('abc' using binary)", want: `VARBINARY("abc")`},
+
+		// string functions
+		{expr: "CONCAT('a', 'b')", want: `VARCHAR("ab")`},
+		{expr: "concat('a', null)", want: "NULL"},
+		{expr: "concat_ws(',', 'a', null, 1)", want: `VARCHAR("a,1")`},
+		{expr: "upper('àbc')", want: `VARCHAR("ÀBC")`},
+		{expr: "length('é')", want: "INT64(2)"},
+		{expr: "char_length('é')", want: "INT64(1)"},
+		{expr: "substr('hello', -3, 2)", want: `VARCHAR("ll")`},
+		{expr: "substring('hello' from 2)", want: `VARCHAR("ello")`},
+		{expr: "locate('L', 'hello', 4)", want: "INT64(4)"},
+		{expr: "instr('hello', 'x')", want: "INT64(0)"},
+		{expr: "trim(leading 'x' from 'xxaxx')", want: `VARCHAR("axx")`},
+		{expr: "rtrim('a  ')", want: `VARCHAR("a")`},
+		{expr: "lpad('a', 4, 'xy')", want: `VARCHAR("xyxa")`},
+		{expr: "rpad('abc', 2, 'x')", want: `VARCHAR("ab")`},
+		{expr: "replace('aXa', 'a', 'b')", want: `VARCHAR("bXb")`},
+		{expr: "substring_index('a.b.c', '.', -2)", want: `VARCHAR("b.c")`},
+		{expr: "insert('abcd', 2, 2, 'X')", want: `VARCHAR("aXd")`},
+		{expr: "reverse('abc')", want: `VARCHAR("cba")`},
+		{expr: "hex('a')", want: `VARCHAR("61")`},
+		{expr: "hex(255)", want: `VARCHAR("FF")`},
+		{expr: "strcmp('a', 'B')", want: "INT64(-1)"},
+
+		// numeric functions
+		{expr: "abs(-2)", want: "INT64(2)"},
+		{expr: "ceil(1.2)", want: "INT64(2)"},
+		{expr: "floor(-1.2)", want: "INT64(-2)"},
+		{expr: "round(2.5)", want: `DECIMAL(3)`},
+		{expr: "round(2.5e0)", want: "FLOAT64(2)"},
+		{expr: "round(1234, -2)", want: "INT64(1200)"},
+		{expr: "truncate(1.999, 1)", want: `DECIMAL(1.9)`},
+		{expr: "sign(-0.5)", want: "INT64(-1)"},
+		{expr: "pow(2, 10)", want: "FLOAT64(1024)"},
+		{expr: "sqrt(-1)", want: "NULL"},
+		{expr: "log(2, 8)", want: "FLOAT64(3)"},
+		{expr: "greatest(1, 3, 2)", want: "INT64(3)"},
+		{expr: "least('b', 'a')", want: `VARCHAR("a")`},
+
+		// date and time functions
+		{expr: "DATE_ADD('2020-01-01', INTERVAL 1 DAY)", want: `VARCHAR("2020-01-02")`},
+		{expr: "date_add(date '2020-01-31', interval 1 month)", want: `DATE("2020-02-29")`},
+		{expr: "date '2020-01-01' + interval 1 hour", want: `DATETIME("2020-01-01 01:00:00")`},
+		{expr: "date_sub('2020-01-01 00:00:00', interval '1:30' hour_minute)", want: `VARCHAR("2019-12-31 22:30:00")`},
+		{expr: "adddate('2020-01-01', 31)", want: `VARCHAR("2020-02-01")`},
+		{expr: "date_add('2020-01-01', interval 1.5 second)", want: `VARCHAR("2020-01-01 00:00:01.5")`},
+		{expr: "date_add('2020-01-01', interval '1.5' second_microsecond)", want: `VARCHAR("2020-01-01 00:00:01.500000")`},
+		{expr: "date_add('9999-12-31', interval 1 day)", want: "NULL"},
+		{expr: "date_add('2020-02-30', interval 1 day)", want: "NULL"},
+		{expr: "date('2020-01-02 10:11:12')", want: `DATE("2020-01-02")`},
+		{expr: "year('2020-01-02')", want: "INT64(2020)"},
+		{expr: "dayofweek('2020-01-05')", want: "INT64(1)"},
+		{expr: "monthname('2020-01-02')", want: `VARCHAR("January")`},
+		{expr: "hour('30:10:00')", want: "INT64(30)"},
+		{expr: "minute('2020-01-02 10:11:12')", want: "INT64(11)"},
+		{expr: "datediff('2020-03-01', '2020-02-01 23:00:00')", want: "INT64(29)"},
+		{expr: "last_day('2020-02-10')", want: `DATE("2020-02-29")`},
+		{expr: "date_format('2020-01-02 13:04:05', '%W %D %M %Y %h:%i %p')", want: `VARCHAR("Thursday 2nd January 2020 01:04 PM")`},
+		{expr: "extract(year_month from '2020-01-02')", want: "INT64(202001)"},
+		{expr: "extract(hour_second from time '10:11:12')", want: "INT64(101112)"},
+		{expr: "timestamp '2020-01-02 10:11:12' > '2020-01-02'", want: "INT64(1)"},
+	}
+	for _, tcase := range testcases {
+		t.Run(tcase.expr, func(t *testing.T) {
+			expr, err := ParseExpr(tcase.expr)
+			require.NoError(t, err)
+			value, err := Evaluate(expr)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, value.String())
+		})
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	testcases := []struct {
+		expr string
+		err  string
+	}{{
+		expr: "a + 1",
+		err:  "expression is not constant: a",
+	}, {
+		expr: "now()",
+		err:  "expression is not constant: now()",
+	}, {
+		expr: "rand() > 0.5",
+		err:  "expression is not constant: rand()",
+	}, {
+		expr: "1 in (select 1 from dual)",
+		err:  "expression is not constant: (select 1 from dual)",
+	}, {
+		expr: "9223372036854775807 + 1",
+		err:  "BIGINT value is out of range in '9223372036854775807 + 1'",
+	}, {
+		expr: "cast(0 as unsigned) - 1",
+		err:  "BIGINT value is out of range in 'cast(0 as unsigned) - 1'",
+	}, {
+		expr: "1e308 * 10",
+		err:  "DOUBLE value is out of range in '1e308 * 10'",
+	}, {
+		expr: "pow(10, 400)",
+		err:  "DOUBLE value is out of range in 'pow(10, 400)'",
+	}, {
+		expr: "year('10:00:00')",
+		err:  "a TIME cannot be converted to a DATETIME without the current date",
+	}, {
+		expr: "date_format('2020-01-01', '%U')",
+		err:  "unsupported expression: date_format('2020-01-01', '%U')",
+	}, {
+		expr: "concat()",
+		err:  "incorrect parameter count in the call to native function 'concat'",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.expr, func(t *testing.T) {
+			expr, err := ParseExpr(tcase.expr)
+			require.NoError(t, err)
+			_, err = Evaluate(expr)
+			assert.EqualError(t, err, tcase.err)
+		})
+	}
+}
+
+func TestFoldConstants(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{{
+		in:  "select * from t where a = 1 + 1",
+		out: "select * from t where a = 2",
+	}, {
+		in:  "select * from t where NOT (1 = 1) or b = CONCAT('a','b')",
+		out: "select * from t where false or b = 'ab'",
+	}, {
+		in:  "select * from t where d > DATE_ADD('2020-01-01', INTERVAL 1 DAY)",
+		out: "select * from t where d > '2020-01-02'",
+	}, {
+		in:  "select 1 + 1, a * (2 * 3) as x from t",
+		out: "select 2 as `1 + 1`, a * 6 as x from t",
+	}, {
+		in:  "select a from t where 0 and b = 1",
+		out: "select a from t where false",
+	}, {
+		in:  "select a from t where a in (1 + 1, 3) and b between 1 * 2 and 2 * 2",
+		out: "select a from t where a in (2, 3) and b between 2 and 4",
+	}, {
+		in:  "select a from t group by 1 + 1 order by 1 + 0, a + (1 + 1)",
+		out: "select a from t group by 1 + 1 order by 1 + 0 asc, a + 2 asc",
+	}, {
+		in:  "update t set a = upper('x'), b = 1.5 * 2 where c = 1 / 4",
+		out: "update t set a = 'X', b = 3.0 where c = 0.2500",
+	}, {
+		in:  "select a from t where b = 1e0 + 1 and c = cast(1 as unsigned) - 1",
+		out: "select a from t where b = 2e0 and c = cast(1 as unsigned) - 1",
+	}, {
+		in:  "select a from t where b = _binary 'x' and c = 'x' collate utf8mb4_bin and d = now() and e = rand()",
+		out: "select a from t where b = _binary 'x' and c = 'x' collate utf8mb4_bin and d = now() and e = rand()",
+	}, {
+		in:  "select a from t where b = null + 1 and c = -1 and d = 0x41 | 0",
+		out: "select a from t where b = null and c = -1 and d = 0x41 | 0",
+	}, {
+		in:  "update t set a = 1 / 0, b = sqrt(-1) where c = 1e308 * 10",
+		out: "update t set a = 1 / 0, b = sqrt(-1) where c = 1e308 * 10",
+	}, {
+		in:  "select a from t where b = 0.1e0 + 0.2e0 and c = 1.5e0 * 2",
+		out: "select a from t where b = 0.30000000000000004e0 and c = 3e0",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			stmt, err := Parse(tcase.in)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.out, String(FoldConstants(stmt)))
+		})
+	}
+
+	expr, err := ParseExpr("1 + 2 * 3")
+	require.NoError(t, err)
+	assert.Equal(t, "7", String(FoldConstants(expr)))
+}
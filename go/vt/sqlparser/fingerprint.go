@@ -0,0 +1,342 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Digest is the fingerprint of a statement, in the spirit of the
+// STATEMENT_DIGEST_TEXT and STATEMENT_DIGEST functions of MySQL. Statements
+// that only differ in their literals, comments, identifier quoting, keyword
+// case or the length of their literal lists have the same digest.
+type Digest struct {
+	// Text is the normalized statement: keywords are upper case, identifiers
+	// are quoted, literals are replaced with ? and literal lists with (...).
+	Text string
+	// Hash is the SHA-256 of Text.
+	Hash [sha256.Size]byte
+}
+
+// String returns the hash of the digest in hex, like STATEMENT_DIGEST.
+func (d Digest) String() string {
+	return hex.EncodeToString(d.Hash[:])
+}
+
+// Hash64 returns the first 64 bits of the hash of the digest.
+func (d Digest) Hash64() uint64 {
+	return binary.BigEndian.Uint64(d.Hash[:8])
+}
+
+func newDigest(text string) Digest {
+	return Digest{Text: text, Hash: sha256.Sum256([]byte(text))}
+}
+
+// digestPlaceholder replaces the literals and bind variables of a digest.
+const digestPlaceholder = "?"
+
+// digestList replaces the lists of literals of a digest.
+const digestList = "(...)"
+
+// Fingerprint returns the digest of a parsed statement.
+func Fingerprint(stmt Statement) Digest {
+	return defaultParser.Load().Fingerprint(stmt)
+}
+
+// Fingerprint behaves like the package-level Fingerprint, with the
+// identifiers quoted like in the dialect of the parser.
+func (p *Parser) Fingerprint(stmt Statement) Digest {
+	buf := NewTrackedBuffer(formatDigest)
+	buf.SetUpperCase(true)
+	buf.SetEscapeAllIdentifiers()
+	buf.SetDialect(p.Dialect())
+	buf.Myprintf("%v", stmt)
+	return newDigest(buf.String())
+}
+
+// FingerprintString returns the digest of a SQL statement. A statement that
+// cannot be parsed is fingerprinted from its tokens only; its digest text
+// is then close to, but not always the same as, the one of a parsed
+// statement. An error is only returned if the statement cannot be tokenized.
+func FingerprintString(sql string) (Digest, error) {
+	return defaultParser.Load().FingerprintString(sql)
+}
+
+// FingerprintString behaves like the package-level FingerprintString, with
+// the settings of the parser.
+func (p *Parser) FingerprintString(sql string) (Digest, error) {
+	stmt, err := p.Parse(sql)
+	if err == nil {
+		return p.Fingerprint(stmt), nil
+	}
+	text, err := digestTokens(p.NewStringTokenizer(sql))
+	if err != nil {
+		return Digest{}, err
+	}
+	return newDigest(text), nil
+}
+
+// formatDigest is the NodeFormatter of Fingerprint.
+func formatDigest(buf *TrackedBuffer, node SQLNode) {
+	switch node := node.(type) {
+	case *ParsedComments:
+		// comments are not part of the digest
+	case *Literal, *Argument, *PositionalArg:
+		buf.WriteString(digestPlaceholder)
+	case *UnaryExpr:
+		// a signed number is a single literal, like in the MySQL digests
+		if isSignedLiteral(node) {
+			buf.WriteString(digestPlaceholder)
+			return
+		}
+		node.Format(buf)
+	case ListArg:
+		buf.WriteString(digestList)
+	case ValTuple:
+		if isLiteralList(node) {
+			buf.WriteString(digestList)
+			return
+		}
+		node.Format(buf)
+	case Values:
+		for _, row := range node {
+			if !isLiteralList(row) {
+				node.Format(buf)
+				return
+			}
+		}
+		buf.literal("values ")
+		buf.WriteString(digestList)
+	default:
+		node.Format(buf)
+	}
+}
+
+// isLiteralList returns whether a tuple only holds literals and bind variables.
+func isLiteralList(tuple ValTuple) bool {
+	for _, expr := range tuple {
+		switch expr := expr.(type) {
+		case *Literal, *Argument, *PositionalArg, *NullVal, BoolVal:
+		case *UnaryExpr:
+			if !isSignedLiteral(expr) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isSignedLiteral returns whether an expression is a literal or a bind
+// variable with one or more signs.
+func isSignedLiteral(expr *UnaryExpr) bool {
+	if expr.Operator != UMinusOp && expr.Operator != UPlusOp {
+		return false
+	}
+	switch inner := expr.Expr.(type) {
+	case *Literal, *Argument, *PositionalArg:
+		return true
+	case *UnaryExpr:
+		return isSignedLiteral(inner)
+	}
+	return false
+}
+
+// digestOperators are the tokens the tokenizer returns without their text.
+var digestOperators = map[int]string{
+	AND:                     "&&",
+	OR:                      "||",
+	CONCAT_OP:               "||",
+	NE:                      "!=",
+	LE:                      "<=",
+	GE:                      ">=",
+	NULL_SAFE_EQUAL:         "<=>",
+	SHIFT_LEFT:              "<<",
+	SHIFT_RIGHT:             ">>",
+	JSON_EXTRACT_OP:         "->",
+	JSON_UNQUOTE_EXTRACT_OP: "->>",
+	ASSIGNMENT_OPT:          ":=",
+}
+
+// digestTokens returns the digest text of a statement from its tokens.
+func digestTokens(tkn *Tokenizer) (string, error) {
+	var tokens []string
+	for {
+		typ, val := tkn.Scan()
+		switch typ {
+		case 0, ';':
+			return joinDigestTokens(collapseDigestLists(tokens), tkn.dialect.IdentifierQuote()), nil
+		case LEX_ERROR:
+			return "", fmt.Errorf("cannot tokenize the statement near %q", val)
+		case COMMENT:
+			continue
+		case STRING, NCHAR_STRING, INTEGRAL, FLOAT, DECIMAL, HEX, HEXNUM, BIT_LITERAL, BITNUM, VALUE_ARG, POSITIONAL_ARG, OFFSET_ARG:
+			for isDigestSign(tokens) {
+				tokens = tokens[:len(tokens)-1]
+			}
+			tokens = append(tokens, digestPlaceholder)
+		case LIST_ARG:
+			tokens = append(tokens, digestList)
+		case ID, AT_ID, AT_STRING, AT_AT_ID:
+			buf := NewTrackedBuffer(nil)
+			buf.SetDialect(tkn.dialect)
+			switch typ {
+			case AT_ID, AT_STRING:
+				buf.WriteByte('@')
+			case AT_AT_ID:
+				buf.WriteString("@@")
+			}
+			writeEscapedString(buf, val)
+			tokens = append(tokens, buf.String())
+		default:
+			switch {
+			case val != "":
+				tokens = append(tokens, strings.ToUpper(val))
+			case digestOperators[typ] != "":
+				tokens = append(tokens, digestOperators[typ])
+			default:
+				tokens = append(tokens, string(rune(typ)))
+			}
+		}
+	}
+}
+
+// isQuotedDigestToken returns whether a digest token is a quoted
+// identifier, in any dialect.
+func isQuotedDigestToken(token string) bool {
+	return token[0] == '`' || token[0] == '"'
+}
+
+// isDigestSign returns whether the last token is the sign of the literal
+// that follows it, rather than a binary operator after an operand.
+func isDigestSign(tokens []string) bool {
+	n := len(tokens)
+	if n == 0 || (tokens[n-1] != "-" && tokens[n-1] != "+") {
+		return false
+	}
+	if n == 1 {
+		return true
+	}
+	prev := tokens[n-2]
+	return prev != digestPlaceholder && prev != ")" && !isQuotedDigestToken(prev) && !strings.HasPrefix(prev, "@")
+}
+
+// collapseDigestLists replaces the lists of placeholders of IN and VALUES
+// with a single (...).
+func collapseDigestLists(tokens []string) []string {
+	// placeholderList returns the end of the list of placeholders at i, or -1.
+	placeholderList := func(i int) int {
+		if i >= len(tokens) || tokens[i] != "(" {
+			return -1
+		}
+		for j := i + 1; j < len(tokens); j += 2 {
+			if tokens[j] != digestPlaceholder || j+1 == len(tokens) {
+				return -1
+			}
+			switch tokens[j+1] {
+			case ")":
+				return j + 2
+			case ",":
+			default:
+				return -1
+			}
+		}
+		return -1
+	}
+	var result []string
+	for i := 0; i < len(tokens); i++ {
+		result = append(result, tokens[i])
+		switch tokens[i] {
+		case "IN":
+			if end := placeholderList(i + 1); end > 0 {
+				result = append(result, digestList)
+				i = end - 1
+			}
+		case "VALUES", "VALUE":
+			end := placeholderList(i + 1)
+			if end < 0 {
+				continue
+			}
+			for end < len(tokens) && tokens[end] == "," {
+				next := placeholderList(end + 1)
+				if next < 0 {
+					break
+				}
+				end = next
+			}
+			result = append(result, digestList)
+			i = end - 1
+		}
+	}
+	return result
+}
+
+// digestSpacedKeywords are the keywords followed by a blank before a
+// parenthesis when formatting a statement. The other keywords followed by
+// a parenthesis are function names, or type names like CHAR(10).
+var digestSpacedKeywords = map[string]bool{
+	"ALL": true, "AND": true, "ANY": true, "AS": true, "BETWEEN": true, "BY": true,
+	"CASE": true, "CHECK": true, "DEFAULT": true, "DISTINCT": true, "DIV": true,
+	"ELSE": true, "EXCEPT": true, "EXISTS": true, "FROM": true, "HAVING": true,
+	"IN": true, "INTERSECT": true, "INTO": true, "IS": true, "JOIN": true,
+	"KEY": true, "LATERAL": true, "LIKE": true, "LIMIT": true, "MOD": true,
+	"NOT": true, "OF": true, "OFFSET": true, "ON": true, "OR": true, "OVER": true,
+	"PARTITION": true, "RECURSIVE": true, "REFERENCES": true, "REGEXP": true,
+	"RETURN": true, "RLIKE": true, "SELECT": true, "SET": true, "SOME": true,
+	"TABLE": true, "THEN": true, "UNION": true, "USING": true, "VALUE": true,
+	"VALUES": true, "WHEN": true, "WHERE": true, "WITH": true, "XOR": true,
+}
+
+// isDigestKeyword returns whether a digest token is a keyword.
+func isDigestKeyword(token string) bool {
+	for i := 0; i < len(token); i++ {
+		if c := token[i]; c != '_' && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return token != ""
+}
+
+// joinDigestTokens joins tokens with blanks, except around the punctuation
+// that is printed without blanks when formatting a statement. The quote is
+// the one of the quoted identifiers.
+func joinDigestTokens(tokens []string, quote byte) string {
+	var sb strings.Builder
+	for i, token := range tokens {
+		if i > 0 {
+			prev := tokens[i-1]
+			blank := true
+			switch {
+			case token == "," || token == ")" || token == "." || prev == "(" || prev == ".":
+				blank = false
+			case token == "(" && (prev[0] == quote || isDigestKeyword(prev) && !digestSpacedKeywords[prev]):
+				// a function call
+				blank = false
+			}
+			if blank {
+				sb.WriteByte(' ')
+			}
+		}
+		sb.WriteString(token)
+	}
+	return sb.String()
+}
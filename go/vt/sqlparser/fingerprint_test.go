@@ -0,0 +1,171 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingerprint(t *testing.T) {
+	testcases := []struct {
+		sqls []string
+		text string
+	}{{
+		sqls: []string{
+			"select a, b from t where x = 1 and y = 'a' limit 10",
+			"SELECT /* comment */ `a`, b from `t` where x = 2 and y = \"b\" LIMIT 5",
+		},
+		text: "SELECT `a`, `b` FROM `t` WHERE `x` = ? AND `y` = ? LIMIT ?",
+	}, {
+		sqls: []string{
+			"select * from t where id in (1, 2, 3)",
+			"select * from t where id in (4)",
+			"select * from t where id in ::ids",
+		},
+		text: "SELECT * FROM `t` WHERE `id` IN (...)",
+	}, {
+		sqls: []string{
+			"insert into t(a, b) values (1, 'x')",
+			"insert into t(a, b) values (2, 'y'), (3, null), (:a, :b)",
+		},
+		text: "INSERT INTO `t`(`a`, `b`) VALUES (...)",
+	}, {
+		sqls: []string{
+			"select * from t where a in (b, 1)",
+		},
+		text: "SELECT * FROM `t` WHERE `a` IN (`b`, ?)",
+	}, {
+		sqls: []string{
+			"update t set a = 1 where b in (select c from u where d = 'x')",
+		},
+		text: "UPDATE `t` SET `a` = ? WHERE `b` IN (SELECT `c` FROM `u` WHERE `d` = ?)",
+	}, {
+		sqls: []string{
+			"select a - 1 from t where b = 1 and c in (1, 2)",
+			"select a - -1 from t where b = -1 and c in (-1, +2.5)",
+			"select a - - -1 from t where b = - :x and c in (- 1)",
+		},
+		text: "SELECT `a` - ? FROM `t` WHERE `b` = ? AND `c` IN (...)",
+	}, {
+		sqls: []string{
+			"select -a, 1 - 2 from t",
+		},
+		text: "SELECT -`a`, ? - ? FROM `t`",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.text, func(t *testing.T) {
+			var first Digest
+			for i, sql := range tcase.sqls {
+				digest, err := FingerprintString(sql)
+				require.NoError(t, err, sql)
+				assert.Equal(t, tcase.text, digest.Text, sql)
+				if i == 0 {
+					first = digest
+					continue
+				}
+				assert.Equal(t, first.Hash, digest.Hash, sql)
+				assert.Equal(t, first.Hash64(), digest.Hash64(), sql)
+			}
+		})
+	}
+}
+
+func TestFingerprintStatement(t *testing.T) {
+	stmt, err := Parse("select a from t where b = 1")
+	require.NoError(t, err)
+	digest := Fingerprint(stmt)
+	assert.Equal(t, "SELECT `a` FROM `t` WHERE `b` = ?", digest.Text)
+	assert.Len(t, digest.String(), 64)
+	assert.Equal(t, "select a from t where b = 1", String(stmt), "the statement must not be modified")
+
+	other, err := FingerprintString("select a from t where c = 1")
+	require.NoError(t, err)
+	assert.NotEqual(t, digest.String(), other.String())
+}
+
+func TestFingerprintTokens(t *testing.T) {
+	testcases := []struct {
+		sql  string
+		text string
+	}{{
+		sql:  "selec a, b from t where c in (1, 2, 3) and d = 'x'",
+		text: "`selec` `a`, `b` FROM `t` WHERE `c` IN (...) AND `d` = ?",
+	}, {
+		sql:  "insert into t (a, b) values (1, 2), (3, 4) on duplicate keys",
+		text: "INSERT INTO `t`(`a`, `b`) VALUES (...) ON DUPLICATE KEYS",
+	}, {
+		sql:  "show @a /* c */, @@b from t where x <= f(1) -- c",
+		text: "SHOW @`a`, @@`b` FROM `t` WHERE `x` <= `f`(?)",
+	}, {
+		sql:  "selec a - 1, -2, f(-3) from t where b = - -4",
+		text: "`selec` `a` - ?, ?, `f`(?) FROM `t` WHERE `b` = ?",
+	}, {
+		sql:  "selec count(*), max(b) from t where c in (select d from u) and exists (select 1)",
+		text: "`selec` COUNT(*), MAX(`b`) FROM `t` WHERE `c` IN (SELECT `d` FROM `u`) AND EXISTS (SELECT ?)",
+	}, {
+		sql:  "show @'a b' from t",
+		text: "SHOW @`a b` FROM `t`",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.sql, func(t *testing.T) {
+			_, err := Parse(tcase.sql)
+			require.Error(t, err, "the statement must not parse")
+			digest, err := FingerprintString(tcase.sql)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.text, digest.Text)
+		})
+	}
+
+	_, err := FingerprintString("select 'unterminated")
+	require.Error(t, err)
+
+	// the tokens are the ones of the dialect of the parser
+	parser, err := New(Options{Dialect: PostgresDialect{}})
+	require.NoError(t, err)
+	digest, err := parser.FingerprintString(`select 'a\' from`)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT ? FROM", digest.Text)
+
+	// and so is the quoting of the identifiers
+	digest, err = parser.FingerprintString(`select "A", b from`)
+	require.NoError(t, err)
+	assert.Equal(t, `SELECT "A", "b" FROM`, digest.Text)
+	digest, err = parser.FingerprintString(`select "A", count(*) from t`)
+	require.NoError(t, err)
+	assert.Equal(t, `SELECT "A", COUNT(*) FROM "t"`, digest.Text)
+}
+
+// TestFingerprintParsed checks that a statement has the same digest from its
+// text and from its parsed statement, in both dialects.
+func TestFingerprintParsed(t *testing.T) {
+	postgres, err := New(Options{Dialect: PostgresDialect{}})
+	require.NoError(t, err)
+	for _, parser := range []*Parser{defaultParser.Load(), postgres} {
+		for _, tcase := range validSQL {
+			stmt, err := parser.Parse(tcase.input)
+			if err != nil {
+				continue
+			}
+			digest, err := parser.FingerprintString(tcase.input)
+			require.NoError(t, err, tcase.input)
+			assert.Equal(t, parser.Fingerprint(stmt), digest, tcase.input)
+		}
+	}
+}
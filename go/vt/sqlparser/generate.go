@@ -16,8 +16,8 @@ limitations under the License.
 
 package sqlparser
 
-// Generate all the AST helpers using the tooling in `go/tools`
+// Generate all the AST helpers using the tooling in `asthelpergen` and `go/tools`
 
 //go:generate go run ./goyacc -fo sql.go sql.y
-//go:generate go run ../../tools/asthelpergen/main  --in . --iface vitess.io/vitess/go/vt/sqlparser.SQLNode --clone_exclude "*ColName" --equals_custom "*ColName"
-//go:generate go run ../../tools/astfmtgen vitess.io/vitess/go/vt/sqlparser/...
+//go:generate go run ./asthelpergen/main --in . --iface github.com/kanzihuang/vitess/go/vt/sqlparser.SQLNode --clone_exclude "*ColName" --equals_custom "*ColName"
+//go:generate go run vitess.io/vitess/go/tools/astfmtgen github.com/kanzihuang/vitess/go/vt/sqlparser/...
@@ -3,9 +3,12 @@ module github.com/kanzihuang/vitess/go/vt/sqlparser
 go 1.20
 
 require (
+	github.com/dave/jennifer v1.6.0
 	github.com/google/go-cmp v0.5.9
 	github.com/spf13/pflag v1.0.5
 	github.com/stretchr/testify v1.8.4
+	golang.org/x/exp v0.0.0-20230131160201-f062dba9d201
+	golang.org/x/tools v0.5.0
 	vitess.io/vitess v0.17.3
 )
 
@@ -14,7 +17,8 @@ require (
 	github.com/golang/glog v1.0.0 // indirect
 	github.com/golang/protobuf v1.5.2 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	golang.org/x/exp v0.0.0-20230131160201-f062dba9d201 // indirect
+	golang.org/x/mod v0.7.0 // indirect
+	golang.org/x/sys v0.5.0 // indirect
 	golang.org/x/text v0.7.0 // indirect
 	google.golang.org/genproto v0.0.0-20230131230820-1c016267d619 // indirect
 	google.golang.org/grpc v1.52.3 // indirect
@@ -3375,6 +3375,31 @@ type $$Lexer interface {
 	Error(s string)
 }
 
+// $$LexerEx is an optional extension of $$Lexer. When the lexer implements it
+// and Tracking returns true, Shifted is called after the parser shifts the
+// lookahead token, or the error token when it recovers from a syntax error,
+// and Reduced after every reduction with the number of
+// symbols of the right-hand side of the production and the value that was
+// computed for it. Both get the depth of the new symbol in the stack of the
+// parser, which lets the lexer keep its own data about the symbols.
+type $$LexerEx interface {
+	$$Lexer
+	Tracking() bool
+	Shifted(depth int)
+	Reduced(rule, depth, n int, val *$$SymType)
+}
+
+// $$LexerErr is an optional extension of $$Lexer. When the lexer implements
+// it and Diagnose returns true, SyntaxError is called before Error when the
+// parser finds a syntax error, with the lookahead token and the stack of
+// states of the parser when it read it, before the default reductions that
+// led to the error.
+type $$LexerErr interface {
+	$$Lexer
+	Diagnose() bool
+	SyntaxError(states []int, lookahead int)
+}
+
 type $$Parser interface {
 	Parse($$Lexer) int
 	Lookahead() int
@@ -3523,6 +3548,15 @@ func ($$rcvr *$$ParserImpl) Parse($$lex $$Lexer) int {
 	var $$Dollar []$$SymType
 	_ = $$Dollar // silence set and not used
 	$$S := $$rcvr.stack[:]
+	$$lexEx, _ := $$lex.($$LexerEx)
+	if $$lexEx != nil && !$$lexEx.Tracking() {
+		$$lexEx = nil
+	}
+	$$lexErr, _ := $$lex.($$LexerErr)
+	if $$lexErr != nil && !$$lexErr.Diagnose() {
+		$$lexErr = nil
+	}
+	var $$lexStates []int // the states when the lookahead was read
 
 	Nerrs := 0   /* number of errors */
 	Errflag := 0 /* error recovery flag */
@@ -3566,6 +3600,12 @@ $$newstate:
 	}
 	if $$rcvr.char < 0 {
 		$$rcvr.char, $$token = $$lex1($$lex, &$$rcvr.lval)
+		if $$lexErr != nil {
+			$$lexStates = $$lexStates[:0]
+			for _, sym := range $$S[:$$p+1] {
+				$$lexStates = append($$lexStates, sym.yys)
+			}
+		}
 	}
 	$$n += $$token
 	if $$n < 0 || $$n >= $$Last {
@@ -3580,6 +3620,9 @@ $$newstate:
 		if Errflag > 0 {
 			Errflag--
 		}
+		if $$lexEx != nil {
+			$$lexEx.Shifted($$p + 1)
+		}
 		goto $$stack
 	}
 
@@ -3589,6 +3632,12 @@ $$default:
 	if $$n == -2 {
 		if $$rcvr.char < 0 {
 			$$rcvr.char, $$token = $$lex1($$lex, &$$rcvr.lval)
+			if $$lexErr != nil {
+				$$lexStates = $$lexStates[:0]
+				for _, sym := range $$S[:$$p+1] {
+					$$lexStates = append($$lexStates, sym.yys)
+				}
+			}
 		}
 
 		/* look through exception table */
@@ -3614,6 +3663,9 @@ $$default:
 		/* error ... attempt to resume parsing */
 		switch Errflag {
 		case 0: /* brand new error */
+			if $$lexErr != nil {
+				$$lexErr.SyntaxError($$lexStates, $$token)
+			}
 			$$lex.Error($$ErrorMessage($$state, $$token))
 			Nerrs++
 			if $$Debug >= 1 {
@@ -3631,6 +3683,9 @@ $$default:
 				if $$n >= 0 && $$n < $$Last {
 					$$state = $$Act[$$n] /* simulate a shift of "error" */
 					if $$Chk[$$state] == $$ErrCode {
+						if $$lexEx != nil {
+							$$lexEx.Shifted($$p + 1)
+						}
 						goto $$stack
 					}
 				}
@@ -3691,6 +3746,9 @@ $$default:
 	}
 	// dummy call; replaced with literal code
 	$$run()
+	if $$lexEx != nil {
+		$$lexEx.Reduced($$nt, $$p+1, $$pt-$$p, &$$VAL)
+	}
 	goto $$stack /* stack new state and value */
 }
 `
@@ -38,21 +38,18 @@ func FormatImpossibleQuery(buf *TrackedBuffer, node SQLNode) {
 			node.GroupBy.Format(buf)
 		}
 	case *Union:
-		if requiresParen(node.Left) {
+		leftParens, rightParens := setOpParens(node)
+		if leftParens {
 			buf.astPrintf(node, "(%v)", node.Left)
 		} else {
 			buf.astPrintf(node, "%v", node.Left)
 		}
 
 		buf.WriteString(" ")
-		if node.Distinct {
-			buf.WriteString(UnionStr)
-		} else {
-			buf.WriteString(UnionAllStr)
-		}
+		buf.WriteString(node.operator())
 		buf.WriteString(" ")
 
-		if requiresParen(node.Right) {
+		if rightParens {
 			buf.astPrintf(node, "(%v)", node.Right)
 		} else {
 			buf.astPrintf(node, "%v", node.Right)
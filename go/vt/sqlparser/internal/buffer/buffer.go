@@ -2,6 +2,8 @@ package buffer
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"io"
 )
 
@@ -20,6 +22,45 @@ type Buffer struct {
 	cache       *bytes.Buffer
 	cacheOffset int
 	CacheBlanks bool
+	err         error
+
+	// line tracking, only maintained when enabled through WithLines
+	lines     bool
+	counted   int
+	line      int
+	lineStart int
+}
+
+// ErrInvalidPosition is reported by Err when a character is peeked past the
+// data the buffer can hold.
+var ErrInvalidPosition = errors.New("sqlparser: invalid buffer position")
+
+// ReadError is the error of the underlying reader of a Buffer, along with
+// the absolute offset of the first byte that could not be read.
+//
+// A ReadError ends the input, unless the reader failed with a temporary
+// error, such as a timeout, and Retry is called.
+type ReadError struct {
+	Offset int
+	Err    error
+}
+
+func (e *ReadError) Error() string {
+	return fmt.Sprintf("sqlparser: read error at offset %d: %v", e.Offset, e.Err)
+}
+
+func (e *ReadError) Unwrap() error {
+	return e.Err
+}
+
+// CacheError is returned when the cache of a Buffer that was created
+// without WithCache is read or reset.
+type CacheError struct {
+	Op string
+}
+
+func (e *CacheError) Error() string {
+	return fmt.Sprintf("sqlparser: %s from null cache of tokenizer buffer", e.Op)
 }
 
 func NewStringBuffer(sql string) *Buffer {
@@ -49,6 +90,14 @@ func WithoutCache() BufferOpt {
 	}
 }
 
+// WithLines enables line and column tracking, see LineCol.
+func WithLines() BufferOpt {
+	return func(buffer *Buffer) {
+		buffer.lines = true
+		buffer.line = 1
+	}
+}
+
 func NewReaderBuffer(reader io.Reader, opts ...BufferOpt) *Buffer {
 	buf := &Buffer{
 		reader: reader,
@@ -67,24 +116,69 @@ func (tb *Buffer) AbsoluteStart() int {
 	return tb.offset + tb.pos
 }
 
+// LineCol returns the 1-based line and column of the given absolute offset.
+// Offsets must be requested in non-decreasing order, and must not precede the
+// start of the current token, as the text before it may already be discarded.
+// It returns zeros if line tracking was not enabled through WithLines.
+func (tb *Buffer) LineCol(abs int) (int, int) {
+	if !tb.lines {
+		return 0, 0
+	}
+	tb.countLines(abs)
+	return tb.line, abs - tb.lineStart + 1
+}
+
+// countLines counts the newlines between the last counted offset and abs.
+func (tb *Buffer) countLines(abs int) {
+	if end := tb.offset + len(tb.buf); abs > end {
+		abs = end
+	}
+	for ; tb.counted < abs; tb.counted++ {
+		if tb.buf[tb.counted-tb.offset] == '\n' {
+			tb.line++
+			tb.lineStart = tb.counted + 1
+		}
+	}
+}
+
 func (tb *Buffer) Cur() uint16 {
 	return tb.Peek(0)
 }
 
+// Peek returns the character at the given distance from the current
+// position, or eofChar past the end of the input. A failure of the reader,
+// and a position past the data the buffer can hold, also return eofChar;
+// the error is reported by Err.
 func (tb *Buffer) Peek(dist int) uint16 {
 	if tb.pos+dist >= len(tb.buf) {
-		if err := tb.load(); err == io.EOF {
-			return eofChar
-		} else if err != nil {
-			panic("sqlparser: " + err.Error())
-		}
+		err := tb.load()
 		if tb.pos+dist >= len(tb.buf) {
-			panic("sqlparser: invalid buffer position")
+			if err == nil && !tb.eof {
+				tb.err = ErrInvalidPosition
+			}
+			return eofChar
 		}
 	}
 	return uint16(tb.buf[tb.pos+dist])
 }
 
+// Err returns the *ReadError of the underlying reader, or
+// ErrInvalidPosition, if any.
+func (tb *Buffer) Err() error {
+	return tb.err
+}
+
+// Retry clears the *ReadError of a temporary failure of the reader, so that
+// the next Peek past the loaded data reads again, and reports whether it did.
+func (tb *Buffer) Retry() bool {
+	var readErr *ReadError
+	if tb.eof || !errors.As(tb.err, &readErr) {
+		return false
+	}
+	tb.err = nil
+	return true
+}
+
 func (tb *Buffer) Skip(dist int) {
 	if tb.cache != nil {
 		tb.cache.Write(tb.buf[tb.start : tb.pos+dist])
@@ -132,31 +226,32 @@ func (tb *Buffer) ReadBuffer() string {
 	return string(result)
 }
 
-func (tb *Buffer) ReadCache() string {
+func (tb *Buffer) ReadCache() (string, error) {
 	if tb.cache == nil && tb.reader == nil {
 		result := string(tb.buf[tb.cacheOffset:tb.AbsolutePos()])
 		tb.cacheOffset = tb.AbsolutePos()
-		return result
+		return result, nil
 	}
 
 	if tb.cache == nil {
-		panic("read from null cache of in tokenizer buffer")
+		return "", &CacheError{Op: "read"}
 	}
 
 	result := tb.cache.String()
 	tb.cache.Reset()
-	return result
+	return result, nil
 }
 
-func (tb *Buffer) ResetCache() {
+func (tb *Buffer) ResetCache() error {
 	if tb.cache == nil && tb.reader == nil {
 		tb.cacheOffset = tb.AbsolutePos()
-		return
+		return nil
 	}
 	if tb.cache == nil {
-		panic("reset from null cache of in tokenizer buffer")
+		return &CacheError{Op: "reset"}
 	}
 	tb.cache.Reset()
+	return nil
 }
 
 func (tb *Buffer) Next() {
@@ -167,6 +262,10 @@ func (tb *Buffer) load() error {
 	if tb.reader == nil || tb.eof {
 		return io.EOF
 	}
+	if tb.err != nil {
+		// a temporary failure of the reader is only retried by Retry
+		return tb.err
+	}
 
 	buf := tb.buf
 	size := len(buf)
@@ -176,6 +275,10 @@ func (tb *Buffer) load() error {
 	if size > len(buf) {
 		buf = make([]byte, size)
 	}
+	if tb.lines {
+		// the text before start is about to be discarded
+		tb.countLines(tb.offset + tb.start)
+	}
 	copy(buf, tb.buf[tb.start:])
 
 	tb.offset += tb.start
@@ -195,8 +298,24 @@ func (tb *Buffer) load() error {
 			}
 			break
 		} else if err != nil {
-			return err
+			// the input ends where the reader failed, unless the
+			// failure is temporary
+			tb.eof = !isTemporary(err)
+			tb.buf = tb.buf[:size]
+			tb.err = &ReadError{Offset: tb.offset + size, Err: err}
+			return tb.err
 		}
 	}
 	return nil
 }
+
+// isTemporary reports whether the reader may succeed if it is retried
+// after the error, as after a timeout.
+func isTemporary(err error) bool {
+	var timeout interface{ Timeout() bool }
+	if errors.As(err, &timeout) && timeout.Timeout() {
+		return true
+	}
+	var temporary interface{ Temporary() bool }
+	return errors.As(err, &temporary) && temporary.Temporary()
+}
@@ -1,9 +1,13 @@
 package buffer
 
 import (
+	"errors"
+	"fmt"
 	"github.com/stretchr/testify/require"
+	"io"
 	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 func Test_Peek(t *testing.T) {
@@ -111,3 +115,114 @@ func Test_Peek(t *testing.T) {
 //		})
 //	}
 //}
+
+func Test_LineCol(t *testing.T) {
+	sql := "select 1\nfrom dual;\n\nselect\n  2"
+	buf := NewReaderBuffer(iotest.OneByteReader(strings.NewReader(sql)), WithLines())
+	var got []string
+	for buf.Cur() != eofChar {
+		abs := buf.AbsolutePos()
+		if sql[abs] == 's' || sql[abs] == 'd' || sql[abs] == '2' {
+			line, col := buf.LineCol(abs)
+			got = append(got, fmt.Sprintf("%d:%d", line, col))
+		}
+		buf.Skip(1)
+	}
+	require.Equal(t, []string{"1:1", "2:6", "4:1", "5:3"}, got)
+
+	line, col := NewStringBuffer(sql).LineCol(3)
+	require.Zero(t, line)
+	require.Zero(t, col)
+}
+
+func Test_ReadError(t *testing.T) {
+	readErr := errors.New("connection reset")
+	reader := io.MultiReader(strings.NewReader("select"), iotest.ErrReader(readErr))
+	buf := NewReaderBuffer(reader)
+	for i := 0; i < len("select"); i++ {
+		require.NotEqual(t, uint16(eofChar), buf.Cur())
+		buf.Next()
+	}
+	require.Equal(t, uint16(eofChar), buf.Cur())
+	require.Equal(t, uint16(eofChar), buf.Peek(1))
+
+	var err *ReadError
+	require.ErrorAs(t, buf.Err(), &err)
+	require.Equal(t, 6, err.Offset)
+	require.ErrorIs(t, buf.Err(), readErr)
+	require.Equal(t, "select", buf.ReadBuffer())
+	require.False(t, buf.Retry())
+	require.ErrorIs(t, buf.Err(), readErr)
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string { return "i/o timeout" }
+func (timeoutError) Timeout() bool { return true }
+
+// timeoutReader times out on its first read.
+type timeoutReader struct {
+	reader io.Reader
+	reads  int
+}
+
+func (r *timeoutReader) Read(p []byte) (int, error) {
+	r.reads++
+	if r.reads == 1 {
+		return 0, timeoutError{}
+	}
+	return r.reader.Read(p)
+}
+
+func Test_ReadErrorTemporary(t *testing.T) {
+	buf := NewReaderBuffer(&timeoutReader{reader: strings.NewReader("select 1")})
+	require.Equal(t, uint16(eofChar), buf.Cur())
+	var err *ReadError
+	require.ErrorAs(t, buf.Err(), &err)
+	require.Equal(t, 0, err.Offset)
+	require.ErrorIs(t, buf.Err(), timeoutError{})
+
+	// the error is kept until the reader is retried
+	require.Equal(t, uint16(eofChar), buf.Cur())
+	require.ErrorIs(t, buf.Err(), timeoutError{})
+	require.True(t, buf.Retry())
+	var got []byte
+	for buf.Cur() != eofChar {
+		got = append(got, byte(buf.Cur()))
+		buf.Next()
+	}
+	require.NoError(t, buf.Err())
+	require.Equal(t, "select 1", string(got))
+}
+
+func Test_InvalidPosition(t *testing.T) {
+	buf := NewReaderBuffer(strings.NewReader(strings.Repeat("a", 2*defaultBufferSize)))
+	require.Equal(t, uint16('a'), buf.Peek(defaultBufferSize-1))
+	require.NoError(t, buf.Err())
+	require.Equal(t, uint16(eofChar), buf.Peek(defaultBufferSize))
+	require.ErrorIs(t, buf.Err(), ErrInvalidPosition)
+	require.Equal(t, uint16(eofChar), buf.Peek(defaultBufferSize))
+	require.False(t, buf.Retry())
+	require.ErrorIs(t, buf.Err(), ErrInvalidPosition)
+}
+
+func Test_CacheError(t *testing.T) {
+	buf := NewReaderBuffer(strings.NewReader("select 1"))
+	_, err := buf.ReadCache()
+	var cacheErr *CacheError
+	require.ErrorAs(t, err, &cacheErr)
+	require.Equal(t, "read", cacheErr.Op)
+
+	err = buf.ResetCache()
+	require.ErrorAs(t, err, &cacheErr)
+	require.Equal(t, "reset", cacheErr.Op)
+
+	buf = NewReaderBuffer(strings.NewReader("select 1"), WithCache())
+	require.Equal(t, uint16('s'), buf.Cur())
+	buf.Next()
+	buf.Skip(0)
+	cache, err := buf.ReadCache()
+	require.NoError(t, err)
+	require.Equal(t, "s", cache)
+	require.NoError(t, buf.ResetCache())
+}
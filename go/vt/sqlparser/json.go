@@ -0,0 +1,222 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// jsonTypeKey is the key of the type tag of the encoded nodes.
+const jsonTypeKey = "@type"
+
+// MarshalNode returns the JSON encoding of an AST. The nodes with fields
+// are encoded as objects with their fields, and a "@type" key holding the
+// name of their type. The other nodes, like the lists, are encoded as
+// their plain value, unless the type of the field holding them is an
+// interface: they are then encoded as an object with a "@type" and a
+// "Value" key. The node given to MarshalNode is always tagged.
+func MarshalNode(node SQLNode) ([]byte, error) {
+	enc := &jsonEncoder{}
+	enc.SQLNode(node)
+	if enc.err != nil {
+		return nil, enc.err
+	}
+	return enc.buf.Bytes(), nil
+}
+
+// UnmarshalNode decodes an AST encoded by MarshalNode.
+func UnmarshalNode(data []byte) (SQLNode, error) {
+	dec := &jsonDecoder{}
+	node := dec.SQLNode(data)
+	if dec.err != nil {
+		return nil, dec.err
+	}
+	return node, nil
+}
+
+// UnmarshalStatement decodes a statement encoded by MarshalNode.
+func UnmarshalStatement(data []byte) (Statement, error) {
+	dec := &jsonDecoder{}
+	stmt := dec.Statement(data)
+	if dec.err != nil {
+		return nil, dec.err
+	}
+	return stmt, nil
+}
+
+// UnmarshalExpr decodes an expression encoded by MarshalNode.
+func UnmarshalExpr(data []byte) (Expr, error) {
+	dec := &jsonDecoder{}
+	expr := dec.Expr(data)
+	if dec.err != nil {
+		return nil, dec.err
+	}
+	return expr, nil
+}
+
+// jsonEncoder writes the JSON encoding of an AST. Its methods for the
+// types of the AST are generated in ast_json.go.
+type jsonEncoder struct {
+	buf bytes.Buffer
+	// first is whether the next field is the first one of its object.
+	first bool
+	err   error
+}
+
+func (enc *jsonEncoder) null() {
+	enc.buf.WriteString("null")
+}
+
+func (enc *jsonEncoder) value(v any) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		if enc.err == nil {
+			enc.err = err
+		}
+		enc.null()
+		return
+	}
+	enc.buf.Write(b)
+}
+
+func (enc *jsonEncoder) openObject() {
+	enc.buf.WriteByte('{')
+	enc.first = true
+}
+
+func (enc *jsonEncoder) openNode(tag string) {
+	enc.openObject()
+	enc.field(jsonTypeKey)
+	enc.value(tag)
+}
+
+func (enc *jsonEncoder) field(name string) {
+	if !enc.first {
+		enc.buf.WriteByte(',')
+	}
+	enc.first = false
+	enc.value(name)
+	enc.buf.WriteByte(':')
+}
+
+func (enc *jsonEncoder) closeObject() {
+	enc.buf.WriteByte('}')
+	enc.first = false
+}
+
+func (enc *jsonEncoder) openArray() {
+	enc.buf.WriteByte('[')
+}
+
+func (enc *jsonEncoder) element(i int) {
+	if i > 0 {
+		enc.buf.WriteByte(',')
+	}
+}
+
+func (enc *jsonEncoder) closeArray() {
+	enc.buf.WriteByte(']')
+}
+
+func (enc *jsonEncoder) unknownType(node SQLNode) {
+	if enc.err == nil {
+		enc.err = fmt.Errorf("cannot encode node of type %T", node)
+	}
+	enc.null()
+}
+
+// jsonDecoder decodes the JSON encoding of an AST. Its methods for the
+// types of the AST are generated in ast_json.go. The first error stops the
+// decoding, and the methods return zero values from then on.
+type jsonDecoder struct {
+	err error
+}
+
+func (dec *jsonDecoder) fail(err error) {
+	if dec.err == nil {
+		dec.err = err
+	}
+}
+
+// isNull returns whether the data is null or missing.
+func isNull(data json.RawMessage) bool {
+	return len(data) == 0 || string(bytes.TrimSpace(data)) == "null"
+}
+
+func (dec *jsonDecoder) value(data json.RawMessage, v any) {
+	if dec.err != nil || isNull(data) {
+		return
+	}
+	dec.fail(json.Unmarshal(data, v))
+}
+
+// object returns the fields of an object, checking its tag unless tag is
+// empty. It returns nil for null.
+func (dec *jsonDecoder) object(data json.RawMessage, tag string) map[string]json.RawMessage {
+	if dec.err != nil || isNull(data) {
+		return nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		dec.fail(err)
+		return nil
+	}
+	if tag != "" {
+		var actual string
+		dec.value(fields[jsonTypeKey], &actual)
+		if actual != tag {
+			dec.fail(fmt.Errorf("expected node of type %s, got %q", tag, actual))
+			return nil
+		}
+	}
+	return fields
+}
+
+// node returns the tag and the fields of a node. The tag is empty for null.
+func (dec *jsonDecoder) node(data json.RawMessage) (string, map[string]json.RawMessage) {
+	fields := dec.object(data, "")
+	if fields == nil {
+		return "", nil
+	}
+	var tag string
+	dec.value(fields[jsonTypeKey], &tag)
+	if tag == "" {
+		dec.fail(fmt.Errorf("missing %s of node", jsonTypeKey))
+	}
+	return tag, fields
+}
+
+// array returns the elements of an array, or nil for null.
+func (dec *jsonDecoder) array(data json.RawMessage) []json.RawMessage {
+	if dec.err != nil || isNull(data) {
+		return nil
+	}
+	var elements []json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		dec.fail(err)
+		return nil
+	}
+	return elements
+}
+
+func (dec *jsonDecoder) unknownTag(tag, iface string) {
+	if tag != "" {
+		dec.fail(fmt.Errorf("unknown %s node type %q", iface, tag))
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMarshalNodeValidSQL checks that the valid test cases round trip
+// through JSON.
+func TestMarshalNodeValidSQL(t *testing.T) {
+	for _, tcase := range validSQL {
+		stmt, err := Parse(tcase.input)
+		require.NoError(t, err, tcase.input)
+
+		data, err := MarshalNode(stmt)
+		require.NoError(t, err, tcase.input)
+		require.True(t, json.Valid(data), "%s\n%s", tcase.input, data)
+
+		decoded, err := UnmarshalStatement(data)
+		require.NoError(t, err, "%s\n%s", tcase.input, data)
+		require.True(t, Equals.Statement(stmt, decoded), "%s\n%s", tcase.input, data)
+		require.Equal(t, String(stmt), String(decoded))
+	}
+}
+
+func TestMarshalNode(t *testing.T) {
+	expr, err := ParseExpr("a = 1 and b in (2, :c)")
+	require.NoError(t, err)
+	data, err := MarshalNode(expr)
+	require.NoError(t, err)
+
+	var tree struct {
+		Type  string `json:"@type"`
+		Right struct {
+			Type  string `json:"@type"`
+			Right struct {
+				Type  string `json:"@type"`
+				Value []struct {
+					Type string `json:"@type"`
+				}
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(data, &tree))
+	assert.Equal(t, "AndExpr", tree.Type)
+	assert.Equal(t, "ComparisonExpr", tree.Right.Type)
+	assert.Equal(t, "ValTuple", tree.Right.Right.Type)
+	require.Len(t, tree.Right.Right.Value, 2)
+	assert.Equal(t, "Literal", tree.Right.Right.Value[0].Type)
+	assert.Equal(t, "Argument", tree.Right.Right.Value[1].Type)
+
+	decoded, err := UnmarshalExpr(data)
+	require.NoError(t, err)
+	assert.True(t, Equals.Expr(expr, decoded))
+
+	node, err := UnmarshalNode(data)
+	require.NoError(t, err)
+	assert.True(t, Equals.SQLNode(expr, node))
+
+	// a list given directly is tagged too
+	data, err = MarshalNode(Columns{NewIdentifierCI("a")})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"@type": "Columns", "Value": ["a"]}`, string(data))
+
+	data, err = MarshalNode(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+	node, err = UnmarshalNode(data)
+	require.NoError(t, err)
+	assert.Nil(t, node)
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	testcases := []struct {
+		data string
+		err  string
+	}{{
+		data: `{"@type": "Nope"}`,
+		err:  `unknown Statement node type "Nope"`,
+	}, {
+		data: `{"Left": null}`,
+		err:  `missing @type of node`,
+	}, {
+		data: `{"@type": "Select", "From": [{"@type": "AndExpr"}]}`,
+		err:  `unknown TableExpr node type "AndExpr"`,
+	}, {
+		data: `{"@type": "Select", "Where": {"@type": "Limit"}}`,
+		err:  `expected node of type Where, got "Limit"`,
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.data, func(t *testing.T) {
+			_, err := UnmarshalStatement([]byte(tcase.data))
+			require.EqualError(t, err, tcase.err)
+		})
+	}
+
+	// the errors of encoding/json are returned as is
+	var typeErr *json.UnmarshalTypeError
+	_, err := UnmarshalStatement([]byte(`{"@type": "Select", "Distinct": "yes"}`))
+	require.ErrorAs(t, err, &typeErr)
+	_, err = UnmarshalStatement([]byte(`[1]`))
+	require.ErrorAs(t, err, &typeErr)
+	var syntaxErr *json.SyntaxError
+	_, err = UnmarshalStatement([]byte(`{"@type": "Select",`))
+	require.ErrorAs(t, err, &syntaxErr)
+}
@@ -115,9 +115,11 @@ var keywords = []keyword{
 	{"_utf8mb4", UNDERSCORE_UTF8MB4},
 	{"_utf8mb3", UNDERSCORE_UTF8MB3},
 	{"accessible", UNUSED},
+	{"account", ACCOUNT},
 	{"action", ACTION},
 	{"add", ADD},
 	{"adddate", ADDDATE},
+	{"admin", ADMIN},
 	{"after", AFTER},
 	{"against", AGAINST},
 	{"algorithm", ALGORITHM},
@@ -131,6 +133,7 @@ var keywords = []keyword{
 	{"asc", ASC},
 	{"ascii", ASCII},
 	{"asensitive", UNUSED},
+	{"at", AT},
 	{"auto_increment", AUTO_INCREMENT},
 	{"autoextend_size", AUTOEXTEND_SIZE},
 	{"avg", AVG},
@@ -164,6 +167,7 @@ var keywords = []keyword{
 	{"check", CHECK},
 	{"checksum", CHECKSUM},
 	{"cleanup", CLEANUP},
+	{"close", CLOSE},
 	{"coalesce", COALESCE},
 	{"code", CODE},
 	{"collate", COLLATE},
@@ -176,13 +180,16 @@ var keywords = []keyword{
 	{"commit", COMMIT},
 	{"compact", COMPACT},
 	{"complete", COMPLETE},
+	{"completion", COMPLETION},
 	{"compressed", COMPRESSED},
 	{"compression", COMPRESSION},
-	{"condition", UNUSED},
+	{"concurrent", CONCURRENT},
+	{"condition", CONDITION},
 	{"connection", CONNECTION},
 	{"consistent", CONSISTENT},
 	{"constraint", CONSTRAINT},
-	{"continue", UNUSED},
+	{"contains", CONTAINS},
+	{"continue", CONTINUE},
 	{"convert", CONVERT},
 	{"copy", COPY},
 	{"count", COUNT},
@@ -202,7 +209,7 @@ var keywords = []keyword{
 	{"curtime", CURTIME},
 	{"current_timestamp", CURRENT_TIMESTAMP},
 	{"current_user", CURRENT_USER},
-	{"cursor", UNUSED},
+	{"cursor", CURSOR},
 	{"data", DATA},
 	{"database", DATABASE},
 	{"databases", DATABASES},
@@ -218,7 +225,7 @@ var keywords = []keyword{
 	{"deallocate", DEALLOCATE},
 	{"dec", UNUSED},
 	{"decimal", DECIMAL_TYPE},
-	{"declare", UNUSED},
+	{"declare", DECLARE},
 	{"default", DEFAULT},
 	{"definer", DEFINER},
 	{"delay_key_write", DELAY_KEY_WRITE},
@@ -227,7 +234,7 @@ var keywords = []keyword{
 	{"dense_rank", DENSE_RANK},
 	{"desc", DESC},
 	{"describe", DESCRIBE},
-	{"deterministic", UNUSED},
+	{"deterministic", DETERMINISTIC},
 	{"directory", DIRECTORY},
 	{"disable", DISABLE},
 	{"discard", DISCARD},
@@ -241,15 +248,16 @@ var keywords = []keyword{
 	{"dumpfile", DUMPFILE},
 	{"duplicate", DUPLICATE},
 	{"dynamic", DYNAMIC},
-	{"each", UNUSED},
+	{"each", EACH},
 	{"else", ELSE},
-	{"elseif", UNUSED},
+	{"elseif", ELSEIF},
 	{"empty", EMPTY},
 	{"enable", ENABLE},
 	{"enclosed", ENCLOSED},
 	{"encryption", ENCRYPTION},
 	{"end", END},
 	{"endpoint", ST_EndPoint},
+	{"ends", ENDS},
 	{"enforced", ENFORCED},
 	{"engine", ENGINE},
 	{"engine_attribute", ENGINE_ATTRIBUTE},
@@ -259,11 +267,13 @@ var keywords = []keyword{
 	{"escape", ESCAPE},
 	{"escaped", ESCAPED},
 	{"event", EVENT},
+	{"every", EVERY},
+	{"except", EXCEPT},
 	{"exchange", EXCHANGE},
 	{"exclusive", EXCLUSIVE},
 	{"execute", EXECUTE},
 	{"exists", EXISTS},
-	{"exit", UNUSED},
+	{"exit", EXIT},
 	{"explain", EXPLAIN},
 	{"expansion", EXPANSION},
 	{"expire", EXPIRE},
@@ -272,7 +282,7 @@ var keywords = []keyword{
 	{"extract", EXTRACT},
 	{"extractvalue", ExtractValue},
 	{"false", FALSE},
-	{"fetch", UNUSED},
+	{"fetch", FETCH},
 	{"fields", FIELDS},
 	{"first", FIRST},
 	{"first_value", FIRST_VALUE},
@@ -282,12 +292,14 @@ var keywords = []keyword{
 	{"float8", FLOAT8_TYPE},
 	{"flush", FLUSH},
 	{"following", FOLLOWING},
+	{"follows", FOLLOWS},
 	{"for", FOR},
 	{"force", FORCE},
 	{"foreign", FOREIGN},
 	{"format", FORMAT},
 	{"format_bytes", FORMAT_BYTES},
 	{"format_pico_time", FORMAT_PICO_TIME},
+	{"found", FOUND},
 	{"from", FROM},
 	{"full", FULL},
 	{"fulltext", FULLTEXT},
@@ -304,11 +316,12 @@ var keywords = []keyword{
 	{"gtid_executed", GTID_EXECUTED},
 	{"gtid_subset", GTID_SUBSET},
 	{"gtid_subtract", GTID_SUBTRACT},
-	{"grant", UNUSED},
+	{"grant", GRANT},
 	{"group", GROUP},
 	{"grouping", UNUSED},
 	{"groups", UNUSED},
 	{"group_concat", GROUP_CONCAT},
+	{"handler", HANDLER},
 	{"hash", HASH},
 	{"having", HAVING},
 	{"header", HEADER},
@@ -318,14 +331,15 @@ var keywords = []keyword{
 	{"hour_microsecond", HOUR_MICROSECOND},
 	{"hour_minute", HOUR_MINUTE},
 	{"hour_second", HOUR_SECOND},
+	{"identified", IDENTIFIED},
 	{"if", IF},
 	{"ignore", IGNORE},
 	{"import", IMPORT},
 	{"in", IN},
 	{"index", INDEX},
 	{"indexes", INDEXES},
-	{"infile", UNUSED},
-	{"inout", UNUSED},
+	{"infile", INFILE},
+	{"inout", INOUT},
 	{"inner", INNER},
 	{"inplace", INPLACE},
 	{"insensitive", UNUSED},
@@ -340,6 +354,7 @@ var keywords = []keyword{
 	{"int4", UNUSED},
 	{"int8", UNUSED},
 	{"integer", INTEGER},
+	{"intersect", INTERSECT},
 	{"interval", INTERVAL},
 	{"into", INTO},
 	{"io_after_gtids", UNUSED},
@@ -348,7 +363,7 @@ var keywords = []keyword{
 	{"is_free_lock", IS_FREE_LOCK},
 	{"is_used_lock", IS_USED_LOCK},
 	{"isolation", ISOLATION},
-	{"iterate", UNUSED},
+	{"iterate", ITERATE},
 	{"invoker", INVOKER},
 	{"join", JOIN},
 	{"json", JSON},
@@ -396,7 +411,7 @@ var keywords = []keyword{
 	{"launch", LAUNCH},
 	{"lead", LEAD},
 	{"leading", LEADING},
-	{"leave", UNUSED},
+	{"leave", LEAVE},
 	{"left", LEFT},
 	{"less", LESS},
 	{"level", LEVEL},
@@ -416,7 +431,7 @@ var keywords = []keyword{
 	{"long", UNUSED},
 	{"longblob", LONGBLOB},
 	{"longtext", LONGTEXT},
-	{"loop", UNUSED},
+	{"loop", LOOP},
 	{"low_priority", LOW_PRIORITY},
 	{"ltrim", LTRIM},
 	{"min", MIN},
@@ -441,7 +456,7 @@ var keywords = []keyword{
 	{"mod", MOD},
 	{"mode", MODE},
 	{"modify", MODIFY},
-	{"modifies", UNUSED},
+	{"modifies", MODIFIES},
 	{"multilinestring", MULTILINESTRING},
 	{"multipoint", MULTIPOINT},
 	{"multipolygon", MULTIPOLYGON},
@@ -452,6 +467,7 @@ var keywords = []keyword{
 	{"nchar", NCHAR},
 	{"next", NEXT},
 	{"nested", NESTED},
+	{"never", NEVER},
 	{"no", NO},
 	{"none", NONE},
 	{"not", NOT},
@@ -476,7 +492,7 @@ var keywords = []keyword{
 	{"or", OR},
 	{"order", ORDER},
 	{"ordinality", ORDINALITY},
-	{"out", UNUSED},
+	{"out", OUT},
 	{"outer", OUTER},
 	{"outfile", OUTFILE},
 	{"over", OVER},
@@ -496,24 +512,28 @@ var keywords = []keyword{
 	{"pointn", ST_PointN},
 	{"polygon", POLYGON},
 	{"position", POSITION},
+	{"precedes", PRECEDES},
 	{"preceding", PRECEDING},
 	{"precision", UNUSED},
 	{"prepare", PREPARE},
+	{"preserve", PRESERVE},
 	{"primary", PRIMARY},
 	{"privileges", PRIVILEGES},
 	{"purge", PURGE},
 	{"processlist", PROCESSLIST},
 	{"procedure", PROCEDURE},
+	{"proxy", PROXY},
 	{"ps_current_thread_id", PS_CURRENT_THREAD_ID},
 	{"ps_thread_id", PS_THREAD_ID},
 	{"queries", QUERIES},
 	{"query", QUERY},
+	{"random", RANDOM},
 	{"range", RANGE},
 	{"quarter", QUARTER},
 	{"rank", RANK},
 	{"ratio", RATIO},
 	{"read", READ},
-	{"reads", UNUSED},
+	{"reads", READS},
 	{"read_write", UNUSED},
 	{"real", REAL},
 	{"rebuild", REBUILD},
@@ -533,20 +553,22 @@ var keywords = []keyword{
 	{"rename", RENAME},
 	{"reorganize", REORGANIZE},
 	{"repair", REPAIR},
-	{"repeat", UNUSED},
+	{"repeat", REPEAT},
 	{"repeatable", REPEATABLE},
 	{"replace", REPLACE},
 	{"require", UNUSED},
-	{"resignal", UNUSED},
+	{"resignal", RESIGNAL},
 	{"respect", RESPECT},
 	{"restrict", RESTRICT},
-	{"return", UNUSED},
+	{"return", RETURN},
 	{"returning", RETURNING},
 	{"retry", RETRY},
+	{"returns", RETURNS},
 	{"revert", REVERT},
-	{"revoke", UNUSED},
+	{"revoke", REVOKE},
 	{"right", RIGHT},
 	{"rlike", RLIKE},
+	{"role", ROLE},
 	{"rollback", ROLLBACK},
 	{"row", ROW},
 	{"row_format", ROW_FORMAT},
@@ -555,6 +577,7 @@ var keywords = []keyword{
 	{"rtrim", RTRIM},
 	{"s3", S3},
 	{"savepoint", SAVEPOINT},
+	{"schedule", SCHEDULE},
 	{"schema", SCHEMA},
 	{"schemas", SCHEMAS},
 	{"second", SECOND},
@@ -571,7 +594,7 @@ var keywords = []keyword{
 	{"share", SHARE},
 	{"shared", SHARED},
 	{"show", SHOW},
-	{"signal", UNUSED},
+	{"signal", SIGNAL},
 	{"signed", SIGNED},
 	{"simple", SIMPLE},
 	{"slow", SLOW},
@@ -580,9 +603,9 @@ var keywords = []keyword{
 	{"spatial", SPATIAL},
 	{"specific", UNUSED},
 	{"sql", SQL},
-	{"sqlexception", UNUSED},
-	{"sqlstate", UNUSED},
-	{"sqlwarning", UNUSED},
+	{"sqlexception", SQLEXCEPTION},
+	{"sqlstate", SQLSTATE},
+	{"sqlwarning", SQLWARNING},
 	{"sql_big_result", UNUSED},
 	{"sql_cache", SQL_CACHE},
 	{"sql_calc_found_rows", SQL_CALC_FOUND_ROWS},
@@ -668,6 +691,7 @@ var keywords = []keyword{
 	{"st_startpoint", ST_StartPoint},
 	{"st_x", ST_X},
 	{"st_y", ST_Y},
+	{"starts", STARTS},
 	{"subdate", SUBDATE},
 	{"sum", SUM},
 	{"sysdate", SYSDATE},
@@ -702,17 +726,18 @@ var keywords = []keyword{
 	{"unbounded", UNBOUNDED},
 	{"uncommitted", UNCOMMITTED},
 	{"undefined", UNDEFINED},
-	{"undo", UNUSED},
+	{"undo", UNDO},
 	{"unicode", UNICODE},
 	{"union", UNION},
 	{"unique", UNIQUE},
 	{"unlock", UNLOCK},
 	{"unsigned", UNSIGNED},
 	{"unthrottle", UNTHROTTLE},
+	{"until", UNTIL},
 	{"update", UPDATE},
 	{"updatexml", UpdateXML},
 	{"upgrade", UPGRADE},
-	{"usage", UNUSED},
+	{"usage", USAGE},
 	{"use", USE},
 	{"user", USER},
 	{"user_resources", USER_RESOURCES},
@@ -757,7 +782,7 @@ var keywords = []keyword{
 	{"weight_string", WEIGHT_STRING},
 	{"when", WHEN},
 	{"where", WHERE},
-	{"while", UNUSED},
+	{"while", WHILE},
 	{"window", WINDOW},
 	{"with", WITH},
 	{"without", WITHOUT},
@@ -770,6 +795,22 @@ var keywords = []keyword{
 	{"zerofill", ZEROFILL},
 }
 
+// postgresKeywords are the keywords that are only recognized by the
+// PostgreSQL dialect. They take precedence over the MySQL keywords.
+//
+// NOTE: RETURNING is reserved in PostgreSQL, so it is lexed as a token of
+// its own instead of the non-reserved MySQL RETURNING keyword, but only
+// where it starts the RETURNING clause of an INSERT, UPDATE or DELETE.
+var postgresKeywords = []keyword{
+	{"conflict", CONFLICT},
+	{"ilike", ILIKE},
+	{"nothing", NOTHING},
+	{"precision", PRECISION},
+	{"returning", DML_RETURNING},
+	{"varying", VARYING},
+	{"zone", ZONE},
+}
+
 // keywordStrings contains the reverse mapping of token to keyword strings
 var keywordStrings = map[int]string{}
 var keywordVals = map[string]int{}
@@ -777,6 +818,9 @@ var keywordVals = map[string]int{}
 // keywordLookupTable is a perfect hash map that maps **case insensitive** keyword names to their ids
 var keywordLookupTable *caseInsensitiveTable
 
+// postgresKeywordLookupTable is the keywordLookupTable of postgresKeywords
+var postgresKeywordLookupTable *caseInsensitiveTable
+
 type caseInsensitiveTable struct {
 	h map[uint64]keyword
 }
@@ -825,6 +869,7 @@ func init() {
 	}
 
 	keywordLookupTable = buildCaseInsensitiveTable(keywords)
+	postgresKeywordLookupTable = buildCaseInsensitiveTable(postgresKeywords)
 }
 
 // KeywordString returns the string corresponding to the given keyword
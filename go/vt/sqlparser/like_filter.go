@@ -48,3 +48,9 @@ func LikeToRegexp(likeExpr string) *regexp.Regexp {
 	keyPattern = fmt.Sprintf("^%s$", keyPattern)
 	return regexp.MustCompile(keyPattern) // Can never fail
 }
+
+// LikeHasLeadingWildcard returns whether a like sql expression starts with
+// an unescaped % or _, which prevents the use of an index.
+func LikeHasLeadingWildcard(likeExpr string) bool {
+	return strings.HasPrefix(likeExpr, "%") || strings.HasPrefix(likeExpr, "_")
+}
@@ -88,3 +88,11 @@ func TestQuoteLikeSpecialCharacters(t *testing.T) {
 
 	assert.Equal(t, want, got)
 }
+
+func TestLikeHasLeadingWildcard(t *testing.T) {
+	assert.True(t, LikeHasLeadingWildcard("%abc"))
+	assert.True(t, LikeHasLeadingWildcard("_abc"))
+	assert.False(t, LikeHasLeadingWildcard("abc%"))
+	assert.False(t, LikeHasLeadingWildcard(`\%abc`))
+	assert.False(t, LikeHasLeadingWildcard(""))
+}
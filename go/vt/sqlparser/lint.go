@@ -0,0 +1,588 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"fmt"
+	"strings"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// LintSeverity is the severity of the findings of a lint rule.
+type LintSeverity int8
+
+// Constants for Enum Type - LintSeverity
+const (
+	// LintOff disables a rule.
+	LintOff LintSeverity = iota
+	LintInfo
+	LintWarning
+	LintError
+)
+
+// ToString returns the severity as a string, like "warning".
+func (severity LintSeverity) ToString() string {
+	switch severity {
+	case LintOff:
+		return "off"
+	case LintInfo:
+		return "info"
+	case LintWarning:
+		return "warning"
+	default:
+		return "error"
+	}
+}
+
+// The names of the built-in lint rules.
+const (
+	LintSelectStar            = "select_star"
+	LintDMLWithoutWhere       = "dml_without_where"
+	LintOrderByRand           = "order_by_rand"
+	LintLeadingWildcardLike   = "leading_wildcard_like"
+	LintImplicitCrossJoin     = "implicit_cross_join"
+	LintNotInNullableSubquery = "not_in_nullable_subquery"
+	LintNonSargablePredicate  = "non_sargable_predicate"
+	LintDMLWithoutLimit       = "dml_without_limit"
+)
+
+// LintRule checks the statements for a problem.
+type LintRule interface {
+	// Name identifies the rule in the registry and in the comment
+	// directives.
+	Name() string
+	// Severity is the severity of the findings of the rule, unless the
+	// registry overrides it.
+	Severity() LintSeverity
+	// Check is called for every node of a linted statement, parents
+	// before children, and reports the findings of the node to the context.
+	Check(ctx *LintContext, node SQLNode)
+}
+
+// LintFinding is a problem found by a lint rule.
+type LintFinding struct {
+	Rule     string
+	Severity LintSeverity
+	// Node is the node of the problem, and Span its source span when the
+	// statement was parsed WithPositions.
+	Node    SQLNode
+	Span    Span
+	Message string
+	// Fix, if set, rewrites the linted statement in place to fix the
+	// problem. ApplyLintFixes calls the fixes of the findings of a
+	// statement.
+	Fix func()
+}
+
+func (f *LintFinding) String() string {
+	if f.Span.IsValid() {
+		return fmt.Sprintf("%v: %s: %s (%s)", f.Span.Start, f.Severity.ToString(), f.Message, f.Rule)
+	}
+	return fmt.Sprintf("%s: %s (%s)", f.Severity.ToString(), f.Message, f.Rule)
+}
+
+// LintContext is the statement a rule checks, and collects its findings.
+type LintContext struct {
+	// Statement is the linted statement.
+	Statement Statement
+	// Schema holds the tables of the statement, and may be nil.
+	Schema *Schema
+
+	positions *Positions
+	// parents are the ancestors of the checked node, the closest last.
+	parents  []SQLNode
+	rule     LintRule
+	severity LintSeverity
+	findings []*LintFinding
+
+	resolution *Resolution
+	resolved   bool
+}
+
+// Parent returns the n-th ancestor of the checked node, its parent for 0,
+// or nil.
+func (ctx *LintContext) Parent(n int) SQLNode {
+	if n >= len(ctx.parents) {
+		return nil
+	}
+	return ctx.parents[len(ctx.parents)-1-n]
+}
+
+// Report adds a finding of the checked rule. The fix may be nil.
+func (ctx *LintContext) Report(node SQLNode, message string, fix func()) {
+	span, _ := ctx.positions.Span(node)
+	ctx.findings = append(ctx.findings, &LintFinding{
+		Rule:     ctx.rule.Name(),
+		Severity: ctx.severity,
+		Node:     node,
+		Span:     span,
+		Message:  message,
+		Fix:      fix,
+	})
+}
+
+// Resolution returns the columns of the statement resolved against the
+// schema, or nil without a schema.
+func (ctx *LintContext) Resolution() *Resolution {
+	if !ctx.resolved {
+		ctx.resolved = true
+		if ctx.Schema != nil {
+			ctx.resolution, _ = Resolve(ctx.Statement, ctx.Schema, nil)
+		}
+	}
+	return ctx.resolution
+}
+
+// Column returns the column of a table of the schema a ColName refers to,
+// and its table, or nil.
+func (ctx *LintContext) Column(col *ColName) (*ColumnDefinition, *CreateTable) {
+	res := ctx.Resolution()
+	if res == nil {
+		return nil, nil
+	}
+	resolved := res.Column(col)
+	if resolved == nil || resolved.Table == nil || resolved.Column == nil {
+		return nil, nil
+	}
+	table := ctx.Schema.Table(resolved.Table.Name)
+	if table == nil {
+		return nil, nil
+	}
+	for _, def := range table.TableSpec.Columns {
+		if def.Name.Equal(resolved.Column.Name) {
+			return def, table
+		}
+	}
+	return nil, nil
+}
+
+// LintRegistry holds the lint rules and their severity.
+type LintRegistry struct {
+	rules    []LintRule
+	severity map[string]LintSeverity
+}
+
+// NewLintRegistry returns a registry with the built-in rules.
+func NewLintRegistry() *LintRegistry {
+	r := &LintRegistry{severity: make(map[string]LintSeverity)}
+	for _, rule := range builtinLintRules {
+		_ = r.Register(rule)
+	}
+	return r
+}
+
+// Register adds a rule to the registry.
+func (r *LintRegistry) Register(rule LintRule) error {
+	name := strings.ToLower(rule.Name())
+	if _, ok := r.severity[name]; ok {
+		return vterrors.Errorf(vtrpcpb.Code_ALREADY_EXISTS, "lint rule '%s' already exists", name)
+	}
+	r.rules = append(r.rules, rule)
+	r.severity[name] = rule.Severity()
+	return nil
+}
+
+// SetSeverity overrides the severity of a rule. LintOff disables it.
+func (r *LintRegistry) SetSeverity(name string, severity LintSeverity) error {
+	name = strings.ToLower(name)
+	if _, ok := r.severity[name]; !ok {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "unknown lint rule '%s'", name)
+	}
+	r.severity[name] = severity
+	return nil
+}
+
+// Rules returns the rules of the registry, in the order they were
+// registered.
+func (r *LintRegistry) Rules() []LintRule {
+	return r.rules
+}
+
+// Lint checks a statement with the enabled rules of the registry, and
+// returns their findings, parents before children. The spans of the findings
+// are looked up in positions, and the rules use the tables of the schema;
+// both may be nil.
+//
+// The comment directives of the statement select its rules:
+//
+//	/*vt+ LINT_RULES=select_star,order_by_rand */ checks only these rules
+//	/*vt+ LINT_DISABLE=select_star */ skips these rules
+func (r *LintRegistry) Lint(stmt Statement, positions *Positions, schema *Schema) []*LintFinding {
+	ctx := &LintContext{Statement: stmt, Schema: schema, positions: positions}
+	rules := r.enabled(stmt)
+	if len(rules) == 0 {
+		return nil
+	}
+	r.check(ctx, rules, stmt)
+	return ctx.findings
+}
+
+// check runs the rules on the node, then on its children with the node as
+// their parent. The walk does not modify the statement: the fixes are
+// applied afterwards by ApplyLintFixes.
+func (r *LintRegistry) check(ctx *LintContext, rules []LintRule, node SQLNode) {
+	for _, rule := range rules {
+		ctx.rule, ctx.severity = rule, r.severity[strings.ToLower(rule.Name())]
+		rule.Check(ctx, node)
+	}
+	ctx.parents = append(ctx.parents, node)
+	self := true
+	_ = Walk(func(child SQLNode) (bool, error) {
+		if self {
+			self = false
+			return true, nil
+		}
+		r.check(ctx, rules, child)
+		return false, nil
+	}, node)
+	ctx.parents = ctx.parents[:len(ctx.parents)-1]
+}
+
+// ApplyLintFixes applies the fixes of the findings to the statement they
+// were found in, children before parents, and returns the number of fixes
+// applied. A fix is skipped when its node is no longer part of the
+// statement, because the fix of another finding replaced it.
+//
+// The fixes are not checked: lint the fixed statement again to find the
+// problems left, or introduced by the fixes.
+func ApplyLintFixes(stmt Statement, findings []*LintFinding) int {
+	fixes := make(map[SQLNode][]func())
+	for _, finding := range findings {
+		if finding.Fix != nil && isPointerNode(finding.Node) {
+			fixes[finding.Node] = append(fixes[finding.Node], finding.Fix)
+		}
+	}
+	applied := 0
+	_ = SafeRewrite(stmt, nil, func(cursor *Cursor) bool {
+		node := cursor.Node()
+		if !isPointerNode(node) {
+			return true
+		}
+		for _, fix := range fixes[node] {
+			fix()
+			applied++
+		}
+		delete(fixes, node)
+		return true
+	})
+	return applied
+}
+
+// enabled returns the rules that are not turned off, and that the comment
+// directives of the statement select.
+func (r *LintRegistry) enabled(stmt Statement) []LintRule {
+	var directives *CommentDirectives
+	if commented, ok := stmt.(Commented); ok {
+		directives = commented.GetParsedComments().Directives()
+	}
+	only := lintRuleNames(directives, DirectiveLintRules)
+	disabled := lintRuleNames(directives, DirectiveLintDisable)
+
+	var rules []LintRule
+	for _, rule := range r.rules {
+		name := strings.ToLower(rule.Name())
+		if r.severity[name] == LintOff || disabled[name] || (only != nil && !only[name]) {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// lintRuleNames returns the rule names of a comment directive, or nil if it
+// is not set.
+func lintRuleNames(directives *CommentDirectives, key string) map[string]bool {
+	val, ok := directives.GetString(key, "")
+	if !ok {
+		return nil
+	}
+	names := make(map[string]bool)
+	for _, name := range strings.Split(val, ",") {
+		names[strings.ToLower(strings.TrimSpace(name))] = true
+	}
+	return names
+}
+
+// lintRule is a built-in lint rule.
+type lintRule struct {
+	name     string
+	severity LintSeverity
+	check    func(ctx *LintContext, node SQLNode)
+}
+
+func (rule *lintRule) Name() string                         { return rule.name }
+func (rule *lintRule) Severity() LintSeverity               { return rule.severity }
+func (rule *lintRule) Check(ctx *LintContext, node SQLNode) { rule.check(ctx, node) }
+
+var builtinLintRules = []LintRule{
+	&lintRule{name: LintSelectStar, severity: LintWarning, check: lintSelectStar},
+	&lintRule{name: LintDMLWithoutWhere, severity: LintError, check: lintDMLWithoutWhere},
+	&lintRule{name: LintOrderByRand, severity: LintWarning, check: lintOrderByRand},
+	&lintRule{name: LintLeadingWildcardLike, severity: LintWarning, check: lintLeadingWildcardLike},
+	&lintRule{name: LintImplicitCrossJoin, severity: LintWarning, check: lintImplicitCrossJoin},
+	&lintRule{name: LintNotInNullableSubquery, severity: LintWarning, check: lintNotInNullableSubquery},
+	&lintRule{name: LintNonSargablePredicate, severity: LintWarning, check: lintNonSargablePredicate},
+	&lintRule{name: LintDMLWithoutLimit, severity: LintInfo, check: lintDMLWithoutLimit},
+}
+
+// lintSelectStar reports the star expressions of the queries, except the
+// ones of EXISTS subqueries. With a schema, the fix expands them to the
+// columns they select.
+func lintSelectStar(ctx *LintContext, node SQLNode) {
+	sel, ok := node.(*Select)
+	if !ok {
+		return
+	}
+	if _, ok := ctx.Parent(0).(*Subquery); ok {
+		if _, ok := ctx.Parent(1).(*ExistsExpr); ok {
+			return
+		}
+	}
+	for _, expr := range sel.SelectExprs {
+		star, ok := expr.(*StarExpr)
+		if !ok {
+			continue
+		}
+		ctx.Report(star, "SELECT * selects the columns the table has when the query runs, not the ones it needs", expandStarFix(ctx, sel, star))
+	}
+}
+
+// expandStarFix returns a fix replacing a star expression by the columns it
+// selects, or nil if they are not known.
+func expandStarFix(ctx *LintContext, sel *Select, star *StarExpr) func() {
+	res := ctx.Resolution()
+	if res == nil || len(res.Errors) > 0 {
+		return nil
+	}
+	columns := res.Star(star)
+	if len(columns) == 0 {
+		return nil
+	}
+	qualify := !star.TableName.IsEmpty() || len(sel.From) > 1
+	if len(sel.From) == 1 {
+		_, join := sel.From[0].(*JoinTableExpr)
+		qualify = qualify || join
+	}
+	exprs := make(SelectExprs, 0, len(columns))
+	for _, col := range columns {
+		expr := &ColName{Name: col.Name}
+		if qualify {
+			source, ok := col.Source.(*AliasedTableExpr)
+			if !ok {
+				return nil
+			}
+			expr.Qualifier = TableName{Name: source.As}
+			if source.As.IsEmpty() {
+				name, err := source.TableName()
+				if err != nil {
+					return nil
+				}
+				expr.Qualifier = name
+			}
+		}
+		exprs = append(exprs, &AliasedExpr{Expr: expr})
+	}
+	return func() {
+		for i, expr := range sel.SelectExprs {
+			if expr == star {
+				sel.SelectExprs = append(sel.SelectExprs[:i:i], append(exprs, sel.SelectExprs[i+1:]...)...)
+				return
+			}
+		}
+	}
+}
+
+func lintDMLWithoutWhere(ctx *LintContext, node SQLNode) {
+	switch node := node.(type) {
+	case *Update:
+		if node.Where == nil {
+			ctx.Report(node, "UPDATE without WHERE changes all the rows of the table", nil)
+		}
+	case *Delete:
+		if node.Where == nil {
+			ctx.Report(node, "DELETE without WHERE deletes all the rows of the table", nil)
+		}
+	}
+}
+
+func lintOrderByRand(ctx *LintContext, node SQLNode) {
+	order, ok := node.(*Order)
+	if !ok {
+		return
+	}
+	if fn, ok := order.Expr.(*FuncExpr); ok && fn.Qualifier.IsEmpty() && fn.Name.EqualString("rand") {
+		ctx.Report(order, "ORDER BY RAND() sorts all the rows of the result", nil)
+	}
+}
+
+func lintLeadingWildcardLike(ctx *LintContext, node SQLNode) {
+	cmp, ok := node.(*ComparisonExpr)
+	if !ok || (cmp.Operator != LikeOp && cmp.Operator != NotLikeOp) {
+		return
+	}
+	if pattern, ok := cmp.Right.(*Literal); ok && pattern.Type == StrVal && LikeHasLeadingWildcard(pattern.Val) {
+		ctx.Report(cmp, fmt.Sprintf("LIKE pattern '%s' starts with a wildcard, which prevents the use of an index", pattern.Val), nil)
+	}
+}
+
+// lintImplicitCrossJoin reports the tables joined by a comma. The fix joins
+// them with JOIN, the conditions joining them staying in the WHERE clause.
+func lintImplicitCrossJoin(ctx *LintContext, node SQLNode) {
+	sel, ok := node.(*Select)
+	if !ok || len(sel.From) < 2 {
+		return
+	}
+	from := sel.From
+	ctx.Report(from[1], fmt.Sprintf("%s is joined implicitly by a comma: use an explicit JOIN with an ON condition", String(from[1])), func() {
+		join := from[0]
+		for _, expr := range from[1:] {
+			join = &JoinTableExpr{LeftExpr: join, Join: NormalJoinType, RightExpr: expr}
+		}
+		sel.From = TableExprs{join}
+	})
+}
+
+// lintNotInNullableSubquery reports the NOT IN over a subquery selecting a
+// column that may be NULL: NOT IN is never true when the subquery returns
+// NULL. Without a schema, all the columns may be NULL, unless the subquery
+// filters the NULL values out.
+func lintNotInNullableSubquery(ctx *LintContext, node SQLNode) {
+	cmp, ok := node.(*ComparisonExpr)
+	if !ok || cmp.Operator != NotInOp {
+		return
+	}
+	subquery, ok := cmp.Right.(*Subquery)
+	if !ok {
+		return
+	}
+	sel, ok := subquery.Select.(*Select)
+	if !ok || len(sel.SelectExprs) != 1 {
+		return
+	}
+	aliased, ok := sel.SelectExprs[0].(*AliasedExpr)
+	if !ok {
+		return
+	}
+	col, ok := aliased.Expr.(*ColName)
+	if !ok {
+		return
+	}
+	if def, _ := ctx.Column(col); def != nil && !nullable(def) {
+		return
+	}
+	if sel.Where != nil {
+		for _, expr := range SplitAndExpression(nil, sel.Where.Expr) {
+			if is, ok := expr.(*IsExpr); ok && is.Right == IsNotNullOp && Equals.Expr(is.Left, col) {
+				return
+			}
+		}
+	}
+	ctx.Report(cmp, fmt.Sprintf("NOT IN returns no rows when the subquery returns NULL, and %s may be NULL: use NOT EXISTS", String(col)), nil)
+}
+
+// lintNonSargablePredicate reports the functions applied to the first
+// column of an index in the comparisons of the WHERE and ON conditions,
+// which prevent the use of the index. It needs a schema.
+func lintNonSargablePredicate(ctx *LintContext, node SQLNode) {
+	if ctx.Schema == nil {
+		return
+	}
+	var cond Expr
+	switch node := node.(type) {
+	case *Where:
+		if node.Type == WhereClause {
+			cond = node.Expr
+		}
+	case *JoinCondition:
+		cond = node.On
+	}
+	if cond == nil {
+		return
+	}
+	for _, expr := range SplitAndExpression(nil, cond) {
+		var sides []Expr
+		switch expr := expr.(type) {
+		case *ComparisonExpr:
+			sides = []Expr{expr.Left, expr.Right}
+		case *BetweenExpr:
+			sides = []Expr{expr.Left}
+		}
+		for _, side := range sides {
+			fn, ok := side.(Callable)
+			if !ok {
+				continue
+			}
+			for _, col := range callableColumns(fn) {
+				def, table := ctx.Column(col)
+				if def == nil {
+					continue
+				}
+				for _, idx := range table.TableSpec.Indexes {
+					if len(idx.Columns) > 0 && idx.Columns[0].Expression == nil && idx.Columns[0].Column.Equal(def.Name) {
+						ctx.Report(fn, fmt.Sprintf("%s applies a function to %s, which prevents the use of index %s", String(fn), String(col), idx.Info.Name.String()), nil)
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+// callableColumns returns the columns a function is applied to, outside
+// its subqueries.
+func callableColumns(fn Callable) []*ColName {
+	var columns []*ColName
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *Subquery:
+			return false, nil
+		case *ColName:
+			columns = append(columns, node)
+		}
+		return true, nil
+	}, fn)
+	return columns
+}
+
+// lintDMLWithoutLimit reports the single table UPDATE and DELETE without
+// LIMIT, which change an unbounded number of rows.
+func lintDMLWithoutLimit(ctx *LintContext, node SQLNode) {
+	switch node := node.(type) {
+	case *Update:
+		if node.Limit == nil && isSingleTable(node.TableExprs) {
+			ctx.Report(node, "UPDATE without LIMIT may change an unbounded number of rows", nil)
+		}
+	case *Delete:
+		if node.Limit == nil && len(node.Targets) == 0 && isSingleTable(node.TableExprs) {
+			ctx.Report(node, "DELETE without LIMIT may delete an unbounded number of rows", nil)
+		}
+	}
+}
+
+func isSingleTable(exprs TableExprs) bool {
+	if len(exprs) != 1 {
+		return false
+	}
+	aliased, ok := exprs[0].(*AliasedTableExpr)
+	if !ok {
+		return false
+	}
+	_, ok = aliased.Expr.(TableName)
+	return ok
+}
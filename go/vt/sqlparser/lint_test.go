@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const lintSchema = "create table t (id int primary key, a int, b varchar(10), key ka (a));" +
+	"create table u (id int primary key, tid int not null, c int)"
+
+func lintFindings(t *testing.T, registry *LintRegistry, sql string, schema *Schema) []string {
+	t.Helper()
+	stmt, positions, err := ParseWithPositions(sql)
+	require.NoError(t, err)
+	var findings []string
+	for _, finding := range registry.Lint(stmt, positions, schema) {
+		findings = append(findings, finding.String())
+	}
+	return findings
+}
+
+func TestLint(t *testing.T) {
+	testcases := []struct {
+		sql      string
+		findings []string
+	}{{
+		sql:      "select * from t",
+		findings: []string{"1:8: warning: SELECT * selects the columns the table has when the query runs, not the ones it needs (select_star)"},
+	}, {
+		sql: "select id from t where exists (select * from u where u.tid = t.id)",
+	}, {
+		sql: "update t set a = 1",
+		findings: []string{
+			"1:1: error: UPDATE without WHERE changes all the rows of the table (dml_without_where)",
+			"1:1: info: UPDATE without LIMIT may change an unbounded number of rows (dml_without_limit)",
+		},
+	}, {
+		sql:      "delete from t where id = 1",
+		findings: []string{"1:1: info: DELETE without LIMIT may delete an unbounded number of rows (dml_without_limit)"},
+	}, {
+		sql: "delete t from t join u on t.id = u.tid where u.c = 1",
+	}, {
+		sql:      "select id from t order by rand() limit 1",
+		findings: []string{"1:27: warning: ORDER BY RAND() sorts all the rows of the result (order_by_rand)"},
+	}, {
+		sql:      "select id from t where b like '%x' or b not like 'x%'",
+		findings: []string{"1:24: warning: LIKE pattern '%x' starts with a wildcard, which prevents the use of an index (leading_wildcard_like)"},
+	}, {
+		sql:      "select t.id from t, u where t.id = u.tid",
+		findings: []string{"1:21: warning: u is joined implicitly by a comma: use an explicit JOIN with an ON condition (implicit_cross_join)"},
+	}, {
+		sql:      "select id from t where id not in (select c from u)",
+		findings: []string{"1:24: warning: NOT IN returns no rows when the subquery returns NULL, and c may be NULL: use NOT EXISTS (not_in_nullable_subquery)"},
+	}, {
+		sql: "select id from t where id not in (select tid from u) and a not in (select c from u where c is not null)",
+	}, {
+		sql: "select id from t join u on abs(t.a) = u.c where year(t.id) = 2020 and lower(b) = 'x'",
+		findings: []string{
+			"1:28: warning: abs(t.a) applies a function to t.a, which prevents the use of index ka (non_sargable_predicate)",
+			"1:49: warning: year(t.id) applies a function to t.id, which prevents the use of index PRIMARY (non_sargable_predicate)",
+		},
+	}}
+	schema := NewSchema()
+	require.NoError(t, schema.ApplySQL(lintSchema))
+	registry := NewLintRegistry()
+	for _, tcase := range testcases {
+		t.Run(tcase.sql, func(t *testing.T) {
+			assert.Equal(t, tcase.findings, lintFindings(t, registry, tcase.sql, schema))
+		})
+	}
+}
+
+func TestLintWithoutSchema(t *testing.T) {
+	registry := NewLintRegistry()
+	assert.Equal(t, []string{
+		"1:24: warning: NOT IN returns no rows when the subquery returns NULL, and tid may be NULL: use NOT EXISTS (not_in_nullable_subquery)",
+	}, lintFindings(t, registry, "select id from t where id not in (select tid from u) and year(id) = 2020", nil))
+}
+
+func TestLintFix(t *testing.T) {
+	schema := NewSchema()
+	require.NoError(t, schema.ApplySQL(lintSchema))
+	stmt, err := Parse("select *, 1 from t, u x where t.id = x.tid")
+	require.NoError(t, err)
+	registry := NewLintRegistry()
+	findings := registry.Lint(stmt, nil, schema)
+	for _, finding := range findings {
+		require.NotNil(t, finding.Fix, finding.String())
+	}
+	assert.Equal(t, "select *, 1 from t, u as x where t.id = x.tid", String(stmt), "linting must not change the statement")
+	assert.Equal(t, 2, ApplyLintFixes(stmt, findings))
+	assert.Equal(t, "select t.id, t.a, t.b, x.id, x.tid, x.c, 1 from t join u as x where t.id = x.tid", String(stmt))
+	// the fixed statement has no findings left
+	assert.Empty(t, registry.Lint(stmt, nil, schema))
+
+	// without a schema, the columns of a star expression are not known
+	stmt, err = Parse("select * from t")
+	require.NoError(t, err)
+	findings = registry.Lint(stmt, nil, nil)
+	require.Len(t, findings, 1)
+	assert.Nil(t, findings[0].Fix)
+	assert.Zero(t, ApplyLintFixes(stmt, findings))
+}
+
+func TestLintDirectives(t *testing.T) {
+	registry := NewLintRegistry()
+	assert.Equal(t, []string{
+		"1:1: error: DELETE without WHERE deletes all the rows of the table (dml_without_where)",
+	}, lintFindings(t, registry, "delete /*vt+ LINT_DISABLE=dml_without_limit */ from t", nil))
+	assert.Equal(t, []string{
+		"1:60: warning: ORDER BY RAND() sorts all the rows of the result (order_by_rand)",
+	}, lintFindings(t, registry, "select /*vt+ LINT_RULES=order_by_rand */ * from t order by rand()", nil))
+	assert.Empty(t, lintFindings(t, registry, "select /*vt+ LINT_DISABLE=Select_Star,order_by_rand */ * from t order by rand()", nil))
+}
+
+// lintTableRule reports the tables with a given name.
+type lintTableRule struct {
+	table string
+}
+
+func (rule *lintTableRule) Name() string           { return "table_" + rule.table }
+func (rule *lintTableRule) Severity() LintSeverity { return LintError }
+func (rule *lintTableRule) Check(ctx *LintContext, node SQLNode) {
+	if name, ok := node.(TableName); ok && name.Name.String() == rule.table {
+		ctx.Report(ctx.Parent(0), "table "+rule.table+" is deprecated", nil)
+	}
+}
+
+func TestLintRegistry(t *testing.T) {
+	registry := NewLintRegistry()
+	require.NoError(t, registry.Register(&lintTableRule{table: "old"}))
+	assert.EqualError(t, registry.Register(&lintTableRule{table: "old"}), "lint rule 'table_old' already exists")
+	require.NoError(t, registry.SetSeverity(LintSelectStar, LintOff))
+	require.NoError(t, registry.SetSeverity(LintImplicitCrossJoin, LintError))
+	assert.EqualError(t, registry.SetSeverity("x", LintInfo), "unknown lint rule 'x'")
+	assert.Len(t, registry.Rules(), 9)
+
+	assert.Equal(t, []string{
+		"1:20: error: t is joined implicitly by a comma: use an explicit JOIN with an ON condition (implicit_cross_join)",
+		"1:15: error: table old is deprecated (table_old)",
+	}, lintFindings(t, registry, "select * from old, t", nil))
+}
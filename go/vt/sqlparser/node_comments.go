@@ -0,0 +1,278 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"strings"
+)
+
+// NodeComments holds the comments of a statement that are not part of the
+// comments following its first keyword. Every comment is attached to the
+// nearest node before or after it, and printed back next to that node.
+// Like source spans, comments are only attached to nodes with pointer types.
+type NodeComments struct {
+	before map[SQLNode][]string
+	after  map[SQLNode][]string
+	// trailing are the comments ending the line of a node after the
+	// comma that follows it, like `a, -- the a column`.
+	trailing map[SQLNode][]string
+}
+
+// Before returns the comments printed before the node.
+func (c *NodeComments) Before(node SQLNode) []string {
+	if c == nil || !isPointerNode(node) {
+		return nil
+	}
+	return c.before[node]
+}
+
+// After returns the comments printed after the node.
+func (c *NodeComments) After(node SQLNode) []string {
+	if c == nil || !isPointerNode(node) {
+		return nil
+	}
+	return c.after[node]
+}
+
+// Trailing returns the comments printed after the comma that follows the
+// node, at the end of its line.
+func (c *NodeComments) Trailing(node SQLNode) []string {
+	if c == nil || !isPointerNode(node) {
+		return nil
+	}
+	return c.trailing[node]
+}
+
+// Len returns the number of attached comments.
+func (c *NodeComments) Len() int {
+	if c == nil {
+		return 0
+	}
+	n := 0
+	for _, comments := range c.before {
+		n += len(comments)
+	}
+	for _, comments := range c.after {
+		n += len(comments)
+	}
+	for _, comments := range c.trailing {
+		n += len(comments)
+	}
+	return n
+}
+
+// WithComments keeps all the comments of the parsed statements, and not
+// only the ones following their first keyword. The comments are attached to
+// the nodes of the last parsed statement, see Comments, and printed back in
+// place by StringWithComments and PrettyStyle.FormatWithComments. It implies
+// WithPositions.
+func WithComments() TokenizerOpt {
+	return func(tokenizer *Tokenizer) {
+		if tokenizer.positions == nil {
+			WithPositions()(tokenizer)
+		}
+		tokenizer.keepComments = true
+	}
+}
+
+// Comments returns the comments attached to the nodes of the last parsed
+// statement, or nil if WithComments was not given to the tokenizer.
+func (tkn *Tokenizer) Comments() *NodeComments {
+	return tkn.attached
+}
+
+// ParseWithComments behaves like Parse, but also returns the comments
+// attached to the nodes of the statement, see WithComments.
+func ParseWithComments(sql string) (Statement, *NodeComments, error) {
+	tokenizer := NewStringTokenizer(sql, WithComments())
+	stmt, _, err := parse2(sql, tokenizer, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stmt, tokenizer.Comments(), nil
+}
+
+// sourceComment is a comment skipped by the tokenizer.
+type sourceComment struct {
+	text string
+	span Span
+	// prevEnd is the end of the token before the comment, and nextStart
+	// the start of the token after it. prevEnd is zero for the comments
+	// before the first token. prevComma is set when the token before the
+	// comment is a comma.
+	prevEnd, nextStart Position
+	prevComma          bool
+}
+
+// attachComments attaches the comments skipped while parsing the last
+// statement to its nodes.
+func (tkn *Tokenizer) attachComments() {
+	comments := tkn.comments
+	tkn.comments, tkn.nextComment = nil, 0
+	if !tkn.keepComments {
+		return
+	}
+	attached := &NodeComments{
+		before:   make(map[SQLNode][]string),
+		after:    make(map[SQLNode][]string),
+		trailing: make(map[SQLNode][]string),
+	}
+	tkn.attached = attached
+	if len(comments) == 0 || tkn.ParseTree == nil {
+		return
+	}
+	if _, ok := tkn.ParseTree.(*CommentOnly); ok {
+		return
+	}
+
+	// only the nodes printed through the formatter can print their
+	// comments; they are printed outer nodes first
+	order := make(map[SQLNode]int)
+	buf := NewTrackedBuffer(func(buf *TrackedBuffer, node SQLNode) {
+		if isPointerNode(node) {
+			if _, ok := order[node]; !ok {
+				order[node] = len(order)
+			}
+		}
+		node.Format(buf)
+	})
+	buf.formatter(tkn.ParseTree)
+
+	for _, comment := range comments {
+		node, place := tkn.positions.commentNode(comment, order)
+		if node == nil {
+			continue
+		}
+		text := strings.TrimRight(comment.text, " \t\r\n")
+		switch place {
+		case commentAfter:
+			attached.after[node] = append(attached.after[node], text)
+		case commentTrailing:
+			attached.trailing[node] = append(attached.trailing[node], text)
+		default:
+			attached.before[node] = append(attached.before[node], text)
+		}
+	}
+}
+
+// commentPlace is where a comment is printed relative to its node.
+type commentPlace int
+
+const (
+	commentBefore commentPlace = iota
+	commentAfter
+	// commentTrailing is after the comma following the node
+	commentTrailing
+)
+
+// commentNode returns the node a comment is attached to, and where the
+// comment goes. A comment goes after the node it directly follows on the
+// same line, or after the comma following it if the comment ends the line.
+// Else it goes before the node it directly precedes. Otherwise, it goes
+// after the nearest node on its line, or before the nearest node after it.
+func (p *Positions) commentNode(comment sourceComment, order map[SQLNode]int) (SQLNode, commentPlace) {
+	var prev, next SQLNode
+	var prevSpan, nextSpan Span
+	for node, span := range p.spans {
+		index, ok := order[node]
+		if !ok {
+			continue
+		}
+		// the outermost of the nodes ending at the same offset comes first
+		if span.End.Offset <= comment.span.Start.Offset {
+			switch {
+			case prev == nil,
+				span.End.Offset > prevSpan.End.Offset,
+				span.End.Offset == prevSpan.End.Offset && span.Start.Offset < prevSpan.Start.Offset,
+				span.End.Offset == prevSpan.End.Offset && span.Start.Offset == prevSpan.Start.Offset && index < order[prev]:
+				prev, prevSpan = node, span
+			}
+		}
+		if span.Start.Offset >= comment.span.End.Offset {
+			switch {
+			case next == nil,
+				span.Start.Offset < nextSpan.Start.Offset,
+				span.Start.Offset == nextSpan.Start.Offset && span.End.Offset > nextSpan.End.Offset,
+				span.Start.Offset == nextSpan.Start.Offset && span.End.Offset == nextSpan.End.Offset && index < order[next]:
+				next, nextSpan = node, span
+			}
+		}
+	}
+
+	sameLine := prev != nil && prevSpan.End.Line == comment.span.Start.Line
+	endsLine := isLineComment(comment.text) || comment.nextStart.Line > comment.span.End.Line
+	switch {
+	case sameLine && prevSpan.End.Offset == comment.prevEnd.Offset:
+		return prev, commentAfter
+	case sameLine && endsLine && comment.prevComma && prevSpan.End.Offset == comment.prevEnd.Offset-1:
+		return prev, commentTrailing
+	case next != nil && nextSpan.Start.Offset == comment.nextStart.Offset:
+		return next, commentBefore
+	case sameLine, next == nil:
+		return prev, commentAfter
+	default:
+		return next, commentBefore
+	}
+}
+
+// isLineComment returns whether a comment ends at the end of its line.
+func isLineComment(comment string) bool {
+	return strings.HasPrefix(comment, "--") || strings.HasPrefix(comment, "#")
+}
+
+// writeCommentsBefore writes the comments before a node, after the trailing
+// comments of the node before it, which follow the comma written since. The
+// line after a line comment keeps the indentation of the line of the
+// comment.
+func (buf *TrackedBuffer) writeCommentsBefore(node SQLNode) {
+	comments := append(buf.trailing, buf.comments.Before(node)...)
+	buf.trailing = nil
+	for _, comment := range comments {
+		buf.WriteString(comment)
+		if !isLineComment(comment) {
+			buf.WriteByte(' ')
+			continue
+		}
+		written := buf.String()
+		line := written[strings.LastIndexByte(written, '\n')+1:]
+		buf.WriteByte('\n')
+		buf.WriteString(line[:len(line)-len(strings.TrimLeft(line, " \t"))])
+	}
+}
+
+// writeCommentsAfter writes the comments after a node. Its trailing
+// comments wait for the comma after it, see writeTrailingComments.
+func (buf *TrackedBuffer) writeCommentsAfter(node SQLNode) {
+	for _, comment := range buf.comments.After(node) {
+		buf.WriteByte(' ')
+		buf.WriteString(comment)
+		if isLineComment(comment) {
+			buf.WriteByte('\n')
+		}
+	}
+	buf.trailing = append(buf.trailing, buf.comments.Trailing(node)...)
+}
+
+// writeTrailingComments writes the trailing comments of the last node right
+// after the comma written after it. The caller ends the line after them.
+func (buf *TrackedBuffer) writeTrailingComments() {
+	for _, comment := range buf.trailing {
+		buf.WriteByte(' ')
+		buf.WriteString(comment)
+	}
+	buf.trailing = nil
+}
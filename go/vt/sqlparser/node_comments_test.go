@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWithComments(t *testing.T) {
+	testcases := []struct {
+		input  string
+		output string
+		pretty string
+	}{{
+		input:  "-- header\nselect a, -- the a column\n  b /* bee */ from t where /* cond */ x = 1 -- trailing",
+		output: "-- header\nselect a, -- the a column\nb /* bee */ from t where /* cond */ x = 1 -- trailing",
+		pretty: "-- header\n" +
+			"select\n" +
+			"  a, -- the a column\n" +
+			"  b /* bee */\n" +
+			"from t\n" +
+			"where /* cond */ x = 1 -- trailing",
+	}, {
+		input:  "select /* hint */ a from t",
+		output: "select /* hint */ a from t",
+		pretty: "select /* hint */ a\nfrom t",
+	}, {
+		input:  "select a from t union /* second */ select b from u",
+		output: "select a from t union /* second */ select b from u",
+		pretty: "select a\nfrom t\nunion\n/* second */ select b\nfrom u",
+	}, {
+		input:  "select a from t where x = 1 and /* y */ y = 2 and z = 3 /* z */",
+		output: "select a from t where x = 1 and /* y */ y = 2 and z = 3 /* z */",
+		pretty: "select a\nfrom t\nwhere x = 1 and /* y */ y = 2 and z = 3 /* z */",
+	}, {
+		input:  "insert into t(a, b) values (1, /* two */ 2) # done",
+		output: "insert into t(a, b) values (1, /* two */ 2) # done",
+		pretty: "insert into t(a, b)\nvalues (1, /* two */ 2) # done",
+	}, {
+		input:  "create table t (\n  a int, -- the a\n  b int\n)",
+		output: "create table t (\n\ta int, -- the a\n\tb int\n)",
+		pretty: "create table t (\n\ta int, -- the a\n\tb int\n)",
+	}, {
+		input:  "select a, /* the a */\n  b from t, -- the t\n  u",
+		output: "select a, /* the a */ b from t, -- the t\nu",
+		pretty: "select\n  a, /* the a */\n  b\nfrom\n  t, -- the t\n  u",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.input, func(t *testing.T) {
+			stmt, comments, err := ParseWithComments(tcase.input)
+			require.NoError(t, err)
+			output := StringWithComments(stmt, comments)
+			pretty := DefaultPrettyStyle.FormatWithComments(stmt, comments)
+			assert.Equal(t, tcase.output, output)
+			assert.Equal(t, tcase.pretty, pretty)
+
+			// the printed comments are attached to the same nodes
+			again, comments, err := ParseWithComments(output)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.output, StringWithComments(again, comments))
+			again, comments, err = ParseWithComments(pretty)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.output, StringWithComments(again, comments))
+
+			// the comments are not part of the statement, which is the one
+			// parsed without WithComments
+			plain, err := Parse(tcase.input)
+			require.NoError(t, err)
+			assert.True(t, Equals.SQLNode(plain, stmt))
+			assert.Equal(t, String(plain), String(stmt))
+		})
+	}
+}
+
+func TestAttachedComments(t *testing.T) {
+	stmt, comments, err := ParseWithComments("select a /* after a */, /* before b */ b from t")
+	require.NoError(t, err)
+	require.Equal(t, 2, comments.Len())
+	exprs := stmt.(*Select).SelectExprs
+	assert.Equal(t, []string{"/* after a */"}, comments.After(exprs[0]))
+	assert.Equal(t, []string{"/* before b */"}, comments.Before(exprs[1]))
+	assert.Nil(t, comments.Before(exprs[0]))
+
+	// sub nodes are printed with their own comments
+	assert.Equal(t, "/* before b */ b", StringWithComments(exprs[1], comments))
+	assert.Equal(t, "b", String(exprs[1]))
+
+	// the comments are attached to the nodes of the parsed statement, so a
+	// clone is equal to it but printed without them
+	clone := CloneStatement(stmt)
+	assert.True(t, Equals.SQLNode(stmt, clone))
+	assert.Equal(t, "select a, b from t", StringWithComments(clone, comments))
+
+	// a comment ending the line after a comma trails the node before it
+	stmt, comments, err = ParseWithComments("select a, -- the a\n b from t")
+	require.NoError(t, err)
+	require.Equal(t, 1, comments.Len())
+	assert.Equal(t, []string{"-- the a"}, comments.Trailing(stmt.(*Select).SelectExprs[0]))
+	assert.Nil(t, comments.Before(stmt.(*Select).SelectExprs[1]))
+
+	// statements without comments after their first keyword keep their
+	// comments too
+	stmt, comments, err = ParseWithComments("rename table a to b -- kept")
+	require.NoError(t, err)
+	assert.Equal(t, 1, comments.Len())
+	assert.Equal(t, "rename table a to b -- kept", StringWithComments(stmt, comments))
+}
+
+// TestPrettyComments checks that the comments printed by the styles are
+// attached to the same nodes when the output is parsed again, so that it is
+// printed the same.
+func TestPrettyComments(t *testing.T) {
+	inputs := []string{
+		"-- header\nselect a, -- the a column\n  b /* bee */, c as x, -- the c\n  d as yy\nfrom t, -- the t\n  u where x = 1 -- trailing",
+		"select f(a, -- the a\n  b) from t",
+		"insert into t(a, b) values (1, -- one\n  2), (3, 4)",
+		"update t set a = 1, -- the a\n  b = 2 where c = 3",
+		"create table t (\n  a int, -- the a\n  b int, /* the b */\n  primary key (a), -- the key\n  constraint c check (a > 0)\n)",
+	}
+	styles := []PrettyStyle{
+		DefaultPrettyStyle,
+		{Indent: "    ", MaxWidth: 20, LeadingCommas: true, AlignAliases: true},
+	}
+	for _, input := range inputs {
+		stmt, comments, err := ParseWithComments(input)
+		require.NoError(t, err, input)
+		for _, style := range styles {
+			pretty := style.FormatWithComments(stmt, comments)
+			again, againComments, err := ParseWithComments(pretty)
+			require.NoError(t, err, pretty)
+			assert.Equal(t, comments.Len(), againComments.Len(), pretty)
+			assert.Equal(t, pretty, style.FormatWithComments(again, againComments))
+			assert.Equal(t, String(stmt), String(again))
+		}
+	}
+}
+
+func TestParseNextWithComments(t *testing.T) {
+	sql := "-- first\nselect 1 from t; /* second */ select 2 from t -- two\n; select 3 from t"
+	tokens := NewStringTokenizer(sql, WithComments())
+	var result []string
+	for {
+		stmt, err := ParseNext(tokens)
+		if err != nil {
+			break
+		}
+		result = append(result, StringWithComments(stmt, tokens.Comments()))
+	}
+	assert.Equal(t, []string{"-- first\nselect 1 from t", "/* second */ select 2 from t -- two", "select 3 from t"}, result)
+}
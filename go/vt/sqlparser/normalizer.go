@@ -82,6 +82,10 @@ func (nz *normalizer) walkStatementDown(node, parent SQLNode) bool {
 	// no need to normalize the statement types
 	case *Set, *Show, *Begin, *Commit, *Rollback, *Savepoint, DDLStatement, *SRollback, *Release, *OtherAdmin, *OtherRead:
 		return false
+	case *Grant, *Revoke, *CreateUser, *AlterUser, *DropUser, *RenameUser, *CreateRole, *DropRole, *SetRole, *SetDefaultRole, *SetPassword:
+		return false
+	case *Load: // the FIELDS, LINES and IGNORE n LINES clauses only take literals
+		return false
 	case *Select:
 		_, isDerived := parent.(*DerivedTable)
 		var tmp bool
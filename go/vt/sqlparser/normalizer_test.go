@@ -371,6 +371,11 @@ func TestNormalize(t *testing.T) {
 		in:      `select * from (select 12) as t`,
 		outstmt: `select * from (select 12 from dual) as t`,
 		outbv:   map[string]*querypb.BindVariable{},
+	}, {
+		// the clauses of LOAD DATA only take literals
+		in:      `load data infile 'a' into table t fields terminated by ',' lines terminated by '\n' ignore 3 lines`,
+		outstmt: `load data infile 'a' into table t fields terminated by ',' lines terminated by '\n' ignore 3 lines`,
+		outbv:   map[string]*querypb.BindVariable{},
 	}}
 	for _, tc := range testcases {
 		t.Run(tc.in, func(t *testing.T) {
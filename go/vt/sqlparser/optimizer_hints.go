@@ -0,0 +1,567 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"strconv"
+	"strings"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// OptimizerHint is a MySQL optimizer hint of a /*+ ... */ comment, like
+// BKA(t1), INDEX(@qb1 t1 idx_a, idx_b), MAX_EXECUTION_TIME(1000) or
+// SET_VAR(sort_buffer_size = 16M).
+type OptimizerHint struct {
+	// Name is the upper-case name of the hint.
+	Name string
+	// QueryBlock is the query block the hint applies to, given by a
+	// leading @name argument.
+	QueryBlock string
+	// Tables are the tables of the table-level, index-level and join-order
+	// hints.
+	Tables []HintTable
+	// Indexes are the indexes of the index-level hints.
+	Indexes []string
+	// Args are the arguments of the other hints: the upper-case strategies
+	// of SEMIJOIN, NO_SEMIJOIN and SUBQUERY, the value of
+	// MAX_EXECUTION_TIME, the name of RESOURCE_GROUP and QB_NAME, and the
+	// variable and the value of SET_VAR.
+	Args []string
+	// Raw is the text of a hint MySQL 8.0 does not know, or of a known
+	// hint with arguments it cannot parse, which is kept as it is written.
+	// Only its Name is parsed: like MySQL, which warns about these hints
+	// and ignores them, the other hints still apply.
+	Raw string
+}
+
+// HintTable is a table of an optimizer hint, which may name the query
+// block of the table: t1@qb1.
+type HintTable struct {
+	Name       string
+	QueryBlock string
+}
+
+// OptimizerHints are the optimizer hints of a statement.
+type OptimizerHints []*OptimizerHint
+
+// hintSyntax is the syntax of the arguments of an optimizer hint.
+type hintSyntax int8
+
+// Constants for Enum Type - hintSyntax
+const (
+	// hintTables is hint([@qb] [tbl[@qb] [, tbl[@qb]] ...])
+	hintTables hintSyntax = iota
+	// hintIndexes is hint([@qb] tbl[@qb] [idx [, idx] ...])
+	hintIndexes
+	// hintStrategies is hint([@qb] [strategy [, strategy] ...])
+	hintStrategies
+	// hintValue is hint(value)
+	hintValue
+	// hintSetVar is SET_VAR(var = value)
+	hintSetVar
+)
+
+// optimizerHintSyntax are the optimizer hints of MySQL 8.0 and the syntax
+// of their arguments.
+var optimizerHintSyntax = map[string]hintSyntax{
+	"BKA":                           hintTables,
+	"NO_BKA":                        hintTables,
+	"BNL":                           hintTables,
+	"NO_BNL":                        hintTables,
+	"DERIVED_CONDITION_PUSHDOWN":    hintTables,
+	"NO_DERIVED_CONDITION_PUSHDOWN": hintTables,
+	"HASH_JOIN":                     hintTables,
+	"NO_HASH_JOIN":                  hintTables,
+	"MERGE":                         hintTables,
+	"NO_MERGE":                      hintTables,
+	"JOIN_FIXED_ORDER":              hintTables,
+	"JOIN_ORDER":                    hintTables,
+	"JOIN_PREFIX":                   hintTables,
+	"JOIN_SUFFIX":                   hintTables,
+	"GROUP_INDEX":                   hintIndexes,
+	"NO_GROUP_INDEX":                hintIndexes,
+	"INDEX":                         hintIndexes,
+	"NO_INDEX":                      hintIndexes,
+	"INDEX_MERGE":                   hintIndexes,
+	"NO_INDEX_MERGE":                hintIndexes,
+	"JOIN_INDEX":                    hintIndexes,
+	"NO_JOIN_INDEX":                 hintIndexes,
+	"MRR":                           hintIndexes,
+	"NO_MRR":                        hintIndexes,
+	"NO_ICP":                        hintIndexes,
+	"NO_RANGE_OPTIMIZATION":         hintIndexes,
+	"ORDER_INDEX":                   hintIndexes,
+	"NO_ORDER_INDEX":                hintIndexes,
+	"SKIP_SCAN":                     hintIndexes,
+	"NO_SKIP_SCAN":                  hintIndexes,
+	"SEMIJOIN":                      hintStrategies,
+	"NO_SEMIJOIN":                   hintStrategies,
+	"SUBQUERY":                      hintStrategies,
+	"MAX_EXECUTION_TIME":            hintValue,
+	"RESOURCE_GROUP":                hintValue,
+	"QB_NAME":                       hintValue,
+	"SET_VAR":                       hintSetVar,
+}
+
+// NewMaxExecutionTimeHint returns a MAX_EXECUTION_TIME hint with a timeout
+// in milliseconds.
+func NewMaxExecutionTimeHint(milliseconds uint64) *OptimizerHint {
+	return &OptimizerHint{Name: "MAX_EXECUTION_TIME", Args: []string{strconv.FormatUint(milliseconds, 10)}}
+}
+
+// NewSetVarHint returns a SET_VAR hint setting a system variable for the
+// duration of the statement. The value is written as is, so strings must
+// be quoted.
+func NewSetVarHint(variable, value string) *OptimizerHint {
+	return &OptimizerHint{Name: "SET_VAR", Args: []string{variable, value}}
+}
+
+// String returns the hint as it is written in a /*+ ... */ comment.
+func (hint *OptimizerHint) String() string {
+	if hint.Raw != "" {
+		return hint.Raw
+	}
+	var b strings.Builder
+	b.WriteString(hint.Name)
+	b.WriteByte('(')
+	var args []string
+	if hint.QueryBlock != "" {
+		args = append(args, "@"+formatHintID(hint.QueryBlock))
+	}
+	switch optimizerHintSyntax[hint.Name] {
+	case hintTables:
+		var tables []string
+		for _, table := range hint.Tables {
+			tables = append(tables, table.String())
+		}
+		args = append(args, strings.Join(tables, ", "))
+	case hintIndexes:
+		var tables []string
+		for _, table := range hint.Tables {
+			tables = append(tables, table.String())
+		}
+		var indexes []string
+		for _, index := range hint.Indexes {
+			indexes = append(indexes, formatHintID(index))
+		}
+		args = append(args, strings.Join(tables, " "), strings.Join(indexes, ", "))
+	case hintStrategies:
+		args = append(args, strings.Join(hint.Args, ", "))
+	case hintValue:
+		for _, arg := range hint.Args {
+			if hint.Name == "MAX_EXECUTION_TIME" {
+				args = append(args, arg)
+			} else {
+				args = append(args, formatHintID(arg))
+			}
+		}
+	case hintSetVar:
+		if len(hint.Args) == 2 {
+			args = append(args, hint.Args[0]+" = "+hint.Args[1])
+		}
+	}
+	first := true
+	for _, arg := range args {
+		if arg == "" {
+			continue
+		}
+		if !first {
+			b.WriteByte(' ')
+		}
+		b.WriteString(arg)
+		first = false
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// String returns the table as it is written in an optimizer hint.
+func (table HintTable) String() string {
+	if table.QueryBlock == "" {
+		return formatHintID(table.Name)
+	}
+	return formatHintID(table.Name) + "@" + formatHintID(table.QueryBlock)
+}
+
+// formatHintID quotes the identifiers of the optimizer hints that need to.
+func formatHintID(id string) string {
+	digits := true
+	for _, c := range id {
+		switch {
+		case c >= '0' && c <= '9':
+		case c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			digits = false
+		default:
+			return "`" + strings.ReplaceAll(id, "`", "``") + "`"
+		}
+	}
+	if digits {
+		return "`" + id + "`"
+	}
+	return id
+}
+
+// String returns the hints as they are written in a /*+ ... */ comment.
+func (hints OptimizerHints) String() string {
+	var parts []string
+	for _, hint := range hints {
+		parts = append(parts, hint.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+// Get returns the first hint with the name, or nil.
+func (hints OptimizerHints) Get(name string) *OptimizerHint {
+	for _, hint := range hints {
+		if strings.EqualFold(hint.Name, name) {
+			return hint
+		}
+	}
+	return nil
+}
+
+// Add returns the hints with the hint appended, unless the same hint is
+// already in the list.
+func (hints OptimizerHints) Add(hint *OptimizerHint) OptimizerHints {
+	for _, other := range hints {
+		if other.String() == hint.String() {
+			return hints
+		}
+	}
+	return append(hints, hint)
+}
+
+// Replace returns the hints with the hint in place of the hints it
+// overrides, which are the hints with the same name and query block and,
+// for SET_VAR, the same variable. The hint is appended if it overrides
+// none.
+func (hints OptimizerHints) Replace(hint *OptimizerHint) OptimizerHints {
+	result := make(OptimizerHints, 0, len(hints)+1)
+	replaced := false
+	for _, other := range hints {
+		if !hint.overrides(other) {
+			result = append(result, other)
+		} else if !replaced {
+			result = append(result, hint)
+			replaced = true
+		}
+	}
+	if !replaced {
+		result = append(result, hint)
+	}
+	return result
+}
+
+// overrides returns whether the hint overrides another hint.
+func (hint *OptimizerHint) overrides(other *OptimizerHint) bool {
+	if !strings.EqualFold(hint.Name, other.Name) || !strings.EqualFold(hint.QueryBlock, other.QueryBlock) {
+		return false
+	}
+	if strings.EqualFold(hint.Name, "SET_VAR") {
+		return len(hint.Args) > 0 && len(other.Args) > 0 && strings.EqualFold(hint.Args[0], other.Args[0])
+	}
+	return true
+}
+
+// Remove returns the hints without the hints with the name.
+func (hints OptimizerHints) Remove(name string) OptimizerHints {
+	result := make(OptimizerHints, 0, len(hints))
+	for _, hint := range hints {
+		if !strings.EqualFold(hint.Name, name) {
+			result = append(result, hint)
+		}
+	}
+	return result
+}
+
+// OptimizerHints parses the optimizer hints of the first /*+ ... */
+// comment. MySQL only reads the hints of the comment that follows the
+// statement keyword, and ignores the other ones.
+func (c *ParsedComments) OptimizerHints() (OptimizerHints, error) {
+	if c == nil {
+		return nil, nil
+	}
+	index := optimizerHintComment(c.comments)
+	if index < 0 {
+		return nil, nil
+	}
+	return ParseOptimizerHints(c.comments[index])
+}
+
+// WithOptimizerHints returns the comments with the optimizer hints in
+// place of the first /*+ ... */ comment, first since MySQL only reads the
+// hints of the comment that follows the statement keyword. The other
+// /*+ ... */ comments are left untouched, since MySQL ignores them. There
+// is no optimizer hint comment if there are no hints, unless an empty one
+// is needed to keep ignoring the next ones.
+func (c *ParsedComments) WithOptimizerHints(hints OptimizerHints) Comments {
+	var comments Comments
+	if c != nil {
+		comments = c.comments
+		if index := optimizerHintComment(comments); index >= 0 {
+			comments = append(comments[:index:index], comments[index+1:]...)
+		}
+	}
+	if len(hints) == 0 {
+		if optimizerHintComment(comments) < 0 {
+			return comments
+		}
+		return append(Comments{queryOptimizerPrefix + " */"}, comments...)
+	}
+	return append(Comments{queryOptimizerPrefix + " " + hints.String() + " */"}, comments...)
+}
+
+// optimizerHintComment returns the index of the first /*+ ... */ comment,
+// or -1.
+func optimizerHintComment(comments Comments) int {
+	for i, comment := range comments {
+		if strings.HasPrefix(comment, queryOptimizerPrefix) {
+			return i
+		}
+	}
+	return -1
+}
+
+// GetOptimizerHints returns the optimizer hints of a statement.
+func GetOptimizerHints(stmt SupportOptimizerHint) (OptimizerHints, error) {
+	return stmt.GetParsedComments().OptimizerHints()
+}
+
+// SetOptimizerHints replaces the optimizer hints of a statement.
+func SetOptimizerHints(stmt SupportOptimizerHint, hints OptimizerHints) {
+	stmt.SetComments(stmt.GetParsedComments().WithOptimizerHints(hints))
+}
+
+// ParseOptimizerHints parses optimizer hints, with or without the /*+ and
+// */ that delimit their comment.
+func ParseOptimizerHints(text string) (OptimizerHints, error) {
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, queryOptimizerPrefix) {
+		if !strings.HasSuffix(text, "*/") {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "optimizer hint comment is not terminated: %s", text)
+		}
+		text = text[len(queryOptimizerPrefix) : len(text)-len("*/")]
+	}
+	tokens, err := scanOptimizerHints(text)
+	if err != nil {
+		return nil, err
+	}
+
+	var hints OptimizerHints
+	for len(tokens) > 0 {
+		if tokens[0].kind != hintWord || len(tokens) < 2 || tokens[1].kind != '(' {
+			return nil, errOptimizerHint(text)
+		}
+		name := strings.ToUpper(tokens[0].val)
+		// the arguments end at the matching parenthesis
+		end, depth := 2, 0
+		for ; end < len(tokens); end++ {
+			if tokens[end].kind == '(' {
+				depth++
+			} else if tokens[end].kind == ')' {
+				if depth == 0 {
+					break
+				}
+				depth--
+			}
+		}
+		if end == len(tokens) {
+			return nil, errOptimizerHint(text)
+		}
+		// like MySQL, the hints that are not known or cannot be parsed are
+		// skipped over
+		hint := &OptimizerHint{Name: name, Raw: text[tokens[0].pos : tokens[end].pos+1]}
+		if syntax, ok := optimizerHintSyntax[name]; ok {
+			if parsed, ok := parseOptimizerHint(name, syntax, tokens[2:end]); ok {
+				hint = parsed
+			}
+		}
+		hints = append(hints, hint)
+		tokens = tokens[end+1:]
+	}
+	return hints, nil
+}
+
+func errOptimizerHint(text string) error {
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "syntax error in optimizer hints: %s", strings.TrimSpace(text))
+}
+
+// parseOptimizerHint parses the arguments of an optimizer hint.
+func parseOptimizerHint(name string, syntax hintSyntax, args []hintToken) (*OptimizerHint, bool) {
+	hint := &OptimizerHint{Name: name}
+	if syntax == hintSetVar {
+		if len(args) < 3 || args[0].kind != hintWord || args[1].kind != '=' {
+			return nil, false
+		}
+		var value []string
+		for _, arg := range args[2:] {
+			if arg.kind != hintWord && arg.kind != hintString {
+				return nil, false
+			}
+			value = append(value, arg.val)
+		}
+		hint.Args = []string{args[0].val, strings.Join(value, " ")}
+		return hint, true
+	}
+
+	if syntax != hintValue && len(args) >= 2 && args[0].kind == '@' && args[1].kind == hintWord {
+		hint.QueryBlock = args[1].val
+		args = args[2:]
+	}
+	// words returns the words separated by optional commas
+	words := func(args []hintToken) ([]string, bool) {
+		var words []string
+		for i, arg := range args {
+			switch {
+			case arg.kind == hintWord:
+				words = append(words, arg.val)
+			case arg.kind != ',' || i == 0 || i == len(args)-1 || args[i-1].kind == ',':
+				return nil, false
+			}
+		}
+		return words, true
+	}
+	// table parses a table at the start of the arguments
+	table := func(args []hintToken) (HintTable, []hintToken, bool) {
+		if len(args) == 0 || args[0].kind != hintWord {
+			return HintTable{}, nil, false
+		}
+		table := HintTable{Name: args[0].val}
+		args = args[1:]
+		if len(args) >= 2 && args[0].kind == '@' && args[1].kind == hintWord {
+			table.QueryBlock = args[1].val
+			args = args[2:]
+		}
+		return table, args, true
+	}
+
+	switch syntax {
+	case hintTables:
+		for len(args) > 0 {
+			t, rest, ok := table(args)
+			if !ok {
+				return nil, false
+			}
+			hint.Tables = append(hint.Tables, t)
+			args = rest
+			if len(args) > 0 && args[0].kind == ',' {
+				if args = args[1:]; len(args) == 0 {
+					return nil, false
+				}
+			}
+		}
+	case hintIndexes:
+		t, rest, ok := table(args)
+		if !ok {
+			return nil, false
+		}
+		hint.Tables = []HintTable{t}
+		if hint.Indexes, ok = words(rest); !ok {
+			return nil, false
+		}
+	case hintStrategies:
+		strategies, ok := words(args)
+		if !ok {
+			return nil, false
+		}
+		for _, strategy := range strategies {
+			hint.Args = append(hint.Args, strings.ToUpper(strategy))
+		}
+	case hintValue:
+		if len(args) != 1 || args[0].kind != hintWord {
+			return nil, false
+		}
+		if name == "MAX_EXECUTION_TIME" {
+			if _, err := strconv.ParseUint(args[0].val, 10, 64); err != nil {
+				return nil, false
+			}
+		}
+		hint.Args = []string{args[0].val}
+	}
+	return hint, true
+}
+
+// Token kinds of the optimizer hints, besides the punctuation characters.
+const (
+	hintWord   = 'w'
+	hintString = 's'
+)
+
+// hintToken is a token of the optimizer hints, at the offset pos of their
+// text.
+type hintToken struct {
+	kind byte
+	val  string
+	pos  int
+}
+
+// scanOptimizerHints splits the text of optimizer hints into words, which
+// are unquoted if they were quoted identifiers, quoted strings and
+// punctuation. The other characters are single tokens, which only the
+// arguments of unknown hints may hold.
+func scanOptimizerHints(text string) ([]hintToken, error) {
+	var tokens []hintToken
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')' || c == ',' || c == '@' || c == '=':
+			tokens = append(tokens, hintToken{kind: c, pos: i})
+			i++
+		case c == '`' || c == '\'' || c == '"':
+			// a quote is escaped by doubling it
+			j := i + 1
+			for ; j < len(text); j++ {
+				if text[j] == c {
+					if j+1 < len(text) && text[j+1] == c {
+						j++
+						continue
+					}
+					break
+				}
+			}
+			if j == len(text) {
+				return nil, errOptimizerHint(text)
+			}
+			if c == '`' {
+				tokens = append(tokens, hintToken{kind: hintWord, val: strings.ReplaceAll(text[i+1:j], "``", "`"), pos: i})
+			} else {
+				tokens = append(tokens, hintToken{kind: hintString, val: text[i : j+1], pos: i})
+			}
+			i = j + 1
+		case isHintWordChar(c):
+			j := i
+			for j < len(text) && isHintWordChar(text[j]) {
+				j++
+			}
+			tokens = append(tokens, hintToken{kind: hintWord, val: text[i:j], pos: i})
+			i = j
+		default:
+			tokens = append(tokens, hintToken{kind: c, pos: i})
+			i++
+		}
+	}
+	return tokens, nil
+}
+
+func isHintWordChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '_' || c == '$' || c == '.' || c == '-' || c == '+' || c >= 0x80
+}
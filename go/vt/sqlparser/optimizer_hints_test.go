@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOptimizerHints(t *testing.T) {
+	testCases := []struct {
+		in, out string
+	}{{
+		in:  "/*+ BKA(t1) NO_BKA(t2) */",
+		out: "BKA(t1) NO_BKA(t2)",
+	}, {
+		in:  "bka(@qb1 t1@qb1, t2@qb2)",
+		out: "BKA(@qb1 t1@qb1, t2@qb2)",
+	}, {
+		in:  "JOIN_ORDER(t1 t2) JOIN_PREFIX(t3) JOIN_FIXED_ORDER()",
+		out: "JOIN_ORDER(t1, t2) JOIN_PREFIX(t3) JOIN_FIXED_ORDER()",
+	}, {
+		in:  "INDEX(t1 idx_a,idx_b) NO_INDEX(@sel_2 `order` PRIMARY) NO_ICP(t1)",
+		out: "INDEX(t1 idx_a, idx_b) NO_INDEX(@sel_2 order PRIMARY) NO_ICP(t1)",
+	}, {
+		in:  "SEMIJOIN(@subq1 firstmatch, loosescan) SUBQUERY(materialization)",
+		out: "SEMIJOIN(@subq1 FIRSTMATCH, LOOSESCAN) SUBQUERY(MATERIALIZATION)",
+	}, {
+		in:  "MAX_EXECUTION_TIME(1000) RESOURCE_GROUP(batch) QB_NAME(qb1)",
+		out: "MAX_EXECUTION_TIME(1000) RESOURCE_GROUP(batch) QB_NAME(qb1)",
+	}, {
+		in:  "SET_VAR(sort_buffer_size=16M) SET_VAR(sql_mode = 'STRICT_ALL_TABLES')",
+		out: "SET_VAR(sort_buffer_size = 16M) SET_VAR(sql_mode = 'STRICT_ALL_TABLES')",
+	}, {
+		in:  "INDEX(`my table` `my``index`)",
+		out: "INDEX(`my table` `my``index`)",
+	}, {
+		in:  "/*+ FOO(bar) BKA(t1) */",
+		out: "FOO(bar) BKA(t1)",
+	}, {
+		in:  "BKA(t1) no_such_hint( @qb1 t1 , 1.5*2 'x''y' ) NO_BKA(t2)",
+		out: "BKA(t1) no_such_hint( @qb1 t1 , 1.5*2 'x''y' ) NO_BKA(t2)",
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.in, func(t *testing.T) {
+			hints, err := ParseOptimizerHints(tc.in)
+			require.NoError(t, err)
+			assert.Equal(t, tc.out, hints.String())
+
+			again, err := ParseOptimizerHints(hints.String())
+			require.NoError(t, err)
+			assert.Equal(t, hints, again)
+		})
+	}
+}
+
+func TestParseOptimizerHintsFields(t *testing.T) {
+	hints, err := ParseOptimizerHints("NO_INDEX(@qb1 t1@qb2 idx) SET_VAR(foreign_key_checks=OFF)")
+	require.NoError(t, err)
+	assert.Equal(t, OptimizerHints{{
+		Name:       "NO_INDEX",
+		QueryBlock: "qb1",
+		Tables:     []HintTable{{Name: "t1", QueryBlock: "qb2"}},
+		Indexes:    []string{"idx"},
+	}, {
+		Name: "SET_VAR",
+		Args: []string{"foreign_key_checks", "OFF"},
+	}}, hints)
+}
+
+func TestParseUnknownOptimizerHints(t *testing.T) {
+	hints, err := ParseOptimizerHints("Foo(bar, `b)z`) BKA(t1)")
+	require.NoError(t, err)
+	assert.Equal(t, OptimizerHints{{
+		Name: "FOO",
+		Raw:  "Foo(bar, `b)z`)",
+	}, {
+		Name:   "BKA",
+		Tables: []HintTable{{Name: "t1"}},
+	}}, hints)
+	assert.Equal(t, "BKA(t1)", hints.Remove("foo").String())
+
+	// the known hints that cannot be parsed are kept as they are written,
+	// and the unknown hints end at their matching parenthesis
+	hints, err = ParseOptimizerHints("INDEX() MAX_EXECUTION_TIME(abc) SET_VAR(aa) BKA(t1,) FOO((a), b) NO_BKA(t2)")
+	require.NoError(t, err)
+	assert.Equal(t, OptimizerHints{
+		{Name: "INDEX", Raw: "INDEX()"},
+		{Name: "MAX_EXECUTION_TIME", Raw: "MAX_EXECUTION_TIME(abc)"},
+		{Name: "SET_VAR", Raw: "SET_VAR(aa)"},
+		{Name: "BKA", Raw: "BKA(t1,)"},
+		{Name: "FOO", Raw: "FOO((a), b)"},
+		{Name: "NO_BKA", Tables: []HintTable{{Name: "t2"}}},
+	}, hints)
+	assert.Equal(t, "INDEX() SET_VAR(aa) BKA(t1,) FOO((a), b) NO_BKA(t2) MAX_EXECUTION_TIME(100)",
+		hints.Remove("MAX_EXECUTION_TIME").Add(NewMaxExecutionTimeHint(100)).String())
+	assert.Equal(t, "INDEX() MAX_EXECUTION_TIME(100) SET_VAR(aa) BKA(t1,) FOO((a), b) NO_BKA(t2)",
+		hints.Replace(NewMaxExecutionTimeHint(100)).String())
+}
+
+func TestParseOptimizerHintsErrors(t *testing.T) {
+	testCases := []struct {
+		in, err string
+	}{{
+		in:  "NO_SUCH_HINT(t1",
+		err: "syntax error in optimizer hints: NO_SUCH_HINT(t1",
+	}, {
+		in:  "BKA(t1",
+		err: "syntax error in optimizer hints: BKA(t1",
+	}, {
+		in:  "BKA t1",
+		err: "syntax error in optimizer hints: BKA t1",
+	}, {
+		in:  "FOO((a)",
+		err: "syntax error in optimizer hints: FOO((a)",
+	}, {
+		in:  "/*+ BKA(t1)",
+		err: "optimizer hint comment is not terminated: /*+ BKA(t1)",
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.in, func(t *testing.T) {
+			_, err := ParseOptimizerHints(tc.in)
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestOptimizerHintsEdit(t *testing.T) {
+	hints, err := ParseOptimizerHints("BKA(t1) MAX_EXECUTION_TIME(10) SET_VAR(a=1) SET_VAR(b=2)")
+	require.NoError(t, err)
+
+	assert.Equal(t, "BKA(t1) MAX_EXECUTION_TIME(10) SET_VAR(a = 1) SET_VAR(b = 2)",
+		hints.Add(NewMaxExecutionTimeHint(10)).String())
+	assert.Equal(t, "BKA(t1) MAX_EXECUTION_TIME(10) SET_VAR(a = 1) SET_VAR(b = 2) MAX_EXECUTION_TIME(20)",
+		hints.Add(NewMaxExecutionTimeHint(20)).String())
+	assert.Equal(t, "BKA(t1) MAX_EXECUTION_TIME(20) SET_VAR(a = 1) SET_VAR(b = 2)",
+		hints.Replace(NewMaxExecutionTimeHint(20)).String())
+	assert.Equal(t, "BKA(t1) MAX_EXECUTION_TIME(10) SET_VAR(a = 1) SET_VAR(B = 3)",
+		hints.Replace(NewSetVarHint("B", "3")).String())
+	assert.Equal(t, "BKA(t1) MAX_EXECUTION_TIME(10) SET_VAR(a = 1) SET_VAR(b = 2) SET_VAR(c = 'x')",
+		hints.Replace(NewSetVarHint("c", "'x'")).String())
+	assert.Equal(t, "BKA(t1) MAX_EXECUTION_TIME(10)", hints.Remove("set_var").String())
+	assert.Equal(t, "", hints.Remove("BKA").Remove("MAX_EXECUTION_TIME").Remove("SET_VAR").String())
+	assert.Equal(t, "MAX_EXECUTION_TIME(10)", hints.Get("max_execution_time").String())
+	assert.Nil(t, hints.Get("NO_BKA"))
+
+	// the edits leave the original list alone
+	assert.Equal(t, "BKA(t1) MAX_EXECUTION_TIME(10) SET_VAR(a = 1) SET_VAR(b = 2)", hints.String())
+}
+
+func TestStatementOptimizerHints(t *testing.T) {
+	testCases := []struct {
+		in, out string
+	}{{
+		in:  "select * from t",
+		out: "select /*+ MAX_EXECUTION_TIME(100) SET_VAR(sort_buffer_size = 16M) */ * from t",
+	}, {
+		in:  "select /* a */ /*+ BKA(t) MAX_EXECUTION_TIME(5) */ * from t",
+		out: "select /*+ BKA(t) MAX_EXECUTION_TIME(100) SET_VAR(sort_buffer_size = 16M) */ /* a */ * from t",
+	}, {
+		in:  "update /*+ SET_VAR(sort_buffer_size = 1M) */ t set a = 1",
+		out: "update /*+ SET_VAR(sort_buffer_size = 16M) MAX_EXECUTION_TIME(100) */ t set a = 1",
+	}, {
+		in:  "select /*+ BKA(t1) */ /* c */ /*+ NO_BKA(t2) */ * from t",
+		out: "select /*+ BKA(t1) MAX_EXECUTION_TIME(100) SET_VAR(sort_buffer_size = 16M) */ /* c */ /*+ NO_BKA(t2) */ * from t",
+	}, {
+		in:  "select /*+ MAX_EXECUTION_TIME(abc) */ * from t",
+		out: "select /*+ MAX_EXECUTION_TIME(100) SET_VAR(sort_buffer_size = 16M) */ * from t",
+	}, {
+		in:  "delete from t",
+		out: "delete /*+ MAX_EXECUTION_TIME(100) SET_VAR(sort_buffer_size = 16M) */ from t",
+	}, {
+		in:  "insert into t values (1)",
+		out: "insert /*+ MAX_EXECUTION_TIME(100) SET_VAR(sort_buffer_size = 16M) */ into t values (1)",
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.in, func(t *testing.T) {
+			stmt, err := Parse(tc.in)
+			require.NoError(t, err)
+			hintStmt := stmt.(SupportOptimizerHint)
+			hints, err := GetOptimizerHints(hintStmt)
+			require.NoError(t, err)
+			hints = hints.Replace(NewMaxExecutionTimeHint(100)).Replace(NewSetVarHint("sort_buffer_size", "16M"))
+			SetOptimizerHints(hintStmt, hints)
+			assert.Equal(t, tc.out, String(stmt))
+
+			SetOptimizerHints(hintStmt, nil)
+			hints, err = GetOptimizerHints(hintStmt)
+			require.NoError(t, err)
+			assert.Empty(t, hints)
+		})
+	}
+	// an empty hint comment keeps MySQL ignoring the next ones
+	stmt, err := Parse("select /*+ BKA(t1) */ /* c */ /*+ NO_BKA(t2) */ * from t")
+	require.NoError(t, err)
+	SetOptimizerHints(stmt.(SupportOptimizerHint), nil)
+	assert.Equal(t, "select /*+ */ /* c */ /*+ NO_BKA(t2) */ * from t", String(stmt))
+}
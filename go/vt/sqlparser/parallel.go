@@ -0,0 +1,238 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"context"
+	"io"
+	"runtime"
+	"strings"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// ParsedStatement is a statement of the input of ParseParallel.
+type ParsedStatement struct {
+	// Index is the 0-based index of the statement in the input.
+	Index int
+	// Offset is the byte offset of the statement in the input.
+	Offset int
+	// SQL is the verbatim text of the statement, without the delimiter
+	// that ends it.
+	SQL       string
+	Statement Statement
+	// Err is the error parsing the statement, or reading the input. The
+	// statement after an error reading the input is the last one. The Pos
+	// of a PositionedErr is the position in the input, like for ParseNext,
+	// not in SQL.
+	Err error
+}
+
+// ParallelOptions are the settings of ParseParallel.
+type ParallelOptions struct {
+	// Parser parses the statements. The default is the parser of the
+	// package-level functions.
+	Parser *Parser
+	// Workers is the number of goroutines parsing statements. The default
+	// is GOMAXPROCS.
+	Workers int
+	// MaxPending is the maximum number of statements split from the input
+	// but not received from the channel yet, which bounds the memory used.
+	// The default is four times the number of workers.
+	MaxPending int
+	// DollarQuotes keeps the bodies quoted with $tag$ in one statement, like
+	// SplitNext does. They are always kept with the PostgreSQL dialect.
+	// MySQL has no dollar-quoted strings, so by default a MySQL input is
+	// split at every delimiter, even within $tag$ ... $tag$.
+	DollarQuotes bool
+}
+
+// ParseParallel parses the statements of the input with a pool of
+// workers, while one goroutine splits the input into statements. The
+// statements are sent to the channel in the order of the input, and the
+// channel is closed after the last one, or once the context is done. The
+// input is not read any further once the context is done.
+func ParseParallel(ctx context.Context, reader io.Reader, opts ParallelOptions) <-chan ParsedStatement {
+	parser := opts.Parser
+	if parser == nil {
+		parser = defaultParser.Load()
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	maxPending := opts.MaxPending
+	if maxPending <= 0 {
+		maxPending = 4 * workers
+	}
+
+	type job struct {
+		stmt   ParsedStatement
+		result chan ParsedStatement
+	}
+	jobs := make(chan job, maxPending)
+	// pending are the results in the order of the input
+	pending := make(chan chan ParsedStatement, maxPending)
+	out := make(chan ParsedStatement)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for j := range jobs {
+				if j.stmt.Err == nil {
+					j.stmt.Statement, j.stmt.Err = parseStatement(parser, j.stmt.SQL, j.stmt.Offset)
+				}
+				j.result <- j.stmt
+			}
+		}()
+	}
+
+	dollarQuotes := opts.DollarQuotes || isPostgres(parser.Dialect())
+
+	go func() {
+		defer close(pending)
+		defer close(jobs)
+		tokenizer := parser.NewReaderTokenizer(reader, WithCacheInBuffer())
+		for index := 0; ; index++ {
+			sql, offset, err := splitNextSource(ctx, tokenizer, dollarQuotes)
+			if err == io.EOF || ctx.Err() != nil {
+				return
+			}
+			j := job{stmt: ParsedStatement{Index: index, Offset: offset, SQL: sql, Err: err}, result: make(chan ParsedStatement, 1)}
+			select {
+			case pending <- j.result:
+			case <-ctx.Done():
+				return
+			}
+			jobs <- j
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	go func() {
+		defer close(out)
+		for result := range pending {
+			select {
+			case stmt := <-result:
+				select {
+				case out <- stmt:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// parseStatement parses a statement split from the input at the offset,
+// like Parser.Parse, but with the position of a syntax error in the input.
+func parseStatement(parser *Parser, sql string, offset int) (Statement, error) {
+	tokenizer := parser.NewStringTokenizer(sql)
+	stmt, _, err := parse2(sql, tokenizer, parser.strictDDL)
+	if posErr, ok := tokenizer.LastError.(PositionedErr); ok && err != nil && (tokenizer.partialDDL == nil || parser.strictDDL) {
+		posErr.Pos += offset
+		err = vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, posErr.Error())
+	}
+	return stmt, err
+}
+
+// splitNextSource returns the verbatim text of the next statement of the
+// tokenizer and its offset, or io.EOF. Unlike SplitNext, it keeps the
+// comments and blanks within the statement, and it only keeps the $tag$
+// bodies in one statement with dollarQuotes. It stops reading the
+// tokenizer with the error of the context once the context is done.
+func splitNextSource(ctx context.Context, tokenizer *Tokenizer, dollarQuotes bool) (string, int, error) {
+	if tokenizer.source == nil {
+		tokenizer.source = &sourceTracker{}
+	}
+	tokenizer.buf.CacheBlanks = true
+	defer func() {
+		tokenizer.buf.CacheBlanks = false
+	}()
+
+	st := tokenizer.source
+	st.stmtStart, st.commentStart = -1, -1
+	begin := tokenizer.absolutePos()
+	var sb strings.Builder
+loop:
+	for tokenizer.LastError == nil {
+		if err := ctx.Err(); err != nil {
+			return "", begin, err
+		}
+		tkn, val := tokenizer.Scan()
+		switch tkn {
+		case DELIMITER_COMMAND:
+			if st.stmtStart >= 0 {
+				tokenizer.resetCache()
+				break loop
+			}
+			sb.WriteString(tokenizer.readCache())
+		case ';':
+			if tokenizer.delimiter != "" {
+				// a semicolon within a statement ended by a custom delimiter
+				sb.WriteString(tokenizer.readCache())
+				break
+			}
+			fallthrough
+		case DELIMITER_END:
+			tokenizer.resetCache()
+			if st.stmtStart >= 0 {
+				break loop
+			}
+			// an empty statement
+			sb.Reset()
+			st.commentStart = -1
+			begin = tokenizer.absolutePos()
+		case 0, eofChar:
+			sb.WriteString(tokenizer.readCache())
+			// the statement may be truncated by a failure of the reader
+			if err := tokenizer.buf.Err(); err != nil {
+				tokenizer.LastError = err
+			}
+			break loop
+		case ID:
+			// without dollarQuotes, $tag$ is an identifier
+			if dollarQuotes && len(val) > 1 && val[0] == '$' && val[len(val)-1] == '$' {
+				sb.WriteString(tokenizer.readCache())
+				body, err := scanProcedureBody(tokenizer, val)
+				tokenizer.buf.CacheBlanks = true
+				if err != nil {
+					break loop
+				}
+				sb.WriteString(body)
+				break
+			}
+			sb.WriteString(tokenizer.readCache())
+		default:
+			sb.WriteString(tokenizer.readCache())
+		}
+	}
+	if tokenizer.LastError != nil {
+		return "", begin, tokenizer.LastError
+	}
+	if st.stmtStart < 0 {
+		return "", begin, io.EOF
+	}
+	text := sb.String()
+	return strings.TrimRight(text[st.stmtStart-begin:], " \t\r\n"), st.stmtStart, nil
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseParallelValid parses all the valid SQL test cases in parallel,
+// and checks they are parsed like ParseNext does, in order.
+func TestParseParallelValid(t *testing.T) {
+	var sb strings.Builder
+	for _, tcase := range validSQL {
+		sb.WriteString(strings.TrimSuffix(tcase.input, ";"))
+		sb.WriteString(";\n")
+	}
+	sql := sb.String()
+
+	tokenizer := NewStringTokenizer(sql)
+	var index int
+	for stmt := range ParseParallel(context.Background(), strings.NewReader(sql), ParallelOptions{Workers: 4, MaxPending: 3}) {
+		require.NoError(t, stmt.Err, stmt.SQL)
+		require.Equal(t, index, stmt.Index)
+		assert.Equal(t, stmt.SQL, sql[stmt.Offset:stmt.Offset+len(stmt.SQL)])
+
+		want, err := ParseNext(tokenizer)
+		require.NoError(t, err)
+		require.Equal(t, String(want), String(stmt.Statement), stmt.SQL)
+		index++
+	}
+	require.Equal(t, len(validSQL), index)
+}
+
+func TestParseParallel(t *testing.T) {
+	sql := "/* setup */ select 1;;\n" +
+		"select /*+ MAX_EXECUTION_TIME(10) */ a\n  from t -- all of them\n;\n" +
+		"select * form t;\n" +
+		"DELIMITER $$\n" +
+		"create procedure p() begin select 1; end $$\n" +
+		"DELIMITER ;\n" +
+		"select 2"
+	parser, err := New(Options{MySQLServerVersion: "5.7.9"})
+	require.NoError(t, err)
+
+	var stmts []ParsedStatement
+	for stmt := range ParseParallel(context.Background(), iotest.OneByteReader(strings.NewReader(sql)), ParallelOptions{Parser: parser}) {
+		stmts = append(stmts, stmt)
+	}
+	require.Len(t, stmts, 5)
+
+	wantSQL := []string{
+		"select 1",
+		"select /*+ MAX_EXECUTION_TIME(10) */ a\n  from t -- all of them",
+		"select * form t",
+		"create procedure p() begin select 1; end",
+		"select 2",
+	}
+	for i, stmt := range stmts {
+		assert.Equal(t, i, stmt.Index)
+		assert.Equal(t, wantSQL[i], stmt.SQL)
+		assert.Equal(t, stmt.SQL, sql[stmt.Offset:stmt.Offset+len(stmt.SQL)])
+	}
+	assert.Equal(t, "select /*+ MAX_EXECUTION_TIME(10) */ a from t", String(stmts[1].Statement))
+	// the position of the error is in the input, like for ParseNext
+	assert.EqualError(t, stmts[2].Err, "syntax error at position 102 near 'form'")
+	tokenizer := parser.NewStringTokenizer(sql)
+	var want error
+	for want == nil {
+		_, want = ParseNext(tokenizer)
+	}
+	assert.EqualError(t, stmts[2].Err, want.Error())
+	assert.Nil(t, stmts[2].Statement)
+	assert.NoError(t, stmts[3].Err)
+}
+
+func TestParseParallelReadError(t *testing.T) {
+	readErr := errors.New("connection reset")
+	reader := io.MultiReader(strings.NewReader("select 1;\nselect 2 from t where"), iotest.ErrReader(readErr))
+
+	var stmts []ParsedStatement
+	for stmt := range ParseParallel(context.Background(), reader, ParallelOptions{}) {
+		stmts = append(stmts, stmt)
+	}
+	require.Len(t, stmts, 2)
+	assert.NoError(t, stmts[0].Err)
+	assert.ErrorIs(t, stmts[1].Err, readErr)
+	assert.Equal(t, 1, stmts[1].Index)
+}
+
+func TestParseParallelCancel(t *testing.T) {
+	sql := strings.Repeat("select 1 from dual;\n", 1000)
+	ctx, cancel := context.WithCancel(context.Background())
+	stmts := ParseParallel(ctx, strings.NewReader(sql), ParallelOptions{Workers: 2, MaxPending: 2})
+
+	first := <-stmts
+	require.NoError(t, first.Err)
+	cancel()
+
+	// the channel is closed soon after the cancellation
+	count := 1
+	for range stmts {
+		count++
+	}
+	assert.Less(t, count, 1000)
+}
+
+func TestParseParallelDollarQuotes(t *testing.T) {
+	const sql = "select $body$ a; b $body$ from t; select 2"
+	postgres, err := New(Options{Dialect: PostgresDialect{}})
+	require.NoError(t, err)
+
+	split := func(opts ParallelOptions) []string {
+		var sqls []string
+		for stmt := range ParseParallel(context.Background(), strings.NewReader(sql), opts) {
+			sqls = append(sqls, stmt.SQL)
+		}
+		return sqls
+	}
+	// MySQL has no dollar-quoted strings
+	assert.Equal(t, []string{"select $body$ a", "b $body$ from t", "select 2"}, split(ParallelOptions{}))
+	assert.Equal(t, []string{"select $body$ a; b $body$ from t", "select 2"}, split(ParallelOptions{DollarQuotes: true}))
+	assert.Equal(t, []string{"select $body$ a; b $body$ from t", "select 2"}, split(ParallelOptions{Parser: postgres}))
+}
+
+// countingReader counts the bytes read from a reader.
+type countingReader struct {
+	io.Reader
+	n atomic.Int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.n.Add(int64(n))
+	return n, err
+}
+
+func TestParseParallelCancelStopsReading(t *testing.T) {
+	// a single long statement is never sent, the cancellation is only seen
+	// while reading it
+	reader := &countingReader{Reader: iotest.OneByteReader(strings.NewReader("select " + strings.Repeat("1 + ", 1<<20) + "1"))}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for range ParseParallel(ctx, reader, ParallelOptions{}) {
+		t.Fatal("no statement is sent after the cancellation")
+	}
+	assert.Less(t, reader.n.Load(), int64(1000))
+}
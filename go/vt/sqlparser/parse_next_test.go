@@ -18,9 +18,11 @@ package sqlparser
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -138,6 +140,14 @@ func TestParseNextEdgeCases(t *testing.T) {
 		name:  "Partial DDL",
 		input: "create table a ignore me this is garbage; select 1 from a",
 		want:  []string{"create table a", "select 1 from a"},
+	}, {
+		name:  "Delimiter",
+		input: "DELIMITER $$\nselect 1 from a$$\nupdate a set b = ';' $$\nDELIMITER ;\nselect 3 from c;",
+		want:  []string{"select 1 from a", "update a set b = ';'", "select 3 from c"},
+	}, {
+		name:  "Delimiter at the end",
+		input: "delimiter //\nselect 1 from a //\ndelimiter ;\n",
+		want:  []string{"select 1 from a"},
 	}}
 
 	for _, test := range tests {
@@ -196,3 +206,160 @@ func TestParseNextStrictNonStrict(t *testing.T) {
 		t.Fatalf("ParseNext(%q) = %q, want %q", input, got, want)
 	}
 }
+
+func TestParseNextReadError(t *testing.T) {
+	readErr := errors.New("connection reset")
+	sql := "select 1 from dual;\nselect 2 from t where"
+	tokens := NewReaderTokenizer(io.MultiReader(strings.NewReader(sql), iotest.ErrReader(readErr)))
+
+	tree, err := ParseNext(tokens)
+	require.NoError(t, err)
+	assert.Equal(t, "select 1 from dual", String(tree))
+
+	// the second statement is truncated by the failure of the reader
+	_, err = ParseNext(tokens)
+	var rerr *ReadError
+	require.ErrorAs(t, err, &rerr)
+	assert.Equal(t, len(sql), rerr.Offset)
+	assert.ErrorIs(t, err, readErr)
+	assert.Equal(t, err, tokens.LastError)
+
+	_, err = ParseNext(tokens)
+	assert.ErrorIs(t, err, readErr)
+}
+
+func TestParseNextReadErrorAfterStatement(t *testing.T) {
+	readErr := errors.New("connection reset")
+	tokens := NewReaderTokenizer(io.MultiReader(strings.NewReader("select 1 from dual"), iotest.ErrReader(readErr)))
+
+	// the statement looks complete, but the rest of it may be missing
+	_, err := ParseNext(tokens)
+	assert.ErrorIs(t, err, readErr)
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string { return "i/o timeout" }
+func (timeoutError) Timeout() bool { return true }
+
+// chunkReader returns a chunk per read, timing out for the empty ones.
+type chunkReader []string
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	if len(*r) == 0 {
+		return 0, io.EOF
+	}
+	chunk := (*r)[0]
+	*r = (*r)[1:]
+	if chunk == "" {
+		return 0, timeoutError{}
+	}
+	return copy(p, chunk), nil
+}
+
+func TestParseNextReadErrorTemporary(t *testing.T) {
+	tokens := NewReaderTokenizer(&chunkReader{"select 1 from dual; select 2 ", "", "from t;\nselect 3 ", "", "from u; select 4 from dual"})
+
+	tree, err := ParseNext(tokens)
+	require.NoError(t, err)
+	assert.Equal(t, "select 1 from dual", String(tree))
+	_, err = ParseNext(tokens)
+	var rerr *ReadError
+	require.ErrorAs(t, err, &rerr)
+	assert.Equal(t, len("select 1 from dual; select 2 "), rerr.Offset)
+	assert.ErrorIs(t, err, timeoutError{})
+
+	// the reader is retried, and the rest of the failed statement skipped
+	_, err = ParseNext(tokens)
+	assert.ErrorIs(t, err, timeoutError{})
+	tree, err = ParseNext(tokens)
+	require.NoError(t, err)
+	assert.Equal(t, "select 4 from dual", String(tree))
+	_, err = ParseNext(tokens)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestParseNextWithSource(t *testing.T) {
+	sql := "  select 1 from dual ;\n" +
+		"-- add a column\n" +
+		"/* twice */ alter table t\n  add column b int;;\n" +
+		"select /*!80000 1 + */ 2 from dual;\n" +
+		"DELIMITER $$\n" +
+		"create procedure p() begin select 1; end $$\n" +
+		"DELIMITER ;\n" +
+		"select 'a;b'\n"
+	type source struct {
+		sql        string
+		start, end int
+		line       int
+	}
+	want := []source{
+		{sql: "select 1 from dual", start: 2, end: 20, line: 1},
+		{sql: "alter table t\n  add column b int", start: 51, end: 83, line: 3},
+		{sql: "select /*!80000 1 + */ 2 from dual", start: 86, end: 120, line: 5},
+		{sql: "create procedure p() begin select 1; end", start: 135, end: 175, line: 7},
+		{sql: "select 'a;b'", start: 191, end: 203, line: 9},
+	}
+	withComments := []string{
+		"select 1 from dual",
+		"-- add a column\n/* twice */ alter table t\n  add column b int",
+		"select /*!80000 1 + */ 2 from dual",
+		"create procedure p() begin select 1; end",
+		"select 'a;b'",
+	}
+
+	tokenizers := map[string]func(...TokenizerOpt) *Tokenizer{
+		"string": func(opts ...TokenizerOpt) *Tokenizer {
+			return NewStringTokenizer(sql, opts...)
+		},
+		"reader": func(opts ...TokenizerOpt) *Tokenizer {
+			reader := iotest.OneByteReader(strings.NewReader(sql))
+			return NewReaderTokenizer(reader, append(opts, WithCacheInBuffer())...)
+		},
+	}
+	for name, newTokenizer := range tokenizers {
+		t.Run(name, func(t *testing.T) {
+			tokenizer := newTokenizer()
+			for _, w := range want {
+				src, err := ParseNextWithSource(tokenizer)
+				require.NoError(t, err)
+				assert.Equal(t, w, source{sql: src.SQL, start: src.Start, end: src.End, line: src.Line})
+				assert.Equal(t, w.sql, sql[src.Start:src.End])
+				assert.NotNil(t, src.Statement)
+			}
+			_, err := ParseNextWithSource(tokenizer)
+			require.Equal(t, io.EOF, err)
+
+			tokenizer = newTokenizer(WithLeadingComments())
+			for _, w := range withComments {
+				src, err := ParseNextWithSource(tokenizer)
+				require.NoError(t, err)
+				assert.Equal(t, w, src.SQL)
+				assert.Equal(t, w, sql[src.Start:src.End])
+			}
+		})
+	}
+}
+
+func TestParseNextWithSourceErrors(t *testing.T) {
+	sql := "select 1;\nselect * form t;\nselect 2"
+	tokenizer := NewStringTokenizer(sql)
+	_, err := ParseNextWithSource(tokenizer)
+	require.NoError(t, err)
+
+	src, err := ParseNextWithSource(tokenizer)
+	require.EqualError(t, err, "syntax error at position 24 near 'form'")
+	assert.Nil(t, src.Statement)
+	assert.Equal(t, "select * form t", src.SQL)
+	assert.Equal(t, 2, src.Line)
+
+	src, err = ParseNextWithSource(tokenizer)
+	require.NoError(t, err)
+	assert.Equal(t, "select 2", src.SQL)
+	assert.Equal(t, 3, src.Line)
+
+	tokenizer = NewReaderTokenizer(strings.NewReader(sql))
+	_, err = ParseNextWithSource(tokenizer)
+	var cacheErr *CacheError
+	require.ErrorAs(t, err, &cacheErr)
+}
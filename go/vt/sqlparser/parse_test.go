@@ -661,6 +661,29 @@ var (
 	}, {
 		input:  "select 1 from dual union select 2 from dual union all select 3 from dual union select 4 from dual union all select 5 from dual",
 		output: "select 1 from dual union select 2 from dual union all select 3 from dual union select 4 from dual union all select 5 from dual",
+	}, {
+		input: "select /* intersect */ 1 from t intersect select 1 from t",
+	}, {
+		input:  "select /* intersect distinct */ 1 from t intersect distinct select 1 from t except all select 2 from t",
+		output: "select /* intersect distinct */ 1 from t intersect select 1 from t except all select 2 from t",
+	}, {
+		input: "select 1 from dual union select 2 from dual intersect all select 3 from dual",
+	}, {
+		input:  "(select 1 from dual union select 2 from dual) intersect select 3 from dual",
+		output: "(select 1 from dual union select 2 from dual) intersect select 3 from dual",
+	}, {
+		input:  "select 1 from dual except (select 2 from dual except select 3 from dual)",
+		output: "select 1 from dual except (select 2 from dual except select 3 from dual)",
+	}, {
+		input:  "select 1 from dual union (select 2 from dual union all select 3 from dual)",
+		output: "select 1 from dual union (select 2 from dual union all select 3 from dual)",
+	}, {
+		input:  "(select 1 from dual except select 2 from dual) union select 3 from dual",
+		output: "select 1 from dual except select 2 from dual union select 3 from dual",
+	}, {
+		input: "select a from t intersect select b from u order by a asc limit 1 for update",
+	}, {
+		input: "with x as (select 1 from dual) select * from x except select 2 from dual",
 	}, {
 		input:  "(select 1 from dual) order by 1 asc limit 2",
 		output: "select 1 from dual order by 1 asc limit 2",
@@ -2096,6 +2119,110 @@ var (
 	}, {
 		input:  "drop view if exists a cascade",
 		output: "drop view if exists a",
+	}, {
+		input:  "create definer = `root`@`localhost` procedure if not exists db.p() comment 'proc' begin end",
+		output: "create definer = root@localhost procedure if not exists db.p() comment 'proc' begin end",
+	}, {
+		input: "create function f(a int, b varchar(10)) returns int no sql return a + 1",
+	}, {
+		input: "create trigger t before insert on tbl for each row insert into log(a) values (new.a)",
+	}, {
+		input: "create event e on schedule at now() + interval 1 hour do delete from t",
+	}, {
+		input: "alter event e on schedule every 2 hour on completion preserve rename to e2 enable comment 'c' do delete from t",
+	}, {
+		input: "alter definer = u@h event e disable",
+	}, {
+		input: "alter procedure p comment 'p' modifies sql data",
+	}, {
+		input: "alter function f language sql contains sql not deterministic sql security definer",
+	}, {
+		input: "drop procedure if exists db.p",
+	}, {
+		input: "drop function f",
+	}, {
+		input: "drop trigger if exists db.t",
+	}, {
+		input: "drop /* c */ event e",
+	}, {
+		input: "grant select, insert (a, b) on db.* to 'u'@'%' with grant option",
+	}, {
+		input:  "GRANT ALL PRIVILEGES ON *.* TO u@localhost",
+		output: "grant all on *.* to 'u'@'localhost'",
+	}, {
+		input: "grant execute on procedure db.p to 'u'@'h'",
+	}, {
+		input: "grant create routine, replication slave, backup_admin on *.* to 'u'",
+	}, {
+		input: "grant select on table t to 'u'",
+	}, {
+		input:  "grant r1, r2 to u with admin option",
+		output: "grant 'r1', 'r2' to 'u' with admin option",
+	}, {
+		input: "revoke if exists update on db.t from 'u'@'%' ignore unknown user",
+	}, {
+		input:  "revoke all privileges, grant option from 'u'",
+		output: "revoke all, grant option from 'u'",
+	}, {
+		input: "revoke 'r1' from 'u'",
+	}, {
+		input: "grant proxy on 'a'@'h' to 'b' with grant option",
+	}, {
+		input:  "revoke if exists proxy on a from b, c",
+		output: "revoke if exists proxy on 'a' from 'b', 'c'",
+	}, {
+		input:  `grant select on db.* to 'u'@"h h"`,
+		output: "grant select on db.* to 'u'@'h h'",
+	}, {
+		input:  `select @'a b', @"c" from dual`,
+		output: "select @`a b`, @c from dual",
+	}, {
+		input: "create user 'u' password expire",
+	}, {
+		input: "create user 'u' identified by 'x' password expire interval 90 day account lock",
+	}, {
+		input: "alter user 'u' password expire never",
+	}, {
+		input: "alter user 'u' password expire default account unlock",
+	}, {
+		input:  "ALTER USER IF EXISTS USER() IDENTIFIED BY 'new'",
+		output: "alter user if exists user() identified by 'new'",
+	}, {
+		input: "create user if not exists 'u'@'%' identified by 'secret', 'v' identified with caching_sha2_password by random password default role 'r1' account lock",
+	}, {
+		input:  "create user 'u' identified with 'mysql_native_password' as '*ABC'",
+		output: "create user 'u' identified with mysql_native_password as '*ABC'",
+	}, {
+		input: "alter user if exists 'u' identified by 'x' account unlock",
+	}, {
+		input: "alter user 'u'@'h' identified by 'x' replace 'y', 'v' identified with caching_sha2_password by random password replace 'z'",
+	}, {
+		input:  "ALTER USER USER() IDENTIFIED BY 'new' REPLACE 'old'",
+		output: "alter user user() identified by 'new' replace 'old'",
+	}, {
+		input: "drop user if exists 'u', 'v'@'h'",
+	}, {
+		input:  "rename user a to b, 'c'@'d' to e",
+		output: "rename user 'a' to 'b', 'c'@'d' to 'e'",
+	}, {
+		input: "create role if not exists 'r1', 'r2'",
+	}, {
+		input: "drop role 'r1'",
+	}, {
+		input: "set role default",
+	}, {
+		input: "set role none",
+	}, {
+		input: "set role 'r1', 'r2'",
+	}, {
+		input:  "set role all except r1, 'r2'@'h'",
+		output: "set role all except 'r1', 'r2'@'h'",
+	}, {
+		input: "set default role all to 'u', 'v'",
+	}, {
+		input: "set password = 'x'",
+	}, {
+		input: "set password for 'u'@'h' to random",
 	}, {
 		input:  "drop index b on a lock = none algorithm default",
 		output: "alter table a drop key b, lock none, algorithm = default",
@@ -3718,6 +3845,39 @@ func TestInvalid(t *testing.T) {
 	}{{
 		input: "select a, b from (select * from tbl) sort by a",
 		err:   "syntax error",
+	}, {
+		input: "create procedure p() l1: begin end l2",
+		err:   "end label without match at position 38 near 'l2'",
+	}, {
+		input: "create procedure p() begin signal sqlstate '45000' set foo = 1; end",
+		err:   "unknown condition information item at position 64",
+	}, {
+		input: "create event e on schedule at now() disable on master do select 1",
+		err:   "expecting slave or replica after disable on at position 54 near 'master'",
+	}, {
+		input: "create procedure p() set t.a = 1",
+		err:   "syntax error at position 29 near 'a'",
+	}, {
+		input: "create procedure p() create function f() returns int return 1",
+		err:   "can't create a stored program from within another stored program at position 62",
+	}, {
+		input: "create procedure p() create table t (a int) foo",
+		err:   "syntax error at position 48 near 'foo'",
+	}, {
+		input: "create or replace procedure p() begin end",
+		err:   "syntax error at position 42",
+	}, {
+		input: "create function f() returns int (select 1)",
+		err:   "syntax error at position 40 near 'select'",
+	}, {
+		input: "grant select to u",
+		err:   "privileges require an ON clause at position 18",
+	}, {
+		input: "grant r1@h on *.* to u",
+		err:   "roles cannot be granted on a privilege level at position 23",
+	}, {
+		input: "grant alter table on *.* to u",
+		err:   "syntax error at position 18 near 'table'",
 	}, {
 		input: "/*!*/",
 		err:   "Query was empty",
@@ -4530,6 +4690,91 @@ func TestLoadData(t *testing.T) {
 		_, err := Parse(tcase)
 		require.NoError(t, err)
 	}
+
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input: "load data infile 'x.txt' into table t",
+	}, {
+		input:  "load data low_priority local infile '/tmp/a.csv' ignore into table db.t partition (p0, p1) character set 'utf8mb4'",
+		output: "load data low_priority local infile '/tmp/a.csv' ignore into table db.t partition (p0, p1) character set 'utf8mb4'",
+	}, {
+		input:  "LOAD DATA CONCURRENT INFILE 'a' REPLACE INTO TABLE t COLUMNS ESCAPED BY '\\\\' TERMINATED BY ',' IGNORE 2 ROWS",
+		output: "load data concurrent infile 'a' replace into table t fields terminated by ',' escaped by '\\\\' ignore 2 lines",
+	}, {
+		input:  "load data infile 'a' into table t fields terminated by '\\t' optionally enclosed by '\"' lines starting by '>' terminated by '\\n' ignore 1 lines (a, @b, c) set d = @b * 2, e = now()",
+		output: "load data infile 'a' into table t fields terminated by '\\t' optionally enclosed by '\\\"' lines starting by '>' terminated by '\\n' ignore 1 lines (a, @b, c) set d = @b * 2, e = now()",
+	}, {
+		input: "load data infile 'a' into table t lines terminated by '' ()",
+	}}
+	for _, tcase := range testCases {
+		want := tcase.output
+		if want == "" {
+			want = tcase.input
+		}
+		tree, err := Parse(tcase.input)
+		require.NoError(t, err, tcase.input)
+		assert.Equal(t, want, String(tree))
+	}
+
+	tree, err := Parse("load data local infile '/tmp/it''s.csv' into table db.t (a, @b) set c = @b")
+	require.NoError(t, err)
+	load := tree.(*Load)
+	assert.True(t, load.Local)
+	assert.Equal(t, "/tmp/it's.csv", load.FileName)
+	assert.Equal(t, "db.t", String(load.Table))
+	assert.Equal(t, Exprs{NewColName("a"), NewVariableExpression("b", SingleAt)}, load.Columns)
+	assert.Equal(t, "c = @b", String(load.SetExprs))
+
+	var cols []string
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if col, ok := node.(*ColName); ok {
+			cols = append(cols, col.Name.String())
+		}
+		return true, nil
+	}, load)
+	assert.Equal(t, []string{"a", "c"}, cols)
+}
+
+func TestAccountManagement(t *testing.T) {
+	tree, err := Parse("grant select (a), backup_admin on db.* to 'u'@'%', v@localhost with grant option")
+	require.NoError(t, err)
+	grant := tree.(*Grant)
+	assert.Equal(t, []*GrantPrivilege{
+		{Name: "select", Columns: Columns{NewIdentifierCI("a")}},
+		{Name: "backup_admin"},
+	}, grant.Privileges)
+	assert.Equal(t, &PrivilegeLevel{DB: NewIdentifierCS("db")}, grant.On)
+	assert.Equal(t, []*Account{{User: "u", Host: "%"}, {User: "v", Host: "localhost"}}, grant.To)
+	assert.True(t, grant.WithGrantOption)
+
+	tree, err = Parse("create user 'it''s'@'10.0.0.%' identified with caching_sha2_password by 'secret' account lock")
+	require.NoError(t, err)
+	createUser := tree.(*CreateUser)
+	require.Len(t, createUser.Users, 1)
+	assert.Equal(t, &Account{User: "it's", Host: "10.0.0.%"}, createUser.Users[0].Account)
+	assert.Equal(t, "caching_sha2_password", createUser.Users[0].Auth.Plugin.String())
+	assert.Equal(t, NewStrLiteral("secret"), createUser.Users[0].Auth.Password)
+	assert.Equal(t, AccountLocked, createUser.AccountLock)
+
+	for _, sql := range []string{
+		"grant all on *.* to u",
+		"revoke r1 from u",
+		"create user u",
+		"alter user u account lock",
+		"drop user u",
+		"rename user u to v",
+		"create role r",
+		"drop role r",
+		"set role all",
+		"set default role none to u",
+		"set password = 'x'",
+	} {
+		tree, err := Parse(sql)
+		require.NoError(t, err, sql)
+		assert.Equal(t, StmtPriv, ASTToStatementType(tree), sql)
+	}
 }
 
 func TestCreateTable(t *testing.T) {
@@ -5750,6 +5995,148 @@ func TestCreateTableEscaped(t *testing.T) {
 	}
 }
 
+// TestStoredPrograms covers the stored programs with compound statement
+// bodies, which can't be concatenated by TestParseNextValid since their
+// inner statements are terminated by ';'.
+func TestStoredPrograms(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input: "create procedure p(in a int, out b varchar(10), inout c int) deterministic sql security invoker begin declare x int default 1; select x from dual; end",
+	}, {
+		input: "create procedure p() l1: begin declare c cursor for select a from t; declare continue handler for not found, sqlwarning, 1062 set @done = 1; open c; l2: loop fetch c into a, b; leave l2; end loop l2; close c; end l1",
+	}, {
+		input:  "create procedure p() begin declare done condition for sqlstate value '45000'; declare exit handler for done, sqlexception begin end; end",
+		output: "create procedure p() begin declare done condition for sqlstate '45000'; declare exit handler for done, sqlexception begin end; end",
+	}, {
+		input:  "create procedure p(a int) begin while a > 0 do set @a = a - 1; iterate w; end while; repeat set @a = a + 1; until a > 10 end repeat; fetch next from c into x; end",
+		output: "create procedure p(a int) begin while a > 0 do set @a = a - 1; iterate w; end while; repeat set @a = a + 1; until a > 10 end repeat; fetch c into x; end",
+	}, {
+		input: "create procedure p(a int) begin case a when 1 then select 1 from dual; else select 2 from dual; end case; case when a > 1 then call q(a); end case; end",
+	}, {
+		input: "create procedure p() begin signal sqlstate '45000' set message_text = 'bad', mysql_errno = 1001; resignal; resignal sqlstate '01000'; end",
+	}, {
+		input: "create function f(a int) returns int reads sql data begin declare c int; if a > 1 then set @c = 1; elseif a = 0 then set @c = 2; else set @c = 3; end if; return c; end",
+	}, {
+		input:  "create definer = current_user() trigger if not exists db.t after delete on tbl for each row precedes t2 begin delete from c where id = old.id; end",
+		output: "create definer = current_user trigger if not exists db.t after delete on tbl for each row precedes t2 begin delete from c where id = old.id; end",
+	}, {
+		input: "create event if not exists e on schedule every 1 day starts now() ends now() + interval 1 year on completion not preserve disable on slave comment 'purge' do begin delete from t; end",
+	}, {
+		input:  "create trigger t before insert on tbl for each row begin SET NEW.a = 1; IF NEW.a < 0 THEN SET NEW.a = 0; END IF; set old.b = new.b; end",
+		output: "create trigger t before insert on tbl for each row begin set NEW.a = 1; if NEW.a < 0 then set NEW.a = 0; end if; set old.b = new.b; end",
+	}, {
+		input:  "create procedure p() begin declare x int; SET x = 1, @y = x, @@session.sql_mode = 'x'; set @z = 1; set names utf8mb4; end",
+		output: "create procedure p() begin declare x int; set x = 1, @y = x, @@sql_mode = 'x'; set @z = 1; set names 'utf8mb4'; end",
+	}, {
+		input:  "create procedure p() begin declare c int; SELECT COUNT(*) INTO c FROM t; select a, b from t into @a, c; end",
+		output: "create procedure p() begin declare c int; select count(*) from t into c; select a, b from t into @a, c; end",
+	}, {
+		input:  "create procedure p() begin start transaction; create table t (a int); drop table t; truncate t; commit; end",
+		output: "create procedure p() begin start transaction; create table t (\n\ta int\n); drop table t; truncate table t; commit; end",
+	}, {
+		input:  "create procedure p() begin start transaction read only; set transaction isolation level read committed; savepoint s; release savepoint s; end",
+		output: "create procedure p() begin start transaction read only; set @@transaction_isolation = 'read-committed'; savepoint s; release savepoint s; end",
+	}, {
+		input:  "create procedure p() begin alter table t add column b int; rename table t to u; create index i on u (b); show tables; use db; flush tables; end",
+		output: "create procedure p() begin alter table t add column b int; rename table t to u; alter table u add index i (b); show tables; use db; flush tables; end",
+	}}
+	var sql strings.Builder
+	sql.WriteString("delimiter $$\n")
+	for _, tcase := range testCases {
+		want := tcase.output
+		if want == "" {
+			want = tcase.input
+		}
+		tree, err := Parse(tcase.input)
+		require.NoError(t, err, tcase.input)
+		assert.Equal(t, want, String(tree))
+
+		// the output must parse back to the same tree
+		again, err := Parse(want)
+		require.NoError(t, err, want)
+		assert.True(t, Equals.Statement(tree, again), want)
+
+		sql.WriteString(tcase.input)
+		sql.WriteString("$$\n")
+	}
+
+	// with a custom delimiter, the bodies are read as part of the statements
+	tokens := NewStringTokenizer(sql.String())
+	for _, tcase := range testCases {
+		tree, err := ParseNext(tokens)
+		require.NoError(t, err, tcase.input)
+		assert.IsType(t, &BeginEndBlock{}, storedProgramBody(tree), tcase.input)
+	}
+	_, err := ParseNext(tokens)
+	assert.Equal(t, io.EOF, err)
+}
+
+func storedProgramBody(stmt Statement) Statement {
+	switch stmt := stmt.(type) {
+	case *CreateProcedure:
+		return stmt.Body
+	case *CreateFunction:
+		return stmt.Body
+	case *CreateTrigger:
+		return stmt.Body
+	case *CreateEvent:
+		return stmt.Body
+	}
+	return nil
+}
+
+func TestStoredProgramDDL(t *testing.T) {
+	testCases := []struct {
+		input       string
+		action      DDLAction
+		ifExists    bool
+		ifNotExists bool
+		table       string
+	}{{
+		input:       "create procedure if not exists p() begin end",
+		action:      CreateDDLAction,
+		ifNotExists: true,
+	}, {
+		input:  "create function f() returns int return 1",
+		action: CreateDDLAction,
+	}, {
+		input:  "create trigger t before update on tbl for each row delete from a",
+		action: CreateDDLAction,
+		table:  "tbl",
+	}, {
+		input:  "create event e on schedule at now() do delete from a",
+		action: CreateDDLAction,
+	}, {
+		input:  "alter procedure p no sql",
+		action: AlterDDLAction,
+	}, {
+		input:  "alter event e enable",
+		action: AlterDDLAction,
+	}, {
+		input:    "drop function if exists f",
+		action:   DropDDLAction,
+		ifExists: true,
+	}, {
+		input:  "drop trigger t",
+		action: DropDDLAction,
+	}}
+	for _, tcase := range testCases {
+		t.Run(tcase.input, func(t *testing.T) {
+			tree, err := Parse(tcase.input)
+			require.NoError(t, err)
+			ddl, ok := tree.(DDLStatement)
+			require.True(t, ok)
+			assert.Equal(t, tcase.action, ddl.GetAction())
+			assert.Equal(t, tcase.ifExists, ddl.GetIfExists())
+			assert.Equal(t, tcase.ifNotExists, ddl.GetIfNotExists())
+			assert.Equal(t, tcase.table, ddl.GetTable().Name.String())
+			assert.Equal(t, StmtDDL, ASTToStatementType(tree))
+		})
+	}
+}
+
 var (
 	invalidSQL = []struct {
 		input        string
@@ -5758,6 +6145,12 @@ var (
 	}{{
 		input:  "select : from t",
 		output: "syntax error at position 9 near ':'",
+	}, {
+		input:  "create user 'u' identified by 'x' replace 'y'",
+		output: "syntax error at position 42 near 'replace'",
+	}, {
+		input:  "alter user 'u' identified with mysql_native_password as '*ABC' replace 'y'",
+		output: "syntax error at position 75 near 'y'",
 	}, {
 		input:  "execute stmt using 1;",
 		output: "syntax error at position 21 near '1'",
@@ -5997,10 +6390,8 @@ partition by range (id)
 
 	for _, testcase := range testcases {
 		t.Run(testcase.input+":"+testcase.mysqlVersion, func(t *testing.T) {
-			oldMySQLVersion := mySQLParserVersion
-			defer func() { mySQLParserVersion = oldMySQLVersion }()
-			mySQLParserVersion = testcase.mysqlVersion
-			tree, err := Parse(testcase.input)
+			parser := &Parser{version: testcase.mysqlVersion, dialect: MysqlDialect{}}
+			tree, err := parser.Parse(testcase.input)
 			require.NoError(t, err, testcase.input)
 			out := String(tree)
 			require.Equal(t, testcase.output, out)
@@ -22,16 +22,21 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+
+	"github.com/kanzihuang/vitess/go/vt/sqlparser/internal/buffer"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/vterrors"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
-var versionFlagSync sync.Once
-
 const defaultMySQLServerVersion = "8.0.30-Vitess"
 
+// defaultCommentVersion is defaultMySQLServerVersion in the comment version
+// format, the version of the zero Parser.
+const defaultCommentVersion = "80030"
+
 // parserPool is a pool for parser objects.
 var parserPool = sync.Pool{
 	New: func() any {
@@ -42,8 +47,152 @@ var parserPool = sync.Pool{
 // zeroParser is a zero-initialized parser to help reinitialize the parser for pooling.
 var zeroParser yyParserImpl
 
-// mySQLParserVersion is the version of MySQL that the parser would emulate
-var mySQLParserVersion string
+// Parser parses SQL with its own settings, so that parsers emulating
+// different versions of MySQL or parsing different dialects can be used at
+// the same time. The zero value parses like New(Options{}).
+type Parser struct {
+	// version is the version of MySQL the parser emulates, in the comment
+	// version format.
+	version             string
+	dialect             Dialect
+	skipSpecialComments bool
+	allowComments       bool
+	strictDDL           bool
+}
+
+// Options are the settings of a Parser.
+type Options struct {
+	// MySQLServerVersion is the version of MySQL the parser emulates, like
+	// 5.7.9. MySQL-specific comments of later versions are ignored. The
+	// default is 8.0.30.
+	MySQLServerVersion string
+	// Dialect is the SQL dialect of the parser. The default is MySQL.
+	Dialect Dialect
+	// SkipSpecialComments and AllowComments are the defaults of the
+	// tokenizer fields of the same names.
+	SkipSpecialComments bool
+	AllowComments       bool
+	// StrictDDL makes partially parsed DDL statements errors instead of
+	// returning them.
+	StrictDDL bool
+}
+
+// New returns a parser with the given options.
+func New(opts Options) (*Parser, error) {
+	if opts.MySQLServerVersion == "" {
+		opts.MySQLServerVersion = defaultMySQLServerVersion
+	}
+	version, err := ConvertMySQLVersionToCommentVersion(opts.MySQLServerVersion)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Dialect == nil {
+		opts.Dialect = MysqlDialect{}
+	}
+	return &Parser{
+		version:             version,
+		dialect:             opts.Dialect,
+		skipSpecialComments: opts.SkipSpecialComments,
+		allowComments:       opts.AllowComments,
+		strictDDL:           opts.StrictDDL,
+	}, nil
+}
+
+// defaultParser is the parser of the package-level functions.
+var defaultParser atomic.Pointer[Parser]
+
+func init() {
+	parser, err := New(Options{})
+	if err != nil {
+		log.Fatalf("unable to parse mysql version: %v", err)
+	}
+	defaultParser.Store(parser)
+}
+
+// NewStringTokenizer creates a new Tokenizer for the sql string, with the
+// settings of the parser.
+func (p *Parser) NewStringTokenizer(sql string, opts ...TokenizerOpt) *Tokenizer {
+	return p.newTokenizer(buffer.NewStringBuffer(sql), opts)
+}
+
+// NewReaderTokenizer creates a new Tokenizer for the sql reader, with the
+// settings of the parser.
+func (p *Parser) NewReaderTokenizer(reader io.Reader, opts ...TokenizerOpt) *Tokenizer {
+	return p.newTokenizer(buffer.NewReaderBuffer(reader), opts)
+}
+
+func (p *Parser) newTokenizer(buf *buffer.Buffer, opts []TokenizerOpt) *Tokenizer {
+	tokenizer := &Tokenizer{
+		AllowComments:       p.allowComments,
+		SkipSpecialComments: p.skipSpecialComments,
+		buf:                 buf,
+		BindVars:            make(map[string]struct{}),
+		dialect:             p.Dialect(),
+		version:             p.Version(),
+	}
+	for _, opt := range opts {
+		opt(tokenizer)
+	}
+	return tokenizer
+}
+
+// Parse2 behaves like the package-level Parse2, with the settings of the
+// parser.
+func (p *Parser) Parse2(sql string) (Statement, BindVars, error) {
+	return parse2(sql, p.NewStringTokenizer(sql), p.strictDDL)
+}
+
+// Parse behaves like the package-level Parse, with the settings of the
+// parser.
+func (p *Parser) Parse(sql string) (Statement, error) {
+	stmt, _, err := p.Parse2(sql)
+	return stmt, err
+}
+
+// ParseNext behaves like the package-level ParseNext, or like
+// ParseNextStrictDDL for a parser with strict DDL. The MySQL version and
+// dialect are the ones of the tokenizer, so it should be created by the
+// parser.
+func (p *Parser) ParseNext(tokenizer *Tokenizer) (Statement, error) {
+	return parseNext(tokenizer, p.strictDDL)
+}
+
+// ParseNextWithSource behaves like the package-level ParseNextWithSource,
+// with the strict DDL setting of the parser.
+func (p *Parser) ParseNextWithSource(tokenizer *Tokenizer) (*StatementSource, error) {
+	return parseNextWithSource(tokenizer, p.strictDDL)
+}
+
+// IsMySQL80AndAbove returns whether the parser emulates MySQL 8.0 or later.
+func (p *Parser) IsMySQL80AndAbove() bool {
+	return p.Version() >= "80000"
+}
+
+// Version returns the version of MySQL the parser emulates, in the comment
+// version format.
+func (p *Parser) Version() string {
+	if p.version == "" {
+		return defaultCommentVersion
+	}
+	return p.version
+}
+
+// Dialect returns the SQL dialect of the parser.
+func (p *Parser) Dialect() Dialect {
+	if p.dialect == nil {
+		return MysqlDialect{}
+	}
+	return p.dialect
+}
+
+// string returns a string representation of an SQLNode in the dialect of
+// the parser.
+func (p *Parser) string(node SQLNode) string {
+	if dialect := p.Dialect(); isPostgres(dialect) {
+		return StringWithDialect(node, dialect)
+	}
+	return String(node)
+}
 
 // yyParsePooled is a wrapper around yyParse that pools the parser objects. There isn't a
 // particularly good reason to use yyParse directly, since it immediately discards its parser.
@@ -80,9 +229,23 @@ func yyParsePooled(yylex yyLexer) int {
 // is partially parsed but still contains a syntax error, the
 // error is ignored and the DDL is returned anyway.
 func Parse2(sql string) (Statement, BindVars, error) {
-	tokenizer := NewStringTokenizer(sql)
+	return defaultParser.Load().Parse2(sql)
+}
+
+// ParseWithPositions behaves like Parse, but also returns the source spans
+// of the pointer nodes of the parsed statement, see Positions.
+func ParseWithPositions(sql string) (Statement, *Positions, error) {
+	tokenizer := NewStringTokenizer(sql, WithPositions())
+	stmt, _, err := parse2(sql, tokenizer, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stmt, tokenizer.Positions(), nil
+}
+
+func parse2(sql string, tokenizer *Tokenizer, strict bool) (Statement, BindVars, error) {
 	if yyParsePooled(tokenizer) != 0 {
-		if tokenizer.partialDDL != nil {
+		if tokenizer.partialDDL != nil && !strict {
 			if typ, val := tokenizer.Scan(); typ != 0 {
 				return nil, nil, fmt.Errorf("extra characters encountered after end of DDL: '%s'", string(val))
 			}
@@ -101,31 +264,32 @@ func Parse2(sql string) (Statement, BindVars, error) {
 	if tokenizer.ParseTree == nil {
 		return nil, nil, ErrEmpty
 	}
+	tokenizer.attachComments()
 	return tokenizer.ParseTree, tokenizer.BindVars, nil
 }
 
-func checkParserVersionFlag() {
-	versionFlagSync.Do(func() {
-		convVersion, err := convertMySQLVersionToCommentVersion(defaultMySQLServerVersion)
-		if err != nil {
-			log.Fatalf("unable to parse mysql version: %v", err)
-		}
-		mySQLParserVersion = convVersion
-	})
-}
-
-// SetParserVersion sets the mysql parser version
+// SetParserVersion sets the mysql parser version of the package-level
+// functions, in the comment version format returned by
+// ConvertMySQLVersionToCommentVersion. Use a Parser to parse with different
+// versions at the same time.
 func SetParserVersion(version string) {
-	mySQLParserVersion = version
+	for {
+		old := defaultParser.Load()
+		parser := *old
+		parser.version = version
+		if defaultParser.CompareAndSwap(old, &parser) {
+			return
+		}
+	}
 }
 
 // GetParserVersion returns the version of the mysql parser
 func GetParserVersion() string {
-	return mySQLParserVersion
+	return defaultParser.Load().version
 }
 
-// convertMySQLVersionToCommentVersion converts the MySQL version into comment version format.
-func convertMySQLVersionToCommentVersion(version string) (string, error) {
+// ConvertMySQLVersionToCommentVersion converts the MySQL version into comment version format.
+func ConvertMySQLVersionToCommentVersion(version string) (string, error) {
 	var res = make([]int, 3)
 	idx := 0
 	val := ""
@@ -214,11 +378,24 @@ func ParseNextStrictDDL(tokenizer *Tokenizer) (Statement, error) {
 }
 
 func parseNext(tokenizer *Tokenizer, strict bool) (Statement, error) {
-	if tokenizer.cur() == ';' {
-		tokenizer.skip(1)
-		tokenizer.skipBlank()
+	for {
+		stmt, err := parseNextPiece(tokenizer, strict)
+		if stmt != nil || err != nil {
+			return stmt, err
+		}
 	}
+}
+
+// parseNextPiece parses the text up to the next delimiter, and returns no
+// statement and no error if it only holds comments.
+func parseNextPiece(tokenizer *Tokenizer, strict bool) (Statement, error) {
+	tokenizer.retryRead()
+	tokenizer.skipDelimiter()
 	if tokenizer.cur() == eofChar {
+		if err := tokenizer.buf.Err(); err != nil {
+			tokenizer.LastError = err
+			return nil, err
+		}
 		return nil, io.EOF
 	}
 
@@ -229,15 +406,123 @@ func parseNext(tokenizer *Tokenizer, strict bool) (Statement, error) {
 			tokenizer.ParseTree = tokenizer.partialDDL
 			return tokenizer.ParseTree, nil
 		}
+		_, tokenizer.lostStatement = tokenizer.LastError.(*ReadError)
 		return nil, tokenizer.LastError
 	}
 	_, isCommentOnly := tokenizer.ParseTree.(*CommentOnly)
 	if tokenizer.ParseTree == nil || isCommentOnly {
-		return ParseNext(tokenizer)
+		return nil, nil
 	}
+	tokenizer.attachComments()
 	return tokenizer.ParseTree, nil
 }
 
+// StatementSource is a statement returned by ParseNextWithSource, along
+// with its source.
+type StatementSource struct {
+	Statement Statement
+	// SQL is the verbatim text of the statement, without the delimiter
+	// that ends it and the blanks before the delimiter.
+	SQL string
+	// Start and End are the byte offsets of SQL in the input.
+	Start, End int
+	// Line is the 1-based line of Start.
+	Line int
+}
+
+// sourceTracker follows the source of the statements returned by
+// ParseNextWithSource. The text from offset on, which starts at line, is
+// in the cache of the buffer. stmtStart is the offset of the first token
+// of the statement being parsed and commentStart the offset of the first
+// comment before it, or -1 until they are scanned.
+type sourceTracker struct {
+	offset       int
+	line         int
+	stmtStart    int
+	commentStart int
+}
+
+// scanned records the offset of a token scanned while parsing a
+// statement.
+func (st *sourceTracker) scanned(typ int, start int) {
+	if st.stmtStart >= 0 {
+		return
+	}
+	switch typ {
+	case 0, ';', DELIMITER_END:
+	case COMMENT:
+		if st.commentStart < 0 {
+			st.commentStart = start
+		}
+	case DELIMITER_COMMAND:
+		st.commentStart = -1
+	default:
+		st.stmtStart = start
+	}
+}
+
+// ParseNextWithSource behaves like ParseNext, but also returns the
+// verbatim text of the statement, its byte offsets and its line, including
+// the comments before the statement if the tokenizer was created with
+// WithLeadingComments. The statement is nil when an error is returned
+// along with the source of the statement in error. Lines are counted from
+// where the first call starts reading, so the tokenizer should not be
+// read by other functions. A reader tokenizer needs WithCacheInBuffer.
+func ParseNextWithSource(tokenizer *Tokenizer) (*StatementSource, error) {
+	return parseNextWithSource(tokenizer, false)
+}
+
+func parseNextWithSource(tokenizer *Tokenizer, strict bool) (*StatementSource, error) {
+	if tokenizer.source == nil {
+		tokenizer.resetCache()
+		if tokenizer.LastError != nil {
+			return nil, tokenizer.LastError
+		}
+		tokenizer.source = &sourceTracker{offset: tokenizer.absolutePos(), line: 1}
+	}
+	cacheBlanks := tokenizer.buf.CacheBlanks
+	tokenizer.buf.CacheBlanks = true
+	defer func() {
+		tokenizer.buf.CacheBlanks = cacheBlanks
+	}()
+
+	st := tokenizer.source
+	for {
+		st.stmtStart, st.commentStart = -1, -1
+		stmt, err := parseNextPiece(tokenizer, strict)
+		text := tokenizer.readCache()
+		if cacheErr, ok := tokenizer.LastError.(*CacheError); ok {
+			return nil, cacheErr
+		}
+		offset, line := st.offset, st.line
+		st.offset += len(text)
+		st.line += strings.Count(text, "\n")
+		if err == io.EOF || stmt == nil && err == nil {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		start := st.stmtStart
+		if tokenizer.leadingComments && st.commentStart >= 0 {
+			start = st.commentStart
+		}
+		if start < offset || start > st.offset {
+			// nothing was scanned, like for a read error
+			start = offset + len(text) - len(strings.TrimLeft(text, " \t\r\n"))
+		}
+		sql := strings.TrimRight(text[start-offset:], " \t\r\n")
+		return &StatementSource{
+			Statement: stmt,
+			SQL:       sql,
+			Start:     start,
+			End:       start + len(sql),
+			Line:      line + strings.Count(text[:start-offset], "\n"),
+		}, err
+	}
+}
+
 // ErrEmpty is a sentinel error returned when parsing empty statements.
 var ErrEmpty = vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.EmptyQuery, "Query was empty")
 
@@ -268,13 +553,19 @@ func SplitStatementToPieces(blob string, opts ...TokenizerOpt) ([]string, error)
 	if blob == "" {
 		return nil, nil
 	}
+	if hasDelimiterCommand(blob) {
+		return splitPieces(blob, opts...)
+	}
 	switch strings.IndexByte(blob, ';') {
 	case -1: // if there is no semicolon, return blob as a whole
 		return []string{blob}, nil
 	case len(blob) - 1: // if there's a single semicolon and it's the last character, return blob without it
 		return []string{blob[:len(blob)-1]}, nil
 	}
+	return splitPieces(blob, opts...)
+}
 
+func splitPieces(blob string, opts ...TokenizerOpt) ([]string, error) {
 	pieces := make([]string, 0, 16)
 	tokenizer := NewStringTokenizer(blob, opts...)
 	for {
@@ -289,6 +580,19 @@ func SplitStatementToPieces(blob string, opts ...TokenizerOpt) ([]string, error)
 	}
 }
 
+// hasDelimiterCommand reports whether the blob may contain a DELIMITER command,
+// which changes the statement delimiter from the semicolon.
+func hasDelimiterCommand(blob string) bool {
+	for i := 0; i+len(delimiterCommand) <= len(blob); i++ {
+		if strings.EqualFold(blob[i:i+len(delimiterCommand)], delimiterCommand) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsMySQL80AndAbove returns whether the package-level functions emulate
+// MySQL 8.0 or later.
 func IsMySQL80AndAbove() bool {
-	return mySQLParserVersion >= "80000"
+	return defaultParser.Load().IsMySQL80AndAbove()
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package sqlparser
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -73,3 +74,75 @@ func TestEmptyErrorAndComments(t *testing.T) {
 		})
 	}
 }
+
+func TestParserVersions(t *testing.T) {
+	mysql57, err := New(Options{MySQLServerVersion: "5.7.9"})
+	require.NoError(t, err)
+	mysql80, err := New(Options{MySQLServerVersion: "8.0.30"})
+	require.NoError(t, err)
+	require.False(t, mysql57.IsMySQL80AndAbove())
+	require.True(t, mysql80.IsMySQL80AndAbove())
+	require.Equal(t, "50709", mysql57.Version())
+
+	const sql = "select /*!80000 1 + */ 2 from dual"
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				stmt, err := mysql57.Parse(sql)
+				require.NoError(t, err)
+				require.Equal(t, "select 2 from dual", String(stmt))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				stmt, err := mysql80.Parse(sql)
+				require.NoError(t, err)
+				require.Equal(t, "select 1 + 2 from dual", String(stmt))
+			}
+		}()
+	}
+	wg.Wait()
+
+	_, err = New(Options{MySQLServerVersion: "x"})
+	require.EqualError(t, err, "MySQL version not correctly setup - x.")
+}
+
+func TestParserOptions(t *testing.T) {
+	const ddl = "create table t (a int) frobnicate"
+	stmt, err := Parse(ddl)
+	require.NoError(t, err)
+	require.False(t, stmt.(DDLStatement).IsFullyParsed())
+
+	strict, err := New(Options{StrictDDL: true})
+	require.NoError(t, err)
+	_, err = strict.Parse(ddl)
+	require.EqualError(t, err, "syntax error at position 34 near 'frobnicate'")
+	_, err = strict.ParseNext(strict.NewStringTokenizer(ddl))
+	require.EqualError(t, err, "syntax error at position 34 near 'frobnicate'")
+
+	postgres, err := New(Options{Dialect: PostgresDialect{}})
+	require.NoError(t, err)
+	stmt, err = postgres.Parse(`select "a b" from t`)
+	require.NoError(t, err)
+	require.Equal(t, "select `a b` from t", String(stmt))
+
+	skip, err := New(Options{SkipSpecialComments: true})
+	require.NoError(t, err)
+	stmt, err = skip.Parse("select /*!50000 1 + */ 2 from dual")
+	require.NoError(t, err)
+	require.Equal(t, "select /*!50000 1 + */ 2 from dual", String(stmt))
+
+	// the zero value parses like the default parser
+	var zero Parser
+	defaults, err := New(Options{})
+	require.NoError(t, err)
+	require.Equal(t, defaults.Version(), zero.Version())
+	require.Equal(t, defaults.Dialect(), zero.Dialect())
+	stmt, err = zero.Parse("select /*!80000 1 + */ 2 from dual")
+	require.NoError(t, err)
+	require.Equal(t, "select 1 + 2 from dual", String(stmt))
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/kanzihuang/vitess/go/vt/sqlparser/internal/buffer"
+)
+
+// Position is a location in the original SQL text.
+type Position struct {
+	// Offset is the 0-based byte offset.
+	Offset int
+	// Line is the 1-based line number.
+	Line int
+	// Column is the 1-based column, counted in bytes.
+	Column int
+}
+
+// IsValid reports whether the position was recorded by the tokenizer.
+func (p Position) IsValid() bool {
+	return p.Line > 0
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// Span is the range of the original SQL text a node was parsed from.
+// Start is inclusive and End is exclusive.
+type Span struct {
+	Start, End Position
+}
+
+// IsValid reports whether the span was recorded by the tokenizer.
+func (s Span) IsValid() bool {
+	return s.Start.IsValid()
+}
+
+func (s Span) String() string {
+	return fmt.Sprintf("%v-%v", s.Start, s.End)
+}
+
+// Positions holds the source spans of the nodes of a parsed statement.
+// Spans are only recorded for nodes with pointer types, such as *ColName,
+// *AliasedTableExpr or *ComparisonExpr; value nodes like TableName or
+// IdentifierCI have no identity to attach a span to. This includes the
+// value fields of pointer nodes, like the Name of a *ColName: Span reports
+// false for them, and the span of the pointer node that holds them is the
+// closest one.
+type Positions struct {
+	spans map[SQLNode]Span
+}
+
+// Span returns the source span of the given node.
+func (p *Positions) Span(node SQLNode) (Span, bool) {
+	if p == nil || !isPointerNode(node) {
+		return Span{}, false
+	}
+	span, ok := p.spans[node]
+	return span, ok
+}
+
+// Len returns the number of nodes with a recorded span.
+func (p *Positions) Len() int {
+	if p == nil {
+		return 0
+	}
+	return len(p.spans)
+}
+
+func (p *Positions) reset() {
+	p.spans = make(map[SQLNode]Span)
+}
+
+// record stores the span of a node. A node can be the value of more than one
+// reduction: when a rule adds an ORDER BY to a parsed SELECT, the span is
+// extended to the end of the clause; when a rule merely passes the node
+// through, e.g. from `WHERE expression`, the span of the node is kept.
+func (p *Positions) record(node SQLNode, span Span) {
+	old, ok := p.spans[node]
+	if ok && (span.Start.Offset != old.Start.Offset || span.End.Offset < old.End.Offset) {
+		return
+	}
+	p.spans[node] = span
+}
+
+func isPointerNode(node SQLNode) bool {
+	if node == nil {
+		return false
+	}
+	v := reflect.ValueOf(node)
+	return v.Kind() == reflect.Pointer && !v.IsNil()
+}
+
+// WithPositions enables tracking of source positions. The spans of the
+// nodes of the last parsed statement are available through Positions.
+func WithPositions() TokenizerOpt {
+	return func(tokenizer *Tokenizer) {
+		buffer.WithLines()(tokenizer.buf)
+		tokenizer.positions = &Positions{}
+		tokenizer.positions.reset()
+	}
+}
+
+// Positions returns the source spans of the nodes of the last parsed
+// statement, or nil if WithPositions was not given to the tokenizer.
+func (tkn *Tokenizer) Positions() *Positions {
+	return tkn.positions
+}
+
+// ErrorPosition returns the position of the last syntax error: the start
+// of the token it was found at, with its line and column, while the
+// position of the PositionedErr in LastError is the end of the token. It
+// is only valid if WithPositions was given to the tokenizer.
+func (tkn *Tokenizer) ErrorPosition() Position {
+	return tkn.errorPosition
+}
+
+// position returns the position at the given absolute offset.
+func (tkn *Tokenizer) position(offset int) Position {
+	line, col := tkn.buf.LineCol(offset)
+	return Position{Offset: offset, Line: line, Column: col}
+}
+
+// scanSpan scans the next token like Scan, and returns its source span.
+func (tkn *Tokenizer) scanSpan() (int, string, Span) {
+	if tkn.specialComment != nil {
+		// all the tokens of a MySQL-specific comment share the span of the comment
+		if typ, val := tkn.specialComment.Scan(); typ != 0 {
+			return typ, val, tkn.specialSpan
+		}
+		tkn.specialComment = nil
+	}
+	tkn.skipBlank()
+	start := tkn.position(tkn.absolutePos())
+	typ, val := tkn.Scan()
+	span := Span{Start: start, End: tkn.position(tkn.absolutePos())}
+	if tkn.specialComment != nil {
+		tkn.specialSpan = span
+	}
+	return typ, val, span
+}
+
+// Tracking implements yyLexerEx. The parser only reports its shifts and
+// reductions when positions are tracked.
+func (tkn *Tokenizer) Tracking() bool {
+	return tkn.positions != nil
+}
+
+// Shifted implements yyLexerEx. It records the span of the token shifted
+// by the parser.
+func (tkn *Tokenizer) Shifted(depth int) {
+	tkn.setSpan(depth, tkn.tokenSpan)
+}
+
+// Reduced implements yyLexerEx. It computes the span of every reduction from
+// the spans of its symbols, and records it for the node it produced.
+func (tkn *Tokenizer) Reduced(rule, depth, n int, val *yySymType) {
+	for len(tkn.spans) < depth+n {
+		tkn.spans = append(tkn.spans, Span{})
+	}
+	var span Span
+	for _, s := range tkn.spans[depth : depth+n] {
+		if !s.IsValid() {
+			continue
+		}
+		if !span.IsValid() {
+			span.Start = s.Start
+		}
+		span.End = s.End
+	}
+	tkn.setSpan(depth, span)
+	if !span.IsValid() {
+		return
+	}
+	node, ok := val.union.(SQLNode)
+	if !ok || !isPointerNode(node) {
+		return
+	}
+	if tkn.widen != nil && node == tkn.widen {
+		tkn.widen = nil
+		tkn.positions.spans[node] = span
+		return
+	}
+	tkn.positions.record(node, span)
+}
+
+// setSpan sets the span of the symbol at the given depth of the stack of
+// the parser.
+func (tkn *Tokenizer) setSpan(depth int, span Span) {
+	for len(tkn.spans) <= depth {
+		tkn.spans = append(tkn.spans, Span{})
+	}
+	tkn.spans[depth] = span
+}
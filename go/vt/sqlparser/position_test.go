@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// spanText returns the source text of the span of the node.
+func spanText(t *testing.T, sql string, positions *Positions, node SQLNode) string {
+	t.Helper()
+	span, ok := positions.Span(node)
+	require.True(t, ok, "no span for %T %s", node, String(node))
+	return sql[span.Start.Offset:span.End.Offset]
+}
+
+func TestParseWithPositions(t *testing.T) {
+	sql := "select a, t.b from t1 as t\n  where t.c = 1 and\n\tlower(d) > 'x' order by a"
+	stmt, positions, err := ParseWithPositions(sql)
+	require.NoError(t, err)
+
+	sel := stmt.(*Select)
+	assert.Equal(t, sql, spanText(t, sql, positions, sel))
+	assert.Equal(t, "a", spanText(t, sql, positions, sel.SelectExprs[0].(*AliasedExpr).Expr))
+	assert.Equal(t, "t.b", spanText(t, sql, positions, sel.SelectExprs[1].(*AliasedExpr).Expr))
+	assert.Equal(t, "t1 as t", spanText(t, sql, positions, sel.From[0]))
+
+	and := sel.Where.Expr.(*AndExpr)
+	assert.Equal(t, "t.c = 1 and\n\tlower(d) > 'x'", spanText(t, sql, positions, and))
+	assert.Equal(t, "t.c = 1", spanText(t, sql, positions, and.Left))
+	cmp := and.Right.(*ComparisonExpr)
+	assert.Equal(t, "lower(d) > 'x'", spanText(t, sql, positions, cmp))
+	assert.Equal(t, "'x'", spanText(t, sql, positions, cmp.Right))
+
+	span, _ := positions.Span(cmp)
+	assert.Equal(t, Position{Offset: 48, Line: 3, Column: 2}, span.Start)
+	assert.Equal(t, Position{Offset: 62, Line: 3, Column: 16}, span.End)
+	assert.Equal(t, "3:2-3:16", span.String())
+
+	_, ok := positions.Span(TableName{Name: NewIdentifierCS("t1")})
+	assert.False(t, ok)
+}
+
+func TestPositionsSpecialComment(t *testing.T) {
+	sql := "select /*!50000 a + */ b from t"
+	stmt, positions, err := ParseWithPositions(sql)
+	require.NoError(t, err)
+
+	plus := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr.(*BinaryExpr)
+	assert.Equal(t, "/*!50000 a + */", spanText(t, sql, positions, plus.Left))
+	assert.Equal(t, "b", spanText(t, sql, positions, plus.Right))
+}
+
+func TestPositionsParseNext(t *testing.T) {
+	sql := "select 1 from dual;\n\nupdate t set a = 2\nwhere b = 3;"
+	tokenizer := NewReaderTokenizer(strings.NewReader(sql), WithPositions())
+
+	first, err := ParseNext(tokenizer)
+	require.NoError(t, err)
+	assert.Equal(t, "select 1 from dual", spanText(t, sql, tokenizer.Positions(), first))
+
+	second, err := ParseNext(tokenizer)
+	require.NoError(t, err)
+	upd := second.(*Update)
+	positions := tokenizer.Positions()
+	assert.Equal(t, "update t set a = 2\nwhere b = 3", spanText(t, sql, positions, upd))
+	_, ok := positions.Span(first)
+	assert.False(t, ok, "positions must be reset for every statement")
+
+	span, ok := positions.Span(upd.Where.Expr)
+	require.True(t, ok)
+	assert.Equal(t, Position{Offset: 46, Line: 4, Column: 7}, span.Start)
+
+	_, err = ParseNext(tokenizer)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestPositionsError(t *testing.T) {
+	sql := "select 1 from dual;\nselect a\n  from t where;\nselect 2 from dual"
+	tokenizer := NewReaderTokenizer(strings.NewReader(sql), WithPositions())
+
+	_, err := ParseNext(tokenizer)
+	require.NoError(t, err)
+	_, err = ParseNext(tokenizer)
+	assert.EqualError(t, err, "syntax error at position 44")
+	assert.Equal(t, Position{Offset: 43, Line: 3, Column: 15}, tokenizer.ErrorPosition())
+
+	_, err = ParseNext(tokenizer)
+	require.NoError(t, err)
+}
+
+func TestPositionsDisabled(t *testing.T) {
+	tokenizer := NewStringTokenizer("select a from t")
+	_, err := ParseNext(tokenizer)
+	require.NoError(t, err)
+	assert.Nil(t, tokenizer.Positions())
+	assert.Zero(t, tokenizer.Positions().Len())
+}
+
+func TestPositionsWidenedNodes(t *testing.T) {
+	testcases := []string{
+		"with x as (select 1 from dual) select * from x order by 1",
+		"insert into t(a, b) values (1, 2) on duplicate key update a = 3",
+		"select a from t union select b from u limit 1",
+	}
+	for _, sql := range testcases {
+		t.Run(sql, func(t *testing.T) {
+			stmt, positions, err := ParseWithPositions(sql)
+			require.NoError(t, err)
+			assert.Equal(t, sql, spanText(t, sql, positions, stmt))
+		})
+	}
+}
+
+// TestPositionsValidSQL checks that every recorded span of the valid test
+// cases lies within the input.
+func TestPositionsValidSQL(t *testing.T) {
+	for _, tcase := range validSQL {
+		stmt, positions, err := ParseWithPositions(tcase.input)
+		require.NoError(t, err, tcase.input)
+		if stmt == nil {
+			continue
+		}
+		for node, span := range positions.spans {
+			require.True(t, span.IsValid(), "%s: %T", tcase.input, node)
+			require.LessOrEqual(t, span.Start.Offset, span.End.Offset, "%s: %T", tcase.input, node)
+			require.LessOrEqual(t, span.End.Offset, len(tcase.input), "%s: %T", tcase.input, node)
+		}
+	}
+}
@@ -59,7 +59,7 @@ func precedenceFor(in Expr) Precendence {
 		return P12
 	case *ComparisonExpr:
 		switch node.Operator {
-		case EqualOp, NotEqualOp, GreaterThanOp, GreaterEqualOp, LessThanOp, LessEqualOp, LikeOp, InOp, RegexpOp, NullSafeEqualOp:
+		case EqualOp, NotEqualOp, GreaterThanOp, GreaterEqualOp, LessThanOp, LessEqualOp, LikeOp, ILikeOp, InOp, RegexpOp, NullSafeEqualOp:
 			return P11
 		}
 	case *IsExpr:
@@ -68,7 +68,7 @@ func precedenceFor(in Expr) Precendence {
 		switch node.Operator {
 		case BitOrOp:
 			return P10
-		case BitAndOp:
+		case BitAndOp, ConcatOp:
 			return P9
 		case ShiftLeftOp, ShiftRightOp:
 			return P8
@@ -86,6 +86,8 @@ func precedenceFor(in Expr) Precendence {
 		case BangOp:
 			return P3
 		}
+	case *TypeCastExpr:
+		return P1
 	case *ExtractedSubquery:
 		return precedenceFor(node.alternative)
 	}
@@ -0,0 +1,681 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"strings"
+)
+
+// LetterCase is the letter case of the functions or identifiers printed by
+// a PrettyStyle.
+type LetterCase int8
+
+// Constants for Enum Type - LetterCase
+const (
+	CasePreserve LetterCase = iota
+	CaseLower
+	CaseUpper
+)
+
+func (c LetterCase) apply(s string) string {
+	switch c {
+	case CaseLower:
+		return strings.ToLower(s)
+	case CaseUpper:
+		return strings.ToUpper(s)
+	default:
+		return s
+	}
+}
+
+// PrettyStyle is the layout of a statement printed on several lines.
+//
+// Select, union, insert, update and delete statements are printed with one
+// clause per line. The lists and conditions of a clause, the joins of a FROM
+// clause and the subqueries that do not fit in MaxWidth are broken with one
+// item per line. Common table expressions are always broken. The other
+// statements are printed on one line. The tokens of the statement are the
+// same as the ones of String, only the blanks and the letter case differ.
+// FormatWithComments also prints the comments attached to the nodes of a
+// statement parsed WithComments, and the line comments end their lines.
+type PrettyStyle struct {
+	// Indent is the indentation of each level of nesting.
+	Indent string
+	// MaxWidth is the width over which lists, conditions and subqueries are
+	// broken. With a MaxWidth of 0, only the clauses are broken.
+	MaxWidth int
+	// LeadingCommas puts the commas of a broken list at the start of the
+	// lines rather than at their end.
+	LeadingCommas bool
+	// AlignAliases aligns the aliases of a broken select list.
+	AlignAliases bool
+	// UpperKeywords prints the keywords in upper case rather than lower case.
+	UpperKeywords bool
+	// FunctionCase is the case of the names of the function calls. The
+	// functions with a dedicated node, like COUNT or SUBSTR, are named with
+	// keywords, so CasePreserve prints them in the case of the keywords.
+	FunctionCase LetterCase
+	// IdentifierCase is the case of the case insensitive identifiers, like
+	// columns and aliases. Table names are never changed.
+	IdentifierCase LetterCase
+	// Dialect is the SQL dialect of the printed statement. The default is
+	// MySQL.
+	Dialect Dialect
+}
+
+// DefaultPrettyStyle is the style of PrettyString.
+var DefaultPrettyStyle = PrettyStyle{
+	Indent:       "  ",
+	MaxWidth:     80,
+	AlignAliases: true,
+}
+
+// PrettyString returns a string representation of an SQLNode on several
+// lines, in the DefaultPrettyStyle.
+func PrettyString(node SQLNode) string {
+	return DefaultPrettyStyle.Format(node)
+}
+
+// Format returns a string representation of an SQLNode in the style.
+func (style PrettyStyle) Format(node SQLNode) string {
+	return style.FormatWithComments(node, nil)
+}
+
+// FormatWithComments returns a string representation of an SQLNode in the
+// style, with the comments attached to its nodes, see WithComments.
+func (style PrettyStyle) FormatWithComments(node SQLNode, comments *NodeComments) string {
+	if node == nil {
+		return ""
+	}
+	p := &prettyPrinter{style: style, comments: comments}
+	buf := p.newBuffer(p.format)
+	p.statement(buf, node)
+	buf.writeTrailingComments()
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// prettyPrinter holds the state of a PrettyStyle.Format call. Its format
+// method is the NodeFormatter of the broken layout, and formatFlat the one
+// of the nodes printed on one line.
+type prettyPrinter struct {
+	style PrettyStyle
+	depth int
+	// margin follows the indentation of the lines of the items of a list
+	// with leading commas.
+	margin string
+	// comments are the comments attached to the nodes of the statement.
+	comments *NodeComments
+	// lineStart is the offset of the current line in the buffer of the
+	// broken layout.
+	lineStart int
+}
+
+func (p *prettyPrinter) newBuffer(formatter NodeFormatter) *TrackedBuffer {
+	buf := NewTrackedBuffer(formatter)
+	buf.SetUpperCase(p.style.UpperKeywords)
+	if p.style.Dialect != nil {
+		buf.SetDialect(p.style.Dialect)
+	}
+	if p.comments != nil {
+		buf.SetComments(p.comments)
+	}
+	return buf
+}
+
+// flat formats the values on one line.
+func (p *prettyPrinter) flat(format string, values ...any) string {
+	buf := p.newBuffer(p.formatFlat)
+	buf.astPrintf(nil, format, values...)
+	return buf.String()
+}
+
+// fits returns whether a string can be written on the current line.
+func (p *prettyPrinter) fits(buf *TrackedBuffer, s string) bool {
+	if strings.IndexByte(s, '\n') >= 0 {
+		return false
+	}
+	if p.style.MaxWidth <= 0 {
+		return true
+	}
+	column := buf.Len() - p.lineStart
+	if p.comments != nil {
+		// a line comment may have ended the line since the last newline
+		line := buf.String()[p.lineStart:]
+		column = len(line) - strings.LastIndexByte(line, '\n') - 1
+	}
+	return column+len(s) <= p.style.MaxWidth
+}
+
+// hasComments returns whether comments are attached to a node.
+func (p *prettyPrinter) hasComments(node SQLNode) bool {
+	return len(p.comments.Before(node)) != 0 || len(p.comments.After(node)) != 0 || len(p.comments.Trailing(node)) != 0
+}
+
+func (p *prettyPrinter) newline(buf *TrackedBuffer) {
+	// a line comment already ended the line
+	if written := buf.String(); !strings.HasSuffix(written, "\n") {
+		buf.WriteByte('\n')
+	}
+	p.lineStart = buf.Len()
+	for i := 0; i < p.depth; i++ {
+		buf.WriteString(p.style.Indent)
+	}
+	buf.WriteString(p.margin)
+}
+
+func (p *prettyPrinter) formatFlat(buf *TrackedBuffer, node SQLNode) {
+	switch node := node.(type) {
+	case IdentifierCI:
+		p.identifier(buf, node)
+	case *FuncExpr:
+		p.funcExpr(buf, node)
+	case *CurTimeFuncExpr, *TimestampFuncExpr:
+		p.namedFuncExpr(buf, node)
+	case Callable:
+		p.callable(buf, node)
+	default:
+		node.Format(buf)
+	}
+}
+
+func (p *prettyPrinter) format(buf *TrackedBuffer, node SQLNode) {
+	switch node := node.(type) {
+	case IdentifierCI:
+		p.identifier(buf, node)
+	case *FuncExpr:
+		p.funcExpr(buf, node)
+	case *CurTimeFuncExpr, *TimestampFuncExpr:
+		p.namedFuncExpr(buf, node)
+	case Callable:
+		p.callable(buf, node)
+	case *Subquery:
+		if s := p.flat("%v", node); p.fits(buf, s) {
+			buf.WriteString(s)
+			return
+		}
+		p.parens(buf, node.Select)
+	case *DerivedTable:
+		if s := p.flat("%v", node); p.fits(buf, s) {
+			buf.WriteString(s)
+			return
+		}
+		if node.Lateral {
+			buf.literal("lateral ")
+		}
+		p.parens(buf, node.Select)
+	case *JoinTableExpr:
+		if s := p.flat("%v", node); p.fits(buf, s) {
+			buf.WriteString(s)
+			return
+		}
+		p.join(buf, node)
+	default:
+		node.Format(buf)
+	}
+}
+
+// join writes each join of a chain of joins on its own line.
+func (p *prettyPrinter) join(buf *TrackedBuffer, node *JoinTableExpr) {
+	if left, ok := node.LeftExpr.(*JoinTableExpr); ok {
+		buf.writeCommentsBefore(left)
+		p.join(buf, left)
+		buf.writeCommentsAfter(left)
+	} else {
+		buf.formatter(node.LeftExpr)
+	}
+	p.newline(buf)
+	buf.astPrintf(node, "%s %v%v", node.Join.ToString(), node.RightExpr, node.Condition)
+}
+
+func (p *prettyPrinter) identifier(buf *TrackedBuffer, node IdentifierCI) {
+	if node.IsEmpty() {
+		return
+	}
+	formatID(buf, p.style.IdentifierCase.apply(node.String()), NoAt)
+}
+
+func (p *prettyPrinter) funcExpr(buf *TrackedBuffer, node *FuncExpr) {
+	if !node.Qualifier.IsEmpty() {
+		buf.astPrintf(node, "%v.", node.Qualifier)
+	}
+	funcName := p.style.FunctionCase.apply(node.Name.String())
+	if containEscapableChars(funcName, NoAt) {
+		writeEscapedString(buf, funcName)
+	} else {
+		buf.WriteString(funcName)
+	}
+	buf.astPrintf(node, "(%v)", node.Exprs)
+}
+
+// namedFuncExpr writes the functions with a dedicated node that keep their
+// name as it is written.
+func (p *prettyPrinter) namedFuncExpr(buf *TrackedBuffer, node SQLNode) {
+	switch node := node.(type) {
+	case *CurTimeFuncExpr:
+		buf.WriteString(p.style.FunctionCase.apply(node.Name.String()))
+		if node.Fsp > 0 {
+			buf.astPrintf(node, "(%d)", node.Fsp)
+		} else {
+			buf.literal("()")
+		}
+	case *TimestampFuncExpr:
+		buf.WriteString(p.style.FunctionCase.apply(node.Name))
+		buf.astPrintf(node, "(%#s, %v, %v)", node.Unit, node.Expr1, node.Expr2)
+	}
+}
+
+// callable writes the other functions with a dedicated node, whose name is
+// the first literal the node writes.
+func (p *prettyPrinter) callable(buf *TrackedBuffer, node Callable) {
+	if p.style.FunctionCase == CasePreserve || !isFunctionCall(node) {
+		node.Format(buf)
+		return
+	}
+	literal := buf.literal
+	start := buf.Len()
+	buf.literal = func(s string) (int, error) {
+		buf.literal = literal
+		name, _, _ := strings.Cut(s, "(")
+		if buf.Len() != start || !isFunctionName(name) {
+			return literal(s)
+		}
+		buf.WriteString(p.style.FunctionCase.apply(name))
+		if _, err := literal(s[len(name):]); err != nil {
+			return 0, err
+		}
+		return len(s), nil
+	}
+	node.Format(buf)
+	buf.literal = literal
+}
+
+// isFunctionCall returns whether a callable node is written as a function
+// call, rather than as an operator like MEMBER OF or + INTERVAL.
+func isFunctionCall(node Callable) bool {
+	switch node := node.(type) {
+	case *MemberOfExpr, *NamedWindow:
+		return false
+	case *DateAddExpr:
+		return node.Type == AdddateType || node.Type == DateAddType
+	case *DateSubExpr:
+		return node.Type == SubdateType || node.Type == DateSubType
+	}
+	return true
+}
+
+// isFunctionName returns whether a literal is a function name.
+func isFunctionName(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c != '_' && (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// parens writes a statement between parentheses, indented on its own lines.
+func (p *prettyPrinter) parens(buf *TrackedBuffer, stmt SelectStatement) {
+	buf.WriteByte('(')
+	p.depth++
+	p.newline(buf)
+	p.statement(buf, stmt)
+	p.depth--
+	p.newline(buf)
+	buf.WriteByte(')')
+}
+
+// statement writes a statement with one clause per line.
+func (p *prettyPrinter) statement(buf *TrackedBuffer, node SQLNode) {
+	buf.writeCommentsBefore(node)
+	defer buf.writeCommentsAfter(node)
+	switch node := node.(type) {
+	case *Select:
+		p.selectStatement(buf, node)
+	case *Union:
+		p.union(buf, node)
+	case *Insert:
+		p.insert(buf, node)
+	case *Update:
+		p.update(buf, node)
+	case *Delete:
+		p.delete(buf, node)
+	default:
+		p.format(buf, node)
+	}
+}
+
+func (p *prettyPrinter) selectStatement(buf *TrackedBuffer, node *Select) {
+	p.with(buf, node.With)
+
+	head := p.newBuffer(p.formatFlat)
+	head.astPrintf(node, "select %v", node.Comments)
+	if node.Distinct {
+		head.literal(DistinctStr)
+	}
+	if node.Cache != nil {
+		if *node.Cache {
+			head.literal(SQLCacheStr)
+		} else {
+			head.literal(SQLNoCacheStr)
+		}
+	}
+	if node.StraightJoinHint {
+		head.literal(StraightJoinHint)
+	}
+	if node.SQLCalcFoundRows {
+		head.literal(SQLCalcFoundRowsStr)
+	}
+	p.list(buf, strings.TrimSuffix(head.String(), " "), prettyItems(node.SelectExprs), true)
+
+	if len(node.From) != 0 {
+		p.newline(buf)
+		p.list(buf, p.flat("from"), prettyItems(node.From), false)
+	}
+	p.where(buf, node.Where)
+	if len(node.GroupBy) != 0 {
+		p.newline(buf)
+		p.list(buf, p.flat("group by"), prettyItems(node.GroupBy), false)
+	}
+	p.where(buf, node.Having)
+	if node.Windows != nil {
+		p.newline(buf)
+		buf.WriteString(p.flat("%v", node.Windows))
+	}
+	p.orderByLimit(buf, node.OrderBy, node.Limit)
+	p.trailer(buf, p.flat("%s%v", node.Lock.ToString(), node.Into))
+}
+
+func (p *prettyPrinter) union(buf *TrackedBuffer, node *Union) {
+	p.with(buf, node.With)
+	leftParens, rightParens := setOpParens(node)
+	p.setOperand(buf, node.Left, leftParens)
+	p.newline(buf)
+	buf.literal(node.operator())
+	p.newline(buf)
+	p.setOperand(buf, node.Right, rightParens)
+	p.orderByLimit(buf, node.OrderBy, node.Limit)
+	p.trailer(buf, p.flat("%s%v", node.Lock.ToString(), node.Into))
+}
+
+func (p *prettyPrinter) setOperand(buf *TrackedBuffer, stmt SelectStatement, parens bool) {
+	if parens {
+		p.parens(buf, stmt)
+		return
+	}
+	p.statement(buf, stmt)
+}
+
+func (p *prettyPrinter) insert(buf *TrackedBuffer, node *Insert) {
+	var action string
+	switch node.Action {
+	case InsertAct:
+		action = InsertStr
+	case ReplaceAct:
+		action = ReplaceStr
+	default:
+		node.Format(buf)
+		return
+	}
+	buf.WriteString(p.flat("%s %v%sinto %v%v%v", action,
+		node.Comments, node.Ignore.ToString(),
+		node.Table.Expr, node.Partitions, node.Columns))
+	p.newline(buf)
+	switch rows := node.Rows.(type) {
+	case Values:
+		p.list(buf, p.flat("values"), prettyItems(rows), false)
+	default:
+		p.statement(buf, rows)
+	}
+	if node.OnDup != nil {
+		p.newline(buf)
+		p.list(buf, p.flat("on duplicate key update"), prettyItems(node.OnDup), false)
+	}
+	if node.OnConflict != nil {
+		p.trailer(buf, p.flat("%v", node.OnConflict))
+	}
+	p.returning(buf, node.Returning)
+}
+
+func (p *prettyPrinter) update(buf *TrackedBuffer, node *Update) {
+	p.with(buf, node.With)
+	head := p.flat("update %v%s", node.Comments, node.Ignore.ToString())
+	p.list(buf, strings.TrimSuffix(head, " "), prettyItems(node.TableExprs), false)
+	p.newline(buf)
+	p.list(buf, p.flat("set"), prettyItems(node.Exprs), false)
+	p.where(buf, node.Where)
+	p.orderByLimit(buf, node.OrderBy, node.Limit)
+	p.returning(buf, node.Returning)
+}
+
+func (p *prettyPrinter) delete(buf *TrackedBuffer, node *Delete) {
+	p.with(buf, node.With)
+	head := p.newBuffer(p.formatFlat)
+	head.astPrintf(node, "delete %v", node.Comments)
+	if node.Ignore {
+		head.literal("ignore ")
+	}
+	if node.Targets != nil {
+		head.astPrintf(node, "%v ", node.Targets)
+	}
+	head.literal("from")
+	p.list(buf, head.String(), prettyItems(node.TableExprs), false)
+	buf.WriteString(p.flat("%v", node.Partitions))
+	p.where(buf, node.Where)
+	p.orderByLimit(buf, node.OrderBy, node.Limit)
+	p.returning(buf, node.Returning)
+}
+
+// with writes the common table expressions, each query on its own lines.
+func (p *prettyPrinter) with(buf *TrackedBuffer, with *With) {
+	if with == nil {
+		return
+	}
+	buf.literal("with ")
+	if with.Recursive {
+		buf.literal("recursive ")
+	}
+	for i, cte := range with.ctes {
+		if i > 0 {
+			buf.WriteByte(',')
+			p.newline(buf)
+		}
+		buf.WriteString(p.flat("%v%v as ", cte.ID, cte.Columns))
+		p.parens(buf, cte.Subquery.Select)
+	}
+	p.newline(buf)
+}
+
+func (p *prettyPrinter) where(buf *TrackedBuffer, where *Where) {
+	if where == nil || where.Expr == nil {
+		return
+	}
+	p.newline(buf)
+	head := p.flat("%s", where.Type.ToString())
+	if s := p.flat("%v", where.Expr); p.fits(buf, head+" "+s) {
+		buf.WriteString(head + " " + s)
+		return
+	}
+	buf.WriteString(head)
+	p.depth++
+	p.newline(buf)
+	p.condition(buf, where.Expr)
+	p.depth--
+}
+
+// condition writes the operands of the top level ANDs or ORs of a
+// condition on their own lines.
+func (p *prettyPrinter) condition(buf *TrackedBuffer, expr Expr) {
+	var operator string
+	var split func(Expr) (Expr, Expr, bool)
+	switch expr.(type) {
+	case *AndExpr:
+		operator = "and "
+		split = func(expr Expr) (Expr, Expr, bool) {
+			and, ok := expr.(*AndExpr)
+			if !ok {
+				return nil, nil, false
+			}
+			return and.Left, and.Right, true
+		}
+	case *OrExpr:
+		operator = "or "
+		split = func(expr Expr) (Expr, Expr, bool) {
+			or, ok := expr.(*OrExpr)
+			if !ok {
+				return nil, nil, false
+			}
+			return or.Left, or.Right, true
+		}
+	default:
+		buf.formatter(expr)
+		return
+	}
+
+	// the operators are left associative: only the left operands are part
+	// of the chain, and the comments of the chain go around its operands
+	first := expr
+	var rest, chain []Expr
+	for {
+		left, right, ok := split(first)
+		if !ok {
+			break
+		}
+		chain = append(chain, first)
+		first, rest = left, append([]Expr{right}, rest...)
+	}
+	for _, node := range chain {
+		buf.writeCommentsBefore(node)
+	}
+	buf.astPrintf(expr, "%l", first)
+	for i, operand := range rest {
+		p.newline(buf)
+		buf.literal(operator)
+		buf.astPrintf(expr, "%r", operand)
+		buf.writeCommentsAfter(chain[len(chain)-1-i])
+	}
+}
+
+func (p *prettyPrinter) orderByLimit(buf *TrackedBuffer, orderBy OrderBy, limit *Limit) {
+	if len(orderBy) != 0 {
+		p.newline(buf)
+		p.list(buf, p.flat("order by"), prettyItems(orderBy), false)
+	}
+	if limit != nil {
+		p.trailer(buf, p.flat("%v", limit))
+	}
+}
+
+func (p *prettyPrinter) returning(buf *TrackedBuffer, returning SelectExprs) {
+	if returning != nil {
+		p.newline(buf)
+		p.list(buf, p.flat("returning"), prettyItems(returning), false)
+	}
+}
+
+// trailer writes a clause formatted with a leading blank on its own line.
+func (p *prettyPrinter) trailer(buf *TrackedBuffer, clause string) {
+	if clause = strings.TrimPrefix(clause, " "); clause != "" {
+		p.newline(buf)
+		buf.WriteString(clause)
+	}
+}
+
+func prettyItems[T SQLNode](nodes []T) []SQLNode {
+	items := make([]SQLNode, 0, len(nodes))
+	for _, node := range nodes {
+		items = append(items, node)
+	}
+	return items
+}
+
+// list writes the head of a clause and its comma separated items, on the
+// same line if they fit, else one item per line.
+func (p *prettyPrinter) list(buf *TrackedBuffer, head string, items []SQLNode, selectList bool) {
+	// a trailing comment ends the line of its item
+	trailing := false
+	flats := make([]string, 0, len(items))
+	for _, item := range items {
+		flats = append(flats, p.flat("%v", item))
+		trailing = trailing || len(p.comments.Trailing(item)) != 0
+	}
+	if line := head + " " + strings.Join(flats, ", "); !trailing && p.fits(buf, line) {
+		buf.WriteString(line)
+		return
+	}
+
+	buf.WriteString(head)
+	margin := p.margin
+	p.depth++
+	defer func() {
+		p.depth--
+		p.margin = margin
+	}()
+
+	aliasColumn := 0
+	if selectList && p.style.AlignAliases {
+		aliasColumn = p.aliasColumn(items)
+	}
+	for i, item := range items {
+		p.margin = margin
+		p.newline(buf)
+		if p.style.LeadingCommas {
+			if i == 0 {
+				buf.WriteString("  ")
+			} else {
+				buf.WriteString(", ")
+			}
+			p.margin = margin + "  "
+		}
+		if aliased, ok := item.(*AliasedExpr); ok && aliasColumn > 0 && !aliased.As.IsEmpty() && !p.hasComments(item) {
+			expr := p.flat("%v", aliased.Expr)
+			buf.WriteString(expr)
+			buf.WriteString(strings.Repeat(" ", aliasColumn-len(expr)))
+			buf.WriteString(p.flat(" as %v", aliased.As))
+		} else {
+			buf.formatter(item)
+		}
+		if !p.style.LeadingCommas && i < len(items)-1 {
+			buf.WriteByte(',')
+		}
+		buf.writeTrailingComments()
+	}
+}
+
+// aliasColumn returns the width of the widest expression of the aliased
+// items of a select list, or 0 if they are not worth aligning.
+func (p *prettyPrinter) aliasColumn(items []SQLNode) int {
+	width, aliased := 0, 0
+	for _, item := range items {
+		expr, ok := item.(*AliasedExpr)
+		if !ok || expr.As.IsEmpty() {
+			continue
+		}
+		s := p.flat("%v", expr.Expr)
+		if strings.IndexByte(s, '\n') >= 0 {
+			return 0
+		}
+		aliased++
+		if len(s) > width {
+			width = len(s)
+		}
+	}
+	indent := p.depth*len(p.style.Indent) + len(p.margin) + 2
+	if aliased < 2 || (p.style.MaxWidth > 0 && indent+width > p.style.MaxWidth) {
+		return 0
+	}
+	return width
+}
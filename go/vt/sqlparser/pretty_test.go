@@ -0,0 +1,216 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrettyString(t *testing.T) {
+	testcases := []struct {
+		input  string
+		output string
+	}{{
+		input: "select a, b as bee from t where x = 1 group by a having count(*) > 1 order by b limit 10 for update",
+		output: "select a, b as bee\n" +
+			"from t\n" +
+			"where x = 1\n" +
+			"group by a\n" +
+			"having count(*) > 1\n" +
+			"order by b asc\n" +
+			"limit 10\n" +
+			"for update",
+	}, {
+		input: "select t1.a_long_column_name, t2.another_long_column_name as b, t3.yet_another_column as c from t1 join t2 on t1.id = t2.id left join t3 on t3.id = t2.id where t1.x = 1 and (t2.y = 2 or t2.z = 3) and t3.w between 1 and 100 and t3.v is null",
+		output: "select\n" +
+			"  t1.a_long_column_name,\n" +
+			"  t2.another_long_column_name as b,\n" +
+			"  t3.yet_another_column       as c\n" +
+			"from t1 join t2 on t1.id = t2.id left join t3 on t3.id = t2.id\n" +
+			"where\n" +
+			"  t1.x = 1\n" +
+			"  and (t2.y = 2 or t2.z = 3)\n" +
+			"  and t3.w between 1 and 100\n" +
+			"  and t3.v is null",
+	}, {
+		input: "with x as (select a from t1), y as (select b from t2) select * from x join y on x.a = y.b where x.a in (select c from t3 where d = 1 and e = 'a long literal that does not fit')",
+		output: "with x as (\n" +
+			"  select a\n" +
+			"  from t1\n" +
+			"),\n" +
+			"y as (\n" +
+			"  select b\n" +
+			"  from t2\n" +
+			")\n" +
+			"select *\n" +
+			"from x join y on x.a = y.b\n" +
+			"where\n" +
+			"  x.a in (\n" +
+			"    select c\n" +
+			"    from t3\n" +
+			"    where d = 1 and e = 'a long literal that does not fit'\n" +
+			"  )",
+	}, {
+		input: "select a from (select a, b from t where b > (select max(b) from u)) as d",
+		output: "select a\n" +
+			"from (select a, b from t where b > (select max(b) from u)) as d",
+	}, {
+		input: "select a from t union all (select b from u order by b limit 1) order by a",
+		output: "select a\n" +
+			"from t\n" +
+			"union all\n" +
+			"(\n" +
+			"  select b\n" +
+			"  from u\n" +
+			"  order by b asc\n" +
+			"  limit 1\n" +
+			")\n" +
+			"order by a asc",
+	}, {
+		input: "select a from t union select b from u into @x",
+		output: "select a\n" +
+			"from t\n" +
+			"union\n" +
+			"select b\n" +
+			"from u\n" +
+			"into @x",
+	}, {
+		input: "insert /* c */ into t(a, b) values (1, 2), (3, 4) on duplicate key update a = values(a)",
+		output: "insert /* c */ into t(a, b)\n" +
+			"values (1, 2), (3, 4)\n" +
+			"on duplicate key update a = values(a)",
+	}, {
+		input: "insert into t select * from u",
+		output: "insert into t\n" +
+			"select *\n" +
+			"from u",
+	}, {
+		input: "update t set a = 1 where b = 2 order by c limit 3",
+		output: "update t\n" +
+			"set a = 1\n" +
+			"where b = 2\n" +
+			"order by c asc\n" +
+			"limit 3",
+	}, {
+		input: "delete t1 from t1 join t2 on t1.id = t2.id where t2.a = 1",
+		output: "delete t1 from t1 join t2 on t1.id = t2.id\n" +
+			"where t2.a = 1",
+	}, {
+		input:  "create table t (a int)",
+		output: "create table t (\n\ta int\n)",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.input, func(t *testing.T) {
+			stmt, err := Parse(tcase.input)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.output, PrettyString(stmt))
+		})
+	}
+}
+
+func TestPrettyStyle(t *testing.T) {
+	sql := "select t1.a, count(t2.b) as total, max(t2.c) as m from t1 join t2 on t1.id = t2.id join t3 using (id) where t1.x = 1 or exists (select 1 from t4 where t4.id = t1.id)"
+	stmt, err := Parse(sql)
+	require.NoError(t, err)
+
+	style := PrettyStyle{
+		Indent:         "    ",
+		MaxWidth:       40,
+		LeadingCommas:  true,
+		AlignAliases:   true,
+		UpperKeywords:  true,
+		FunctionCase:   CaseUpper,
+		IdentifierCase: CaseUpper,
+	}
+	assert.Equal(t, "SELECT\n"+
+		"      t1.A\n"+
+		"    , COUNT(t2.B) AS TOTAL\n"+
+		"    , MAX(t2.C)   AS M\n"+
+		"FROM\n"+
+		"      t1\n"+
+		"      JOIN t2 ON t1.ID = t2.ID\n"+
+		"      JOIN t3 USING (ID)\n"+
+		"WHERE\n"+
+		"    t1.X = 1\n"+
+		"    OR EXISTS (\n"+
+		"        SELECT 1\n"+
+		"        FROM t4\n"+
+		"        WHERE t4.ID = t1.ID\n"+
+		"    )", style.Format(stmt))
+
+	style = PrettyStyle{Indent: "\t"}
+	assert.Equal(t, "select t1.a, count(t2.b) as total, max(t2.c) as m\n"+
+		"from t1 join t2 on t1.id = t2.id join t3 using (id)\n"+
+		"where t1.x = 1 or exists (select 1 from t4 where t4.id = t1.id)", style.Format(stmt))
+
+	assert.Equal(t, "", PrettyString(nil))
+}
+
+func TestPrettyFunctionCase(t *testing.T) {
+	stmt, err := Parse("select Now(), count(*), Substr(a, 1), TimestampAdd(day, 1, b), trim(leading 'x' from c), d member of (json_array(1)), e + interval 1 day, IfNull(f, 0) from t")
+	require.NoError(t, err)
+
+	style := PrettyStyle{FunctionCase: CaseUpper}
+	assert.Equal(t, "select NOW(), COUNT(*), SUBSTR(a, 1), TIMESTAMPADD(day, 1, b), TRIM(leading 'x' from c), d member of (JSON_ARRAY(1)), e + interval 1 day, IFNULL(f, 0)\n"+
+		"from t", style.Format(stmt))
+
+	// the functions named with keywords follow the keywords, the others
+	// keep their name as it is parsed
+	style = PrettyStyle{UpperKeywords: true}
+	assert.Equal(t, "SELECT now(), COUNT(*), SUBSTR(a, 1), timestampadd(day, 1, b), TRIM(LEADING 'x' FROM c), d MEMBER OF (JSON_ARRAY(1)), e + INTERVAL 1 day, IfNull(f, 0)\n"+
+		"FROM t", style.Format(stmt))
+
+	style = PrettyStyle{UpperKeywords: true, FunctionCase: CaseLower}
+	assert.Equal(t, "SELECT now(), count(*), substr(a, 1), timestampadd(day, 1, b), trim(LEADING 'x' FROM c), d MEMBER OF (json_array(1)), e + INTERVAL 1 day, ifnull(f, 0)\n"+
+		"FROM t", style.Format(stmt))
+}
+
+// TestPrettyValidSQL checks that the pretty printed valid test cases parse
+// back to the same statements.
+func TestPrettyValidSQL(t *testing.T) {
+	narrow := PrettyStyle{Indent: "\t", MaxWidth: 10, LeadingCommas: true, AlignAliases: true, UpperKeywords: true}
+	functions := PrettyStyle{Indent: "  ", MaxWidth: 40, FunctionCase: CaseUpper}
+	for _, tcase := range validSQL {
+		stmt, err := Parse(tcase.input)
+		require.NoError(t, err, tcase.input)
+		if stmt == nil {
+			continue
+		}
+		want := String(stmt)
+		if again, err := Parse(want); err != nil || String(again) != want {
+			// the statement does not round trip, pretty printed or not
+			continue
+		}
+
+		pretty := PrettyString(stmt)
+		reparsed, err := Parse(pretty)
+		require.NoError(t, err, "%s\n%s", tcase.input, pretty)
+		require.Equal(t, want, String(reparsed), "%s\n%s", tcase.input, pretty)
+
+		// some nodes keep the text of their keywords as it was parsed
+		for _, style := range []PrettyStyle{narrow, functions} {
+			pretty = style.Format(stmt)
+			reparsed, err = Parse(pretty)
+			require.NoError(t, err, "%s\n%s", tcase.input, pretty)
+			require.True(t, strings.EqualFold(want, String(reparsed)), "%s\n%s", tcase.input, pretty)
+		}
+	}
+}
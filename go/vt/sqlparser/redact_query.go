@@ -20,10 +20,17 @@ import querypb "vitess.io/vitess/go/vt/proto/query"
 
 // RedactSQLQuery returns a sql string with the params stripped out for display
 func RedactSQLQuery(sql string) (string, error) {
+	return defaultParser.Load().RedactSQLQuery(sql)
+}
+
+// RedactSQLQuery behaves like the package-level RedactSQLQuery, with the
+// settings of the parser. The redacted query is printed in the dialect of
+// the parser.
+func (p *Parser) RedactSQLQuery(sql string) (string, error) {
 	bv := map[string]*querypb.BindVariable{}
 	sqlStripped, comments := SplitMarginComments(sql)
 
-	stmt, reservedVars, err := Parse2(sqlStripped)
+	stmt, reservedVars, err := p.Parse2(sqlStripped)
 	if err != nil {
 		return "", err
 	}
@@ -32,6 +39,28 @@ func RedactSQLQuery(sql string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	redactPasswords(stmt)
+
+	return comments.Leading + p.string(stmt) + comments.Trailing, nil
+}
 
-	return comments.Leading + String(stmt) + comments.Trailing, nil
+// redactPasswords replaces the passwords of account management statements,
+// which are not normalized into bind variables.
+func redactPasswords(stmt Statement) {
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *Authentication:
+			if node.Password != nil {
+				node.Password = NewStrLiteral(RedactedPassword)
+			}
+			if node.CurrentPassword != nil {
+				node.CurrentPassword = NewStrLiteral(RedactedPassword)
+			}
+		case *SetPassword:
+			if node.Password != nil {
+				node.Password = NewStrLiteral(RedactedPassword)
+			}
+		}
+		return true, nil
+	}, stmt)
 }
@@ -31,3 +31,44 @@ func TestRedactSQLStatements(t *testing.T) {
 
 	require.Equal(t, "select a, b, c from t where x = :x /* INT64 */ and y = :x /* INT64 */ and z = :z /* VARCHAR */", redactedSQL)
 }
+
+func TestRedactLoadData(t *testing.T) {
+	sql := "load data infile 'a' into table t fields terminated by ',' lines terminated by '\\n' ignore 3 lines"
+	redactedSQL, err := RedactSQLQuery(sql)
+	require.NoError(t, err)
+	require.Equal(t, "load data infile 'a' into table t fields terminated by ',' lines terminated by '\\n' ignore 3 lines", redactedSQL)
+}
+
+func TestRedactPasswords(t *testing.T) {
+	testcases := []struct {
+		sql  string
+		want string
+	}{{
+		sql:  "create user 'u'@'%' identified by 'secret', v identified with mysql_native_password as '*ABC'",
+		want: "create user 'u'@'%' identified by '<redacted>', 'v' identified with mysql_native_password as '<redacted>'",
+	}, {
+		sql:  "alter user u identified by random password",
+		want: "alter user 'u' identified by random password",
+	}, {
+		sql:  "alter user 'u'@'h' identified by 'new' replace 'old'",
+		want: "alter user 'u'@'h' identified by '<redacted>' replace '<redacted>'",
+	}, {
+		sql:  "/* c */ set password for u@h = 'secret'",
+		want: "/* c */ set password for 'u'@'h' = '<redacted>'",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.sql, func(t *testing.T) {
+			redactedSQL, err := RedactSQLQuery(tc.sql)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, redactedSQL)
+		})
+	}
+}
+
+func TestParserRedactSQLQuery(t *testing.T) {
+	parser, err := New(Options{Dialect: PostgresDialect{}})
+	require.NoError(t, err)
+	redactedSQL, err := parser.RedactSQLQuery(`select "Col" from t where a = 'x\'`)
+	require.NoError(t, err)
+	require.Equal(t, `select "Col" from t where a = :a /* VARCHAR */`, redactedSQL)
+}
@@ -0,0 +1,723 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Catalog describes the tables and views the columns of a statement can
+// refer to. FindView returns nil for a name that is not a view.
+type Catalog interface {
+	VSchemaViews
+	// FindTable returns the table with the given name, or nil if there is
+	// no such table.
+	FindTable(name TableName) *CatalogTable
+}
+
+// CatalogTable is a table of a Catalog.
+type CatalogTable struct {
+	Name    TableName
+	Columns []*CatalogColumn
+}
+
+// CatalogColumn is a column of a CatalogTable.
+type CatalogColumn struct {
+	Name IdentifierCI
+	Type *ColumnType
+}
+
+// Column returns the column with the given name, or nil.
+func (t *CatalogTable) Column(name IdentifierCI) *CatalogColumn {
+	for _, col := range t.Columns {
+		if col.Name.Equal(name) {
+			return col
+		}
+	}
+	return nil
+}
+
+// ResolvedColumn is a column a ColName or a star expression refers to.
+type ResolvedColumn struct {
+	// Name is the name of the column in its source, or the alias it is
+	// referred to by.
+	Name IdentifierCI
+	// Source is the table expression of the FROM clause the column belongs
+	// to. It is nil for a select expression referred to by its alias, and
+	// for the columns of a union referred to by its ORDER BY.
+	Source TableExpr
+	// Alias is the select expression a column of GROUP BY, HAVING or ORDER
+	// BY refers to by its alias.
+	Alias *AliasedExpr
+	// Table and Column are the catalog column the column is, directly or
+	// through the views, common table expressions, derived tables and
+	// aliases that select it unchanged. They are nil for computed columns.
+	Table  *CatalogTable
+	Column *CatalogColumn
+}
+
+// ResolveError is an unknown or ambiguous column, or an unknown table, of
+// a resolved statement.
+type ResolveError struct {
+	Err string
+	// Node is the node in error: the *ColName, the *StarExpr, the table
+	// expression, or the statement for the columns and tables it lists.
+	Node SQLNode
+	// Span is the source span of Node, when the statement was parsed
+	// WithPositions.
+	Span Span
+}
+
+func (e *ResolveError) Error() string {
+	if e.Span.IsValid() {
+		return fmt.Sprintf("%s at position %v", e.Err, e.Span.Start)
+	}
+	return e.Err
+}
+
+// Resolution holds the columns the ColNames and the star expressions of a
+// statement refer to.
+type Resolution struct {
+	columns map[*ColName]*ResolvedColumn
+	stars   map[*StarExpr][]*ResolvedColumn
+	// Errors are the unknown and ambiguous columns and the unknown tables
+	// of the statement.
+	Errors []*ResolveError
+}
+
+// Column returns the column a ColName refers to, or nil if it was not
+// resolved.
+func (res *Resolution) Column(col *ColName) *ResolvedColumn {
+	return res.columns[col]
+}
+
+// Star returns the columns a star expression expands to.
+func (res *Resolution) Star(star *StarExpr) []*ResolvedColumn {
+	return res.stars[star]
+}
+
+// Resolve binds the ColNames of a statement to the columns of the tables of
+// the catalog, and expands its star expressions. Table aliases, USING and
+// NATURAL joins, common table expressions, derived tables, views and
+// correlated subqueries are resolved, and GROUP BY, HAVING and ORDER BY can
+// refer to the aliases of the select expressions.
+//
+// The unknown and ambiguous columns and the unknown tables are returned as
+// ResolveErrors, joined in the error; the span of their nodes is looked up
+// in positions, which may be nil. The columns of a missing table are not
+// reported. Only the columns of queries and DML statements are resolved:
+// the ones of DDL statements, like CHECK constraints, are not.
+func Resolve(stmt Statement, catalog Catalog, positions *Positions) (*Resolution, error) {
+	r := newResolver(catalog, positions, make(map[string]*resolveCTE))
+	r.statement(stmt)
+	if len(r.res.Errors) == 0 {
+		return r.res, nil
+	}
+	errs := make([]error, 0, len(r.res.Errors))
+	for _, err := range r.res.Errors {
+		errs = append(errs, err)
+	}
+	return r.res, errors.Join(errs...)
+}
+
+// resolveSource is a table expression of a FROM clause.
+type resolveSource struct {
+	// name is the name of the table, or the alias of the table expression.
+	name    TableName
+	columns []*ResolvedColumn
+	// opaque is set when the columns of the source are unknown, like the
+	// ones of a missing table: any column of it is accepted.
+	opaque bool
+}
+
+// matches returns whether a column or star expression qualifier refers to
+// the source.
+func (src *resolveSource) matches(qualifier TableName) bool {
+	if src.name.Name != qualifier.Name {
+		return false
+	}
+	return qualifier.Qualifier.IsEmpty() || qualifier.Qualifier == src.name.Qualifier
+}
+
+func (src *resolveSource) column(name IdentifierCI) *ResolvedColumn {
+	for _, col := range src.columns {
+		if col.Name.Equal(name) {
+			return col
+		}
+	}
+	return nil
+}
+
+// resolveCTE holds the columns of a common table expression or of a view.
+type resolveCTE struct {
+	columns []*ResolvedColumn
+	opaque  bool
+}
+
+// resolveScope holds the names a query can refer to.
+type resolveScope struct {
+	parent  *resolveScope
+	sources []*resolveSource
+	// columns are the columns of the FROM clause, in the order * expands
+	// to.
+	columns []*ResolvedColumn
+	ctes    map[string]*resolveCTE
+	// aliases are the select expressions with an alias, which GROUP BY,
+	// HAVING and ORDER BY can refer to.
+	aliases map[string]*ResolvedColumn
+	// coalesced maps the columns of USING and NATURAL joins to the column
+	// of the other side of the join they are merged with.
+	coalesced map[*ResolvedColumn]*ResolvedColumn
+}
+
+func newResolveScope(parent *resolveScope) *resolveScope {
+	return &resolveScope{parent: parent}
+}
+
+func (scope *resolveScope) cte(name TableName) *resolveCTE {
+	if !name.Qualifier.IsEmpty() {
+		return nil
+	}
+	for s := scope; s != nil; s = s.parent {
+		if cte := s.ctes[name.Name.String()]; cte != nil {
+			return cte
+		}
+	}
+	return nil
+}
+
+func (scope *resolveScope) addCTE(name IdentifierCS, cte *resolveCTE) {
+	if scope.ctes == nil {
+		scope.ctes = map[string]*resolveCTE{}
+	}
+	scope.ctes[name.String()] = cte
+}
+
+// root returns the column a column of a USING or NATURAL join is merged
+// into.
+func (scope *resolveScope) root(col *ResolvedColumn) *ResolvedColumn {
+	for {
+		next, ok := scope.coalesced[col]
+		if !ok {
+			return col
+		}
+		col = next
+	}
+}
+
+type resolver struct {
+	catalog   Catalog
+	positions *Positions
+	res       *Resolution
+	// views caches the columns of the views, shared by the resolvers of
+	// the views.
+	views map[string]*resolveCTE
+}
+
+func newResolver(catalog Catalog, positions *Positions, views map[string]*resolveCTE) *resolver {
+	return &resolver{
+		catalog:   catalog,
+		positions: positions,
+		res: &Resolution{
+			columns: make(map[*ColName]*ResolvedColumn),
+			stars:   make(map[*StarExpr][]*ResolvedColumn),
+		},
+		views: views,
+	}
+}
+
+func (r *resolver) fail(node SQLNode, format string, args ...any) {
+	span, _ := r.positions.Span(node)
+	r.res.Errors = append(r.res.Errors, &ResolveError{
+		Err:  fmt.Sprintf(format, args...),
+		Node: node,
+		Span: span,
+	})
+}
+
+// clauseName returns the name of a clause in the error messages.
+func clauseName(clause AccessClause) string {
+	if clause == ClauseOther {
+		return "statement"
+	}
+	return clause.ToString() + " clause"
+}
+
+func (r *resolver) statement(stmt Statement) {
+	switch stmt := stmt.(type) {
+	case SelectStatement:
+		r.selectStatement(stmt, nil)
+	case *Insert:
+		r.insert(stmt)
+	case *Update:
+		r.update(stmt)
+	case *Delete:
+		r.delete(stmt)
+	default:
+		// resolve the queries of the other statements, like CREATE VIEW
+		root := true
+		_ = Walk(func(node SQLNode) (bool, error) {
+			if root {
+				root = false
+				return true, nil
+			}
+			switch node := node.(type) {
+			case SelectStatement, *Insert, *Update, *Delete:
+				r.statement(node.(Statement))
+				return false, nil
+			}
+			return true, nil
+		}, stmt)
+	}
+}
+
+// selectStatement resolves a query, and returns its columns.
+func (r *resolver) selectStatement(stmt SelectStatement, parent *resolveScope) []*ResolvedColumn {
+	switch stmt := stmt.(type) {
+	case *Select:
+		scope := newResolveScope(parent)
+		r.with(stmt.With, scope)
+		for _, expr := range stmt.From {
+			scope.columns = append(scope.columns, r.tableExpr(expr, scope)...)
+		}
+		columns := r.selectExprs(stmt.SelectExprs, scope)
+		if stmt.Where != nil {
+			r.expr(stmt.Where.Expr, scope, ClauseWhere)
+		}
+		for _, expr := range stmt.GroupBy {
+			r.expr(expr, scope, ClauseGroupBy)
+		}
+		if stmt.Having != nil {
+			r.expr(stmt.Having.Expr, scope, ClauseHaving)
+		}
+		if stmt.Windows != nil {
+			r.expr(stmt.Windows, scope, ClauseSelect)
+		}
+		r.orderByLimit(stmt.OrderBy, stmt.Limit, scope)
+		return columns
+	case *Union:
+		scope := newResolveScope(parent)
+		r.with(stmt.With, scope)
+		columns := r.selectStatement(stmt.Left, scope)
+		r.selectStatement(stmt.Right, scope)
+		// the ORDER BY of a union refers to its columns
+		orderScope := newResolveScope(scope)
+		orderScope.sources = []*resolveSource{{columns: columns}}
+		r.orderByLimit(stmt.OrderBy, stmt.Limit, orderScope)
+		return columns
+	}
+	return nil
+}
+
+func (r *resolver) orderByLimit(orderBy OrderBy, limit *Limit, scope *resolveScope) {
+	for _, order := range orderBy {
+		r.expr(order.Expr, scope, ClauseOrderBy)
+	}
+	if limit != nil {
+		r.expr(limit, scope, ClauseOther)
+	}
+}
+
+// with resolves the queries of the common table expressions and adds them
+// to the scope. A recursive common table expression is opaque to itself.
+func (r *resolver) with(with *With, scope *resolveScope) {
+	if with == nil {
+		return
+	}
+	for _, expr := range with.ctes {
+		cte := &resolveCTE{opaque: true}
+		if with.Recursive {
+			scope.addCTE(expr.ID, cte)
+		}
+		columns := r.selectStatement(expr.Subquery.Select, scope)
+		cte.columns, cte.opaque = renameColumns(columns, expr.Columns), false
+		scope.addCTE(expr.ID, cte)
+	}
+}
+
+// renameColumns returns the columns of a query with the names of the column
+// list of a common table expression or a derived table, if any.
+func renameColumns(columns []*ResolvedColumn, names Columns) []*ResolvedColumn {
+	if len(names) == 0 {
+		return columns
+	}
+	renamed := make([]*ResolvedColumn, 0, len(columns))
+	for i, col := range columns {
+		if i < len(names) {
+			col = &ResolvedColumn{Name: names[i], Table: col.Table, Column: col.Column}
+		}
+		renamed = append(renamed, col)
+	}
+	return renamed
+}
+
+// sourceColumns returns the columns of a query as the columns of the table
+// expression it is selected from.
+func sourceColumns(columns []*ResolvedColumn, source TableExpr) []*ResolvedColumn {
+	result := make([]*ResolvedColumn, 0, len(columns))
+	for _, col := range columns {
+		result = append(result, &ResolvedColumn{Name: col.Name, Source: source, Table: col.Table, Column: col.Column})
+	}
+	return result
+}
+
+// tableExpr adds the sources of a table expression to the scope, resolves
+// its join conditions, and returns its columns.
+func (r *resolver) tableExpr(expr TableExpr, scope *resolveScope) []*ResolvedColumn {
+	switch expr := expr.(type) {
+	case *AliasedTableExpr:
+		src := &resolveSource{}
+		switch table := expr.Expr.(type) {
+		case TableName:
+			if table.Qualifier.IsEmpty() && table.Name.String() == "dual" {
+				return nil
+			}
+			src.name = table
+			src.columns, src.opaque = r.table(table, expr, scope)
+		case *DerivedTable:
+			// only a lateral derived table can refer to the sources of the
+			// query it is part of
+			inner := &resolveScope{parent: scope.parent, ctes: scope.ctes}
+			if table.Lateral {
+				inner = scope
+			}
+			columns := r.selectStatement(table.Select, inner)
+			src.columns = sourceColumns(renameColumns(columns, expr.Columns), expr)
+		}
+		if !expr.As.IsEmpty() {
+			src.name = TableName{Name: expr.As}
+		}
+		scope.sources = append(scope.sources, src)
+		return src.columns
+	case *ParenTableExpr:
+		var columns []*ResolvedColumn
+		for _, expr := range expr.Exprs {
+			columns = append(columns, r.tableExpr(expr, scope)...)
+		}
+		return columns
+	case *JoinTableExpr:
+		left := r.tableExpr(expr.LeftExpr, scope)
+		right := r.tableExpr(expr.RightExpr, scope)
+		var using Columns
+		switch expr.Join {
+		case NaturalJoinType, NaturalLeftJoinType, NaturalRightJoinType:
+			for _, col := range left {
+				if findColumn(right, col.Name) != nil {
+					using = append(using, col.Name)
+				}
+			}
+		default:
+			if expr.Condition != nil {
+				if expr.Condition.On != nil {
+					r.expr(expr.Condition.On, scope, ClauseJoinOn)
+				}
+				using = expr.Condition.Using
+			}
+		}
+		if len(using) == 0 {
+			return append(append([]*ResolvedColumn(nil), left...), right...)
+		}
+		rightJoin := expr.Join == RightJoinType || expr.Join == NaturalRightJoinType
+		return r.coalesce(expr, scope, left, right, using, rightJoin)
+	case *JSONTableExpr:
+		r.expr(expr.Expr, scope, ClauseOther)
+		scope.sources = append(scope.sources, &resolveSource{name: TableName{Name: expr.Alias}, opaque: true})
+	}
+	return nil
+}
+
+// table returns the columns of a common table expression, a view or a
+// table of the catalog, and whether they are opaque.
+func (r *resolver) table(name TableName, expr *AliasedTableExpr, scope *resolveScope) ([]*ResolvedColumn, bool) {
+	if cte := scope.cte(name); cte != nil {
+		return sourceColumns(cte.columns, expr), cte.opaque
+	}
+	if view := r.catalog.FindView(name); view != nil {
+		cte := r.view(name, view)
+		return sourceColumns(cte.columns, expr), cte.opaque
+	}
+	table := r.catalog.FindTable(name)
+	if table == nil {
+		r.fail(expr, "table '%s' doesn't exist", String(name))
+		return nil, true
+	}
+	columns := make([]*ResolvedColumn, 0, len(table.Columns))
+	for _, col := range table.Columns {
+		columns = append(columns, &ResolvedColumn{Name: col.Name, Source: expr, Table: table, Column: col})
+	}
+	return columns, false
+}
+
+// view returns the columns of a view. The errors of its query are not the
+// ones of the statement: it is resolved on its own.
+func (r *resolver) view(name TableName, view SelectStatement) *resolveCTE {
+	key := String(name)
+	if cte, ok := r.views[key]; ok {
+		return cte
+	}
+	// a view referring to itself is opaque
+	cte := &resolveCTE{opaque: true}
+	r.views[key] = cte
+	columns := newResolver(r.catalog, nil, r.views).selectStatement(view, nil)
+	cte.columns, cte.opaque = columns, false
+	return cte
+}
+
+func findColumn(columns []*ResolvedColumn, name IdentifierCI) *ResolvedColumn {
+	for _, col := range columns {
+		if col.Name.Equal(name) {
+			return col
+		}
+	}
+	return nil
+}
+
+// coalesce merges the columns of a USING or NATURAL join, and returns the
+// columns of the join: the merged columns first, then the other columns of
+// the left and right sides. The merged columns are the ones of the left
+// side, or of the right side for a right join.
+func (r *resolver) coalesce(expr *JoinTableExpr, scope *resolveScope, left, right []*ResolvedColumn, using Columns, rightJoin bool) []*ResolvedColumn {
+	if scope.coalesced == nil {
+		scope.coalesced = map[*ResolvedColumn]*ResolvedColumn{}
+	}
+	merged := map[*ResolvedColumn]bool{}
+	var columns []*ResolvedColumn
+	for _, name := range using {
+		leftCol, rightCol := findColumn(left, name), findColumn(right, name)
+		if leftCol == nil || rightCol == nil {
+			if !r.hasOpaqueSource(scope) {
+				r.fail(expr, "unknown column '%s' in %s", name.String(), clauseName(ClauseJoinOn))
+			}
+			continue
+		}
+		keep, drop := leftCol, rightCol
+		if rightJoin {
+			keep, drop = rightCol, leftCol
+		}
+		scope.coalesced[drop] = keep
+		merged[leftCol], merged[rightCol] = true, true
+		columns = append(columns, keep)
+	}
+	for _, side := range [][]*ResolvedColumn{left, right} {
+		for _, col := range side {
+			if !merged[col] {
+				columns = append(columns, col)
+			}
+		}
+	}
+	return columns
+}
+
+func (r *resolver) hasOpaqueSource(scope *resolveScope) bool {
+	for _, src := range scope.sources {
+		if src.opaque {
+			return true
+		}
+	}
+	return false
+}
+
+// selectExprs resolves the select expressions, adds their aliases to the
+// scope, and returns the columns they select.
+func (r *resolver) selectExprs(exprs SelectExprs, scope *resolveScope) []*ResolvedColumn {
+	var columns []*ResolvedColumn
+	for _, expr := range exprs {
+		switch expr := expr.(type) {
+		case *StarExpr:
+			for _, col := range r.star(expr, scope) {
+				columns = append(columns, &ResolvedColumn{Name: col.Name, Table: col.Table, Column: col.Column})
+			}
+		case *AliasedExpr:
+			r.expr(expr.Expr, scope, ClauseSelect)
+			col := &ResolvedColumn{Name: expr.As}
+			if name, ok := expr.Expr.(*ColName); ok {
+				if col.Name.IsEmpty() {
+					col.Name = name.Name
+				}
+				if resolved := r.res.columns[name]; resolved != nil {
+					col.Table, col.Column = resolved.Table, resolved.Column
+				}
+			}
+			if col.Name.IsEmpty() {
+				col.Name = NewIdentifierCI(String(expr.Expr))
+			}
+			columns = append(columns, col)
+			if !expr.As.IsEmpty() {
+				if scope.aliases == nil {
+					scope.aliases = map[string]*ResolvedColumn{}
+				}
+				scope.aliases[expr.As.Lowered()] = &ResolvedColumn{Name: expr.As, Alias: expr, Table: col.Table, Column: col.Column}
+			}
+		case *Nextval:
+			r.expr(expr.Expr, scope, ClauseSelect)
+		}
+	}
+	return columns
+}
+
+// star expands a star expression to the columns of the FROM clause, or of
+// the table it names.
+func (r *resolver) star(expr *StarExpr, scope *resolveScope) []*ResolvedColumn {
+	columns := scope.columns
+	if !expr.TableName.IsEmpty() {
+		columns = nil
+		found := false
+		for _, src := range scope.sources {
+			if src.matches(expr.TableName) {
+				columns, found = src.columns, true
+				break
+			}
+		}
+		if !found {
+			r.fail(expr, "unknown table '%s'", String(expr.TableName))
+		}
+	}
+	r.res.stars[expr] = columns
+	return columns
+}
+
+// expr resolves the columns of an expression, and its subqueries.
+func (r *resolver) expr(expr SQLNode, scope *resolveScope, clause AccessClause) {
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *ColName:
+			r.column(node, scope, clause)
+		case *Subquery:
+			r.selectStatement(node.Select, scope)
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+}
+
+// column resolves a column in the scope, or in the outer scopes for a
+// correlated column. An unqualified column of GROUP BY can refer to an
+// alias if there is no such column, and one of HAVING or ORDER BY refers
+// to an alias first.
+func (r *resolver) column(col *ColName, scope *resolveScope, clause AccessClause) {
+	if !col.Qualifier.IsEmpty() {
+		for s := scope; s != nil; s = s.parent {
+			for _, src := range s.sources {
+				if !src.matches(col.Qualifier) {
+					continue
+				}
+				if resolved := src.column(col.Name); resolved != nil {
+					r.res.columns[col] = resolved
+				} else if !src.opaque {
+					r.fail(col, "unknown column '%s' in %s", String(col), clauseName(clause))
+				}
+				return
+			}
+		}
+		r.fail(col, "unknown column '%s' in %s", String(col), clauseName(clause))
+		return
+	}
+
+	alias := scope.aliases[col.Name.Lowered()]
+	if alias != nil && (clause == ClauseHaving || clause == ClauseOrderBy) {
+		r.res.columns[col] = alias
+		return
+	}
+	for s := scope; s != nil; s = s.parent {
+		var found *ResolvedColumn
+		opaque := false
+		for _, src := range s.sources {
+			opaque = opaque || src.opaque
+			resolved := src.column(col.Name)
+			if resolved == nil {
+				continue
+			}
+			resolved = s.root(resolved)
+			if found != nil && found != resolved {
+				r.fail(col, "column '%s' in %s is ambiguous", String(col), clauseName(clause))
+				return
+			}
+			found = resolved
+		}
+		switch {
+		case found != nil:
+			r.res.columns[col] = found
+			return
+		case s == scope && alias != nil && clause == ClauseGroupBy:
+			r.res.columns[col] = alias
+			return
+		case opaque:
+			return
+		}
+	}
+	r.fail(col, "unknown column '%s' in %s", String(col), clauseName(clause))
+}
+
+func (r *resolver) insert(stmt *Insert) {
+	scope := newResolveScope(nil)
+	r.tableExpr(stmt.Table, scope)
+	for _, src := range scope.sources {
+		for _, name := range stmt.Columns {
+			if !src.opaque && src.column(name) == nil {
+				r.fail(stmt, "unknown column '%s' in %s", name.String(), clauseName(ClauseInsertColumns))
+			}
+		}
+	}
+	switch rows := stmt.Rows.(type) {
+	case SelectStatement:
+		r.selectStatement(rows, nil)
+	case Values:
+		r.expr(rows, scope, ClauseOther)
+	}
+	r.expr(stmt.OnDup, scope, ClauseSet)
+	r.selectExprs(stmt.Returning, scope)
+}
+
+func (r *resolver) update(stmt *Update) {
+	scope := newResolveScope(nil)
+	r.with(stmt.With, scope)
+	for _, expr := range stmt.TableExprs {
+		scope.columns = append(scope.columns, r.tableExpr(expr, scope)...)
+	}
+	for _, expr := range stmt.Exprs {
+		r.column(expr.Name, scope, ClauseSet)
+		r.expr(expr.Expr, scope, ClauseSet)
+	}
+	if stmt.Where != nil {
+		r.expr(stmt.Where.Expr, scope, ClauseWhere)
+	}
+	r.orderByLimit(stmt.OrderBy, stmt.Limit, scope)
+	r.selectExprs(stmt.Returning, scope)
+}
+
+func (r *resolver) delete(stmt *Delete) {
+	scope := newResolveScope(nil)
+	r.with(stmt.With, scope)
+	for _, expr := range stmt.TableExprs {
+		scope.columns = append(scope.columns, r.tableExpr(expr, scope)...)
+	}
+	for _, target := range stmt.Targets {
+		found := false
+		for _, src := range scope.sources {
+			found = found || src.matches(target)
+		}
+		if !found {
+			r.fail(stmt, "unknown table '%s' in delete", String(target))
+		}
+	}
+	if stmt.Where != nil {
+		r.expr(stmt.Where.Expr, scope, ClauseWhere)
+	}
+	r.orderByLimit(stmt.OrderBy, stmt.Limit, scope)
+	r.selectExprs(stmt.Returning, scope)
+}
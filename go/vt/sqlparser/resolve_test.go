@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCatalog is a catalog of tables given as name and column names, and
+// of views given as SQL.
+type testCatalog struct {
+	tables map[string]*CatalogTable
+	views  map[string]SelectStatement
+}
+
+func newTestCatalog(t *testing.T, tables map[string][]string, views map[string]string) *testCatalog {
+	catalog := &testCatalog{tables: map[string]*CatalogTable{}, views: map[string]SelectStatement{}}
+	for name, columns := range tables {
+		table := &CatalogTable{Name: TableName{Name: NewIdentifierCS(name)}}
+		for _, col := range columns {
+			table.Columns = append(table.Columns, &CatalogColumn{Name: NewIdentifierCI(col), Type: &ColumnType{Type: "int"}})
+		}
+		catalog.tables[name] = table
+	}
+	for name, sql := range views {
+		stmt, err := Parse(sql)
+		require.NoError(t, err)
+		catalog.views[name] = stmt.(SelectStatement)
+	}
+	return catalog
+}
+
+func (c *testCatalog) FindTable(name TableName) *CatalogTable {
+	return c.tables[String(name)]
+}
+
+func (c *testCatalog) FindView(name TableName) SelectStatement {
+	return c.views[String(name)]
+}
+
+// resolvedString returns the columns the ColNames of a statement resolve to,
+// as "column=table.column", "column=alias" or "column=?".
+func resolvedString(stmt Statement, res *Resolution) []string {
+	var result []string
+	_ = Walk(func(node SQLNode) (bool, error) {
+		col, ok := node.(*ColName)
+		if !ok {
+			return true, nil
+		}
+		resolved := res.Column(col)
+		switch {
+		case resolved == nil:
+			result = append(result, String(col)+"=?")
+		case resolved.Column != nil:
+			result = append(result, fmt.Sprintf("%s=%s.%s", String(col), String(resolved.Table.Name), resolved.Column.Name.String()))
+		default:
+			result = append(result, fmt.Sprintf("%s=%s", String(col), resolved.Name.String()))
+		}
+		return true, nil
+	}, stmt)
+	return result
+}
+
+func TestResolve(t *testing.T) {
+	catalog := newTestCatalog(t, map[string][]string{
+		"t1": {"id", "a", "b"},
+		"t2": {"id", "b", "c"},
+	}, map[string]string{
+		"v": "select id, a as x from t1",
+	})
+	testcases := []struct {
+		sql      string
+		resolved []string
+		errors   []string
+	}{{
+		sql:      "select a, t1.b, x.c from t1 join t2 as x on t1.id = x.id where a > 1",
+		resolved: []string{"t1.id=t1.id", "x.id=t2.id", "a=t1.a", "t1.b=t1.b", "x.c=t2.c", "a=t1.a"},
+	}, {
+		sql:      "select id, b from t1 join t2 using (id)",
+		resolved: []string{"id=t1.id", "b=?"},
+		errors:   []string{"column 'b' in select clause is ambiguous at position 1:12"},
+	}, {
+		sql:      "select id, c from t1 natural right join t2 where b = 1",
+		resolved: []string{"id=t2.id", "c=t2.c", "b=t2.b"},
+	}, {
+		sql:      "select a + 1 as n, count(*) as cnt from t1 group by n having cnt > 1 order by n, b",
+		resolved: []string{"a=t1.a", "n=n", "cnt=cnt", "n=n", "b=t1.b"},
+	}, {
+		sql:      "select a as id from t1 order by id",
+		resolved: []string{"a=t1.a", "id=t1.a"},
+	}, {
+		sql:      "with c(k, v) as (select id, a from t1) select k, c.v from c",
+		resolved: []string{"k=t1.id", "c.v=t1.a", "id=t1.id", "a=t1.a"},
+	}, {
+		sql:      "with recursive c as (select 1 as n from dual union all select n + 1 from c where n < 5) select n from c",
+		resolved: []string{"n=n", "n=?", "n=?"},
+	}, {
+		sql:      "select d.k from (select id as k from t1) as d where d.k in (select c from t2 where t2.id = d.k)",
+		resolved: []string{"id=t1.id", "d.k=t1.id", "d.k=t1.id", "c=t2.c", "t2.id=t2.id", "d.k=t1.id"},
+	}, {
+		sql:      "select * from t1 where exists (select 1 from t2 where t2.b = t1.b and c = a)",
+		resolved: []string{"t2.b=t2.b", "t1.b=t1.b", "c=t2.c", "a=t1.a"},
+	}, {
+		sql:      "select x, v.id from v",
+		resolved: []string{"x=t1.a", "v.id=t1.id"},
+	}, {
+		sql:      "select d from t1 where t3.a = 1",
+		resolved: []string{"d=?", "t3.a=?"},
+		errors: []string{
+			"unknown column 'd' in select clause at position 1:8",
+			"unknown column 't3.a' in where clause at position 1:24",
+		},
+	}, {
+		sql:      "select a from t3 where b = 1",
+		resolved: []string{"a=?", "b=?"},
+		errors:   []string{"table 't3' doesn't exist at position 1:15"},
+	}, {
+		sql:      "update t1 join t2 on t1.id = t2.id set a = c where t2.b = 1",
+		resolved: []string{"t1.id=t1.id", "t2.id=t2.id", "a=t1.a", "c=t2.c", "t2.b=t2.b"},
+	}, {
+		sql:      "insert into t1 (id, d) values (1, 2) on duplicate key update a = values(b)",
+		resolved: []string{"a=t1.a", "b=t1.b"},
+		errors:   []string{"unknown column 'd' in insert columns clause at position 1:1"},
+	}, {
+		sql:      "delete x from t1 where id = 1",
+		resolved: []string{"id=t1.id"},
+		errors:   []string{"unknown table 'x' in delete at position 1:1"},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.sql, func(t *testing.T) {
+			stmt, positions, err := ParseWithPositions(tcase.sql)
+			require.NoError(t, err)
+			res, err := Resolve(stmt, catalog, positions)
+			assert.Equal(t, tcase.resolved, resolvedString(stmt, res))
+			var errs []string
+			for _, err := range res.Errors {
+				errs = append(errs, err.Error())
+			}
+			assert.Equal(t, tcase.errors, errs)
+			if len(tcase.errors) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestResolveStar(t *testing.T) {
+	catalog := newTestCatalog(t, map[string][]string{
+		"t1": {"id", "a", "b"},
+		"t2": {"id", "b", "c"},
+	}, nil)
+	testcases := []struct {
+		sql     string
+		columns []string
+	}{{
+		sql:     "select * from t1, t2",
+		columns: []string{"t1.id", "t1.a", "t1.b", "t2.id", "t2.b", "t2.c"},
+	}, {
+		sql:     "select * from t1 join t2 using (id)",
+		columns: []string{"t1.id", "t1.a", "t1.b", "t2.b", "t2.c"},
+	}, {
+		sql:     "select * from t1 natural join t2",
+		columns: []string{"t1.id", "t1.b", "t1.a", "t2.c"},
+	}, {
+		sql:     "select x.* from t1 join t2 as x on t1.id = x.id",
+		columns: []string{"t2.id", "t2.b", "t2.c"},
+	}, {
+		sql:     "select d.* from (select b, a from t1) as d",
+		columns: []string{"t1.b", "t1.a"},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.sql, func(t *testing.T) {
+			stmt, err := Parse(tcase.sql)
+			require.NoError(t, err)
+			res, err := Resolve(stmt, catalog, nil)
+			require.NoError(t, err)
+			star := stmt.(*Select).SelectExprs[0].(*StarExpr)
+			var columns []string
+			for _, col := range res.Star(star) {
+				columns = append(columns, String(col.Table.Name)+"."+col.Column.Name.String())
+			}
+			assert.Equal(t, tcase.columns, columns)
+		})
+	}
+}
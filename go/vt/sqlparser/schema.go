@@ -0,0 +1,1051 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// Schema is an in-memory model of the databases, tables and views of a
+// server, built by applying DDL statements to it. It implements Catalog.
+//
+// The tables are kept as CREATE TABLE statements, normalized the way the
+// server reports them: the keys of column definitions are table indexes,
+// and the indexes, foreign keys and check constraints without a name get
+// the one the server would generate.
+//
+// Unqualified names refer to the database of the last USE statement. The
+// databases of qualified names do not have to be created first: migration
+// files usually do not create the databases they apply to.
+type Schema struct {
+	database  string
+	databases map[string]*CreateDatabase
+	tables    map[schemaName]*schemaTable
+	views     map[schemaName]*CreateView
+}
+
+// schemaName is the key of the tables and views of a schema.
+type schemaName struct {
+	database, name string
+}
+
+// NewSchema returns an empty schema.
+func NewSchema() *Schema {
+	return &Schema{
+		databases: make(map[string]*CreateDatabase),
+		tables:    make(map[schemaName]*schemaTable),
+		views:     make(map[schemaName]*CreateView),
+	}
+}
+
+// Clone returns a copy of the schema, which DDL statements can be applied to
+// without changing the schema.
+func (s *Schema) Clone() *Schema {
+	clone := NewSchema()
+	clone.database = s.database
+	for name, db := range s.databases {
+		clone.databases[name] = db
+	}
+	// the definitions are never changed in place, they can be shared
+	for name, table := range s.tables {
+		clone.tables[name] = &schemaTable{create: table.create}
+	}
+	for name, view := range s.views {
+		clone.views[name] = view
+	}
+	return clone
+}
+
+func (s *Schema) key(name TableName) schemaName {
+	db := name.Qualifier.String()
+	if db == "" {
+		db = s.database
+	}
+	return schemaName{database: db, name: name.Name.String()}
+}
+
+// Table returns the definition of a table, or nil if there is no such
+// table. The definition belongs to the schema and must not be changed.
+func (s *Schema) Table(name TableName) *CreateTable {
+	if table := s.tables[s.key(name)]; table != nil {
+		return table.create
+	}
+	return nil
+}
+
+// Tables returns the definitions of the tables, sorted by database and
+// name.
+func (s *Schema) Tables() []*CreateTable {
+	names := make([]schemaName, 0, len(s.tables))
+	for name := range s.tables {
+		names = append(names, name)
+	}
+	sortSchemaNames(names)
+	tables := make([]*CreateTable, 0, len(names))
+	for _, name := range names {
+		tables = append(tables, s.tables[name].create)
+	}
+	return tables
+}
+
+// View returns the definition of a view, or nil if there is no such view.
+// The definition belongs to the schema and must not be changed.
+func (s *Schema) View(name TableName) *CreateView {
+	return s.views[s.key(name)]
+}
+
+// Views returns the definitions of the views, sorted by database and name.
+func (s *Schema) Views() []*CreateView {
+	names := make([]schemaName, 0, len(s.views))
+	for name := range s.views {
+		names = append(names, name)
+	}
+	sortSchemaNames(names)
+	views := make([]*CreateView, 0, len(names))
+	for _, name := range names {
+		views = append(views, s.views[name])
+	}
+	return views
+}
+
+// Databases returns the names of the created databases, sorted.
+func (s *Schema) Databases() []string {
+	names := make([]string, 0, len(s.databases))
+	for name := range s.databases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortSchemaNames(names []schemaName) {
+	sort.Slice(names, func(i, j int) bool {
+		if names[i].database != names[j].database {
+			return names[i].database < names[j].database
+		}
+		return names[i].name < names[j].name
+	})
+}
+
+// FindTable implements Catalog.
+func (s *Schema) FindTable(name TableName) *CatalogTable {
+	table := s.tables[s.key(name)]
+	if table == nil {
+		return nil
+	}
+	if table.catalog == nil {
+		table.catalog = &CatalogTable{Name: table.create.Table}
+		for _, col := range table.create.TableSpec.Columns {
+			table.catalog.Columns = append(table.catalog.Columns, &CatalogColumn{Name: col.Name, Type: col.Type})
+		}
+	}
+	return table.catalog
+}
+
+// FindView implements Catalog.
+func (s *Schema) FindView(name TableName) SelectStatement {
+	view := s.views[s.key(name)]
+	if view == nil {
+		return nil
+	}
+	if len(view.Columns) == 0 {
+		return view.Select
+	}
+	// the column list of the view renames the columns of its query
+	return &Select{
+		SelectExprs: SelectExprs{&StarExpr{}},
+		From: TableExprs{&AliasedTableExpr{
+			Expr:    &DerivedTable{Select: view.Select},
+			As:      view.ViewName.Name,
+			Columns: view.Columns,
+		}},
+	}
+}
+
+// ApplySQL applies the statements of a SQL script to the schema. It stops
+// at the first statement that cannot be parsed or applied.
+func (s *Schema) ApplySQL(sql string) error {
+	tokenizer := NewStringTokenizer(sql)
+	for {
+		stmt, err := ParseNext(tokenizer)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := s.Apply(stmt); err != nil {
+			return err
+		}
+	}
+}
+
+// Apply applies a statement to the schema. The CREATE, ALTER, DROP, RENAME
+// and TRUNCATE statements of tables, views and databases are applied, as is
+// USE. The other statements, including the DDL of stored routines, triggers
+// and events, leave the schema unchanged.
+//
+// An invalid change, like dropping a missing column or adding an index
+// with the name of an existing one, returns an error and leaves the schema
+// unchanged.
+func (s *Schema) Apply(stmt Statement) error {
+	switch stmt := stmt.(type) {
+	case *CreateTable:
+		return s.createTable(stmt)
+	case *AlterTable:
+		return s.alterTable(stmt)
+	case *DropTable:
+		return s.dropTable(stmt)
+	case *RenameTable:
+		return s.renameTable(stmt)
+	case *TruncateTable:
+		if s.tables[s.key(stmt.Table)] == nil {
+			return tableNotFound(stmt.Table)
+		}
+	case *CreateView:
+		return s.createView(stmt)
+	case *AlterView:
+		return s.alterView(stmt)
+	case *DropView:
+		return s.dropView(stmt)
+	case *CreateDatabase:
+		return s.createDatabase(stmt)
+	case *AlterDatabase:
+		return s.alterDatabase(stmt)
+	case *DropDatabase:
+		return s.dropDatabase(stmt)
+	case *Use:
+		s.database = stmt.DBName.String()
+	}
+	return nil
+}
+
+func tableNotFound(name TableName) error {
+	return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "table '%s' doesn't exist", String(name))
+}
+
+func tableExists(name TableName) error {
+	return vterrors.Errorf(vtrpcpb.Code_ALREADY_EXISTS, "table '%s' already exists", String(name))
+}
+
+func notFullyParsed(stmt Statement) error {
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "statement was not fully parsed: %s", String(stmt))
+}
+
+func (s *Schema) createTable(stmt *CreateTable) error {
+	if !stmt.FullyParsed {
+		return notFullyParsed(stmt)
+	}
+	key := s.key(stmt.Table)
+	if s.tables[key] != nil || s.views[key] != nil {
+		if stmt.IfNotExists {
+			return nil
+		}
+		return tableExists(stmt.Table)
+	}
+	table := &schemaTable{create: &CreateTable{Temp: stmt.Temp, Table: stmt.Table, FullyParsed: true}}
+	spec := stmt.TableSpec
+	if stmt.OptLike != nil {
+		like := s.tables[s.key(stmt.OptLike.LikeTable)]
+		if like == nil {
+			return tableNotFound(stmt.OptLike.LikeTable)
+		}
+		spec = like.create.TableSpec
+	}
+	if err := table.setSpec(spec, stmt.OptLike != nil); err != nil {
+		return err
+	}
+	s.tables[key] = table
+	return nil
+}
+
+func (s *Schema) alterTable(stmt *AlterTable) error {
+	if !stmt.FullyParsed {
+		return notFullyParsed(stmt)
+	}
+	key := s.key(stmt.Table)
+	old := s.tables[key]
+	if old == nil {
+		return tableNotFound(stmt.Table)
+	}
+	// the options are applied to a copy, so that a failing one leaves the
+	// table unchanged
+	table := &schemaTable{create: CloneRefOfCreateTable(old.create)}
+	newKey := key
+	for _, option := range stmt.AlterOptions {
+		if rename, ok := option.(*RenameTableName); ok {
+			newKey = s.key(rename.Table)
+			if newKey != key && (s.tables[newKey] != nil || s.views[newKey] != nil) {
+				return tableExists(rename.Table)
+			}
+			table.create.Table = rename.Table
+			continue
+		}
+		if err := table.alter(option); err != nil {
+			return err
+		}
+	}
+	if stmt.PartitionOption != nil {
+		table.create.TableSpec.PartitionOption = CloneRefOfPartitionOption(stmt.PartitionOption)
+	}
+	if stmt.PartitionSpec != nil {
+		if err := table.alterPartitions(stmt.PartitionSpec, s); err != nil {
+			return err
+		}
+	}
+	delete(s.tables, key)
+	s.tables[newKey] = table
+	return nil
+}
+
+func (s *Schema) dropTable(stmt *DropTable) error {
+	for _, name := range stmt.FromTables {
+		if s.tables[s.key(name)] == nil && !stmt.IfExists {
+			return tableNotFound(name)
+		}
+	}
+	for _, name := range stmt.FromTables {
+		delete(s.tables, s.key(name))
+	}
+	return nil
+}
+
+// renameTable renames the tables one pair after the other, so that tables
+// can be swapped through a temporary name.
+func (s *Schema) renameTable(stmt *RenameTable) error {
+	tables := make(map[schemaName]*schemaTable, len(s.tables))
+	for name, table := range s.tables {
+		tables[name] = table
+	}
+	for _, pair := range stmt.TablePairs {
+		from, to := s.key(pair.FromTable), s.key(pair.ToTable)
+		table := tables[from]
+		if table == nil {
+			return tableNotFound(pair.FromTable)
+		}
+		if tables[to] != nil || s.views[to] != nil {
+			return tableExists(pair.ToTable)
+		}
+		create := CloneRefOfCreateTable(table.create)
+		create.Table = pair.ToTable
+		delete(tables, from)
+		tables[to] = &schemaTable{create: create}
+	}
+	s.tables = tables
+	return nil
+}
+
+func (s *Schema) createView(stmt *CreateView) error {
+	key := s.key(stmt.ViewName)
+	if s.tables[key] != nil || (s.views[key] != nil && !stmt.IsReplace) {
+		return tableExists(stmt.ViewName)
+	}
+	view := CloneRefOfCreateView(stmt)
+	view.IsReplace, view.Comments = false, nil
+	s.views[key] = view
+	return nil
+}
+
+func (s *Schema) alterView(stmt *AlterView) error {
+	key := s.key(stmt.ViewName)
+	if s.views[key] == nil {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "view '%s' doesn't exist", String(stmt.ViewName))
+	}
+	alter := CloneRefOfAlterView(stmt)
+	s.views[key] = &CreateView{
+		ViewName:    alter.ViewName,
+		Algorithm:   alter.Algorithm,
+		Definer:     alter.Definer,
+		Security:    alter.Security,
+		Columns:     alter.Columns,
+		Select:      alter.Select,
+		CheckOption: alter.CheckOption,
+	}
+	return nil
+}
+
+func (s *Schema) dropView(stmt *DropView) error {
+	for _, name := range stmt.FromTables {
+		if s.views[s.key(name)] == nil && !stmt.IfExists {
+			return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "view '%s' doesn't exist", String(name))
+		}
+	}
+	for _, name := range stmt.FromTables {
+		delete(s.views, s.key(name))
+	}
+	return nil
+}
+
+func (s *Schema) createDatabase(stmt *CreateDatabase) error {
+	name := stmt.DBName.String()
+	if s.databases[name] != nil {
+		if stmt.IfNotExists {
+			return nil
+		}
+		return vterrors.Errorf(vtrpcpb.Code_ALREADY_EXISTS, "database '%s' already exists", name)
+	}
+	db := CloneRefOfCreateDatabase(stmt)
+	db.IfNotExists, db.Comments = false, nil
+	s.databases[name] = db
+	return nil
+}
+
+func (s *Schema) alterDatabase(stmt *AlterDatabase) error {
+	name := stmt.DBName.String()
+	if name == "" {
+		name = s.database
+	}
+	db := s.databases[name]
+	if db == nil {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "unknown database '%s'", name)
+	}
+	db = CloneRefOfCreateDatabase(db)
+	for _, option := range stmt.AlterOptions {
+		replaced := false
+		for i, existing := range db.CreateOptions {
+			if existing.Type == option.Type {
+				db.CreateOptions[i], replaced = option, true
+			}
+		}
+		if !replaced {
+			db.CreateOptions = append(db.CreateOptions, option)
+		}
+	}
+	s.databases[name] = db
+	return nil
+}
+
+// dropDatabase drops a database, and its tables and views.
+func (s *Schema) dropDatabase(stmt *DropDatabase) error {
+	name := stmt.DBName.String()
+	if s.databases[name] == nil {
+		if stmt.IfExists {
+			return nil
+		}
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "unknown database '%s'", name)
+	}
+	delete(s.databases, name)
+	for key := range s.tables {
+		if key.database == name {
+			delete(s.tables, key)
+		}
+	}
+	for key := range s.views {
+		if key.database == name {
+			delete(s.views, key)
+		}
+	}
+	return nil
+}
+
+// schemaTable is a table of a schema. Its definition is only changed while
+// it is built: the tables of a schema are replaced by altered copies.
+type schemaTable struct {
+	create *CreateTable
+	// catalog is built by FindTable.
+	catalog *CatalogTable
+}
+
+func (t *schemaTable) spec() *TableSpec {
+	return t.create.TableSpec
+}
+
+func (t *schemaTable) invalid(format string, args ...any) error {
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "table '%s': %s", String(t.create.Table), fmt.Sprintf(format, args...))
+}
+
+// setSpec sets the definition of a new table, normalizing it. Foreign keys
+// are not copied by CREATE TABLE ... LIKE.
+func (t *schemaTable) setSpec(spec *TableSpec, like bool) error {
+	t.create.TableSpec = &TableSpec{}
+	if spec == nil {
+		return nil
+	}
+	spec = CloneRefOfTableSpec(spec)
+	t.spec().Options = spec.Options
+	t.spec().PartitionOption = spec.PartitionOption
+	for _, col := range spec.Columns {
+		if err := t.addColumn(col, false, nil); err != nil {
+			return err
+		}
+	}
+	if len(t.spec().Columns) == 0 {
+		return t.invalid("a table must have at least one column")
+	}
+	for _, idx := range spec.Indexes {
+		if err := t.addIndex(idx); err != nil {
+			return err
+		}
+	}
+	for _, constraint := range spec.Constraints {
+		if _, fk := constraint.Details.(*ForeignKeyDefinition); fk && like {
+			continue
+		}
+		if err := t.addConstraint(constraint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *schemaTable) column(name IdentifierCI) int {
+	for i, col := range t.spec().Columns {
+		if col.Name.Equal(name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *schemaTable) index(name IdentifierCI) int {
+	for i, idx := range t.spec().Indexes {
+		if idx.Info.Name.Equal(name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *schemaTable) constraint(name IdentifierCI) int {
+	for i, constraint := range t.spec().Constraints {
+		if constraint.Name.Equal(name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// addColumn adds a column at its position, and the index of its key.
+func (t *schemaTable) addColumn(col *ColumnDefinition, first bool, after *ColName) error {
+	if t.column(col.Name) >= 0 {
+		return t.invalid("duplicate column name '%s'", col.Name.String())
+	}
+	columns := t.spec().Columns
+	pos := len(columns)
+	if first {
+		pos = 0
+	} else if after != nil {
+		pos = t.column(after.Name)
+		if pos < 0 {
+			return t.invalid("unknown column '%s'", after.Name.String())
+		}
+		pos++
+	}
+	columns = append(columns, nil)
+	copy(columns[pos+1:], columns[pos:])
+	columns[pos] = col
+	t.spec().Columns = columns
+	return t.addColumnKey(col)
+}
+
+// addColumnKey turns the key of a column definition into an index of the
+// table.
+func (t *schemaTable) addColumnKey(col *ColumnDefinition) error {
+	if col.Type.Options == nil || col.Type.Options.KeyOpt == ColKeyNone {
+		return nil
+	}
+	info := &IndexInfo{}
+	switch col.Type.Options.KeyOpt {
+	case ColKeyPrimary, ColKey:
+		info.Type, info.Primary, info.Unique = "primary key", true, true
+	case ColKeyUnique, ColKeyUniqueKey:
+		info.Type, info.Unique = "unique key", true
+	case ColKeySpatialKey:
+		info.Type, info.Spatial = "spatial key", true
+	case ColKeyFulltextKey:
+		info.Type, info.Fulltext = "fulltext key", true
+	}
+	col.Type.Options.KeyOpt = ColKeyNone
+	return t.addIndex(&IndexDefinition{Info: info, Columns: []*IndexColumn{{Column: col.Name}}})
+}
+
+// addIndex adds an index, naming it after its first column if it has no
+// name. The primary key comes first, and its columns are not null.
+func (t *schemaTable) addIndex(idx *IndexDefinition) error {
+	for _, col := range idx.Columns {
+		if col.Expression == nil && t.column(col.Column) < 0 {
+			return t.invalid("key column '%s' doesn't exist in table", col.Column.String())
+		}
+	}
+	if idx.Info.Primary {
+		if t.index(NewIdentifierCI("PRIMARY")) >= 0 {
+			return t.invalid("multiple primary key defined")
+		}
+		idx.Info.Name, idx.Info.ConstraintName = NewIdentifierCI("PRIMARY"), IdentifierCI{}
+		for _, col := range idx.Columns {
+			if i := t.column(col.Column); i >= 0 {
+				column := t.spec().Columns[i]
+				if column.Type.Options == nil {
+					column.Type.Options = &ColumnTypeOptions{}
+				}
+				notNull := false
+				column.Type.Options.Null = &notNull
+			}
+		}
+		t.spec().Indexes = append([]*IndexDefinition{idx}, t.spec().Indexes...)
+		return nil
+	}
+	if idx.Info.Name.IsEmpty() {
+		idx.Info.Name = idx.Info.ConstraintName
+	}
+	if idx.Info.Name.IsEmpty() {
+		base := "functional_index"
+		if len(idx.Columns) > 0 && idx.Columns[0].Expression == nil {
+			base = idx.Columns[0].Column.String()
+		}
+		idx.Info.Name = t.indexName(base)
+	}
+	if t.index(idx.Info.Name) >= 0 {
+		return t.invalid("duplicate key name '%s'", idx.Info.Name.String())
+	}
+	t.spec().Indexes = append(t.spec().Indexes, idx)
+	return nil
+}
+
+// indexName returns an index name that is not used yet: base, or base
+// followed by a number.
+func (t *schemaTable) indexName(base string) IdentifierCI {
+	name := NewIdentifierCI(base)
+	for i := 2; t.index(name) >= 0 || strings.EqualFold(name.String(), "PRIMARY"); i++ {
+		name = NewIdentifierCI(fmt.Sprintf("%s_%d", base, i))
+	}
+	return name
+}
+
+// addConstraint adds a foreign key or a check constraint, naming it like
+// the server if it has no name. A foreign key gets an index on its columns
+// if there is none yet.
+func (t *schemaTable) addConstraint(constraint *ConstraintDefinition) error {
+	fk, isFK := constraint.Details.(*ForeignKeyDefinition)
+	if constraint.Name.IsEmpty() {
+		suffix := "_chk_"
+		if isFK {
+			suffix = "_ibfk_"
+		}
+		for i := 1; constraint.Name.IsEmpty() || t.constraint(constraint.Name) >= 0; i++ {
+			constraint.Name = NewIdentifierCI(fmt.Sprintf("%s%s%d", t.create.Table.Name.String(), suffix, i))
+		}
+	}
+	if t.constraint(constraint.Name) >= 0 {
+		return t.invalid("duplicate constraint name '%s'", constraint.Name.String())
+	}
+	if isFK {
+		for _, col := range fk.Source {
+			if t.column(col) < 0 {
+				return t.invalid("key column '%s' doesn't exist in table", col.String())
+			}
+		}
+		if !t.hasIndexPrefix(fk.Source) {
+			name := fk.IndexName
+			if name.IsEmpty() {
+				name = constraint.Name
+			}
+			idx := &IndexDefinition{Info: &IndexInfo{Type: "key", Name: name}}
+			for _, col := range fk.Source {
+				idx.Columns = append(idx.Columns, &IndexColumn{Column: col})
+			}
+			if err := t.addIndex(idx); err != nil {
+				return err
+			}
+		}
+	}
+	t.spec().Constraints = append(t.spec().Constraints, constraint)
+	return nil
+}
+
+// hasIndexPrefix returns whether an index starts with the given columns.
+func (t *schemaTable) hasIndexPrefix(columns Columns) bool {
+	for _, idx := range t.spec().Indexes {
+		if len(idx.Columns) < len(columns) {
+			continue
+		}
+		prefix := true
+		for i, col := range columns {
+			prefix = prefix && idx.Columns[i].Expression == nil && idx.Columns[i].Column.Equal(col)
+		}
+		if prefix {
+			return true
+		}
+	}
+	return false
+}
+
+// alter applies an option of ALTER TABLE.
+func (t *schemaTable) alter(option AlterOption) error {
+	// the definitions of the option become part of the table
+	option = CloneAlterOption(option)
+	switch option := option.(type) {
+	case *AddColumns:
+		first, after := option.First, option.After
+		for _, col := range option.Columns {
+			if err := t.addColumn(col, first, after); err != nil {
+				return err
+			}
+			// the next columns follow the first one
+			if first || after != nil {
+				first, after = false, &ColName{Name: col.Name}
+			}
+		}
+	case *AddIndexDefinition:
+		return t.addIndex(option.IndexDefinition)
+	case *AddConstraintDefinition:
+		return t.addConstraint(option.ConstraintDefinition)
+	case *AlterColumn:
+		i := t.column(option.Column.Name)
+		if i < 0 {
+			return t.invalid("unknown column '%s'", option.Column.Name.String())
+		}
+		col := t.spec().Columns[i]
+		if col.Type.Options == nil {
+			col.Type.Options = &ColumnTypeOptions{}
+		}
+		switch {
+		case option.DropDefault:
+			col.Type.Options.Default = nil
+		case option.DefaultVal != nil:
+			col.Type.Options.Default = option.DefaultVal
+		case option.Invisible != nil:
+			col.Type.Options.Invisible = option.Invisible
+		}
+	case *ChangeColumn:
+		return t.replaceColumn(option.OldColumn.Name, option.NewColDefinition, option.First, option.After)
+	case *ModifyColumn:
+		return t.replaceColumn(option.NewColDefinition.Name, option.NewColDefinition, option.First, option.After)
+	case *RenameColumn:
+		i := t.column(option.OldName.Name)
+		if i < 0 {
+			return t.invalid("unknown column '%s'", option.OldName.Name.String())
+		}
+		if j := t.column(option.NewName.Name); j >= 0 && j != i {
+			return t.invalid("duplicate column name '%s'", option.NewName.Name.String())
+		}
+		t.renameColumn(option.OldName.Name, option.NewName.Name)
+		t.spec().Columns[i].Name = option.NewName.Name
+	case *DropColumn:
+		return t.dropColumn(option.Name.Name)
+	case *DropKey:
+		return t.dropKey(option)
+	case *RenameIndex:
+		i := t.index(option.OldName)
+		if i < 0 {
+			return t.invalid("key '%s' doesn't exist", option.OldName.String())
+		}
+		if j := t.index(option.NewName); j >= 0 && j != i {
+			return t.invalid("duplicate key name '%s'", option.NewName.String())
+		}
+		t.spec().Indexes[i].Info.Name = option.NewName
+	case *AlterIndex:
+		i := t.index(option.Name)
+		if i < 0 {
+			return t.invalid("key '%s' doesn't exist", option.Name.String())
+		}
+		t.setIndexVisibility(t.spec().Indexes[i], option.Invisible)
+	case *AlterCheck:
+		i := t.constraint(option.Name)
+		check, ok := (*CheckConstraintDefinition)(nil), false
+		if i >= 0 {
+			check, ok = t.spec().Constraints[i].Details.(*CheckConstraintDefinition)
+		}
+		if !ok {
+			return t.invalid("check constraint '%s' doesn't exist", option.Name.String())
+		}
+		check.Enforced = option.Enforced
+	case *AlterCharset:
+		t.setOption(&TableOption{Name: "charset", String: option.CharacterSet, CaseSensitive: true})
+		if option.Collate != "" {
+			t.setOption(&TableOption{Name: "collate", String: option.Collate, CaseSensitive: true})
+		}
+	case TableOptions:
+		for _, opt := range option {
+			t.setOption(opt)
+		}
+	}
+	// the other options, like ALGORITHM or LOCK, do not change the table
+	return nil
+}
+
+// replaceColumn replaces the definition of a column, which is renamed in
+// the indexes and constraints of the table.
+func (t *schemaTable) replaceColumn(name IdentifierCI, col *ColumnDefinition, first bool, after *ColName) error {
+	i := t.column(name)
+	if i < 0 {
+		return t.invalid("unknown column '%s'", name.String())
+	}
+	if j := t.column(col.Name); j >= 0 && j != i {
+		return t.invalid("duplicate column name '%s'", col.Name.String())
+	}
+	if after != nil && after.Name.Equal(name) {
+		return t.invalid("unknown column '%s'", after.Name.String())
+	}
+	t.renameColumn(name, col.Name)
+	columns := t.spec().Columns
+	if !first && after == nil {
+		columns[i] = col
+		return t.addColumnKey(col)
+	}
+	t.spec().Columns = append(columns[:i:i], columns[i+1:]...)
+	return t.addColumn(col, first, after)
+}
+
+// renameColumn renames a column in the indexes and constraints of the
+// table.
+func (t *schemaTable) renameColumn(from, to IdentifierCI) {
+	for _, idx := range t.spec().Indexes {
+		for _, col := range idx.Columns {
+			if col.Expression == nil && col.Column.Equal(from) {
+				col.Column = to
+			}
+		}
+	}
+	for _, constraint := range t.spec().Constraints {
+		switch details := constraint.Details.(type) {
+		case *ForeignKeyDefinition:
+			for i, col := range details.Source {
+				if col.Equal(from) {
+					details.Source[i] = to
+				}
+			}
+		case *CheckConstraintDefinition:
+			_ = Walk(func(node SQLNode) (bool, error) {
+				if col, ok := node.(*ColName); ok && col.Qualifier.IsEmpty() && col.Name.Equal(from) {
+					col.Name = to
+				}
+				return true, nil
+			}, details.Expr)
+		}
+	}
+}
+
+// dropColumn drops a column, and removes it from the indexes: the indexes
+// left without columns are dropped.
+func (t *schemaTable) dropColumn(name IdentifierCI) error {
+	i := t.column(name)
+	if i < 0 {
+		return t.invalid("unknown column '%s'", name.String())
+	}
+	if len(t.spec().Columns) == 1 {
+		return t.invalid("cannot drop all columns, use DROP TABLE instead")
+	}
+	for _, constraint := range t.spec().Constraints {
+		if fk, ok := constraint.Details.(*ForeignKeyDefinition); ok {
+			for _, col := range fk.Source {
+				if col.Equal(name) {
+					return t.invalid("cannot drop column '%s': needed in foreign key constraint '%s'", name.String(), constraint.Name.String())
+				}
+			}
+		}
+	}
+	columns := t.spec().Columns
+	t.spec().Columns = append(columns[:i:i], columns[i+1:]...)
+	var indexes []*IndexDefinition
+	for _, idx := range t.spec().Indexes {
+		var cols []*IndexColumn
+		for _, col := range idx.Columns {
+			if col.Expression != nil || !col.Column.Equal(name) {
+				cols = append(cols, col)
+			}
+		}
+		if len(cols) > 0 {
+			idx.Columns = cols
+			indexes = append(indexes, idx)
+		}
+	}
+	t.spec().Indexes = indexes
+	return nil
+}
+
+func (t *schemaTable) dropKey(key *DropKey) error {
+	switch key.Type {
+	case PrimaryKeyType:
+		i := t.index(NewIdentifierCI("PRIMARY"))
+		if i < 0 {
+			return t.invalid("no primary key to drop")
+		}
+		t.spec().Indexes = append(t.spec().Indexes[:i:i], t.spec().Indexes[i+1:]...)
+	case NormalKeyType:
+		i := t.index(key.Name)
+		if i < 0 || t.spec().Indexes[i].Info.Primary {
+			return t.invalid("key '%s' doesn't exist", key.Name.String())
+		}
+		t.spec().Indexes = append(t.spec().Indexes[:i:i], t.spec().Indexes[i+1:]...)
+	case ForeignKeyType, CheckKeyType:
+		i := t.constraint(key.Name)
+		if i >= 0 {
+			_, isFK := t.spec().Constraints[i].Details.(*ForeignKeyDefinition)
+			if isFK != (key.Type == ForeignKeyType) {
+				i = -1
+			}
+		}
+		if i < 0 {
+			return t.invalid("%s '%s' doesn't exist", strings.ToLower(key.Type.ToString()), key.Name.String())
+		}
+		t.spec().Constraints = append(t.spec().Constraints[:i:i], t.spec().Constraints[i+1:]...)
+	}
+	return nil
+}
+
+// setIndexVisibility replaces the VISIBLE or INVISIBLE option of an index.
+func (t *schemaTable) setIndexVisibility(idx *IndexDefinition, invisible bool) {
+	var options []*IndexOption
+	for _, opt := range idx.Options {
+		if !strings.EqualFold(opt.Name, "visible") && !strings.EqualFold(opt.Name, "invisible") {
+			options = append(options, opt)
+		}
+	}
+	if invisible {
+		options = append(options, &IndexOption{Name: "invisible"})
+	}
+	idx.Options = options
+}
+
+// setOption sets a table option, replacing the option with the same name.
+func (t *schemaTable) setOption(opt *TableOption) {
+	name := tableOptionName(opt.Name)
+	for i, existing := range t.spec().Options {
+		if tableOptionName(existing.Name) == name {
+			t.spec().Options[i] = opt
+			return
+		}
+	}
+	t.spec().Options = append(t.spec().Options, opt)
+}
+
+// tableOptionName returns the name of a table option, in lower case and
+// without the synonyms of the character set.
+func tableOptionName(name string) string {
+	name = strings.ToLower(name)
+	if name == "character set" {
+		return "charset"
+	}
+	return name
+}
+
+func (t *schemaTable) partition(name IdentifierCI) int {
+	for i, def := range t.spec().PartitionOption.Definitions {
+		if def.Name.Equal(name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// alterPartitions applies the partition changes of ALTER TABLE.
+func (t *schemaTable) alterPartitions(spec *PartitionSpec, s *Schema) error {
+	partitions := t.spec().PartitionOption
+	if spec.Action == UpgradeAction {
+		return nil
+	}
+	if partitions == nil {
+		return t.invalid("partition management on a not partitioned table is not possible")
+	}
+	for _, name := range spec.Names {
+		if t.partition(name) < 0 {
+			return t.invalid("unknown partition '%s'", name.String())
+		}
+	}
+	switch spec.Action {
+	case RemoveAction:
+		t.spec().PartitionOption = nil
+	case AddAction:
+		for _, def := range spec.Definitions {
+			if t.partition(def.Name) >= 0 {
+				return t.invalid("duplicate partition name '%s'", def.Name.String())
+			}
+			partitions.Definitions = append(partitions.Definitions, CloneRefOfPartitionDefinition(def))
+		}
+		if len(spec.Definitions) == 0 && spec.Number != nil {
+			n, err := partitionCount(spec.Number)
+			if err != nil {
+				return t.invalid("%v", err)
+			}
+			partitions.Partitions += n
+		}
+	case DropAction:
+		if len(spec.Names) >= len(partitions.Definitions) {
+			return t.invalid("cannot remove all partitions, use DROP TABLE instead")
+		}
+		t.dropPartitions(spec.Names)
+	case ReorganizeAction:
+		if len(spec.Names) == 0 {
+			return nil
+		}
+		pos := t.partition(spec.Names[0])
+		t.dropPartitions(spec.Names)
+		definitions := partitions.Definitions
+		var added []*PartitionDefinition
+		for _, def := range spec.Definitions {
+			if t.partition(def.Name) >= 0 {
+				return t.invalid("duplicate partition name '%s'", def.Name.String())
+			}
+			added = append(added, CloneRefOfPartitionDefinition(def))
+		}
+		partitions.Definitions = append(append(append([]*PartitionDefinition(nil), definitions[:pos]...), added...), definitions[pos:]...)
+	case CoalesceAction:
+		n, err := partitionCount(spec.Number)
+		if err != nil {
+			return t.invalid("%v", err)
+		}
+		count := partitions.Partitions
+		if len(partitions.Definitions) > 0 {
+			count = len(partitions.Definitions)
+		}
+		if n >= count {
+			return t.invalid("cannot remove all partitions, use DROP TABLE instead")
+		}
+		if len(partitions.Definitions) > 0 {
+			partitions.Definitions = partitions.Definitions[:count-n]
+		} else {
+			partitions.Partitions -= n
+		}
+	case ExchangeAction:
+		if s.tables[s.key(spec.TableName)] == nil {
+			return tableNotFound(spec.TableName)
+		}
+	}
+	return nil
+}
+
+func (t *schemaTable) dropPartitions(names Partitions) {
+	var definitions []*PartitionDefinition
+	for _, def := range t.spec().PartitionOption.Definitions {
+		dropped := false
+		for _, name := range names {
+			dropped = dropped || def.Name.Equal(name)
+		}
+		if !dropped {
+			definitions = append(definitions, def)
+		}
+	}
+	t.spec().PartitionOption.Definitions = definitions
+}
+
+func partitionCount(number *Literal) (int, error) {
+	var n int
+	if number == nil || number.Type != IntVal {
+		return 0, fmt.Errorf("invalid number of partitions")
+	}
+	if _, err := fmt.Sscan(number.Val, &n); err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid number of partitions '%s'", number.Val)
+	}
+	return n, nil
+}
@@ -0,0 +1,638 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"strings"
+)
+
+// DiffStatements returns the statements that change the tables and views
+// created by the statements from into the ones created by the statements
+// to. See DiffSchemas.
+func DiffStatements(from, to []Statement) ([]DDLStatement, error) {
+	fromSchema, toSchema := NewSchema(), NewSchema()
+	for _, stmt := range from {
+		if err := fromSchema.Apply(stmt); err != nil {
+			return nil, err
+		}
+	}
+	for _, stmt := range to {
+		if err := toSchema.Apply(stmt); err != nil {
+			return nil, err
+		}
+	}
+	return DiffSchemas(fromSchema, toSchema), nil
+}
+
+// DiffSchemas returns the statements that change the tables and views of
+// the schema from into the ones of the schema to, in the order they must
+// be applied: the dropped views, the created tables, the altered tables,
+// the dropped tables, and the created and altered views. The created tables
+// come after the tables their foreign keys refer to, and the views after
+// the views they select from.
+//
+// The tables are compared as DiffTables does. The views are compared as
+// written, but for their definer and default options: a changed view is
+// replaced by ALTER VIEW.
+func DiffSchemas(from, to *Schema) []DDLStatement {
+	var result []DDLStatement
+
+	var droppedViews TableNames
+	for _, view := range from.Views() {
+		if to.View(view.ViewName) == nil {
+			droppedViews = append(droppedViews, view.ViewName)
+		}
+	}
+	if len(droppedViews) > 0 {
+		result = append(result, &DropView{FromTables: droppedViews})
+	}
+
+	var created, dropped []*CreateTable
+	var altered []DDLStatement
+	for _, table := range to.Tables() {
+		old := from.Table(table.Table)
+		if old == nil {
+			created = append(created, table)
+		} else if alter := diffTables(old, table); alter != nil {
+			altered = append(altered, alter)
+		}
+	}
+	for _, table := range from.Tables() {
+		if to.Table(table.Table) == nil {
+			dropped = append(dropped, table)
+		}
+	}
+	for _, table := range sortByReferences(created) {
+		result = append(result, CloneRefOfCreateTable(table))
+	}
+	result = append(result, altered...)
+	if len(dropped) > 0 {
+		drop := &DropTable{}
+		// the tables referring to the others are dropped first
+		sorted := sortByReferences(dropped)
+		for i := len(sorted) - 1; i >= 0; i-- {
+			drop.FromTables = append(drop.FromTables, sorted[i].Table)
+		}
+		result = append(result, drop)
+	}
+
+	for _, view := range sortViews(to.Views()) {
+		old := from.View(view.ViewName)
+		switch {
+		case old == nil:
+			result = append(result, CloneRefOfCreateView(view))
+		case canonicalView(old) != canonicalView(view):
+			view = CloneRefOfCreateView(view)
+			result = append(result, &AlterView{
+				ViewName:    view.ViewName,
+				Algorithm:   view.Algorithm,
+				Definer:     view.Definer,
+				Security:    view.Security,
+				Columns:     view.Columns,
+				Select:      view.Select,
+				CheckOption: view.CheckOption,
+			})
+		}
+	}
+	return result
+}
+
+// sortByReferences sorts tables so that the tables their foreign keys refer
+// to come first, when they are part of the tables.
+func sortByReferences(tables []*CreateTable) []*CreateTable {
+	byName := make(map[string]*CreateTable, len(tables))
+	for _, table := range tables {
+		byName[String(table.Table)] = table
+	}
+	var sorted []*CreateTable
+	visited := make(map[*CreateTable]bool, len(tables))
+	var visit func(table *CreateTable)
+	visit = func(table *CreateTable) {
+		if visited[table] {
+			return
+		}
+		visited[table] = true
+		for _, constraint := range table.TableSpec.Constraints {
+			if fk, ok := constraint.Details.(*ForeignKeyDefinition); ok {
+				if ref := byName[String(fk.ReferenceDefinition.ReferencedTable)]; ref != nil {
+					visit(ref)
+				}
+			}
+		}
+		sorted = append(sorted, table)
+	}
+	for _, table := range tables {
+		visit(table)
+	}
+	return sorted
+}
+
+// sortViews sorts views so that the views they select from come first.
+func sortViews(views []*CreateView) []*CreateView {
+	byName := make(map[string]*CreateView, len(views))
+	for _, view := range views {
+		byName[String(view.ViewName)] = view
+	}
+	var sorted []*CreateView
+	visited := make(map[*CreateView]bool, len(views))
+	var visit func(view *CreateView)
+	visit = func(view *CreateView) {
+		if visited[view] {
+			return
+		}
+		visited[view] = true
+		_ = Walk(func(node SQLNode) (bool, error) {
+			if name, ok := node.(TableName); ok {
+				if ref := byName[String(name)]; ref != nil {
+					visit(ref)
+				}
+			}
+			return true, nil
+		}, view.Select)
+		sorted = append(sorted, view)
+	}
+	for _, view := range views {
+		visit(view)
+	}
+	return sorted
+}
+
+// canonicalView returns a view as SQL, without its definer and with its
+// default algorithm and security left out.
+func canonicalView(view *CreateView) string {
+	view = CloneRefOfCreateView(view)
+	view.Definer, view.Comments, view.IsReplace = nil, nil, false
+	view.Algorithm, view.Security = strings.ToLower(view.Algorithm), strings.ToLower(view.Security)
+	if view.Algorithm == "undefined" {
+		view.Algorithm = ""
+	}
+	if view.Security == "definer" {
+		view.Security = ""
+	}
+	return String(view)
+}
+
+// DiffTables returns the ALTER TABLE statement that changes the table from
+// into the table to, or nil if they are the same. Both tables are first
+// normalized as a Schema does; an invalid table returns an error.
+//
+// The columns, indexes, foreign keys, check constraints, table options and
+// partitioning are compared after the equivalent ways to write them are
+// replaced by a single one: the display width of integer types, the
+// character set and collation of a column when they are the ones of the
+// table, the default NULL of a nullable column, the default referential
+// actions and index options, the synonyms of types and character sets,
+// and the case of keywords. An index that is only renamed is renamed. The
+// table options missing from the table to are left unchanged, and
+// AUTO_INCREMENT is ignored.
+func DiffTables(from, to *CreateTable) (*AlterTable, error) {
+	fromTable, err := normalizedTable(from)
+	if err != nil {
+		return nil, err
+	}
+	toTable, err := normalizedTable(to)
+	if err != nil {
+		return nil, err
+	}
+	return diffTables(fromTable, toTable), nil
+}
+
+func normalizedTable(create *CreateTable) (*CreateTable, error) {
+	if !create.FullyParsed {
+		return nil, notFullyParsed(create)
+	}
+	table := &schemaTable{create: &CreateTable{Temp: create.Temp, Table: create.Table, FullyParsed: true}}
+	if err := table.setSpec(create.TableSpec, false); err != nil {
+		return nil, err
+	}
+	return table.create, nil
+}
+
+// diffTables compares two normalized tables.
+func diffTables(from, to *CreateTable) *AlterTable {
+	alter := &AlterTable{Table: from.Table, FullyParsed: true}
+	fromSpec, toSpec := from.TableSpec, to.TableSpec
+
+	// the changed foreign keys and check constraints are dropped first, so
+	// that the columns they use can be dropped
+	var addedConstraints []*ConstraintDefinition
+	fromConstraints := make(map[string]*ConstraintDefinition)
+	for _, constraint := range fromSpec.Constraints {
+		fromConstraints[constraint.Name.Lowered()] = constraint
+	}
+	for _, constraint := range toSpec.Constraints {
+		old := fromConstraints[constraint.Name.Lowered()]
+		delete(fromConstraints, constraint.Name.Lowered())
+		switch {
+		case old == nil:
+			addedConstraints = append(addedConstraints, constraint)
+		case canonicalConstraint(old, true) == canonicalConstraint(constraint, true):
+		case canonicalConstraint(old, false) == canonicalConstraint(constraint, false):
+			alter.AlterOptions = append(alter.AlterOptions, &AlterCheck{
+				Name:     constraint.Name,
+				Enforced: constraint.Details.(*CheckConstraintDefinition).Enforced,
+			})
+		default:
+			alter.AlterOptions = append(alter.AlterOptions, dropConstraint(old))
+			addedConstraints = append(addedConstraints, constraint)
+		}
+	}
+	for _, constraint := range fromSpec.Constraints {
+		if fromConstraints[constraint.Name.Lowered()] != nil {
+			alter.AlterOptions = append(alter.AlterOptions, dropConstraint(constraint))
+		}
+	}
+
+	// the indexes that are only renamed are renamed, the changed ones are
+	// dropped and added again
+	var addedIndexes []*IndexDefinition
+	fromIndexes := make(map[string]*IndexDefinition)
+	for _, idx := range fromSpec.Indexes {
+		fromIndexes[idx.Info.Name.Lowered()] = idx
+	}
+	var newIndexes []*IndexDefinition
+	for _, idx := range toSpec.Indexes {
+		old := fromIndexes[idx.Info.Name.Lowered()]
+		switch {
+		case old == nil:
+			newIndexes = append(newIndexes, idx)
+		case canonicalIndex(old, true) != canonicalIndex(idx, true):
+			alter.AlterOptions = append(alter.AlterOptions, dropIndex(old))
+			addedIndexes = append(addedIndexes, idx)
+			delete(fromIndexes, idx.Info.Name.Lowered())
+		default:
+			delete(fromIndexes, idx.Info.Name.Lowered())
+		}
+	}
+	for _, idx := range newIndexes {
+		renamed := false
+		for _, old := range fromSpec.Indexes {
+			if fromIndexes[old.Info.Name.Lowered()] == old && canonicalIndex(old, false) == canonicalIndex(idx, false) {
+				alter.AlterOptions = append(alter.AlterOptions, &RenameIndex{OldName: old.Info.Name, NewName: idx.Info.Name})
+				delete(fromIndexes, old.Info.Name.Lowered())
+				renamed = true
+				break
+			}
+		}
+		if !renamed {
+			addedIndexes = append(addedIndexes, idx)
+		}
+	}
+	for _, idx := range fromSpec.Indexes {
+		if fromIndexes[idx.Info.Name.Lowered()] == idx {
+			alter.AlterOptions = append(alter.AlterOptions, dropIndex(idx))
+		}
+	}
+
+	alter.AlterOptions = append(alter.AlterOptions, diffColumns(fromSpec, toSpec)...)
+
+	for _, idx := range addedIndexes {
+		alter.AlterOptions = append(alter.AlterOptions, &AddIndexDefinition{IndexDefinition: CloneRefOfIndexDefinition(idx)})
+	}
+	// the check constraints are added before the foreign keys
+	for _, fks := range []bool{false, true} {
+		for _, constraint := range addedConstraints {
+			if _, fk := constraint.Details.(*ForeignKeyDefinition); fk == fks {
+				alter.AlterOptions = append(alter.AlterOptions, &AddConstraintDefinition{ConstraintDefinition: CloneRefOfConstraintDefinition(constraint)})
+			}
+		}
+	}
+
+	if options := diffTableOptions(fromSpec.Options, toSpec.Options); len(options) > 0 {
+		alter.AlterOptions = append(alter.AlterOptions, options)
+	}
+
+	switch {
+	case toSpec.PartitionOption == nil && fromSpec.PartitionOption != nil:
+		alter.PartitionSpec = &PartitionSpec{Action: RemoveAction}
+	case toSpec.PartitionOption != nil && (fromSpec.PartitionOption == nil || String(fromSpec.PartitionOption) != String(toSpec.PartitionOption)):
+		alter.PartitionOption = CloneRefOfPartitionOption(toSpec.PartitionOption)
+	}
+
+	if len(alter.AlterOptions) == 0 && alter.PartitionSpec == nil && alter.PartitionOption == nil {
+		return nil
+	}
+	return alter
+}
+
+// diffColumns returns the options that drop, add, move and modify the
+// columns. The moved columns are the fewest ones that give the columns of
+// to their order.
+func diffColumns(from, to *TableSpec) []AlterOption {
+	var options []AlterOption
+	fromCharset, fromCollate := tableCharset(from.Options)
+	toCharset, toCollate := tableCharset(to.Options)
+	// the columns of the primary key of to are made not null by adding it
+	primary := make(map[string]bool)
+	for _, idx := range to.Indexes {
+		if idx.Info.Primary {
+			for _, col := range idx.Columns {
+				primary[col.Column.Lowered()] = true
+			}
+		}
+	}
+	toColumns := make(map[string]*ColumnDefinition, len(to.Columns))
+	for _, col := range to.Columns {
+		toColumns[col.Name.Lowered()] = col
+	}
+	fromColumns := make(map[string]*ColumnDefinition, len(from.Columns))
+	var kept []string
+	for _, col := range from.Columns {
+		name := col.Name.Lowered()
+		fromColumns[name] = col
+		if toColumns[name] == nil {
+			options = append(options, &DropColumn{Name: &ColName{Name: col.Name}})
+		} else {
+			kept = append(kept, name)
+		}
+	}
+	var order []string
+	for _, col := range to.Columns {
+		if fromColumns[col.Name.Lowered()] != nil {
+			order = append(order, col.Name.Lowered())
+		}
+	}
+	stay := longestCommonSubsequence(kept, order)
+
+	var prev *ColumnDefinition
+	for i, col := range to.Columns {
+		name := col.Name.Lowered()
+		old := fromColumns[name]
+		first, after := prev == nil, (*ColName)(nil)
+		if prev != nil {
+			after = &ColName{Name: prev.Name}
+		}
+		prev = col
+		switch {
+		case old == nil:
+			add := &AddColumns{Columns: []*ColumnDefinition{CloneRefOfColumnDefinition(col)}}
+			// the columns added after all the kept columns need no position
+			if !onlyNewColumns(to.Columns[i:], fromColumns) {
+				add.First, add.After = first, after
+			}
+			options = append(options, add)
+		case !stay[name]:
+			options = append(options, &ModifyColumn{NewColDefinition: CloneRefOfColumnDefinition(col), First: first, After: after})
+		case canonicalColumn(old, fromCharset, fromCollate, primary[name]) != canonicalColumn(col, toCharset, toCollate, primary[name]):
+			options = append(options, &ModifyColumn{NewColDefinition: CloneRefOfColumnDefinition(col)})
+		}
+	}
+	return options
+}
+
+func onlyNewColumns(columns []*ColumnDefinition, existing map[string]*ColumnDefinition) bool {
+	for _, col := range columns {
+		if existing[col.Name.Lowered()] != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// longestCommonSubsequence returns the elements of the longest common
+// subsequence of a and b.
+func longestCommonSubsequence(a, b []string) map[string]bool {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			case lengths[i+1][j] >= lengths[i][j+1]:
+				lengths[i][j] = lengths[i+1][j]
+			default:
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+	result := make(map[string]bool)
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			result[a[i]] = true
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return result
+}
+
+// diffTableOptions returns the options of to that are missing from or
+// different in from.
+func diffTableOptions(from, to TableOptions) TableOptions {
+	fromOptions := make(map[string]string, len(from))
+	for _, opt := range from {
+		fromOptions[tableOptionName(opt.Name)] = canonicalTableOption(opt)
+	}
+	var options TableOptions
+	for _, opt := range to {
+		name := tableOptionName(opt.Name)
+		if name == "auto_increment" {
+			continue
+		}
+		if old, ok := fromOptions[name]; !ok || old != canonicalTableOption(opt) {
+			options = append(options, CloneRefOfTableOption(opt))
+		}
+	}
+	return options
+}
+
+func canonicalTableOption(opt *TableOption) string {
+	opt = CloneRefOfTableOption(opt)
+	opt.Name = tableOptionName(opt.Name)
+	switch opt.Name {
+	case "engine", "collate":
+		opt.String = strings.ToLower(opt.String)
+	case "charset":
+		opt.String = canonicalCharset(opt.String)
+	}
+	return String(TableOptions{opt})
+}
+
+// tableCharset returns the character set and collation of a table, or empty
+// strings if they are not given.
+func tableCharset(options TableOptions) (charset, collate string) {
+	for _, opt := range options {
+		switch tableOptionName(opt.Name) {
+		case "charset":
+			charset = canonicalCharset(opt.String)
+		case "collate":
+			collate = strings.ToLower(opt.String)
+		}
+	}
+	return charset, collate
+}
+
+func canonicalCharset(charset string) string {
+	charset = strings.ToLower(charset)
+	if charset == "utf8" {
+		return "utf8mb3"
+	}
+	return charset
+}
+
+// canonicalTypes are the synonyms of column types.
+var canonicalTypes = map[string]string{
+	"integer":          "int",
+	"bool":             "tinyint",
+	"boolean":          "tinyint",
+	"dec":              "decimal",
+	"numeric":          "decimal",
+	"fixed":            "decimal",
+	"real":             "double",
+	"double precision": "double",
+}
+
+// integerTypes are the types whose display width is ignored.
+var integerTypes = map[string]bool{
+	"tinyint":   true,
+	"smallint":  true,
+	"mediumint": true,
+	"int":       true,
+	"bigint":    true,
+}
+
+// canonicalColumn returns a column definition as SQL, written in a single
+// way. The character set and collation of the table are left out, and a
+// column of a primary key is not null.
+func canonicalColumn(col *ColumnDefinition, charset, collate string, primary bool) string {
+	col = CloneRefOfColumnDefinition(col)
+	typ := col.Type
+	typ.Type = strings.ToLower(typ.Type)
+	if canonical, ok := canonicalTypes[typ.Type]; ok {
+		typ.Type = canonical
+	}
+	switch {
+	case integerTypes[typ.Type] && !typ.Zerofill:
+		typ.Length = nil
+	case typ.Type == "decimal":
+		if typ.Length == nil {
+			typ.Length = NewIntLiteral("10")
+		}
+		if typ.Scale == nil {
+			typ.Scale = NewIntLiteral("0")
+		}
+	}
+	typ.Charset.Name = canonicalCharset(typ.Charset.Name)
+	if typ.Charset.Name == charset {
+		typ.Charset.Name = ""
+	}
+	if typ.Options == nil {
+		typ.Options = &ColumnTypeOptions{}
+	}
+	opts := typ.Options
+	opts.Collate = strings.ToLower(opts.Collate)
+	if opts.Collate == collate {
+		opts.Collate = ""
+	}
+	if opts.Null == nil || primary {
+		null := !primary
+		opts.Null = &null
+	}
+	if _, null := opts.Default.(*NullVal); null && *opts.Null {
+		opts.Default = nil
+	}
+	// numbers are given as strings by the server
+	if lit, ok := opts.Default.(*Literal); ok && lit.Type != StrVal && lit.Type != HexVal && lit.Type != BitVal {
+		opts.Default = NewStrLiteral(lit.Val)
+	}
+	if opts.Invisible != nil && !*opts.Invisible {
+		opts.Invisible = nil
+	}
+	if opts.Comment != nil && opts.Comment.Val == "" {
+		opts.Comment = nil
+	}
+	return String(col)
+}
+
+// canonicalIndex returns an index definition as SQL, written in a single
+// way, with or without its name.
+func canonicalIndex(idx *IndexDefinition, withName bool) string {
+	idx = CloneRefOfIndexDefinition(idx)
+	info := idx.Info
+	switch {
+	case info.Primary:
+		info.Type = "primary key"
+	case info.Spatial:
+		info.Type = "spatial key"
+	case info.Fulltext:
+		info.Type = "fulltext key"
+	case info.Unique:
+		info.Type = "unique key"
+	default:
+		info.Type = "key"
+	}
+	info.ConstraintName = IdentifierCI{}
+	info.Name = NewIdentifierCI(info.Name.Lowered())
+	if !withName {
+		info.Name = IdentifierCI{}
+	}
+	var options []*IndexOption
+	for _, opt := range idx.Options {
+		opt.Name = strings.ToLower(opt.Name)
+		if opt.Name == "visible" || (opt.Name == "using" && strings.EqualFold(opt.String, "btree")) {
+			continue
+		}
+		options = append(options, opt)
+	}
+	idx.Options = options
+	return String(idx)
+}
+
+// canonicalConstraint returns a foreign key or a check constraint as SQL,
+// written in a single way, with or without whether the check constraint is
+// enforced.
+func canonicalConstraint(constraint *ConstraintDefinition, withEnforced bool) string {
+	constraint = CloneRefOfConstraintDefinition(constraint)
+	constraint.Name = NewIdentifierCI(constraint.Name.Lowered())
+	switch details := constraint.Details.(type) {
+	case *ForeignKeyDefinition:
+		details.IndexName = IdentifierCI{}
+		ref := details.ReferenceDefinition
+		for _, action := range []*ReferenceAction{&ref.OnDelete, &ref.OnUpdate} {
+			if *action == Restrict || *action == NoAction {
+				*action = DefaultAction
+			}
+		}
+	case *CheckConstraintDefinition:
+		if !withEnforced {
+			details.Enforced = true
+		}
+	}
+	return String(constraint)
+}
+
+func dropConstraint(constraint *ConstraintDefinition) *DropKey {
+	if _, fk := constraint.Details.(*ForeignKeyDefinition); fk {
+		return &DropKey{Type: ForeignKeyType, Name: constraint.Name}
+	}
+	return &DropKey{Type: CheckKeyType, Name: constraint.Name}
+}
+
+func dropIndex(idx *IndexDefinition) *DropKey {
+	if idx.Info.Primary {
+		return &DropKey{Type: PrimaryKeyType}
+	}
+	return &DropKey{Type: NormalKeyType, Name: idx.Info.Name}
+}
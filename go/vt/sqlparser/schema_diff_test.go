@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffTables(t *testing.T) {
+	testcases := []struct {
+		name     string
+		from, to string
+		diff     string
+	}{{
+		name: "equivalent definitions",
+		from: "create table t (id int(11) not null, a integer default '0', b varchar(10) character set utf8mb4 default null, primary key (id) using btree) engine=InnoDB auto_increment=12 default charset=utf8mb4",
+		to:   "create table t (id int primary key, a int default 0, b varchar(10)) ENGINE InnoDB CHARSET utf8mb4",
+	}, {
+		name: "columns",
+		from: "create table t (id int, a int, b int, c int)",
+		to:   "create table t (id int, c int, a bigint, x int, b int, y int)",
+		diff: "alter table t modify column c int after id, modify column a bigint, add column x int after a, add column y int",
+	}, {
+		name: "dropped column",
+		from: "create table t (id int, a int, b int, key k (a, b))",
+		to:   "create table t (id int, b int, key k (b))",
+		diff: "alter table t drop key k, drop column a, add key k (b)",
+	}, {
+		name: "indexes",
+		from: "create table t (id int, a int, b int, primary key (id), key k1 (a), key k2 (b))",
+		to:   "create table t (id int, a int, b int, primary key (id, a), key k3 (a), unique key k2 (b))",
+		diff: "alter table t drop primary key, drop key k2, rename index k1 to k3, add primary key (id, a), add unique key k2 (b)",
+	}, {
+		name: "constraints",
+		from: "create table t (id int, pid int, constraint c1 check (id > 0), constraint fk foreign key (pid) references p (id) on delete restrict)",
+		to:   "create table t (id int, pid int, constraint c1 check (id > 0) not enforced, constraint c2 check (pid > 0), constraint fk foreign key (pid) references p (id) on delete cascade)",
+		diff: "alter table t alter check c1 not enforced, drop foreign key fk, add constraint c2 check (pid > 0), add constraint fk foreign key (pid) references p (id) on delete cascade",
+	}, {
+		name: "options and partitions",
+		from: "create table t (id int) engine InnoDB comment 'a' partition by hash (id) partitions 4",
+		to:   "create table t (id int) comment 'b' \npartition by hash (id) partitions 8",
+		diff: "alter table t comment 'b' \npartition by hash (id) partitions 8",
+	}, {
+		name: "removed partitioning",
+		from: "create table t (id int) partition by hash (id) partitions 4",
+		to:   "create table t (id int)",
+		diff: "alter table t remove partitioning",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			from, err := Parse(tcase.from)
+			require.NoError(t, err)
+			to, err := Parse(tcase.to)
+			require.NoError(t, err)
+			alter, err := DiffTables(from.(*CreateTable), to.(*CreateTable))
+			require.NoError(t, err)
+			if tcase.diff == "" {
+				assert.Nil(t, alter)
+				return
+			}
+			require.NotNil(t, alter)
+			assert.Equal(t, tcase.diff, String(alter))
+
+			// the diff turns the table from into the table to
+			s := NewSchema()
+			require.NoError(t, s.Apply(from))
+			require.NoError(t, s.Apply(alter))
+			alter, err = DiffTables(s.Table(from.(*CreateTable).Table), to.(*CreateTable))
+			require.NoError(t, err)
+			if alter != nil {
+				t.Errorf("unexpected diff after applying it: %s", String(alter))
+			}
+		})
+	}
+}
+
+func TestDiffSchemas(t *testing.T) {
+	from := "create table a (id int primary key);" +
+		"create table b (id int, aid int, foreign key (aid) references a (id));" +
+		"create table old (id int);" +
+		"create view v1 as select id from a;" +
+		"create view v2 as select id from old"
+	to := "create table c (id int primary key, did int, foreign key (did) references d (id));" +
+		"create table d (id int primary key);" +
+		"create table a (id int primary key, name text);" +
+		"create table b (id int, aid int, foreign key (aid) references a (id));" +
+		"create view v3 as select id from v1;" +
+		"create definer = root@localhost view v1 as select id, name from a"
+	fromSchema, toSchema := NewSchema(), NewSchema()
+	require.NoError(t, fromSchema.ApplySQL(from))
+	require.NoError(t, toSchema.ApplySQL(to))
+
+	var diff []string
+	for _, stmt := range DiffSchemas(fromSchema, toSchema) {
+		diff = append(diff, String(stmt))
+	}
+	assert.Equal(t, []string{
+		"drop view v2",
+		"create table d (\n\tid int not null,\n\tprimary key (id)\n)",
+		"create table c (\n\tid int not null,\n\tdid int,\n\tprimary key (id),\n\tkey c_ibfk_1 (did),\n\tconstraint c_ibfk_1 foreign key (did) references d (id)\n)",
+		"alter table a add column `name` text",
+		"drop table old",
+		"alter definer = root@localhost view v1 as select id, `name` from a",
+		"create view v3 as select id from v1",
+	}, diff)
+
+	// the diff turns the schema from into the schema to
+	for _, stmt := range DiffSchemas(fromSchema, toSchema) {
+		require.NoError(t, fromSchema.Apply(stmt))
+	}
+	assert.Empty(t, DiffSchemas(fromSchema, toSchema))
+}
+
+func TestDiffStatements(t *testing.T) {
+	parse := func(sql string) Statement {
+		stmt, err := Parse(sql)
+		require.NoError(t, err)
+		return stmt
+	}
+	diff, err := DiffStatements(
+		[]Statement{parse("create table t (id int)")},
+		[]Statement{parse("create table t (id bigint)")},
+	)
+	require.NoError(t, err)
+	require.Len(t, diff, 1)
+	assert.Equal(t, "alter table t modify column id bigint", String(diff[0]))
+
+	_, err = DiffStatements(nil, []Statement{parse("create table t (id int, id int)")})
+	assert.EqualError(t, err, "table 't': duplicate column name 'id'")
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// schemaString returns the tables and views of a schema as SQL.
+func schemaString(s *Schema) []string {
+	var result []string
+	for _, table := range s.Tables() {
+		result = append(result, String(table))
+	}
+	for _, view := range s.Views() {
+		result = append(result, String(view))
+	}
+	return result
+}
+
+func TestSchemaApply(t *testing.T) {
+	testcases := []struct {
+		name   string
+		sql    string
+		schema []string
+	}{{
+		name: "column keys become indexes",
+		sql:  "create table t (id int primary key, a varchar(10) unique, b int, key (b), unique (a))",
+		schema: []string{
+			"create table t (\n\tid int not null,\n\ta varchar(10),\n\tb int,\n\tprimary key (id),\n\tunique key a (a),\n\tkey b (b),\n\tunique key a_2 (a)\n)",
+		},
+	}, {
+		name: "add, change, modify and drop columns",
+		sql: "create table t (id int, a int, b int);" +
+			"alter table t add column c int after id, add column d int first, add column (e int);" +
+			"alter table t change column a x bigint, modify b text first, drop column e",
+		schema: []string{"create table t (\n\tb text,\n\td int,\n\tid int,\n\tc int,\n\tx bigint\n)"},
+	}, {
+		name: "rename columns in indexes and constraints",
+		sql: "create table t (id int, a int, key k (a, id), check (a > 0));" +
+			"alter table t rename column a to b",
+		schema: []string{"create table t (\n\tid int,\n\tb int,\n\tkey k (b, id),\n\tconstraint t_chk_1 check (b > 0)\n)"},
+	}, {
+		name: "drop a column from its indexes",
+		sql: "create table t (id int, a int, b int, key ka (a), key kab (a, b));" +
+			"alter table t drop column a",
+		schema: []string{"create table t (\n\tid int,\n\tb int,\n\tkey kab (b)\n)"},
+	}, {
+		name: "indexes",
+		sql: "create table t (id int, a int, key k (a));" +
+			"alter table t add primary key (id), rename index k to k2, alter index k2 invisible;" +
+			"create index k3 on t (a, id)",
+		schema: []string{"create table t (\n\tid int not null,\n\ta int,\n\tprimary key (id),\n\tkey k2 (a) invisible,\n\tindex k3 (a, id)\n)"},
+	}, {
+		name: "foreign keys",
+		sql: "create table p (id int primary key);" +
+			"create table c (id int, pid int, foreign key (pid) references p (id));" +
+			"alter table c add constraint fk2 foreign key (id) references p (id), drop foreign key c_ibfk_1",
+		schema: []string{
+			"create table c (\n\tid int,\n\tpid int,\n\tkey c_ibfk_1 (pid),\n\tkey fk2 (id),\n\tconstraint fk2 foreign key (id) references p (id)\n)",
+			"create table p (\n\tid int not null,\n\tprimary key (id)\n)",
+		},
+	}, {
+		name: "table options",
+		sql: "create table t (id int) engine InnoDB charset latin1;" +
+			"alter table t character set utf8mb4, comment 'x'",
+		schema: []string{"create table t (\n\tid int\n) engine InnoDB,\n  charset utf8mb4,\n  comment 'x'"},
+	}, {
+		name: "partitions",
+		sql: "create table t (id int) partition by range (id) (partition p0 values less than (10), partition p1 values less than (20));" +
+			"alter table t add partition (partition p2 values less than (30));" +
+			"alter table t drop partition p0",
+		schema: []string{"create table t (\n\tid int\n)\npartition by range (id)\n(partition p1 values less than (20),\n partition p2 values less than (30))"},
+	}, {
+		name: "rename and like",
+		sql: "create table a (id int, b int, foreign key (b) references a (id));" +
+			"create table tmp like a;" +
+			"rename table a to x, tmp to a, x to tmp",
+		schema: []string{
+			"create table a (\n\tid int,\n\tb int,\n\tkey a_ibfk_1 (b)\n)",
+			"create table tmp (\n\tid int,\n\tb int,\n\tkey a_ibfk_1 (b),\n\tconstraint a_ibfk_1 foreign key (b) references a (id)\n)",
+		},
+	}, {
+		name: "views",
+		sql: "create table t (id int);" +
+			"create view v as select id from t;" +
+			"create or replace view v as select id + 1 as x from t;" +
+			"create view w as select * from t;" +
+			"alter view w as select 1 from dual;" +
+			"drop view w",
+		schema: []string{
+			"create table t (\n\tid int\n)",
+			"create view v as select id + 1 as x from t",
+		},
+	}, {
+		name: "databases",
+		sql: "create database d1; create table d1.t (id int); create table t (id int);" +
+			"use d1; create table u (id int); drop database d1",
+		schema: []string{"create table t (\n\tid int\n)"},
+	}, {
+		name: "drop and truncate",
+		sql: "create table t1 (id int); create table t2 (id int);" +
+			"truncate table t1; drop table if exists t1, t3",
+		schema: []string{"create table t2 (\n\tid int\n)"},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			s := NewSchema()
+			require.NoError(t, s.ApplySQL(tcase.sql))
+			assert.Equal(t, tcase.schema, schemaString(s))
+		})
+	}
+}
+
+func TestSchemaApplyErrors(t *testing.T) {
+	const setup = "create table t (id int primary key, a int, b int, key k (a), constraint fk foreign key (b) references t (id))"
+	testcases := []struct {
+		sql string
+		err string
+	}{{
+		sql: "create table t (x int)",
+		err: "table 't' already exists",
+	}, {
+		sql: "create table u (x int, x int)",
+		err: "table 'u': duplicate column name 'x'",
+	}, {
+		sql: "create table u (x int, key (y))",
+		err: "table 'u': key column 'y' doesn't exist in table",
+	}, {
+		sql: "alter table t drop column x",
+		err: "table 't': unknown column 'x'",
+	}, {
+		sql: "alter table t drop column b",
+		err: "table 't': cannot drop column 'b': needed in foreign key constraint 'fk'",
+	}, {
+		sql: "alter table t add index k (b)",
+		err: "table 't': duplicate key name 'k'",
+	}, {
+		sql: "alter table t add primary key (a)",
+		err: "table 't': multiple primary key defined",
+	}, {
+		sql: "alter table t drop index x",
+		err: "table 't': key 'x' doesn't exist",
+	}, {
+		sql: "alter table t drop foreign key k",
+		err: "table 't': foreign key 'k' doesn't exist",
+	}, {
+		sql: "alter table t add constraint fk check (a > 0)",
+		err: "table 't': duplicate constraint name 'fk'",
+	}, {
+		sql: "alter table t add column c int, drop column x",
+		err: "table 't': unknown column 'x'",
+	}, {
+		sql: "alter table t drop partition p0",
+		err: "table 't': partition management on a not partitioned table is not possible",
+	}, {
+		sql: "alter table u add column c int",
+		err: "table 'u' doesn't exist",
+	}, {
+		sql: "drop table t, u",
+		err: "table 'u' doesn't exist",
+	}, {
+		sql: "rename table t to u, u to t, x to y",
+		err: "table 'x' doesn't exist",
+	}, {
+		sql: "create view t as select 1 from dual",
+		err: "table 't' already exists",
+	}, {
+		sql: "drop view v",
+		err: "view 'v' doesn't exist",
+	}, {
+		sql: "drop database d",
+		err: "unknown database 'd'",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.sql, func(t *testing.T) {
+			s := NewSchema()
+			require.NoError(t, s.ApplySQL(setup))
+			before := schemaString(s)
+			stmt, err := Parse(tcase.sql)
+			require.NoError(t, err)
+			require.EqualError(t, s.Apply(stmt), tcase.err)
+			// a failing statement leaves the schema unchanged
+			assert.Equal(t, before, schemaString(s))
+		})
+	}
+}
+
+func TestSchemaCatalog(t *testing.T) {
+	s := NewSchema()
+	require.NoError(t, s.ApplySQL("create table t (id int, a int); create view v (x, y) as select id, a from t"))
+	stmt, err := Parse("select x, v.y, t.a from v join t on v.x = t.id")
+	require.NoError(t, err)
+	res, err := Resolve(stmt, s, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v.x=t.id", "t.id=t.id", "x=t.id", "v.y=t.a", "t.a=t.a"}, resolvedString(stmt, res))
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// Diagnostic is a syntax error found by ParseScript.
+type Diagnostic struct {
+	// Statement is the index of the statement in error.
+	Statement int
+	// Offset is the byte offset of the token the error was found at, and
+	// Line and Column are its 1-based line and column.
+	Offset, Line, Column int
+	// Err is the message of the error, usually "syntax error".
+	Err string
+	// Near is the token the error was found at.
+	Near string
+	// Expected are the tokens the parser expected instead of the token the
+	// error was found at. There are none for the errors the grammar
+	// reports itself, like a label mismatch.
+	Expected []string
+}
+
+// maxExpected is the maximum number of expected tokens listed by
+// Diagnostic.Error.
+const maxExpected = 8
+
+func (d *Diagnostic) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s at line %d column %d", d.Err, d.Line, d.Column)
+	switch {
+	case len(d.Expected) == 1:
+		fmt.Fprintf(&sb, ": expected %s", d.Expected[0])
+	case len(d.Expected) > maxExpected:
+		fmt.Fprintf(&sb, ": expected one of %s, ...", strings.Join(d.Expected[:maxExpected], ", "))
+	case len(d.Expected) > 1:
+		fmt.Fprintf(&sb, ": expected one of %s", strings.Join(d.Expected, ", "))
+	}
+	if d.Near != "" {
+		fmt.Fprintf(&sb, " near '%s'", d.Near)
+	}
+	return sb.String()
+}
+
+// ParseScript parses all the statements of a script. Unlike ParseNext, it
+// does not stop at the first syntax error: a statement in error is
+// replaced by a BadStatement holding its text, and the error is returned
+// among the diagnostics, in the order of the script. The DDL statements are
+// parsed strictly, so a partially parsed DDL statement is a BadStatement
+// too, whatever the StrictDDL setting of the parser.
+func ParseScript(sql string) ([]Statement, []*Diagnostic) {
+	return defaultParser.Load().ParseScript(sql)
+}
+
+// ParseScript behaves like the package-level ParseScript, with the
+// settings of the parser.
+func (p *Parser) ParseScript(sql string) ([]Statement, []*Diagnostic) {
+	tokenizer := p.NewStringTokenizer(sql)
+	tokenizer.diagnose = true
+
+	var stmts []Statement
+	var diagnostics []*Diagnostic
+	for {
+		tokenizer.expected = nil
+		src, err := parseNextWithSource(tokenizer, true)
+		if err == io.EOF {
+			return stmts, diagnostics
+		}
+		if err == nil {
+			stmts = append(stmts, src.Statement)
+			continue
+		}
+
+		// a string tokenizer only fails on syntax errors
+		d := &Diagnostic{Statement: len(stmts), Offset: tokenizer.errorStart, Err: err.Error(), Expected: tokenizer.expected}
+		if posErr, ok := err.(PositionedErr); ok {
+			d.Err, d.Near = posErr.Err, posErr.Near
+		}
+		if d.Offset < src.Start || d.Offset > len(sql) {
+			d.Offset = src.Start
+		}
+		d.Line = src.Line + strings.Count(sql[src.Start:d.Offset], "\n")
+		d.Column = d.Offset - strings.LastIndexByte(sql[:d.Offset], '\n')
+		stmts = append(stmts, &BadStatement{SQL: src.SQL})
+		diagnostics = append(diagnostics, d)
+	}
+}
+
+// Diagnose reports whether the parser calls SyntaxError, which is only
+// needed when diagnosing a script.
+func (tkn *Tokenizer) Diagnose() bool {
+	return tkn.diagnose
+}
+
+// SyntaxError keeps the tokens expected by the parser at a syntax error.
+func (tkn *Tokenizer) SyntaxError(states []int, lookahead int) {
+	tkn.expected = expectedTokens(states)
+}
+
+// expectedTokens returns the names of the tokens the parser accepts with
+// the given stack of states. Unlike yyErrorMessage, which only looks at
+// the state of the error, it follows the reductions from the state the
+// parser read the token in. The keywords that are only accepted as
+// identifiers are listed as a single "identifier", after the punctuation
+// and the reserved keywords.
+func expectedTokens(states []int) []string {
+	// the first tokens are $end, error and $unk
+	const tokStart = 4
+
+	var keywords, punctuation []string
+	identifier := acceptsToken(states, idToken)
+	for tok := tokStart; tok-1 < len(yyToknames); tok++ {
+		if tok == idToken || !acceptsToken(states, tok) {
+			continue
+		}
+		switch name := yyTokname(tok); {
+		case strings.HasPrefix(name, "'"):
+			punctuation = append(punctuation, name)
+		case identifier && isIdentifierAt(states, tok):
+		default:
+			keywords = append(keywords, name)
+		}
+	}
+	expected := append(punctuation, keywords...)
+	if identifier {
+		expected = append(expected, "identifier")
+	}
+	return expected
+}
+
+// idToken is the token of the identifiers in the parser tables.
+var idToken = func() int {
+	for i, name := range yyToknames {
+		if name == "ID" {
+			return i + 1
+		}
+	}
+	panic("no ID token in the grammar")
+}()
+
+// isIdentifierAt returns whether the parser accepts the keyword as an
+// identifier with the given stack of states: once the keyword is shifted,
+// its reductions reach the stack of an identifier shifted in its place, or
+// the stack of the first reduction of the identifier, before the parser
+// shifts the next punctuation.
+func isIdentifierAt(states []int, tok int) bool {
+	withID, _ := shiftToken(states, idToken)
+	withTok, _ := shiftToken(states, tok)
+	for next := range yyToknames {
+		if !strings.HasPrefix(yyToknames[next], "'") {
+			continue
+		}
+		idStacks := [][]int{withID}
+		if stack, shift, ok := parserAction(withID, next+1); ok && !shift {
+			idStacks = append(idStacks, stack)
+		}
+		stack := withTok
+		// the limit guards against a cycle, like in reduceToken
+		for i := 0; i < 1000; i++ {
+			for _, idStack := range idStacks {
+				if slices.Equal(stack, idStack) {
+					return true
+				}
+			}
+			var shift, ok bool
+			if stack, shift, ok = parserAction(stack, next+1); !ok || shift {
+				break
+			}
+		}
+	}
+	return false
+}
+
+// acceptsToken simulates the parser with the given stack of states, and
+// returns whether it shifts the token.
+func acceptsToken(states []int, tok int) bool {
+	_, ok := reduceToken(states, tok)
+	return ok
+}
+
+// shiftToken returns the stack of states once the parser shifted the
+// token, and whether it does.
+func shiftToken(states []int, tok int) ([]int, bool) {
+	stack, ok := reduceToken(states, tok)
+	if !ok {
+		return nil, false
+	}
+	n := yyPact[stack[len(stack)-1]] + tok
+	return append(stack, yyAct[n]), true
+}
+
+// reduceToken simulates the parser with the given stack of states, and
+// returns the stack from which it shifts the token, and whether it does.
+func reduceToken(states []int, tok int) ([]int, bool) {
+	stack := append([]int(nil), states...)
+	// the reductions without a shift are bounded by the depth of the
+	// grammar, the limit guards against a cycle
+	for i := 0; i < 1000; i++ {
+		next, shift, ok := parserAction(stack, tok)
+		if !ok {
+			return nil, false
+		}
+		if shift {
+			return stack, true
+		}
+		stack = next
+	}
+	return nil, false
+}
+
+// parserAction returns whether the parser shifts the token with the given
+// stack of states or, if it does not, the stack once it reduced by one
+// production. It is not ok if the token is an error.
+func parserAction(stack []int, tok int) (next []int, shift bool, ok bool) {
+	state := stack[len(stack)-1]
+	if n := yyPact[state]; n > yyFlag {
+		if n += tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+			return stack, true, true
+		}
+	}
+
+	n := yyDef[state]
+	if n == -2 {
+		i := 0
+		for yyExca[i] != -1 || yyExca[i+1] != state {
+			i += 2
+		}
+		for i += 2; yyExca[i] >= 0 && yyExca[i] != tok; i += 2 {
+		}
+		n = yyExca[i+1]
+	}
+	if n <= 0 {
+		// an error, or the end of the input being accepted
+		return nil, false, false
+	}
+
+	// reduce by production n, and go to the state of its nonterminal
+	next = append([]int(nil), stack[:len(stack)-yyR2[n]]...)
+	g := yyPgo[yyR1[n]]
+	goTo := yyAct[g]
+	if j := g + next[len(next)-1] + 1; j < yyLast && yyChk[yyAct[j]] == -yyR1[n] {
+		goTo = yyAct[j]
+	}
+	return append(next, goTo), false, true
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScript(t *testing.T) {
+	sql := "select 1;\n" +
+		"select * form t;\n" +
+		"update t set a = 1;\n" +
+		"insert into t\n  values (1;\n" +
+		"DELIMITER $$\n" +
+		"create procedure p() begin select 1 t; select 2; end $$\n" +
+		"DELIMITER ;\n" +
+		"delete from t"
+	stmts, diagnostics := ParseScript(sql)
+
+	var got []string
+	for _, stmt := range stmts {
+		got = append(got, String(stmt))
+	}
+	assert.Equal(t, []string{
+		"select 1 from dual",
+		"select * form t",
+		"update t set a = 1",
+		"insert into t\n  values (1",
+		"create procedure p() begin select 1 as t from dual; select 2 from dual; end",
+		"delete from t",
+	}, got)
+	assert.IsType(t, &BadStatement{}, stmts[1])
+	assert.IsType(t, &BadStatement{}, stmts[3])
+	assert.IsType(t, &CreateProcedure{}, stmts[4])
+
+	require.Len(t, diagnostics, 2)
+	assert.Equal(t, 1, diagnostics[0].Statement)
+	assert.Equal(t, 19, diagnostics[0].Offset)
+	assert.Equal(t, 2, diagnostics[0].Line)
+	assert.Equal(t, 10, diagnostics[0].Column)
+	assert.Equal(t, "form", diagnostics[0].Near)
+	assert.Contains(t, diagnostics[0].Expected, "FROM")
+	assert.Contains(t, diagnostics[0].Expected, "WHERE")
+	assert.Equal(t, "syntax error at line 2 column 10: expected one of ',', ';', UNION, EXCEPT, INTERSECT, FROM, WHERE, GROUP, ... near 'form'",
+		diagnostics[0].Error())
+
+	assert.Equal(t, 3, diagnostics[1].Statement)
+	assert.Equal(t, 5, diagnostics[1].Line)
+	assert.Equal(t, 12, diagnostics[1].Column)
+	assert.Contains(t, diagnostics[1].Expected, "')'")
+	assert.Equal(t, "syntax error at line 5 column 12: expected one of ',', ')', '|', '=', '<', '>', '&', '+', ...", diagnostics[1].Error())
+}
+
+func TestParseScriptDiagnostics(t *testing.T) {
+	testCases := []struct {
+		in, err string
+	}{{
+		in:  "selec 1",
+		err: "syntax error at line 1 column 1: expected one of '(', ';', WITH, SELECT, STREAM, VSTREAM, INSERT, UPDATE, ... near 'selec'",
+	}, {
+		in:  "update t sett a = 1",
+		err: "syntax error at line 1 column 15: expected one of ',', SET, JOIN, STRAIGHT_JOIN, LEFT, RIGHT, INNER, CROSS, ... near 'a'",
+	}, {
+		in:  "insert into t values (1, 2",
+		err: "syntax error at line 1 column 27: expected one of ',', ')', '|', '=', '<', '>', '&', '+', ...",
+	}, {
+		in:  "DELIMITER $$\ncreate procedure p() begin select 1 t end $$",
+		err: "syntax error at line 2 column 39: expected one of ',', ';', UNION, EXCEPT, INTERSECT, FROM, WHERE, GROUP, ... near 'end'",
+	}, {
+		in:  "select bad from",
+		err: "syntax error at line 1 column 16: expected one of '(', JSON_TABLE, LATERAL, identifier",
+	}, {
+		in:  "grant select on t to",
+		err: "syntax error at line 1 column 21: expected one of STRING, identifier",
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.in, func(t *testing.T) {
+			stmts, diagnostics := ParseScript(tc.in)
+			require.Len(t, stmts, 1)
+			require.Len(t, diagnostics, 1)
+			assert.Equal(t, tc.err, diagnostics[0].Error())
+		})
+	}
+}
+
+func TestParseScriptExpectedIdentifier(t *testing.T) {
+	// the non-reserved keywords are accepted as identifiers, the reserved
+	// keywords and the literals are not
+	_, diagnostics := ParseScript("select a from t where a = ")
+	require.Len(t, diagnostics, 1)
+	expected := diagnostics[0].Expected
+	assert.Equal(t, "identifier", expected[len(expected)-1])
+	assert.Contains(t, expected, "'('")
+	assert.Contains(t, expected, "CASE")
+	assert.Contains(t, expected, "STRING")
+	assert.Contains(t, expected, "NULL")
+	assert.NotContains(t, expected, "ID")
+	assert.NotContains(t, expected, "ACTION")
+	assert.NotContains(t, expected, "MEMBER")
+}
+
+func TestParseScriptPartialDDL(t *testing.T) {
+	stmts, diagnostics := ParseScript("select 1; create table t (a int,); select 5")
+	require.Len(t, stmts, 3)
+	assert.IsType(t, &BadStatement{}, stmts[1])
+	assert.Equal(t, "create table t (a int,)", String(stmts[1]))
+	assert.Equal(t, "select 5 from dual", String(stmts[2]))
+
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, 1, diagnostics[0].Statement)
+	assert.Equal(t, 1, diagnostics[0].Line)
+	assert.Equal(t, 33, diagnostics[0].Column)
+	assert.Contains(t, diagnostics[0].Error(), "syntax error at line 1 column 33: expected ")
+}
+
+func TestParseScriptValid(t *testing.T) {
+	stmts, diagnostics := ParseScript("select 1; /* only a comment */; select 2")
+	assert.Empty(t, diagnostics)
+	require.Len(t, stmts, 2)
+	assert.Equal(t, "select 2 from dual", String(stmts[1]))
+
+	// the diagnostics leave the other parses alone
+	_, err := Parse("select * form t")
+	assert.EqualError(t, err, "syntax error at position 14 near 'form'")
+}
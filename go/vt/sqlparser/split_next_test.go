@@ -17,10 +17,12 @@ limitations under the License.
 package sqlparser
 
 import (
+	"errors"
 	"github.com/stretchr/testify/require"
 	"io"
 	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 const functionShowCreateTable = `CREATE OR REPLACE FUNCTION "public"."showcreatetable"("namespace" varchar, "tablename" varchar) RETURNS "pg_catalog"."varchar" AS $BODY$
@@ -353,6 +355,22 @@ func TestSplitNextEdgeCases(t *testing.T) {
 		name:  "Partial DDL",
 		input: "create table a ignore me this is garbage; select 1 from a",
 		want:  []string{"create table a ignore me this is garbage", "select 1 from a"},
+	}, {
+		name:  "Delimiter",
+		input: "DELIMITER $$\nselect 1 from a; select 2 from b$$\nupdate a set b = '$$' $$\nDELIMITER ;\nselect 3 from c;",
+		want:  []string{"select 1 from a; select 2 from b", "update a set b = '$$'", "select 3 from c"},
+	}, {
+		name:  "Delimiter without restore",
+		input: "select 1 from a;\ndelimiter //\nselect 2 from b //\nselect 3 from c",
+		want:  []string{"select 1 from a", "select 2 from b", "select 3 from c"},
+	}, {
+		name:  "Delimiter right after a literal",
+		input: "DELIMITER $$\nselect 2$$\nselect 0x1F$$\nselect 1.5$$\nselect 1e3$$\nselect :a$$\nDELIMITER ;\nselect 3",
+		want:  []string{"select 2", "select 0x1F", "select 1.5", "select 1e3", "select :a", "select 3"},
+	}, {
+		name:  "Delimiter as identifier",
+		input: "select delimiter from a; update a set delimiter = 1",
+		want:  []string{"select delimiter from a", "update a set delimiter = 1"},
 	}}
 
 	for _, test := range tests {
@@ -442,3 +460,60 @@ func BenchmarkSplitToPieces(b *testing.B) {
 		})
 	}
 }
+
+func TestDelimiterAfterLiteral(t *testing.T) {
+	sql := "DELIMITER $$\nselect 2$$\nselect 1.5 from t where a = 0x1F$$\nDELIMITER ;"
+
+	pieces, err := SplitStatementToPieces(sql)
+	require.NoError(t, err)
+	require.Equal(t, []string{"select 2", "select 1.5 from t where a = 0x1F"}, pieces)
+
+	tokens := NewStringTokenizer(sql)
+	for _, want := range []string{"select 2 from dual", "select 1.5 from t where a = 0x1F"} {
+		tree, err := ParseNext(tokens)
+		require.NoError(t, err)
+		require.Equal(t, want, String(tree))
+	}
+	_, err = ParseNext(tokens)
+	require.Equal(t, io.EOF, err)
+}
+
+func TestParseNextSkipsToCustomDelimiter(t *testing.T) {
+	// the statement in error is skipped up to the delimiter, not to the
+	// first semicolon of the body of the procedure
+	tokens := NewStringTokenizer("DELIMITER $$\ncreate procedure p() begin select 1 t end; select 2; end$$\nselect 3$$")
+	_, err := ParseNext(tokens)
+	require.Error(t, err)
+
+	tree, err := ParseNext(tokens)
+	require.NoError(t, err)
+	require.Equal(t, "select 3 from dual", String(tree))
+	_, err = ParseNext(tokens)
+	require.Equal(t, io.EOF, err)
+}
+
+func TestSplitNextReadError(t *testing.T) {
+	readErr := errors.New("connection reset")
+	sql := "select 1 from a; update a set b = 'x"
+	tokenizer := NewReaderTokenizer(io.MultiReader(strings.NewReader(sql), iotest.ErrReader(readErr)), WithCacheInBuffer())
+
+	one, err := SplitNext(tokenizer)
+	require.NoError(t, err)
+	require.Equal(t, "select 1 from a", one)
+
+	_, err = SplitNext(tokenizer)
+	var rerr *ReadError
+	require.ErrorAs(t, err, &rerr)
+	require.Equal(t, len(sql), rerr.Offset)
+	require.ErrorIs(t, err, readErr)
+	require.Equal(t, err, tokenizer.LastError)
+}
+
+func TestSplitNextWithoutCache(t *testing.T) {
+	tokenizer := NewReaderTokenizer(strings.NewReader("select 1 from a; select 2 from b"))
+	require.NotPanics(t, func() {
+		_, err := SplitNext(tokenizer)
+		var cerr *CacheError
+		require.ErrorAs(t, err, &cerr)
+	})
+}
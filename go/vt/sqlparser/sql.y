@@ -25,7 +25,9 @@ func setAllowComments(yylex yyLexer, allow bool) {
 }
 
 func setDDL(yylex yyLexer, node Statement) {
-  yylex.(*Tokenizer).partialDDL = node
+  if tkn := yylex.(*Tokenizer); !tkn.inRoutine {
+    tkn.partialDDL = node
+  }
 }
 
 // skipToEnd forces the lexer to end prematurely. Not all SQL statements
@@ -35,10 +37,54 @@ func skipToEnd(yylex yyLexer) {
   yylex.(*Tokenizer).SkipToEnd = true
 }
 
+// grantPrivileges returns the privileges of the items of a GRANT or REVOKE
+// statement with an ON clause. An item without a host that was parsed as an
+// account is a dynamic privilege, such as BACKUP_ADMIN.
+func grantPrivileges(yylex yyLexer, items []SQLNode) ([]*GrantPrivilege, bool) {
+  privileges := make([]*GrantPrivilege, 0, len(items))
+  for _, item := range items {
+    switch item := item.(type) {
+    case *GrantPrivilege:
+      privileges = append(privileges, item)
+    case *Account:
+      if item.Host != "" {
+        yylex.Error("roles cannot be granted on a privilege level")
+        return nil, false
+      }
+      privileges = append(privileges, &GrantPrivilege{Name: NewIdentifierCI(item.User).Lowered()})
+    }
+  }
+  return privileges, true
+}
+
+// grantRoles returns the roles of the items of a GRANT or REVOKE statement
+// without an ON clause.
+func grantRoles(yylex yyLexer, items []SQLNode) ([]*Account, bool) {
+  roles := make([]*Account, 0, len(items))
+  for _, item := range items {
+    role, ok := item.(*Account)
+    if !ok {
+      yylex.Error("privileges require an ON clause")
+      return nil, false
+    }
+    roles = append(roles, role)
+  }
+  return roles, true
+}
+
 func markBindVariable(yylex yyLexer, bvar string) {
   yylex.(*Tokenizer).BindVars[bvar] = struct{}{}
 }
 
+// widenSpan gives the node the source span of the whole production being
+// reduced. It is needed when an action completes a node created by a symbol
+// other than the first one, e.g. adding the WITH clause to a SELECT.
+func widenSpan(yylex yyLexer, node SQLNode) {
+  if tkn := yylex.(*Tokenizer); tkn.positions != nil {
+    tkn.widen = node
+  }
+}
+
 %}
 
 %struct {
@@ -82,6 +128,7 @@ func markBindVariable(yylex yyLexer, bvar string) {
   ctes          []*CommonTableExpr
   order         *Order
   limit         *Limit
+  onConflict    *OnConflict
 
   updateExpr    *UpdateExpr
   setExpr       *SetExpr
@@ -197,6 +244,47 @@ func markBindVariable(yylex yyLexer, bvar string) {
   jtOnResponse	*JtOnResponse
   variables      []*Variable
   variable       *Variable
+
+  procParam      *ProcParameter
+  procParams     []*ProcParameter
+  procParameterMode ProcParameterMode
+  routineCharacteristic *RoutineCharacteristic
+  routineCharacteristics []*RoutineCharacteristic
+  triggerTiming  TriggerTiming
+  triggerEvent   TriggerEvent
+  triggerOrder   *TriggerOrder
+  eventSchedule  *EventSchedule
+  eventOnCompletion EventOnCompletion
+  eventStatus    EventStatus
+  alterEvent     *AlterEvent
+  statementList  StatementList
+  elseIf         *ElseIfBlock
+  elseIfs        []*ElseIfBlock
+  caseStatementWhen *CaseStatementWhen
+  caseStatementWhens []*CaseStatementWhen
+  handlerAction  HandlerAction
+  conditionValue *ConditionValue
+  conditionValues []*ConditionValue
+  signalItem     *SignalItem
+  signalItems    []*SignalItem
+  routineSetExpr *RoutineSetExpr
+  routineSetExprs RoutineSetExprs
+
+  loadPriority   LoadPriority
+  loadFields     *LoadFields
+  loadLines      *LoadLines
+
+  grantItem      SQLNode
+  grantItems     []SQLNode
+  privilegeLevel *PrivilegeLevel
+  account        *Account
+  accounts       []*Account
+  authentication *Authentication
+  userSpec       *UserSpec
+  userSpecs      []*UserSpec
+  accountLock    AccountLock
+  passwordExpire *PasswordExpire
+  renameUserPairs []*RenameUserPair
 }
 
 // These precedence rules are there to handle shift-reduce conflicts.
@@ -223,14 +311,29 @@ func markBindVariable(yylex yyLexer, bvar string) {
 // In order to ensure lower precedence of reduction, this rule has to come before the precedence declaration of STRING.
 // This precedence should not be used anywhere else other than with non-reserved-keywords that are also used for type-casting a STRING.
 %nonassoc <str> STRING_TYPE_PREFIX_NON_KEYWORD
+// GRANT_PROXY_NON_KEYWORD is used to resolve the shift-reduce conflict between GRANT PROXY ON and
+// a privilege named proxy, after seeing PROXY with ON as lookahead. It gives reducing PROXY into a
+// non-reserved keyword a lower precedence than shifting ON, so it has to come before the declaration of ON.
+%nonassoc <str> GRANT_PROXY_NON_KEYWORD
+// PG_CAST_TYPE_NON_KEYWORD is used to resolve the shift-reduce conflicts between the PostgreSQL casts to the
+// multi-word types, like DOUBLE PRECISION or TIME WITH TIME ZONE, and a cast to their first word followed by an
+// alias or a clause. It gives reducing the first word into a keyword a lower precedence than shifting the next word.
+%nonassoc <str> PG_CAST_TYPE_NON_KEYWORD
+%nonassoc <str> PRECISION VARYING WITH WITHOUT
 
 %token LEX_ERROR
-%left <str> UNION
+%token <str> DELIMITER_COMMAND DELIMITER_END
+%left <str> UNION EXCEPT
+%left <str> INTERSECT
 %token <str> SELECT STREAM VSTREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
 %token <str> ALL DISTINCT AS EXISTS ASC DESC INTO DUPLICATE DEFAULT SET LOCK UNLOCK KEYS DO CALL
 %token <str> DISTINCTROW PARSER GENERATED ALWAYS
 %token <str> OUTFILE S3 DATA LOAD LINES TERMINATED ESCAPED ENCLOSED
 %token <str> DUMPFILE CSV HEADER MANIFEST OVERWRITE STARTING OPTIONALLY
+%token <str> INFILE CONCURRENT
+
+// Account management tokens
+%token <str> GRANT REVOKE USAGE IDENTIFIED ACCOUNT NEVER PROXY
 %token <str> VALUES LAST_INSERT_ID
 %token <str> NEXT VALUE SHARE MODE
 %token <str> SQL_NO_CACHE SQL_CACHE SQL_CALC_FOUND_ROWS
@@ -239,8 +342,8 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %left <str> SUBQUERY_AS_EXPR
 %left <str> '(' ',' ')'
 %nonassoc <str> STRING
-%token <str> ID AT_ID AT_AT_ID HEX NCHAR_STRING INTEGRAL FLOAT DECIMAL HEXNUM COMMENT COMMENT_KEYWORD BITNUM BIT_LITERAL COMPRESSION
-%token <str> VALUE_ARG LIST_ARG OFFSET_ARG
+%token <str> ID AT_ID AT_AT_ID AT_STRING HEX NCHAR_STRING INTEGRAL FLOAT DECIMAL HEXNUM COMMENT COMMENT_KEYWORD BITNUM BIT_LITERAL COMPRESSION
+%token <str> VALUE_ARG LIST_ARG OFFSET_ARG POSITIONAL_ARG
 %token <str> JSON_PRETTY JSON_STORAGE_SIZE JSON_STORAGE_FREE JSON_CONTAINS JSON_CONTAINS_PATH JSON_EXTRACT JSON_KEYS JSON_OVERLAPS JSON_SEARCH JSON_VALUE
 %token <str> EXTRACT
 %token <str> NULL TRUE FALSE OFF
@@ -266,8 +369,8 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %left <str> AND
 %right <str> NOT '!'
 %left <str> BETWEEN CASE WHEN THEN ELSE END
-%left <str> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE REGEXP RLIKE IN ASSIGNMENT_OPT
-%left <str> '&'
+%left <str> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE ILIKE REGEXP RLIKE IN ASSIGNMENT_OPT
+%left <str> '&' CONCAT_OP
 %left <str> SHIFT_LEFT SHIFT_RIGHT
 %left <str> '+' '-'
 %left <str> '*' '/' DIV '%' MOD
@@ -281,6 +384,7 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %right <str> UNDERSCORE_LATIN7 UNDERSCORE_MACCE UNDERSCORE_MACROMAN UNDERSCORE_SJIS UNDERSCORE_SWE7 UNDERSCORE_TIS620 UNDERSCORE_UCS2 UNDERSCORE_UJIS UNDERSCORE_UTF16
 %right <str> UNDERSCORE_UTF16LE UNDERSCORE_UTF32 UNDERSCORE_UTF8 UNDERSCORE_UTF8MB4 UNDERSCORE_UTF8MB3
 %right <str> INTERVAL
+%left <str> TYPECAST
 %nonassoc <str> '.'
 %left <str> WINDOW_EXPR
 
@@ -359,10 +463,10 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %token <str> ST_Area ST_Centroid ST_ExteriorRing ST_InteriorRingN ST_NumInteriorRings ST_NumGeometries ST_GeometryN ST_LongFromGeoHash ST_PointFromGeoHash ST_LatFromGeoHash ST_GeoHash ST_AsGeoJSON ST_GeomFromGeoJSON
 
 // Match
-%token <str> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION WITHOUT VALIDATION
+%token <str> MATCH AGAINST BOOLEAN LANGUAGE QUERY EXPANSION VALIDATION
 
 // MySQL reserved words that are unused by this grammar will map to this token.
-%token <str> UNUSED ARRAY BYTE CUME_DIST DESCRIPTION DENSE_RANK EMPTY EXCEPT FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD
+%token <str> UNUSED ARRAY BYTE CUME_DIST DESCRIPTION DENSE_RANK EMPTY FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD
 %token <str> NTH_VALUE NTILE OF OVER PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM WINDOW
 %token <str> ACTIVE ADMIN AUTOEXTEND_SIZE BUCKETS CLONE COLUMN_FORMAT COMPONENT DEFINITION ENFORCED ENGINE_ATTRIBUTE EXCLUDE FOLLOWING GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
 %token <str> INACTIVE INVISIBLE LOCKED MASTER_COMPRESSION_ALGORITHMS MASTER_PUBLIC_KEY_PATH MASTER_TLS_CIPHERSUITES MASTER_ZSTD_COMPRESSION_LEVEL
@@ -370,6 +474,14 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %token <str> RANDOM REFERENCE REQUIRE_ROW_FORMAT RESOURCE RESPECT RESTART RETAIN REUSE ROLE SECONDARY SECONDARY_ENGINE SECONDARY_ENGINE_ATTRIBUTE SECONDARY_LOAD SECONDARY_UNLOAD SIMPLE SKIP SRID
 %token <str> THREAD_PRIORITY TIES UNBOUNDED VCPU VISIBLE RETURNING
 
+// PostgreSQL Keywords
+%token <str> CONFLICT NOTHING DML_RETURNING ZONE
+
+// Stored programs
+%token <str> DECLARE ELSEIF LOOP WHILE REPEAT UNTIL LEAVE ITERATE HANDLER CONTINUE EXIT UNDO CONDITION SQLSTATE SQLWARNING SQLEXCEPTION FOUND
+%token <str> CURSOR CLOSE FETCH SIGNAL RESIGNAL RETURN RETURNS DETERMINISTIC CONTAINS READS MODIFIES OUT INOUT
+%token <str> EACH FOLLOWS PRECEDES SCHEDULE AT EVERY STARTS ENDS COMPLETION PRESERVE
+
 // Performance Schema Functions
 %token <str> FORMAT_BYTES FORMAT_PICO_TIME PS_CURRENT_THREAD_ID PS_THREAD_ID
 
@@ -398,7 +510,7 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %type <partitionByType> range_or_list
 %type <integer> partitions_opt algorithm_opt subpartitions_opt partition_max_rows partition_min_rows
 %type <statement> command
-%type <selStmt> query_expression_parens query_expression query_expression_body select_statement query_primary select_stmt_with_into
+%type <selStmt> query_expression_parens query_expression query_expression_body set_operand select_statement query_primary select_stmt_with_into
 %type <statement> explain_statement explainable_statement
 %type <statement> prepare_statement
 %type <statement> vexplain_statement
@@ -455,7 +567,7 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %type <intervalType> interval
 %type <str> cache_opt separator_opt flush_option for_channel_opt maxvalue
 %type <matchExprOption> match_option
-%type <boolean> distinct_opt union_op replace_opt local_opt
+%type <boolean> distinct_opt set_quantifier_opt replace_opt local_opt
 %type <selectExprs> select_expression_list select_expression_list_opt
 %type <selectExpr> select_expression
 %type <strs> select_options flush_option_list
@@ -512,6 +624,8 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %type <variables> at_id_list execute_statement_list_opt
 %type <partitions> opt_partition_clause partition_list
 %type <updateExprs> on_dup_opt
+%type <onConflict> on_conflict conflict_target_opt
+%type <selectExprs> returning_opt
 %type <updateExprs> update_list
 %type <setExprs> set_list transaction_chars
 %type <setExpr> set_expression transaction_char
@@ -523,7 +637,7 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %type <str> columns_or_fields extended_opt storage_opt
 %type <showFilter> like_or_where_opt like_opt
 %type <boolean> exists_opt not_exists_opt enforced enforced_opt temp_opt full_opt
-%type <empty> to_opt
+%type <empty> to_opt sp_fetch_from_opt
 %type <str> reserved_keyword non_reserved_keyword
 %type <identifierCI> sql_id sql_id_opt reserved_sql_id col_alias as_ci_opt
 %type <expr> charset_value
@@ -533,7 +647,9 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %type <str> charset
 %type <scope> set_session_or_global
 %type <convertType> convert_type returning_type_opt convert_type_weight_string
-%type <boolean> array_opt
+%type <boolean> array_opt pg_array_opt
+%type <convertType> pg_cast_type
+%type <str> pg_time_zone
 %type <columnType> column_type
 %type <columnType> int_type decimal_type numeric_type time_type char_type spatial_type
 %type <literal> length_opt partition_comment partition_data_directory partition_index_directory
@@ -587,6 +703,62 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %type <str> expire_opt
 %type <literal> ratio_opt
 %type <txAccessModes> tx_chacteristics_opt tx_chars
+%type <statement> sp_proc_stmt sp_func_stmt sp_block_statement sp_declaration event_do_opt
+%type <statement> sp_body sp_func_body sp_set_statement set_account_statement start_transaction_statement
+%type <routineSetExprs> sp_set_list
+%type <routineSetExpr> sp_set_expression
+%type <expr> sp_set_variable into_variable
+%type <exprs> into_variable_list
+%type <statementList> sp_statement_list sp_block_statement_list sp_block_statement_list_opt sp_else_opt
+%type <procParam> proc_param func_param
+%type <procParams> proc_param_list proc_param_list_opt func_param_list func_param_list_opt
+%type <procParameterMode> proc_param_mode
+%type <routineCharacteristic> routine_characteristic
+%type <routineCharacteristics> routine_characteristic_list routine_characteristic_list_opt
+%type <triggerTiming> trigger_timing
+%type <triggerEvent> trigger_event
+%type <triggerOrder> trigger_order_opt
+%type <eventSchedule> event_schedule
+%type <eventOnCompletion> event_on_completion_opt
+%type <eventStatus> event_status_opt
+%type <literal> event_comment_opt
+%type <loadPriority> load_priority_opt
+%type <boolean> load_local_opt
+%type <loadFields> load_fields_opt load_fields_list
+%type <loadLines> load_lines_opt load_lines_list
+%type <literal> load_ignore_lines_opt
+%type <exprs> load_columns_opt load_column_list
+%type <expr> load_column
+%type <updateExprs> load_set_opt
+%type <tableName> load_table_name
+%type <statement> grant_statement revoke_statement
+%type <grantItem> grant_item
+%type <grantItems> grant_item_list
+%type <str> privilege_type account_host_opt
+%type <privilegeLevel> privilege_level privilege_level_target
+%type <account> account_name
+%type <accounts> account_list create_user_default_role_opt
+%type <authentication> authentication_opt alter_authentication_opt
+%type <userSpec> user_spec alter_user_spec
+%type <userSpecs> user_spec_list alter_user_spec_list
+%type <accountLock> account_lock_opt
+%type <passwordExpire> password_expire_opt
+%type <identifierCI> authentication_plugin
+%type <renameUserPairs> rename_user_list
+%type <boolean> grant_option_opt admin_option_opt ignore_unknown_user_opt
+%type <tableName> event_rename_opt
+%type <alterEvent> alter_event_prefix
+%type <expr> event_starts_opt event_ends_opt sp_default_opt
+%type <identifierCI> sp_begin_label
+%type <elseIf> sp_elseif
+%type <elseIfs> sp_elseif_list sp_elseif_list_opt
+%type <caseStatementWhen> sp_case_when
+%type <caseStatementWhens> sp_case_when_list
+%type <handlerAction> sp_handler_action
+%type <conditionValue> sp_condition_value sp_sqlstate sp_handler_condition sp_signal_condition sp_signal_condition_opt
+%type <conditionValues> sp_handler_condition_list
+%type <signalItem> sp_signal_item
+%type <signalItems> sp_signal_item_list sp_signal_item_list_opt
 %type <txAccessMode> tx_char
 %start any_command
 
@@ -649,6 +821,8 @@ command:
 | prepare_statement
 | execute_statement
 | deallocate_statement
+| grant_statement
+| revoke_statement
 | /*empty*/
 {
   setParseTree(yylex, nil)
@@ -659,6 +833,10 @@ user_defined_variable:
   {
     $$ = NewVariableExpression($1, SingleAt)
   }
+| AT_STRING
+  {
+    $$ = NewVariableExpression($1, SingleAt)
+  }
 
 ci_identifier:
   ID
@@ -680,6 +858,10 @@ variable_expr:
   {
     $$ = NewVariableExpression(string($1), SingleAt)
   }
+| AT_STRING
+  {
+    $$ = NewVariableExpression(string($1), SingleAt)
+  }
 | AT_AT_ID
   {
     $$ = NewVariableExpression(string($1), DoubleAt)
@@ -692,7042 +874,8649 @@ do_statement:
   }
 
 load_statement:
-  LOAD DATA skip_to_end
+  LOAD DATA load_priority_opt load_local_opt INFILE STRING ignore_opt INTO TABLE load_table_name opt_partition_clause charset_opt load_fields_opt load_lines_opt load_ignore_lines_opt load_columns_opt load_set_opt
   {
-    $$ = &Load{}
+    $$ = &Load{Priority: $3, Local: $4, FileName: $6, Ignore: $7, Table: $10, Partitions: $11, Charset: $12, Fields: $13, Lines: $14, IgnoreLines: $15, Columns: $16, SetExprs: $17}
   }
-
-with_clause:
-  WITH with_list
+| LOAD DATA load_priority_opt load_local_opt INFILE STRING REPLACE INTO TABLE load_table_name opt_partition_clause charset_opt load_fields_opt load_lines_opt load_ignore_lines_opt load_columns_opt load_set_opt
   {
-	$$ = &With{ctes: $2, Recursive: false}
+    $$ = &Load{Priority: $3, Local: $4, FileName: $6, Replace: true, Table: $10, Partitions: $11, Charset: $12, Fields: $13, Lines: $14, IgnoreLines: $15, Columns: $16, SetExprs: $17}
   }
-| WITH RECURSIVE with_list
+// LOAD DATA FROM S3 of Aurora is not parsed.
+| LOAD DATA FROM skip_to_end
   {
-	$$ = &With{ctes: $3, Recursive: true}
+    $$ = &OtherAdmin{}
   }
 
-with_clause_opt:
+load_priority_opt:
   {
-    $$ = nil
+    $$ = NoLoadPriority
   }
- | with_clause
- {
- 	$$ = $1
- }
-
-with_list:
-  with_list ',' common_table_expr
+| LOW_PRIORITY
   {
-	$$ = append($1, $3)
+    $$ = LowPriorityLoad
   }
-| common_table_expr
+| CONCURRENT
   {
-	$$ = []*CommonTableExpr{$1}
+    $$ = ConcurrentLoad
   }
 
-common_table_expr:
-  table_id column_list_opt AS subquery
+load_local_opt:
   {
-	$$ = &CommonTableExpr{ID: $1, Columns: $2, Subquery: $4}
+    $$ = false
   }
-
-query_expression_parens:
-  openb query_expression_parens closeb
+| LOCAL
   {
-  	$$ = $2
+    $$ = true
   }
-| openb query_expression closeb
+
+load_table_name:
+  table_name
+// A quoted table name has always been accepted by the parser.
+| STRING
   {
-     $$ = $2
+    $$ = TableName{Name: NewIdentifierCS($1)}
   }
-| openb query_expression locking_clause closeb
+
+load_fields_opt:
   {
-    setLockInSelect($2, $3)
-    $$ = $2
+    $$ = nil
   }
+| load_fields_list
 
-// TODO; (Manan, Ritwiz) : Use this in create, insert statements
-//query_expression_or_parens:
-//	query_expression
-//	{
-//		$$ = $1
-//	}
-//	| query_expression locking_clause
-//	{
-//		setLockInSelect($1, $2)
-//		$$ = $1
-//	}
-//	| query_expression_parens
-//	{
-//		$$ = $1
-//	}
-
-query_expression:
- query_expression_body order_by_opt limit_opt
+load_fields_list:
+  columns_or_fields
   {
-	$1.SetOrderBy($2)
-	$1.SetLimit($3)
-	$$ = $1
+    $$ = &LoadFields{}
   }
-| query_expression_parens limit_clause
+| load_fields_list TERMINATED BY STRING
   {
-	$1.SetLimit($2)
-	$$ = $1
+    $1.TerminatedBy = NewStrLiteral($4)
+    $$ = $1
   }
-| query_expression_parens order_by_clause limit_opt
+| load_fields_list ENCLOSED BY STRING
   {
-	$1.SetOrderBy($2)
-	$1.SetLimit($3)
-	$$ = $1
+    $1.EnclosedBy = NewStrLiteral($4)
+    $$ = $1
   }
-| with_clause query_expression_body order_by_opt limit_opt
+| load_fields_list OPTIONALLY ENCLOSED BY STRING
   {
-  		$2.SetWith($1)
-		$2.SetOrderBy($3)
-		$2.SetLimit($4)
-		$$ = $2
+    $1.OptionallyEnclosed = true
+    $1.EnclosedBy = NewStrLiteral($5)
+    $$ = $1
   }
-| with_clause query_expression_parens limit_clause
+| load_fields_list ESCAPED BY STRING
   {
-  		$2.SetWith($1)
-		$2.SetLimit($3)
-		$$ = $2
+    $1.EscapedBy = NewStrLiteral($4)
+    $$ = $1
   }
-| with_clause query_expression_parens order_by_clause limit_opt
+
+load_lines_opt:
   {
-  		$2.SetWith($1)
-		$2.SetOrderBy($3)
-		$2.SetLimit($4)
-		$$ = $2
+    $$ = nil
   }
-| with_clause query_expression_parens
+| load_lines_list
+
+load_lines_list:
+  LINES
   {
-	$2.SetWith($1)
+    $$ = &LoadLines{}
   }
-| SELECT comment_opt cache_opt NEXT num_val for_from table_name
+| load_lines_list STARTING BY STRING
   {
-	$$ = NewSelect(Comments($2), SelectExprs{&Nextval{Expr: $5}}, []string{$3}/*options*/, nil, TableExprs{&AliasedTableExpr{Expr: $7}}, nil/*where*/, nil/*groupBy*/, nil/*having*/, nil)
+    $1.StartingBy = NewStrLiteral($4)
+    $$ = $1
+  }
+| load_lines_list TERMINATED BY STRING
+  {
+    $1.TerminatedBy = NewStrLiteral($4)
+    $$ = $1
   }
 
-query_expression_body:
- query_primary
+load_ignore_lines_opt:
   {
-	$$ = $1
+    $$ = nil
   }
-| query_expression_body union_op query_primary
+| IGNORE INTEGRAL LINES
   {
- 	$$ = &Union{Left: $1, Distinct: $2, Right: $3}
+    $$ = NewIntLiteral($2)
+  }
+| IGNORE INTEGRAL ROWS
+  {
+    $$ = NewIntLiteral($2)
   }
-| query_expression_parens union_op query_primary
+
+load_columns_opt:
   {
-	$$ = &Union{Left: $1, Distinct: $2, Right: $3}
+    $$ = nil
   }
-| query_expression_body union_op query_expression_parens
+| openb closeb
   {
-  	$$ = &Union{Left: $1, Distinct: $2, Right: $3}
+    $$ = Exprs{}
   }
-| query_expression_parens union_op query_expression_parens
+| openb load_column_list closeb
   {
-	$$ = &Union{Left: $1, Distinct: $2, Right: $3}
+    $$ = $2
   }
 
-select_statement:
-query_expression
+load_column_list:
+  load_column
   {
-	$$ = $1
+    $$ = Exprs{$1}
   }
-| query_expression locking_clause
+| load_column_list ',' load_column
   {
-	setLockInSelect($1, $2)
-	$$ = $1
+    $$ = append($1, $3)
   }
-| query_expression_parens
+
+load_column:
+  sql_id
   {
-	$$ = $1
+    $$ = &ColName{Name: $1}
   }
-| select_stmt_with_into
+| user_defined_variable
   {
-	$$ = $1
+    $$ = $1
   }
 
-select_stmt_with_into:
-  openb select_stmt_with_into closeb
+load_set_opt:
   {
-	$$ = $2;
+    $$ = nil
   }
-| query_expression into_clause
+| SET update_list
   {
-	$1.SetInto($2)
-	$$ = $1
+    $$ = $2
   }
-| query_expression into_clause locking_clause
+
+grant_statement:
+  GRANT grant_item_list ON privilege_level TO account_list grant_option_opt
   {
-	$1.SetInto($2)
-	$1.SetLock($3)
-	$$ = $1
+    privileges, ok := grantPrivileges(yylex, $2)
+    if !ok {
+      return 1
+    }
+    $$ = &Grant{Privileges: privileges, On: $4, To: $6, WithGrantOption: $7}
   }
-| query_expression locking_clause into_clause
+| GRANT grant_item_list TO account_list admin_option_opt
   {
-	$1.SetInto($3)
-	$1.SetLock($2)
-	$$ = $1
+    roles, ok := grantRoles(yylex, $2)
+    if !ok {
+      return 1
+    }
+    $$ = &Grant{Roles: roles, To: $4, WithAdminOption: $5}
   }
-| query_expression_parens into_clause
+| GRANT PROXY ON account_name TO account_list grant_option_opt
   {
- 	$1.SetInto($2)
-	$$ = $1
+    $$ = &Grant{Proxy: $4, To: $6, WithGrantOption: $7}
   }
 
-stream_statement:
-  STREAM comment_opt select_expression FROM table_name
+revoke_statement:
+  REVOKE exists_opt PROXY ON account_name FROM account_list ignore_unknown_user_opt
   {
-    $$ = &Stream{Comments: Comments($2).Parsed(), SelectExpr: $3, Table: $5}
+    $$ = &Revoke{IfExists: $2, Proxy: $5, From: $7, IgnoreUnknownUser: $8}
   }
-
-vstream_statement:
-  VSTREAM comment_opt select_expression FROM table_name where_expression_opt limit_opt
+| REVOKE exists_opt grant_item_list ON privilege_level FROM account_list ignore_unknown_user_opt
   {
-    $$ = &VStream{Comments: Comments($2).Parsed(), SelectExpr: $3, Table: $5, Where: NewWhere(WhereClause, $6), Limit: $7}
+    privileges, ok := grantPrivileges(yylex, $3)
+    if !ok {
+      return 1
+    }
+    $$ = &Revoke{IfExists: $2, Privileges: privileges, On: $5, From: $7, IgnoreUnknownUser: $8}
+  }
+| REVOKE exists_opt grant_item_list FROM account_list ignore_unknown_user_opt
+  {
+    // REVOKE ALL PRIVILEGES, GRANT OPTION has no ON clause
+    if _, ok := $3[0].(*GrantPrivilege); ok {
+      privileges, ok := grantPrivileges(yylex, $3)
+      if !ok {
+        return 1
+      }
+      $$ = &Revoke{IfExists: $2, Privileges: privileges, From: $5, IgnoreUnknownUser: $6}
+    } else {
+      roles, ok := grantRoles(yylex, $3)
+      if !ok {
+        return 1
+      }
+      $$ = &Revoke{IfExists: $2, Roles: roles, From: $5, IgnoreUnknownUser: $6}
+    }
   }
 
-// query_primary is an unparenthesized SELECT with no order by clause or beyond.
-query_primary:
-//  1         2            3              4                    5             6                7           8            9           10
-  SELECT comment_opt select_options select_expression_list into_clause from_opt where_expression_opt group_by_opt having_opt named_windows_list_opt
+grant_item_list:
+  grant_item
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*into*/, $6/*from*/, NewWhere(WhereClause, $7), GroupBy($8), NewWhere(HavingClause, $9), $10)
+    $$ = []SQLNode{$1}
   }
-| SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt named_windows_list_opt
+| grant_item_list ',' grant_item
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, nil, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8), $9)
+    $$ = append($1, $3)
   }
 
-insert_statement:
-  insert_or_replace comment_opt ignore_opt into_table_name opt_partition_clause insert_data on_dup_opt
+grant_item:
+  privilege_type column_list_opt
   {
-    // insert_data returns a *Insert pre-filled with Columns & Values
-    ins := $6
-    ins.Action = $1
-    ins.Comments = Comments($2).Parsed()
-    ins.Ignore = $3
-    ins.Table = getAliasedTableExprFromTableName($4)
-    ins.Partitions = $5
-    ins.OnDup = OnDup($7)
-    $$ = ins
+    $$ = &GrantPrivilege{Name: $1, Columns: $2}
   }
-| insert_or_replace comment_opt ignore_opt into_table_name opt_partition_clause SET update_list on_dup_opt
+| account_name
   {
-    cols := make(Columns, 0, len($7))
-    vals := make(ValTuple, 0, len($8))
-    for _, updateList := range $7 {
-      cols = append(cols, updateList.Name.Name)
-      vals = append(vals, updateList.Expr)
-    }
-    $$ = &Insert{Action: $1, Comments: Comments($2).Parsed(), Ignore: $3, Table: getAliasedTableExprFromTableName($4), Partitions: $5, Columns: cols, Rows: Values{vals}, OnDup: OnDup($8)}
+    $$ = $1
   }
 
-insert_or_replace:
-  INSERT
+privilege_type:
+  ALL
   {
-    $$ = InsertAct
+    $$ = "all"
   }
-| REPLACE
+| ALL PRIVILEGES
   {
-    $$ = ReplaceAct
+    $$ = "all"
   }
-
-update_statement:
-  with_clause_opt UPDATE comment_opt ignore_opt table_references SET update_list where_expression_opt order_by_opt limit_opt
+| ALTER
   {
-    $$ = &Update{With: $1, Comments: Comments($3).Parsed(), Ignore: $4, TableExprs: $5, Exprs: $7, Where: NewWhere(WhereClause, $8), OrderBy: $9, Limit: $10}
+    $$ = "alter"
   }
-
-delete_statement:
-  with_clause_opt DELETE comment_opt ignore_opt FROM table_name as_opt_id opt_partition_clause where_expression_opt order_by_opt limit_opt
+| ALTER ID
   {
-    $$ = &Delete{With: $1, Comments: Comments($3).Parsed(), Ignore: $4, TableExprs: TableExprs{&AliasedTableExpr{Expr:$6, As: $7}}, Partitions: $8, Where: NewWhere(WhereClause, $9), OrderBy: $10, Limit: $11}
+    if NewIdentifierCI($2).Lowered() != "routine" {
+      yylex.Error("expected ROUTINE")
+      return 1
+    }
+    $$ = "alter routine"
   }
-| with_clause_opt DELETE comment_opt ignore_opt FROM table_name_list USING table_references where_expression_opt
+| CREATE
   {
-    $$ = &Delete{With: $1, Comments: Comments($3).Parsed(), Ignore: $4, Targets: $6, TableExprs: $8, Where: NewWhere(WhereClause, $9)}
+    $$ = "create"
   }
-| with_clause_opt DELETE comment_opt ignore_opt table_name_list from_or_using table_references where_expression_opt
+| CREATE ID
   {
-    $$ = &Delete{With: $1, Comments: Comments($3).Parsed(), Ignore: $4, Targets: $5, TableExprs: $7, Where: NewWhere(WhereClause, $8)}
+    if NewIdentifierCI($2).Lowered() != "routine" {
+      yylex.Error("expected ROUTINE")
+      return 1
+    }
+    $$ = "create routine"
   }
-| with_clause_opt DELETE comment_opt ignore_opt delete_table_list from_or_using table_references where_expression_opt
+| CREATE ROLE
   {
-    $$ = &Delete{With: $1, Comments: Comments($3).Parsed(), Ignore: $4, Targets: $5, TableExprs: $7, Where: NewWhere(WhereClause, $8)}
+    $$ = "create role"
   }
-
-from_or_using:
-  FROM {}
-| USING {}
-
-view_name_list:
-  table_name
+| CREATE TABLESPACE
   {
-    $$ = TableNames{$1}
+    $$ = "create tablespace"
   }
-| view_name_list ',' table_name
+| CREATE TEMPORARY TABLES
   {
-    $$ = append($$, $3)
+    $$ = "create temporary tables"
   }
-
-table_name_list:
-  table_name
+| CREATE USER
   {
-    $$ = TableNames{$1}
+    $$ = "create user"
   }
-| table_name_list ',' table_name
+| CREATE VIEW
   {
-    $$ = append($$, $3)
+    $$ = "create view"
   }
-
-delete_table_list:
-  delete_table_name
+| DELETE
   {
-    $$ = TableNames{$1}
+    $$ = "delete"
   }
-| delete_table_list ',' delete_table_name
+| DROP
   {
-    $$ = append($$, $3)
+    $$ = "drop"
   }
-
-opt_partition_clause:
+| DROP ROLE
   {
-    $$ = nil
+    $$ = "drop role"
   }
-| PARTITION openb partition_list closeb
+| GRANT OPTION
   {
-  $$ = $3
+    $$ = "grant option"
   }
-
-set_statement:
-  SET comment_opt set_list
+| INDEX
   {
-    $$ = NewSetStatement(Comments($2).Parsed(), $3)
+    $$ = "index"
   }
-
-set_list:
-  set_expression
+| INSERT
   {
-    $$ = SetExprs{$1}
+    $$ = "insert"
   }
-| set_list ',' set_expression
+| LOCK TABLES
   {
-    $$ = append($1, $3)
+    $$ = "lock tables"
   }
-
-set_expression:
-  set_variable '=' ON
+| SELECT
   {
-    $$ = &SetExpr{Var: $1, Expr: NewStrLiteral("on")}
+    $$ = "select"
   }
-| set_variable '=' OFF
+| SHOW DATABASES
   {
-    $$ = &SetExpr{Var: $1, Expr: NewStrLiteral("off")}
+    $$ = "show databases"
   }
-| set_variable '=' expression
+| SHOW VIEW
   {
-    $$ = &SetExpr{Var: $1, Expr: $3}
+    $$ = "show view"
   }
-| charset_or_character_set_or_names charset_value collate_opt
+| UPDATE
   {
-    $$ = &SetExpr{Var: NewSetVariable(string($1), SessionScope), Expr: $2}
+    $$ = "update"
   }
-
-set_variable:
-  ID
+| USAGE
   {
-    $$ = NewSetVariable(string($1), SessionScope)
+    $$ = "usage"
   }
-| variable_expr
+| ID ID
   {
-    $$ = $1
+    // REPLICATION CLIENT and REPLICATION SLAVE
+    if NewIdentifierCI($1).Lowered() != "replication" {
+      yylex.Error("expected REPLICATION")
+      return 1
+    }
+    $$ = "replication " + NewIdentifierCI($2).Lowered()
   }
-| set_session_or_global ID
+| non_reserved_keyword
   {
-    $$ = NewSetVariable(string($2), $1)
+    $$ = NewIdentifierCI($1).Lowered()
   }
 
-set_transaction_statement:
-  SET comment_opt set_session_or_global TRANSACTION transaction_chars
+privilege_level:
+  privilege_level_target
   {
-    $$ = NewSetStatement(Comments($2).Parsed(), UpdateSetExprsScope($5, $3))
+    $$ = $1
   }
-| SET comment_opt TRANSACTION transaction_chars
+| TABLE privilege_level_target
   {
-    $$ = NewSetStatement(Comments($2).Parsed(), $4)
+    $2.ObjectType = TableObjectType
+    $$ = $2
   }
-
-transaction_chars:
-  transaction_char
+| FUNCTION privilege_level_target
   {
-    $$ = SetExprs{$1}
+    $2.ObjectType = FunctionObjectType
+    $$ = $2
   }
-| transaction_chars ',' transaction_char
+| PROCEDURE privilege_level_target
   {
-    $$ = append($1, $3)
+    $2.ObjectType = ProcedureObjectType
+    $$ = $2
   }
 
-transaction_char:
-  ISOLATION LEVEL isolation_level
+privilege_level_target:
+  '*'
   {
-    $$ = &SetExpr{Var: NewSetVariable(TransactionIsolationStr, NextTxScope), Expr: NewStrLiteral($3)}
+    $$ = &PrivilegeLevel{}
   }
-| READ WRITE
+| '*' '.' '*'
   {
-    $$ = &SetExpr{Var: NewSetVariable(TransactionReadOnlyStr, NextTxScope), Expr: NewStrLiteral("off")}
+    $$ = &PrivilegeLevel{Global: true}
   }
-| READ ONLY
+| table_id '.' '*'
   {
-    $$ = &SetExpr{Var: NewSetVariable(TransactionReadOnlyStr, NextTxScope), Expr: NewStrLiteral("on")}
+    $$ = &PrivilegeLevel{DB: $1}
   }
-
-isolation_level:
-  REPEATABLE READ
+| table_name
   {
-    $$ = RepeatableReadStr
+    $$ = &PrivilegeLevel{DB: $1.Qualifier, Name: $1.Name}
   }
-| READ COMMITTED
+
+grant_option_opt:
   {
-    $$ = ReadCommittedStr
+    $$ = false
   }
-| READ UNCOMMITTED
+| WITH GRANT OPTION
   {
-    $$ = ReadUncommittedStr
+    $$ = true
   }
-| SERIALIZABLE
+
+admin_option_opt:
   {
-    $$ = SerializableStr
+    $$ = false
   }
-
-set_session_or_global:
-  SESSION
+| WITH ADMIN OPTION
   {
-    $$ = SessionScope
+    $$ = true
   }
-| LOCAL
+
+ignore_unknown_user_opt:
   {
-    $$ = SessionScope
+    $$ = false
   }
-| GLOBAL
+| IGNORE ID USER
   {
-    $$ = GlobalScope
+    if NewIdentifierCI($2).Lowered() != "unknown" {
+      yylex.Error("expected UNKNOWN")
+      return 1
+    }
+    $$ = true
   }
 
-create_statement:
-  create_table_prefix table_spec
+account_name:
+  STRING account_host_opt
   {
-    $1.TableSpec = $2
-    $1.FullyParsed = true
-    $$ = $1
+    $$ = &Account{User: $1, Host: $2}
   }
-| create_table_prefix create_like
+| ID account_host_opt
   {
-    // Create table [name] like [name]
-    $1.OptLike = $2
-    $1.FullyParsed = true
-    $$ = $1
+    $$ = &Account{User: $1, Host: $2}
   }
-| create_index_prefix '(' index_column_list ')' index_option_list_opt algorithm_lock_opt
+
+account_host_opt:
   {
-    indexDef := $1.AlterOptions[0].(*AddIndexDefinition).IndexDefinition
-    indexDef.Columns = $3
-    indexDef.Options = append(indexDef.Options,$5...)
-    $1.AlterOptions = append($1.AlterOptions,$6...)
-    $1.FullyParsed = true
-    $$ = $1
+    $$ = ""
   }
-| CREATE comment_opt replace_opt algorithm_view definer_opt security_view_opt VIEW table_name column_list_opt AS select_statement check_option_opt
+| AT_ID
   {
-    $$ = &CreateView{ViewName: $8, Comments: Comments($2).Parsed(), IsReplace:$3, Algorithm:$4, Definer: $5 ,Security:$6, Columns:$9, Select: $11, CheckOption: $12 }
+    $$ = $1
   }
-| create_database_prefix create_options_opt
+| AT_STRING
   {
-    $1.FullyParsed = true
-    $1.CreateOptions = $2
     $$ = $1
   }
 
-replace_opt:
+account_list:
+  account_name
   {
-    $$ = false
+    $$ = []*Account{$1}
   }
-| OR REPLACE
+| account_list ',' account_name
   {
-    $$ = true
+    $$ = append($1, $3)
   }
 
-vindex_type_opt:
+user_spec_list:
+  user_spec
   {
-    $$ = NewIdentifierCI("")
+    $$ = []*UserSpec{$1}
   }
-| USING vindex_type
+| user_spec_list ',' user_spec
   {
-    $$ = $2
+    $$ = append($1, $3)
   }
 
-vindex_type:
-  sql_id
+user_spec:
+  account_name authentication_opt
   {
-    $$ = $1
+    $$ = &UserSpec{Account: $1, Auth: $2}
   }
 
-vindex_params_opt:
+authentication_opt:
   {
-    var v []VindexParam
-    $$ = v
+    $$ = nil
   }
-| WITH vindex_param_list
+| IDENTIFIED BY STRING
   {
-    $$ = $2
+    $$ = &Authentication{Password: NewStrLiteral($3)}
   }
-
-vindex_param_list:
-  vindex_param
+| IDENTIFIED BY RANDOM PASSWORD
   {
-    $$ = make([]VindexParam, 0, 4)
-    $$ = append($$, $1)
+    $$ = &Authentication{RandomPassword: true}
   }
-| vindex_param_list ',' vindex_param
+| IDENTIFIED WITH authentication_plugin
   {
-    $$ = append($$, $3)
+    $$ = &Authentication{Plugin: $3}
   }
-
-vindex_param:
-  reserved_sql_id '=' table_opt_value
+| IDENTIFIED WITH authentication_plugin BY STRING
   {
-    $$ = VindexParam{Key: $1, Val: $3}
+    $$ = &Authentication{Plugin: $3, Password: NewStrLiteral($5)}
   }
-
-json_object_param_opt:
+| IDENTIFIED WITH authentication_plugin BY RANDOM PASSWORD
   {
-    $$ = nil
+    $$ = &Authentication{Plugin: $3, RandomPassword: true}
   }
-| json_object_param_list
+| IDENTIFIED WITH authentication_plugin AS STRING
   {
-    $$ = $1
+    $$ = &Authentication{Plugin: $3, Password: NewStrLiteral($5), Hashed: true}
   }
 
-json_object_param_list:
-  json_object_param
+alter_user_spec_list:
+  alter_user_spec
   {
-    $$ = []*JSONObjectParam{$1}
+    $$ = []*UserSpec{$1}
   }
-| json_object_param_list ',' json_object_param
+| alter_user_spec_list ',' alter_user_spec
   {
-    $$ = append($$, $3)
+    $$ = append($1, $3)
   }
 
-json_object_param:
-  expression ',' expression
+alter_user_spec:
+  account_name alter_authentication_opt
   {
-    $$ = &JSONObjectParam{Key:$1, Value:$3}
+    $$ = &UserSpec{Account: $1, Auth: $2}
   }
 
-create_table_prefix:
-  CREATE comment_opt temp_opt TABLE not_exists_opt table_name
+// REPLACE only follows a new password given with BY, and only in ALTER USER
+alter_authentication_opt:
+  authentication_opt
   {
-    $$ = &CreateTable{Comments: Comments($2).Parsed(), Table: $6, IfNotExists: $5, Temp: $3}
-    setDDL(yylex, $$)
+    $$ = $1
   }
-
-alter_table_prefix:
-  ALTER comment_opt TABLE table_name
+| authentication_opt REPLACE STRING
   {
-    $$ = &AlterTable{Comments: Comments($2).Parsed(), Table: $4}
-    setDDL(yylex, $$)
+    if $1 == nil || $1.Hashed || ($1.Password == nil && !$1.RandomPassword) {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $1.CurrentPassword = NewStrLiteral($3)
+    $$ = $1
   }
 
-create_index_prefix:
-  CREATE comment_opt INDEX ci_identifier using_opt ON table_name
-  {
-    $$ = &AlterTable{Table: $7, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition:&IndexDefinition{Info: &IndexInfo{Name:$4, Type:string($3)}, Options:$5}}}}
-    setDDL(yylex, $$)
-  }
-| CREATE comment_opt FULLTEXT INDEX ci_identifier using_opt ON table_name
-  {
-    $$ = &AlterTable{Table: $8, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition:&IndexDefinition{Info: &IndexInfo{Name:$5, Type:string($3)+" "+string($4), Fulltext:true}, Options:$6}}}}
-    setDDL(yylex, $$)
-  }
-| CREATE comment_opt SPATIAL INDEX ci_identifier using_opt ON table_name
-  {
-    $$ = &AlterTable{Table: $8, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition:&IndexDefinition{Info: &IndexInfo{Name:$5, Type:string($3)+" "+string($4), Spatial:true}, Options:$6}}}}
-    setDDL(yylex, $$)
-  }
-| CREATE comment_opt UNIQUE INDEX ci_identifier using_opt ON table_name
+authentication_plugin:
+  sql_id
   {
-    $$ = &AlterTable{Table: $8, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition:&IndexDefinition{Info: &IndexInfo{Name:$5, Type:string($3)+" "+string($4), Unique:true}, Options:$6}}}}
-    setDDL(yylex, $$)
+    $$ = $1
   }
-
-create_database_prefix:
-  CREATE comment_opt database_or_schema comment_opt not_exists_opt table_id
+| STRING
   {
-    $$ = &CreateDatabase{Comments: Comments($4).Parsed(), DBName: $6, IfNotExists: $5}
-    setDDL(yylex,$$)
+    $$ = NewIdentifierCI($1)
   }
 
-alter_database_prefix:
-  ALTER comment_opt database_or_schema
+create_user_default_role_opt:
   {
-    $$ = &AlterDatabase{}
-    setDDL(yylex,$$)
+    $$ = nil
   }
-
-database_or_schema:
-  DATABASE
-| SCHEMA
-
-table_spec:
-  '(' table_column_list ')' table_option_list_opt partitions_options_opt
+| DEFAULT ROLE account_list
   {
-    $$ = $2
-    $$.Options = $4
-    $$.PartitionOption = $5
+    $$ = $3
   }
 
-create_options_opt:
+password_expire_opt:
   {
     $$ = nil
   }
-| create_options
+| PASSWORD EXPIRE
   {
-    $$ = $1
+    $$ = &PasswordExpire{Type: PasswordExpireNow}
   }
-
-create_options:
-  character_set
+| PASSWORD EXPIRE DEFAULT
   {
-    $$ = []DatabaseOption{$1}
+    $$ = &PasswordExpire{Type: PasswordExpireDefault}
   }
-| collate
+| PASSWORD EXPIRE NEVER
   {
-    $$ = []DatabaseOption{$1}
+    $$ = &PasswordExpire{Type: PasswordExpireNever}
   }
-| encryption
+| PASSWORD EXPIRE INTERVAL INTEGRAL DAY
   {
-    $$ = []DatabaseOption{$1}
+    $$ = &PasswordExpire{Type: PasswordExpireInterval, Days: NewIntLiteral($4)}
   }
-| create_options collate
+
+account_lock_opt:
   {
-    $$ = append($1,$2)
+    $$ = NoAccountLock
   }
-| create_options character_set
+| ACCOUNT LOCK
   {
-    $$ = append($1,$2)
+    $$ = AccountLocked
   }
-| create_options encryption
+| ACCOUNT UNLOCK
   {
-    $$ = append($1,$2)
+    $$ = AccountUnlocked
   }
 
-default_optional:
-  /* empty */ %prec LOWER_THAN_CHARSET
+rename_user_list:
+  account_name TO account_name
   {
-    $$ = false
+    $$ = []*RenameUserPair{{FromUser: $1, ToUser: $3}}
   }
-| DEFAULT
+| rename_user_list ',' account_name TO account_name
   {
-    $$ = true
+    $$ = append($1, &RenameUserPair{FromUser: $3, ToUser: $5})
   }
 
-character_set:
-  default_optional charset_or_character_set equal_opt ID
+with_clause:
+  WITH with_list
   {
-    $$ = DatabaseOption{Type:CharacterSetType, Value:string($4), IsDefault:$1}
+	$$ = &With{ctes: $2, Recursive: false}
   }
-| default_optional charset_or_character_set equal_opt STRING
+| WITH RECURSIVE with_list
   {
-    $$ = DatabaseOption{Type:CharacterSetType, Value:encodeSQLString($4), IsDefault:$1}
+	$$ = &With{ctes: $3, Recursive: true}
   }
 
-collate:
-  default_optional COLLATE equal_opt ID
-  {
-    $$ = DatabaseOption{Type:CollateType, Value:string($4), IsDefault:$1}
-  }
-| default_optional COLLATE equal_opt STRING
+with_clause_opt:
   {
-    $$ = DatabaseOption{Type:CollateType, Value:encodeSQLString($4), IsDefault:$1}
+    $$ = nil
   }
+ | with_clause
+ {
+ 	$$ = $1
+ }
 
-encryption:
-  default_optional ENCRYPTION equal_opt ID
+with_list:
+  with_list ',' common_table_expr
   {
-    $$ = DatabaseOption{Type:EncryptionType, Value:string($4), IsDefault:$1}
+	$$ = append($1, $3)
   }
-| default_optional ENCRYPTION equal_opt STRING
+| common_table_expr
   {
-    $$ = DatabaseOption{Type:EncryptionType, Value:encodeSQLString($4), IsDefault:$1}
+	$$ = []*CommonTableExpr{$1}
   }
 
-create_like:
-  LIKE table_name
+common_table_expr:
+  table_id column_list_opt AS subquery
   {
-    $$ = &OptLike{LikeTable: $2}
+	$$ = &CommonTableExpr{ID: $1, Columns: $2, Subquery: $4}
   }
-| '(' LIKE table_name ')'
+
+query_expression_parens:
+  openb query_expression_parens closeb
   {
-    $$ = &OptLike{LikeTable: $3}
+  	$$ = $2
   }
-
-column_definition_list:
-  column_definition
+| openb query_expression closeb
   {
-    $$ = []*ColumnDefinition{$1}
+     $$ = $2
   }
-| column_definition_list ',' column_definition
+| openb query_expression locking_clause closeb
   {
-    $$ = append($1,$3)
+    setLockInSelect($2, $3)
+    $$ = $2
   }
 
-table_column_list:
-  column_definition
+// TODO; (Manan, Ritwiz) : Use this in create, insert statements
+//query_expression_or_parens:
+//	query_expression
+//	{
+//		$$ = $1
+//	}
+//	| query_expression locking_clause
+//	{
+//		setLockInSelect($1, $2)
+//		$$ = $1
+//	}
+//	| query_expression_parens
+//	{
+//		$$ = $1
+//	}
+
+query_expression:
+ query_expression_body order_by_opt limit_opt
   {
-    $$ = &TableSpec{}
-    $$.AddColumn($1)
+	$1.SetOrderBy($2)
+	$1.SetLimit($3)
+	$$ = $1
   }
-| check_constraint_definition
+| query_expression_parens limit_clause
   {
-    $$ = &TableSpec{}
-    $$.AddConstraint($1)
+	$1.SetLimit($2)
+	$$ = $1
   }
-| table_column_list ',' column_definition
+| query_expression_parens order_by_clause limit_opt
   {
-    $$.AddColumn($3)
+	$1.SetOrderBy($2)
+	$1.SetLimit($3)
+	$$ = $1
   }
-| table_column_list ',' column_definition check_constraint_definition
+| with_clause query_expression_body order_by_opt limit_opt
   {
-    $$.AddColumn($3)
-    $$.AddConstraint($4)
+  		$2.SetWith($1)
+		$2.SetOrderBy($3)
+		$2.SetLimit($4)
+		widenSpan(yylex, $2)
+		$$ = $2
   }
-| table_column_list ',' index_definition
+| with_clause query_expression_parens limit_clause
   {
-    $$.AddIndex($3)
+  		$2.SetWith($1)
+		$2.SetLimit($3)
+		widenSpan(yylex, $2)
+		$$ = $2
   }
-| table_column_list ',' constraint_definition
+| with_clause query_expression_parens order_by_clause limit_opt
   {
-    $$.AddConstraint($3)
+  		$2.SetWith($1)
+		$2.SetOrderBy($3)
+		$2.SetLimit($4)
+		widenSpan(yylex, $2)
+		$$ = $2
   }
-| table_column_list ',' check_constraint_definition
+| with_clause query_expression_parens
   {
-    $$.AddConstraint($3)
+	$2.SetWith($1)
+  }
+| SELECT comment_opt cache_opt NEXT num_val for_from table_name
+  {
+	$$ = NewSelect(Comments($2), SelectExprs{&Nextval{Expr: $5}}, []string{$3}/*options*/, nil, TableExprs{&AliasedTableExpr{Expr: $7}}, nil/*where*/, nil/*groupBy*/, nil/*having*/, nil)
   }
 
-// collate_opt has to be in the first rule so that we don't have a shift reduce conflict when seeing a COLLATE
-// with column_attribute_list_opt. Always shifting there would have meant that we would have always ended up using the
-// second rule in the grammar whenever COLLATE was specified.
-// We now have a shift reduce conflict between COLLATE and collate_opt. Shifting there is fine. Essentially, we have
-// postponed the decision of which rule to use until we have consumed the COLLATE id/string tokens.
-column_definition:
-  sql_id column_type collate_opt column_attribute_list_opt reference_definition_opt
+query_expression_body:
+ query_primary
   {
-    $2.Options = $4
-    if $2.Options.Collate == "" {
-    	$2.Options.Collate = $3
-    }
-    $2.Options.Reference = $5
-    $$ = &ColumnDefinition{Name: $1, Type: $2}
+	$$ = $1
   }
-| sql_id column_type collate_opt generated_always_opt AS '(' expression ')' generated_column_attribute_list_opt reference_definition_opt
+| set_operand UNION set_quantifier_opt set_operand
   {
-    $2.Options = $9
-    $2.Options.As = $7
-    $2.Options.Reference = $10
-    $2.Options.Collate = $3
-    $$ = &ColumnDefinition{Name: $1, Type: $2}
+	$$ = &Union{Left: $1, Type: UnionType, Distinct: $3, Right: $4}
   }
-
-generated_always_opt:
+| set_operand EXCEPT set_quantifier_opt set_operand
   {
-    $$ = ""
+	$$ = &Union{Left: $1, Type: ExceptType, Distinct: $3, Right: $4}
   }
-|  GENERATED ALWAYS
+| set_operand INTERSECT set_quantifier_opt set_operand
   {
-    $$ = ""
+	$$ = &Union{Left: $1, Type: IntersectType, Distinct: $3, Right: $4}
   }
 
-// There is a shift reduce conflict that arises here because UNIQUE and KEY are column_type_option and so is UNIQUE KEY.
-// So in the state "column_type_options UNIQUE. KEY" there is a shift-reduce conflict(resovled by "%rigth <str> UNIQUE KEY").
-// This has been added to emulate what MySQL does. The previous architecture was such that the order of the column options
-// was specific (as stated in the MySQL guide) and did not accept arbitrary order options. For example NOT NULL DEFAULT 1 and not DEFAULT 1 NOT NULL
-column_attribute_list_opt:
+// The operands of the set operations are resolved by the precedence of
+// UNION, EXCEPT and INTERSECT.
+set_operand:
+  query_expression_body
   {
-    $$ = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: ColKeyNone, Comment: nil, As: nil, Invisible: nil, Format: UnspecifiedFormat, EngineAttribute: nil, SecondaryEngineAttribute: nil }
+	$$ = $1
   }
-| column_attribute_list_opt NULL
+| query_expression_parens
   {
-    val := true
-    $1.Null = &val
-    $$ = $1
+	$$ = $1
   }
-| column_attribute_list_opt NOT NULL
+
+select_statement:
+query_expression
   {
-    val := false
-    $1.Null = &val
-    $$ = $1
+	$$ = $1
   }
-| column_attribute_list_opt DEFAULT openb expression closeb
+| query_expression locking_clause
   {
-	$1.Default = $4
+	setLockInSelect($1, $2)
 	$$ = $1
   }
-| column_attribute_list_opt DEFAULT now_or_signed_literal
+| query_expression_parens
   {
-    $1.Default = $3
-    $$ = $1
+	$$ = $1
   }
-| column_attribute_list_opt ON UPDATE function_call_nonkeyword
+| select_stmt_with_into
   {
-    $1.OnUpdate = $4
-    $$ = $1
+	$$ = $1
   }
-| column_attribute_list_opt AUTO_INCREMENT
+
+select_stmt_with_into:
+  openb select_stmt_with_into closeb
   {
-    $1.Autoincrement = true
-    $$ = $1
+	$$ = $2;
   }
-| column_attribute_list_opt COMMENT_KEYWORD STRING
+| query_expression into_clause
   {
-    $1.Comment = NewStrLiteral($3)
-    $$ = $1
+	$1.SetInto($2)
+	$$ = $1
   }
-| column_attribute_list_opt keys
+| query_expression into_clause locking_clause
   {
-    $1.KeyOpt = $2
-    $$ = $1
+	$1.SetInto($2)
+	$1.SetLock($3)
+	$$ = $1
   }
-| column_attribute_list_opt COLLATE STRING
+| query_expression locking_clause into_clause
   {
-    $1.Collate = encodeSQLString($3)
+	$1.SetInto($3)
+	$1.SetLock($2)
+	$$ = $1
   }
-| column_attribute_list_opt COLLATE ci_identifier
+| query_expression_parens into_clause
   {
-    $1.Collate = string($3.String())
-    $$ = $1
+ 	$1.SetInto($2)
+	$$ = $1
   }
-| column_attribute_list_opt COLUMN_FORMAT column_format
+
+stream_statement:
+  STREAM comment_opt select_expression FROM table_name
   {
-    $1.Format = $3
+    $$ = &Stream{Comments: Comments($2).Parsed(), SelectExpr: $3, Table: $5}
   }
-| column_attribute_list_opt SRID INTEGRAL
+
+vstream_statement:
+  VSTREAM comment_opt select_expression FROM table_name where_expression_opt limit_opt
   {
-    $1.SRID = NewIntLiteral($3)
-    $$ = $1
+    $$ = &VStream{Comments: Comments($2).Parsed(), SelectExpr: $3, Table: $5, Where: NewWhere(WhereClause, $6), Limit: $7}
   }
-| column_attribute_list_opt VISIBLE
+
+// query_primary is an unparenthesized SELECT with no order by clause or beyond.
+query_primary:
+//  1         2            3              4                    5             6                7           8            9           10
+  SELECT comment_opt select_options select_expression_list into_clause from_opt where_expression_opt group_by_opt having_opt named_windows_list_opt
   {
-    val := false
-    $1.Invisible = &val
-    $$ = $1
+    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*into*/, $6/*from*/, NewWhere(WhereClause, $7), GroupBy($8), NewWhere(HavingClause, $9), $10)
   }
-| column_attribute_list_opt INVISIBLE
+| SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt named_windows_list_opt
   {
-    val := true
-    $1.Invisible = &val
-    $$ = $1
+    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, nil, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8), $9)
   }
-| column_attribute_list_opt ENGINE_ATTRIBUTE equal_opt STRING
+
+insert_statement:
+  insert_or_replace comment_opt ignore_opt into_table_name opt_partition_clause insert_data on_dup_opt returning_opt
   {
-    $1.EngineAttribute = NewStrLiteral($4)
+    // insert_data returns a *Insert pre-filled with Columns & Values
+    ins := $6
+    ins.Action = $1
+    ins.Comments = Comments($2).Parsed()
+    ins.Ignore = $3
+    ins.Table = getAliasedTableExprFromTableName($4)
+    ins.Partitions = $5
+    ins.OnDup = OnDup($7)
+    ins.Returning = $8
+    widenSpan(yylex, ins)
+    $$ = ins
   }
-| column_attribute_list_opt SECONDARY_ENGINE_ATTRIBUTE equal_opt STRING
+| insert_or_replace comment_opt ignore_opt into_table_name opt_partition_clause insert_data on_conflict returning_opt
   {
-    $1.SecondaryEngineAttribute = NewStrLiteral($4)
+    ins := $6
+    ins.Action = $1
+    ins.Comments = Comments($2).Parsed()
+    ins.Ignore = $3
+    ins.Table = getAliasedTableExprFromTableName($4)
+    ins.Partitions = $5
+    ins.OnConflict = $7
+    ins.Returning = $8
+    widenSpan(yylex, ins)
+    $$ = ins
   }
-
-column_format:
-  FIXED
-{
-  $$ = FixedFormat
-}
-| DYNAMIC
-{
-  $$ = DynamicFormat
-}
-| DEFAULT
-{
-  $$ = DefaultFormat
-}
-
-column_storage:
-  VIRTUAL
-{
-  $$ = VirtualStorage
-}
-| STORED
-{
-  $$ = StoredStorage
-}
-
-generated_column_attribute_list_opt:
+| insert_or_replace comment_opt ignore_opt into_table_name opt_partition_clause SET update_list on_dup_opt
   {
-    $$ = &ColumnTypeOptions{}
+    cols := make(Columns, 0, len($7))
+    vals := make(ValTuple, 0, len($8))
+    for _, updateList := range $7 {
+      cols = append(cols, updateList.Name.Name)
+      vals = append(vals, updateList.Expr)
+    }
+    $$ = &Insert{Action: $1, Comments: Comments($2).Parsed(), Ignore: $3, Table: getAliasedTableExprFromTableName($4), Partitions: $5, Columns: cols, Rows: Values{vals}, OnDup: OnDup($8)}
   }
-| generated_column_attribute_list_opt column_storage
+
+insert_or_replace:
+  INSERT
   {
-    $1.Storage = $2
-    $$ = $1
+    $$ = InsertAct
   }
-| generated_column_attribute_list_opt NULL
+| REPLACE
   {
-    val := true
-    $1.Null = &val
-    $$ = $1
+    $$ = ReplaceAct
   }
-| generated_column_attribute_list_opt NOT NULL
+
+update_statement:
+  with_clause_opt UPDATE comment_opt ignore_opt table_references SET update_list where_expression_opt order_by_opt limit_opt returning_opt
   {
-    val := false
-    $1.Null = &val
-    $$ = $1
+    $$ = &Update{With: $1, Comments: Comments($3).Parsed(), Ignore: $4, TableExprs: $5, Exprs: $7, Where: NewWhere(WhereClause, $8), OrderBy: $9, Limit: $10, Returning: $11}
   }
-| generated_column_attribute_list_opt COMMENT_KEYWORD STRING
+
+delete_statement:
+  with_clause_opt DELETE comment_opt ignore_opt FROM table_name as_opt_id opt_partition_clause where_expression_opt order_by_opt limit_opt returning_opt
   {
-    $1.Comment = NewStrLiteral($3)
-    $$ = $1
+    $$ = &Delete{With: $1, Comments: Comments($3).Parsed(), Ignore: $4, TableExprs: TableExprs{&AliasedTableExpr{Expr:$6, As: $7}}, Partitions: $8, Where: NewWhere(WhereClause, $9), OrderBy: $10, Limit: $11, Returning: $12}
   }
-| generated_column_attribute_list_opt keys
+| with_clause_opt DELETE comment_opt ignore_opt FROM table_name_list USING table_references where_expression_opt returning_opt
   {
-    $1.KeyOpt = $2
-    $$ = $1
+    $$ = &Delete{With: $1, Comments: Comments($3).Parsed(), Ignore: $4, Targets: $6, TableExprs: $8, Where: NewWhere(WhereClause, $9), Returning: $10}
   }
-| generated_column_attribute_list_opt VISIBLE
+| with_clause_opt DELETE comment_opt ignore_opt table_name_list from_or_using table_references where_expression_opt
   {
-    val := false
-    $1.Invisible = &val
-    $$ = $1
+    $$ = &Delete{With: $1, Comments: Comments($3).Parsed(), Ignore: $4, Targets: $5, TableExprs: $7, Where: NewWhere(WhereClause, $8)}
   }
-| generated_column_attribute_list_opt INVISIBLE
+| with_clause_opt DELETE comment_opt ignore_opt delete_table_list from_or_using table_references where_expression_opt
   {
-    val := true
-    $1.Invisible = &val
-    $$ = $1
+    $$ = &Delete{With: $1, Comments: Comments($3).Parsed(), Ignore: $4, Targets: $5, TableExprs: $7, Where: NewWhere(WhereClause, $8)}
   }
 
-now_or_signed_literal:
-now
+returning_opt:
   {
-  	$$ = $1
+    $$ = nil
   }
-| signed_literal_or_null
-
-now:
-CURRENT_TIMESTAMP func_datetime_precision
+| DML_RETURNING select_expression_list
   {
-    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("current_timestamp"), Fsp: $2}
+    $$ = $2
   }
-| LOCALTIME func_datetime_precision
+
+from_or_using:
+  FROM {}
+| USING {}
+
+view_name_list:
+  table_name
   {
-    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("localtime"), Fsp: $2}
+    $$ = TableNames{$1}
   }
-| LOCALTIMESTAMP func_datetime_precision
+| view_name_list ',' table_name
   {
-    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("localtimestamp"), Fsp: $2}
+    $$ = append($$, $3)
   }
-| UTC_TIMESTAMP func_datetime_precision
+
+table_name_list:
+  table_name
   {
-    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("utc_timestamp"), Fsp:$2}
+    $$ = TableNames{$1}
   }
-| NOW func_datetime_precision
+| table_name_list ',' table_name
   {
-    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("now"), Fsp: $2}
+    $$ = append($$, $3)
   }
-| SYSDATE func_datetime_precision
+
+delete_table_list:
+  delete_table_name
   {
-    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("sysdate"), Fsp: $2}
+    $$ = TableNames{$1}
   }
-  // curdate
-/* doesn't support fsp */
-| CURRENT_DATE func_paren_opt
+| delete_table_list ',' delete_table_name
   {
-    $$ = &FuncExpr{Name:NewIdentifierCI("current_date")}
+    $$ = append($$, $3)
   }
-| CURDATE func_paren_opt
+
+opt_partition_clause:
   {
-    $$ = &FuncExpr{Name:NewIdentifierCI("curdate")}
+    $$ = nil
   }
-| UTC_TIME func_datetime_precision
+| PARTITION openb partition_list closeb
   {
-    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("utc_time"), Fsp: $2}
+  $$ = $3
   }
-  // curtime
-| CURRENT_TIME func_datetime_precision
+
+set_statement:
+  SET comment_opt set_list
   {
-    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("current_time"), Fsp: $2}
+    $$ = NewSetStatement(Comments($2).Parsed(), $3)
   }
-| CURTIME func_datetime_precision
+| set_account_statement
+
+set_account_statement:
+  SET comment_opt ROLE DEFAULT
   {
-    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("curtime"), Fsp: $2}
+    $$ = &SetRole{Type: DefaultRole}
   }
-
-signed_literal_or_null:
-signed_literal
-| null_as_literal
-
- null_as_literal:
-NULL
- {
-    $$ = &NullVal{}
- }
-
- signed_literal:
- literal
-| '+' NUM_literal
-   {
- 	$$= $2
-   }
-| '-' NUM_literal
-   {
-   	$$ = &UnaryExpr{Operator: UMinusOp, Expr: $2}
-   }
-
-literal:
-text_literal
+| SET comment_opt ROLE NONE
   {
-   $$= $1
+    $$ = &SetRole{Type: NoRoles}
   }
-| NUM_literal
+| SET comment_opt ROLE ALL
   {
-  	$$= $1
+    $$ = &SetRole{Type: AllRoles}
   }
-| boolean_value
+| SET comment_opt ROLE ALL EXCEPT account_list
   {
-  	$$ = $1
+    $$ = &SetRole{Type: AllRoles, Roles: $6}
   }
-| HEX
+| SET comment_opt ROLE account_list
   {
-	$$ = NewHexLiteral($1)
+    $$ = &SetRole{Type: ListRoles, Roles: $4}
   }
-| HEXNUM
+| SET comment_opt DEFAULT ROLE NONE TO account_list
   {
-  	$$ = NewHexNumLiteral($1)
+    $$ = &SetDefaultRole{Type: NoRoles, To: $7}
   }
-| BITNUM
+| SET comment_opt DEFAULT ROLE ALL TO account_list
   {
-  	$$ = NewBitLiteral($1[2:])
+    $$ = &SetDefaultRole{Type: AllRoles, To: $7}
   }
-| BIT_LITERAL
+| SET comment_opt DEFAULT ROLE account_list TO account_list
   {
-	$$ = NewBitLiteral($1)
+    $$ = &SetDefaultRole{Type: ListRoles, Roles: $5, To: $7}
   }
-| VALUE_ARG
+| SET comment_opt PASSWORD '=' STRING
   {
-    $$ = parseBindVariable(yylex, $1[1:])
+    $$ = &SetPassword{Password: NewStrLiteral($5)}
   }
-| underscore_charsets BIT_LITERAL %prec UNARY
+| SET comment_opt PASSWORD FOR account_name '=' STRING
   {
-  	$$ = &IntroducerExpr{CharacterSet: $1, Expr: NewBitLiteral($2)}
+    $$ = &SetPassword{For: $5, Password: NewStrLiteral($7)}
   }
-| underscore_charsets HEXNUM %prec UNARY
+| SET comment_opt PASSWORD TO RANDOM
   {
-  	$$ = &IntroducerExpr{CharacterSet: $1, Expr: NewHexNumLiteral($2)}
+    $$ = &SetPassword{}
   }
-| underscore_charsets BITNUM %prec UNARY
+| SET comment_opt PASSWORD FOR account_name TO RANDOM
   {
-  	$$ = &IntroducerExpr{CharacterSet: $1, Expr: NewBitLiteral($2[2:])}
+    $$ = &SetPassword{For: $5}
   }
-| underscore_charsets HEX %prec UNARY
+
+set_list:
+  set_expression
   {
-   	$$ = &IntroducerExpr{CharacterSet: $1, Expr: NewHexLiteral($2)}
+    $$ = SetExprs{$1}
   }
-| underscore_charsets column_name_or_offset %prec UNARY
+| set_list ',' set_expression
   {
-    $$ = &IntroducerExpr{CharacterSet: $1, Expr: $2}
+    $$ = append($1, $3)
   }
-| underscore_charsets VALUE_ARG %prec UNARY
+
+set_expression:
+  set_variable '=' ON
   {
-    arg := parseBindVariable(yylex, $2[1:])
-    $$ = &IntroducerExpr{CharacterSet: $1, Expr: arg}
+    $$ = &SetExpr{Var: $1, Expr: NewStrLiteral("on")}
   }
-| DATE STRING
+| set_variable '=' OFF
   {
-  $$ = NewDateLiteral($2)
+    $$ = &SetExpr{Var: $1, Expr: NewStrLiteral("off")}
   }
-| TIME STRING
+| set_variable '=' expression
   {
-  $$ = NewTimeLiteral($2)
+    $$ = &SetExpr{Var: $1, Expr: $3}
   }
-| TIMESTAMP STRING
+| charset_or_character_set_or_names charset_value collate_opt
   {
-  $$ = NewTimestampLiteral($2)
+    $$ = &SetExpr{Var: NewSetVariable(string($1), SessionScope), Expr: $2}
   }
 
-underscore_charsets:
-  UNDERSCORE_ARMSCII8
+set_variable:
+  ID
   {
-    $$ = Armscii8Str
+    $$ = NewSetVariable(string($1), SessionScope)
   }
-| UNDERSCORE_ASCII
+| variable_expr
   {
-    $$ = ASCIIStr
+    $$ = $1
   }
-| UNDERSCORE_BIG5
+| set_session_or_global ID
   {
-    $$ = Big5Str
+    $$ = NewSetVariable(string($2), $1)
   }
-| UNDERSCORE_BINARY
+
+set_transaction_statement:
+  SET comment_opt set_session_or_global TRANSACTION transaction_chars
   {
-    $$ = UBinaryStr
+    $$ = NewSetStatement(Comments($2).Parsed(), UpdateSetExprsScope($5, $3))
   }
-| UNDERSCORE_CP1250
+| SET comment_opt TRANSACTION transaction_chars
   {
-    $$ = Cp1250Str
+    $$ = NewSetStatement(Comments($2).Parsed(), $4)
   }
-| UNDERSCORE_CP1251
+
+transaction_chars:
+  transaction_char
   {
-    $$ = Cp1251Str
+    $$ = SetExprs{$1}
   }
-| UNDERSCORE_CP1256
+| transaction_chars ',' transaction_char
   {
-    $$ = Cp1256Str
+    $$ = append($1, $3)
   }
-| UNDERSCORE_CP1257
+
+transaction_char:
+  ISOLATION LEVEL isolation_level
   {
-    $$ = Cp1257Str
+    $$ = &SetExpr{Var: NewSetVariable(TransactionIsolationStr, NextTxScope), Expr: NewStrLiteral($3)}
   }
-| UNDERSCORE_CP850
+| READ WRITE
   {
-    $$ = Cp850Str
+    $$ = &SetExpr{Var: NewSetVariable(TransactionReadOnlyStr, NextTxScope), Expr: NewStrLiteral("off")}
   }
-| UNDERSCORE_CP852
+| READ ONLY
   {
-    $$ = Cp852Str
+    $$ = &SetExpr{Var: NewSetVariable(TransactionReadOnlyStr, NextTxScope), Expr: NewStrLiteral("on")}
   }
-| UNDERSCORE_CP866
+
+isolation_level:
+  REPEATABLE READ
   {
-    $$ = Cp866Str
+    $$ = RepeatableReadStr
   }
-| UNDERSCORE_CP932
+| READ COMMITTED
   {
-    $$ = Cp932Str
+    $$ = ReadCommittedStr
   }
-| UNDERSCORE_DEC8
+| READ UNCOMMITTED
   {
-    $$ = Dec8Str
+    $$ = ReadUncommittedStr
   }
-| UNDERSCORE_EUCJPMS
+| SERIALIZABLE
   {
-    $$ = EucjpmsStr
+    $$ = SerializableStr
   }
-| UNDERSCORE_EUCKR
+
+set_session_or_global:
+  SESSION
   {
-    $$ = EuckrStr
+    $$ = SessionScope
   }
-| UNDERSCORE_GB18030
+| LOCAL
   {
-    $$ = Gb18030Str
+    $$ = SessionScope
   }
-| UNDERSCORE_GB2312
+| GLOBAL
   {
-    $$ = Gb2312Str
+    $$ = GlobalScope
   }
-| UNDERSCORE_GBK
+
+create_statement:
+  create_table_prefix table_spec
   {
-    $$ = GbkStr
+    $1.TableSpec = $2
+    $1.FullyParsed = true
+    $$ = $1
   }
-| UNDERSCORE_GEOSTD8
+| create_table_prefix create_like
   {
-    $$ = Geostd8Str
+    // Create table [name] like [name]
+    $1.OptLike = $2
+    $1.FullyParsed = true
+    $$ = $1
   }
-| UNDERSCORE_GREEK
+| create_index_prefix '(' index_column_list ')' index_option_list_opt algorithm_lock_opt
   {
-    $$ = GreekStr
+    indexDef := $1.AlterOptions[0].(*AddIndexDefinition).IndexDefinition
+    indexDef.Columns = $3
+    indexDef.Options = append(indexDef.Options,$5...)
+    $1.AlterOptions = append($1.AlterOptions,$6...)
+    $1.FullyParsed = true
+    $$ = $1
   }
-| UNDERSCORE_HEBREW
+| CREATE comment_opt replace_opt algorithm_view definer_opt security_view_opt VIEW table_name column_list_opt AS select_statement check_option_opt
   {
-    $$ = HebrewStr
+    $$ = &CreateView{ViewName: $8, Comments: Comments($2).Parsed(), IsReplace:$3, Algorithm:$4, Definer: $5 ,Security:$6, Columns:$9, Select: $11, CheckOption: $12 }
   }
-| UNDERSCORE_HP8
+| create_database_prefix create_options_opt
   {
-    $$ = Hp8Str
+    $1.FullyParsed = true
+    $1.CreateOptions = $2
+    $$ = $1
   }
-| UNDERSCORE_KEYBCS2
+| CREATE comment_opt replace_opt algorithm_view definer_opt PROCEDURE not_exists_opt table_name '(' proc_param_list_opt ')' routine_characteristic_list_opt sp_body
   {
-    $$ = Keybcs2Str
+    // OR REPLACE and ALGORITHM are only accepted to share the prefix with CREATE VIEW
+    if $3 || $4 != "" {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = &CreateProcedure{Comments: Comments($2).Parsed(), Definer: $5, IfNotExists: $7, Name: $8, Params: $10, Characteristics: $12, Body: $13}
   }
-| UNDERSCORE_KOI8R
+| CREATE comment_opt replace_opt algorithm_view definer_opt FUNCTION not_exists_opt table_name '(' func_param_list_opt ')' RETURNS column_type routine_characteristic_list_opt sp_func_body
   {
-    $$ = Koi8rStr
+    if $3 || $4 != "" {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = &CreateFunction{Comments: Comments($2).Parsed(), Definer: $5, IfNotExists: $7, Name: $8, Params: $10, Returns: $13, Characteristics: $14, Body: $15}
   }
-| UNDERSCORE_KOI8U
+| CREATE comment_opt replace_opt algorithm_view definer_opt TRIGGER not_exists_opt table_name trigger_timing trigger_event ON table_name FOR EACH ROW trigger_order_opt sp_body
   {
-    $$ = Koi8uStr
+    if $3 || $4 != "" {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = &CreateTrigger{Comments: Comments($2).Parsed(), Definer: $5, IfNotExists: $7, Name: $8, Timing: $9, Event: $10, Table: $12, Order: $16, Body: $17}
   }
-| UNDERSCORE_LATIN1
+| CREATE comment_opt replace_opt algorithm_view definer_opt EVENT not_exists_opt table_name ON SCHEDULE event_schedule event_on_completion_opt event_status_opt event_comment_opt DO sp_body
   {
-    $$ = Latin1Str
+    if $3 || $4 != "" {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = &CreateEvent{Comments: Comments($2).Parsed(), Definer: $5, IfNotExists: $7, Name: $8, Schedule: $11, OnCompletion: $12, Status: $13, Comment: $14, Body: $16}
   }
-| UNDERSCORE_LATIN2
+| CREATE comment_opt USER not_exists_opt user_spec_list create_user_default_role_opt password_expire_opt account_lock_opt
   {
-    $$ = Latin2Str
+    $$ = &CreateUser{IfNotExists: $4, Users: $5, DefaultRoles: $6, PasswordExpire: $7, AccountLock: $8}
   }
-| UNDERSCORE_LATIN5
+| CREATE comment_opt ROLE not_exists_opt account_list
   {
-    $$ = Latin5Str
+    $$ = &CreateRole{IfNotExists: $4, Roles: $5}
   }
-| UNDERSCORE_LATIN7
+
+replace_opt:
   {
-    $$ = Latin7Str
+    $$ = false
   }
-| UNDERSCORE_MACCE
+| OR REPLACE
   {
-    $$ = MacceStr
+    $$ = true
   }
-| UNDERSCORE_MACROMAN
+
+vindex_type_opt:
   {
-    $$ = MacromanStr
+    $$ = NewIdentifierCI("")
   }
-| UNDERSCORE_SJIS
+| USING vindex_type
   {
-    $$ = SjisStr
+    $$ = $2
   }
-| UNDERSCORE_SWE7
+
+vindex_type:
+  sql_id
   {
-    $$ = Swe7Str
+    $$ = $1
   }
-| UNDERSCORE_TIS620
+
+vindex_params_opt:
   {
-    $$ = Tis620Str
+    var v []VindexParam
+    $$ = v
   }
-| UNDERSCORE_UCS2
+| WITH vindex_param_list
   {
-    $$ = Ucs2Str
+    $$ = $2
   }
-| UNDERSCORE_UJIS
+
+vindex_param_list:
+  vindex_param
   {
-    $$ = UjisStr
+    $$ = make([]VindexParam, 0, 4)
+    $$ = append($$, $1)
   }
-| UNDERSCORE_UTF16
+| vindex_param_list ',' vindex_param
   {
-    $$ = Utf16Str
+    $$ = append($$, $3)
   }
-| UNDERSCORE_UTF16LE
+
+vindex_param:
+  reserved_sql_id '=' table_opt_value
   {
-    $$ = Utf16leStr
+    $$ = VindexParam{Key: $1, Val: $3}
   }
-| UNDERSCORE_UTF32
+
+json_object_param_opt:
   {
-    $$ = Utf32Str
+    $$ = nil
   }
-| UNDERSCORE_UTF8
+| json_object_param_list
   {
-    $$ = Utf8Str
+    $$ = $1
   }
-| UNDERSCORE_UTF8MB4
+
+json_object_param_list:
+  json_object_param
   {
-    $$ = Utf8mb4Str
+    $$ = []*JSONObjectParam{$1}
   }
-| UNDERSCORE_UTF8MB3
+| json_object_param_list ',' json_object_param
   {
-    $$ = Utf8Str
+    $$ = append($$, $3)
   }
 
-literal_or_null:
-literal
-| null_as_literal
-
-NUM_literal:
-INTEGRAL
+json_object_param:
+  expression ',' expression
   {
-    $$ = NewIntLiteral($1)
+    $$ = &JSONObjectParam{Key:$1, Value:$3}
   }
-| FLOAT
+
+create_table_prefix:
+  CREATE comment_opt temp_opt TABLE not_exists_opt table_name
   {
-    $$ = NewFloatLiteral($1)
+    $$ = &CreateTable{Comments: Comments($2).Parsed(), Table: $6, IfNotExists: $5, Temp: $3}
+    setDDL(yylex, $$)
   }
-| DECIMAL
+
+alter_table_prefix:
+  ALTER comment_opt TABLE table_name
   {
-    $$ = NewDecimalLiteral($1)
+    $$ = &AlterTable{Comments: Comments($2).Parsed(), Table: $4}
+    setDDL(yylex, $$)
   }
 
-text_literal:
-STRING
+create_index_prefix:
+  CREATE comment_opt INDEX ci_identifier using_opt ON table_name
   {
-	$$ = NewStrLiteral($1)
+    $$ = &AlterTable{Table: $7, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition:&IndexDefinition{Info: &IndexInfo{Name:$4, Type:string($3)}, Options:$5}}}}
+    setDDL(yylex, $$)
   }
-| NCHAR_STRING
+| CREATE comment_opt FULLTEXT INDEX ci_identifier using_opt ON table_name
   {
-	$$ = &UnaryExpr{Operator: NStringOp, Expr: NewStrLiteral($1)}
+    $$ = &AlterTable{Table: $8, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition:&IndexDefinition{Info: &IndexInfo{Name:$5, Type:string($3)+" "+string($4), Fulltext:true}, Options:$6}}}}
+    setDDL(yylex, $$)
   }
- | underscore_charsets STRING %prec UNARY
-   {
-   	$$ = &IntroducerExpr{CharacterSet: $1, Expr: NewStrLiteral($2)}
-   }
-
-text_literal_or_arg:
-  text_literal
+| CREATE comment_opt SPATIAL INDEX ci_identifier using_opt ON table_name
   {
-    $$ = $1
+    $$ = &AlterTable{Table: $8, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition:&IndexDefinition{Info: &IndexInfo{Name:$5, Type:string($3)+" "+string($4), Spatial:true}, Options:$6}}}}
+    setDDL(yylex, $$)
   }
-| VALUE_ARG
+| CREATE comment_opt UNIQUE INDEX ci_identifier using_opt ON table_name
   {
-    $$ = parseBindVariable(yylex, $1[1:])
+    $$ = &AlterTable{Table: $8, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition:&IndexDefinition{Info: &IndexInfo{Name:$5, Type:string($3)+" "+string($4), Unique:true}, Options:$6}}}}
+    setDDL(yylex, $$)
   }
 
-keys:
-  PRIMARY KEY
+create_database_prefix:
+  CREATE comment_opt database_or_schema comment_opt not_exists_opt table_id
   {
-    $$ = ColKeyPrimary
+    $$ = &CreateDatabase{Comments: Comments($4).Parsed(), DBName: $6, IfNotExists: $5}
+    setDDL(yylex,$$)
   }
-| UNIQUE
+
+alter_database_prefix:
+  ALTER comment_opt database_or_schema
   {
-    $$ = ColKeyUnique
+    $$ = &AlterDatabase{}
+    setDDL(yylex,$$)
   }
-| UNIQUE KEY
+
+database_or_schema:
+  DATABASE
+| SCHEMA
+
+table_spec:
+  '(' table_column_list ')' table_option_list_opt partitions_options_opt
   {
-    $$ = ColKeyUniqueKey
+    $$ = $2
+    $$.Options = $4
+    $$.PartitionOption = $5
   }
-| KEY
+
+create_options_opt:
   {
-    $$ = ColKey
+    $$ = nil
   }
-
-column_type:
-  numeric_type unsigned_opt zero_fill_opt
+| create_options
   {
     $$ = $1
-    $$.Unsigned = $2
-    $$.Zerofill = $3
   }
-| char_type
-| time_type
-| spatial_type
 
-numeric_type:
-  int_type length_opt
+create_options:
+  character_set
   {
-    $$ = $1
-    $$.Length = $2
+    $$ = []DatabaseOption{$1}
   }
-| decimal_type
+| collate
   {
-    $$ = $1
+    $$ = []DatabaseOption{$1}
   }
-
-int_type:
-  BIT
+| encryption
   {
-    $$ = &ColumnType{Type: string($1)}
+    $$ = []DatabaseOption{$1}
   }
-| BOOL
+| create_options collate
   {
-    $$ = &ColumnType{Type: string($1)}
+    $$ = append($1,$2)
   }
-| BOOLEAN
+| create_options character_set
   {
-    $$ = &ColumnType{Type: string($1)}
+    $$ = append($1,$2)
   }
-| TINYINT
+| create_options encryption
   {
-    $$ = &ColumnType{Type: string($1)}
+    $$ = append($1,$2)
   }
-| SMALLINT
+
+default_optional:
+  /* empty */ %prec LOWER_THAN_CHARSET
   {
-    $$ = &ColumnType{Type: string($1)}
+    $$ = false
   }
-| MEDIUMINT
+| DEFAULT
   {
-    $$ = &ColumnType{Type: string($1)}
+    $$ = true
   }
-| INT
+
+character_set:
+  default_optional charset_or_character_set equal_opt ID
   {
-    $$ = &ColumnType{Type: string($1)}
+    $$ = DatabaseOption{Type:CharacterSetType, Value:string($4), IsDefault:$1}
   }
-| INTEGER
+| default_optional charset_or_character_set equal_opt STRING
   {
-    $$ = &ColumnType{Type: string($1)}
+    $$ = DatabaseOption{Type:CharacterSetType, Value:encodeSQLString($4), IsDefault:$1}
   }
-| BIGINT
+
+collate:
+  default_optional COLLATE equal_opt ID
   {
-    $$ = &ColumnType{Type: string($1)}
+    $$ = DatabaseOption{Type:CollateType, Value:string($4), IsDefault:$1}
   }
-
-decimal_type:
-REAL double_length_opt
+| default_optional COLLATE equal_opt STRING
   {
-    $$ = &ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = DatabaseOption{Type:CollateType, Value:encodeSQLString($4), IsDefault:$1}
   }
-| DOUBLE double_length_opt
+
+encryption:
+  default_optional ENCRYPTION equal_opt ID
   {
-    $$ = &ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = DatabaseOption{Type:EncryptionType, Value:string($4), IsDefault:$1}
   }
-| FLOAT8_TYPE double_length_opt
+| default_optional ENCRYPTION equal_opt STRING
   {
-    $$ = &ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = DatabaseOption{Type:EncryptionType, Value:encodeSQLString($4), IsDefault:$1}
   }
-| FLOAT_TYPE float_length_opt
+
+create_like:
+  LIKE table_name
   {
-    $$ = &ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = &OptLike{LikeTable: $2}
   }
-| FLOAT4_TYPE float_length_opt
+| '(' LIKE table_name ')'
   {
-    $$ = &ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = &OptLike{LikeTable: $3}
   }
-| DECIMAL_TYPE decimal_length_opt
+
+column_definition_list:
+  column_definition
   {
-    $$ = &ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = []*ColumnDefinition{$1}
   }
-| NUMERIC decimal_length_opt
+| column_definition_list ',' column_definition
   {
-    $$ = &ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = append($1,$3)
   }
 
-time_type:
-  DATE
+table_column_list:
+  column_definition
   {
-    $$ = &ColumnType{Type: string($1)}
+    $$ = &TableSpec{}
+    $$.AddColumn($1)
   }
-| TIME length_opt
+| check_constraint_definition
   {
-    $$ = &ColumnType{Type: string($1), Length: $2}
+    $$ = &TableSpec{}
+    $$.AddConstraint($1)
   }
-| TIMESTAMP length_opt
+| table_column_list ',' column_definition
   {
-    $$ = &ColumnType{Type: string($1), Length: $2}
+    $$.AddColumn($3)
   }
-| DATETIME length_opt
+| table_column_list ',' column_definition check_constraint_definition
   {
-    $$ = &ColumnType{Type: string($1), Length: $2}
+    $$.AddColumn($3)
+    $$.AddConstraint($4)
   }
-| YEAR length_opt
+| table_column_list ',' index_definition
   {
-    $$ = &ColumnType{Type: string($1), Length: $2}
+    $$.AddIndex($3)
   }
-
-char_type:
-  CHAR length_opt charset_opt
+| table_column_list ',' constraint_definition
   {
-    $$ = &ColumnType{Type: string($1), Length: $2, Charset: $3}
+    $$.AddConstraint($3)
   }
-| CHAR length_opt BYTE
+| table_column_list ',' check_constraint_definition
   {
-    // CHAR BYTE is an alias for binary. See also:
-    // https://dev.mysql.com/doc/refman/8.0/en/string-type-syntax.html
-    $$ = &ColumnType{Type: "binary", Length: $2}
+    $$.AddConstraint($3)
   }
-| VARCHAR length_opt charset_opt
+
+// collate_opt has to be in the first rule so that we don't have a shift reduce conflict when seeing a COLLATE
+// with column_attribute_list_opt. Always shifting there would have meant that we would have always ended up using the
+// second rule in the grammar whenever COLLATE was specified.
+// We now have a shift reduce conflict between COLLATE and collate_opt. Shifting there is fine. Essentially, we have
+// postponed the decision of which rule to use until we have consumed the COLLATE id/string tokens.
+column_definition:
+  sql_id column_type collate_opt column_attribute_list_opt reference_definition_opt
   {
-    $$ = &ColumnType{Type: string($1), Length: $2, Charset: $3}
+    $2.Options = $4
+    if $2.Options.Collate == "" {
+    	$2.Options.Collate = $3
+    }
+    $2.Options.Reference = $5
+    $$ = &ColumnDefinition{Name: $1, Type: $2}
   }
-| BINARY length_opt
+| sql_id column_type collate_opt generated_always_opt AS '(' expression ')' generated_column_attribute_list_opt reference_definition_opt
   {
-    $$ = &ColumnType{Type: string($1), Length: $2}
+    $2.Options = $9
+    $2.Options.As = $7
+    $2.Options.Reference = $10
+    $2.Options.Collate = $3
+    $$ = &ColumnDefinition{Name: $1, Type: $2}
   }
-| VARBINARY length_opt
+
+generated_always_opt:
   {
-    $$ = &ColumnType{Type: string($1), Length: $2}
+    $$ = ""
   }
-| TEXT charset_opt
+|  GENERATED ALWAYS
   {
-    $$ = &ColumnType{Type: string($1), Charset: $2}
+    $$ = ""
   }
-| TINYTEXT charset_opt
+
+// There is a shift reduce conflict that arises here because UNIQUE and KEY are column_type_option and so is UNIQUE KEY.
+// So in the state "column_type_options UNIQUE. KEY" there is a shift-reduce conflict(resovled by "%rigth <str> UNIQUE KEY").
+// This has been added to emulate what MySQL does. The previous architecture was such that the order of the column options
+// was specific (as stated in the MySQL guide) and did not accept arbitrary order options. For example NOT NULL DEFAULT 1 and not DEFAULT 1 NOT NULL
+column_attribute_list_opt:
   {
-    $$ = &ColumnType{Type: string($1), Charset: $2}
+    $$ = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: ColKeyNone, Comment: nil, As: nil, Invisible: nil, Format: UnspecifiedFormat, EngineAttribute: nil, SecondaryEngineAttribute: nil }
   }
-| MEDIUMTEXT charset_opt
+| column_attribute_list_opt NULL
   {
-    $$ = &ColumnType{Type: string($1), Charset: $2}
+    val := true
+    $1.Null = &val
+    $$ = $1
   }
-| LONGTEXT charset_opt
+| column_attribute_list_opt NOT NULL
   {
-    $$ = &ColumnType{Type: string($1), Charset: $2}
+    val := false
+    $1.Null = &val
+    $$ = $1
   }
-| BLOB
+| column_attribute_list_opt DEFAULT openb expression closeb
   {
-    $$ = &ColumnType{Type: string($1)}
+	$1.Default = $4
+	$$ = $1
   }
-| TINYBLOB
+| column_attribute_list_opt DEFAULT now_or_signed_literal
   {
-    $$ = &ColumnType{Type: string($1)}
+    $1.Default = $3
+    $$ = $1
   }
-| MEDIUMBLOB
+| column_attribute_list_opt ON UPDATE function_call_nonkeyword
   {
-    $$ = &ColumnType{Type: string($1)}
+    $1.OnUpdate = $4
+    $$ = $1
   }
-| LONGBLOB
+| column_attribute_list_opt AUTO_INCREMENT
   {
-    $$ = &ColumnType{Type: string($1)}
+    $1.Autoincrement = true
+    $$ = $1
   }
-| JSON
+| column_attribute_list_opt COMMENT_KEYWORD STRING
   {
-    $$ = &ColumnType{Type: string($1)}
+    $1.Comment = NewStrLiteral($3)
+    $$ = $1
   }
-| ENUM '(' enum_values ')' charset_opt
+| column_attribute_list_opt keys
   {
-    $$ = &ColumnType{Type: string($1), EnumValues: $3, Charset: $5}
+    $1.KeyOpt = $2
+    $$ = $1
   }
-// need set_values / SetValues ?
-| SET '(' enum_values ')' charset_opt
+| column_attribute_list_opt COLLATE STRING
   {
-    $$ = &ColumnType{Type: string($1), EnumValues: $3, Charset: $5}
+    $1.Collate = encodeSQLString($3)
   }
-
-spatial_type:
-  GEOMETRY
+| column_attribute_list_opt COLLATE ci_identifier
   {
-    $$ = &ColumnType{Type: string($1)}
+    $1.Collate = string($3.String())
+    $$ = $1
   }
-| POINT
+| column_attribute_list_opt COLUMN_FORMAT column_format
   {
-    $$ = &ColumnType{Type: string($1)}
+    $1.Format = $3
   }
-| LINESTRING
+| column_attribute_list_opt SRID INTEGRAL
   {
-    $$ = &ColumnType{Type: string($1)}
+    $1.SRID = NewIntLiteral($3)
+    $$ = $1
   }
-| POLYGON
+| column_attribute_list_opt VISIBLE
   {
-    $$ = &ColumnType{Type: string($1)}
+    val := false
+    $1.Invisible = &val
+    $$ = $1
   }
-| GEOMETRYCOLLECTION
+| column_attribute_list_opt INVISIBLE
   {
-    $$ = &ColumnType{Type: string($1)}
+    val := true
+    $1.Invisible = &val
+    $$ = $1
   }
-| MULTIPOINT
-  {
-    $$ = &ColumnType{Type: string($1)}
-  }
-| MULTILINESTRING
+| column_attribute_list_opt ENGINE_ATTRIBUTE equal_opt STRING
   {
-    $$ = &ColumnType{Type: string($1)}
+    $1.EngineAttribute = NewStrLiteral($4)
   }
-| MULTIPOLYGON
+| column_attribute_list_opt SECONDARY_ENGINE_ATTRIBUTE equal_opt STRING
   {
-    $$ = &ColumnType{Type: string($1)}
+    $1.SecondaryEngineAttribute = NewStrLiteral($4)
   }
 
-enum_values:
-  STRING
+column_format:
+  FIXED
+{
+  $$ = FixedFormat
+}
+| DYNAMIC
+{
+  $$ = DynamicFormat
+}
+| DEFAULT
+{
+  $$ = DefaultFormat
+}
+
+column_storage:
+  VIRTUAL
+{
+  $$ = VirtualStorage
+}
+| STORED
+{
+  $$ = StoredStorage
+}
+
+generated_column_attribute_list_opt:
   {
-    $$ = make([]string, 0, 4)
-    $$ = append($$, encodeSQLString($1))
+    $$ = &ColumnTypeOptions{}
   }
-| enum_values ',' STRING
+| generated_column_attribute_list_opt column_storage
   {
-    $$ = append($1, encodeSQLString($3))
+    $1.Storage = $2
+    $$ = $1
   }
-
-length_opt:
+| generated_column_attribute_list_opt NULL
   {
-    $$ = nil
+    val := true
+    $1.Null = &val
+    $$ = $1
   }
-| '(' INTEGRAL ')'
+| generated_column_attribute_list_opt NOT NULL
   {
-    $$ = NewIntLiteral($2)
+    val := false
+    $1.Null = &val
+    $$ = $1
   }
-
-double_length_opt:
+| generated_column_attribute_list_opt COMMENT_KEYWORD STRING
   {
-    $$ = LengthScaleOption{}
+    $1.Comment = NewStrLiteral($3)
+    $$ = $1
   }
-| '(' INTEGRAL ',' INTEGRAL ')'
+| generated_column_attribute_list_opt keys
   {
-    $$ = LengthScaleOption{
-        Length: NewIntLiteral($2),
-        Scale: NewIntLiteral($4),
-    }
+    $1.KeyOpt = $2
+    $$ = $1
   }
-
-float_length_opt:
-double_length_opt
+| generated_column_attribute_list_opt VISIBLE
   {
+    val := false
+    $1.Invisible = &val
     $$ = $1
   }
-| '(' INTEGRAL ')'
+| generated_column_attribute_list_opt INVISIBLE
   {
-    $$ = LengthScaleOption{
-        Length: NewIntLiteral($2),
-    }
+    val := true
+    $1.Invisible = &val
+    $$ = $1
   }
 
-decimal_length_opt:
+now_or_signed_literal:
+now
   {
-    $$ = LengthScaleOption{}
+  	$$ = $1
   }
-| '(' INTEGRAL ')'
+| signed_literal_or_null
+
+now:
+CURRENT_TIMESTAMP func_datetime_precision
   {
-    $$ = LengthScaleOption{
-        Length: NewIntLiteral($2),
-    }
+    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("current_timestamp"), Fsp: $2}
   }
-| '(' INTEGRAL ',' INTEGRAL ')'
+| LOCALTIME func_datetime_precision
   {
-    $$ = LengthScaleOption{
-        Length: NewIntLiteral($2),
-        Scale: NewIntLiteral($4),
-    }
+    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("localtime"), Fsp: $2}
   }
-
-unsigned_opt:
+| LOCALTIMESTAMP func_datetime_precision
   {
-    $$ = false
+    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("localtimestamp"), Fsp: $2}
   }
-| UNSIGNED
+| UTC_TIMESTAMP func_datetime_precision
   {
-    $$ = true
+    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("utc_timestamp"), Fsp:$2}
   }
-| SIGNED
+| NOW func_datetime_precision
   {
-    $$ = false
+    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("now"), Fsp: $2}
   }
-
-zero_fill_opt:
+| SYSDATE func_datetime_precision
   {
-    $$ = false
+    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("sysdate"), Fsp: $2}
   }
-| ZEROFILL
+  // curdate
+/* doesn't support fsp */
+| CURRENT_DATE func_paren_opt
   {
-    $$ = true
+    $$ = &FuncExpr{Name:NewIdentifierCI("current_date")}
   }
-
-charset_opt:
+| CURDATE func_paren_opt
   {
-    $$ = ColumnCharset{}
+    $$ = &FuncExpr{Name:NewIdentifierCI("curdate")}
   }
-| charset_or_character_set sql_id binary_opt
+| UTC_TIME func_datetime_precision
   {
-    $$ = ColumnCharset{Name: string($2.String()), Binary: $3}
+    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("utc_time"), Fsp: $2}
   }
-| charset_or_character_set STRING binary_opt
+  // curtime
+| CURRENT_TIME func_datetime_precision
   {
-    $$ = ColumnCharset{Name: encodeSQLString($2), Binary: $3}
+    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("current_time"), Fsp: $2}
   }
-| charset_or_character_set BINARY
+| CURTIME func_datetime_precision
   {
-    $$ = ColumnCharset{Name: string($2)}
+    $$ = &CurTimeFuncExpr{Name:NewIdentifierCI("curtime"), Fsp: $2}
   }
-| ASCII binary_opt
+
+signed_literal_or_null:
+signed_literal
+| null_as_literal
+
+ null_as_literal:
+NULL
+ {
+    $$ = &NullVal{}
+ }
+
+ signed_literal:
+ literal
+| '+' NUM_literal
+   {
+ 	$$= $2
+   }
+| '-' NUM_literal
+   {
+   	$$ = &UnaryExpr{Operator: UMinusOp, Expr: $2}
+   }
+
+literal:
+text_literal
   {
-    // ASCII: Shorthand for CHARACTER SET latin1.
-    $$ = ColumnCharset{Name: "latin1", Binary: $2}
+   $$= $1
   }
-| UNICODE binary_opt
+| NUM_literal
   {
-    // UNICODE: Shorthand for CHARACTER SET ucs2.
-    $$ = ColumnCharset{Name: "ucs2", Binary: $2}
+  	$$= $1
   }
-| BINARY
+| boolean_value
   {
-    // BINARY: Shorthand for default CHARACTER SET but with binary collation
-    $$ = ColumnCharset{Name: "", Binary: true}
+  	$$ = $1
   }
-| BINARY ASCII
+| HEX
   {
-    // BINARY ASCII: Shorthand for CHARACTER SET latin1 with binary collation
-    $$ = ColumnCharset{Name: "latin1", Binary: true}
+	$$ = NewHexLiteral($1)
   }
-| BINARY UNICODE
+| HEXNUM
   {
-    // BINARY UNICODE: Shorthand for CHARACTER SET ucs2 with binary collation
-    $$ = ColumnCharset{Name: "ucs2", Binary: true}
+  	$$ = NewHexNumLiteral($1)
   }
-
-binary_opt:
+| BITNUM
   {
-    $$ = false
+  	$$ = NewBitLiteral($1[2:])
   }
-| BINARY
+| BIT_LITERAL
   {
-    $$ = true
+	$$ = NewBitLiteral($1)
   }
-
-collate_opt:
+| VALUE_ARG
   {
-    $$ = ""
+    $$ = parseBindVariable(yylex, $1[1:])
   }
-| COLLATE ci_identifier
+| underscore_charsets BIT_LITERAL %prec UNARY
   {
-    $$ = string($2.String())
+  	$$ = &IntroducerExpr{CharacterSet: $1, Expr: NewBitLiteral($2)}
   }
-| COLLATE STRING
+| underscore_charsets HEXNUM %prec UNARY
   {
-    $$ = encodeSQLString($2)
+  	$$ = &IntroducerExpr{CharacterSet: $1, Expr: NewHexNumLiteral($2)}
   }
-
-index_definition:
-  index_info '(' index_column_list ')' index_option_list_opt
+| underscore_charsets BITNUM %prec UNARY
   {
-    $$ = &IndexDefinition{Info: $1, Columns: $3, Options: $5}
+  	$$ = &IntroducerExpr{CharacterSet: $1, Expr: NewBitLiteral($2[2:])}
   }
-
-index_option_list_opt:
+| underscore_charsets HEX %prec UNARY
   {
-    $$ = nil
+   	$$ = &IntroducerExpr{CharacterSet: $1, Expr: NewHexLiteral($2)}
   }
-| index_option_list
+| underscore_charsets column_name_or_offset %prec UNARY
   {
-    $$ = $1
+    $$ = &IntroducerExpr{CharacterSet: $1, Expr: $2}
   }
-
-index_option_list:
-  index_option
+| underscore_charsets VALUE_ARG %prec UNARY
   {
-    $$ = []*IndexOption{$1}
+    arg := parseBindVariable(yylex, $2[1:])
+    $$ = &IntroducerExpr{CharacterSet: $1, Expr: arg}
   }
-| index_option_list index_option
+| DATE STRING
   {
-    $$ = append($$, $2)
+  $$ = NewDateLiteral($2)
   }
-
-index_option:
-  using_index_type
+| TIME STRING
   {
-    $$ = $1
+  $$ = NewTimeLiteral($2)
   }
-| KEY_BLOCK_SIZE equal_opt INTEGRAL
+| TIMESTAMP STRING
   {
-    // should not be string
-    $$ = &IndexOption{Name: string($1), Value: NewIntLiteral($3)}
-  }
-| COMMENT_KEYWORD STRING
-  {
-    $$ = &IndexOption{Name: string($1), Value: NewStrLiteral($2)}
+  $$ = NewTimestampLiteral($2)
   }
-| VISIBLE
+
+underscore_charsets:
+  UNDERSCORE_ARMSCII8
   {
-    $$ = &IndexOption{Name: string($1) }
+    $$ = Armscii8Str
   }
-| INVISIBLE
+| UNDERSCORE_ASCII
   {
-    $$ = &IndexOption{Name: string($1) }
+    $$ = ASCIIStr
   }
-| WITH PARSER ci_identifier
+| UNDERSCORE_BIG5
   {
-    $$ = &IndexOption{Name: string($1) + " " + string($2), String: $3.String()}
+    $$ = Big5Str
   }
-| ENGINE_ATTRIBUTE equal_opt STRING
+| UNDERSCORE_BINARY
   {
-    $$ = &IndexOption{Name: string($1), Value: NewStrLiteral($3)}
+    $$ = UBinaryStr
   }
-| SECONDARY_ENGINE_ATTRIBUTE equal_opt STRING
+| UNDERSCORE_CP1250
   {
-    $$ = &IndexOption{Name: string($1), Value: NewStrLiteral($3)}
+    $$ = Cp1250Str
   }
-
-equal_opt:
-  /* empty */
+| UNDERSCORE_CP1251
   {
-    $$ = ""
+    $$ = Cp1251Str
   }
-| '='
+| UNDERSCORE_CP1256
   {
-    $$ = string($1)
+    $$ = Cp1256Str
   }
-
-index_info:
-  constraint_name_opt PRIMARY KEY name_opt
+| UNDERSCORE_CP1257
   {
-    $$ = &IndexInfo{Type: string($2) + " " + string($3), ConstraintName: NewIdentifierCI($1), Name: NewIdentifierCI("PRIMARY"), Primary: true, Unique: true}
+    $$ = Cp1257Str
   }
-| SPATIAL index_or_key_opt name_opt
+| UNDERSCORE_CP850
   {
-    $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewIdentifierCI($3), Spatial: true, Unique: false}
+    $$ = Cp850Str
   }
-| FULLTEXT index_or_key_opt name_opt
+| UNDERSCORE_CP852
   {
-    $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewIdentifierCI($3), Fulltext: true, Unique: false}
+    $$ = Cp852Str
   }
-| constraint_name_opt UNIQUE index_or_key_opt name_opt
+| UNDERSCORE_CP866
   {
-    $$ = &IndexInfo{Type: string($2) + " " + string($3), ConstraintName: NewIdentifierCI($1), Name: NewIdentifierCI($4), Unique: true}
+    $$ = Cp866Str
   }
-| index_or_key name_opt
+| UNDERSCORE_CP932
   {
-    $$ = &IndexInfo{Type: string($1), Name: NewIdentifierCI($2), Unique: false}
+    $$ = Cp932Str
   }
-
-constraint_name_opt:
+| UNDERSCORE_DEC8
   {
-    $$ = ""
+    $$ = Dec8Str
   }
-| CONSTRAINT name_opt
+| UNDERSCORE_EUCJPMS
   {
-    $$ = $2
+    $$ = EucjpmsStr
   }
-
-index_symbols:
-  INDEX
+| UNDERSCORE_EUCKR
   {
-    $$ = string($1)
+    $$ = EuckrStr
   }
-| KEYS
+| UNDERSCORE_GB18030
   {
-    $$ = string($1)
+    $$ = Gb18030Str
   }
-| INDEXES
+| UNDERSCORE_GB2312
   {
-    $$ = string($1)
+    $$ = Gb2312Str
   }
-
-from_or_in:
-  FROM
+| UNDERSCORE_GBK
   {
-    $$ = string($1)
+    $$ = GbkStr
   }
-| IN
+| UNDERSCORE_GEOSTD8
   {
-    $$ = string($1)
+    $$ = Geostd8Str
   }
-
-index_or_key_opt:
+| UNDERSCORE_GREEK
   {
-    $$ = "key"
+    $$ = GreekStr
   }
-| index_or_key
+| UNDERSCORE_HEBREW
   {
-    $$ = $1
+    $$ = HebrewStr
   }
-
-index_or_key:
-  INDEX
+| UNDERSCORE_HP8
   {
-    $$ = string($1)
+    $$ = Hp8Str
   }
-  | KEY
+| UNDERSCORE_KEYBCS2
   {
-    $$ = string($1)
+    $$ = Keybcs2Str
   }
-
-name_opt:
+| UNDERSCORE_KOI8R
   {
-    $$ = ""
+    $$ = Koi8rStr
   }
-| ci_identifier
+| UNDERSCORE_KOI8U
   {
-    $$ = string($1.String())
+    $$ = Koi8uStr
   }
-
-index_column_list:
-  index_column
+| UNDERSCORE_LATIN1
   {
-    $$ = []*IndexColumn{$1}
+    $$ = Latin1Str
   }
-| index_column_list ',' index_column
+| UNDERSCORE_LATIN2
   {
-    $$ = append($$, $3)
+    $$ = Latin2Str
   }
-
-index_column:
-  sql_id length_opt asc_desc_opt
+| UNDERSCORE_LATIN5
   {
-    $$ = &IndexColumn{Column: $1, Length: $2, Direction: $3}
+    $$ = Latin5Str
   }
-| openb expression closeb asc_desc_opt
+| UNDERSCORE_LATIN7
   {
-    $$ = &IndexColumn{Expression: $2, Direction: $4}
+    $$ = Latin7Str
   }
-
-constraint_definition:
-  CONSTRAINT ci_identifier_opt constraint_info
+| UNDERSCORE_MACCE
   {
-    $$ = &ConstraintDefinition{Name: $2, Details: $3}
+    $$ = MacceStr
   }
-|  constraint_info
+| UNDERSCORE_MACROMAN
   {
-    $$ = &ConstraintDefinition{Details: $1}
+    $$ = MacromanStr
   }
-
-check_constraint_definition:
-  CONSTRAINT ci_identifier_opt check_constraint_info
+| UNDERSCORE_SJIS
   {
-    $$ = &ConstraintDefinition{Name: $2, Details: $3}
+    $$ = SjisStr
   }
-|  check_constraint_info
+| UNDERSCORE_SWE7
   {
-    $$ = &ConstraintDefinition{Details: $1}
+    $$ = Swe7Str
   }
-
-constraint_info:
-  FOREIGN KEY name_opt '(' column_list ')' reference_definition
+| UNDERSCORE_TIS620
   {
-    $$ = &ForeignKeyDefinition{IndexName: NewIdentifierCI($3), Source: $5, ReferenceDefinition: $7}
+    $$ = Tis620Str
   }
-
-reference_definition:
-  REFERENCES table_name '(' column_list ')' fk_match_opt
+| UNDERSCORE_UCS2
   {
-    $$ = &ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6}
+    $$ = Ucs2Str
   }
-| REFERENCES table_name '(' column_list ')' fk_match_opt fk_on_delete
+| UNDERSCORE_UJIS
   {
-    $$ = &ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6, OnDelete: $7}
+    $$ = UjisStr
   }
-| REFERENCES table_name '(' column_list ')' fk_match_opt fk_on_update
+| UNDERSCORE_UTF16
   {
-    $$ = &ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6, OnUpdate: $7}
+    $$ = Utf16Str
   }
-| REFERENCES table_name '(' column_list ')' fk_match_opt fk_on_delete fk_on_update
+| UNDERSCORE_UTF16LE
   {
-    $$ = &ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6, OnDelete: $7, OnUpdate: $8}
+    $$ = Utf16leStr
   }
-| REFERENCES table_name '(' column_list ')' fk_match_opt fk_on_update fk_on_delete
+| UNDERSCORE_UTF32
   {
-    $$ = &ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6, OnUpdate: $7, OnDelete: $8}
+    $$ = Utf32Str
   }
-
-reference_definition_opt:
+| UNDERSCORE_UTF8
   {
-    $$ = nil
+    $$ = Utf8Str
   }
-| reference_definition
+| UNDERSCORE_UTF8MB4
   {
-    $$ = $1
+    $$ = Utf8mb4Str
   }
-
-check_constraint_info:
-  CHECK '(' expression ')' enforced_opt
+| UNDERSCORE_UTF8MB3
   {
-    $$ = &CheckConstraintDefinition{Expr: $3, Enforced: $5}
+    $$ = Utf8Str
   }
 
-fk_match:
-  MATCH fk_match_action
-  {
-    $$ = $2
-  }
+literal_or_null:
+literal
+| null_as_literal
 
-fk_match_action:
-  FULL
+NUM_literal:
+INTEGRAL
   {
-    $$ = Full
+    $$ = NewIntLiteral($1)
   }
-| PARTIAL
+| FLOAT
   {
-    $$ = Partial
+    $$ = NewFloatLiteral($1)
   }
-| SIMPLE
+| DECIMAL
   {
-    $$ = Simple
+    $$ = NewDecimalLiteral($1)
   }
 
-fk_match_opt:
+text_literal:
+STRING
   {
-    $$ = DefaultMatch
+	$$ = NewStrLiteral($1)
   }
-| fk_match
+| NCHAR_STRING
   {
-    $$ = $1
+	$$ = &UnaryExpr{Operator: NStringOp, Expr: NewStrLiteral($1)}
   }
+ | underscore_charsets STRING %prec UNARY
+   {
+   	$$ = &IntroducerExpr{CharacterSet: $1, Expr: NewStrLiteral($2)}
+   }
 
-fk_on_delete:
-  ON DELETE fk_reference_action
+text_literal_or_arg:
+  text_literal
   {
-    $$ = $3
+    $$ = $1
   }
-
-fk_on_update:
-  ON UPDATE fk_reference_action
+| VALUE_ARG
   {
-    $$ = $3
+    $$ = parseBindVariable(yylex, $1[1:])
   }
 
-fk_reference_action:
-  RESTRICT
+keys:
+  PRIMARY KEY
   {
-    $$ = Restrict
+    $$ = ColKeyPrimary
   }
-| CASCADE
-  {
-    $$ = Cascade
-  }
-| NO ACTION
+| UNIQUE
   {
-    $$ = NoAction
+    $$ = ColKeyUnique
   }
-| SET DEFAULT
+| UNIQUE KEY
   {
-    $$ = SetDefault
+    $$ = ColKeyUniqueKey
   }
-| SET NULL
+| KEY
   {
-    $$ = SetNull
+    $$ = ColKey
   }
 
-restrict_or_cascade_opt:
+column_type:
+  numeric_type unsigned_opt zero_fill_opt
   {
-    $$ = ""
+    $$ = $1
+    $$.Unsigned = $2
+    $$.Zerofill = $3
   }
-| RESTRICT
+| char_type
+| time_type
+| spatial_type
+
+numeric_type:
+  int_type length_opt
   {
-    $$ = string($1)
+    $$ = $1
+    $$.Length = $2
   }
-| CASCADE
+| decimal_type
   {
-    $$ = string($1)
+    $$ = $1
   }
 
-enforced:
-  ENFORCED
+int_type:
+  BIT
   {
-    $$ = true
+    $$ = &ColumnType{Type: string($1)}
   }
-| NOT ENFORCED
+| BOOL
   {
-    $$ = false
+    $$ = &ColumnType{Type: string($1)}
   }
-
-enforced_opt:
+| BOOLEAN
   {
-    $$ = true
+    $$ = &ColumnType{Type: string($1)}
   }
-| enforced
+| TINYINT
   {
-    $$ = $1
+    $$ = &ColumnType{Type: string($1)}
   }
-
-table_option_list_opt:
+| SMALLINT
   {
-    $$ = nil
+    $$ = &ColumnType{Type: string($1)}
   }
-| table_option_list
+| MEDIUMINT
   {
-    $$ = $1
+    $$ = &ColumnType{Type: string($1)}
   }
-
-table_option_list:
-  table_option
+| INT
   {
-    $$ = TableOptions{$1}
+    $$ = &ColumnType{Type: string($1)}
   }
-| table_option_list ',' table_option
+| INTEGER
   {
-    $$ = append($1,$3)
+    $$ = &ColumnType{Type: string($1)}
   }
-| table_option_list table_option
+| BIGINT
   {
-    $$ = append($1,$2)
+    $$ = &ColumnType{Type: string($1)}
   }
 
-space_separated_table_option_list:
-  table_option
+decimal_type:
+REAL double_length_opt
   {
-    $$ = TableOptions{$1}
+    $$ = &ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
   }
-| space_separated_table_option_list table_option
+| DOUBLE double_length_opt
   {
-    $$ = append($1,$2)
+    $$ = &ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
   }
-
-table_option:
-  AUTO_INCREMENT equal_opt INTEGRAL
+| FLOAT8_TYPE double_length_opt
   {
-    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+    $$ = &ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
   }
-| AUTOEXTEND_SIZE equal_opt INTEGRAL
+| FLOAT_TYPE float_length_opt
   {
-    $$ = &TableOption{Name: string($1), Value: NewIntLiteral($3)}
+    $$ = &ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
   }
-| AVG_ROW_LENGTH equal_opt INTEGRAL
+| FLOAT4_TYPE float_length_opt
   {
-    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+    $$ = &ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
   }
-| default_optional charset_or_character_set equal_opt charset
+| DECIMAL_TYPE decimal_length_opt
   {
-    $$ = &TableOption{Name:(string($2)), String:$4, CaseSensitive: true}
+    $$ = &ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
   }
-| default_optional COLLATE equal_opt charset
+| NUMERIC decimal_length_opt
   {
-    $$ = &TableOption{Name:string($2), String:$4, CaseSensitive: true}
+    $$ = &ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
   }
-| CHECKSUM equal_opt INTEGRAL
+
+time_type:
+  DATE
   {
-    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+    $$ = &ColumnType{Type: string($1)}
   }
-| COMMENT_KEYWORD equal_opt STRING
+| TIME length_opt
   {
-    $$ = &TableOption{Name:string($1), Value:NewStrLiteral($3)}
+    $$ = &ColumnType{Type: string($1), Length: $2}
   }
-| COMPRESSION equal_opt STRING
+| TIMESTAMP length_opt
   {
-    $$ = &TableOption{Name:string($1), Value:NewStrLiteral($3)}
+    $$ = &ColumnType{Type: string($1), Length: $2}
   }
-| CONNECTION equal_opt STRING
+| DATETIME length_opt
   {
-    $$ = &TableOption{Name:string($1), Value:NewStrLiteral($3)}
+    $$ = &ColumnType{Type: string($1), Length: $2}
   }
-| DATA DIRECTORY equal_opt STRING
+| YEAR length_opt
   {
-    $$ = &TableOption{Name:(string($1)+" "+string($2)), Value:NewStrLiteral($4)}
+    $$ = &ColumnType{Type: string($1), Length: $2}
   }
-| INDEX DIRECTORY equal_opt STRING
+
+char_type:
+  CHAR length_opt charset_opt
   {
-    $$ = &TableOption{Name:(string($1)+" "+string($2)), Value:NewStrLiteral($4)}
+    $$ = &ColumnType{Type: string($1), Length: $2, Charset: $3}
   }
-| DELAY_KEY_WRITE equal_opt INTEGRAL
+| CHAR length_opt BYTE
   {
-    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+    // CHAR BYTE is an alias for binary. See also:
+    // https://dev.mysql.com/doc/refman/8.0/en/string-type-syntax.html
+    $$ = &ColumnType{Type: "binary", Length: $2}
   }
-| ENCRYPTION equal_opt STRING
+| VARCHAR length_opt charset_opt
   {
-    $$ = &TableOption{Name:string($1), Value:NewStrLiteral($3)}
+    $$ = &ColumnType{Type: string($1), Length: $2, Charset: $3}
   }
-| ENGINE equal_opt table_alias
+| BINARY length_opt
   {
-    $$ = &TableOption{Name:string($1), String:$3.String(), CaseSensitive: true}
+    $$ = &ColumnType{Type: string($1), Length: $2}
   }
-| ENGINE_ATTRIBUTE equal_opt STRING
+| VARBINARY length_opt
   {
-    $$ = &TableOption{Name: string($1), Value: NewStrLiteral($3)}
+    $$ = &ColumnType{Type: string($1), Length: $2}
   }
-| INSERT_METHOD equal_opt insert_method_options
+| TEXT charset_opt
   {
-    $$ = &TableOption{Name:string($1), String:string($3)}
+    $$ = &ColumnType{Type: string($1), Charset: $2}
   }
-| KEY_BLOCK_SIZE equal_opt INTEGRAL
+| TINYTEXT charset_opt
   {
-    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+    $$ = &ColumnType{Type: string($1), Charset: $2}
   }
-| MAX_ROWS equal_opt INTEGRAL
+| MEDIUMTEXT charset_opt
   {
-    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+    $$ = &ColumnType{Type: string($1), Charset: $2}
   }
-| MIN_ROWS equal_opt INTEGRAL
+| LONGTEXT charset_opt
   {
-    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+    $$ = &ColumnType{Type: string($1), Charset: $2}
   }
-| PACK_KEYS equal_opt INTEGRAL
+| BLOB
   {
-    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+    $$ = &ColumnType{Type: string($1)}
   }
-| PACK_KEYS equal_opt DEFAULT
+| TINYBLOB
   {
-    $$ = &TableOption{Name:string($1), String:string($3)}
+    $$ = &ColumnType{Type: string($1)}
   }
-| PASSWORD equal_opt STRING
+| MEDIUMBLOB
   {
-    $$ = &TableOption{Name:string($1), Value:NewStrLiteral($3)}
+    $$ = &ColumnType{Type: string($1)}
   }
-| ROW_FORMAT equal_opt row_format_options
+| LONGBLOB
   {
-    $$ = &TableOption{Name:string($1), String:string($3)}
+    $$ = &ColumnType{Type: string($1)}
   }
-| SECONDARY_ENGINE_ATTRIBUTE equal_opt STRING
+| JSON
   {
-    $$ = &TableOption{Name: string($1), Value: NewStrLiteral($3)}
+    $$ = &ColumnType{Type: string($1)}
   }
-| STATS_AUTO_RECALC equal_opt INTEGRAL
+| ENUM '(' enum_values ')' charset_opt
   {
-    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+    $$ = &ColumnType{Type: string($1), EnumValues: $3, Charset: $5}
   }
-| STATS_AUTO_RECALC equal_opt DEFAULT
+// need set_values / SetValues ?
+| SET '(' enum_values ')' charset_opt
   {
-    $$ = &TableOption{Name:string($1), String:string($3)}
+    $$ = &ColumnType{Type: string($1), EnumValues: $3, Charset: $5}
   }
-| STATS_PERSISTENT equal_opt INTEGRAL
+
+spatial_type:
+  GEOMETRY
   {
-    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+    $$ = &ColumnType{Type: string($1)}
   }
-| STATS_PERSISTENT equal_opt DEFAULT
+| POINT
   {
-    $$ = &TableOption{Name:string($1), String:string($3)}
+    $$ = &ColumnType{Type: string($1)}
   }
-| STATS_SAMPLE_PAGES equal_opt INTEGRAL
+| LINESTRING
   {
-    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+    $$ = &ColumnType{Type: string($1)}
   }
-| TABLESPACE equal_opt sql_id storage_opt
+| POLYGON
   {
-    $$ = &TableOption{Name:string($1), String: ($3.String() + $4)}
+    $$ = &ColumnType{Type: string($1)}
   }
-| UNION equal_opt '(' table_name_list ')'
+| GEOMETRYCOLLECTION
   {
-    $$ = &TableOption{Name:string($1), Tables: $4}
+    $$ = &ColumnType{Type: string($1)}
   }
-
-storage_opt:
+| MULTIPOINT
   {
-    $$ = ""
+    $$ = &ColumnType{Type: string($1)}
   }
-| STORAGE DISK
+| MULTILINESTRING
   {
-    $$ = " " + string($1) + " " + string($2)
+    $$ = &ColumnType{Type: string($1)}
   }
-| STORAGE MEMORY
+| MULTIPOLYGON
   {
-    $$ = " " + string($1) + " " + string($2)
+    $$ = &ColumnType{Type: string($1)}
   }
 
-row_format_options:
-  DEFAULT
-| DYNAMIC
-| FIXED
-| COMPRESSED
-| REDUNDANT
-| COMPACT
-
-insert_method_options:
-  NO
-| FIRST
-| LAST
-
-table_opt_value:
-  table_id '.' reserved_table_id
+enum_values:
+  STRING
   {
-    $$ = String(TableName{Qualifier: $1, Name: $3})
+    $$ = make([]string, 0, 4)
+    $$ = append($$, encodeSQLString($1))
   }
-| reserved_sql_id
+| enum_values ',' STRING
   {
-    $$ = $1.String()
+    $$ = append($1, encodeSQLString($3))
   }
-| STRING
+
+length_opt:
   {
-    $$ = encodeSQLString($1)
+    $$ = nil
   }
-| INTEGRAL
+| '(' INTEGRAL ')'
   {
-    $$ = string($1)
+    $$ = NewIntLiteral($2)
   }
 
-column_opt:
+double_length_opt:
   {
-    $$ = ""
+    $$ = LengthScaleOption{}
+  }
+| '(' INTEGRAL ',' INTEGRAL ')'
+  {
+    $$ = LengthScaleOption{
+        Length: NewIntLiteral($2),
+        Scale: NewIntLiteral($4),
+    }
   }
-| COLUMN
 
-first_opt:
+float_length_opt:
+double_length_opt
   {
-    $$ = false
+    $$ = $1
   }
-| FIRST
+| '(' INTEGRAL ')'
   {
-    $$ = true
+    $$ = LengthScaleOption{
+        Length: NewIntLiteral($2),
+    }
   }
 
-after_opt:
+decimal_length_opt:
   {
-    $$ = nil
+    $$ = LengthScaleOption{}
   }
-| AFTER column_name
+| '(' INTEGRAL ')'
   {
-    $$ = $2
+    $$ = LengthScaleOption{
+        Length: NewIntLiteral($2),
+    }
+  }
+| '(' INTEGRAL ',' INTEGRAL ')'
+  {
+    $$ = LengthScaleOption{
+        Length: NewIntLiteral($2),
+        Scale: NewIntLiteral($4),
+    }
   }
 
-expire_opt:
+unsigned_opt:
   {
-    $$ = ""
+    $$ = false
   }
-| EXPIRE STRING
+| UNSIGNED
   {
-    $$ = string($2)
+    $$ = true
   }
-
-ratio_opt:
+| SIGNED
   {
-    $$ = nil
+    $$ = false
   }
-| RATIO INTEGRAL
+
+zero_fill_opt:
   {
-    $$ = NewIntLiteral($2)
+    $$ = false
   }
-| RATIO DECIMAL
+| ZEROFILL
   {
-    $$ = NewDecimalLiteral($2)
+    $$ = true
   }
 
-alter_commands_list:
+charset_opt:
   {
-    $$ = nil
+    $$ = ColumnCharset{}
   }
-| alter_options
+| charset_or_character_set sql_id binary_opt
   {
-    $$ = $1
+    $$ = ColumnCharset{Name: string($2.String()), Binary: $3}
   }
-| alter_options ',' ORDER BY column_list
+| charset_or_character_set STRING binary_opt
   {
-    $$ = append($1,&OrderByOption{Cols:$5})
+    $$ = ColumnCharset{Name: encodeSQLString($2), Binary: $3}
   }
-| alter_commands_modifier_list
+| charset_or_character_set BINARY
   {
-    $$ = $1
+    $$ = ColumnCharset{Name: string($2)}
   }
-| alter_commands_modifier_list ',' alter_options
+| ASCII binary_opt
   {
-    $$ = append($1,$3...)
+    // ASCII: Shorthand for CHARACTER SET latin1.
+    $$ = ColumnCharset{Name: "latin1", Binary: $2}
   }
-| alter_commands_modifier_list ',' alter_options ',' ORDER BY column_list
+| UNICODE binary_opt
   {
-    $$ = append(append($1,$3...),&OrderByOption{Cols:$7})
+    // UNICODE: Shorthand for CHARACTER SET ucs2.
+    $$ = ColumnCharset{Name: "ucs2", Binary: $2}
   }
-
-alter_options:
-  alter_option
+| BINARY
   {
-    $$ = []AlterOption{$1}
+    // BINARY: Shorthand for default CHARACTER SET but with binary collation
+    $$ = ColumnCharset{Name: "", Binary: true}
   }
-| alter_options ',' alter_option
+| BINARY ASCII
   {
-    $$ = append($1,$3)
+    // BINARY ASCII: Shorthand for CHARACTER SET latin1 with binary collation
+    $$ = ColumnCharset{Name: "latin1", Binary: true}
   }
-| alter_options ',' alter_commands_modifier
+| BINARY UNICODE
   {
-    $$ = append($1,$3)
+    // BINARY UNICODE: Shorthand for CHARACTER SET ucs2 with binary collation
+    $$ = ColumnCharset{Name: "ucs2", Binary: true}
   }
 
-alter_option:
-  space_separated_table_option_list
+binary_opt:
   {
-    $$ = $1
+    $$ = false
   }
-| ADD check_constraint_definition
+| BINARY
   {
-    $$ = &AddConstraintDefinition{ConstraintDefinition: $2}
+    $$ = true
   }
-| ADD constraint_definition
+
+collate_opt:
   {
-    $$ = &AddConstraintDefinition{ConstraintDefinition: $2}
+    $$ = ""
   }
-| ADD index_definition
+| COLLATE ci_identifier
   {
-    $$ = &AddIndexDefinition{IndexDefinition: $2}
+    $$ = string($2.String())
   }
-| ADD column_opt '(' column_definition_list ')'
+| COLLATE STRING
   {
-    $$ = &AddColumns{Columns: $4}
+    $$ = encodeSQLString($2)
   }
-| ADD column_opt column_definition first_opt after_opt
+
+index_definition:
+  index_info '(' index_column_list ')' index_option_list_opt
   {
-    $$ = &AddColumns{Columns: []*ColumnDefinition{$3}, First:$4, After:$5}
+    $$ = &IndexDefinition{Info: $1, Columns: $3, Options: $5}
   }
-| ALTER column_opt column_name DROP DEFAULT
+
+index_option_list_opt:
   {
-    $$ = &AlterColumn{Column: $3, DropDefault:true}
+    $$ = nil
   }
-| ALTER column_opt column_name SET DEFAULT signed_literal_or_null
+| index_option_list
   {
-    $$ = &AlterColumn{Column: $3, DropDefault:false, DefaultVal:$6}
+    $$ = $1
   }
-| ALTER column_opt column_name SET DEFAULT openb expression closeb
+
+index_option_list:
+  index_option
   {
-	$$ = &AlterColumn{Column: $3, DropDefault:false, DefaultVal:$7}
+    $$ = []*IndexOption{$1}
   }
-| ALTER column_opt column_name SET VISIBLE
+| index_option_list index_option
   {
-    val := false
-    $$ = &AlterColumn{Column: $3, Invisible:&val}
+    $$ = append($$, $2)
   }
-| ALTER column_opt column_name SET INVISIBLE
+
+index_option:
+  using_index_type
   {
-    val := true
-    $$ = &AlterColumn{Column: $3, Invisible:&val}
+    $$ = $1
   }
-| ALTER CHECK ci_identifier enforced
+| KEY_BLOCK_SIZE equal_opt INTEGRAL
   {
-    $$ = &AlterCheck{Name: $3, Enforced: $4}
+    // should not be string
+    $$ = &IndexOption{Name: string($1), Value: NewIntLiteral($3)}
   }
-| ALTER INDEX ci_identifier VISIBLE
+| COMMENT_KEYWORD STRING
   {
-    $$ = &AlterIndex{Name: $3, Invisible: false}
+    $$ = &IndexOption{Name: string($1), Value: NewStrLiteral($2)}
   }
-| ALTER INDEX ci_identifier INVISIBLE
+| VISIBLE
   {
-    $$ = &AlterIndex{Name: $3, Invisible: true}
+    $$ = &IndexOption{Name: string($1) }
   }
-| CHANGE column_opt column_name column_definition first_opt after_opt
+| INVISIBLE
   {
-    $$ = &ChangeColumn{OldColumn:$3, NewColDefinition:$4, First:$5, After:$6}
+    $$ = &IndexOption{Name: string($1) }
   }
-| MODIFY column_opt column_definition first_opt after_opt
+| WITH PARSER ci_identifier
   {
-    $$ = &ModifyColumn{NewColDefinition:$3, First:$4, After:$5}
+    $$ = &IndexOption{Name: string($1) + " " + string($2), String: $3.String()}
   }
-| RENAME COLUMN column_name TO column_name
+| ENGINE_ATTRIBUTE equal_opt STRING
   {
-    $$ = &RenameColumn{OldName: $3, NewName: $5}
+    $$ = &IndexOption{Name: string($1), Value: NewStrLiteral($3)}
   }
-| CONVERT TO charset_or_character_set charset collate_opt
+| SECONDARY_ENGINE_ATTRIBUTE equal_opt STRING
   {
-    $$ = &AlterCharset{CharacterSet:$4, Collate:$5}
+    $$ = &IndexOption{Name: string($1), Value: NewStrLiteral($3)}
   }
-| DISABLE KEYS
+
+equal_opt:
+  /* empty */
   {
-    $$ = &KeyState{Enable:false}
+    $$ = ""
   }
-| ENABLE KEYS
+| '='
   {
-    $$ = &KeyState{Enable:true}
+    $$ = string($1)
   }
-| DISCARD TABLESPACE
+
+index_info:
+  constraint_name_opt PRIMARY KEY name_opt
   {
-    $$ = &TablespaceOperation{Import:false}
+    $$ = &IndexInfo{Type: string($2) + " " + string($3), ConstraintName: NewIdentifierCI($1), Name: NewIdentifierCI("PRIMARY"), Primary: true, Unique: true}
   }
-| IMPORT TABLESPACE
+| SPATIAL index_or_key_opt name_opt
   {
-    $$ = &TablespaceOperation{Import:true}
+    $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewIdentifierCI($3), Spatial: true, Unique: false}
   }
-| DROP column_opt column_name
+| FULLTEXT index_or_key_opt name_opt
   {
-    $$ = &DropColumn{Name:$3}
+    $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewIdentifierCI($3), Fulltext: true, Unique: false}
   }
-| DROP index_or_key ci_identifier
+| constraint_name_opt UNIQUE index_or_key_opt name_opt
   {
-    $$ = &DropKey{Type:NormalKeyType, Name:$3}
+    $$ = &IndexInfo{Type: string($2) + " " + string($3), ConstraintName: NewIdentifierCI($1), Name: NewIdentifierCI($4), Unique: true}
   }
-| DROP PRIMARY KEY
+| index_or_key name_opt
   {
-    $$ = &DropKey{Type:PrimaryKeyType}
+    $$ = &IndexInfo{Type: string($1), Name: NewIdentifierCI($2), Unique: false}
   }
-| DROP FOREIGN KEY ci_identifier
+
+constraint_name_opt:
   {
-    $$ = &DropKey{Type:ForeignKeyType, Name:$4}
+    $$ = ""
   }
-| DROP CHECK ci_identifier
+| CONSTRAINT name_opt
   {
-    $$ = &DropKey{Type:CheckKeyType, Name:$3}
+    $$ = $2
   }
-| DROP CONSTRAINT ci_identifier
+
+index_symbols:
+  INDEX
   {
-    $$ = &DropKey{Type:CheckKeyType, Name:$3}
+    $$ = string($1)
   }
-| FORCE
+| KEYS
   {
-    $$ = &Force{}
+    $$ = string($1)
   }
-| RENAME to_opt table_name
+| INDEXES
   {
-    $$ = &RenameTableName{Table:$3}
+    $$ = string($1)
   }
-| RENAME index_or_key ci_identifier TO ci_identifier
+
+from_or_in:
+  FROM
   {
-    $$ = &RenameIndex{OldName:$3, NewName:$5}
+    $$ = string($1)
+  }
+| IN
+  {
+    $$ = string($1)
   }
 
-alter_commands_modifier_list:
-  alter_commands_modifier
+index_or_key_opt:
   {
-    $$ = []AlterOption{$1}
+    $$ = "key"
   }
-| alter_commands_modifier_list ',' alter_commands_modifier
+| index_or_key
   {
-    $$ = append($1,$3)
+    $$ = $1
   }
 
-alter_commands_modifier:
-  ALGORITHM equal_opt DEFAULT
-    {
-      $$ = AlgorithmValue(string($3))
-    }
-  | ALGORITHM equal_opt INPLACE
-    {
-      $$ = AlgorithmValue(string($3))
-    }
-  | ALGORITHM equal_opt COPY
-    {
-      $$ = AlgorithmValue(string($3))
-    }
-  | ALGORITHM equal_opt INSTANT
-    {
-      $$ = AlgorithmValue(string($3))
-    }
-  | LOCK equal_opt DEFAULT
-    {
-      $$ = &LockOption{Type:DefaultType}
-    }
-  | LOCK equal_opt NONE
-    {
-      $$ = &LockOption{Type:NoneType}
-    }
-  | LOCK equal_opt SHARED
-    {
-      $$ = &LockOption{Type:SharedType}
-    }
-  | LOCK equal_opt EXCLUSIVE
-    {
-      $$ = &LockOption{Type:ExclusiveType}
-    }
-  | WITH VALIDATION
-    {
-      $$ = &Validation{With:true}
-    }
-  | WITHOUT VALIDATION
-    {
-      $$ = &Validation{With:false}
-    }
+index_or_key:
+  INDEX
+  {
+    $$ = string($1)
+  }
+  | KEY
+  {
+    $$ = string($1)
+  }
 
-alter_statement:
-  alter_table_prefix alter_commands_list partitions_options_opt
+name_opt:
   {
-    $1.FullyParsed = true
-    $1.AlterOptions = $2
-    $1.PartitionOption = $3
-    $$ = $1
+    $$ = ""
   }
-| alter_table_prefix alter_commands_list REMOVE PARTITIONING
+| ci_identifier
+  {
+    $$ = string($1.String())
+  }
+
+index_column_list:
+  index_column
+  {
+    $$ = []*IndexColumn{$1}
+  }
+| index_column_list ',' index_column
+  {
+    $$ = append($$, $3)
+  }
+
+index_column:
+  sql_id length_opt asc_desc_opt
+  {
+    $$ = &IndexColumn{Column: $1, Length: $2, Direction: $3}
+  }
+| openb expression closeb asc_desc_opt
+  {
+    $$ = &IndexColumn{Expression: $2, Direction: $4}
+  }
+
+constraint_definition:
+  CONSTRAINT ci_identifier_opt constraint_info
+  {
+    $$ = &ConstraintDefinition{Name: $2, Details: $3}
+  }
+|  constraint_info
+  {
+    $$ = &ConstraintDefinition{Details: $1}
+  }
+
+check_constraint_definition:
+  CONSTRAINT ci_identifier_opt check_constraint_info
+  {
+    $$ = &ConstraintDefinition{Name: $2, Details: $3}
+  }
+|  check_constraint_info
+  {
+    $$ = &ConstraintDefinition{Details: $1}
+  }
+
+constraint_info:
+  FOREIGN KEY name_opt '(' column_list ')' reference_definition
+  {
+    $$ = &ForeignKeyDefinition{IndexName: NewIdentifierCI($3), Source: $5, ReferenceDefinition: $7}
+  }
+
+reference_definition:
+  REFERENCES table_name '(' column_list ')' fk_match_opt
+  {
+    $$ = &ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6}
+  }
+| REFERENCES table_name '(' column_list ')' fk_match_opt fk_on_delete
+  {
+    $$ = &ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6, OnDelete: $7}
+  }
+| REFERENCES table_name '(' column_list ')' fk_match_opt fk_on_update
+  {
+    $$ = &ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6, OnUpdate: $7}
+  }
+| REFERENCES table_name '(' column_list ')' fk_match_opt fk_on_delete fk_on_update
+  {
+    $$ = &ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6, OnDelete: $7, OnUpdate: $8}
+  }
+| REFERENCES table_name '(' column_list ')' fk_match_opt fk_on_update fk_on_delete
+  {
+    $$ = &ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6, OnUpdate: $7, OnDelete: $8}
+  }
+
+reference_definition_opt:
+  {
+    $$ = nil
+  }
+| reference_definition
   {
-    $1.FullyParsed = true
-    $1.AlterOptions = $2
-    $1.PartitionSpec = &PartitionSpec{Action:RemoveAction}
     $$ = $1
   }
-| alter_table_prefix alter_commands_modifier_list ',' partition_operation
+
+check_constraint_info:
+  CHECK '(' expression ')' enforced_opt
+  {
+    $$ = &CheckConstraintDefinition{Expr: $3, Enforced: $5}
+  }
+
+fk_match:
+  MATCH fk_match_action
+  {
+    $$ = $2
+  }
+
+fk_match_action:
+  FULL
+  {
+    $$ = Full
+  }
+| PARTIAL
+  {
+    $$ = Partial
+  }
+| SIMPLE
+  {
+    $$ = Simple
+  }
+
+fk_match_opt:
+  {
+    $$ = DefaultMatch
+  }
+| fk_match
   {
-    $1.FullyParsed = true
-    $1.AlterOptions = $2
-    $1.PartitionSpec = $4
     $$ = $1
   }
-| alter_table_prefix partition_operation
+
+fk_on_delete:
+  ON DELETE fk_reference_action
+  {
+    $$ = $3
+  }
+
+fk_on_update:
+  ON UPDATE fk_reference_action
+  {
+    $$ = $3
+  }
+
+fk_reference_action:
+  RESTRICT
+  {
+    $$ = Restrict
+  }
+| CASCADE
+  {
+    $$ = Cascade
+  }
+| NO ACTION
+  {
+    $$ = NoAction
+  }
+| SET DEFAULT
+  {
+    $$ = SetDefault
+  }
+| SET NULL
+  {
+    $$ = SetNull
+  }
+
+restrict_or_cascade_opt:
+  {
+    $$ = ""
+  }
+| RESTRICT
+  {
+    $$ = string($1)
+  }
+| CASCADE
+  {
+    $$ = string($1)
+  }
+
+enforced:
+  ENFORCED
+  {
+    $$ = true
+  }
+| NOT ENFORCED
+  {
+    $$ = false
+  }
+
+enforced_opt:
+  {
+    $$ = true
+  }
+| enforced
+  {
+    $$ = $1
+  }
+
+table_option_list_opt:
+  {
+    $$ = nil
+  }
+| table_option_list
+  {
+    $$ = $1
+  }
+
+table_option_list:
+  table_option
+  {
+    $$ = TableOptions{$1}
+  }
+| table_option_list ',' table_option
+  {
+    $$ = append($1,$3)
+  }
+| table_option_list table_option
+  {
+    $$ = append($1,$2)
+  }
+
+space_separated_table_option_list:
+  table_option
+  {
+    $$ = TableOptions{$1}
+  }
+| space_separated_table_option_list table_option
+  {
+    $$ = append($1,$2)
+  }
+
+table_option:
+  AUTO_INCREMENT equal_opt INTEGRAL
+  {
+    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+  }
+| AUTOEXTEND_SIZE equal_opt INTEGRAL
+  {
+    $$ = &TableOption{Name: string($1), Value: NewIntLiteral($3)}
+  }
+| AVG_ROW_LENGTH equal_opt INTEGRAL
+  {
+    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+  }
+| default_optional charset_or_character_set equal_opt charset
+  {
+    $$ = &TableOption{Name:(string($2)), String:$4, CaseSensitive: true}
+  }
+| default_optional COLLATE equal_opt charset
+  {
+    $$ = &TableOption{Name:string($2), String:$4, CaseSensitive: true}
+  }
+| CHECKSUM equal_opt INTEGRAL
+  {
+    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+  }
+| COMMENT_KEYWORD equal_opt STRING
+  {
+    $$ = &TableOption{Name:string($1), Value:NewStrLiteral($3)}
+  }
+| COMPRESSION equal_opt STRING
+  {
+    $$ = &TableOption{Name:string($1), Value:NewStrLiteral($3)}
+  }
+| CONNECTION equal_opt STRING
+  {
+    $$ = &TableOption{Name:string($1), Value:NewStrLiteral($3)}
+  }
+| DATA DIRECTORY equal_opt STRING
+  {
+    $$ = &TableOption{Name:(string($1)+" "+string($2)), Value:NewStrLiteral($4)}
+  }
+| INDEX DIRECTORY equal_opt STRING
+  {
+    $$ = &TableOption{Name:(string($1)+" "+string($2)), Value:NewStrLiteral($4)}
+  }
+| DELAY_KEY_WRITE equal_opt INTEGRAL
+  {
+    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+  }
+| ENCRYPTION equal_opt STRING
+  {
+    $$ = &TableOption{Name:string($1), Value:NewStrLiteral($3)}
+  }
+| ENGINE equal_opt table_alias
+  {
+    $$ = &TableOption{Name:string($1), String:$3.String(), CaseSensitive: true}
+  }
+| ENGINE_ATTRIBUTE equal_opt STRING
+  {
+    $$ = &TableOption{Name: string($1), Value: NewStrLiteral($3)}
+  }
+| INSERT_METHOD equal_opt insert_method_options
+  {
+    $$ = &TableOption{Name:string($1), String:string($3)}
+  }
+| KEY_BLOCK_SIZE equal_opt INTEGRAL
+  {
+    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+  }
+| MAX_ROWS equal_opt INTEGRAL
+  {
+    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+  }
+| MIN_ROWS equal_opt INTEGRAL
+  {
+    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+  }
+| PACK_KEYS equal_opt INTEGRAL
+  {
+    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+  }
+| PACK_KEYS equal_opt DEFAULT
+  {
+    $$ = &TableOption{Name:string($1), String:string($3)}
+  }
+| PASSWORD equal_opt STRING
+  {
+    $$ = &TableOption{Name:string($1), Value:NewStrLiteral($3)}
+  }
+| ROW_FORMAT equal_opt row_format_options
+  {
+    $$ = &TableOption{Name:string($1), String:string($3)}
+  }
+| SECONDARY_ENGINE_ATTRIBUTE equal_opt STRING
+  {
+    $$ = &TableOption{Name: string($1), Value: NewStrLiteral($3)}
+  }
+| STATS_AUTO_RECALC equal_opt INTEGRAL
+  {
+    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+  }
+| STATS_AUTO_RECALC equal_opt DEFAULT
+  {
+    $$ = &TableOption{Name:string($1), String:string($3)}
+  }
+| STATS_PERSISTENT equal_opt INTEGRAL
+  {
+    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+  }
+| STATS_PERSISTENT equal_opt DEFAULT
+  {
+    $$ = &TableOption{Name:string($1), String:string($3)}
+  }
+| STATS_SAMPLE_PAGES equal_opt INTEGRAL
+  {
+    $$ = &TableOption{Name:string($1), Value:NewIntLiteral($3)}
+  }
+| TABLESPACE equal_opt sql_id storage_opt
+  {
+    $$ = &TableOption{Name:string($1), String: ($3.String() + $4)}
+  }
+| UNION equal_opt '(' table_name_list ')'
+  {
+    $$ = &TableOption{Name:string($1), Tables: $4}
+  }
+
+storage_opt:
+  {
+    $$ = ""
+  }
+| STORAGE DISK
+  {
+    $$ = " " + string($1) + " " + string($2)
+  }
+| STORAGE MEMORY
+  {
+    $$ = " " + string($1) + " " + string($2)
+  }
+
+row_format_options:
+  DEFAULT
+| DYNAMIC
+| FIXED
+| COMPRESSED
+| REDUNDANT
+| COMPACT
+
+insert_method_options:
+  NO
+| FIRST
+| LAST
+
+table_opt_value:
+  table_id '.' reserved_table_id
+  {
+    $$ = String(TableName{Qualifier: $1, Name: $3})
+  }
+| reserved_sql_id
+  {
+    $$ = $1.String()
+  }
+| STRING
+  {
+    $$ = encodeSQLString($1)
+  }
+| INTEGRAL
+  {
+    $$ = string($1)
+  }
+
+column_opt:
+  {
+    $$ = ""
+  }
+| COLUMN
+
+first_opt:
+  {
+    $$ = false
+  }
+| FIRST
+  {
+    $$ = true
+  }
+
+after_opt:
+  {
+    $$ = nil
+  }
+| AFTER column_name
+  {
+    $$ = $2
+  }
+
+expire_opt:
+  {
+    $$ = ""
+  }
+| EXPIRE STRING
+  {
+    $$ = string($2)
+  }
+
+ratio_opt:
+  {
+    $$ = nil
+  }
+| RATIO INTEGRAL
+  {
+    $$ = NewIntLiteral($2)
+  }
+| RATIO DECIMAL
+  {
+    $$ = NewDecimalLiteral($2)
+  }
+
+alter_commands_list:
+  {
+    $$ = nil
+  }
+| alter_options
+  {
+    $$ = $1
+  }
+| alter_options ',' ORDER BY column_list
+  {
+    $$ = append($1,&OrderByOption{Cols:$5})
+  }
+| alter_commands_modifier_list
+  {
+    $$ = $1
+  }
+| alter_commands_modifier_list ',' alter_options
+  {
+    $$ = append($1,$3...)
+  }
+| alter_commands_modifier_list ',' alter_options ',' ORDER BY column_list
+  {
+    $$ = append(append($1,$3...),&OrderByOption{Cols:$7})
+  }
+
+alter_options:
+  alter_option
+  {
+    $$ = []AlterOption{$1}
+  }
+| alter_options ',' alter_option
+  {
+    $$ = append($1,$3)
+  }
+| alter_options ',' alter_commands_modifier
+  {
+    $$ = append($1,$3)
+  }
+
+alter_option:
+  space_separated_table_option_list
+  {
+    $$ = $1
+  }
+| ADD check_constraint_definition
+  {
+    $$ = &AddConstraintDefinition{ConstraintDefinition: $2}
+  }
+| ADD constraint_definition
+  {
+    $$ = &AddConstraintDefinition{ConstraintDefinition: $2}
+  }
+| ADD index_definition
+  {
+    $$ = &AddIndexDefinition{IndexDefinition: $2}
+  }
+| ADD column_opt '(' column_definition_list ')'
+  {
+    $$ = &AddColumns{Columns: $4}
+  }
+| ADD column_opt column_definition first_opt after_opt
+  {
+    $$ = &AddColumns{Columns: []*ColumnDefinition{$3}, First:$4, After:$5}
+  }
+| ALTER column_opt column_name DROP DEFAULT
+  {
+    $$ = &AlterColumn{Column: $3, DropDefault:true}
+  }
+| ALTER column_opt column_name SET DEFAULT signed_literal_or_null
+  {
+    $$ = &AlterColumn{Column: $3, DropDefault:false, DefaultVal:$6}
+  }
+| ALTER column_opt column_name SET DEFAULT openb expression closeb
+  {
+	$$ = &AlterColumn{Column: $3, DropDefault:false, DefaultVal:$7}
+  }
+| ALTER column_opt column_name SET VISIBLE
+  {
+    val := false
+    $$ = &AlterColumn{Column: $3, Invisible:&val}
+  }
+| ALTER column_opt column_name SET INVISIBLE
+  {
+    val := true
+    $$ = &AlterColumn{Column: $3, Invisible:&val}
+  }
+| ALTER CHECK ci_identifier enforced
+  {
+    $$ = &AlterCheck{Name: $3, Enforced: $4}
+  }
+| ALTER INDEX ci_identifier VISIBLE
+  {
+    $$ = &AlterIndex{Name: $3, Invisible: false}
+  }
+| ALTER INDEX ci_identifier INVISIBLE
+  {
+    $$ = &AlterIndex{Name: $3, Invisible: true}
+  }
+| CHANGE column_opt column_name column_definition first_opt after_opt
+  {
+    $$ = &ChangeColumn{OldColumn:$3, NewColDefinition:$4, First:$5, After:$6}
+  }
+| MODIFY column_opt column_definition first_opt after_opt
+  {
+    $$ = &ModifyColumn{NewColDefinition:$3, First:$4, After:$5}
+  }
+| RENAME COLUMN column_name TO column_name
+  {
+    $$ = &RenameColumn{OldName: $3, NewName: $5}
+  }
+| CONVERT TO charset_or_character_set charset collate_opt
+  {
+    $$ = &AlterCharset{CharacterSet:$4, Collate:$5}
+  }
+| DISABLE KEYS
+  {
+    $$ = &KeyState{Enable:false}
+  }
+| ENABLE KEYS
+  {
+    $$ = &KeyState{Enable:true}
+  }
+| DISCARD TABLESPACE
+  {
+    $$ = &TablespaceOperation{Import:false}
+  }
+| IMPORT TABLESPACE
+  {
+    $$ = &TablespaceOperation{Import:true}
+  }
+| DROP column_opt column_name
+  {
+    $$ = &DropColumn{Name:$3}
+  }
+| DROP index_or_key ci_identifier
+  {
+    $$ = &DropKey{Type:NormalKeyType, Name:$3}
+  }
+| DROP PRIMARY KEY
+  {
+    $$ = &DropKey{Type:PrimaryKeyType}
+  }
+| DROP FOREIGN KEY ci_identifier
+  {
+    $$ = &DropKey{Type:ForeignKeyType, Name:$4}
+  }
+| DROP CHECK ci_identifier
+  {
+    $$ = &DropKey{Type:CheckKeyType, Name:$3}
+  }
+| DROP CONSTRAINT ci_identifier
+  {
+    $$ = &DropKey{Type:CheckKeyType, Name:$3}
+  }
+| FORCE
+  {
+    $$ = &Force{}
+  }
+| RENAME to_opt table_name
+  {
+    $$ = &RenameTableName{Table:$3}
+  }
+| RENAME index_or_key ci_identifier TO ci_identifier
+  {
+    $$ = &RenameIndex{OldName:$3, NewName:$5}
+  }
+
+alter_commands_modifier_list:
+  alter_commands_modifier
+  {
+    $$ = []AlterOption{$1}
+  }
+| alter_commands_modifier_list ',' alter_commands_modifier
+  {
+    $$ = append($1,$3)
+  }
+
+alter_commands_modifier:
+  ALGORITHM equal_opt DEFAULT
+    {
+      $$ = AlgorithmValue(string($3))
+    }
+  | ALGORITHM equal_opt INPLACE
+    {
+      $$ = AlgorithmValue(string($3))
+    }
+  | ALGORITHM equal_opt COPY
+    {
+      $$ = AlgorithmValue(string($3))
+    }
+  | ALGORITHM equal_opt INSTANT
+    {
+      $$ = AlgorithmValue(string($3))
+    }
+  | LOCK equal_opt DEFAULT
+    {
+      $$ = &LockOption{Type:DefaultType}
+    }
+  | LOCK equal_opt NONE
+    {
+      $$ = &LockOption{Type:NoneType}
+    }
+  | LOCK equal_opt SHARED
+    {
+      $$ = &LockOption{Type:SharedType}
+    }
+  | LOCK equal_opt EXCLUSIVE
+    {
+      $$ = &LockOption{Type:ExclusiveType}
+    }
+  | WITH VALIDATION
+    {
+      $$ = &Validation{With:true}
+    }
+  | WITHOUT VALIDATION
+    {
+      $$ = &Validation{With:false}
+    }
+
+alter_statement:
+  alter_table_prefix alter_commands_list partitions_options_opt
+  {
+    $1.FullyParsed = true
+    $1.AlterOptions = $2
+    $1.PartitionOption = $3
+    $$ = $1
+  }
+| alter_table_prefix alter_commands_list REMOVE PARTITIONING
+  {
+    $1.FullyParsed = true
+    $1.AlterOptions = $2
+    $1.PartitionSpec = &PartitionSpec{Action:RemoveAction}
+    $$ = $1
+  }
+| alter_table_prefix alter_commands_modifier_list ',' partition_operation
+  {
+    $1.FullyParsed = true
+    $1.AlterOptions = $2
+    $1.PartitionSpec = $4
+    $$ = $1
+  }
+| alter_table_prefix partition_operation
+  {
+    $1.FullyParsed = true
+    $1.PartitionSpec = $2
+    $$ = $1
+  }
+| ALTER comment_opt algorithm_view definer_opt security_view_opt VIEW table_name column_list_opt AS select_statement check_option_opt
+  {
+    $$ = &AlterView{ViewName: $7, Comments: Comments($2).Parsed(), Algorithm:$3, Definer: $4 ,Security:$5, Columns:$8, Select: $10, CheckOption: $11 }
+  }
+// The syntax here causes a shift / reduce issue, because ENCRYPTION is a non reserved keyword
+// and the database identifier is optional. When no identifier is given, the current database
+// is used. This means though that `alter database encryption` is ambiguous whether it means
+// the encryption keyword, or the encryption database name, resulting in the conflict.
+// The preference here is to shift, so it is treated as a database name. This matches the MySQL
+// behavior as well.
+| alter_database_prefix table_id_opt create_options
+  {
+    $1.FullyParsed = true
+    $1.DBName = $2
+    $1.AlterOptions = $3
+    $$ = $1
+  }
+| alter_database_prefix table_id UPGRADE DATA DIRECTORY NAME
+  {
+    $1.FullyParsed = true
+    $1.DBName = $2
+    $1.UpdateDataDirectory = true
+    $$ = $1
+  }
+| ALTER comment_opt VSCHEMA CREATE VINDEX table_name vindex_type_opt vindex_params_opt
+  {
+    $$ = &AlterVschema{
+        Action: CreateVindexDDLAction,
+        Table: $6,
+        VindexSpec: &VindexSpec{
+          Name: NewIdentifierCI($6.Name.String()),
+          Type: $7,
+          Params: $8,
+        },
+      }
+  }
+| ALTER comment_opt VSCHEMA DROP VINDEX table_name
+  {
+    $$ = &AlterVschema{
+        Action: DropVindexDDLAction,
+        Table: $6,
+        VindexSpec: &VindexSpec{
+          Name: NewIdentifierCI($6.Name.String()),
+        },
+      }
+  }
+| ALTER comment_opt VSCHEMA ADD TABLE table_name
+  {
+    $$ = &AlterVschema{Action: AddVschemaTableDDLAction, Table: $6}
+  }
+| ALTER comment_opt VSCHEMA DROP TABLE table_name
+  {
+    $$ = &AlterVschema{Action: DropVschemaTableDDLAction, Table: $6}
+  }
+| ALTER comment_opt VSCHEMA ON table_name ADD VINDEX sql_id '(' column_list ')' vindex_type_opt vindex_params_opt
+  {
+    $$ = &AlterVschema{
+        Action: AddColVindexDDLAction,
+        Table: $5,
+        VindexSpec: &VindexSpec{
+            Name: $8,
+            Type: $12,
+            Params: $13,
+        },
+        VindexCols: $10,
+      }
+  }
+| ALTER comment_opt VSCHEMA ON table_name DROP VINDEX sql_id
+  {
+    $$ = &AlterVschema{
+        Action: DropColVindexDDLAction,
+        Table: $5,
+        VindexSpec: &VindexSpec{
+            Name: $8,
+        },
+      }
+  }
+| ALTER comment_opt VSCHEMA ADD SEQUENCE table_name
+  {
+    $$ = &AlterVschema{Action: AddSequenceDDLAction, Table: $6}
+  }
+| ALTER comment_opt VSCHEMA ON table_name ADD AUTO_INCREMENT sql_id USING table_name
+  {
+    $$ = &AlterVschema{
+        Action: AddAutoIncDDLAction,
+        Table: $5,
+        AutoIncSpec: &AutoIncSpec{
+            Column: $8,
+            Sequence: $10,
+        },
+    }
+  }
+| ALTER comment_opt VITESS_MIGRATION STRING RETRY
+  {
+    $$ = &AlterMigration{
+      Type: RetryMigrationType,
+      UUID: string($4),
+    }
+  }
+| ALTER comment_opt VITESS_MIGRATION STRING CLEANUP
+  {
+    $$ = &AlterMigration{
+      Type: CleanupMigrationType,
+      UUID: string($4),
+    }
+  }
+| ALTER comment_opt VITESS_MIGRATION STRING LAUNCH
+  {
+    $$ = &AlterMigration{
+      Type: LaunchMigrationType,
+      UUID: string($4),
+    }
+  }
+| ALTER comment_opt VITESS_MIGRATION STRING LAUNCH VITESS_SHARDS STRING
+  {
+    $$ = &AlterMigration{
+      Type: LaunchMigrationType,
+      UUID: string($4),
+      Shards: string($7),
+    }
+  }
+| ALTER comment_opt VITESS_MIGRATION LAUNCH ALL
+  {
+    $$ = &AlterMigration{
+      Type: LaunchAllMigrationType,
+    }
+  }
+| ALTER comment_opt VITESS_MIGRATION STRING COMPLETE
+  {
+    $$ = &AlterMigration{
+      Type: CompleteMigrationType,
+      UUID: string($4),
+    }
+  }
+| ALTER comment_opt VITESS_MIGRATION COMPLETE ALL
+  {
+    $$ = &AlterMigration{
+      Type: CompleteAllMigrationType,
+    }
+  }
+| ALTER comment_opt VITESS_MIGRATION STRING CANCEL
+  {
+    $$ = &AlterMigration{
+      Type: CancelMigrationType,
+      UUID: string($4),
+    }
+  }
+| ALTER comment_opt VITESS_MIGRATION CANCEL ALL
+  {
+    $$ = &AlterMigration{
+      Type: CancelAllMigrationType,
+    }
+  }
+| ALTER comment_opt VITESS_MIGRATION STRING THROTTLE expire_opt ratio_opt
+  {
+    $$ = &AlterMigration{
+      Type: ThrottleMigrationType,
+      UUID: string($4),
+      Expire: $6,
+      Ratio: $7,
+    }
+  }
+| ALTER comment_opt VITESS_MIGRATION THROTTLE ALL expire_opt ratio_opt
+  {
+    $$ = &AlterMigration{
+      Type: ThrottleAllMigrationType,
+      Expire: $6,
+      Ratio: $7,
+    }
+  }
+| ALTER comment_opt VITESS_MIGRATION STRING UNTHROTTLE
+  {
+    $$ = &AlterMigration{
+      Type: UnthrottleMigrationType,
+      UUID: string($4),
+    }
+  }
+| ALTER comment_opt VITESS_MIGRATION UNTHROTTLE ALL
+  {
+    $$ = &AlterMigration{
+      Type: UnthrottleAllMigrationType,
+    }
+  }
+| ALTER comment_opt PROCEDURE table_name routine_characteristic_list_opt
+  {
+    $$ = &AlterProcedure{Comments: Comments($2).Parsed(), Name: $4, Characteristics: $5}
+  }
+| ALTER comment_opt FUNCTION table_name routine_characteristic_list_opt
+  {
+    $$ = &AlterFunction{Comments: Comments($2).Parsed(), Name: $4, Characteristics: $5}
+  }
+| ALTER comment_opt USER exists_opt alter_user_spec_list password_expire_opt account_lock_opt
+  {
+    $$ = &AlterUser{IfExists: $4, Users: $5, PasswordExpire: $6, AccountLock: $7}
+  }
+| ALTER comment_opt USER exists_opt USER '(' ')' alter_authentication_opt
+  {
+    $$ = &AlterUser{IfExists: $4, Users: []*UserSpec{{Account: &Account{CurrentUser: true}, Auth: $8}}}
+  }
+| alter_event_prefix event_on_completion_opt event_rename_opt event_status_opt event_comment_opt event_do_opt
+  {
+    $1.OnCompletion = $2
+    $1.RenameTo = $3
+    $1.Status = $4
+    $1.Comment = $5
+    $1.Body = $6
+    $$ = $1
+  }
+// ON SCHEDULE and ON COMPLETION are both optional, so the schedule is not
+// a separate optional rule to avoid a shift / reduce conflict on ON.
+| alter_event_prefix ON SCHEDULE event_schedule event_on_completion_opt event_rename_opt event_status_opt event_comment_opt event_do_opt
+  {
+    $1.Schedule = $4
+    $1.OnCompletion = $5
+    $1.RenameTo = $6
+    $1.Status = $7
+    $1.Comment = $8
+    $1.Body = $9
+    $$ = $1
+  }
+
+alter_event_prefix:
+  ALTER comment_opt algorithm_view definer_opt EVENT table_name
+  {
+    // ALGORITHM is only accepted to share the prefix with ALTER VIEW
+    if $3 != "" {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = &AlterEvent{Comments: Comments($2).Parsed(), Definer: $4, Name: $6}
+  }
+
+partitions_options_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY partitions_options_beginning partitions_opt subpartition_opt partition_definitions_opt
+    {
+      $3.Partitions = $4
+      $3.SubPartition = $5
+      $3.Definitions = $6
+      widenSpan(yylex, $3)
+      $$ = $3
+    }
+
+partitions_options_beginning:
+  linear_opt HASH '(' expression ')'
+    {
+      $$ = &PartitionOption {
+        IsLinear: $1,
+        Type: HashType,
+        Expr: $4,
+      }
+    }
+| linear_opt KEY algorithm_opt '(' column_list_empty ')'
+    {
+      $$ = &PartitionOption {
+        IsLinear: $1,
+        Type: KeyType,
+        KeyAlgorithm: $3,
+        ColList: $5,
+      }
+    }
+| range_or_list '(' expression ')'
+    {
+      $$ = &PartitionOption {
+        Type: $1,
+        Expr: $3,
+      }
+    }
+| range_or_list COLUMNS '(' column_list ')'
+  {
+    $$ = &PartitionOption {
+        Type: $1,
+        ColList: $4,
+    }
+  }
+
+subpartition_opt:
+  {
+    $$ = nil
+  }
+| SUBPARTITION BY linear_opt HASH '(' expression ')' subpartitions_opt
+  {
+    $$ = &SubPartition {
+      IsLinear: $3,
+      Type: HashType,
+      Expr: $6,
+      SubPartitions: $8,
+    }
+  }
+| SUBPARTITION BY linear_opt KEY algorithm_opt '(' column_list ')' subpartitions_opt
+  {
+    $$ = &SubPartition {
+      IsLinear: $3,
+      Type: KeyType,
+      KeyAlgorithm: $5,
+      ColList: $7,
+      SubPartitions: $9,
+    }
+  }
+
+partition_definitions_opt:
+  {
+    $$ = nil
+  }
+| '(' partition_definitions ')'
+  {
+    $$ = $2
+  }
+
+linear_opt:
+  {
+    $$ = false
+  }
+| LINEAR
+  {
+    $$ = true
+  }
+
+algorithm_opt:
+  {
+    $$ = 0
+  }
+| ALGORITHM '=' INTEGRAL
+  {
+    $$ = convertStringToInt($3)
+  }
+
+json_table_function:
+  JSON_TABLE openb expression ',' text_literal_or_arg jt_columns_clause closeb as_opt_id
+  {
+    $$ = &JSONTableExpr{Expr: $3, Filter: $5, Columns: $6, Alias: $8}
+  }
+
+jt_columns_clause:
+  COLUMNS openb columns_list closeb
+  {
+    $$= $3
+  }
+
+columns_list:
+  jt_column
+  {
+    $$= []*JtColumnDefinition{$1}
+  }
+| columns_list ',' jt_column
+  {
+    $$ = append($1, $3)
+  }
+
+jt_column:
+ sql_id FOR ORDINALITY
+  {
+    $$ = &JtColumnDefinition{JtOrdinal: &JtOrdinalColDef{Name: $1}}
+  }
+| sql_id column_type collate_opt jt_exists_opt PATH text_literal_or_arg
+  {
+    $2.Options= &ColumnTypeOptions{Collate:$3}
+    jtPath := &JtPathColDef{Name: $1, Type: $2, JtColExists: $4, Path: $6}
+    $$ = &JtColumnDefinition{JtPath: jtPath}
+  }
+| sql_id column_type collate_opt jt_exists_opt PATH text_literal_or_arg on_empty
+  {
+    $2.Options= &ColumnTypeOptions{Collate:$3}
+    jtPath := &JtPathColDef{Name: $1, Type: $2, JtColExists: $4, Path: $6, EmptyOnResponse: $7}
+    $$ = &JtColumnDefinition{JtPath: jtPath}
+  }
+| sql_id column_type collate_opt jt_exists_opt PATH text_literal_or_arg on_error
+  {
+    $2.Options= &ColumnTypeOptions{Collate:$3}
+    jtPath := &JtPathColDef{Name: $1, Type: $2, JtColExists: $4, Path: $6, ErrorOnResponse: $7}
+    $$ = &JtColumnDefinition{JtPath: jtPath}
+  }
+| sql_id column_type collate_opt jt_exists_opt PATH text_literal_or_arg on_empty on_error
+  {
+    $2.Options= &ColumnTypeOptions{Collate:$3}
+    jtPath := &JtPathColDef{Name: $1, Type: $2, JtColExists: $4, Path: $6, EmptyOnResponse: $7, ErrorOnResponse: $8}
+    $$ = &JtColumnDefinition{JtPath: jtPath}
+  }
+| NESTED jt_path_opt text_literal_or_arg jt_columns_clause
+  {
+    jtNestedPath := &JtNestedPathColDef{Path: $3, Columns: $4}
+    $$ = &JtColumnDefinition{JtNestedPath: jtNestedPath}
+  }
+
+jt_path_opt:
+  {
+    $$ = false
+  }
+| PATH
+  {
+    $$ = true
+  }
+jt_exists_opt:
+  {
+    $$=false
+  }
+| EXISTS
+  {
+    $$=true
+  }
+
+on_empty:
+  json_on_response ON EMPTY
+  {
+    $$= $1
+  }
+
+on_error:
+  json_on_response ON ERROR
+  {
+    $$= $1
+  }
+
+json_on_response:
+  ERROR
+  {
+    $$ = &JtOnResponse{ResponseType: ErrorJSONType}
+  }
+| NULL
+  {
+    $$ = &JtOnResponse{ResponseType: NullJSONType}
+  }
+| DEFAULT text_literal_or_arg
+  {
+    $$ = &JtOnResponse{ResponseType: DefaultJSONType, Expr: $2}
+  }
+
+range_or_list:
+  RANGE
+  {
+    $$ = RangeType
+  }
+| LIST
+  {
+    $$ = ListType
+  }
+
+partitions_opt:
+  {
+    $$ = -1
+  }
+| PARTITIONS INTEGRAL
+  {
+    $$ = convertStringToInt($2)
+  }
+
+subpartitions_opt:
+  {
+    $$ = -1
+  }
+| SUBPARTITIONS INTEGRAL
+  {
+    $$ = convertStringToInt($2)
+  }
+
+partition_operation:
+  ADD PARTITION '(' partition_definition ')'
+  {
+    $$ = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{$4}}
+  }
+| DROP PARTITION partition_list
+  {
+    $$ = &PartitionSpec{Action:DropAction, Names:$3}
+  }
+| REORGANIZE PARTITION partition_list INTO openb partition_definitions closeb
+  {
+    $$ = &PartitionSpec{Action: ReorganizeAction, Names: $3, Definitions: $6}
+  }
+| DISCARD PARTITION partition_list TABLESPACE
+  {
+    $$ = &PartitionSpec{Action:DiscardAction, Names:$3}
+  }
+| DISCARD PARTITION ALL TABLESPACE
+  {
+    $$ = &PartitionSpec{Action:DiscardAction, IsAll:true}
+  }
+| IMPORT PARTITION partition_list TABLESPACE
+  {
+    $$ = &PartitionSpec{Action:ImportAction, Names:$3}
+  }
+| IMPORT PARTITION ALL TABLESPACE
+  {
+    $$ = &PartitionSpec{Action:ImportAction, IsAll:true}
+  }
+| TRUNCATE PARTITION partition_list
+  {
+    $$ = &PartitionSpec{Action:TruncateAction, Names:$3}
+  }
+| TRUNCATE PARTITION ALL
+  {
+    $$ = &PartitionSpec{Action:TruncateAction, IsAll:true}
+  }
+| COALESCE PARTITION INTEGRAL
+  {
+    $$ = &PartitionSpec{Action:CoalesceAction, Number:NewIntLiteral($3) }
+  }
+| EXCHANGE PARTITION sql_id WITH TABLE table_name without_valid_opt
+  {
+    $$ = &PartitionSpec{Action:ExchangeAction, Names: Partitions{$3}, TableName: $6, WithoutValidation: $7}
+  }
+| ANALYZE PARTITION partition_list
+  {
+    $$ = &PartitionSpec{Action:AnalyzeAction, Names:$3}
+  }
+| ANALYZE PARTITION ALL
+  {
+    $$ = &PartitionSpec{Action:AnalyzeAction, IsAll:true}
+  }
+| CHECK PARTITION partition_list
+  {
+    $$ = &PartitionSpec{Action:CheckAction, Names:$3}
+  }
+| CHECK PARTITION ALL
+  {
+    $$ = &PartitionSpec{Action:CheckAction, IsAll:true}
+  }
+| OPTIMIZE PARTITION partition_list
+  {
+    $$ = &PartitionSpec{Action:OptimizeAction, Names:$3}
+  }
+| OPTIMIZE PARTITION ALL
+  {
+    $$ = &PartitionSpec{Action:OptimizeAction, IsAll:true}
+  }
+| REBUILD PARTITION partition_list
+  {
+    $$ = &PartitionSpec{Action:RebuildAction, Names:$3}
+  }
+| REBUILD PARTITION ALL
+  {
+    $$ = &PartitionSpec{Action:RebuildAction, IsAll:true}
+  }
+| REPAIR PARTITION partition_list
+  {
+    $$ = &PartitionSpec{Action:RepairAction, Names:$3}
+  }
+| REPAIR PARTITION ALL
+  {
+    $$ = &PartitionSpec{Action:RepairAction, IsAll:true}
+  }
+| UPGRADE PARTITIONING
+  {
+    $$ = &PartitionSpec{Action:UpgradeAction}
+  }
+
+without_valid_opt:
+  {
+    $$ = false
+  }
+| WITH VALIDATION
+  {
+    $$ = false
+  }
+| WITHOUT VALIDATION
+  {
+    $$ = true
+  }
+
+partition_definitions:
+  partition_definition
+  {
+    $$ = []*PartitionDefinition{$1}
+  }
+| partition_definitions ',' partition_definition
+  {
+    $$ = append($1, $3)
+  }
+
+partition_definition:
+  partition_name partition_definition_attribute_list_opt
+  {
+    $$.Options = $2
+  }
+
+partition_definition_attribute_list_opt:
+  {
+    $$ = &PartitionDefinitionOptions{}
+  }
+| partition_definition_attribute_list_opt partition_value_range
+  {
+    $1.ValueRange = $2
+    $$ = $1
+  }
+| partition_definition_attribute_list_opt partition_comment
+  {
+    $1.Comment = $2
+    $$ = $1
+  }
+| partition_definition_attribute_list_opt partition_engine
+  {
+    $1.Engine = $2
+    $$ = $1
+  }
+| partition_definition_attribute_list_opt partition_data_directory
+  {
+    $1.DataDirectory = $2
+    $$ = $1
+  }
+| partition_definition_attribute_list_opt partition_index_directory
+  {
+    $1.IndexDirectory = $2
+    $$ = $1
+  }
+| partition_definition_attribute_list_opt partition_max_rows
+  {
+    val := $2
+    $1.MaxRows = &val
+    $$ = $1
+  }
+| partition_definition_attribute_list_opt partition_min_rows
+  {
+    val := $2
+    $1.MinRows = &val
+    $$ = $1
+  }
+| partition_definition_attribute_list_opt partition_tablespace_name
+  {
+    $1.TableSpace = $2
+    $$ = $1
+  }
+| partition_definition_attribute_list_opt subpartition_definition_list_with_brackets
+  {
+    $1.SubPartitionDefinitions = $2
+    $$ = $1
+  }
+
+subpartition_definition_list_with_brackets:
+  openb subpartition_definition_list closeb{
+    $$ = $2
+  }
+
+subpartition_definition_list:
+  subpartition_definition
+  {
+    $$ = SubPartitionDefinitions{$1}
+  }
+| subpartition_definition_list ',' subpartition_definition
+  {
+    $$ = append($1, $3)
+  }
+
+subpartition_definition:
+  SUBPARTITION sql_id subpartition_definition_attribute_list_opt
+  {
+    $$ = &SubPartitionDefinition{Name:$2, Options: $3}
+  }
+
+subpartition_definition_attribute_list_opt:
+  {
+    $$ = &SubPartitionDefinitionOptions{}
+  }
+| subpartition_definition_attribute_list_opt partition_comment
+  {
+    $1.Comment = $2
+    $$ = $1
+  }
+| subpartition_definition_attribute_list_opt partition_engine
+  {
+    $1.Engine = $2
+    $$ = $1
+  }
+| subpartition_definition_attribute_list_opt partition_data_directory
+  {
+    $1.DataDirectory = $2
+    $$ = $1
+  }
+| subpartition_definition_attribute_list_opt partition_index_directory
+  {
+    $1.IndexDirectory = $2
+    $$ = $1
+  }
+| subpartition_definition_attribute_list_opt partition_max_rows
+  {
+    val := $2
+    $1.MaxRows = &val
+    $$ = $1
+  }
+| subpartition_definition_attribute_list_opt partition_min_rows
+  {
+    val := $2
+    $1.MinRows = &val
+    $$ = $1
+  }
+| subpartition_definition_attribute_list_opt partition_tablespace_name
+  {
+    $1.TableSpace = $2
+    $$ = $1
+  }
+
+partition_value_range:
+  VALUES LESS THAN row_tuple
+  {
+    $$ = &PartitionValueRange{
+    	Type: LessThanType,
+    	Range: $4,
+    }
+  }
+| VALUES LESS THAN maxvalue
+  {
+    $$ = &PartitionValueRange{
+    	Type: LessThanType,
+    	Maxvalue: true,
+    }
+  }
+| VALUES IN row_tuple
+  {
+    $$ = &PartitionValueRange{
+    	Type: InType,
+    	Range: $3,
+    }
+  }
+
+partition_storage_opt:
+  {
+    $$ = false
+  }
+| STORAGE
+  {
+    $$ = true
+  }
+
+partition_engine:
+  partition_storage_opt ENGINE equal_opt table_alias
+  {
+    $$ = &PartitionEngine{Storage:$1, Name: $4.String()}
+  }
+
+partition_comment:
+  COMMENT_KEYWORD equal_opt STRING
+  {
+    $$ = NewStrLiteral($3)
+  }
+
+partition_data_directory:
+  DATA DIRECTORY equal_opt STRING
+  {
+    $$ = NewStrLiteral($4)
+  }
+
+partition_index_directory:
+  INDEX DIRECTORY equal_opt STRING
+  {
+    $$ = NewStrLiteral($4)
+  }
+
+partition_max_rows:
+  MAX_ROWS equal_opt INTEGRAL
+  {
+    $$ = convertStringToInt($3)
+  }
+
+partition_min_rows:
+  MIN_ROWS equal_opt INTEGRAL
+  {
+    $$ = convertStringToInt($3)
+  }
+
+partition_tablespace_name:
+  TABLESPACE equal_opt table_alias
+  {
+    $$ = $3.String()
+  }
+
+partition_name:
+  PARTITION sql_id
+  {
+    $$ = &PartitionDefinition{Name: $2}
+  }
+
+maxvalue:
+  MAXVALUE
+  {
+    $$ = ""
+  }
+| openb MAXVALUE closeb
+  {
+    $$ = ""
+  }
+
+rename_statement:
+  RENAME TABLE rename_list
+  {
+    $$ = &RenameTable{TablePairs: $3}
+  }
+| RENAME USER rename_user_list
+  {
+    $$ = &RenameUser{UserPairs: $3}
+  }
+
+rename_list:
+  table_name TO table_name
+  {
+    $$ = []*RenameTablePair{{FromTable: $1, ToTable: $3}}
+  }
+| rename_list ',' table_name TO table_name
+  {
+    $$ = append($1, &RenameTablePair{FromTable: $3, ToTable: $5})
+  }
+
+drop_statement:
+  DROP comment_opt temp_opt TABLE exists_opt table_name_list restrict_or_cascade_opt
+  {
+    $$ = &DropTable{FromTables: $6, IfExists: $5, Comments: Comments($2).Parsed(), Temp: $3}
+  }
+| DROP comment_opt USER exists_opt account_list
+  {
+    $$ = &DropUser{IfExists: $4, Users: $5}
+  }
+| DROP comment_opt ROLE exists_opt account_list
+  {
+    $$ = &DropRole{IfExists: $4, Roles: $5}
+  }
+| DROP comment_opt INDEX ci_identifier ON table_name algorithm_lock_opt
+  {
+    // Change this to an alter statement
+    if $4.Lowered() == "primary" {
+      $$ = &AlterTable{FullyParsed:true, Table: $6,AlterOptions: append([]AlterOption{&DropKey{Type:PrimaryKeyType}},$7...)}
+    } else {
+      $$ = &AlterTable{FullyParsed: true, Table: $6,AlterOptions: append([]AlterOption{&DropKey{Type:NormalKeyType, Name:$4}},$7...)}
+    }
+  }
+| DROP comment_opt VIEW exists_opt view_name_list restrict_or_cascade_opt
+  {
+    $$ = &DropView{FromTables: $5, Comments: Comments($2).Parsed(), IfExists: $4}
+  }
+| DROP comment_opt database_or_schema exists_opt table_id
+  {
+    $$ = &DropDatabase{Comments: Comments($2).Parsed(), DBName: $5, IfExists: $4}
+  }
+| DROP comment_opt PROCEDURE exists_opt table_name
+  {
+    $$ = &DropProcedure{Comments: Comments($2).Parsed(), IfExists: $4, Name: $5}
+  }
+| DROP comment_opt FUNCTION exists_opt table_name
+  {
+    $$ = &DropFunction{Comments: Comments($2).Parsed(), IfExists: $4, Name: $5}
+  }
+| DROP comment_opt TRIGGER exists_opt table_name
+  {
+    $$ = &DropTrigger{Comments: Comments($2).Parsed(), IfExists: $4, Name: $5}
+  }
+| DROP comment_opt EVENT exists_opt table_name
+  {
+    $$ = &DropEvent{Comments: Comments($2).Parsed(), IfExists: $4, Name: $5}
+  }
+
+truncate_statement:
+  TRUNCATE TABLE table_name
+  {
+    $$ = &TruncateTable{Table: $3}
+  }
+| TRUNCATE table_name
+  {
+    $$ = &TruncateTable{Table: $2}
+  }
+
+analyze_statement:
+  ANALYZE TABLE table_name
+  {
+    $$ = &OtherRead{}
+  }
+
+purge_statement:
+  PURGE BINARY LOGS TO STRING
+  {
+    $$ = &PurgeBinaryLogs{To: string($5)}
+  }
+| PURGE BINARY LOGS BEFORE STRING
+  {
+    $$ = &PurgeBinaryLogs{Before: string($5)}
+  }
+
+show_statement:
+  SHOW charset_or_character_set like_or_where_opt
+  {
+    $$ = &Show{&ShowBasic{Command: Charset, Filter: $3}}
+  }
+| SHOW COLLATION like_or_where_opt
+  {
+    $$ = &Show{&ShowBasic{Command: Collation, Filter: $3}}
+  }
+| SHOW full_opt columns_or_fields from_or_in table_name from_database_opt like_or_where_opt
+  {
+    $$ = &Show{&ShowBasic{Full: $2, Command: Column, Tbl: $5, DbName: $6, Filter: $7}}
+  }
+| SHOW DATABASES like_or_where_opt
+  {
+    $$ = &Show{&ShowBasic{Command: Database, Filter: $3}}
+  }
+| SHOW SCHEMAS like_or_where_opt
+  {
+    $$ = &Show{&ShowBasic{Command: Database, Filter: $3}}
+  }
+| SHOW KEYSPACES like_or_where_opt
+  {
+    $$ = &Show{&ShowBasic{Command: Keyspace, Filter: $3}}
+  }
+| SHOW VITESS_KEYSPACES like_or_where_opt
   {
-    $1.FullyParsed = true
-    $1.PartitionSpec = $2
-    $$ = $1
+    $$ = &Show{&ShowBasic{Command: Keyspace, Filter: $3}}
   }
-| ALTER comment_opt algorithm_view definer_opt security_view_opt VIEW table_name column_list_opt AS select_statement check_option_opt
+| SHOW FUNCTION STATUS like_or_where_opt
   {
-    $$ = &AlterView{ViewName: $7, Comments: Comments($2).Parsed(), Algorithm:$3, Definer: $4 ,Security:$5, Columns:$8, Select: $10, CheckOption: $11 }
+    $$ = &Show{&ShowBasic{Command: Function, Filter: $4}}
   }
-// The syntax here causes a shift / reduce issue, because ENCRYPTION is a non reserved keyword
-// and the database identifier is optional. When no identifier is given, the current database
-// is used. This means though that `alter database encryption` is ambiguous whether it means
-// the encryption keyword, or the encryption database name, resulting in the conflict.
-// The preference here is to shift, so it is treated as a database name. This matches the MySQL
-// behavior as well.
-| alter_database_prefix table_id_opt create_options
+| SHOW extended_opt index_symbols from_or_in table_name from_database_opt like_or_where_opt
   {
-    $1.FullyParsed = true
-    $1.DBName = $2
-    $1.AlterOptions = $3
-    $$ = $1
+    $$ = &Show{&ShowBasic{Command: Index, Tbl: $5, DbName: $6, Filter: $7}}
   }
-| alter_database_prefix table_id UPGRADE DATA DIRECTORY NAME
+| SHOW OPEN TABLES from_database_opt like_or_where_opt
   {
-    $1.FullyParsed = true
-    $1.DBName = $2
-    $1.UpdateDataDirectory = true
-    $$ = $1
+    $$ = &Show{&ShowBasic{Command: OpenTable, DbName:$4, Filter: $5}}
   }
-| ALTER comment_opt VSCHEMA CREATE VINDEX table_name vindex_type_opt vindex_params_opt
+| SHOW PRIVILEGES
   {
-    $$ = &AlterVschema{
-        Action: CreateVindexDDLAction,
-        Table: $6,
-        VindexSpec: &VindexSpec{
-          Name: NewIdentifierCI($6.Name.String()),
-          Type: $7,
-          Params: $8,
-        },
-      }
+    $$ = &Show{&ShowBasic{Command: Privilege}}
   }
-| ALTER comment_opt VSCHEMA DROP VINDEX table_name
+| SHOW PROCEDURE STATUS like_or_where_opt
   {
-    $$ = &AlterVschema{
-        Action: DropVindexDDLAction,
-        Table: $6,
-        VindexSpec: &VindexSpec{
-          Name: NewIdentifierCI($6.Name.String()),
-        },
-      }
+    $$ = &Show{&ShowBasic{Command: Procedure, Filter: $4}}
   }
-| ALTER comment_opt VSCHEMA ADD TABLE table_name
+| SHOW session_or_local_opt STATUS like_or_where_opt
   {
-    $$ = &AlterVschema{Action: AddVschemaTableDDLAction, Table: $6}
+    $$ = &Show{&ShowBasic{Command: StatusSession, Filter: $4}}
   }
-| ALTER comment_opt VSCHEMA DROP TABLE table_name
+| SHOW GLOBAL STATUS like_or_where_opt
   {
-    $$ = &AlterVschema{Action: DropVschemaTableDDLAction, Table: $6}
+    $$ = &Show{&ShowBasic{Command: StatusGlobal, Filter: $4}}
   }
-| ALTER comment_opt VSCHEMA ON table_name ADD VINDEX sql_id '(' column_list ')' vindex_type_opt vindex_params_opt
+| SHOW session_or_local_opt VARIABLES like_or_where_opt
   {
-    $$ = &AlterVschema{
-        Action: AddColVindexDDLAction,
-        Table: $5,
-        VindexSpec: &VindexSpec{
-            Name: $8,
-            Type: $12,
-            Params: $13,
-        },
-        VindexCols: $10,
-      }
+    $$ = &Show{&ShowBasic{Command: VariableSession, Filter: $4}}
   }
-| ALTER comment_opt VSCHEMA ON table_name DROP VINDEX sql_id
+| SHOW GLOBAL VARIABLES like_or_where_opt
   {
-    $$ = &AlterVschema{
-        Action: DropColVindexDDLAction,
-        Table: $5,
-        VindexSpec: &VindexSpec{
-            Name: $8,
-        },
-      }
+    $$ = &Show{&ShowBasic{Command: VariableGlobal, Filter: $4}}
   }
-| ALTER comment_opt VSCHEMA ADD SEQUENCE table_name
+| SHOW TABLE STATUS from_database_opt like_or_where_opt
   {
-    $$ = &AlterVschema{Action: AddSequenceDDLAction, Table: $6}
+    $$ = &Show{&ShowBasic{Command: TableStatus, DbName:$4, Filter: $5}}
   }
-| ALTER comment_opt VSCHEMA ON table_name ADD AUTO_INCREMENT sql_id USING table_name
+| SHOW full_opt TABLES from_database_opt like_or_where_opt
   {
-    $$ = &AlterVschema{
-        Action: AddAutoIncDDLAction,
-        Table: $5,
-        AutoIncSpec: &AutoIncSpec{
-            Column: $8,
-            Sequence: $10,
-        },
-    }
+    $$ = &Show{&ShowBasic{Command: Table, Full: $2, DbName:$4, Filter: $5}}
   }
-| ALTER comment_opt VITESS_MIGRATION STRING RETRY
+| SHOW TRIGGERS from_database_opt like_or_where_opt
   {
-    $$ = &AlterMigration{
-      Type: RetryMigrationType,
-      UUID: string($4),
-    }
+    $$ = &Show{&ShowBasic{Command: Trigger, DbName:$3, Filter: $4}}
   }
-| ALTER comment_opt VITESS_MIGRATION STRING CLEANUP
+| SHOW CREATE DATABASE table_name
   {
-    $$ = &AlterMigration{
-      Type: CleanupMigrationType,
-      UUID: string($4),
-    }
+    $$ = &Show{&ShowCreate{Command: CreateDb, Op: $4}}
   }
-| ALTER comment_opt VITESS_MIGRATION STRING LAUNCH
+| SHOW CREATE EVENT table_name
   {
-    $$ = &AlterMigration{
-      Type: LaunchMigrationType,
-      UUID: string($4),
-    }
+    $$ = &Show{&ShowCreate{Command: CreateE, Op: $4}}
   }
-| ALTER comment_opt VITESS_MIGRATION STRING LAUNCH VITESS_SHARDS STRING
+| SHOW CREATE FUNCTION table_name
   {
-    $$ = &AlterMigration{
-      Type: LaunchMigrationType,
-      UUID: string($4),
-      Shards: string($7),
-    }
+    $$ = &Show{&ShowCreate{Command: CreateF, Op: $4}}
   }
-| ALTER comment_opt VITESS_MIGRATION LAUNCH ALL
+| SHOW CREATE PROCEDURE table_name
   {
-    $$ = &AlterMigration{
-      Type: LaunchAllMigrationType,
-    }
+    $$ = &Show{&ShowCreate{Command: CreateProc, Op: $4}}
   }
-| ALTER comment_opt VITESS_MIGRATION STRING COMPLETE
+| SHOW CREATE TABLE table_name
   {
-    $$ = &AlterMigration{
-      Type: CompleteMigrationType,
-      UUID: string($4),
-    }
+    $$ = &Show{&ShowCreate{Command: CreateTbl, Op: $4}}
   }
-| ALTER comment_opt VITESS_MIGRATION COMPLETE ALL
+| SHOW CREATE TRIGGER table_name
   {
-    $$ = &AlterMigration{
-      Type: CompleteAllMigrationType,
-    }
+    $$ = &Show{&ShowCreate{Command: CreateTr, Op: $4}}
   }
-| ALTER comment_opt VITESS_MIGRATION STRING CANCEL
+| SHOW CREATE VIEW table_name
   {
-    $$ = &AlterMigration{
-      Type: CancelMigrationType,
-      UUID: string($4),
-    }
+    $$ = &Show{&ShowCreate{Command: CreateV, Op: $4}}
   }
-| ALTER comment_opt VITESS_MIGRATION CANCEL ALL
+| SHOW ENGINES
   {
-    $$ = &AlterMigration{
-      Type: CancelAllMigrationType,
-    }
+    $$ = &Show{&ShowBasic{Command: Engines}}
   }
-| ALTER comment_opt VITESS_MIGRATION STRING THROTTLE expire_opt ratio_opt
+| SHOW PLUGINS
   {
-    $$ = &AlterMigration{
-      Type: ThrottleMigrationType,
-      UUID: string($4),
-      Expire: $6,
-      Ratio: $7,
-    }
+    $$ = &Show{&ShowBasic{Command: Plugins}}
   }
-| ALTER comment_opt VITESS_MIGRATION THROTTLE ALL expire_opt ratio_opt
+| SHOW GLOBAL GTID_EXECUTED from_database_opt
   {
-    $$ = &AlterMigration{
-      Type: ThrottleAllMigrationType,
-      Expire: $6,
-      Ratio: $7,
-    }
+    $$ = &Show{&ShowBasic{Command: GtidExecGlobal, DbName: $4}}
   }
-| ALTER comment_opt VITESS_MIGRATION STRING UNTHROTTLE
+| SHOW GLOBAL VGTID_EXECUTED from_database_opt
   {
-    $$ = &AlterMigration{
-      Type: UnthrottleMigrationType,
-      UUID: string($4),
-    }
+    $$ = &Show{&ShowBasic{Command: VGtidExecGlobal, DbName: $4}}
   }
-| ALTER comment_opt VITESS_MIGRATION UNTHROTTLE ALL
+| SHOW VITESS_METADATA VARIABLES like_opt
   {
-    $$ = &AlterMigration{
-      Type: UnthrottleAllMigrationType,
-    }
+    $$ = &Show{&ShowBasic{Command: VitessVariables, Filter: $4}}
   }
-
-partitions_options_opt:
+| SHOW VITESS_MIGRATIONS from_database_opt like_or_where_opt
   {
-    $$ = nil
+    $$ = &Show{&ShowBasic{Command: VitessMigrations, Filter: $4, DbName: $3}}
   }
-| PARTITION BY partitions_options_beginning partitions_opt subpartition_opt partition_definitions_opt
-    {
-      $3.Partitions = $4
-      $3.SubPartition = $5
-      $3.Definitions = $6
-      $$ = $3
-    }
-
-partitions_options_beginning:
-  linear_opt HASH '(' expression ')'
-    {
-      $$ = &PartitionOption {
-        IsLinear: $1,
-        Type: HashType,
-        Expr: $4,
-      }
-    }
-| linear_opt KEY algorithm_opt '(' column_list_empty ')'
-    {
-      $$ = &PartitionOption {
-        IsLinear: $1,
-        Type: KeyType,
-        KeyAlgorithm: $3,
-        ColList: $5,
-      }
-    }
-| range_or_list '(' expression ')'
-    {
-      $$ = &PartitionOption {
-        Type: $1,
-        Expr: $3,
-      }
-    }
-| range_or_list COLUMNS '(' column_list ')'
+| SHOW VITESS_MIGRATION STRING LOGS
   {
-    $$ = &PartitionOption {
-        Type: $1,
-        ColList: $4,
-    }
+    $$ = &ShowMigrationLogs{UUID: string($3)}
   }
-
-subpartition_opt:
+| SHOW VITESS_THROTTLED_APPS
   {
-    $$ = nil
+    $$ = &ShowThrottledApps{}
   }
-| SUBPARTITION BY linear_opt HASH '(' expression ')' subpartitions_opt
+| SHOW VITESS_REPLICATION_STATUS like_opt
   {
-    $$ = &SubPartition {
-      IsLinear: $3,
-      Type: HashType,
-      Expr: $6,
-      SubPartitions: $8,
-    }
+    $$ = &Show{&ShowBasic{Command: VitessReplicationStatus, Filter: $3}}
   }
-| SUBPARTITION BY linear_opt KEY algorithm_opt '(' column_list ')' subpartitions_opt
+| SHOW VITESS_THROTTLER STATUS
   {
-    $$ = &SubPartition {
-      IsLinear: $3,
-      Type: KeyType,
-      KeyAlgorithm: $5,
-      ColList: $7,
-      SubPartitions: $9,
-    }
+    $$ = &ShowThrottlerStatus{}
   }
-
-partition_definitions_opt:
+| SHOW VSCHEMA TABLES
   {
-    $$ = nil
+    $$ = &Show{&ShowBasic{Command: VschemaTables}}
   }
-| '(' partition_definitions ')'
+| SHOW VSCHEMA VINDEXES
   {
-    $$ = $2
+    $$ = &Show{&ShowBasic{Command: VschemaVindexes}}
   }
-
-linear_opt:
+| SHOW VSCHEMA VINDEXES from_or_on table_name
   {
-    $$ = false
+    $$ = &Show{&ShowBasic{Command: VschemaVindexes, Tbl: $5}}
   }
-| LINEAR
+| SHOW WARNINGS
   {
-    $$ = true
+    $$ = &Show{&ShowBasic{Command: Warnings}}
   }
-
-algorithm_opt:
+| SHOW VITESS_SHARDS like_or_where_opt
   {
-    $$ = 0
+    $$ = &Show{&ShowBasic{Command: VitessShards, Filter: $3}}
   }
-| ALGORITHM '=' INTEGRAL
+| SHOW VITESS_TABLETS like_or_where_opt
   {
-    $$ = convertStringToInt($3)
+    $$ = &Show{&ShowBasic{Command: VitessTablets, Filter: $3}}
   }
-
-json_table_function:
-  JSON_TABLE openb expression ',' text_literal_or_arg jt_columns_clause closeb as_opt_id
+| SHOW VITESS_TARGET
   {
-    $$ = &JSONTableExpr{Expr: $3, Filter: $5, Columns: $6, Alias: $8}
+    $$ = &Show{&ShowBasic{Command: VitessTarget}}
   }
-
-jt_columns_clause:
-  COLUMNS openb columns_list closeb
+/*
+ * Catch-all for show statements without vitess keywords:
+ */
+| SHOW ci_identifier ddl_skip_to_end
   {
-    $$= $3
+    $$ = &Show{&ShowOther{Command: string($2.String())}}
   }
-
-columns_list:
-  jt_column
+| SHOW CREATE USER ddl_skip_to_end
   {
-    $$= []*JtColumnDefinition{$1}
+    $$ = &Show{&ShowOther{Command: string($2) + " " + string($3)}}
+   }
+| SHOW BINARY ci_identifier ddl_skip_to_end /* SHOW BINARY ... */
+  {
+    $$ = &Show{&ShowOther{Command: string($2) + " " + $3.String()}}
   }
-| columns_list ',' jt_column
+| SHOW BINARY LOGS ddl_skip_to_end /* SHOW BINARY LOGS */
   {
-    $$ = append($1, $3)
+    $$ = &Show{&ShowOther{Command: string($2) + " " + string($3)}}
   }
-
-jt_column:
- sql_id FOR ORDINALITY
+| SHOW ENGINE ddl_skip_to_end
   {
-    $$ = &JtColumnDefinition{JtOrdinal: &JtOrdinalColDef{Name: $1}}
+    $$ = &Show{&ShowOther{Command: string($2)}}
   }
-| sql_id column_type collate_opt jt_exists_opt PATH text_literal_or_arg
+| SHOW FUNCTION CODE table_name
   {
-    $2.Options= &ColumnTypeOptions{Collate:$3}
-    jtPath := &JtPathColDef{Name: $1, Type: $2, JtColExists: $4, Path: $6}
-    $$ = &JtColumnDefinition{JtPath: jtPath}
+    $$ = &Show{&ShowOther{Command: string($2) + " " + string($3) + " " + String($4)}}
   }
-| sql_id column_type collate_opt jt_exists_opt PATH text_literal_or_arg on_empty
+| SHOW PROCEDURE CODE table_name
   {
-    $2.Options= &ColumnTypeOptions{Collate:$3}
-    jtPath := &JtPathColDef{Name: $1, Type: $2, JtColExists: $4, Path: $6, EmptyOnResponse: $7}
-    $$ = &JtColumnDefinition{JtPath: jtPath}
+    $$ = &Show{&ShowOther{Command: string($2) + " " + string($3) + " " + String($4)}}
   }
-| sql_id column_type collate_opt jt_exists_opt PATH text_literal_or_arg on_error
+| SHOW full_opt PROCESSLIST from_database_opt like_or_where_opt
   {
-    $2.Options= &ColumnTypeOptions{Collate:$3}
-    jtPath := &JtPathColDef{Name: $1, Type: $2, JtColExists: $4, Path: $6, ErrorOnResponse: $7}
-    $$ = &JtColumnDefinition{JtPath: jtPath}
+    $$ = &Show{&ShowOther{Command: string($3)}}
   }
-| sql_id column_type collate_opt jt_exists_opt PATH text_literal_or_arg on_empty on_error
+| SHOW STORAGE ddl_skip_to_end
   {
-    $2.Options= &ColumnTypeOptions{Collate:$3}
-    jtPath := &JtPathColDef{Name: $1, Type: $2, JtColExists: $4, Path: $6, EmptyOnResponse: $7, ErrorOnResponse: $8}
-    $$ = &JtColumnDefinition{JtPath: jtPath}
+    $$ = &Show{&ShowOther{Command: string($2)}}
   }
-| NESTED jt_path_opt text_literal_or_arg jt_columns_clause
+
+extended_opt:
+  /* empty */
   {
-    jtNestedPath := &JtNestedPathColDef{Path: $3, Columns: $4}
-    $$ = &JtColumnDefinition{JtNestedPath: jtNestedPath}
+    $$ = ""
+  }
+  | EXTENDED
+  {
+    $$ = "extended "
   }
 
-jt_path_opt:
+full_opt:
+  /* empty */
   {
     $$ = false
   }
-| PATH
+| FULL
   {
     $$ = true
   }
-jt_exists_opt:
+
+columns_or_fields:
+  COLUMNS
   {
-    $$=false
+      $$ = string($1)
   }
-| EXISTS
+| FIELDS
   {
-    $$=true
+      $$ = string($1)
   }
 
-on_empty:
-  json_on_response ON EMPTY
+from_database_opt:
+  /* empty */
   {
-    $$= $1
+    $$ = NewIdentifierCS("")
   }
-
-on_error:
-  json_on_response ON ERROR
+| FROM table_id
   {
-    $$= $1
+    $$ = $2
+  }
+| IN table_id
+  {
+    $$ = $2
   }
 
-json_on_response:
-  ERROR
+like_or_where_opt:
+  /* empty */
   {
-    $$ = &JtOnResponse{ResponseType: ErrorJSONType}
+    $$ = nil
   }
-| NULL
+| LIKE STRING
   {
-    $$ = &JtOnResponse{ResponseType: NullJSONType}
+    $$ = &ShowFilter{Like:string($2)}
   }
-| DEFAULT text_literal_or_arg
+| WHERE expression
   {
-    $$ = &JtOnResponse{ResponseType: DefaultJSONType, Expr: $2}
+    $$ = &ShowFilter{Filter:$2}
   }
 
-range_or_list:
-  RANGE
+like_opt:
+  /* empty */
+    {
+      $$ = nil
+    }
+  | LIKE STRING
+    {
+      $$ = &ShowFilter{Like:string($2)}
+    }
+
+session_or_local_opt:
+  /* empty */
   {
-    $$ = RangeType
+    $$ = struct{}{}
   }
-| LIST
+| SESSION
   {
-    $$ = ListType
+    $$ = struct{}{}
+  }
+| LOCAL
+  {
+    $$ = struct{}{}
   }
 
-partitions_opt:
+from_or_on:
+  FROM
   {
-    $$ = -1
+    $$ = string($1)
   }
-| PARTITIONS INTEGRAL
+| ON
   {
-    $$ = convertStringToInt($2)
+    $$ = string($1)
   }
 
-subpartitions_opt:
+use_statement:
+  USE use_table_name
   {
-    $$ = -1
+    $$ = &Use{DBName: $2}
   }
-| SUBPARTITIONS INTEGRAL
+| USE
   {
-    $$ = convertStringToInt($2)
+    $$ = &Use{DBName:IdentifierCS{v:""}}
+  }
+| USE use_table_name AT_ID
+  {
+    $$ = &Use{DBName:NewIdentifierCS($2.String()+"@"+string($3))}
   }
 
-partition_operation:
-  ADD PARTITION '(' partition_definition ')'
+// We use this because what is legal in `USE <tbl>` is not the same as in `SELECT ... FROM <tbl>`
+use_table_name:
+  ID
   {
-    $$ = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{$4}}
+    $$ = NewIdentifierCS(string($1))
   }
-| DROP PARTITION partition_list
+| AT_ID
   {
-    $$ = &PartitionSpec{Action:DropAction, Names:$3}
+    $$ = NewIdentifierCS("@"+string($1))
   }
-| REORGANIZE PARTITION partition_list INTO openb partition_definitions closeb
+| AT_AT_ID
   {
-    $$ = &PartitionSpec{Action: ReorganizeAction, Names: $3, Definitions: $6}
+    $$ = NewIdentifierCS("@@"+string($1))
   }
-| DISCARD PARTITION partition_list TABLESPACE
+| non_reserved_keyword
   {
-    $$ = &PartitionSpec{Action:DiscardAction, Names:$3}
+    $$ = NewIdentifierCS(string($1))
   }
-| DISCARD PARTITION ALL TABLESPACE
+
+
+begin_statement:
+  BEGIN
   {
-    $$ = &PartitionSpec{Action:DiscardAction, IsAll:true}
+    $$ = &Begin{}
   }
-| IMPORT PARTITION partition_list TABLESPACE
+| start_transaction_statement
+
+start_transaction_statement:
+  START TRANSACTION tx_chacteristics_opt
   {
-    $$ = &PartitionSpec{Action:ImportAction, Names:$3}
+    $$ = &Begin{TxAccessModes: $3}
   }
-| IMPORT PARTITION ALL TABLESPACE
+
+tx_chacteristics_opt:
   {
-    $$ = &PartitionSpec{Action:ImportAction, IsAll:true}
+    $$ = nil
   }
-| TRUNCATE PARTITION partition_list
+| tx_chars
   {
-    $$ = &PartitionSpec{Action:TruncateAction, Names:$3}
+    $$ = $1
   }
-| TRUNCATE PARTITION ALL
+
+tx_chars:
+  tx_char
   {
-    $$ = &PartitionSpec{Action:TruncateAction, IsAll:true}
+    $$ = []TxAccessMode{$1}
   }
-| COALESCE PARTITION INTEGRAL
+| tx_chars ',' tx_char
   {
-    $$ = &PartitionSpec{Action:CoalesceAction, Number:NewIntLiteral($3) }
+    $$ = append($1, $3)
   }
-| EXCHANGE PARTITION sql_id WITH TABLE table_name without_valid_opt
+
+tx_char:
+  WITH CONSISTENT SNAPSHOT
   {
-    $$ = &PartitionSpec{Action:ExchangeAction, Names: Partitions{$3}, TableName: $6, WithoutValidation: $7}
+    $$ = WithConsistentSnapshot
   }
-| ANALYZE PARTITION partition_list
+| READ WRITE
   {
-    $$ = &PartitionSpec{Action:AnalyzeAction, Names:$3}
+    $$ = ReadWrite
   }
-| ANALYZE PARTITION ALL
+| READ ONLY
+  {
+    $$ = ReadOnly
+  }
+
+
+commit_statement:
+  COMMIT
   {
-    $$ = &PartitionSpec{Action:AnalyzeAction, IsAll:true}
+    $$ = &Commit{}
   }
-| CHECK PARTITION partition_list
+
+rollback_statement:
+  ROLLBACK
   {
-    $$ = &PartitionSpec{Action:CheckAction, Names:$3}
+    $$ = &Rollback{}
   }
-| CHECK PARTITION ALL
+| ROLLBACK work_opt TO savepoint_opt sql_id
   {
-    $$ = &PartitionSpec{Action:CheckAction, IsAll:true}
+    $$ = &SRollback{Name: $5}
   }
-| OPTIMIZE PARTITION partition_list
+
+work_opt:
+  { $$ = struct{}{} }
+| WORK
+  { $$ = struct{}{} }
+
+savepoint_opt:
+  { $$ = struct{}{} }
+| SAVEPOINT
+  { $$ = struct{}{} }
+
+savepoint_statement:
+  SAVEPOINT sql_id
   {
-    $$ = &PartitionSpec{Action:OptimizeAction, Names:$3}
+    $$ = &Savepoint{Name: $2}
   }
-| OPTIMIZE PARTITION ALL
+
+release_statement:
+  RELEASE SAVEPOINT sql_id
   {
-    $$ = &PartitionSpec{Action:OptimizeAction, IsAll:true}
+    $$ = &Release{Name: $3}
   }
-| REBUILD PARTITION partition_list
+
+explain_format_opt:
   {
-    $$ = &PartitionSpec{Action:RebuildAction, Names:$3}
+    $$ = EmptyType
   }
-| REBUILD PARTITION ALL
+| FORMAT '=' JSON
   {
-    $$ = &PartitionSpec{Action:RebuildAction, IsAll:true}
+    $$ = JSONType
   }
-| REPAIR PARTITION partition_list
+| FORMAT '=' TREE
   {
-    $$ = &PartitionSpec{Action:RepairAction, Names:$3}
+    $$ = TreeType
   }
-| REPAIR PARTITION ALL
+| FORMAT '=' VITESS
   {
-    $$ = &PartitionSpec{Action:RepairAction, IsAll:true}
+    $$ = VitessType
   }
-| UPGRADE PARTITIONING
+| FORMAT '=' VTEXPLAIN
   {
-    $$ = &PartitionSpec{Action:UpgradeAction}
+    $$ = VTExplainType
   }
-
-without_valid_opt:
+| FORMAT '=' TRADITIONAL
   {
-    $$ = false
+    $$ = TraditionalType
   }
-| WITH VALIDATION
+| ANALYZE
   {
-    $$ = false
+    $$ = AnalyzeType
   }
-| WITHOUT VALIDATION
+
+vexplain_type_opt:
   {
-    $$ = true
+    $$ = PlanVExplainType
   }
-
-partition_definitions:
-  partition_definition
+| PLAN
   {
-    $$ = []*PartitionDefinition{$1}
+    $$ = PlanVExplainType
   }
-| partition_definitions ',' partition_definition
+| ALL
   {
-    $$ = append($1, $3)
+    $$ = AllVExplainType
   }
-
-partition_definition:
-  partition_name partition_definition_attribute_list_opt
+| QUERIES
   {
-    $$.Options = $2
+    $$ = QueriesVExplainType
   }
 
-partition_definition_attribute_list_opt:
+explain_synonyms:
+  EXPLAIN
   {
-    $$ = &PartitionDefinitionOptions{}
+    $$ = $1
   }
-| partition_definition_attribute_list_opt partition_value_range
+| DESCRIBE
   {
-    $1.ValueRange = $2
     $$ = $1
   }
-| partition_definition_attribute_list_opt partition_comment
+| DESC
   {
-    $1.Comment = $2
     $$ = $1
   }
-| partition_definition_attribute_list_opt partition_engine
+
+explainable_statement:
+  select_statement
   {
-    $1.Engine = $2
     $$ = $1
   }
-| partition_definition_attribute_list_opt partition_data_directory
+| update_statement
   {
-    $1.DataDirectory = $2
     $$ = $1
   }
-| partition_definition_attribute_list_opt partition_index_directory
+| insert_statement
   {
-    $1.IndexDirectory = $2
     $$ = $1
   }
-| partition_definition_attribute_list_opt partition_max_rows
+| delete_statement
   {
-    val := $2
-    $1.MaxRows = &val
     $$ = $1
   }
-| partition_definition_attribute_list_opt partition_min_rows
+
+wild_opt:
   {
-    val := $2
-    $1.MinRows = &val
-    $$ = $1
+    $$ = ""
   }
-| partition_definition_attribute_list_opt partition_tablespace_name
+| sql_id
   {
-    $1.TableSpace = $2
-    $$ = $1
+    $$ = $1.val
   }
-| partition_definition_attribute_list_opt subpartition_definition_list_with_brackets
+| STRING
   {
-    $1.SubPartitionDefinitions = $2
-    $$ = $1
+    $$ = encodeSQLString($1)
   }
 
-subpartition_definition_list_with_brackets:
-  openb subpartition_definition_list closeb{
-    $$ = $2
+explain_statement:
+  explain_synonyms comment_opt table_name wild_opt
+  {
+    $$ = &ExplainTab{Table: $3, Wild: $4}
   }
-
-subpartition_definition_list:
-  subpartition_definition
+| explain_synonyms comment_opt explain_format_opt explainable_statement
   {
-    $$ = SubPartitionDefinitions{$1}
+    $$ = &ExplainStmt{Type: $3, Statement: $4, Comments: Comments($2).Parsed()}
   }
-| subpartition_definition_list ',' subpartition_definition
+
+vexplain_statement:
+  VEXPLAIN comment_opt vexplain_type_opt explainable_statement
   {
-    $$ = append($1, $3)
+    $$ = &VExplainStmt{Type: $3, Statement: $4, Comments: Comments($2).Parsed()}
   }
 
-subpartition_definition:
-  SUBPARTITION sql_id subpartition_definition_attribute_list_opt
+other_statement:
+  REPAIR skip_to_end
   {
-    $$ = &SubPartitionDefinition{Name:$2, Options: $3}
+    $$ = &OtherAdmin{}
+  }
+| OPTIMIZE skip_to_end
+  {
+    $$ = &OtherAdmin{}
   }
 
-subpartition_definition_attribute_list_opt:
+lock_statement:
+  LOCK TABLES lock_table_list
   {
-    $$ = &SubPartitionDefinitionOptions{}
+    $$ = &LockTables{Tables: $3}
   }
-| subpartition_definition_attribute_list_opt partition_comment
+
+lock_table_list:
+  lock_table
   {
-    $1.Comment = $2
-    $$ = $1
+    $$ = TableAndLockTypes{$1}
   }
-| subpartition_definition_attribute_list_opt partition_engine
+| lock_table_list ',' lock_table
   {
-    $1.Engine = $2
-    $$ = $1
+    $$ = append($1, $3)
   }
-| subpartition_definition_attribute_list_opt partition_data_directory
+
+lock_table:
+  aliased_table_name lock_type
   {
-    $1.DataDirectory = $2
-    $$ = $1
+    $$ = &TableAndLockType{Table:$1, Lock:$2}
   }
-| subpartition_definition_attribute_list_opt partition_index_directory
+
+lock_type:
+  READ
   {
-    $1.IndexDirectory = $2
-    $$ = $1
+    $$ = Read
   }
-| subpartition_definition_attribute_list_opt partition_max_rows
+| READ LOCAL
   {
-    val := $2
-    $1.MaxRows = &val
-    $$ = $1
+    $$ = ReadLocal
   }
-| subpartition_definition_attribute_list_opt partition_min_rows
+| WRITE
   {
-    val := $2
-    $1.MinRows = &val
-    $$ = $1
+    $$ = Write
   }
-| subpartition_definition_attribute_list_opt partition_tablespace_name
+| LOW_PRIORITY WRITE
   {
-    $1.TableSpace = $2
-    $$ = $1
+    $$ = LowPriorityWrite
   }
 
-partition_value_range:
-  VALUES LESS THAN row_tuple
+unlock_statement:
+  UNLOCK TABLES
   {
-    $$ = &PartitionValueRange{
-    	Type: LessThanType,
-    	Range: $4,
-    }
+    $$ = &UnlockTables{}
   }
-| VALUES LESS THAN maxvalue
+
+revert_statement:
+  REVERT comment_opt VITESS_MIGRATION STRING
   {
-    $$ = &PartitionValueRange{
-    	Type: LessThanType,
-    	Maxvalue: true,
-    }
+    $$ = &RevertMigration{Comments: Comments($2).Parsed(), UUID: string($4)}
   }
-| VALUES IN row_tuple
+
+flush_statement:
+  FLUSH local_opt flush_option_list
   {
-    $$ = &PartitionValueRange{
-    	Type: InType,
-    	Range: $3,
-    }
+    $$ = &Flush{IsLocal: $2, FlushOptions:$3}
   }
-
-partition_storage_opt:
+| FLUSH local_opt TABLES
   {
-    $$ = false
+    $$ = &Flush{IsLocal: $2}
   }
-| STORAGE
+| FLUSH local_opt TABLES WITH READ LOCK
   {
-    $$ = true
+    $$ = &Flush{IsLocal: $2, WithLock:true}
   }
-
-partition_engine:
-  partition_storage_opt ENGINE equal_opt table_alias
+| FLUSH local_opt TABLES table_name_list
   {
-    $$ = &PartitionEngine{Storage:$1, Name: $4.String()}
+    $$ = &Flush{IsLocal: $2, TableNames:$4}
   }
-
-partition_comment:
-  COMMENT_KEYWORD equal_opt STRING
+| FLUSH local_opt TABLES table_name_list WITH READ LOCK
   {
-    $$ = NewStrLiteral($3)
+    $$ = &Flush{IsLocal: $2, TableNames:$4, WithLock:true}
   }
-
-partition_data_directory:
-  DATA DIRECTORY equal_opt STRING
+| FLUSH local_opt TABLES table_name_list FOR EXPORT
   {
-    $$ = NewStrLiteral($4)
+    $$ = &Flush{IsLocal: $2, TableNames:$4, ForExport:true}
   }
 
-partition_index_directory:
-  INDEX DIRECTORY equal_opt STRING
+flush_option_list:
+  flush_option
   {
-    $$ = NewStrLiteral($4)
+    $$ = []string{$1}
   }
-
-partition_max_rows:
-  MAX_ROWS equal_opt INTEGRAL
+| flush_option_list ',' flush_option
   {
-    $$ = convertStringToInt($3)
+    $$ = append($1,$3)
   }
 
-partition_min_rows:
-  MIN_ROWS equal_opt INTEGRAL
+flush_option:
+  BINARY LOGS
   {
-    $$ = convertStringToInt($3)
+    $$ = string($1) + " " + string($2)
   }
-
-partition_tablespace_name:
-  TABLESPACE equal_opt table_alias
+| ENGINE LOGS
   {
-    $$ = $3.String()
+    $$ = string($1) + " " + string($2)
   }
-
-partition_name:
-  PARTITION sql_id
+| ERROR LOGS
   {
-    $$ = &PartitionDefinition{Name: $2}
+    $$ = string($1) + " " + string($2)
   }
-
-maxvalue:
-  MAXVALUE
+| GENERAL LOGS
   {
-    $$ = ""
+    $$ = string($1) + " " + string($2)
   }
-| openb MAXVALUE closeb
+| HOSTS
   {
-    $$ = ""
+    $$ = string($1)
   }
-
-rename_statement:
-  RENAME TABLE rename_list
+| LOGS
   {
-    $$ = &RenameTable{TablePairs: $3}
+    $$ = string($1)
   }
-
-rename_list:
-  table_name TO table_name
+| PRIVILEGES
   {
-    $$ = []*RenameTablePair{{FromTable: $1, ToTable: $3}}
+    $$ = string($1)
   }
-| rename_list ',' table_name TO table_name
+| RELAY LOGS for_channel_opt
   {
-    $$ = append($1, &RenameTablePair{FromTable: $3, ToTable: $5})
+    $$ = string($1) + " " + string($2) + $3
   }
-
-drop_statement:
-  DROP comment_opt temp_opt TABLE exists_opt table_name_list restrict_or_cascade_opt
+| SLOW LOGS
   {
-    $$ = &DropTable{FromTables: $6, IfExists: $5, Comments: Comments($2).Parsed(), Temp: $3}
+    $$ = string($1) + " " + string($2)
   }
-| DROP comment_opt INDEX ci_identifier ON table_name algorithm_lock_opt
+| OPTIMIZER_COSTS
   {
-    // Change this to an alter statement
-    if $4.Lowered() == "primary" {
-      $$ = &AlterTable{FullyParsed:true, Table: $6,AlterOptions: append([]AlterOption{&DropKey{Type:PrimaryKeyType}},$7...)}
-    } else {
-      $$ = &AlterTable{FullyParsed: true, Table: $6,AlterOptions: append([]AlterOption{&DropKey{Type:NormalKeyType, Name:$4}},$7...)}
-    }
+    $$ = string($1)
   }
-| DROP comment_opt VIEW exists_opt view_name_list restrict_or_cascade_opt
+| STATUS
   {
-    $$ = &DropView{FromTables: $5, Comments: Comments($2).Parsed(), IfExists: $4}
+    $$ = string($1)
   }
-| DROP comment_opt database_or_schema exists_opt table_id
+| USER_RESOURCES
   {
-    $$ = &DropDatabase{Comments: Comments($2).Parsed(), DBName: $5, IfExists: $4}
+    $$ = string($1)
   }
 
-truncate_statement:
-  TRUNCATE TABLE table_name
+local_opt:
   {
-    $$ = &TruncateTable{Table: $3}
+    $$ = false
   }
-| TRUNCATE table_name
+| LOCAL
   {
-    $$ = &TruncateTable{Table: $2}
+    $$ = true
   }
-
-analyze_statement:
-  ANALYZE TABLE table_name
+| NO_WRITE_TO_BINLOG
   {
-    $$ = &OtherRead{}
+    $$ = true
   }
 
-purge_statement:
-  PURGE BINARY LOGS TO STRING
+for_channel_opt:
   {
-    $$ = &PurgeBinaryLogs{To: string($5)}
+    $$ = ""
   }
-| PURGE BINARY LOGS BEFORE STRING
+| FOR CHANNEL ci_identifier
   {
-    $$ = &PurgeBinaryLogs{Before: string($5)}
+    $$ = " " + string($1) + " " + string($2) + " " + $3.String()
   }
 
-show_statement:
-  SHOW charset_or_character_set like_or_where_opt
+comment_opt:
   {
-    $$ = &Show{&ShowBasic{Command: Charset, Filter: $3}}
+    setAllowComments(yylex, true)
   }
-| SHOW COLLATION like_or_where_opt
+  comment_list
   {
-    $$ = &Show{&ShowBasic{Command: Collation, Filter: $3}}
+    $$ = $2
+    setAllowComments(yylex, false)
   }
-| SHOW full_opt columns_or_fields from_or_in table_name from_database_opt like_or_where_opt
+
+comment_list:
   {
-    $$ = &Show{&ShowBasic{Full: $2, Command: Column, Tbl: $5, DbName: $6, Filter: $7}}
+    $$ = nil
   }
-| SHOW DATABASES like_or_where_opt
+| comment_list COMMENT
   {
-    $$ = &Show{&ShowBasic{Command: Database, Filter: $3}}
+    $$ = append($1, $2)
   }
-| SHOW SCHEMAS like_or_where_opt
+
+set_quantifier_opt:
   {
-    $$ = &Show{&ShowBasic{Command: Database, Filter: $3}}
+    $$ = true
   }
-| SHOW KEYSPACES like_or_where_opt
+| ALL
   {
-    $$ = &Show{&ShowBasic{Command: Keyspace, Filter: $3}}
+    $$ = false
   }
-| SHOW VITESS_KEYSPACES like_or_where_opt
+| DISTINCT
   {
-    $$ = &Show{&ShowBasic{Command: Keyspace, Filter: $3}}
+    $$ = true
   }
-| SHOW FUNCTION STATUS like_or_where_opt
+
+cache_opt:
+{
+  $$ = ""
+}
+| SQL_NO_CACHE
+{
+  $$ = SQLNoCacheStr
+}
+| SQL_CACHE
+{
+  $$ = SQLCacheStr
+}
+
+distinct_opt:
   {
-    $$ = &Show{&ShowBasic{Command: Function, Filter: $4}}
+    $$ = false
   }
-| SHOW extended_opt index_symbols from_or_in table_name from_database_opt like_or_where_opt
+| DISTINCT
   {
-    $$ = &Show{&ShowBasic{Command: Index, Tbl: $5, DbName: $6, Filter: $7}}
+    $$ = true
   }
-| SHOW OPEN TABLES from_database_opt like_or_where_opt
+| DISTINCTROW
   {
-    $$ = &Show{&ShowBasic{Command: OpenTable, DbName:$4, Filter: $5}}
+    $$ = true
   }
-| SHOW PRIVILEGES
+
+prepare_statement:
+  PREPARE comment_opt sql_id FROM text_literal_or_arg
   {
-    $$ = &Show{&ShowBasic{Command: Privilege}}
+    $$ = &PrepareStmt{Name:$3, Comments: Comments($2).Parsed(), Statement:$5}
   }
-| SHOW PROCEDURE STATUS like_or_where_opt
+| PREPARE comment_opt sql_id FROM user_defined_variable
   {
-    $$ = &Show{&ShowBasic{Command: Procedure, Filter: $4}}
+    $$ = &PrepareStmt{
+    	Name:$3,
+    	Comments: Comments($2).Parsed(),
+    	Statement: $5,
+    }
   }
-| SHOW session_or_local_opt STATUS like_or_where_opt
+
+execute_statement:
+  EXECUTE comment_opt sql_id execute_statement_list_opt
   {
-    $$ = &Show{&ShowBasic{Command: StatusSession, Filter: $4}}
+    $$ = &ExecuteStmt{Name:$3, Comments: Comments($2).Parsed(), Arguments: $4}
   }
-| SHOW GLOBAL STATUS like_or_where_opt
+
+execute_statement_list_opt: // execute db.foo(@apa) using @foo, @bar
   {
-    $$ = &Show{&ShowBasic{Command: StatusGlobal, Filter: $4}}
+    $$ = nil
   }
-| SHOW session_or_local_opt VARIABLES like_or_where_opt
+| USING at_id_list
   {
-    $$ = &Show{&ShowBasic{Command: VariableSession, Filter: $4}}
+    $$ = $2
   }
-| SHOW GLOBAL VARIABLES like_or_where_opt
+
+deallocate_statement:
+  DEALLOCATE comment_opt PREPARE sql_id
   {
-    $$ = &Show{&ShowBasic{Command: VariableGlobal, Filter: $4}}
+    $$ = &DeallocateStmt{Comments: Comments($2).Parsed(), Name:$4}
   }
-| SHOW TABLE STATUS from_database_opt like_or_where_opt
+| DROP comment_opt PREPARE sql_id
   {
-    $$ = &Show{&ShowBasic{Command: TableStatus, DbName:$4, Filter: $5}}
+    $$ = &DeallocateStmt{Comments: Comments($2).Parsed(), Name: $4}
   }
-| SHOW full_opt TABLES from_database_opt like_or_where_opt
+
+select_expression_list_opt:
   {
-    $$ = &Show{&ShowBasic{Command: Table, Full: $2, DbName:$4, Filter: $5}}
+    $$ = nil
   }
-| SHOW TRIGGERS from_database_opt like_or_where_opt
+| select_expression_list
   {
-    $$ = &Show{&ShowBasic{Command: Trigger, DbName:$3, Filter: $4}}
+    $$ = $1
   }
-| SHOW CREATE DATABASE table_name
+
+select_options:
   {
-    $$ = &Show{&ShowCreate{Command: CreateDb, Op: $4}}
+    $$ = nil
   }
-| SHOW CREATE EVENT table_name
+| select_option
   {
-    $$ = &Show{&ShowCreate{Command: CreateE, Op: $4}}
+    $$ = []string{$1}
   }
-| SHOW CREATE FUNCTION table_name
+| select_option select_option // TODO: figure out a way to do this recursively instead.
+  {                           // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
+    $$ = []string{$1, $2}
+  }
+| select_option select_option select_option
   {
-    $$ = &Show{&ShowCreate{Command: CreateF, Op: $4}}
+    $$ = []string{$1, $2, $3}
   }
-| SHOW CREATE PROCEDURE table_name
+| select_option select_option select_option select_option
   {
-    $$ = &Show{&ShowCreate{Command: CreateProc, Op: $4}}
+    $$ = []string{$1, $2, $3, $4}
   }
-| SHOW CREATE TABLE table_name
+
+select_option:
+  SQL_NO_CACHE
   {
-    $$ = &Show{&ShowCreate{Command: CreateTbl, Op: $4}}
+    $$ = SQLNoCacheStr
   }
-| SHOW CREATE TRIGGER table_name
+| SQL_CACHE
   {
-    $$ = &Show{&ShowCreate{Command: CreateTr, Op: $4}}
+    $$ = SQLCacheStr
   }
-| SHOW CREATE VIEW table_name
+| DISTINCT
   {
-    $$ = &Show{&ShowCreate{Command: CreateV, Op: $4}}
+    $$ = DistinctStr
   }
-| SHOW ENGINES
+| DISTINCTROW
   {
-    $$ = &Show{&ShowBasic{Command: Engines}}
+    $$ = DistinctStr
   }
-| SHOW PLUGINS
+| STRAIGHT_JOIN
   {
-    $$ = &Show{&ShowBasic{Command: Plugins}}
+    $$ = StraightJoinHint
   }
-| SHOW GLOBAL GTID_EXECUTED from_database_opt
+| SQL_CALC_FOUND_ROWS
   {
-    $$ = &Show{&ShowBasic{Command: GtidExecGlobal, DbName: $4}}
+    $$ = SQLCalcFoundRowsStr
   }
-| SHOW GLOBAL VGTID_EXECUTED from_database_opt
+| ALL
   {
-    $$ = &Show{&ShowBasic{Command: VGtidExecGlobal, DbName: $4}}
+    $$ = AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
   }
-| SHOW VITESS_METADATA VARIABLES like_opt
+
+select_expression_list:
+  select_expression
   {
-    $$ = &Show{&ShowBasic{Command: VitessVariables, Filter: $4}}
+    $$ = SelectExprs{$1}
   }
-| SHOW VITESS_MIGRATIONS from_database_opt like_or_where_opt
+| select_expression_list ',' select_expression
   {
-    $$ = &Show{&ShowBasic{Command: VitessMigrations, Filter: $4, DbName: $3}}
+    $$ = append($$, $3)
   }
-| SHOW VITESS_MIGRATION STRING LOGS
+
+select_expression:
+  '*'
   {
-    $$ = &ShowMigrationLogs{UUID: string($3)}
+    $$ = &StarExpr{}
   }
-| SHOW VITESS_THROTTLED_APPS
+| expression as_ci_opt
   {
-    $$ = &ShowThrottledApps{}
+    $$ = &AliasedExpr{Expr: $1, As: $2}
   }
-| SHOW VITESS_REPLICATION_STATUS like_opt
+| table_id '.' '*'
   {
-    $$ = &Show{&ShowBasic{Command: VitessReplicationStatus, Filter: $3}}
+    $$ = &StarExpr{TableName: TableName{Name: $1}}
   }
-| SHOW VITESS_THROTTLER STATUS
+| table_id '.' reserved_table_id '.' '*'
   {
-    $$ = &ShowThrottlerStatus{}
+    $$ = &StarExpr{TableName: TableName{Qualifier: $1, Name: $3}}
   }
-| SHOW VSCHEMA TABLES
+
+as_ci_opt:
   {
-    $$ = &Show{&ShowBasic{Command: VschemaTables}}
+    $$ = IdentifierCI{}
   }
-| SHOW VSCHEMA VINDEXES
+| col_alias
   {
-    $$ = &Show{&ShowBasic{Command: VschemaVindexes}}
+    $$ = $1
   }
-| SHOW VSCHEMA VINDEXES from_or_on table_name
+| AS col_alias
   {
-    $$ = &Show{&ShowBasic{Command: VschemaVindexes, Tbl: $5}}
+    $$ = $2
   }
-| SHOW WARNINGS
+
+col_alias:
+  sql_id
+| STRING
   {
-    $$ = &Show{&ShowBasic{Command: Warnings}}
+    $$ = NewIdentifierCI(string($1))
   }
-| SHOW VITESS_SHARDS like_or_where_opt
+
+from_opt:
+  %prec EMPTY_FROM_CLAUSE {
+    $$ = TableExprs{&AliasedTableExpr{Expr:TableName{Name: NewIdentifierCS("dual")}}}
+  }
+  | from_clause
   {
-    $$ = &Show{&ShowBasic{Command: VitessShards, Filter: $3}}
+  	$$ = $1
   }
-| SHOW VITESS_TABLETS like_or_where_opt
+
+from_clause:
+FROM table_references
   {
-    $$ = &Show{&ShowBasic{Command: VitessTablets, Filter: $3}}
+    $$ = $2
   }
-| SHOW VITESS_TARGET
+
+table_references:
+  table_reference
   {
-    $$ = &Show{&ShowBasic{Command: VitessTarget}}
+    $$ = TableExprs{$1}
   }
-/*
- * Catch-all for show statements without vitess keywords:
- */
-| SHOW ci_identifier ddl_skip_to_end
+| table_references ',' table_reference
   {
-    $$ = &Show{&ShowOther{Command: string($2.String())}}
+    $$ = append($$, $3)
   }
-| SHOW CREATE USER ddl_skip_to_end
+
+table_reference:
+  table_factor
+| join_table
+
+table_factor:
+  aliased_table_name
   {
-    $$ = &Show{&ShowOther{Command: string($2) + " " + string($3)}}
-   }
-| SHOW BINARY ci_identifier ddl_skip_to_end /* SHOW BINARY ... */
+    $$ = $1
+  }
+| derived_table as_opt table_id column_list_opt
   {
-    $$ = &Show{&ShowOther{Command: string($2) + " " + $3.String()}}
+    $$ = &AliasedTableExpr{Expr:$1, As: $3, Columns: $4}
   }
-| SHOW BINARY LOGS ddl_skip_to_end /* SHOW BINARY LOGS */
+| openb table_references closeb
   {
-    $$ = &Show{&ShowOther{Command: string($2) + " " + string($3)}}
+    $$ = &ParenTableExpr{Exprs: $2}
   }
-| SHOW ENGINE ddl_skip_to_end
+| json_table_function
   {
-    $$ = &Show{&ShowOther{Command: string($2)}}
+    $$ = $1
   }
-| SHOW FUNCTION CODE table_name
+
+derived_table:
+  query_expression_parens
   {
-    $$ = &Show{&ShowOther{Command: string($2) + " " + string($3) + " " + String($4)}}
+    $$ = &DerivedTable{Lateral: false, Select: $1}
   }
-| SHOW PROCEDURE CODE table_name
+| LATERAL query_expression_parens
   {
-    $$ = &Show{&ShowOther{Command: string($2) + " " + string($3) + " " + String($4)}}
+    $$ = &DerivedTable{Lateral: true, Select: $2}
   }
-| SHOW full_opt PROCESSLIST from_database_opt like_or_where_opt
+
+aliased_table_name:
+table_name as_opt_id index_hint_list_opt
   {
-    $$ = &Show{&ShowOther{Command: string($3)}}
+    $$ = &AliasedTableExpr{Expr:$1, As: $2, Hints: $3}
   }
-| SHOW STORAGE ddl_skip_to_end
+| table_name PARTITION openb partition_list closeb as_opt_id index_hint_list_opt
   {
-    $$ = &Show{&ShowOther{Command: string($2)}}
+    $$ = &AliasedTableExpr{Expr:$1, Partitions: $4, As: $6, Hints: $7}
   }
 
-extended_opt:
-  /* empty */
+column_list_opt:
   {
-    $$ = ""
+    $$ = nil
   }
-  | EXTENDED
+| '(' column_list ')'
   {
-    $$ = "extended "
+    $$ = $2
   }
 
-full_opt:
-  /* empty */
+column_list_empty:
   {
-    $$ = false
+    $$ = nil
   }
-| FULL
+| column_list
   {
-    $$ = true
+    $$ = $1
   }
 
-columns_or_fields:
-  COLUMNS
+column_list:
+  sql_id
   {
-      $$ = string($1)
+    $$ = Columns{$1}
   }
-| FIELDS
+| column_list ',' sql_id
   {
-      $$ = string($1)
+    $$ = append($$, $3)
   }
 
-from_database_opt:
-  /* empty */
+at_id_list:
+  user_defined_variable
   {
-    $$ = NewIdentifierCS("")
+    $$ = []*Variable{$1}
   }
-| FROM table_id
+| at_id_list ',' user_defined_variable
   {
-    $$ = $2
+    $$ = append($$, $3)
   }
-| IN table_id
+
+index_list:
+  sql_id
   {
-    $$ = $2
+    $$ = Columns{$1}
   }
-
-like_or_where_opt:
-  /* empty */
+| PRIMARY
   {
-    $$ = nil
+    $$ = Columns{NewIdentifierCI(string($1))}
   }
-| LIKE STRING
+| index_list ',' sql_id
   {
-    $$ = &ShowFilter{Like:string($2)}
+    $$ = append($$, $3)
   }
-| WHERE expression
+| index_list ',' PRIMARY
   {
-    $$ = &ShowFilter{Filter:$2}
+    $$ = append($$, NewIdentifierCI(string($3)))
   }
 
-like_opt:
-  /* empty */
-    {
-      $$ = nil
-    }
-  | LIKE STRING
-    {
-      $$ = &ShowFilter{Like:string($2)}
-    }
-
-session_or_local_opt:
-  /* empty */
+partition_list:
+  sql_id
   {
-    $$ = struct{}{}
+    $$ = Partitions{$1}
   }
-| SESSION
+| partition_list ',' sql_id
   {
-    $$ = struct{}{}
+    $$ = append($$, $3)
   }
-| LOCAL
+
+// There is a grammar conflict here:
+// 1: INSERT INTO a SELECT * FROM b JOIN c ON b.i = c.i
+// 2: INSERT INTO a SELECT * FROM b JOIN c ON DUPLICATE KEY UPDATE a.i = 1
+// When yacc encounters the ON clause, it cannot determine which way to
+// resolve. The %prec override below makes the parser choose the
+// first construct, which automatically makes the second construct a
+// syntax error. This is the same behavior as MySQL.
+join_table:
+  table_reference inner_join table_factor join_condition_opt
   {
-    $$ = struct{}{}
+    $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
   }
-
-from_or_on:
-  FROM
+| table_reference straight_join table_factor on_expression_opt
   {
-    $$ = string($1)
+    $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
   }
-| ON
+| table_reference outer_join table_reference join_condition
   {
-    $$ = string($1)
+    $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
+  }
+| table_reference natural_join table_factor
+  {
+    $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3}
   }
 
-use_statement:
-  USE use_table_name
+join_condition:
+  ON expression
+  { $$ = &JoinCondition{On: $2} }
+| USING '(' column_list ')'
+  { $$ = &JoinCondition{Using: $3} }
+
+join_condition_opt:
+%prec JOIN
+  { $$ = &JoinCondition{} }
+| join_condition
+  { $$ = $1 }
+
+on_expression_opt:
+%prec JOIN
+  { $$ = &JoinCondition{} }
+| ON expression
+  { $$ = &JoinCondition{On: $2} }
+
+as_opt:
+  { $$ = struct{}{} }
+| AS
+  { $$ = struct{}{} }
+
+as_opt_id:
   {
-    $$ = &Use{DBName: $2}
+    $$ = NewIdentifierCS("")
   }
-| USE
+| table_alias
   {
-    $$ = &Use{DBName:IdentifierCS{v:""}}
+    $$ = $1
   }
-| USE use_table_name AT_ID
+| AS table_alias
   {
-    $$ = &Use{DBName:NewIdentifierCS($2.String()+"@"+string($3))}
+    $$ = $2
   }
 
-// We use this because what is legal in `USE <tbl>` is not the same as in `SELECT ... FROM <tbl>`
-use_table_name:
-  ID
+table_alias:
+  table_id
+| STRING
   {
     $$ = NewIdentifierCS(string($1))
   }
-| AT_ID
+
+inner_join:
+  JOIN
   {
-    $$ = NewIdentifierCS("@"+string($1))
+    $$ = NormalJoinType
   }
-| AT_AT_ID
+| INNER JOIN
   {
-    $$ = NewIdentifierCS("@@"+string($1))
+    $$ = NormalJoinType
   }
-| non_reserved_keyword
+| CROSS JOIN
   {
-    $$ = NewIdentifierCS(string($1))
+    $$ = NormalJoinType
   }
 
-
-begin_statement:
-  BEGIN
-  {
-    $$ = &Begin{}
-  }
-| START TRANSACTION tx_chacteristics_opt
+straight_join:
+  STRAIGHT_JOIN
   {
-    $$ = &Begin{TxAccessModes: $3}
+    $$ = StraightJoinType
   }
 
-tx_chacteristics_opt:
+outer_join:
+  LEFT JOIN
   {
-    $$ = nil
+    $$ = LeftJoinType
   }
-| tx_chars
+| LEFT OUTER JOIN
   {
-    $$ = $1
+    $$ = LeftJoinType
   }
-
-tx_chars:
-  tx_char
+| RIGHT JOIN
   {
-    $$ = []TxAccessMode{$1}
+    $$ = RightJoinType
   }
-| tx_chars ',' tx_char
+| RIGHT OUTER JOIN
   {
-    $$ = append($1, $3)
+    $$ = RightJoinType
   }
 
-tx_char:
-  WITH CONSISTENT SNAPSHOT
+natural_join:
+ NATURAL JOIN
   {
-    $$ = WithConsistentSnapshot
+    $$ = NaturalJoinType
   }
-| READ WRITE
+| NATURAL outer_join
   {
-    $$ = ReadWrite
+    if $2 == LeftJoinType {
+      $$ = NaturalLeftJoinType
+    } else {
+      $$ = NaturalRightJoinType
+    }
   }
-| READ ONLY
+
+into_table_name:
+  INTO table_name
   {
-    $$ = ReadOnly
+    $$ = $2
   }
-
-
-commit_statement:
-  COMMIT
+| table_name
   {
-    $$ = &Commit{}
+    $$ = $1
   }
 
-rollback_statement:
-  ROLLBACK
+table_name:
+  table_id
   {
-    $$ = &Rollback{}
+    $$ = TableName{Name: $1}
   }
-| ROLLBACK work_opt TO savepoint_opt sql_id
+| table_id '.' reserved_table_id
   {
-    $$ = &SRollback{Name: $5}
+    $$ = TableName{Qualifier: $1, Name: $3}
   }
 
-work_opt:
-  { $$ = struct{}{} }
-| WORK
-  { $$ = struct{}{} }
-
-savepoint_opt:
-  { $$ = struct{}{} }
-| SAVEPOINT
-  { $$ = struct{}{} }
-
-savepoint_statement:
-  SAVEPOINT sql_id
+delete_table_name:
+table_id '.' '*'
   {
-    $$ = &Savepoint{Name: $2}
+    $$ = TableName{Name: $1}
   }
 
-release_statement:
-  RELEASE SAVEPOINT sql_id
+index_hint_list_opt:
   {
-    $$ = &Release{Name: $3}
+    $$ = nil
   }
-
-explain_format_opt:
+| index_hint_list
   {
-    $$ = EmptyType
+    $$ = $1
   }
-| FORMAT '=' JSON
+
+index_hint_list:
+index_hint
   {
-    $$ = JSONType
+    $$ = IndexHints{$1}
   }
-| FORMAT '=' TREE
+| index_hint_list index_hint
   {
-    $$ = TreeType
+    $$ = append($1,$2)
   }
-| FORMAT '=' VITESS
+
+index_hint:
+  USE index_or_key index_hint_for_opt openb index_list closeb
   {
-    $$ = VitessType
+    $$ = &IndexHint{Type: UseOp, ForType:$3, Indexes: $5}
   }
-| FORMAT '=' VTEXPLAIN
+| USE index_or_key index_hint_for_opt openb closeb
   {
-    $$ = VTExplainType
+    $$ = &IndexHint{Type: UseOp, ForType: $3}
   }
-| FORMAT '=' TRADITIONAL
+| IGNORE index_or_key index_hint_for_opt openb index_list closeb
   {
-    $$ = TraditionalType
+    $$ = &IndexHint{Type: IgnoreOp, ForType: $3, Indexes: $5}
   }
-| ANALYZE
+| FORCE index_or_key index_hint_for_opt openb index_list closeb
   {
-    $$ = AnalyzeType
+    $$ = &IndexHint{Type: ForceOp, ForType: $3, Indexes: $5}
   }
 
-vexplain_type_opt:
+index_hint_for_opt:
   {
-    $$ = PlanVExplainType
+    $$ = NoForType
   }
-| PLAN
+| FOR JOIN
   {
-    $$ = PlanVExplainType
+    $$ = JoinForType
   }
-| ALL
+| FOR ORDER BY
   {
-    $$ = AllVExplainType
+    $$ = OrderByForType
   }
-| QUERIES
+| FOR GROUP BY
   {
-    $$ = QueriesVExplainType
+    $$ = GroupByForType
   }
 
-explain_synonyms:
-  EXPLAIN
-  {
-    $$ = $1
-  }
-| DESCRIBE
-  {
-    $$ = $1
-  }
-| DESC
-  {
-    $$ = $1
-  }
 
-explainable_statement:
-  select_statement
+where_expression_opt:
   {
-    $$ = $1
+    $$ = nil
   }
-| update_statement
+| WHERE expression
   {
-    $$ = $1
+    $$ = $2
   }
-| insert_statement
+
+/* all possible expressions */
+expression:
+  expression OR expression %prec OR
   {
-    $$ = $1
+	$$ = &OrExpr{Left: $1, Right: $3}
   }
-| delete_statement
+| expression XOR expression %prec XOR
   {
-    $$ = $1
+	$$ = &XorExpr{Left: $1, Right: $3}
   }
-
-wild_opt:
+| expression AND expression %prec AND
   {
-    $$ = ""
+	$$ = &AndExpr{Left: $1, Right: $3}
   }
-| sql_id
+| NOT expression %prec NOT
   {
-    $$ = $1.val
+	  $$ = &NotExpr{Expr: $2}
   }
-| STRING
+| bool_pri IS is_suffix %prec IS
   {
-    $$ = encodeSQLString($1)
+	 $$ = &IsExpr{Left: $1, Right: $3}
   }
-
-explain_statement:
-  explain_synonyms comment_opt table_name wild_opt
+| bool_pri %prec EXPRESSION_PREC_SETTER
   {
-    $$ = &ExplainTab{Table: $3, Wild: $4}
+	$$ = $1
   }
-| explain_synonyms comment_opt explain_format_opt explainable_statement
+| user_defined_variable ASSIGNMENT_OPT expression %prec ASSIGNMENT_OPT
+ {
+  $$ = &AssignmentExpr{Left: $1, Right: $3}
+ }
+| expression MEMBER OF openb expression closeb
   {
-    $$ = &ExplainStmt{Type: $3, Statement: $4, Comments: Comments($2).Parsed()}
+    $$ = &MemberOfExpr{Value: $1, JSONArr:$5 }
   }
 
-vexplain_statement:
-  VEXPLAIN comment_opt vexplain_type_opt explainable_statement
+bool_pri:
+bool_pri IS NULL %prec IS
   {
-    $$ = &VExplainStmt{Type: $3, Statement: $4, Comments: Comments($2).Parsed()}
+	 $$ = &IsExpr{Left: $1, Right: IsNullOp}
   }
-
-other_statement:
-  REPAIR skip_to_end
+| bool_pri IS NOT NULL %prec IS
   {
-    $$ = &OtherAdmin{}
+  	$$ = &IsExpr{Left: $1, Right: IsNotNullOp}
   }
-| OPTIMIZE skip_to_end
+| bool_pri compare predicate
   {
-    $$ = &OtherAdmin{}
+	$$ = &ComparisonExpr{Left: $1, Operator: $2, Right: $3}
   }
-
-lock_statement:
-  LOCK TABLES lock_table_list
+| predicate %prec EXPRESSION_PREC_SETTER
   {
-    $$ = &LockTables{Tables: $3}
+	$$ = $1
   }
 
-lock_table_list:
-  lock_table
-  {
-    $$ = TableAndLockTypes{$1}
-  }
-| lock_table_list ',' lock_table
+predicate:
+bit_expr IN col_tuple
   {
-    $$ = append($1, $3)
+	$$ = &ComparisonExpr{Left: $1, Operator: InOp, Right: $3}
   }
-
-lock_table:
-  aliased_table_name lock_type
+| bit_expr NOT IN col_tuple
   {
-    $$ = &TableAndLockType{Table:$1, Lock:$2}
+	$$ = &ComparisonExpr{Left: $1, Operator: NotInOp, Right: $4}
   }
-
-lock_type:
-  READ
+| bit_expr BETWEEN bit_expr AND predicate
   {
-    $$ = Read
+	 $$ = &BetweenExpr{Left: $1, IsBetween: true, From: $3, To: $5}
   }
-| READ LOCAL
+| bit_expr NOT BETWEEN bit_expr AND predicate
   {
-    $$ = ReadLocal
+	$$ = &BetweenExpr{Left: $1, IsBetween: false, From: $4, To: $6}
   }
-| WRITE
+| bit_expr LIKE simple_expr
   {
-    $$ = Write
+	  $$ = &ComparisonExpr{Left: $1, Operator: LikeOp, Right: $3}
   }
-| LOW_PRIORITY WRITE
+| bit_expr NOT LIKE simple_expr
   {
-    $$ = LowPriorityWrite
+	$$ = &ComparisonExpr{Left: $1, Operator: NotLikeOp, Right: $4}
   }
-
-unlock_statement:
-  UNLOCK TABLES
+| bit_expr LIKE simple_expr ESCAPE simple_expr %prec LIKE
   {
-    $$ = &UnlockTables{}
+	  $$ = &ComparisonExpr{Left: $1, Operator: LikeOp, Right: $3, Escape: $5}
   }
-
-revert_statement:
-  REVERT comment_opt VITESS_MIGRATION STRING
+| bit_expr NOT LIKE simple_expr ESCAPE simple_expr %prec LIKE
   {
-    $$ = &RevertMigration{Comments: Comments($2).Parsed(), UUID: string($4)}
+	$$ = &ComparisonExpr{Left: $1, Operator: NotLikeOp, Right: $4, Escape: $6}
   }
-
-flush_statement:
-  FLUSH local_opt flush_option_list
+| bit_expr ILIKE simple_expr
   {
-    $$ = &Flush{IsLocal: $2, FlushOptions:$3}
+	  $$ = &ComparisonExpr{Left: $1, Operator: ILikeOp, Right: $3}
   }
-| FLUSH local_opt TABLES
+| bit_expr NOT ILIKE simple_expr
   {
-    $$ = &Flush{IsLocal: $2}
+	$$ = &ComparisonExpr{Left: $1, Operator: NotILikeOp, Right: $4}
   }
-| FLUSH local_opt TABLES WITH READ LOCK
+| bit_expr ILIKE simple_expr ESCAPE simple_expr %prec ILIKE
   {
-    $$ = &Flush{IsLocal: $2, WithLock:true}
+	  $$ = &ComparisonExpr{Left: $1, Operator: ILikeOp, Right: $3, Escape: $5}
   }
-| FLUSH local_opt TABLES table_name_list
+| bit_expr NOT ILIKE simple_expr ESCAPE simple_expr %prec ILIKE
   {
-    $$ = &Flush{IsLocal: $2, TableNames:$4}
+	$$ = &ComparisonExpr{Left: $1, Operator: NotILikeOp, Right: $4, Escape: $6}
   }
-| FLUSH local_opt TABLES table_name_list WITH READ LOCK
+| bit_expr regexp_symbol bit_expr
   {
-    $$ = &Flush{IsLocal: $2, TableNames:$4, WithLock:true}
+	$$ = &ComparisonExpr{Left: $1, Operator: RegexpOp, Right: $3}
   }
-| FLUSH local_opt TABLES table_name_list FOR EXPORT
+| bit_expr NOT regexp_symbol bit_expr
   {
-    $$ = &Flush{IsLocal: $2, TableNames:$4, ForExport:true}
+	 $$ = &ComparisonExpr{Left: $1, Operator: NotRegexpOp, Right: $4}
   }
+| bit_expr %prec EXPRESSION_PREC_SETTER
+ {
+	$$ = $1
+ }
 
-flush_option_list:
-  flush_option
+regexp_symbol:
+  REGEXP
   {
-    $$ = []string{$1}
   }
-| flush_option_list ',' flush_option
+| RLIKE
   {
-    $$ = append($1,$3)
   }
 
-flush_option:
-  BINARY LOGS
+
+bit_expr:
+bit_expr '|' bit_expr %prec '|'
   {
-    $$ = string($1) + " " + string($2)
+	  $$ = &BinaryExpr{Left: $1, Operator: BitOrOp, Right: $3}
   }
-| ENGINE LOGS
+| bit_expr '&' bit_expr %prec '&'
   {
-    $$ = string($1) + " " + string($2)
+	  $$ = &BinaryExpr{Left: $1, Operator: BitAndOp, Right: $3}
   }
-| ERROR LOGS
+| bit_expr CONCAT_OP bit_expr %prec CONCAT_OP
   {
-    $$ = string($1) + " " + string($2)
+	  $$ = &BinaryExpr{Left: $1, Operator: ConcatOp, Right: $3}
   }
-| GENERAL LOGS
+| bit_expr SHIFT_LEFT bit_expr %prec SHIFT_LEFT
   {
-    $$ = string($1) + " " + string($2)
+	  $$ = &BinaryExpr{Left: $1, Operator: ShiftLeftOp, Right: $3}
   }
-| HOSTS
+| bit_expr SHIFT_RIGHT bit_expr %prec SHIFT_RIGHT
   {
-    $$ = string($1)
+	  $$ = &BinaryExpr{Left: $1, Operator: ShiftRightOp, Right: $3}
   }
-| LOGS
+| bit_expr '+' bit_expr %prec '+'
   {
-    $$ = string($1)
+	  $$ = &BinaryExpr{Left: $1, Operator: PlusOp, Right: $3}
   }
-| PRIVILEGES
+| bit_expr '-' bit_expr %prec '-'
   {
-    $$ = string($1)
+	  $$ = &BinaryExpr{Left: $1, Operator: MinusOp, Right: $3}
   }
-| RELAY LOGS for_channel_opt
+| bit_expr '+' INTERVAL bit_expr interval %prec '+'
   {
-    $$ = string($1) + " " + string($2) + $3
+	  $$ = &DateAddExpr{Type: PlusIntervalRightType, Date: $1, Unit: $5, Expr: $4}
   }
-| SLOW LOGS
+| bit_expr '-' INTERVAL bit_expr interval %prec '-'
   {
-    $$ = string($1) + " " + string($2)
+	  $$ = &DateSubExpr{Type: MinusIntervalRightType, Date: $1, Unit: $5, Expr: $4}
   }
-| OPTIMIZER_COSTS
+| bit_expr '*' bit_expr %prec '*'
   {
-    $$ = string($1)
+	  $$ = &BinaryExpr{Left: $1, Operator: MultOp, Right: $3}
   }
-| STATUS
+| bit_expr '/' bit_expr %prec '/'
   {
-    $$ = string($1)
+	  $$ = &BinaryExpr{Left: $1, Operator: DivOp, Right: $3}
   }
-| USER_RESOURCES
+| bit_expr '%' bit_expr %prec '%'
   {
-    $$ = string($1)
+	  $$ = &BinaryExpr{Left: $1, Operator: ModOp, Right: $3}
   }
-
-local_opt:
+| bit_expr DIV bit_expr %prec DIV
   {
-    $$ = false
+	  $$ = &BinaryExpr{Left: $1, Operator: IntDivOp, Right: $3}
   }
-| LOCAL
+| bit_expr MOD bit_expr %prec MOD
   {
-    $$ = true
+	  $$ = &BinaryExpr{Left: $1, Operator: ModOp, Right: $3}
   }
-| NO_WRITE_TO_BINLOG
+| bit_expr '^' bit_expr %prec '^'
   {
-    $$ = true
+	  $$ = &BinaryExpr{Left: $1, Operator: BitXorOp, Right: $3}
+  }
+| simple_expr %prec EXPRESSION_PREC_SETTER
+  {
+	$$ = $1
   }
 
-for_channel_opt:
+simple_expr:
+function_call_keyword
   {
-    $$ = ""
+  	$$ = $1
   }
-| FOR CHANNEL ci_identifier
+| function_call_nonkeyword
   {
-    $$ = " " + string($1) + " " + string($2) + " " + $3.String()
+  	$$ = $1
   }
-
-comment_opt:
+| function_call_generic
   {
-    setAllowComments(yylex, true)
+  	$$ = $1
   }
-  comment_list
+| function_call_conflict
   {
-    $$ = $2
-    setAllowComments(yylex, false)
+  	$$ = $1
   }
-
-comment_list:
+| simple_expr COLLATE charset %prec UNARY
   {
-    $$ = nil
+	$$ = &CollateExpr{Expr: $1, Collation: $3}
   }
-| comment_list COMMENT
+| simple_expr TYPECAST pg_cast_type pg_array_opt %prec TYPECAST
   {
-    $$ = append($1, $2)
+	$$ = &TypeCastExpr{Expr: $1, Type: $3, Array: $4}
   }
-
-union_op:
-  UNION
+| POSITIONAL_ARG
   {
-    $$ = true
+	$$ = &PositionalArg{Index: convertStringToInt($1)}
   }
-| UNION ALL
+| literal_or_null
   {
-    $$ = false
+  	$$ = $1
   }
-| UNION DISTINCT
+| column_name_or_offset
   {
-    $$ = true
+  	$$ = $1
   }
-
-cache_opt:
-{
-  $$ = ""
-}
-| SQL_NO_CACHE
-{
-  $$ = SQLNoCacheStr
-}
-| SQL_CACHE
-{
-  $$ = SQLCacheStr
-}
-
-distinct_opt:
+| variable_expr
   {
-    $$ = false
+  	$$ = $1
   }
-| DISTINCT
+| '+' simple_expr %prec UNARY
   {
-    $$ = true
+	$$= $2; // TODO: do we really want to ignore unary '+' before any kind of literals?
   }
-| DISTINCTROW
+| '-' simple_expr %prec UNARY
   {
-    $$ = true
+	$$ = &UnaryExpr{Operator: UMinusOp, Expr: $2}
   }
-
-prepare_statement:
-  PREPARE comment_opt sql_id FROM text_literal_or_arg
+| '~' simple_expr %prec UNARY
   {
-    $$ = &PrepareStmt{Name:$3, Comments: Comments($2).Parsed(), Statement:$5}
+	$$ = &UnaryExpr{Operator: TildaOp, Expr: $2}
   }
-| PREPARE comment_opt sql_id FROM user_defined_variable
+| '!' simple_expr %prec UNARY
   {
-    $$ = &PrepareStmt{
-    	Name:$3,
-    	Comments: Comments($2).Parsed(),
-    	Statement: $5,
-    }
+    $$ = &UnaryExpr{Operator: BangOp, Expr: $2}
   }
-
-execute_statement:
-  EXECUTE comment_opt sql_id execute_statement_list_opt
+| subquery
   {
-    $$ = &ExecuteStmt{Name:$3, Comments: Comments($2).Parsed(), Arguments: $4}
+	$$= $1
   }
-
-execute_statement_list_opt: // execute db.foo(@apa) using @foo, @bar
+| tuple_expression
   {
-    $$ = nil
+	$$ = $1
   }
-| USING at_id_list
+| EXISTS subquery
   {
-    $$ = $2
+	$$ = &ExistsExpr{Subquery: $2}
   }
-
-deallocate_statement:
-  DEALLOCATE comment_opt PREPARE sql_id
+| MATCH column_names_opt_paren AGAINST openb bit_expr match_option closeb
   {
-    $$ = &DeallocateStmt{Comments: Comments($2).Parsed(), Name:$4}
+  $$ = &MatchExpr{Columns: $2, Expr: $5, Option: $6}
   }
-| DROP comment_opt PREPARE sql_id
+| CAST openb expression AS convert_type array_opt closeb
   {
-    $$ = &DeallocateStmt{Comments: Comments($2).Parsed(), Name: $4}
+    $$ = &CastExpr{Expr: $3, Type: $5, Array: $6}
   }
-
-select_expression_list_opt:
+| CONVERT openb expression ',' convert_type closeb
   {
-    $$ = nil
+    $$ = &ConvertExpr{Expr: $3, Type: $5}
   }
-| select_expression_list
+| CONVERT openb expression USING charset closeb
   {
-    $$ = $1
+    $$ = &ConvertUsingExpr{Expr: $3, Type: $5}
   }
-
-select_options:
+| BINARY simple_expr %prec UNARY
   {
-    $$ = nil
+    // From: https://dev.mysql.com/doc/refman/8.0/en/cast-functions.html#operator_binary
+    // To convert a string expression to a binary string, these constructs are equivalent:
+    //    CAST(expr AS BINARY)
+    //    BINARY expr
+    $$ = &ConvertExpr{Expr: $2, Type: &ConvertType{Type: $1}}
+  }
+| DEFAULT default_opt
+  {
+	 $$ = &Default{ColName: $2}
   }
-| select_option
+| INTERVAL bit_expr interval '+' bit_expr %prec INTERVAL
   {
-    $$ = []string{$1}
+	  $$ = &DateAddExpr{Type: PlusIntervalLeftType, Date: $5, Unit: $3, Expr: $2}
   }
-| select_option select_option // TODO: figure out a way to do this recursively instead.
-  {                           // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
-    $$ = []string{$1, $2}
+| INTERVAL openb expression ',' expression_list closeb
+  {
+    $$ = &IntervalFuncExpr{Expr: $3, Exprs: $5}
   }
-| select_option select_option select_option
+| column_name_or_offset JSON_EXTRACT_OP text_literal_or_arg
   {
-    $$ = []string{$1, $2, $3}
+	$$ = &BinaryExpr{Left: $1, Operator: JSONExtractOp, Right: $3}
   }
-| select_option select_option select_option select_option
+| column_name_or_offset JSON_UNQUOTE_EXTRACT_OP text_literal_or_arg
   {
-    $$ = []string{$1, $2, $3, $4}
+	$$ = &BinaryExpr{Left: $1, Operator: JSONUnquoteExtractOp, Right: $3}
   }
 
-select_option:
-  SQL_NO_CACHE
+column_names_opt_paren:
+  column_names
   {
-    $$ = SQLNoCacheStr
+    $$ = $1
   }
-| SQL_CACHE
+| openb column_names closeb
   {
-    $$ = SQLCacheStr
+    $$ = $2
   }
-| DISTINCT
+
+column_names:
+  column_name
   {
-    $$ = DistinctStr
+    $$ = []*ColName{$1}
   }
-| DISTINCTROW
+| column_names ',' column_name
   {
-    $$ = DistinctStr
+    $$ = append($1, $3)
   }
-| STRAIGHT_JOIN
+
+trim_type:
+  BOTH
   {
-    $$ = StraightJoinHint
+    $$ = BothTrimType
   }
-| SQL_CALC_FOUND_ROWS
+| LEADING
   {
-    $$ = SQLCalcFoundRowsStr
+    $$ = LeadingTrimType
   }
-| ALL
+| TRAILING
   {
-    $$ = AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
+    $$ = TrailingTrimType
   }
 
-select_expression_list:
-  select_expression
+frame_units:
+  ROWS
   {
-    $$ = SelectExprs{$1}
+    $$ = FrameRowsType
   }
-| select_expression_list ',' select_expression
+| RANGE
   {
-    $$ = append($$, $3)
+    $$ = FrameRangeType
   }
 
-select_expression:
-  '*'
+
+argument_less_window_expr_type:
+  CUME_DIST
   {
-    $$ = &StarExpr{}
+    $$ = CumeDistExprType
   }
-| expression as_ci_opt
+| DENSE_RANK
   {
-    $$ = &AliasedExpr{Expr: $1, As: $2}
+    $$ = DenseRankExprType
   }
-| table_id '.' '*'
+| PERCENT_RANK
   {
-    $$ = &StarExpr{TableName: TableName{Name: $1}}
+    $$ = PercentRankExprType
   }
-| table_id '.' reserved_table_id '.' '*'
+| RANK
   {
-    $$ = &StarExpr{TableName: TableName{Qualifier: $1, Name: $3}}
+    $$ = RankExprType
+  }
+| ROW_NUMBER
+  {
+    $$ = RowNumberExprType
   }
 
-as_ci_opt:
+frame_point:
+  CURRENT ROW
   {
-    $$ = IdentifierCI{}
+    $$ = &FramePoint{Type:CurrentRowType}
   }
-| col_alias
+| UNBOUNDED PRECEDING
   {
-    $$ = $1
+    $$ = &FramePoint{Type:UnboundedPrecedingType}
   }
-| AS col_alias
+| UNBOUNDED FOLLOWING
   {
-    $$ = $2
+    $$ = &FramePoint{Type:UnboundedFollowingType}
   }
-
-col_alias:
-  sql_id
-| STRING
+| NUM_literal PRECEDING
   {
-    $$ = NewIdentifierCI(string($1))
+    $$ = &FramePoint{Type:ExprPrecedingType, Expr:$1}
   }
-
-from_opt:
-  %prec EMPTY_FROM_CLAUSE {
-    $$ = TableExprs{&AliasedTableExpr{Expr:TableName{Name: NewIdentifierCS("dual")}}}
+| INTERVAL bit_expr interval PRECEDING
+  {
+    $$ = &FramePoint{Type:ExprPrecedingType, Expr:$2, Unit: $3}
   }
-  | from_clause
+| NUM_literal FOLLOWING
   {
-  	$$ = $1
+    $$ = &FramePoint{Type:ExprFollowingType, Expr:$1}
   }
-
-from_clause:
-FROM table_references
+| INTERVAL bit_expr interval FOLLOWING
   {
-    $$ = $2
+    $$ = &FramePoint{Type:ExprFollowingType, Expr:$2, Unit:$3}
   }
 
-table_references:
-  table_reference
+frame_clause_opt:
   {
-    $$ = TableExprs{$1}
+    $$ = nil
   }
-| table_references ',' table_reference
+| frame_clause
   {
-    $$ = append($$, $3)
+    $$ = $1
   }
 
-table_reference:
-  table_factor
-| join_table
-
-table_factor:
-  aliased_table_name
+frame_clause:
+  frame_units frame_point
   {
-    $$ = $1
+    $$ = &FrameClause{ Unit: $1, Start: $2 }
   }
-| derived_table as_opt table_id column_list_opt
+| frame_units BETWEEN frame_point AND frame_point
   {
-    $$ = &AliasedTableExpr{Expr:$1, As: $3, Columns: $4}
+    $$ = &FrameClause{ Unit: $1, Start: $3, End: $5 }
   }
-| openb table_references closeb
+
+window_partition_clause_opt:
   {
-    $$ = &ParenTableExpr{Exprs: $2}
+    $$= nil
   }
-| json_table_function
+| PARTITION BY expression_list
   {
-    $$ = $1
+    $$ = $3
   }
 
-derived_table:
-  query_expression_parens
+sql_id_opt:
   {
-    $$ = &DerivedTable{Lateral: false, Select: $1}
   }
-| LATERAL query_expression_parens
+| sql_id
   {
-    $$ = &DerivedTable{Lateral: true, Select: $2}
+    $$ = $1
   }
 
-aliased_table_name:
-table_name as_opt_id index_hint_list_opt
-  {
-    $$ = &AliasedTableExpr{Expr:$1, As: $2, Hints: $3}
-  }
-| table_name PARTITION openb partition_list closeb as_opt_id index_hint_list_opt
+window_spec:
+sql_id_opt window_partition_clause_opt order_by_opt frame_clause_opt
   {
-    $$ = &AliasedTableExpr{Expr:$1, Partitions: $4, As: $6, Hints: $7}
+    $$ = &WindowSpecification{ Name: $1, PartitionClause: $2, OrderClause: $3, FrameClause: $4}
   }
 
-column_list_opt:
+over_clause:
+  OVER openb window_spec closeb
   {
-    $$ = nil
+    $$ = &OverClause{ WindowSpec: $3 }
   }
-| '(' column_list ')'
+| OVER sql_id
   {
-    $$ = $2
+    $$ = &OverClause{WindowName: $2}
   }
 
-column_list_empty:
+null_treatment_clause_opt:
   {
     $$ = nil
   }
-| column_list
+| null_treatment_clause
+
+null_treatment_clause:
+  null_treatment_type
   {
-    $$ = $1
+    $$ = &NullTreatmentClause{$1}
   }
 
-column_list:
-  sql_id
+null_treatment_type:
+  RESPECT NULLS
   {
-    $$ = Columns{$1}
+    $$ = RespectNullsType
   }
-| column_list ',' sql_id
+| IGNORE NULLS
   {
-    $$ = append($$, $3)
+    $$ = IgnoreNullsType
   }
 
-at_id_list:
-  user_defined_variable
+first_or_last_value_expr_type:
+  FIRST_VALUE
   {
-    $$ = []*Variable{$1}
+    $$ = FirstValueExprType
   }
-| at_id_list ',' user_defined_variable
+| LAST_VALUE
   {
-    $$ = append($$, $3)
+    $$ = LastValueExprType
   }
 
-index_list:
-  sql_id
-  {
-    $$ = Columns{$1}
-  }
-| PRIMARY
+from_first_last_type:
+  FROM FIRST
   {
-    $$ = Columns{NewIdentifierCI(string($1))}
+    $$ = FromFirstType
   }
-| index_list ',' sql_id
+| FROM LAST
   {
-    $$ = append($$, $3)
+    $$ = FromLastType
   }
-| index_list ',' PRIMARY
+
+from_first_last_clause_opt:
   {
-    $$ = append($$, NewIdentifierCI(string($3)))
+    $$ = nil
   }
+| from_first_last_clause
 
-partition_list:
-  sql_id
+from_first_last_clause:
+  from_first_last_type
   {
-    $$ = Partitions{$1}
+    $$ = &FromFirstLastClause{$1}
   }
-| partition_list ',' sql_id
+
+lag_lead_expr_type:
+  LAG
   {
-    $$ = append($$, $3)
+    $$ = LagExprType
   }
-
-// There is a grammar conflict here:
-// 1: INSERT INTO a SELECT * FROM b JOIN c ON b.i = c.i
-// 2: INSERT INTO a SELECT * FROM b JOIN c ON DUPLICATE KEY UPDATE a.i = 1
-// When yacc encounters the ON clause, it cannot determine which way to
-// resolve. The %prec override below makes the parser choose the
-// first construct, which automatically makes the second construct a
-// syntax error. This is the same behavior as MySQL.
-join_table:
-  table_reference inner_join table_factor join_condition_opt
+| LEAD
   {
-    $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
+    $$ = LeadExprType
   }
-| table_reference straight_join table_factor on_expression_opt
+
+window_definition:
+  sql_id AS openb window_spec closeb
   {
-    $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
+    $$ = &WindowDefinition{Name:$1, WindowSpec:$4}
   }
-| table_reference outer_join table_reference join_condition
+
+window_definition_list:
+  window_definition
   {
-    $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
+    $$ = WindowDefinitions{$1}
   }
-| table_reference natural_join table_factor
+| window_definition_list ',' window_definition
   {
-    $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3}
+    $$ = append($1,$3)
   }
 
-join_condition:
-  ON expression
-  { $$ = &JoinCondition{On: $2} }
-| USING '(' column_list ')'
-  { $$ = &JoinCondition{Using: $3} }
-
-join_condition_opt:
-%prec JOIN
-  { $$ = &JoinCondition{} }
-| join_condition
-  { $$ = $1 }
-
-on_expression_opt:
-%prec JOIN
-  { $$ = &JoinCondition{} }
-| ON expression
-  { $$ = &JoinCondition{On: $2} }
-
-as_opt:
-  { $$ = struct{}{} }
-| AS
-  { $$ = struct{}{} }
-
-as_opt_id:
+default_opt:
+  /* empty */
   {
-    $$ = NewIdentifierCS("")
+    $$ = ""
   }
-| table_alias
+| openb ci_identifier closeb
   {
-    $$ = $1
+    $$ = string($2.String())
   }
-| AS table_alias
+
+boolean_value:
+  TRUE
   {
-    $$ = $2
+    $$ = BoolVal(true)
   }
-
-table_alias:
-  table_id
-| STRING
+| FALSE
   {
-    $$ = NewIdentifierCS(string($1))
+    $$ = BoolVal(false)
   }
 
-inner_join:
-  JOIN
+
+is_suffix:
+ TRUE
   {
-    $$ = NormalJoinType
+    $$ = IsTrueOp
   }
-| INNER JOIN
+| NOT TRUE
   {
-    $$ = NormalJoinType
+    $$ = IsNotTrueOp
   }
-| CROSS JOIN
+| FALSE
   {
-    $$ = NormalJoinType
+    $$ = IsFalseOp
   }
-
-straight_join:
-  STRAIGHT_JOIN
+| NOT FALSE
   {
-    $$ = StraightJoinType
+    $$ = IsNotFalseOp
   }
 
-outer_join:
-  LEFT JOIN
+compare:
+  '='
   {
-    $$ = LeftJoinType
+    $$ = EqualOp
   }
-| LEFT OUTER JOIN
+| '<'
   {
-    $$ = LeftJoinType
+    $$ = LessThanOp
   }
-| RIGHT JOIN
+| '>'
   {
-    $$ = RightJoinType
+    $$ = GreaterThanOp
   }
-| RIGHT OUTER JOIN
+| LE
   {
-    $$ = RightJoinType
+    $$ = LessEqualOp
   }
-
-natural_join:
- NATURAL JOIN
+| GE
   {
-    $$ = NaturalJoinType
+    $$ = GreaterEqualOp
   }
-| NATURAL outer_join
+| NE
   {
-    if $2 == LeftJoinType {
-      $$ = NaturalLeftJoinType
-    } else {
-      $$ = NaturalRightJoinType
-    }
+    $$ = NotEqualOp
   }
-
-into_table_name:
-  INTO table_name
+| NULL_SAFE_EQUAL
   {
-    $$ = $2
+    $$ = NullSafeEqualOp
   }
-| table_name
+
+col_tuple:
+  row_tuple
   {
     $$ = $1
   }
-
-table_name:
-  table_id
+| subquery
   {
-    $$ = TableName{Name: $1}
+    $$ = $1
   }
-| table_id '.' reserved_table_id
+| LIST_ARG
   {
-    $$ = TableName{Qualifier: $1, Name: $3}
+    $$ = ListArg($1[2:])
+    markBindVariable(yylex, $1[2:])
   }
 
-delete_table_name:
-table_id '.' '*'
+subquery:
+  query_expression_parens %prec SUBQUERY_AS_EXPR
   {
-    $$ = TableName{Name: $1}
+  	$$ = &Subquery{$1}
   }
 
-index_hint_list_opt:
+expression_list:
+  expression
   {
-    $$ = nil
+    $$ = Exprs{$1}
   }
-| index_hint_list
+| expression_list ',' expression
   {
-    $$ = $1
+    $$ = append($1, $3)
   }
 
-index_hint_list:
-index_hint
+/*
+  Regular function calls without special token or syntax, guaranteed to not
+  introduce side effects due to being a simple identifier
+*/
+function_call_generic:
+  sql_id openb select_expression_list_opt closeb
   {
-    $$ = IndexHints{$1}
+    $$ = &FuncExpr{Name: $1, Exprs: $3}
   }
-| index_hint_list index_hint
+| table_id '.' reserved_sql_id openb select_expression_list_opt closeb
   {
-    $$ = append($1,$2)
+    $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
   }
 
-index_hint:
-  USE index_or_key index_hint_for_opt openb index_list closeb
+/*
+  Function calls using reserved keywords, with dedicated grammar rules
+  as a result
+*/
+function_call_keyword:
+  LEFT openb select_expression_list closeb
   {
-    $$ = &IndexHint{Type: UseOp, ForType:$3, Indexes: $5}
+    $$ = &FuncExpr{Name: NewIdentifierCI("left"), Exprs: $3}
   }
-| USE index_or_key index_hint_for_opt openb closeb
+| RIGHT openb select_expression_list closeb
   {
-    $$ = &IndexHint{Type: UseOp, ForType: $3}
+    $$ = &FuncExpr{Name: NewIdentifierCI("right"), Exprs: $3}
   }
-| IGNORE index_or_key index_hint_for_opt openb index_list closeb
+| SUBSTRING openb expression ',' expression ',' expression closeb
   {
-    $$ = &IndexHint{Type: IgnoreOp, ForType: $3, Indexes: $5}
+    $$ = &SubstrExpr{Name: $3, From: $5, To: $7}
   }
-| FORCE index_or_key index_hint_for_opt openb index_list closeb
+| SUBSTRING openb expression ',' expression closeb
   {
-    $$ = &IndexHint{Type: ForceOp, ForType: $3, Indexes: $5}
+    $$ = &SubstrExpr{Name: $3, From: $5}
   }
-
-index_hint_for_opt:
+| SUBSTRING openb expression FROM expression FOR expression closeb
   {
-    $$ = NoForType
+  	$$ = &SubstrExpr{Name: $3, From: $5, To: $7}
   }
-| FOR JOIN
+| SUBSTRING openb expression FROM expression closeb
   {
-    $$ = JoinForType
+  	$$ = &SubstrExpr{Name: $3, From: $5}
   }
-| FOR ORDER BY
+| CASE expression_opt when_expression_list else_expression_opt END
   {
-    $$ = OrderByForType
+    $$ = &CaseExpr{Expr: $2, Whens: $3, Else: $4}
   }
-| FOR GROUP BY
+| VALUES openb column_name closeb
   {
-    $$ = GroupByForType
+    $$ = &ValuesFuncExpr{Name: $3}
   }
-
-
-where_expression_opt:
+| INSERT openb expression ',' expression ',' expression ',' expression closeb
   {
-    $$ = nil
+    $$ = &InsertExpr{Str: $3, Pos: $5, Len: $7, NewStr: $9}
   }
-| WHERE expression
+| CURRENT_USER func_paren_opt
   {
-    $$ = $2
+    $$ =  &FuncExpr{Name: NewIdentifierCI($1)}
   }
 
-/* all possible expressions */
-expression:
-  expression OR expression %prec OR
-  {
-	$$ = &OrExpr{Left: $1, Right: $3}
-  }
-| expression XOR expression %prec XOR
+/*
+  Function calls using non reserved keywords but with special syntax forms.
+  Dedicated grammar rules are needed because of the special syntax
+*/
+function_call_nonkeyword:
+/* doesn't support fsp */
+UTC_DATE func_paren_opt
   {
-	$$ = &XorExpr{Left: $1, Right: $3}
+    $$ = &FuncExpr{Name:NewIdentifierCI("utc_date")}
   }
-| expression AND expression %prec AND
+| now
   {
-	$$ = &AndExpr{Left: $1, Right: $3}
+  	$$ = $1
   }
-| NOT expression %prec NOT
+| COUNT openb '*' closeb
   {
-	  $$ = &NotExpr{Expr: $2}
+    $$ = &CountStar{}
   }
-| bool_pri IS is_suffix %prec IS
+| COUNT openb distinct_opt expression_list closeb
   {
-	 $$ = &IsExpr{Left: $1, Right: $3}
+    $$ = &Count{Distinct:$3, Args:$4}
   }
-| bool_pri %prec EXPRESSION_PREC_SETTER
+| MAX openb distinct_opt expression closeb
   {
-	$$ = $1
+    $$ = &Max{Distinct:$3, Arg:$4}
   }
-| user_defined_variable ASSIGNMENT_OPT expression %prec ASSIGNMENT_OPT
- {
-  $$ = &AssignmentExpr{Left: $1, Right: $3}
- }
-| expression MEMBER OF openb expression closeb
+| MIN openb distinct_opt expression closeb
   {
-    $$ = &MemberOfExpr{Value: $1, JSONArr:$5 }
-  }
-
-bool_pri:
-bool_pri IS NULL %prec IS
+    $$ = &Min{Distinct:$3, Arg:$4}
+  }
+| SUM openb distinct_opt expression closeb
   {
-	 $$ = &IsExpr{Left: $1, Right: IsNullOp}
+    $$ = &Sum{Distinct:$3, Arg:$4}
   }
-| bool_pri IS NOT NULL %prec IS
+| AVG openb distinct_opt expression closeb
   {
-  	$$ = &IsExpr{Left: $1, Right: IsNotNullOp}
+    $$ = &Avg{Distinct:$3, Arg:$4}
   }
-| bool_pri compare predicate
+| BIT_AND openb expression closeb
   {
-	$$ = &ComparisonExpr{Left: $1, Operator: $2, Right: $3}
+    $$ = &BitAnd{Arg:$3}
   }
-| predicate %prec EXPRESSION_PREC_SETTER
+| BIT_OR openb expression closeb
   {
-	$$ = $1
+    $$ = &BitOr{Arg:$3}
   }
-
-predicate:
-bit_expr IN col_tuple
+| BIT_XOR openb expression closeb
+   {
+     $$ = &BitXor{Arg:$3}
+   }
+| STD openb expression closeb
+    {
+      $$ = &Std{Arg:$3}
+    }
+| STDDEV openb expression closeb
+    {
+      $$ = &StdDev{Arg:$3}
+    }
+| STDDEV_POP openb expression closeb
+    {
+      $$ = &StdPop{Arg:$3}
+    }
+| STDDEV_SAMP openb expression closeb
+    {
+      $$ = &StdSamp{Arg:$3}
+    }
+| VAR_POP openb expression closeb
+     {
+       $$ = &VarPop{Arg:$3}
+     }
+| VAR_SAMP openb expression closeb
+     {
+       $$ = &VarSamp{Arg:$3}
+     }
+| VARIANCE openb expression closeb
+     {
+       $$ = &Variance{Arg:$3}
+     }
+| GROUP_CONCAT openb distinct_opt expression_list order_by_opt separator_opt limit_opt closeb
   {
-	$$ = &ComparisonExpr{Left: $1, Operator: InOp, Right: $3}
+    $$ = &GroupConcatExpr{Distinct: $3, Exprs: $4, OrderBy: $5, Separator: $6, Limit: $7}
   }
-| bit_expr NOT IN col_tuple
+| TIMESTAMPADD openb sql_id ',' expression ',' expression closeb
   {
-	$$ = &ComparisonExpr{Left: $1, Operator: NotInOp, Right: $4}
+    $$ = &TimestampFuncExpr{Name:string("timestampadd"), Unit:$3.String(), Expr1:$5, Expr2:$7}
   }
-| bit_expr BETWEEN bit_expr AND predicate
+| TIMESTAMPDIFF openb sql_id ',' expression ',' expression closeb
   {
-	 $$ = &BetweenExpr{Left: $1, IsBetween: true, From: $3, To: $5}
+    $$ = &TimestampFuncExpr{Name:string("timestampdiff"), Unit:$3.String(), Expr1:$5, Expr2:$7}
   }
-| bit_expr NOT BETWEEN bit_expr AND predicate
+| EXTRACT openb interval FROM expression closeb
   {
-	$$ = &BetweenExpr{Left: $1, IsBetween: false, From: $4, To: $6}
+	$$ = &ExtractFuncExpr{IntervalTypes: $3, Expr: $5}
   }
-| bit_expr LIKE simple_expr
+| WEIGHT_STRING openb expression convert_type_weight_string closeb
   {
-	  $$ = &ComparisonExpr{Left: $1, Operator: LikeOp, Right: $3}
+    $$ = &WeightStringFuncExpr{Expr: $3, As: $4}
   }
-| bit_expr NOT LIKE simple_expr
+| JSON_PRETTY openb expression closeb
   {
-	$$ = &ComparisonExpr{Left: $1, Operator: NotLikeOp, Right: $4}
+    $$ = &JSONPrettyExpr{JSONVal: $3}
   }
-| bit_expr LIKE simple_expr ESCAPE simple_expr %prec LIKE
+| JSON_STORAGE_FREE openb expression closeb
   {
-	  $$ = &ComparisonExpr{Left: $1, Operator: LikeOp, Right: $3, Escape: $5}
+    $$ = &JSONStorageFreeExpr{ JSONVal: $3}
   }
-| bit_expr NOT LIKE simple_expr ESCAPE simple_expr %prec LIKE
+| JSON_STORAGE_SIZE openb expression closeb
   {
-	$$ = &ComparisonExpr{Left: $1, Operator: NotLikeOp, Right: $4, Escape: $6}
+    $$ = &JSONStorageSizeExpr{ JSONVal: $3}
   }
-| bit_expr regexp_symbol bit_expr
+| LTRIM openb expression closeb
   {
-	$$ = &ComparisonExpr{Left: $1, Operator: RegexpOp, Right: $3}
+    $$ = &TrimFuncExpr{TrimFuncType:LTrimType, Type: LeadingTrimType, StringArg: $3}
   }
-| bit_expr NOT regexp_symbol bit_expr
+| RTRIM openb expression closeb
   {
-	 $$ = &ComparisonExpr{Left: $1, Operator: NotRegexpOp, Right: $4}
+    $$ = &TrimFuncExpr{TrimFuncType:RTrimType, Type: TrailingTrimType, StringArg: $3}
   }
-| bit_expr %prec EXPRESSION_PREC_SETTER
- {
-	$$ = $1
- }
-
-regexp_symbol:
-  REGEXP
+| TRIM openb trim_type expression_opt FROM expression closeb
   {
+    $$ = &TrimFuncExpr{Type:$3, TrimArg:$4, StringArg: $6}
   }
-| RLIKE
+| TRIM openb expression closeb
   {
+    $$ = &TrimFuncExpr{StringArg: $3}
   }
-
-
-bit_expr:
-bit_expr '|' bit_expr %prec '|'
+| CHAR openb expression_list closeb
   {
-	  $$ = &BinaryExpr{Left: $1, Operator: BitOrOp, Right: $3}
+    $$ = &CharExpr{Exprs: $3}
   }
-| bit_expr '&' bit_expr %prec '&'
+| CHAR openb expression_list USING charset closeb
   {
-	  $$ = &BinaryExpr{Left: $1, Operator: BitAndOp, Right: $3}
+    $$ = &CharExpr{Exprs: $3, Charset: $5}
   }
-| bit_expr SHIFT_LEFT bit_expr %prec SHIFT_LEFT
+| TRIM openb expression FROM expression closeb
   {
-	  $$ = &BinaryExpr{Left: $1, Operator: ShiftLeftOp, Right: $3}
+    $$ = &TrimFuncExpr{TrimArg:$3, StringArg: $5}
   }
-| bit_expr SHIFT_RIGHT bit_expr %prec SHIFT_RIGHT
+| LOCATE openb expression ',' expression closeb
   {
-	  $$ = &BinaryExpr{Left: $1, Operator: ShiftRightOp, Right: $3}
+    $$ = &LocateExpr{SubStr: $3, Str: $5}
   }
-| bit_expr '+' bit_expr %prec '+'
+| LOCATE openb expression ',' expression ',' expression closeb
   {
-	  $$ = &BinaryExpr{Left: $1, Operator: PlusOp, Right: $3}
+    $$ = &LocateExpr{SubStr: $3, Str: $5, Pos: $7}
   }
-| bit_expr '-' bit_expr %prec '-'
+| POSITION openb bit_expr IN expression closeb
   {
-	  $$ = &BinaryExpr{Left: $1, Operator: MinusOp, Right: $3}
+    $$ = &LocateExpr{SubStr: $3, Str: $5}
   }
-| bit_expr '+' INTERVAL bit_expr interval %prec '+'
+| GET_LOCK openb expression ',' expression closeb
   {
-	  $$ = &DateAddExpr{Type: PlusIntervalRightType, Date: $1, Unit: $5, Expr: $4}
+    $$ = &LockingFunc{Type: GetLock, Name:$3, Timeout:$5}
   }
-| bit_expr '-' INTERVAL bit_expr interval %prec '-'
+| IS_FREE_LOCK openb expression closeb
   {
-	  $$ = &DateSubExpr{Type: MinusIntervalRightType, Date: $1, Unit: $5, Expr: $4}
+    $$ = &LockingFunc{Type: IsFreeLock, Name:$3}
   }
-| bit_expr '*' bit_expr %prec '*'
+| IS_USED_LOCK openb expression closeb
   {
-	  $$ = &BinaryExpr{Left: $1, Operator: MultOp, Right: $3}
+    $$ = &LockingFunc{Type: IsUsedLock, Name:$3}
   }
-| bit_expr '/' bit_expr %prec '/'
+| RELEASE_ALL_LOCKS openb closeb
   {
-	  $$ = &BinaryExpr{Left: $1, Operator: DivOp, Right: $3}
+    $$ = &LockingFunc{Type: ReleaseAllLocks}
   }
-| bit_expr '%' bit_expr %prec '%'
+| RELEASE_LOCK openb expression closeb
   {
-	  $$ = &BinaryExpr{Left: $1, Operator: ModOp, Right: $3}
+    $$ = &LockingFunc{Type: ReleaseLock, Name:$3}
   }
-| bit_expr DIV bit_expr %prec DIV
+| JSON_SCHEMA_VALID openb expression ',' expression closeb
   {
-	  $$ = &BinaryExpr{Left: $1, Operator: IntDivOp, Right: $3}
+    $$ = &JSONSchemaValidFuncExpr{ Schema: $3, Document: $5}
   }
-| bit_expr MOD bit_expr %prec MOD
+| JSON_SCHEMA_VALIDATION_REPORT openb expression ',' expression closeb
   {
-	  $$ = &BinaryExpr{Left: $1, Operator: ModOp, Right: $3}
+    $$ = &JSONSchemaValidationReportFuncExpr{ Schema: $3, Document: $5}
   }
-| bit_expr '^' bit_expr %prec '^'
+| JSON_ARRAY openb expression_list_opt closeb
   {
-	  $$ = &BinaryExpr{Left: $1, Operator: BitXorOp, Right: $3}
+    $$ = &JSONArrayExpr{ Params:$3 }
   }
-| simple_expr %prec EXPRESSION_PREC_SETTER
+| ST_AsBinary openb expression closeb
   {
-	$$ = $1
+    $$ = &GeomFormatExpr{ FormatType: BinaryFormat, Geom: $3}
   }
-
-simple_expr:
-function_call_keyword
+| ST_AsBinary openb expression ',' expression closeb
   {
-  	$$ = $1
+    $$ = &GeomFormatExpr{ FormatType: BinaryFormat, Geom: $3, AxisOrderOpt: $5 }
   }
-| function_call_nonkeyword
+| ST_AsText openb expression closeb
   {
-  	$$ = $1
+    $$ = &GeomFormatExpr{ FormatType: TextFormat, Geom: $3}
   }
-| function_call_generic
+| ST_AsText openb expression ',' expression closeb
   {
-  	$$ = $1
+    $$ = &GeomFormatExpr{ FormatType: TextFormat, Geom: $3, AxisOrderOpt: $5 }
   }
-| function_call_conflict
+| ST_IsEmpty openb expression closeb
   {
-  	$$ = $1
+    $$ = &GeomPropertyFuncExpr{ Property: IsEmpty, Geom: $3}
   }
-| simple_expr COLLATE charset %prec UNARY
+| ST_IsSimple openb expression closeb
   {
-	$$ = &CollateExpr{Expr: $1, Collation: $3}
+    $$ = &GeomPropertyFuncExpr{ Property: IsSimple, Geom: $3}
   }
-| literal_or_null
+| ST_Dimension openb expression closeb
   {
-  	$$ = $1
+    $$ = &GeomPropertyFuncExpr{ Property: Dimension, Geom: $3}
   }
-| column_name_or_offset
+| ST_Envelope openb expression closeb
   {
-  	$$ = $1
+    $$ = &GeomPropertyFuncExpr{ Property: Envelope, Geom: $3}
   }
-| variable_expr
+| ST_GeometryType openb expression closeb
   {
-  	$$ = $1
+    $$ = &GeomPropertyFuncExpr{ Property: GeometryType, Geom: $3}
   }
-| '+' simple_expr %prec UNARY
+| ST_Latitude openb expression closeb
   {
-	$$= $2; // TODO: do we really want to ignore unary '+' before any kind of literals?
+    $$ = &PointPropertyFuncExpr{ Property: Latitude, Point: $3}
   }
-| '-' simple_expr %prec UNARY
+| ST_Latitude openb expression ',' expression closeb
   {
-	$$ = &UnaryExpr{Operator: UMinusOp, Expr: $2}
+    $$ = &PointPropertyFuncExpr{ Property: Latitude, Point: $3, ValueToSet: $5}
   }
-| '~' simple_expr %prec UNARY
+| ST_Longitude openb expression closeb
   {
-	$$ = &UnaryExpr{Operator: TildaOp, Expr: $2}
+    $$ = &PointPropertyFuncExpr{ Property: Longitude, Point: $3}
   }
-| '!' simple_expr %prec UNARY
+| ST_Longitude openb expression ',' expression closeb
   {
-    $$ = &UnaryExpr{Operator: BangOp, Expr: $2}
+    $$ = &PointPropertyFuncExpr{ Property: Longitude, Point: $3, ValueToSet: $5}
   }
-| subquery
+| ST_EndPoint openb expression closeb
   {
-	$$= $1
+    $$ = &LinestrPropertyFuncExpr{ Property: EndPoint, Linestring: $3}
   }
-| tuple_expression
+| ST_IsClosed openb expression closeb
   {
-	$$ = $1
+    $$ = &LinestrPropertyFuncExpr{ Property: IsClosed, Linestring: $3}
   }
-| EXISTS subquery
+| ST_Length openb expression closeb
   {
-	$$ = &ExistsExpr{Subquery: $2}
+    $$ = &LinestrPropertyFuncExpr{ Property: Length, Linestring: $3}
   }
-| MATCH column_names_opt_paren AGAINST openb bit_expr match_option closeb
+| ST_Length openb expression ',' expression closeb
   {
-  $$ = &MatchExpr{Columns: $2, Expr: $5, Option: $6}
+    $$ = &LinestrPropertyFuncExpr{ Property: Length, Linestring: $3, PropertyDefArg: $5}
   }
-| CAST openb expression AS convert_type array_opt closeb
+| ST_NumPoints openb expression closeb
   {
-    $$ = &CastExpr{Expr: $3, Type: $5, Array: $6}
+    $$ = &LinestrPropertyFuncExpr{ Property: NumPoints, Linestring: $3}
   }
-| CONVERT openb expression ',' convert_type closeb
+| ST_PointN openb expression ',' expression closeb
   {
-    $$ = &ConvertExpr{Expr: $3, Type: $5}
+    $$ = &LinestrPropertyFuncExpr{ Property: PointN, Linestring: $3, PropertyDefArg: $5}
   }
-| CONVERT openb expression USING charset closeb
+| ST_StartPoint openb expression closeb
   {
-    $$ = &ConvertUsingExpr{Expr: $3, Type: $5}
+    $$ = &LinestrPropertyFuncExpr{ Property: StartPoint, Linestring: $3}
   }
-| BINARY simple_expr %prec UNARY
+| ST_X openb expression closeb
   {
-    // From: https://dev.mysql.com/doc/refman/8.0/en/cast-functions.html#operator_binary
-    // To convert a string expression to a binary string, these constructs are equivalent:
-    //    CAST(expr AS BINARY)
-    //    BINARY expr
-    $$ = &ConvertExpr{Expr: $2, Type: &ConvertType{Type: $1}}
+    $$ = &PointPropertyFuncExpr{ Property: XCordinate, Point: $3}
   }
-| DEFAULT default_opt
+| ST_X openb expression ',' expression closeb
   {
-	 $$ = &Default{ColName: $2}
+    $$ = &PointPropertyFuncExpr{ Property: XCordinate, Point: $3, ValueToSet: $5}
   }
-| INTERVAL bit_expr interval '+' bit_expr %prec INTERVAL
+| ST_Y openb expression closeb
   {
-	  $$ = &DateAddExpr{Type: PlusIntervalLeftType, Date: $5, Unit: $3, Expr: $2}
+    $$ = &PointPropertyFuncExpr{ Property: YCordinate, Point: $3}
   }
-| INTERVAL openb expression ',' expression_list closeb
+| ST_Y openb expression ',' expression closeb
   {
-    $$ = &IntervalFuncExpr{Expr: $3, Exprs: $5}
+    $$ = &PointPropertyFuncExpr{ Property: YCordinate, Point: $3, ValueToSet: $5}
   }
-| column_name_or_offset JSON_EXTRACT_OP text_literal_or_arg
+| ST_GeometryFromText openb expression closeb
   {
-	$$ = &BinaryExpr{Left: $1, Operator: JSONExtractOp, Right: $3}
+    $$ = &GeomFromTextExpr{ Type: GeometryFromText, WktText: $3 }
   }
-| column_name_or_offset JSON_UNQUOTE_EXTRACT_OP text_literal_or_arg
+| ST_GeometryFromText openb expression ',' expression closeb
   {
-	$$ = &BinaryExpr{Left: $1, Operator: JSONUnquoteExtractOp, Right: $3}
+    $$ = &GeomFromTextExpr{ Type: GeometryFromText, WktText: $3, Srid: $5 }
   }
-
-column_names_opt_paren:
-  column_names
+| ST_GeometryFromText openb expression ',' expression ',' expression closeb
   {
-    $$ = $1
+    $$ = &GeomFromTextExpr{ Type: GeometryFromText, WktText: $3, Srid: $5, AxisOrderOpt: $7 }
   }
-| openb column_names closeb
+|  ST_GeometryCollectionFromText openb expression closeb
   {
-    $$ = $2
+    $$ = &GeomFromTextExpr{ Type: GeometryCollectionFromText, WktText: $3 }
   }
-
-column_names:
-  column_name
+| ST_GeometryCollectionFromText openb expression ',' expression closeb
   {
-    $$ = []*ColName{$1}
+    $$ = &GeomFromTextExpr{ Type: GeometryCollectionFromText, WktText: $3, Srid: $5 }
   }
-| column_names ',' column_name
+| ST_GeometryCollectionFromText openb expression ',' expression ',' expression closeb
   {
-    $$ = append($1, $3)
+    $$ = &GeomFromTextExpr{ Type: GeometryCollectionFromText, WktText: $3, Srid: $5, AxisOrderOpt: $7 }
   }
-
-trim_type:
-  BOTH
+| ST_LineStringFromText openb expression closeb
   {
-    $$ = BothTrimType
+    $$ = &GeomFromTextExpr{ Type: LineStringFromText, WktText: $3 }
   }
-| LEADING
+| ST_LineStringFromText openb expression ',' expression closeb
   {
-    $$ = LeadingTrimType
+    $$ = &GeomFromTextExpr{ Type: LineStringFromText, WktText: $3, Srid: $5 }
   }
-| TRAILING
+| ST_LineStringFromText openb expression ',' expression ',' expression closeb
   {
-    $$ = TrailingTrimType
+    $$ = &GeomFromTextExpr{ Type: LineStringFromText, WktText: $3, Srid: $5, AxisOrderOpt: $7 }
   }
-
-frame_units:
-  ROWS
+| ST_MultiLineStringFromText openb expression closeb
   {
-    $$ = FrameRowsType
+    $$ = &GeomFromTextExpr{ Type: MultiLinestringFromText, WktText: $3 }
   }
-| RANGE
+| ST_MultiLineStringFromText openb expression ',' expression closeb
   {
-    $$ = FrameRangeType
+    $$ = &GeomFromTextExpr{ Type: MultiLinestringFromText, WktText: $3, Srid: $5 }
   }
-
-
-argument_less_window_expr_type:
-  CUME_DIST
+| ST_MultiLineStringFromText openb expression ',' expression ',' expression closeb
   {
-    $$ = CumeDistExprType
+    $$ = &GeomFromTextExpr{ Type: MultiLinestringFromText, WktText: $3, Srid: $5, AxisOrderOpt: $7 }
   }
-| DENSE_RANK
+| ST_MultiPointFromText openb expression closeb
   {
-    $$ = DenseRankExprType
+    $$ = &GeomFromTextExpr{ Type: MultiPointFromText, WktText: $3 }
   }
-| PERCENT_RANK
+| ST_MultiPointFromText openb expression ',' expression closeb
   {
-    $$ = PercentRankExprType
+    $$ = &GeomFromTextExpr{ Type: MultiPointFromText, WktText: $3, Srid: $5 }
   }
-| RANK
+| ST_MultiPointFromText openb expression ',' expression ',' expression closeb
   {
-    $$ = RankExprType
+    $$ = &GeomFromTextExpr{ Type: MultiPointFromText, WktText: $3, Srid: $5, AxisOrderOpt: $7 }
   }
-| ROW_NUMBER
+| ST_MultiPolygonFromText openb expression closeb
   {
-    $$ = RowNumberExprType
+    $$ = &GeomFromTextExpr{ Type: MultiPolygonFromText, WktText: $3 }
   }
-
-frame_point:
-  CURRENT ROW
+| ST_MultiPolygonFromText openb expression ',' expression closeb
   {
-    $$ = &FramePoint{Type:CurrentRowType}
+    $$ = &GeomFromTextExpr{ Type: MultiPolygonFromText, WktText: $3, Srid: $5 }
   }
-| UNBOUNDED PRECEDING
+| ST_MultiPolygonFromText openb expression ',' expression ',' expression closeb
   {
-    $$ = &FramePoint{Type:UnboundedPrecedingType}
+    $$ = &GeomFromTextExpr{ Type: MultiPolygonFromText, WktText: $3, Srid: $5, AxisOrderOpt: $7 }
   }
-| UNBOUNDED FOLLOWING
+| ST_PointFromText openb expression closeb
   {
-    $$ = &FramePoint{Type:UnboundedFollowingType}
+    $$ = &GeomFromTextExpr{ Type: PointFromText, WktText: $3 }
   }
-| NUM_literal PRECEDING
+| ST_PointFromText openb expression ',' expression closeb
   {
-    $$ = &FramePoint{Type:ExprPrecedingType, Expr:$1}
+    $$ = &GeomFromTextExpr{ Type: PointFromText, WktText: $3, Srid: $5 }
   }
-| INTERVAL bit_expr interval PRECEDING
+| ST_PointFromText openb expression ',' expression ',' expression closeb
   {
-    $$ = &FramePoint{Type:ExprPrecedingType, Expr:$2, Unit: $3}
+    $$ = &GeomFromTextExpr{ Type: PointFromText, WktText: $3, Srid: $5, AxisOrderOpt: $7 }
   }
-| NUM_literal FOLLOWING
+| ST_PolygonFromText openb expression closeb
   {
-    $$ = &FramePoint{Type:ExprFollowingType, Expr:$1}
+    $$ = &GeomFromTextExpr{ Type: PolygonFromText, WktText: $3 }
   }
-| INTERVAL bit_expr interval FOLLOWING
+| ST_PolygonFromText openb expression ',' expression closeb
   {
-    $$ = &FramePoint{Type:ExprFollowingType, Expr:$2, Unit:$3}
+    $$ = &GeomFromTextExpr{ Type: PolygonFromText, WktText: $3, Srid: $5 }
   }
-
-frame_clause_opt:
+| ST_PolygonFromText openb expression ',' expression ',' expression closeb
   {
-    $$ = nil
+    $$ = &GeomFromTextExpr{ Type: PolygonFromText, WktText: $3, Srid: $5, AxisOrderOpt: $7 }
   }
-| frame_clause
+| ST_GeometryFromWKB openb expression closeb
   {
-    $$ = $1
+    $$ = &GeomFromWKBExpr{ Type: GeometryFromWKB, WkbBlob: $3 }
   }
-
-frame_clause:
-  frame_units frame_point
+| ST_GeometryFromWKB openb expression ',' expression closeb
   {
-    $$ = &FrameClause{ Unit: $1, Start: $2 }
+    $$ = &GeomFromWKBExpr{ Type: GeometryFromWKB, WkbBlob: $3, Srid: $5 }
   }
-| frame_units BETWEEN frame_point AND frame_point
+| ST_GeometryFromWKB openb expression ',' expression ',' expression closeb
   {
-    $$ = &FrameClause{ Unit: $1, Start: $3, End: $5 }
+    $$ = &GeomFromWKBExpr{ Type: GeometryFromWKB, WkbBlob: $3, Srid: $5, AxisOrderOpt: $7 }
   }
-
-window_partition_clause_opt:
+|  ST_GeometryCollectionFromWKB openb expression closeb
   {
-    $$= nil
+    $$ = &GeomFromWKBExpr{ Type: GeometryCollectionFromWKB, WkbBlob: $3 }
   }
-| PARTITION BY expression_list
+| ST_GeometryCollectionFromWKB openb expression ',' expression closeb
   {
-    $$ = $3
+    $$ = &GeomFromWKBExpr{ Type: GeometryCollectionFromWKB, WkbBlob: $3, Srid: $5 }
   }
-
-sql_id_opt:
+| ST_GeometryCollectionFromWKB openb expression ',' expression ',' expression closeb
   {
+    $$ = &GeomFromWKBExpr{ Type: GeometryCollectionFromWKB, WkbBlob: $3, Srid: $5, AxisOrderOpt: $7 }
   }
-| sql_id
+| ST_LineStringFromWKB openb expression closeb
   {
-    $$ = $1
+    $$ = &GeomFromWKBExpr{ Type: LineStringFromWKB, WkbBlob: $3 }
   }
-
-window_spec:
-sql_id_opt window_partition_clause_opt order_by_opt frame_clause_opt
+| ST_LineStringFromWKB openb expression ',' expression closeb
   {
-    $$ = &WindowSpecification{ Name: $1, PartitionClause: $2, OrderClause: $3, FrameClause: $4}
+    $$ = &GeomFromWKBExpr{ Type: LineStringFromWKB, WkbBlob: $3, Srid: $5 }
   }
-
-over_clause:
-  OVER openb window_spec closeb
+| ST_LineStringFromWKB openb expression ',' expression ',' expression closeb
   {
-    $$ = &OverClause{ WindowSpec: $3 }
+    $$ = &GeomFromWKBExpr{ Type: LineStringFromWKB, WkbBlob: $3, Srid: $5, AxisOrderOpt: $7 }
   }
-| OVER sql_id
+| ST_MultiLineStringFromWKB openb expression closeb
   {
-    $$ = &OverClause{WindowName: $2}
+    $$ = &GeomFromWKBExpr{ Type: MultiLinestringFromWKB, WkbBlob: $3 }
   }
-
-null_treatment_clause_opt:
+| ST_MultiLineStringFromWKB openb expression ',' expression closeb
   {
-    $$ = nil
+    $$ = &GeomFromWKBExpr{ Type: MultiLinestringFromWKB, WkbBlob: $3, Srid: $5 }
   }
-| null_treatment_clause
-
-null_treatment_clause:
-  null_treatment_type
+| ST_MultiLineStringFromWKB openb expression ',' expression ',' expression closeb
   {
-    $$ = &NullTreatmentClause{$1}
+    $$ = &GeomFromWKBExpr{ Type: MultiLinestringFromWKB, WkbBlob: $3, Srid: $5, AxisOrderOpt: $7 }
   }
-
-null_treatment_type:
-  RESPECT NULLS
+| ST_MultiPointFromWKB openb expression closeb
   {
-    $$ = RespectNullsType
+    $$ = &GeomFromWKBExpr{ Type: MultiPointFromWKB, WkbBlob: $3 }
   }
-| IGNORE NULLS
+| ST_MultiPointFromWKB openb expression ',' expression closeb
   {
-    $$ = IgnoreNullsType
+    $$ = &GeomFromWKBExpr{ Type: MultiPointFromWKB, WkbBlob: $3, Srid: $5 }
   }
-
-first_or_last_value_expr_type:
-  FIRST_VALUE
+| ST_MultiPointFromWKB openb expression ',' expression ',' expression closeb
   {
-    $$ = FirstValueExprType
+    $$ = &GeomFromWKBExpr{ Type: MultiPointFromWKB, WkbBlob: $3, Srid: $5, AxisOrderOpt: $7 }
   }
-| LAST_VALUE
+| ST_MultiPolygonFromWKB openb expression closeb
   {
-    $$ = LastValueExprType
+    $$ = &GeomFromWKBExpr{ Type: MultiPolygonFromWKB, WkbBlob: $3 }
   }
-
-from_first_last_type:
-  FROM FIRST
+| ST_MultiPolygonFromWKB openb expression ',' expression closeb
   {
-    $$ = FromFirstType
+    $$ = &GeomFromWKBExpr{ Type: MultiPolygonFromWKB, WkbBlob: $3, Srid: $5 }
   }
-| FROM LAST
+| ST_MultiPolygonFromWKB openb expression ',' expression ',' expression closeb
   {
-    $$ = FromLastType
+    $$ = &GeomFromWKBExpr{ Type: MultiPolygonFromWKB, WkbBlob: $3, Srid: $5, AxisOrderOpt: $7 }
   }
-
-from_first_last_clause_opt:
+| ST_PointFromWKB openb expression closeb
   {
-    $$ = nil
+    $$ = &GeomFromWKBExpr{ Type: PointFromWKB, WkbBlob: $3 }
   }
-| from_first_last_clause
-
-from_first_last_clause:
-  from_first_last_type
+| ST_PointFromWKB openb expression ',' expression closeb
   {
-    $$ = &FromFirstLastClause{$1}
+    $$ = &GeomFromWKBExpr{ Type: PointFromWKB, WkbBlob: $3, Srid: $5 }
   }
-
-lag_lead_expr_type:
-  LAG
+| ST_PointFromWKB openb expression ',' expression ',' expression closeb
   {
-    $$ = LagExprType
+    $$ = &GeomFromWKBExpr{ Type: PointFromWKB, WkbBlob: $3, Srid: $5, AxisOrderOpt: $7 }
   }
-| LEAD
+| ST_PolygonFromWKB openb expression closeb
   {
-    $$ = LeadExprType
+    $$ = &GeomFromWKBExpr{ Type: PolygonFromWKB, WkbBlob: $3 }
   }
-
-window_definition:
-  sql_id AS openb window_spec closeb
+| ST_PolygonFromWKB openb expression ',' expression closeb
   {
-    $$ = &WindowDefinition{Name:$1, WindowSpec:$4}
+    $$ = &GeomFromWKBExpr{ Type: PolygonFromWKB, WkbBlob: $3, Srid: $5 }
   }
-
-window_definition_list:
-  window_definition
+| ST_PolygonFromWKB openb expression ',' expression ',' expression closeb
   {
-    $$ = WindowDefinitions{$1}
+    $$ = &GeomFromWKBExpr{ Type: PolygonFromWKB, WkbBlob: $3, Srid: $5, AxisOrderOpt: $7 }
   }
-| window_definition_list ',' window_definition
+| ST_Area openb expression closeb
+ {
+   $$ = &PolygonPropertyFuncExpr{ Property: Area, Polygon: $3 }
+ }
+| ST_Centroid openb expression closeb
+ {
+   $$ = &PolygonPropertyFuncExpr{ Property: Centroid, Polygon: $3 }
+ }
+| ST_ExteriorRing openb expression closeb
+ {
+   $$ = &PolygonPropertyFuncExpr{ Property: ExteriorRing, Polygon: $3 }
+ }
+| ST_InteriorRingN openb expression ',' expression closeb
+ {
+   $$ = &PolygonPropertyFuncExpr{ Property: InteriorRingN, Polygon: $3, PropertyDefArg: $5 }
+ }
+| ST_NumInteriorRings openb expression closeb
+ {
+   $$ = &PolygonPropertyFuncExpr{ Property: NumInteriorRings, Polygon: $3 }
+ }
+| ST_GeometryN openb expression ',' expression closeb
+ {
+   $$ = &GeomCollPropertyFuncExpr{ Property: GeometryN, GeomColl: $3, PropertyDefArg: $5 }
+ }
+| ST_NumGeometries openb expression closeb
+ {
+   $$ = &GeomCollPropertyFuncExpr{ Property: NumGeometries, GeomColl: $3 }
+ }
+| ST_GeoHash openb expression ',' expression ',' expression closeb
   {
-    $$ = append($1,$3)
+    $$ = &GeoHashFromLatLongExpr{ Longitude: $3, Latitude: $5, MaxLength: $7 }
   }
-
-default_opt:
-  /* empty */
+| ST_GeoHash openb expression ',' expression closeb
   {
-    $$ = ""
+    $$ = &GeoHashFromPointExpr{ Point: $3, MaxLength: $5 }
   }
-| openb ci_identifier closeb
+| ST_LatFromGeoHash openb expression closeb
   {
-    $$ = string($2.String())
+    $$ = &GeomFromGeoHashExpr{ GeomType: LatitudeFromHash, GeoHash: $3 }
   }
-
-boolean_value:
-  TRUE
+| ST_LongFromGeoHash openb expression closeb
   {
-    $$ = BoolVal(true)
+    $$ = &GeomFromGeoHashExpr{ GeomType: LongitudeFromHash, GeoHash: $3 }
   }
-| FALSE
+| ST_PointFromGeoHash openb expression ',' expression closeb
   {
-    $$ = BoolVal(false)
+    $$ = &GeomFromGeoHashExpr{ GeomType: PointFromHash, GeoHash: $3, SridOpt: $5 }
   }
-
-
-is_suffix:
- TRUE
+| ST_GeomFromGeoJSON openb expression closeb
   {
-    $$ = IsTrueOp
+    $$ = &GeomFromGeoJSONExpr{ GeoJSON: $3 }
   }
-| NOT TRUE
+| ST_GeomFromGeoJSON openb expression ',' expression closeb
   {
-    $$ = IsNotTrueOp
+    $$ = &GeomFromGeoJSONExpr{ GeoJSON: $3, HigherDimHandlerOpt: $5 }
   }
-| FALSE
+| ST_GeomFromGeoJSON openb expression ',' expression ',' expression closeb
   {
-    $$ = IsFalseOp
+    $$ = &GeomFromGeoJSONExpr{ GeoJSON: $3, HigherDimHandlerOpt: $5 , Srid: $7 }
   }
-| NOT FALSE
+| ST_AsGeoJSON openb expression closeb
   {
-    $$ = IsNotFalseOp
+    $$ = &GeoJSONFromGeomExpr{ Geom: $3 }
   }
-
-compare:
-  '='
+| ST_AsGeoJSON openb expression ',' expression closeb
   {
-    $$ = EqualOp
+    $$ = &GeoJSONFromGeomExpr{ Geom: $3, MaxDecimalDigits: $5 }
   }
-| '<'
+| ST_AsGeoJSON openb expression ',' expression ',' expression closeb
   {
-    $$ = LessThanOp
+    $$ = &GeoJSONFromGeomExpr{ Geom: $3, MaxDecimalDigits: $5 , Bitmask: $7 }
   }
-| '>'
+| JSON_OBJECT openb json_object_param_opt closeb
   {
-    $$ = GreaterThanOp
+    $$ = &JSONObjectExpr{ Params:$3 }
   }
-| LE
+| JSON_QUOTE openb expression closeb
   {
-    $$ = LessEqualOp
+    $$ = &JSONQuoteExpr{ StringArg:$3 }
   }
-| GE
+| JSON_CONTAINS openb expression ',' expression_list closeb
   {
-    $$ = GreaterEqualOp
+    $$ = &JSONContainsExpr{Target: $3, Candidate: $5[0], PathList: $5[1:]}
   }
-| NE
+| JSON_CONTAINS_PATH openb expression ',' expression ',' expression_list closeb
   {
-    $$ = NotEqualOp
+    $$ = &JSONContainsPathExpr{JSONDoc: $3, OneOrAll: $5, PathList: $7}
   }
-| NULL_SAFE_EQUAL
+| JSON_EXTRACT openb expression ',' expression_list closeb
   {
-    $$ = NullSafeEqualOp
+    $$ = &JSONExtractExpr{JSONDoc: $3, PathList: $5}
   }
-
-col_tuple:
-  row_tuple
+| JSON_KEYS openb expression closeb
   {
-    $$ = $1
+    $$ = &JSONKeysExpr{JSONDoc: $3}
   }
-| subquery
+| JSON_KEYS openb expression ',' expression closeb
   {
-    $$ = $1
+    $$ = &JSONKeysExpr{JSONDoc: $3, Path: $5}
   }
-| LIST_ARG
+| JSON_OVERLAPS openb expression ',' expression closeb
   {
-    $$ = ListArg($1[2:])
-    markBindVariable(yylex, $1[2:])
+    $$ = &JSONOverlapsExpr{JSONDoc1:$3, JSONDoc2:$5}
   }
-
-subquery:
-  query_expression_parens %prec SUBQUERY_AS_EXPR
+| JSON_SEARCH openb expression ',' expression ',' expression closeb
   {
-  	$$ = &Subquery{$1}
+    $$ = &JSONSearchExpr{JSONDoc: $3, OneOrAll: $5, SearchStr: $7 }
   }
-
-expression_list:
-  expression
+| JSON_SEARCH openb expression ',' expression ',' expression ',' expression_list closeb
   {
-    $$ = Exprs{$1}
+    $$ = &JSONSearchExpr{JSONDoc: $3, OneOrAll: $5, SearchStr: $7, EscapeChar: $9[0], PathList:$9[1:] }
   }
-| expression_list ',' expression
+| JSON_VALUE openb expression ',' expression returning_type_opt closeb
   {
-    $$ = append($1, $3)
+    $$ = &JSONValueExpr{JSONDoc: $3, Path: $5, ReturningType: $6}
   }
-
-/*
-  Regular function calls without special token or syntax, guaranteed to not
-  introduce side effects due to being a simple identifier
-*/
-function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+| JSON_VALUE openb expression ',' expression returning_type_opt on_empty closeb
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &JSONValueExpr{JSONDoc: $3, Path: $5, ReturningType: $6, EmptyOnResponse: $7}
   }
-| table_id '.' reserved_sql_id openb select_expression_list_opt closeb
+| JSON_VALUE openb expression ',' expression returning_type_opt on_error closeb
   {
-    $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
+    $$ = &JSONValueExpr{JSONDoc: $3, Path: $5, ReturningType: $6, ErrorOnResponse: $7}
   }
-
-/*
-  Function calls using reserved keywords, with dedicated grammar rules
-  as a result
-*/
-function_call_keyword:
-  LEFT openb select_expression_list closeb
+| JSON_VALUE openb expression ',' expression returning_type_opt on_empty on_error closeb
   {
-    $$ = &FuncExpr{Name: NewIdentifierCI("left"), Exprs: $3}
+    $$ = &JSONValueExpr{JSONDoc: $3, Path: $5, ReturningType: $6, EmptyOnResponse: $7, ErrorOnResponse: $8}
   }
-| RIGHT openb select_expression_list closeb
+| JSON_DEPTH openb expression closeb
   {
-    $$ = &FuncExpr{Name: NewIdentifierCI("right"), Exprs: $3}
+    $$ = &JSONAttributesExpr{Type:DepthAttributeType, JSONDoc:$3}
   }
-| SUBSTRING openb expression ',' expression ',' expression closeb
+| JSON_VALID openb expression closeb
   {
-    $$ = &SubstrExpr{Name: $3, From: $5, To: $7}
+    $$ = &JSONAttributesExpr{Type:ValidAttributeType, JSONDoc:$3}
   }
-| SUBSTRING openb expression ',' expression closeb
+| JSON_TYPE openb expression closeb
   {
-    $$ = &SubstrExpr{Name: $3, From: $5}
+    $$ = &JSONAttributesExpr{Type:TypeAttributeType, JSONDoc:$3}
   }
-| SUBSTRING openb expression FROM expression FOR expression closeb
+| JSON_LENGTH openb expression closeb
   {
-  	$$ = &SubstrExpr{Name: $3, From: $5, To: $7}
+    $$ = &JSONAttributesExpr{Type:LengthAttributeType, JSONDoc:$3 }
   }
-| SUBSTRING openb expression FROM expression closeb
+| JSON_LENGTH openb expression ',' expression closeb
   {
-  	$$ = &SubstrExpr{Name: $3, From: $5}
+    $$ = &JSONAttributesExpr{Type:LengthAttributeType, JSONDoc:$3, Path: $5 }
   }
-| CASE expression_opt when_expression_list else_expression_opt END
+| JSON_ARRAY_APPEND openb expression ',' json_object_param_list closeb
   {
-    $$ = &CaseExpr{Expr: $2, Whens: $3, Else: $4}
+    $$ = &JSONValueModifierExpr{Type:JSONArrayAppendType ,JSONDoc:$3, Params:$5}
   }
-| VALUES openb column_name closeb
+| JSON_ARRAY_INSERT openb expression ',' json_object_param_list closeb
   {
-    $$ = &ValuesFuncExpr{Name: $3}
+    $$ = &JSONValueModifierExpr{Type:JSONArrayInsertType ,JSONDoc:$3, Params:$5}
   }
-| INSERT openb expression ',' expression ',' expression ',' expression closeb
+| JSON_INSERT openb expression ',' json_object_param_list closeb
   {
-    $$ = &InsertExpr{Str: $3, Pos: $5, Len: $7, NewStr: $9}
+    $$ = &JSONValueModifierExpr{Type:JSONInsertType ,JSONDoc:$3, Params:$5}
   }
-| CURRENT_USER func_paren_opt
+| JSON_REPLACE openb expression ',' json_object_param_list closeb
   {
-    $$ =  &FuncExpr{Name: NewIdentifierCI($1)}
+    $$ = &JSONValueModifierExpr{Type:JSONReplaceType ,JSONDoc:$3, Params:$5}
   }
-
-/*
-  Function calls using non reserved keywords but with special syntax forms.
-  Dedicated grammar rules are needed because of the special syntax
-*/
-function_call_nonkeyword:
-/* doesn't support fsp */
-UTC_DATE func_paren_opt
+| JSON_SET openb expression ',' json_object_param_list closeb
   {
-    $$ = &FuncExpr{Name:NewIdentifierCI("utc_date")}
+    $$ = &JSONValueModifierExpr{Type:JSONSetType ,JSONDoc:$3, Params:$5}
   }
-| now
+| JSON_MERGE openb expression ',' expression_list closeb
   {
-  	$$ = $1
+    $$ = &JSONValueMergeExpr{Type: JSONMergeType, JSONDoc: $3, JSONDocList: $5}
   }
-| COUNT openb '*' closeb
+| JSON_MERGE_PATCH openb expression ',' expression_list closeb
   {
-    $$ = &CountStar{}
+    $$ = &JSONValueMergeExpr{Type: JSONMergePatchType, JSONDoc: $3, JSONDocList: $5}
   }
-| COUNT openb distinct_opt expression_list closeb
+| JSON_MERGE_PRESERVE openb expression ',' expression_list closeb
   {
-    $$ = &Count{Distinct:$3, Args:$4}
+    $$ = &JSONValueMergeExpr{Type: JSONMergePreserveType, JSONDoc: $3, JSONDocList: $5}
   }
-| MAX openb distinct_opt expression closeb
+| JSON_REMOVE openb expression ',' expression_list closeb
   {
-    $$ = &Max{Distinct:$3, Arg:$4}
+    $$ = &JSONRemoveExpr{JSONDoc:$3, PathList: $5}
   }
-| MIN openb distinct_opt expression closeb
+| JSON_UNQUOTE openb expression closeb
   {
-    $$ = &Min{Distinct:$3, Arg:$4}
+    $$ = &JSONUnquoteExpr{JSONValue:$3}
   }
-| SUM openb distinct_opt expression closeb
+| MULTIPOLYGON openb expression_list closeb
   {
-    $$ = &Sum{Distinct:$3, Arg:$4}
+    $$ = &MultiPolygonExpr{ PolygonParams:$3 }
   }
-| AVG openb distinct_opt expression closeb
+| MULTIPOINT openb expression_list closeb
   {
-    $$ = &Avg{Distinct:$3, Arg:$4}
+    $$ = &MultiPointExpr{ PointParams:$3 }
   }
-| BIT_AND openb expression closeb
+| MULTILINESTRING openb expression_list closeb
   {
-    $$ = &BitAnd{Arg:$3}
+    $$ = &MultiLinestringExpr{ LinestringParams:$3 }
   }
-| BIT_OR openb expression closeb
+| POLYGON openb expression_list closeb
   {
-    $$ = &BitOr{Arg:$3}
+    $$ = &PolygonExpr{ LinestringParams:$3 }
   }
-| BIT_XOR openb expression closeb
-   {
-     $$ = &BitXor{Arg:$3}
-   }
-| STD openb expression closeb
-    {
-      $$ = &Std{Arg:$3}
-    }
-| STDDEV openb expression closeb
-    {
-      $$ = &StdDev{Arg:$3}
-    }
-| STDDEV_POP openb expression closeb
-    {
-      $$ = &StdPop{Arg:$3}
-    }
-| STDDEV_SAMP openb expression closeb
-    {
-      $$ = &StdSamp{Arg:$3}
-    }
-| VAR_POP openb expression closeb
-     {
-       $$ = &VarPop{Arg:$3}
-     }
-| VAR_SAMP openb expression closeb
-     {
-       $$ = &VarSamp{Arg:$3}
-     }
-| VARIANCE openb expression closeb
-     {
-       $$ = &Variance{Arg:$3}
-     }
-| GROUP_CONCAT openb distinct_opt expression_list order_by_opt separator_opt limit_opt closeb
+| LINESTRING openb expression_list closeb
   {
-    $$ = &GroupConcatExpr{Distinct: $3, Exprs: $4, OrderBy: $5, Separator: $6, Limit: $7}
+    $$ = &LineStringExpr{ PointParams:$3 }
   }
-| TIMESTAMPADD openb sql_id ',' expression ',' expression closeb
+| POINT openb expression ',' expression closeb
   {
-    $$ = &TimestampFuncExpr{Name:string("timestampadd"), Unit:$3.String(), Expr1:$5, Expr2:$7}
+    $$ = &PointExpr{ XCordinate:$3, YCordinate:$5 }
   }
-| TIMESTAMPDIFF openb sql_id ',' expression ',' expression closeb
+| argument_less_window_expr_type openb closeb over_clause
   {
-    $$ = &TimestampFuncExpr{Name:string("timestampdiff"), Unit:$3.String(), Expr1:$5, Expr2:$7}
+    $$ = &ArgumentLessWindowExpr{ Type: $1, OverClause : $4 }
   }
-| EXTRACT openb interval FROM expression closeb
+| first_or_last_value_expr_type openb expression closeb null_treatment_clause_opt over_clause
   {
-	$$ = &ExtractFuncExpr{IntervalTypes: $3, Expr: $5}
+    $$ = &FirstOrLastValueExpr{ Type: $1, Expr : $3 , NullTreatmentClause:$5 ,OverClause:$6 }
   }
-| WEIGHT_STRING openb expression convert_type_weight_string closeb
+| NTILE openb null_int_variable_arg closeb over_clause
   {
-    $$ = &WeightStringFuncExpr{Expr: $3, As: $4}
+    $$ =  &NtileExpr{N: $3, OverClause: $5}
   }
-| JSON_PRETTY openb expression closeb
+| NTH_VALUE openb expression ',' null_int_variable_arg closeb from_first_last_clause_opt null_treatment_clause_opt over_clause
   {
-    $$ = &JSONPrettyExpr{JSONVal: $3}
+    $$ =  &NTHValueExpr{ Expr: $3, N: $5, FromFirstLastClause:$7, NullTreatmentClause:$8, OverClause: $9}
   }
-| JSON_STORAGE_FREE openb expression closeb
+| lag_lead_expr_type openb expression closeb null_treatment_clause_opt over_clause
   {
-    $$ = &JSONStorageFreeExpr{ JSONVal: $3}
+    $$ = &LagLeadExpr{ Type:$1 , Expr: $3, NullTreatmentClause:$5, OverClause: $6 }
   }
-| JSON_STORAGE_SIZE openb expression closeb
+| lag_lead_expr_type openb expression ',' null_int_variable_arg default_with_comma_opt closeb null_treatment_clause_opt over_clause
   {
-    $$ = &JSONStorageSizeExpr{ JSONVal: $3}
+    $$ =  &LagLeadExpr{ Type:$1 , Expr: $3, N: $5, Default: $6, NullTreatmentClause:$8, OverClause: $9}
   }
-| LTRIM openb expression closeb
+| ADDDATE openb expression ',' INTERVAL bit_expr interval closeb
   {
-    $$ = &TrimFuncExpr{TrimFuncType:LTrimType, Type: LeadingTrimType, StringArg: $3}
+    $$ = &DateAddExpr{Type: AdddateType, Date: $3, Expr: $6, Unit: $7}
   }
-| RTRIM openb expression closeb
+| ADDDATE openb expression ',' expression closeb
   {
-    $$ = &TrimFuncExpr{TrimFuncType:RTrimType, Type: TrailingTrimType, StringArg: $3}
+    $$ = &DateAddExpr{Type: AdddateType, Date: $3, Expr: $5}
   }
-| TRIM openb trim_type expression_opt FROM expression closeb
+| DATE_ADD openb expression ',' INTERVAL bit_expr interval closeb
   {
-    $$ = &TrimFuncExpr{Type:$3, TrimArg:$4, StringArg: $6}
+    $$ = &DateAddExpr{Type: DateAddType, Date: $3, Expr: $6, Unit: $7}
   }
-| TRIM openb expression closeb
+| DATE_SUB openb expression ',' INTERVAL bit_expr interval closeb
   {
-    $$ = &TrimFuncExpr{StringArg: $3}
+    $$ = &DateSubExpr{Type: DateSubType, Date: $3, Expr: $6, Unit: $7}
   }
-| CHAR openb expression_list closeb
+| SUBDATE openb expression ',' INTERVAL bit_expr interval closeb
   {
-    $$ = &CharExpr{Exprs: $3}
+    $$ = &DateSubExpr{Type: SubdateType, Date: $3, Expr: $6, Unit: $7}
   }
-| CHAR openb expression_list USING charset closeb
+| SUBDATE openb expression ',' expression closeb
   {
-    $$ = &CharExpr{Exprs: $3, Charset: $5}
+    $$ = &DateSubExpr{Type: SubdateType, Date: $3, Expr: $5}
   }
-| TRIM openb expression FROM expression closeb
+| regular_expressions
+| xml_expressions
+| performance_schema_function_expressions
+| gtid_function_expressions
+
+null_int_variable_arg:
+  null_as_literal
   {
-    $$ = &TrimFuncExpr{TrimArg:$3, StringArg: $5}
+    $$ = $1
   }
-| LOCATE openb expression ',' expression closeb
+| INTEGRAL
   {
-    $$ = &LocateExpr{SubStr: $3, Str: $5}
+    $$ = NewIntLiteral($1)
   }
-| LOCATE openb expression ',' expression ',' expression closeb
+| user_defined_variable
   {
-    $$ = &LocateExpr{SubStr: $3, Str: $5, Pos: $7}
+    $$ = $1
   }
-| POSITION openb bit_expr IN expression closeb
+| VALUE_ARG
   {
-    $$ = &LocateExpr{SubStr: $3, Str: $5}
+    $$ = parseBindVariable(yylex, $1[1:])
   }
-| GET_LOCK openb expression ',' expression closeb
+
+default_with_comma_opt:
   {
-    $$ = &LockingFunc{Type: GetLock, Name:$3, Timeout:$5}
+    $$ = nil
   }
-| IS_FREE_LOCK openb expression closeb
+| ',' expression
   {
-    $$ = &LockingFunc{Type: IsFreeLock, Name:$3}
+    $$ = $2
   }
-| IS_USED_LOCK openb expression closeb
+
+regular_expressions:
+  REGEXP_INSTR openb expression ',' expression closeb
   {
-    $$ = &LockingFunc{Type: IsUsedLock, Name:$3}
+    $$ = &RegexpInstrExpr{Expr:$3, Pattern:$5}
   }
-| RELEASE_ALL_LOCKS openb closeb
+| REGEXP_INSTR openb expression ',' expression ',' expression closeb
   {
-    $$ = &LockingFunc{Type: ReleaseAllLocks}
+    $$ = &RegexpInstrExpr{Expr:$3, Pattern:$5, Position: $7}
   }
-| RELEASE_LOCK openb expression closeb
+| REGEXP_INSTR openb expression ',' expression ',' expression ',' expression closeb
   {
-    $$ = &LockingFunc{Type: ReleaseLock, Name:$3}
+    $$ = &RegexpInstrExpr{Expr:$3, Pattern:$5, Position: $7, Occurrence: $9}
   }
-| JSON_SCHEMA_VALID openb expression ',' expression closeb
+| REGEXP_INSTR openb expression ',' expression ',' expression ',' expression ',' expression closeb
   {
-    $$ = &JSONSchemaValidFuncExpr{ Schema: $3, Document: $5}
+    $$ = &RegexpInstrExpr{Expr:$3, Pattern:$5, Position: $7, Occurrence: $9, ReturnOption: $11}
   }
-| JSON_SCHEMA_VALIDATION_REPORT openb expression ',' expression closeb
+| REGEXP_INSTR openb expression ',' expression ',' expression ',' expression ',' expression ',' expression closeb
   {
-    $$ = &JSONSchemaValidationReportFuncExpr{ Schema: $3, Document: $5}
+    // Match type is kept expression as TRIM( ' m  ') is accepted
+    $$ = &RegexpInstrExpr{Expr:$3, Pattern:$5, Position: $7, Occurrence: $9, ReturnOption: $11, MatchType: $13}
   }
-| JSON_ARRAY openb expression_list_opt closeb
+| REGEXP_LIKE openb expression ',' expression closeb
   {
-    $$ = &JSONArrayExpr{ Params:$3 }
+    $$ = &RegexpLikeExpr{Expr:$3, Pattern:$5}
   }
-| ST_AsBinary openb expression closeb
+| REGEXP_LIKE openb expression ',' expression ',' expression closeb
   {
-    $$ = &GeomFormatExpr{ FormatType: BinaryFormat, Geom: $3}
+    $$ = &RegexpLikeExpr{Expr:$3, Pattern:$5, MatchType: $7}
   }
-| ST_AsBinary openb expression ',' expression closeb
+| REGEXP_REPLACE openb expression ',' expression ',' expression closeb
   {
-    $$ = &GeomFormatExpr{ FormatType: BinaryFormat, Geom: $3, AxisOrderOpt: $5 }
+    $$ = &RegexpReplaceExpr{Expr:$3, Pattern:$5, Repl: $7}
   }
-| ST_AsText openb expression closeb
+| REGEXP_REPLACE openb expression ',' expression ',' expression ',' expression closeb
   {
-    $$ = &GeomFormatExpr{ FormatType: TextFormat, Geom: $3}
+    $$ = &RegexpReplaceExpr{Expr:$3, Pattern:$5, Repl: $7, Position: $9}
   }
-| ST_AsText openb expression ',' expression closeb
+| REGEXP_REPLACE openb expression ',' expression ',' expression ',' expression ',' expression closeb
   {
-    $$ = &GeomFormatExpr{ FormatType: TextFormat, Geom: $3, AxisOrderOpt: $5 }
+    $$ = &RegexpReplaceExpr{Expr:$3, Pattern:$5, Repl: $7, Position: $9, Occurrence: $11}
   }
-| ST_IsEmpty openb expression closeb
+| REGEXP_REPLACE openb expression ',' expression ',' expression ',' expression ',' expression ',' expression closeb
   {
-    $$ = &GeomPropertyFuncExpr{ Property: IsEmpty, Geom: $3}
+    // Match type is kept expression as TRIM( ' m  ') is accepted
+    $$ = &RegexpReplaceExpr{Expr:$3, Pattern:$5, Repl: $7, Position: $9, Occurrence: $11, MatchType: $13}
   }
-| ST_IsSimple openb expression closeb
+| REGEXP_SUBSTR openb expression ',' expression closeb
   {
-    $$ = &GeomPropertyFuncExpr{ Property: IsSimple, Geom: $3}
+    $$ = &RegexpSubstrExpr{Expr:$3, Pattern:$5 }
   }
-| ST_Dimension openb expression closeb
+| REGEXP_SUBSTR openb expression ',' expression ',' expression closeb
   {
-    $$ = &GeomPropertyFuncExpr{ Property: Dimension, Geom: $3}
+    $$ = &RegexpSubstrExpr{Expr:$3, Pattern:$5, Position: $7}
   }
-| ST_Envelope openb expression closeb
+| REGEXP_SUBSTR openb expression ',' expression ',' expression ',' expression closeb
   {
-    $$ = &GeomPropertyFuncExpr{ Property: Envelope, Geom: $3}
+    $$ = &RegexpSubstrExpr{Expr:$3, Pattern:$5, Position: $7, Occurrence: $9}
   }
-| ST_GeometryType openb expression closeb
+| REGEXP_SUBSTR openb expression ',' expression ',' expression ',' expression ',' expression closeb
   {
-    $$ = &GeomPropertyFuncExpr{ Property: GeometryType, Geom: $3}
+    // Match type is kept expression as TRIM( ' m  ') is accepted
+    $$ = &RegexpSubstrExpr{Expr:$3, Pattern:$5, Position: $7, Occurrence: $9, MatchType: $11}
   }
-| ST_Latitude openb expression closeb
+
+xml_expressions:
+  ExtractValue openb expression ',' expression closeb
   {
-    $$ = &PointPropertyFuncExpr{ Property: Latitude, Point: $3}
+    $$ = &ExtractValueExpr{Fragment:$3, XPathExpr:$5}
   }
-| ST_Latitude openb expression ',' expression closeb
+| UpdateXML openb expression ',' expression ',' expression closeb
   {
-    $$ = &PointPropertyFuncExpr{ Property: Latitude, Point: $3, ValueToSet: $5}
+    $$ = &UpdateXMLExpr{ Target:$3, XPathExpr:$5, NewXML:$7 }
   }
-| ST_Longitude openb expression closeb
+
+performance_schema_function_expressions:
+  FORMAT_BYTES openb expression closeb
   {
-    $$ = &PointPropertyFuncExpr{ Property: Longitude, Point: $3}
+    $$ = &PerformanceSchemaFuncExpr{Type: FormatBytesType, Argument:$3}
   }
-| ST_Longitude openb expression ',' expression closeb
+| FORMAT_PICO_TIME openb expression closeb
   {
-    $$ = &PointPropertyFuncExpr{ Property: Longitude, Point: $3, ValueToSet: $5}
+    $$ = &PerformanceSchemaFuncExpr{Type: FormatPicoTimeType, Argument:$3}
   }
-| ST_EndPoint openb expression closeb
+| PS_CURRENT_THREAD_ID openb closeb
   {
-    $$ = &LinestrPropertyFuncExpr{ Property: EndPoint, Linestring: $3}
+    $$ = &PerformanceSchemaFuncExpr{Type: PsCurrentThreadIDType}
   }
-| ST_IsClosed openb expression closeb
+| PS_THREAD_ID openb expression closeb
   {
-    $$ = &LinestrPropertyFuncExpr{ Property: IsClosed, Linestring: $3}
+    $$ = &PerformanceSchemaFuncExpr{Type: PsThreadIDType, Argument:$3}
   }
-| ST_Length openb expression closeb
+
+gtid_function_expressions:
+  GTID_SUBSET openb expression ',' expression closeb
   {
-    $$ = &LinestrPropertyFuncExpr{ Property: Length, Linestring: $3}
+    $$ = &GTIDFuncExpr{Type: GTIDSubsetType, Set1:$3, Set2: $5 }
   }
-| ST_Length openb expression ',' expression closeb
+| GTID_SUBTRACT openb expression ',' expression closeb
   {
-    $$ = &LinestrPropertyFuncExpr{ Property: Length, Linestring: $3, PropertyDefArg: $5}
+    $$ = &GTIDFuncExpr{Type: GTIDSubtractType, Set1:$3, Set2: $5 }
   }
-| ST_NumPoints openb expression closeb
+| WAIT_FOR_EXECUTED_GTID_SET openb expression closeb
   {
-    $$ = &LinestrPropertyFuncExpr{ Property: NumPoints, Linestring: $3}
+    $$ = &GTIDFuncExpr{Type: WaitForExecutedGTIDSetType, Set1: $3}
   }
-| ST_PointN openb expression ',' expression closeb
+| WAIT_FOR_EXECUTED_GTID_SET openb expression ',' expression closeb
   {
-    $$ = &LinestrPropertyFuncExpr{ Property: PointN, Linestring: $3, PropertyDefArg: $5}
+    $$ = &GTIDFuncExpr{Type: WaitForExecutedGTIDSetType, Set1: $3, Timeout: $5 }
   }
-| ST_StartPoint openb expression closeb
+| WAIT_UNTIL_SQL_THREAD_AFTER_GTIDS openb expression closeb
   {
-    $$ = &LinestrPropertyFuncExpr{ Property: StartPoint, Linestring: $3}
+    $$ = &GTIDFuncExpr{Type: WaitUntilSQLThreadAfterGTIDSType, Set1: $3 }
   }
-| ST_X openb expression closeb
+| WAIT_UNTIL_SQL_THREAD_AFTER_GTIDS openb expression ',' expression closeb
   {
-    $$ = &PointPropertyFuncExpr{ Property: XCordinate, Point: $3}
+    $$ = &GTIDFuncExpr{Type: WaitUntilSQLThreadAfterGTIDSType, Set1: $3, Timeout: $5 }
   }
-| ST_X openb expression ',' expression closeb
+| WAIT_UNTIL_SQL_THREAD_AFTER_GTIDS openb expression ',' expression ',' expression closeb
   {
-    $$ = &PointPropertyFuncExpr{ Property: XCordinate, Point: $3, ValueToSet: $5}
+    $$ = &GTIDFuncExpr{Type: WaitUntilSQLThreadAfterGTIDSType, Set1: $3, Timeout: $5, Channel: $7 }
   }
-| ST_Y openb expression closeb
+
+returning_type_opt:
   {
-    $$ = &PointPropertyFuncExpr{ Property: YCordinate, Point: $3}
+    $$ = nil
   }
-| ST_Y openb expression ',' expression closeb
+| RETURNING convert_type
   {
-    $$ = &PointPropertyFuncExpr{ Property: YCordinate, Point: $3, ValueToSet: $5}
+    $$ = $2
   }
-| ST_GeometryFromText openb expression closeb
+
+interval:
+  DAY_HOUR
   {
-    $$ = &GeomFromTextExpr{ Type: GeometryFromText, WktText: $3 }
+	$$=IntervalDayHour
   }
-| ST_GeometryFromText openb expression ',' expression closeb
+| DAY_MICROSECOND
   {
-    $$ = &GeomFromTextExpr{ Type: GeometryFromText, WktText: $3, Srid: $5 }
+	$$=IntervalDayMicrosecond
   }
-| ST_GeometryFromText openb expression ',' expression ',' expression closeb
+| DAY_MINUTE
   {
-    $$ = &GeomFromTextExpr{ Type: GeometryFromText, WktText: $3, Srid: $5, AxisOrderOpt: $7 }
+	$$=IntervalDayMinute
   }
-|  ST_GeometryCollectionFromText openb expression closeb
+| DAY_SECOND
   {
-    $$ = &GeomFromTextExpr{ Type: GeometryCollectionFromText, WktText: $3 }
+	$$=IntervalDaySecond
   }
-| ST_GeometryCollectionFromText openb expression ',' expression closeb
+| HOUR_MICROSECOND
   {
-    $$ = &GeomFromTextExpr{ Type: GeometryCollectionFromText, WktText: $3, Srid: $5 }
+	$$=IntervalHourMicrosecond
+  }
+| HOUR_MINUTE
+  {
+	$$=IntervalHourMinute
+  }
+| HOUR_SECOND
+  {
+	$$=IntervalHourSecond
+  }
+| MINUTE_MICROSECOND
+  {
+	$$=IntervalMinuteMicrosecond
+  }
+| MINUTE_SECOND
+  {
+	$$=IntervalMinuteSecond
   }
-| ST_GeometryCollectionFromText openb expression ',' expression ',' expression closeb
+| SECOND_MICROSECOND
   {
-    $$ = &GeomFromTextExpr{ Type: GeometryCollectionFromText, WktText: $3, Srid: $5, AxisOrderOpt: $7 }
+	$$=IntervalSecondMicrosecond
   }
-| ST_LineStringFromText openb expression closeb
+| YEAR_MONTH
   {
-    $$ = &GeomFromTextExpr{ Type: LineStringFromText, WktText: $3 }
+	$$=IntervalYearMonth
   }
-| ST_LineStringFromText openb expression ',' expression closeb
+| DAY
   {
-    $$ = &GeomFromTextExpr{ Type: LineStringFromText, WktText: $3, Srid: $5 }
+ 	$$=IntervalDay
   }
-| ST_LineStringFromText openb expression ',' expression ',' expression closeb
+| WEEK
   {
-    $$ = &GeomFromTextExpr{ Type: LineStringFromText, WktText: $3, Srid: $5, AxisOrderOpt: $7 }
+  	$$=IntervalWeek
   }
-| ST_MultiLineStringFromText openb expression closeb
+| HOUR
   {
-    $$ = &GeomFromTextExpr{ Type: MultiLinestringFromText, WktText: $3 }
+ 	$$=IntervalHour
   }
-| ST_MultiLineStringFromText openb expression ',' expression closeb
+| MINUTE
   {
-    $$ = &GeomFromTextExpr{ Type: MultiLinestringFromText, WktText: $3, Srid: $5 }
+ 	$$=IntervalMinute
   }
-| ST_MultiLineStringFromText openb expression ',' expression ',' expression closeb
+| MONTH
   {
-    $$ = &GeomFromTextExpr{ Type: MultiLinestringFromText, WktText: $3, Srid: $5, AxisOrderOpt: $7 }
+	$$=IntervalMonth
   }
-| ST_MultiPointFromText openb expression closeb
+| QUARTER
   {
-    $$ = &GeomFromTextExpr{ Type: MultiPointFromText, WktText: $3 }
+	$$=IntervalQuarter
   }
-| ST_MultiPointFromText openb expression ',' expression closeb
+| SECOND
   {
-    $$ = &GeomFromTextExpr{ Type: MultiPointFromText, WktText: $3, Srid: $5 }
+	$$=IntervalSecond
   }
-| ST_MultiPointFromText openb expression ',' expression ',' expression closeb
+| MICROSECOND
   {
-    $$ = &GeomFromTextExpr{ Type: MultiPointFromText, WktText: $3, Srid: $5, AxisOrderOpt: $7 }
+	$$=IntervalMicrosecond
   }
-| ST_MultiPolygonFromText openb expression closeb
+| YEAR
   {
-    $$ = &GeomFromTextExpr{ Type: MultiPolygonFromText, WktText: $3 }
+	$$=IntervalYear
   }
-| ST_MultiPolygonFromText openb expression ',' expression closeb
+
+func_paren_opt:
+  /* empty */
+| openb closeb
+
+func_datetime_precision:
+  /* empty */
   {
-    $$ = &GeomFromTextExpr{ Type: MultiPolygonFromText, WktText: $3, Srid: $5 }
+  	$$ = 0
   }
-| ST_MultiPolygonFromText openb expression ',' expression ',' expression closeb
+| openb closeb
   {
-    $$ = &GeomFromTextExpr{ Type: MultiPolygonFromText, WktText: $3, Srid: $5, AxisOrderOpt: $7 }
+    $$ = 0
   }
-| ST_PointFromText openb expression closeb
+| openb INTEGRAL closeb
   {
-    $$ = &GeomFromTextExpr{ Type: PointFromText, WktText: $3 }
+      $$ = convertStringToInt($2)
   }
-| ST_PointFromText openb expression ',' expression closeb
+
+/*
+  Function calls using non reserved keywords with *normal* syntax forms. Because
+  the names are non-reserved, they need a dedicated rule so as not to conflict
+*/
+function_call_conflict:
+  IF openb select_expression_list closeb
   {
-    $$ = &GeomFromTextExpr{ Type: PointFromText, WktText: $3, Srid: $5 }
+    $$ = &FuncExpr{Name: NewIdentifierCI("if"), Exprs: $3}
   }
-| ST_PointFromText openb expression ',' expression ',' expression closeb
+| DATABASE openb select_expression_list_opt closeb
   {
-    $$ = &GeomFromTextExpr{ Type: PointFromText, WktText: $3, Srid: $5, AxisOrderOpt: $7 }
+    $$ = &FuncExpr{Name: NewIdentifierCI("database"), Exprs: $3}
   }
-| ST_PolygonFromText openb expression closeb
+| SCHEMA openb select_expression_list_opt closeb
   {
-    $$ = &GeomFromTextExpr{ Type: PolygonFromText, WktText: $3 }
+    $$ = &FuncExpr{Name: NewIdentifierCI("schema"), Exprs: $3}
   }
-| ST_PolygonFromText openb expression ',' expression closeb
+| MOD openb select_expression_list closeb
   {
-    $$ = &GeomFromTextExpr{ Type: PolygonFromText, WktText: $3, Srid: $5 }
+    $$ = &FuncExpr{Name: NewIdentifierCI("mod"), Exprs: $3}
   }
-| ST_PolygonFromText openb expression ',' expression ',' expression closeb
+| REPLACE openb select_expression_list closeb
   {
-    $$ = &GeomFromTextExpr{ Type: PolygonFromText, WktText: $3, Srid: $5, AxisOrderOpt: $7 }
+    $$ = &FuncExpr{Name: NewIdentifierCI("replace"), Exprs: $3}
   }
-| ST_GeometryFromWKB openb expression closeb
+| REPEAT openb select_expression_list closeb
   {
-    $$ = &GeomFromWKBExpr{ Type: GeometryFromWKB, WkbBlob: $3 }
+    $$ = &FuncExpr{Name: NewIdentifierCI($1), Exprs: $3}
   }
-| ST_GeometryFromWKB openb expression ',' expression closeb
+
+match_option:
+/*empty*/
   {
-    $$ = &GeomFromWKBExpr{ Type: GeometryFromWKB, WkbBlob: $3, Srid: $5 }
+    $$ = NoOption
   }
-| ST_GeometryFromWKB openb expression ',' expression ',' expression closeb
+| IN BOOLEAN MODE
   {
-    $$ = &GeomFromWKBExpr{ Type: GeometryFromWKB, WkbBlob: $3, Srid: $5, AxisOrderOpt: $7 }
+    $$ = BooleanModeOpt
   }
-|  ST_GeometryCollectionFromWKB openb expression closeb
+| IN NATURAL LANGUAGE MODE
+ {
+    $$ = NaturalLanguageModeOpt
+ }
+| IN NATURAL LANGUAGE MODE WITH QUERY EXPANSION
+ {
+    $$ = NaturalLanguageModeWithQueryExpansionOpt
+ }
+| WITH QUERY EXPANSION
+ {
+    $$ = QueryExpansionOpt
+ }
+
+charset:
+  sql_id
   {
-    $$ = &GeomFromWKBExpr{ Type: GeometryCollectionFromWKB, WkbBlob: $3 }
+    $$ = string($1.String())
   }
-| ST_GeometryCollectionFromWKB openb expression ',' expression closeb
+| STRING
   {
-    $$ = &GeomFromWKBExpr{ Type: GeometryCollectionFromWKB, WkbBlob: $3, Srid: $5 }
+    $$ = string($1)
   }
-| ST_GeometryCollectionFromWKB openb expression ',' expression ',' expression closeb
+| BINARY
   {
-    $$ = &GeomFromWKBExpr{ Type: GeometryCollectionFromWKB, WkbBlob: $3, Srid: $5, AxisOrderOpt: $7 }
+    $$ = string($1)
   }
-| ST_LineStringFromWKB openb expression closeb
+
+convert_type_weight_string:
+  /* empty */
   {
-    $$ = &GeomFromWKBExpr{ Type: LineStringFromWKB, WkbBlob: $3 }
+    $$ = nil
   }
-| ST_LineStringFromWKB openb expression ',' expression closeb
+| AS BINARY '(' INTEGRAL ')'
   {
-    $$ = &GeomFromWKBExpr{ Type: LineStringFromWKB, WkbBlob: $3, Srid: $5 }
+    $$ = &ConvertType{Type: string($2), Length: NewIntLiteral($4)}
   }
-| ST_LineStringFromWKB openb expression ',' expression ',' expression closeb
+| AS CHAR '(' INTEGRAL ')'
   {
-    $$ = &GeomFromWKBExpr{ Type: LineStringFromWKB, WkbBlob: $3, Srid: $5, AxisOrderOpt: $7 }
+    $$ = &ConvertType{Type: string($2), Length: NewIntLiteral($4)}
   }
-| ST_MultiLineStringFromWKB openb expression closeb
+
+convert_type:
+  BINARY length_opt
   {
-    $$ = &GeomFromWKBExpr{ Type: MultiLinestringFromWKB, WkbBlob: $3 }
+    $$ = &ConvertType{Type: string($1), Length: $2}
   }
-| ST_MultiLineStringFromWKB openb expression ',' expression closeb
+| CHAR length_opt charset_opt
   {
-    $$ = &GeomFromWKBExpr{ Type: MultiLinestringFromWKB, WkbBlob: $3, Srid: $5 }
+    $$ = &ConvertType{Type: string($1), Length: $2, Charset: $3}
   }
-| ST_MultiLineStringFromWKB openb expression ',' expression ',' expression closeb
+| DATE
   {
-    $$ = &GeomFromWKBExpr{ Type: MultiLinestringFromWKB, WkbBlob: $3, Srid: $5, AxisOrderOpt: $7 }
+    $$ = &ConvertType{Type: string($1)}
   }
-| ST_MultiPointFromWKB openb expression closeb
+| DATETIME length_opt
   {
-    $$ = &GeomFromWKBExpr{ Type: MultiPointFromWKB, WkbBlob: $3 }
+    $$ = &ConvertType{Type: string($1), Length: $2}
   }
-| ST_MultiPointFromWKB openb expression ',' expression closeb
+| DECIMAL_TYPE decimal_length_opt
   {
-    $$ = &GeomFromWKBExpr{ Type: MultiPointFromWKB, WkbBlob: $3, Srid: $5 }
+    $$ = &ConvertType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
   }
-| ST_MultiPointFromWKB openb expression ',' expression ',' expression closeb
+| JSON
   {
-    $$ = &GeomFromWKBExpr{ Type: MultiPointFromWKB, WkbBlob: $3, Srid: $5, AxisOrderOpt: $7 }
+    $$ = &ConvertType{Type: string($1)}
   }
-| ST_MultiPolygonFromWKB openb expression closeb
+| NCHAR length_opt
   {
-    $$ = &GeomFromWKBExpr{ Type: MultiPolygonFromWKB, WkbBlob: $3 }
+    $$ = &ConvertType{Type: string($1), Length: $2}
   }
-| ST_MultiPolygonFromWKB openb expression ',' expression closeb
+| SIGNED
   {
-    $$ = &GeomFromWKBExpr{ Type: MultiPolygonFromWKB, WkbBlob: $3, Srid: $5 }
+    $$ = &ConvertType{Type: string($1)}
   }
-| ST_MultiPolygonFromWKB openb expression ',' expression ',' expression closeb
+| SIGNED INTEGER
   {
-    $$ = &GeomFromWKBExpr{ Type: MultiPolygonFromWKB, WkbBlob: $3, Srid: $5, AxisOrderOpt: $7 }
+    $$ = &ConvertType{Type: string($1)}
   }
-| ST_PointFromWKB openb expression closeb
+| TIME length_opt
   {
-    $$ = &GeomFromWKBExpr{ Type: PointFromWKB, WkbBlob: $3 }
+    $$ = &ConvertType{Type: string($1), Length: $2}
   }
-| ST_PointFromWKB openb expression ',' expression closeb
+| UNSIGNED
   {
-    $$ = &GeomFromWKBExpr{ Type: PointFromWKB, WkbBlob: $3, Srid: $5 }
+    $$ = &ConvertType{Type: string($1)}
   }
-| ST_PointFromWKB openb expression ',' expression ',' expression closeb
+| UNSIGNED INTEGER
   {
-    $$ = &GeomFromWKBExpr{ Type: PointFromWKB, WkbBlob: $3, Srid: $5, AxisOrderOpt: $7 }
+    $$ = &ConvertType{Type: string($1)}
   }
-| ST_PolygonFromWKB openb expression closeb
+| FLOAT_TYPE length_opt
   {
-    $$ = &GeomFromWKBExpr{ Type: PolygonFromWKB, WkbBlob: $3 }
+    $$ = &ConvertType{Type: string($1), Length: $2}
   }
-| ST_PolygonFromWKB openb expression ',' expression closeb
+| DOUBLE
   {
-    $$ = &GeomFromWKBExpr{ Type: PolygonFromWKB, WkbBlob: $3, Srid: $5 }
+    $$ = &ConvertType{Type: string($1)}
   }
-| ST_PolygonFromWKB openb expression ',' expression ',' expression closeb
+| REAL
   {
-    $$ = &GeomFromWKBExpr{ Type: PolygonFromWKB, WkbBlob: $3, Srid: $5, AxisOrderOpt: $7 }
+    $$ = &ConvertType{Type: string($1)}
   }
-| ST_Area openb expression closeb
- {
-   $$ = &PolygonPropertyFuncExpr{ Property: Area, Polygon: $3 }
- }
-| ST_Centroid openb expression closeb
- {
-   $$ = &PolygonPropertyFuncExpr{ Property: Centroid, Polygon: $3 }
- }
-| ST_ExteriorRing openb expression closeb
- {
-   $$ = &PolygonPropertyFuncExpr{ Property: ExteriorRing, Polygon: $3 }
- }
-| ST_InteriorRingN openb expression ',' expression closeb
- {
-   $$ = &PolygonPropertyFuncExpr{ Property: InteriorRingN, Polygon: $3, PropertyDefArg: $5 }
- }
-| ST_NumInteriorRings openb expression closeb
- {
-   $$ = &PolygonPropertyFuncExpr{ Property: NumInteriorRings, Polygon: $3 }
- }
-| ST_GeometryN openb expression ',' expression closeb
- {
-   $$ = &GeomCollPropertyFuncExpr{ Property: GeometryN, GeomColl: $3, PropertyDefArg: $5 }
- }
-| ST_NumGeometries openb expression closeb
- {
-   $$ = &GeomCollPropertyFuncExpr{ Property: NumGeometries, GeomColl: $3 }
- }
-| ST_GeoHash openb expression ',' expression ',' expression closeb
+
+array_opt:
+  /* empty */
   {
-    $$ = &GeoHashFromLatLongExpr{ Longitude: $3, Latitude: $5, MaxLength: $7 }
+    $$ = false
   }
-| ST_GeoHash openb expression ',' expression closeb
+| ARRAY
   {
-    $$ = &GeoHashFromPointExpr{ Point: $3, MaxLength: $5 }
+    $$ = true
   }
-| ST_LatFromGeoHash openb expression closeb
+
+pg_cast_type:
+  reserved_sql_id
   {
-    $$ = &GeomFromGeoHashExpr{ GeomType: LatitudeFromHash, GeoHash: $3 }
+    $$ = &ConvertType{Type: $1.Lowered()}
   }
-| ST_LongFromGeoHash openb expression closeb
+| reserved_sql_id '(' INTEGRAL ')'
   {
-    $$ = &GeomFromGeoHashExpr{ GeomType: LongitudeFromHash, GeoHash: $3 }
+    $$ = &ConvertType{Type: $1.Lowered(), Length: NewIntLiteral($3)}
   }
-| ST_PointFromGeoHash openb expression ',' expression closeb
+| reserved_sql_id '(' INTEGRAL ',' INTEGRAL ')'
   {
-    $$ = &GeomFromGeoHashExpr{ GeomType: PointFromHash, GeoHash: $3, SridOpt: $5 }
+    $$ = &ConvertType{Type: $1.Lowered(), Length: NewIntLiteral($3), Scale: NewIntLiteral($5)}
   }
-| ST_GeomFromGeoJSON openb expression closeb
+| DOUBLE PRECISION
   {
-    $$ = &GeomFromGeoJSONExpr{ GeoJSON: $3 }
+    $$ = &ConvertType{Type: "double precision"}
   }
-| ST_GeomFromGeoJSON openb expression ',' expression closeb
+| CHARACTER VARYING
   {
-    $$ = &GeomFromGeoJSONExpr{ GeoJSON: $3, HigherDimHandlerOpt: $5 }
+    $$ = &ConvertType{Type: "character varying"}
   }
-| ST_GeomFromGeoJSON openb expression ',' expression ',' expression closeb
+| CHARACTER VARYING '(' INTEGRAL ')'
   {
-    $$ = &GeomFromGeoJSONExpr{ GeoJSON: $3, HigherDimHandlerOpt: $5 , Srid: $7 }
+    $$ = &ConvertType{Type: "character varying", Length: NewIntLiteral($4)}
   }
-| ST_AsGeoJSON openb expression closeb
+| TIME pg_time_zone
   {
-    $$ = &GeoJSONFromGeomExpr{ Geom: $3 }
+    $$ = &ConvertType{Type: "time " + $2}
   }
-| ST_AsGeoJSON openb expression ',' expression closeb
+| TIMESTAMP pg_time_zone
   {
-    $$ = &GeoJSONFromGeomExpr{ Geom: $3, MaxDecimalDigits: $5 }
+    $$ = &ConvertType{Type: "timestamp " + $2}
   }
-| ST_AsGeoJSON openb expression ',' expression ',' expression closeb
+
+pg_time_zone:
+  WITH TIME ZONE
   {
-    $$ = &GeoJSONFromGeomExpr{ Geom: $3, MaxDecimalDigits: $5 , Bitmask: $7 }
+    $$ = "with time zone"
   }
-| JSON_OBJECT openb json_object_param_opt closeb
+| WITHOUT TIME ZONE
   {
-    $$ = &JSONObjectExpr{ Params:$3 }
+    $$ = "without time zone"
   }
-| JSON_QUOTE openb expression closeb
+
+pg_array_opt:
+  /* empty */
   {
-    $$ = &JSONQuoteExpr{ StringArg:$3 }
+    $$ = false
   }
-| JSON_CONTAINS openb expression ',' expression_list closeb
+| '[' ']'
   {
-    $$ = &JSONContainsExpr{Target: $3, Candidate: $5[0], PathList: $5[1:]}
+    $$ = true
   }
-| JSON_CONTAINS_PATH openb expression ',' expression ',' expression_list closeb
+
+expression_opt:
   {
-    $$ = &JSONContainsPathExpr{JSONDoc: $3, OneOrAll: $5, PathList: $7}
+    $$ = nil
   }
-| JSON_EXTRACT openb expression ',' expression_list closeb
+| expression
   {
-    $$ = &JSONExtractExpr{JSONDoc: $3, PathList: $5}
+    $$ = $1
   }
-| JSON_KEYS openb expression closeb
+
+separator_opt:
   {
-    $$ = &JSONKeysExpr{JSONDoc: $3}
+    $$ = string("")
   }
-| JSON_KEYS openb expression ',' expression closeb
+| SEPARATOR STRING
   {
-    $$ = &JSONKeysExpr{JSONDoc: $3, Path: $5}
+    $$ = encodeSQLString($2)
   }
-| JSON_OVERLAPS openb expression ',' expression closeb
+
+when_expression_list:
+  when_expression
   {
-    $$ = &JSONOverlapsExpr{JSONDoc1:$3, JSONDoc2:$5}
+    $$ = []*When{$1}
   }
-| JSON_SEARCH openb expression ',' expression ',' expression closeb
+| when_expression_list when_expression
   {
-    $$ = &JSONSearchExpr{JSONDoc: $3, OneOrAll: $5, SearchStr: $7 }
+    $$ = append($1, $2)
   }
-| JSON_SEARCH openb expression ',' expression ',' expression ',' expression_list closeb
+
+when_expression:
+  WHEN expression THEN expression
   {
-    $$ = &JSONSearchExpr{JSONDoc: $3, OneOrAll: $5, SearchStr: $7, EscapeChar: $9[0], PathList:$9[1:] }
+    $$ = &When{Cond: $2, Val: $4}
   }
-| JSON_VALUE openb expression ',' expression returning_type_opt closeb
+
+else_expression_opt:
   {
-    $$ = &JSONValueExpr{JSONDoc: $3, Path: $5, ReturningType: $6}
+    $$ = nil
   }
-| JSON_VALUE openb expression ',' expression returning_type_opt on_empty closeb
+| ELSE expression
   {
-    $$ = &JSONValueExpr{JSONDoc: $3, Path: $5, ReturningType: $6, EmptyOnResponse: $7}
+    $$ = $2
   }
-| JSON_VALUE openb expression ',' expression returning_type_opt on_error closeb
+
+column_name:
+  ci_identifier
   {
-    $$ = &JSONValueExpr{JSONDoc: $3, Path: $5, ReturningType: $6, ErrorOnResponse: $7}
+    $$ = &ColName{Name: $1}
   }
-| JSON_VALUE openb expression ',' expression returning_type_opt on_empty on_error closeb
+| non_reserved_keyword
   {
-    $$ = &JSONValueExpr{JSONDoc: $3, Path: $5, ReturningType: $6, EmptyOnResponse: $7, ErrorOnResponse: $8}
+    $$ = &ColName{Name: NewIdentifierCI(string($1))}
   }
-| JSON_DEPTH openb expression closeb
+| table_id '.' reserved_sql_id
   {
-    $$ = &JSONAttributesExpr{Type:DepthAttributeType, JSONDoc:$3}
+    $$ = &ColName{Qualifier: TableName{Name: $1}, Name: $3}
   }
-| JSON_VALID openb expression closeb
+| table_id '.' reserved_table_id '.' reserved_sql_id
   {
-    $$ = &JSONAttributesExpr{Type:ValidAttributeType, JSONDoc:$3}
+    $$ = &ColName{Qualifier: TableName{Qualifier: $1, Name: $3}, Name: $5}
   }
-| JSON_TYPE openb expression closeb
+
+column_name_or_offset:
+  column_name
   {
-    $$ = &JSONAttributesExpr{Type:TypeAttributeType, JSONDoc:$3}
+    $$ = $1
   }
-| JSON_LENGTH openb expression closeb
+| OFFSET_ARG
   {
-    $$ = &JSONAttributesExpr{Type:LengthAttributeType, JSONDoc:$3 }
+    $$ = &Offset{V: convertStringToInt($1)}
   }
-| JSON_LENGTH openb expression ',' expression closeb
+
+num_val:
+  sql_id
   {
-    $$ = &JSONAttributesExpr{Type:LengthAttributeType, JSONDoc:$3, Path: $5 }
+    // TODO(sougou): Deprecate this construct.
+    if $1.Lowered() != "value" {
+      yylex.Error("expecting value after next")
+      return 1
+    }
+    $$ = NewIntLiteral("1")
   }
-| JSON_ARRAY_APPEND openb expression ',' json_object_param_list closeb
+| INTEGRAL VALUES
   {
-    $$ = &JSONValueModifierExpr{Type:JSONArrayAppendType ,JSONDoc:$3, Params:$5}
+    $$ = NewIntLiteral($1)
   }
-| JSON_ARRAY_INSERT openb expression ',' json_object_param_list closeb
+| VALUE_ARG VALUES
   {
-    $$ = &JSONValueModifierExpr{Type:JSONArrayInsertType ,JSONDoc:$3, Params:$5}
+    $$ = parseBindVariable(yylex, $1[1:])
   }
-| JSON_INSERT openb expression ',' json_object_param_list closeb
+
+group_by_opt:
   {
-    $$ = &JSONValueModifierExpr{Type:JSONInsertType ,JSONDoc:$3, Params:$5}
+    $$ = nil
   }
-| JSON_REPLACE openb expression ',' json_object_param_list closeb
+| GROUP BY expression_list
   {
-    $$ = &JSONValueModifierExpr{Type:JSONReplaceType ,JSONDoc:$3, Params:$5}
+    $$ = $3
   }
-| JSON_SET openb expression ',' json_object_param_list closeb
+
+having_opt:
   {
-    $$ = &JSONValueModifierExpr{Type:JSONSetType ,JSONDoc:$3, Params:$5}
+    $$ = nil
   }
-| JSON_MERGE openb expression ',' expression_list closeb
+| HAVING expression
   {
-    $$ = &JSONValueMergeExpr{Type: JSONMergeType, JSONDoc: $3, JSONDocList: $5}
+    $$ = $2
   }
-| JSON_MERGE_PATCH openb expression ',' expression_list closeb
+
+named_window:
+  WINDOW window_definition_list %prec WINDOW_EXPR
   {
-    $$ = &JSONValueMergeExpr{Type: JSONMergePatchType, JSONDoc: $3, JSONDocList: $5}
+    $$ = &NamedWindow{$2}
   }
-| JSON_MERGE_PRESERVE openb expression ',' expression_list closeb
+
+named_windows_list:
+  named_window
   {
-    $$ = &JSONValueMergeExpr{Type: JSONMergePreserveType, JSONDoc: $3, JSONDocList: $5}
+    $$ = NamedWindows{$1}
   }
-| JSON_REMOVE openb expression ',' expression_list closeb
+| named_windows_list ',' named_window
   {
-    $$ = &JSONRemoveExpr{JSONDoc:$3, PathList: $5}
+    $$ = append($1,$3)
   }
-| JSON_UNQUOTE openb expression closeb
+
+named_windows_list_opt:
   {
-    $$ = &JSONUnquoteExpr{JSONValue:$3}
+    $$ = nil
   }
-| MULTIPOLYGON openb expression_list closeb
+| named_windows_list
   {
-    $$ = &MultiPolygonExpr{ PolygonParams:$3 }
+    $$ = $1
   }
-| MULTIPOINT openb expression_list closeb
+
+order_by_opt:
   {
-    $$ = &MultiPointExpr{ PointParams:$3 }
+    $$ = nil
   }
-| MULTILINESTRING openb expression_list closeb
+ | order_by_clause
+ {
+ 	$$ = $1
+ }
+
+order_by_clause:
+ORDER BY order_list
   {
-    $$ = &MultiLinestringExpr{ LinestringParams:$3 }
+    $$ = $3
   }
-| POLYGON openb expression_list closeb
+
+order_list:
+  order
   {
-    $$ = &PolygonExpr{ LinestringParams:$3 }
+    $$ = OrderBy{$1}
   }
-| LINESTRING openb expression_list closeb
+| order_list ',' order
   {
-    $$ = &LineStringExpr{ PointParams:$3 }
+    $$ = append($1, $3)
   }
-| POINT openb expression ',' expression closeb
+
+order:
+  expression asc_desc_opt
   {
-    $$ = &PointExpr{ XCordinate:$3, YCordinate:$5 }
+    $$ = &Order{Expr: $1, Direction: $2}
   }
-| argument_less_window_expr_type openb closeb over_clause
+
+asc_desc_opt:
   {
-    $$ = &ArgumentLessWindowExpr{ Type: $1, OverClause : $4 }
+    $$ = AscOrder
   }
-| first_or_last_value_expr_type openb expression closeb null_treatment_clause_opt over_clause
+| ASC
   {
-    $$ = &FirstOrLastValueExpr{ Type: $1, Expr : $3 , NullTreatmentClause:$5 ,OverClause:$6 }
+    $$ = AscOrder
   }
-| NTILE openb null_int_variable_arg closeb over_clause
+| DESC
   {
-    $$ =  &NtileExpr{N: $3, OverClause: $5}
+    $$ = DescOrder
   }
-| NTH_VALUE openb expression ',' null_int_variable_arg closeb from_first_last_clause_opt null_treatment_clause_opt over_clause
+
+limit_opt:
   {
-    $$ =  &NTHValueExpr{ Expr: $3, N: $5, FromFirstLastClause:$7, NullTreatmentClause:$8, OverClause: $9}
+    $$ = nil
   }
-| lag_lead_expr_type openb expression closeb null_treatment_clause_opt over_clause
+ | limit_clause
+ {
+ 	$$ = $1
+ }
+
+limit_clause:
+LIMIT expression
   {
-    $$ = &LagLeadExpr{ Type:$1 , Expr: $3, NullTreatmentClause:$5, OverClause: $6 }
+    $$ = &Limit{Rowcount: $2}
   }
-| lag_lead_expr_type openb expression ',' null_int_variable_arg default_with_comma_opt closeb null_treatment_clause_opt over_clause
+| LIMIT expression ',' expression
   {
-    $$ =  &LagLeadExpr{ Type:$1 , Expr: $3, N: $5, Default: $6, NullTreatmentClause:$8, OverClause: $9}
+    $$ = &Limit{Offset: $2, Rowcount: $4}
   }
-| ADDDATE openb expression ',' INTERVAL bit_expr interval closeb
+| LIMIT expression OFFSET expression
   {
-    $$ = &DateAddExpr{Type: AdddateType, Date: $3, Expr: $6, Unit: $7}
+    $$ = &Limit{Offset: $4, Rowcount: $2}
   }
-| ADDDATE openb expression ',' expression closeb
+
+algorithm_lock_opt:
   {
-    $$ = &DateAddExpr{Type: AdddateType, Date: $3, Expr: $5}
+    $$ = nil
   }
-| DATE_ADD openb expression ',' INTERVAL bit_expr interval closeb
+| lock_index algorithm_index
   {
-    $$ = &DateAddExpr{Type: DateAddType, Date: $3, Expr: $6, Unit: $7}
+     $$ = []AlterOption{$1,$2}
   }
-| DATE_SUB openb expression ',' INTERVAL bit_expr interval closeb
+| algorithm_index lock_index
   {
-    $$ = &DateSubExpr{Type: DateSubType, Date: $3, Expr: $6, Unit: $7}
+     $$ = []AlterOption{$1,$2}
   }
-| SUBDATE openb expression ',' INTERVAL bit_expr interval closeb
+| algorithm_index
   {
-    $$ = &DateSubExpr{Type: SubdateType, Date: $3, Expr: $6, Unit: $7}
+     $$ = []AlterOption{$1}
   }
-| SUBDATE openb expression ',' expression closeb
+| lock_index
   {
-    $$ = &DateSubExpr{Type: SubdateType, Date: $3, Expr: $5}
+     $$ = []AlterOption{$1}
   }
-| regular_expressions
-| xml_expressions
-| performance_schema_function_expressions
-| gtid_function_expressions
 
-null_int_variable_arg:
-  null_as_literal
+
+lock_index:
+  LOCK equal_opt DEFAULT
   {
-    $$ = $1
+    $$ = &LockOption{Type:DefaultType}
   }
-| INTEGRAL
+| LOCK equal_opt NONE
   {
-    $$ = NewIntLiteral($1)
+    $$ = &LockOption{Type:NoneType}
   }
-| user_defined_variable
+| LOCK equal_opt SHARED
   {
-    $$ = $1
+    $$ = &LockOption{Type:SharedType}
   }
-| VALUE_ARG
+| LOCK equal_opt EXCLUSIVE
   {
-    $$ = parseBindVariable(yylex, $1[1:])
+    $$ = &LockOption{Type:ExclusiveType}
   }
 
-default_with_comma_opt:
+algorithm_index:
+  ALGORITHM equal_opt DEFAULT
   {
-    $$ = nil
+    $$ = AlgorithmValue($3)
   }
-| ',' expression
+| ALGORITHM equal_opt INPLACE
   {
-    $$ = $2
+    $$ = AlgorithmValue($3)
   }
-
-regular_expressions:
-  REGEXP_INSTR openb expression ',' expression closeb
+| ALGORITHM equal_opt COPY
   {
-    $$ = &RegexpInstrExpr{Expr:$3, Pattern:$5}
+    $$ = AlgorithmValue($3)
   }
-| REGEXP_INSTR openb expression ',' expression ',' expression closeb
+| ALGORITHM equal_opt INSTANT
   {
-    $$ = &RegexpInstrExpr{Expr:$3, Pattern:$5, Position: $7}
+    $$ = AlgorithmValue($3)
   }
-| REGEXP_INSTR openb expression ',' expression ',' expression ',' expression closeb
+
+algorithm_view:
   {
-    $$ = &RegexpInstrExpr{Expr:$3, Pattern:$5, Position: $7, Occurrence: $9}
+    $$ = ""
   }
-| REGEXP_INSTR openb expression ',' expression ',' expression ',' expression ',' expression closeb
+| ALGORITHM '=' UNDEFINED
   {
-    $$ = &RegexpInstrExpr{Expr:$3, Pattern:$5, Position: $7, Occurrence: $9, ReturnOption: $11}
+    $$ = string($3)
   }
-| REGEXP_INSTR openb expression ',' expression ',' expression ',' expression ',' expression ',' expression closeb
+| ALGORITHM '=' MERGE
   {
-    // Match type is kept expression as TRIM( ' m  ') is accepted
-    $$ = &RegexpInstrExpr{Expr:$3, Pattern:$5, Position: $7, Occurrence: $9, ReturnOption: $11, MatchType: $13}
+    $$ = string($3)
   }
-| REGEXP_LIKE openb expression ',' expression closeb
+| ALGORITHM '=' TEMPTABLE
   {
-    $$ = &RegexpLikeExpr{Expr:$3, Pattern:$5}
+    $$ = string($3)
   }
-| REGEXP_LIKE openb expression ',' expression ',' expression closeb
+
+security_view_opt:
   {
-    $$ = &RegexpLikeExpr{Expr:$3, Pattern:$5, MatchType: $7}
+    $$ = ""
   }
-| REGEXP_REPLACE openb expression ',' expression ',' expression closeb
+| SQL SECURITY security_view
   {
-    $$ = &RegexpReplaceExpr{Expr:$3, Pattern:$5, Repl: $7}
+    $$ = $3
   }
-| REGEXP_REPLACE openb expression ',' expression ',' expression ',' expression closeb
+
+security_view:
+  DEFINER
   {
-    $$ = &RegexpReplaceExpr{Expr:$3, Pattern:$5, Repl: $7, Position: $9}
+    $$ = string($1)
   }
-| REGEXP_REPLACE openb expression ',' expression ',' expression ',' expression ',' expression closeb
+| INVOKER
   {
-    $$ = &RegexpReplaceExpr{Expr:$3, Pattern:$5, Repl: $7, Position: $9, Occurrence: $11}
+    $$ = string($1)
   }
-| REGEXP_REPLACE openb expression ',' expression ',' expression ',' expression ',' expression ',' expression closeb
+
+check_option_opt:
   {
-    // Match type is kept expression as TRIM( ' m  ') is accepted
-    $$ = &RegexpReplaceExpr{Expr:$3, Pattern:$5, Repl: $7, Position: $9, Occurrence: $11, MatchType: $13}
+    $$ = ""
   }
-| REGEXP_SUBSTR openb expression ',' expression closeb
+| WITH cascade_or_local_opt CHECK OPTION
   {
-    $$ = &RegexpSubstrExpr{Expr:$3, Pattern:$5 }
+    $$ = $2
   }
-| REGEXP_SUBSTR openb expression ',' expression ',' expression closeb
+
+cascade_or_local_opt:
   {
-    $$ = &RegexpSubstrExpr{Expr:$3, Pattern:$5, Position: $7}
+    $$ = "cascaded"
   }
-| REGEXP_SUBSTR openb expression ',' expression ',' expression ',' expression closeb
+| CASCADED
   {
-    $$ = &RegexpSubstrExpr{Expr:$3, Pattern:$5, Position: $7, Occurrence: $9}
+    $$ = string($1)
   }
-| REGEXP_SUBSTR openb expression ',' expression ',' expression ',' expression ',' expression closeb
+| LOCAL
   {
-    // Match type is kept expression as TRIM( ' m  ') is accepted
-    $$ = &RegexpSubstrExpr{Expr:$3, Pattern:$5, Position: $7, Occurrence: $9, MatchType: $11}
+    $$ = string($1)
   }
 
-xml_expressions:
-  ExtractValue openb expression ',' expression closeb
+definer_opt:
   {
-    $$ = &ExtractValueExpr{Fragment:$3, XPathExpr:$5}
+    $$ = nil
   }
-| UpdateXML openb expression ',' expression ',' expression closeb
+| DEFINER '=' user
   {
-    $$ = &UpdateXMLExpr{ Target:$3, XPathExpr:$5, NewXML:$7 }
+    $$ = $3
   }
 
-performance_schema_function_expressions:
-  FORMAT_BYTES openb expression closeb
+user:
+CURRENT_USER
   {
-    $$ = &PerformanceSchemaFuncExpr{Type: FormatBytesType, Argument:$3}
+    $$ = &Definer{
+    	Name: string($1),
+    }
   }
-| FORMAT_PICO_TIME openb expression closeb
+| CURRENT_USER '(' ')'
   {
-    $$ = &PerformanceSchemaFuncExpr{Type: FormatPicoTimeType, Argument:$3}
+    $$ = &Definer{
+        Name: string($1),
+    }
   }
-| PS_CURRENT_THREAD_ID openb closeb
+| user_username address_opt
   {
-    $$ = &PerformanceSchemaFuncExpr{Type: PsCurrentThreadIDType}
+    $$ = &Definer{
+        Name: $1,
+        Address: $2,
+    }
   }
-| PS_THREAD_ID openb expression closeb
+
+user_username:
+  STRING
   {
-    $$ = &PerformanceSchemaFuncExpr{Type: PsThreadIDType, Argument:$3}
+    $$ = encodeSQLString($1)
+  }
+| ID
+  {
+    $$ = formatIdentifier($1)
   }
 
-gtid_function_expressions:
-  GTID_SUBSET openb expression ',' expression closeb
+address_opt:
   {
-    $$ = &GTIDFuncExpr{Type: GTIDSubsetType, Set1:$3, Set2: $5 }
+    $$ = ""
   }
-| GTID_SUBTRACT openb expression ',' expression closeb
+| AT_ID
   {
-    $$ = &GTIDFuncExpr{Type: GTIDSubtractType, Set1:$3, Set2: $5 }
+    $$ = formatAddress($1)
   }
-| WAIT_FOR_EXECUTED_GTID_SET openb expression closeb
+| AT_STRING
   {
-    $$ = &GTIDFuncExpr{Type: WaitForExecutedGTIDSetType, Set1: $3}
+    $$ = encodeSQLString($1)
   }
-| WAIT_FOR_EXECUTED_GTID_SET openb expression ',' expression closeb
+
+locking_clause:
+FOR UPDATE
   {
-    $$ = &GTIDFuncExpr{Type: WaitForExecutedGTIDSetType, Set1: $3, Timeout: $5 }
+    $$ = ForUpdateLock
   }
-| WAIT_UNTIL_SQL_THREAD_AFTER_GTIDS openb expression closeb
+| LOCK IN SHARE MODE
   {
-    $$ = &GTIDFuncExpr{Type: WaitUntilSQLThreadAfterGTIDSType, Set1: $3 }
+    $$ = ShareModeLock
   }
-| WAIT_UNTIL_SQL_THREAD_AFTER_GTIDS openb expression ',' expression closeb
+
+into_clause:
+INTO OUTFILE S3 STRING charset_opt format_opt export_options manifest_opt overwrite_opt
+{
+$$ = &SelectInto{Type:IntoOutfileS3, FileName:encodeSQLString($4), Charset:$5, FormatOption:$6, ExportOption:$7, Manifest:$8, Overwrite:$9}
+}
+| INTO DUMPFILE STRING
+{
+$$ = &SelectInto{Type:IntoDumpfile, FileName:encodeSQLString($3), Charset:ColumnCharset{}, FormatOption:"", ExportOption:"", Manifest:"", Overwrite:""}
+}
+| INTO OUTFILE STRING charset_opt export_options
+{
+$$ = &SelectInto{Type:IntoOutfile, FileName:encodeSQLString($3), Charset:$4, FormatOption:"", ExportOption:$5, Manifest:"", Overwrite:""}
+}
+| INTO into_variable_list
+{
+$$ = &SelectInto{Type:IntoVariables, Variables:$2}
+}
+
+into_variable_list:
+  into_variable
   {
-    $$ = &GTIDFuncExpr{Type: WaitUntilSQLThreadAfterGTIDSType, Set1: $3, Timeout: $5 }
+    $$ = Exprs{$1}
   }
-| WAIT_UNTIL_SQL_THREAD_AFTER_GTIDS openb expression ',' expression ',' expression closeb
+| into_variable_list ',' into_variable
   {
-    $$ = &GTIDFuncExpr{Type: WaitUntilSQLThreadAfterGTIDSType, Set1: $3, Timeout: $5, Channel: $7 }
+    $$ = append($1, $3)
   }
 
-returning_type_opt:
+// into_variable is a user variable, or a local variable of a stored program.
+into_variable:
+  user_defined_variable
   {
-    $$ = nil
+    $$ = $1
   }
-| RETURNING convert_type
+| ci_identifier
   {
-    $$ = $2
+    $$ = &ColName{Name: $1}
   }
 
-interval:
-  DAY_HOUR
+format_opt:
   {
-	$$=IntervalDayHour
+    $$ = ""
   }
-| DAY_MICROSECOND
+| FORMAT CSV header_opt
   {
-	$$=IntervalDayMicrosecond
+    $$ = " format csv" + $3
   }
-| DAY_MINUTE
+| FORMAT TEXT header_opt
   {
-	$$=IntervalDayMinute
+    $$ = " format text" + $3
   }
-| DAY_SECOND
+
+header_opt:
   {
-	$$=IntervalDaySecond
+    $$ = ""
   }
-| HOUR_MICROSECOND
+| HEADER
   {
-	$$=IntervalHourMicrosecond
+    $$ = " header"
   }
-| HOUR_MINUTE
+
+manifest_opt:
   {
-	$$=IntervalHourMinute
+    $$ = ""
   }
-| HOUR_SECOND
+| MANIFEST ON
   {
-	$$=IntervalHourSecond
+    $$ = " manifest on"
   }
-| MINUTE_MICROSECOND
+| MANIFEST OFF
   {
-	$$=IntervalMinuteMicrosecond
+    $$ = " manifest off"
   }
-| MINUTE_SECOND
+
+overwrite_opt:
   {
-	$$=IntervalMinuteSecond
+    $$ = ""
   }
-| SECOND_MICROSECOND
+| OVERWRITE ON
   {
-	$$=IntervalSecondMicrosecond
+    $$ = " overwrite on"
   }
-| YEAR_MONTH
+| OVERWRITE OFF
   {
-	$$=IntervalYearMonth
+    $$ = " overwrite off"
   }
-| DAY
+
+export_options:
+  fields_opts lines_opts
   {
- 	$$=IntervalDay
+    $$ = $1 + $2
   }
-| WEEK
+
+lines_opts:
   {
-  	$$=IntervalWeek
+    $$ = ""
   }
-| HOUR
+| LINES lines_opt_list
   {
- 	$$=IntervalHour
+    $$ = " lines" + $2
   }
-| MINUTE
+
+lines_opt_list:
+  lines_opt
   {
- 	$$=IntervalMinute
+    $$ = $1
+  }
+| lines_opt_list lines_opt
+  {
+    $$ = $1 + $2
+  }
+
+lines_opt:
+  STARTING BY STRING
+  {
+    $$ = " starting by " + encodeSQLString($3)
   }
-| MONTH
+| TERMINATED BY STRING
   {
-	$$=IntervalMonth
+    $$ = " terminated by " + encodeSQLString($3)
   }
-| QUARTER
+
+fields_opts:
   {
-	$$=IntervalQuarter
+    $$ = ""
   }
-| SECOND
+| columns_or_fields fields_opt_list
   {
-	$$=IntervalSecond
+    $$ = " " + $1 + $2
   }
-| MICROSECOND
+
+fields_opt_list:
+  fields_opt
   {
-	$$=IntervalMicrosecond
+    $$ = $1
   }
-| YEAR
+| fields_opt_list fields_opt
   {
-	$$=IntervalYear
+    $$ = $1 + $2
   }
 
-func_paren_opt:
-  /* empty */
-| openb closeb
-
-func_datetime_precision:
-  /* empty */
+fields_opt:
+  TERMINATED BY STRING
   {
-  	$$ = 0
+    $$ = " terminated by " + encodeSQLString($3)
   }
-| openb closeb
+| optionally_opt ENCLOSED BY STRING
   {
-    $$ = 0
+    $$ = $1 + " enclosed by " + encodeSQLString($4)
   }
-| openb INTEGRAL closeb
+| ESCAPED BY STRING
   {
-      $$ = convertStringToInt($2)
+    $$ = " escaped by " + encodeSQLString($3)
   }
 
-/*
-  Function calls using non reserved keywords with *normal* syntax forms. Because
-  the names are non-reserved, they need a dedicated rule so as not to conflict
-*/
-function_call_conflict:
-  IF openb select_expression_list closeb
+optionally_opt:
   {
-    $$ = &FuncExpr{Name: NewIdentifierCI("if"), Exprs: $3}
+    $$ = ""
   }
-| DATABASE openb select_expression_list_opt closeb
+| OPTIONALLY
   {
-    $$ = &FuncExpr{Name: NewIdentifierCI("database"), Exprs: $3}
+    $$ = " optionally"
   }
-| SCHEMA openb select_expression_list_opt closeb
+
+// insert_data expands all combinations into a single rule.
+// This avoids a shift/reduce conflict while encountering the
+// following two possible constructs:
+// insert into t1(a, b) (select * from t2)
+// insert into t1(select * from t2)
+// Because the rules are together, the parser can keep shifting
+// the tokens until it disambiguates a as sql_id and select as keyword.
+insert_data:
+  VALUES tuple_list
   {
-    $$ = &FuncExpr{Name: NewIdentifierCI("schema"), Exprs: $3}
+    $$ = &Insert{Rows: $2}
   }
-| MOD openb select_expression_list closeb
+| select_statement
   {
-    $$ = &FuncExpr{Name: NewIdentifierCI("mod"), Exprs: $3}
+    $$ = &Insert{Rows: $1}
   }
-| REPLACE openb select_expression_list closeb
+| openb ins_column_list closeb VALUES tuple_list
   {
-    $$ = &FuncExpr{Name: NewIdentifierCI("replace"), Exprs: $3}
+    $$ = &Insert{Columns: $2, Rows: $5}
   }
-
-match_option:
-/*empty*/
+| openb closeb VALUES tuple_list
   {
-    $$ = NoOption
+    $$ = &Insert{Columns: []IdentifierCI{}, Rows: $4}
   }
-| IN BOOLEAN MODE
+| openb ins_column_list closeb select_statement
   {
-    $$ = BooleanModeOpt
+    $$ = &Insert{Columns: $2, Rows: $4}
   }
-| IN NATURAL LANGUAGE MODE
- {
-    $$ = NaturalLanguageModeOpt
- }
-| IN NATURAL LANGUAGE MODE WITH QUERY EXPANSION
- {
-    $$ = NaturalLanguageModeWithQueryExpansionOpt
- }
-| WITH QUERY EXPANSION
- {
-    $$ = QueryExpansionOpt
- }
 
-charset:
+ins_column_list:
   sql_id
   {
-    $$ = string($1.String())
+    $$ = Columns{$1}
   }
-| STRING
+| sql_id '.' sql_id
   {
-    $$ = string($1)
+    $$ = Columns{$3}
   }
-| BINARY
+| ins_column_list ',' sql_id
   {
-    $$ = string($1)
+    $$ = append($$, $3)
   }
-
-convert_type_weight_string:
-  /* empty */
+| ins_column_list ',' sql_id '.' sql_id
   {
-    $$ = nil
+    $$ = append($$, $5)
   }
-| AS BINARY '(' INTEGRAL ')'
+
+on_dup_opt:
   {
-    $$ = &ConvertType{Type: string($2), Length: NewIntLiteral($4)}
+    $$ = nil
   }
-| AS CHAR '(' INTEGRAL ')'
+| ON DUPLICATE KEY UPDATE update_list
   {
-    $$ = &ConvertType{Type: string($2), Length: NewIntLiteral($4)}
+    $$ = $5
   }
 
-convert_type:
-  BINARY length_opt
-  {
-    $$ = &ConvertType{Type: string($1), Length: $2}
-  }
-| CHAR length_opt charset_opt
+on_conflict:
+  ON CONFLICT conflict_target_opt DO NOTHING
   {
-    $$ = &ConvertType{Type: string($1), Length: $2, Charset: $3}
+    $$ = $3
+    $$.DoNothing = true
   }
-| DATE
+| ON CONFLICT conflict_target_opt DO UPDATE SET update_list where_expression_opt
   {
-    $$ = &ConvertType{Type: string($1)}
+    $$ = $3
+    $$.Exprs = $7
+    $$.Where = NewWhere(WhereClause, $8)
   }
-| DATETIME length_opt
+
+conflict_target_opt:
   {
-    $$ = &ConvertType{Type: string($1), Length: $2}
+    $$ = &OnConflict{}
   }
-| DECIMAL_TYPE decimal_length_opt
+| openb column_list closeb
   {
-    $$ = &ConvertType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = &OnConflict{Columns: $2}
   }
-| JSON
+| ON CONSTRAINT sql_id
   {
-    $$ = &ConvertType{Type: string($1)}
+    $$ = &OnConflict{Constraint: $3}
   }
-| NCHAR length_opt
+
+tuple_list:
+  tuple_or_empty
   {
-    $$ = &ConvertType{Type: string($1), Length: $2}
+    $$ = Values{$1}
   }
-| SIGNED
+| tuple_list ',' tuple_or_empty
   {
-    $$ = &ConvertType{Type: string($1)}
+    $$ = append($1, $3)
   }
-| SIGNED INTEGER
+
+tuple_or_empty:
+  row_tuple
   {
-    $$ = &ConvertType{Type: string($1)}
+    $$ = $1
   }
-| TIME length_opt
+| openb closeb
   {
-    $$ = &ConvertType{Type: string($1), Length: $2}
+    $$ = ValTuple{}
   }
-| UNSIGNED
+
+row_tuple:
+  openb expression_list closeb
   {
-    $$ = &ConvertType{Type: string($1)}
+    $$ = ValTuple($2)
   }
-| UNSIGNED INTEGER
+| ROW openb expression_list closeb
   {
-    $$ = &ConvertType{Type: string($1)}
+    $$ = ValTuple($3)
   }
-| FLOAT_TYPE length_opt
+tuple_expression:
+ row_tuple
   {
-    $$ = &ConvertType{Type: string($1), Length: $2}
+    if len($1) == 1 {
+      $$ = $1[0]
+    } else {
+      $$ = $1
+    }
   }
-| DOUBLE
+
+update_list:
+  update_expression
   {
-    $$ = &ConvertType{Type: string($1)}
+    $$ = UpdateExprs{$1}
   }
-| REAL
+| update_list ',' update_expression
   {
-    $$ = &ConvertType{Type: string($1)}
+    $$ = append($1, $3)
   }
 
-array_opt:
-  /* empty */
-  {
-    $$ = false
-  }
-| ARRAY
+update_expression:
+  column_name '=' expression
   {
-    $$ = true
+    $$ = &UpdateExpr{Name: $1, Expr: $3}
   }
 
-expression_opt:
+charset_or_character_set:
+  CHARSET
+| CHARACTER SET
   {
-    $$ = nil
+    $$ = "charset"
   }
-| expression
+
+charset_or_character_set_or_names:
+  charset_or_character_set
+| NAMES
+
+charset_value:
+  sql_id
   {
-    $$ = $1
+    $$ = NewStrLiteral($1.String())
   }
-
-separator_opt:
+| STRING
   {
-    $$ = string("")
+    $$ = NewStrLiteral($1)
   }
-| SEPARATOR STRING
+| DEFAULT
   {
-    $$ = encodeSQLString($2)
+    $$ = &Default{}
   }
 
-when_expression_list:
-  when_expression
-  {
-    $$ = []*When{$1}
-  }
-| when_expression_list when_expression
+for_from:
+  FOR
+| FROM
+
+temp_opt:
+  { $$ = false }
+| TEMPORARY
+  { $$ = true }
+
+exists_opt:
+  { $$ = false }
+| IF EXISTS
+  { $$ = true }
+
+not_exists_opt:
+  { $$ = false }
+| IF NOT EXISTS
+  { $$ = true }
+
+ignore_opt:
+  { $$ = false }
+| IGNORE
+  { $$ = true }
+
+to_opt:
+  { $$ = struct{}{} }
+| TO
+  { $$ = struct{}{} }
+| AS
+  { $$ = struct{}{} }
+
+call_statement:
+  CALL table_name openb expression_list_opt closeb
   {
-    $$ = append($1, $2)
+    $$ = &CallProc{Name: $2, Params: $4}
   }
 
-when_expression:
-  WHEN expression THEN expression
+proc_param_list_opt:
   {
-    $$ = &When{Cond: $2, Val: $4}
+    $$ = nil
   }
+| proc_param_list
 
-else_expression_opt:
+proc_param_list:
+  proc_param
   {
-    $$ = nil
+    $$ = []*ProcParameter{$1}
   }
-| ELSE expression
+| proc_param_list ',' proc_param
   {
-    $$ = $2
+    $$ = append($1, $3)
   }
 
-column_name:
-  ci_identifier
+proc_param:
+  proc_param_mode sql_id column_type
   {
-    $$ = &ColName{Name: $1}
+    $$ = &ProcParameter{Mode: $1, Name: $2, Type: $3}
   }
-| non_reserved_keyword
+
+proc_param_mode:
   {
-    $$ = &ColName{Name: NewIdentifierCI(string($1))}
+    $$ = DefaultParameterMode
   }
-| table_id '.' reserved_sql_id
+| IN
   {
-    $$ = &ColName{Qualifier: TableName{Name: $1}, Name: $3}
+    $$ = InParameterMode
   }
-| table_id '.' reserved_table_id '.' reserved_sql_id
+| OUT
   {
-    $$ = &ColName{Qualifier: TableName{Qualifier: $1, Name: $3}, Name: $5}
+    $$ = OutParameterMode
   }
-
-column_name_or_offset:
-  column_name
+| INOUT
   {
-    $$ = $1
+    $$ = InOutParameterMode
   }
-| OFFSET_ARG
+
+func_param_list_opt:
   {
-    $$ = &Offset{V: convertStringToInt($1)}
+    $$ = nil
   }
+| func_param_list
 
-num_val:
-  sql_id
+func_param_list:
+  func_param
   {
-    // TODO(sougou): Deprecate this construct.
-    if $1.Lowered() != "value" {
-      yylex.Error("expecting value after next")
-      return 1
-    }
-    $$ = NewIntLiteral("1")
+    $$ = []*ProcParameter{$1}
   }
-| INTEGRAL VALUES
+| func_param_list ',' func_param
   {
-    $$ = NewIntLiteral($1)
+    $$ = append($1, $3)
   }
-| VALUE_ARG VALUES
+
+func_param:
+  sql_id column_type
   {
-    $$ = parseBindVariable(yylex, $1[1:])
+    $$ = &ProcParameter{Name: $1, Type: $2}
   }
 
-group_by_opt:
+routine_characteristic_list_opt:
   {
     $$ = nil
   }
-| GROUP BY expression_list
-  {
-    $$ = $3
-  }
+| routine_characteristic_list
 
-having_opt:
+routine_characteristic_list:
+  routine_characteristic
   {
-    $$ = nil
+    $$ = []*RoutineCharacteristic{$1}
   }
-| HAVING expression
+| routine_characteristic_list routine_characteristic
   {
-    $$ = $2
+    $$ = append($1, $2)
   }
 
-named_window:
-  WINDOW window_definition_list %prec WINDOW_EXPR
+routine_characteristic:
+  COMMENT_KEYWORD STRING
   {
-    $$ = &NamedWindow{$2}
+    $$ = &RoutineCharacteristic{Type: CommentCharacteristic, Comment: $2}
   }
-
-named_windows_list:
-  named_window
+| LANGUAGE SQL
   {
-    $$ = NamedWindows{$1}
+    $$ = &RoutineCharacteristic{Type: LanguageSQLCharacteristic}
   }
-| named_windows_list ',' named_window
+| DETERMINISTIC
   {
-    $$ = append($1,$3)
+    $$ = &RoutineCharacteristic{Type: DeterministicCharacteristic}
   }
-
-named_windows_list_opt:
+| NOT DETERMINISTIC
   {
-    $$ = nil
+    $$ = &RoutineCharacteristic{Type: NotDeterministicCharacteristic}
   }
-| named_windows_list
+| CONTAINS SQL
   {
-    $$ = $1
+    $$ = &RoutineCharacteristic{Type: ContainsSQLCharacteristic}
   }
-
-order_by_opt:
+| NO SQL
   {
-    $$ = nil
+    $$ = &RoutineCharacteristic{Type: NoSQLCharacteristic}
   }
- | order_by_clause
- {
- 	$$ = $1
- }
-
-order_by_clause:
-ORDER BY order_list
+| READS SQL DATA
   {
-    $$ = $3
+    $$ = &RoutineCharacteristic{Type: ReadsSQLDataCharacteristic}
   }
-
-order_list:
-  order
+| MODIFIES SQL DATA
   {
-    $$ = OrderBy{$1}
+    $$ = &RoutineCharacteristic{Type: ModifiesSQLDataCharacteristic}
   }
-| order_list ',' order
+| SQL SECURITY DEFINER
   {
-    $$ = append($1, $3)
+    $$ = &RoutineCharacteristic{Type: SQLSecurityDefinerCharacteristic}
   }
-
-order:
-  expression asc_desc_opt
+| SQL SECURITY INVOKER
   {
-    $$ = &Order{Expr: $1, Direction: $2}
+    $$ = &RoutineCharacteristic{Type: SQLSecurityInvokerCharacteristic}
   }
 
-asc_desc_opt:
-  {
-    $$ = AscOrder
-  }
-| ASC
-  {
-    $$ = AscOrder
-  }
-| DESC
+trigger_timing:
+  BEFORE
   {
-    $$ = DescOrder
+    $$ = BeforeTrigger
   }
-
-limit_opt:
+| AFTER
   {
-    $$ = nil
+    $$ = AfterTrigger
   }
- | limit_clause
- {
- 	$$ = $1
- }
 
-limit_clause:
-LIMIT expression
+trigger_event:
+  INSERT
   {
-    $$ = &Limit{Rowcount: $2}
+    $$ = InsertTrigger
   }
-| LIMIT expression ',' expression
+| UPDATE
   {
-    $$ = &Limit{Offset: $2, Rowcount: $4}
+    $$ = UpdateTrigger
   }
-| LIMIT expression OFFSET expression
+| DELETE
   {
-    $$ = &Limit{Offset: $4, Rowcount: $2}
+    $$ = DeleteTrigger
   }
 
-algorithm_lock_opt:
+trigger_order_opt:
   {
     $$ = nil
   }
-| lock_index algorithm_index
+| FOLLOWS sql_id
   {
-     $$ = []AlterOption{$1,$2}
+    $$ = &TriggerOrder{Name: $2}
   }
-| algorithm_index lock_index
+| PRECEDES sql_id
   {
-     $$ = []AlterOption{$1,$2}
+    $$ = &TriggerOrder{Precedes: true, Name: $2}
   }
-| algorithm_index
+
+event_schedule:
+  AT expression
   {
-     $$ = []AlterOption{$1}
+    $$ = &EventSchedule{At: $2}
   }
-| lock_index
+| EVERY expression interval event_starts_opt event_ends_opt
   {
-     $$ = []AlterOption{$1}
+    $$ = &EventSchedule{Every: $2, Unit: $3, Starts: $4, Ends: $5}
   }
 
-
-lock_index:
-  LOCK equal_opt DEFAULT
+event_starts_opt:
   {
-    $$ = &LockOption{Type:DefaultType}
+    $$ = nil
   }
-| LOCK equal_opt NONE
+| STARTS expression
   {
-    $$ = &LockOption{Type:NoneType}
+    $$ = $2
   }
-| LOCK equal_opt SHARED
+
+event_ends_opt:
   {
-    $$ = &LockOption{Type:SharedType}
+    $$ = nil
   }
-| LOCK equal_opt EXCLUSIVE
+| ENDS expression
   {
-    $$ = &LockOption{Type:ExclusiveType}
+    $$ = $2
   }
 
-algorithm_index:
-  ALGORITHM equal_opt DEFAULT
-  {
-    $$ = AlgorithmValue($3)
-  }
-| ALGORITHM equal_opt INPLACE
+event_on_completion_opt:
   {
-    $$ = AlgorithmValue($3)
+    $$ = NoOnCompletion
   }
-| ALGORITHM equal_opt COPY
+| ON COMPLETION PRESERVE
   {
-    $$ = AlgorithmValue($3)
+    $$ = OnCompletionPreserve
   }
-| ALGORITHM equal_opt INSTANT
+| ON COMPLETION NOT PRESERVE
   {
-    $$ = AlgorithmValue($3)
+    $$ = OnCompletionNotPreserve
   }
 
-algorithm_view:
+event_status_opt:
   {
-    $$ = ""
+    $$ = NoEventStatus
   }
-| ALGORITHM '=' UNDEFINED
+| ENABLE
   {
-    $$ = string($3)
+    $$ = EnableEventStatus
   }
-| ALGORITHM '=' MERGE
+| DISABLE
   {
-    $$ = string($3)
+    $$ = DisableEventStatus
   }
-| ALGORITHM '=' TEMPTABLE
+| DISABLE ON sql_id
   {
-    $$ = string($3)
+    if $3.Lowered() != "slave" && $3.Lowered() != "replica" {
+      yylex.Error("expecting slave or replica after disable on")
+      return 1
+    }
+    $$ = DisableOnReplicaEventStatus
   }
 
-security_view_opt:
+event_comment_opt:
   {
-    $$ = ""
+    $$ = nil
   }
-| SQL SECURITY security_view
+| COMMENT_KEYWORD STRING
   {
-    $$ = $3
+    $$ = NewStrLiteral($2)
   }
 
-security_view:
-  DEFINER
+event_rename_opt:
   {
-    $$ = string($1)
+    $$ = TableName{}
   }
-| INVOKER
+| RENAME TO table_name
   {
-    $$ = string($1)
+    $$ = $3
   }
 
-check_option_opt:
+event_do_opt:
   {
-    $$ = ""
+    $$ = nil
   }
-| WITH cascade_or_local_opt CHECK OPTION
+| DO sp_body
   {
     $$ = $2
   }
 
-cascade_or_local_opt:
+/*
+ * Stored program bodies. A body is a single statement, usually a
+ * BEGIN ... END compound statement of statements terminated by ';'.
+ */
+sp_body:
+  sp_body_begin sp_proc_stmt
   {
-    $$ = "cascaded"
+    $$ = $2
   }
-| CASCADED
+
+sp_func_body:
+  sp_body_begin sp_func_stmt
   {
-    $$ = string($1)
+    $$ = $2
   }
-| LOCAL
+
+// sp_body_begin marks the start of the body of a stored program, so that
+// a DDL statement of the body isn't taken for a partially parsed statement.
+sp_body_begin:
   {
-    $$ = string($1)
+    yylex.(*Tokenizer).inRoutine = true
   }
 
-definer_opt:
+sp_proc_stmt:
+  select_statement
   {
-    $$ = nil
+    $$ = $1
   }
-| DEFINER '=' user
+| sp_func_stmt
+| start_transaction_statement
   {
-    $$ = $3
+    // BEGIN starts a compound statement in a body, so keep the
+    // transaction formatted as START TRANSACTION.
+    if begin := $1.(*Begin); begin.TxAccessModes == nil {
+      begin.TxAccessModes = []TxAccessMode{}
+    }
+    $$ = $1
   }
-
-user:
-CURRENT_USER
+| savepoint_statement
+| release_statement
+| create_statement
   {
-    $$ = &Definer{
-    	Name: string($1),
+    switch $1.(type) {
+    case *CreateProcedure, *CreateFunction, *CreateTrigger, *CreateEvent:
+      yylex.Error("can't create a stored program from within another stored program")
+      return 1
     }
+    $$ = $1
   }
-| CURRENT_USER '(' ')'
+| alter_statement
+| rename_statement
+| drop_statement
+| truncate_statement
+| analyze_statement
+| show_statement
+| use_statement
+| flush_statement
+| explain_statement
+| grant_statement
+| revoke_statement
+
+// sp_func_stmt is a statement of a stored function body. A function can't
+// return a result set, so it excludes SELECT, which would also make a body
+// starting with '(' ambiguous with the length of the RETURNS type.
+sp_func_stmt:
+  insert_statement
+| update_statement
+| delete_statement
+| sp_set_statement
+| call_statement
+| do_statement
+| commit_statement
+| rollback_statement
+| prepare_statement
+| execute_statement
+| deallocate_statement
+| sp_begin_label BEGIN sp_block_statement_list_opt END ci_identifier_opt
   {
-    $$ = &Definer{
-        Name: string($1),
+    if endLabelMismatch($1, $5) {
+      yylex.Error("end label without match")
+      return 1
     }
+    $$ = &BeginEndBlock{Label: $1, Statements: $3}
   }
-| user_username address_opt
+| sp_begin_label LOOP sp_statement_list END LOOP ci_identifier_opt
   {
-    $$ = &Definer{
-        Name: $1,
-        Address: $2,
+    if endLabelMismatch($1, $6) {
+      yylex.Error("end label without match")
+      return 1
     }
+    $$ = &LoopStatement{Label: $1, Statements: $3}
   }
-
-user_username:
-  STRING
+| sp_begin_label WHILE expression DO sp_statement_list END WHILE ci_identifier_opt
+  {
+    if endLabelMismatch($1, $8) {
+      yylex.Error("end label without match")
+      return 1
+    }
+    $$ = &WhileStatement{Label: $1, Cond: $3, Statements: $5}
+  }
+| sp_begin_label REPEAT sp_statement_list UNTIL expression END REPEAT ci_identifier_opt
   {
-    $$ = encodeSQLString($1)
+    if endLabelMismatch($1, $8) {
+      yylex.Error("end label without match")
+      return 1
+    }
+    $$ = &RepeatStatement{Label: $1, Statements: $3, Until: $5}
   }
-| ID
+| IF expression THEN sp_statement_list sp_elseif_list_opt sp_else_opt END IF
   {
-    $$ = formatIdentifier($1)
+    $$ = &IfStatement{Cond: $2, Statements: $4, ElseIfs: $5, Else: $6}
   }
-
-address_opt:
+| CASE expression_opt sp_case_when_list sp_else_opt END CASE
   {
-    $$ = ""
+    $$ = &CaseStatement{Expr: $2, Whens: $3, Else: $4}
   }
-| AT_ID
+| LEAVE ci_identifier
   {
-    $$ = formatAddress($1)
+    $$ = &LeaveStatement{Label: $2}
   }
-
-locking_clause:
-FOR UPDATE
+| ITERATE ci_identifier
   {
-    $$ = ForUpdateLock
+    $$ = &IterateStatement{Label: $2}
   }
-| LOCK IN SHARE MODE
+| RETURN expression
   {
-    $$ = ShareModeLock
+    $$ = &ReturnStatement{Expr: $2}
   }
-
-into_clause:
-INTO OUTFILE S3 STRING charset_opt format_opt export_options manifest_opt overwrite_opt
-{
-$$ = &SelectInto{Type:IntoOutfileS3, FileName:encodeSQLString($4), Charset:$5, FormatOption:$6, ExportOption:$7, Manifest:$8, Overwrite:$9}
-}
-| INTO DUMPFILE STRING
-{
-$$ = &SelectInto{Type:IntoDumpfile, FileName:encodeSQLString($3), Charset:ColumnCharset{}, FormatOption:"", ExportOption:"", Manifest:"", Overwrite:""}
-}
-| INTO OUTFILE STRING charset_opt export_options
-{
-$$ = &SelectInto{Type:IntoOutfile, FileName:encodeSQLString($3), Charset:$4, FormatOption:"", ExportOption:$5, Manifest:"", Overwrite:""}
-}
-
-format_opt:
+| OPEN sql_id
   {
-    $$ = ""
+    $$ = &OpenCursor{Name: $2}
   }
-| FORMAT CSV header_opt
+| CLOSE sql_id
   {
-    $$ = " format csv" + $3
+    $$ = &CloseCursor{Name: $2}
   }
-| FORMAT TEXT header_opt
+| FETCH sp_fetch_from_opt sql_id INTO column_list
   {
-    $$ = " format text" + $3
+    $$ = &FetchCursor{Name: $3, Variables: $5}
   }
-
-header_opt:
+| SIGNAL sp_signal_condition sp_signal_item_list_opt
   {
-    $$ = ""
+    $$ = &Signal{Condition: $2, Items: $3}
   }
-| HEADER
+| RESIGNAL sp_signal_condition_opt sp_signal_item_list_opt
   {
-    $$ = " header"
+    $$ = &Signal{Resignal: true, Condition: $2, Items: $3}
   }
 
-manifest_opt:
+// sp_set_statement is a SET statement of a stored program, which can
+// also assign to local variables and to the NEW and OLD rows of a trigger.
+sp_set_statement:
+  SET comment_opt sp_set_list
   {
-    $$ = ""
+    $$ = NewRoutineSetStatement(Comments($2).Parsed(), $3)
   }
-| MANIFEST ON
+| set_account_statement
+| set_transaction_statement
+
+sp_set_list:
+  sp_set_expression
   {
-    $$ = " manifest on"
+    $$ = RoutineSetExprs{$1}
   }
-| MANIFEST OFF
+| sp_set_list ',' sp_set_expression
   {
-    $$ = " manifest off"
+    $$ = append($1, $3)
   }
 
-overwrite_opt:
+sp_set_expression:
+  sp_set_variable '=' ON
   {
-    $$ = ""
+    $$ = &RoutineSetExpr{Var: $1, Expr: NewStrLiteral("on")}
   }
-| OVERWRITE ON
+| sp_set_variable '=' OFF
   {
-    $$ = " overwrite on"
+    $$ = &RoutineSetExpr{Var: $1, Expr: NewStrLiteral("off")}
   }
-| OVERWRITE OFF
+| sp_set_variable '=' expression
   {
-    $$ = " overwrite off"
+    $$ = &RoutineSetExpr{Var: $1, Expr: $3}
   }
-
-export_options:
-  fields_opts lines_opts
+| charset_or_character_set_or_names charset_value collate_opt
   {
-    $$ = $1 + $2
+    $$ = &RoutineSetExpr{Var: NewSetVariable(string($1), SessionScope), Expr: $2}
   }
 
-lines_opts:
+sp_set_variable:
+  ci_identifier
   {
-    $$ = ""
+    $$ = &ColName{Name: $1}
   }
-| LINES lines_opt_list
+| ci_identifier '.' reserved_sql_id
   {
-    $$ = " lines" + $2
+    if !$1.EqualString("new") && !$1.EqualString("old") {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = &ColName{Qualifier: TableName{Name: NewIdentifierCS($1.String())}, Name: $3}
   }
-
-lines_opt_list:
-  lines_opt
+| variable_expr
   {
     $$ = $1
   }
-| lines_opt_list lines_opt
+| set_session_or_global ID
   {
-    $$ = $1 + $2
+    $$ = NewSetVariable(string($2), $1)
   }
 
-lines_opt:
-  STARTING BY STRING
+sp_begin_label:
   {
-    $$ = " starting by " + encodeSQLString($3)
+    $$ = NewIdentifierCI("")
   }
-| TERMINATED BY STRING
+| ci_identifier ':'
   {
-    $$ = " terminated by " + encodeSQLString($3)
+    $$ = $1
   }
 
-fields_opts:
+sp_statement_list:
+  sp_proc_stmt ';'
   {
-    $$ = ""
+    $$ = StatementList{$1}
   }
-| columns_or_fields fields_opt_list
+| sp_statement_list sp_proc_stmt ';'
   {
-    $$ = " " + $1 + $2
+    $$ = append($1, $2)
   }
 
-fields_opt_list:
-  fields_opt
-  {
-    $$ = $1
-  }
-| fields_opt_list fields_opt
+sp_block_statement_list_opt:
   {
-    $$ = $1 + $2
+    $$ = nil
   }
+| sp_block_statement_list
 
-fields_opt:
-  TERMINATED BY STRING
-  {
-    $$ = " terminated by " + encodeSQLString($3)
-  }
-| optionally_opt ENCLOSED BY STRING
+sp_block_statement_list:
+  sp_block_statement ';'
   {
-    $$ = $1 + " enclosed by " + encodeSQLString($4)
+    $$ = StatementList{$1}
   }
-| ESCAPED BY STRING
+| sp_block_statement_list sp_block_statement ';'
   {
-    $$ = " escaped by " + encodeSQLString($3)
+    $$ = append($1, $2)
   }
 
-optionally_opt:
-  {
-    $$ = ""
-  }
-| OPTIONALLY
+sp_block_statement:
+  sp_proc_stmt
+| sp_declaration
+
+sp_declaration:
+  DECLARE column_list column_type sp_default_opt
   {
-    $$ = " optionally"
+    $$ = &DeclareVar{Names: $2, Type: $3, Default: $4}
   }
-
-// insert_data expands all combinations into a single rule.
-// This avoids a shift/reduce conflict while encountering the
-// following two possible constructs:
-// insert into t1(a, b) (select * from t2)
-// insert into t1(select * from t2)
-// Because the rules are together, the parser can keep shifting
-// the tokens until it disambiguates a as sql_id and select as keyword.
-insert_data:
-  VALUES tuple_list
+| DECLARE sql_id CONDITION FOR sp_condition_value
   {
-    $$ = &Insert{Rows: $2}
+    $$ = &DeclareCondition{Name: $2, Value: $5}
   }
-| select_statement
+| DECLARE sql_id CURSOR FOR select_statement
   {
-    $$ = &Insert{Rows: $1}
+    $$ = &DeclareCursor{Name: $2, Select: $5}
   }
-| openb ins_column_list closeb VALUES tuple_list
+| DECLARE sp_handler_action HANDLER FOR sp_handler_condition_list sp_proc_stmt
   {
-    $$ = &Insert{Columns: $2, Rows: $5}
+    $$ = &DeclareHandler{Action: $2, Conditions: $5, Statement: $6}
   }
-| openb closeb VALUES tuple_list
+
+sp_default_opt:
   {
-    $$ = &Insert{Columns: []IdentifierCI{}, Rows: $4}
+    $$ = nil
   }
-| openb ins_column_list closeb select_statement
+| DEFAULT expression
   {
-    $$ = &Insert{Columns: $2, Rows: $4}
+    $$ = $2
   }
 
-ins_column_list:
-  sql_id
+sp_handler_action:
+  CONTINUE
   {
-    $$ = Columns{$1}
+    $$ = ContinueHandler
   }
-| sql_id '.' sql_id
+| EXIT
   {
-    $$ = Columns{$3}
+    $$ = ExitHandler
   }
-| ins_column_list ',' sql_id
+| UNDO
   {
-    $$ = append($$, $3)
+    $$ = UndoHandler
   }
-| ins_column_list ',' sql_id '.' sql_id
+
+sp_condition_value:
+  INTEGRAL
   {
-    $$ = append($$, $5)
+    $$ = &ConditionValue{Type: ErrorCodeCondition, Value: $1}
   }
+| sp_sqlstate
 
-on_dup_opt:
+sp_sqlstate:
+  SQLSTATE STRING
   {
-    $$ = nil
+    $$ = &ConditionValue{Type: SQLStateCondition, Value: $2}
   }
-| ON DUPLICATE KEY UPDATE update_list
+| SQLSTATE sql_id STRING
   {
-    $$ = $5
+    if $2.Lowered() != "value" {
+      yylex.Error("expecting value after sqlstate")
+      return 1
+    }
+    $$ = &ConditionValue{Type: SQLStateCondition, Value: $3}
   }
 
-tuple_list:
-  tuple_or_empty
+sp_handler_condition_list:
+  sp_handler_condition
   {
-    $$ = Values{$1}
+    $$ = []*ConditionValue{$1}
   }
-| tuple_list ',' tuple_or_empty
+| sp_handler_condition_list ',' sp_handler_condition
   {
     $$ = append($1, $3)
   }
 
-tuple_or_empty:
-  row_tuple
+sp_handler_condition:
+  sp_condition_value
+| sql_id
   {
-    $$ = $1
+    $$ = &ConditionValue{Type: NamedCondition, Name: $1}
   }
-| openb closeb
+| SQLWARNING
   {
-    $$ = ValTuple{}
+    $$ = &ConditionValue{Type: SQLWarningCondition}
   }
-
-row_tuple:
-  openb expression_list closeb
+| NOT FOUND
   {
-    $$ = ValTuple($2)
+    $$ = &ConditionValue{Type: NotFoundCondition}
   }
-| ROW openb expression_list closeb
+| SQLEXCEPTION
   {
-    $$ = ValTuple($3)
+    $$ = &ConditionValue{Type: SQLExceptionCondition}
   }
-tuple_expression:
- row_tuple
+
+sp_elseif_list_opt:
   {
-    if len($1) == 1 {
-      $$ = $1[0]
-    } else {
-      $$ = $1
-    }
+    $$ = nil
   }
+| sp_elseif_list
 
-update_list:
-  update_expression
+sp_elseif_list:
+  sp_elseif
   {
-    $$ = UpdateExprs{$1}
+    $$ = []*ElseIfBlock{$1}
   }
-| update_list ',' update_expression
+| sp_elseif_list sp_elseif
   {
-    $$ = append($1, $3)
+    $$ = append($1, $2)
   }
 
-update_expression:
-  column_name '=' expression
+sp_elseif:
+  ELSEIF expression THEN sp_statement_list
   {
-    $$ = &UpdateExpr{Name: $1, Expr: $3}
+    $$ = &ElseIfBlock{Cond: $2, Statements: $4}
   }
 
-charset_or_character_set:
-  CHARSET
-| CHARACTER SET
+sp_else_opt:
   {
-    $$ = "charset"
+    $$ = nil
+  }
+| ELSE sp_statement_list
+  {
+    $$ = $2
   }
 
-charset_or_character_set_or_names:
-  charset_or_character_set
-| NAMES
-
-charset_value:
-  sql_id
+sp_case_when_list:
+  sp_case_when
   {
-    $$ = NewStrLiteral($1.String())
+    $$ = []*CaseStatementWhen{$1}
   }
-| STRING
+| sp_case_when_list sp_case_when
   {
-    $$ = NewStrLiteral($1)
+    $$ = append($1, $2)
   }
-| DEFAULT
+
+sp_case_when:
+  WHEN expression THEN sp_statement_list
   {
-    $$ = &Default{}
+    $$ = &CaseStatementWhen{Cond: $2, Statements: $4}
   }
 
-for_from:
-  FOR
+sp_fetch_from_opt:
+  {}
 | FROM
+  {}
+| NEXT FROM
+  {}
 
-temp_opt:
-  { $$ = false }
-| TEMPORARY
-  { $$ = true }
-
-exists_opt:
-  { $$ = false }
-| IF EXISTS
-  { $$ = true }
+sp_signal_condition:
+  sp_sqlstate
+| sql_id
+  {
+    $$ = &ConditionValue{Type: NamedCondition, Name: $1}
+  }
 
-not_exists_opt:
-  { $$ = false }
-| IF NOT EXISTS
-  { $$ = true }
+sp_signal_condition_opt:
+  {
+    $$ = nil
+  }
+| sp_signal_condition
 
-ignore_opt:
-  { $$ = false }
-| IGNORE
-  { $$ = true }
+sp_signal_item_list_opt:
+  {
+    $$ = nil
+  }
+| SET sp_signal_item_list
+  {
+    $$ = $2
+  }
 
-to_opt:
-  { $$ = struct{}{} }
-| TO
-  { $$ = struct{}{} }
-| AS
-  { $$ = struct{}{} }
+sp_signal_item_list:
+  sp_signal_item
+  {
+    $$ = []*SignalItem{$1}
+  }
+| sp_signal_item_list ',' sp_signal_item
+  {
+    $$ = append($1, $3)
+  }
 
-call_statement:
-  CALL table_name openb expression_list_opt closeb
+sp_signal_item:
+  sql_id '=' expression
   {
-    $$ = &CallProc{Name: $2, Params: $4}
+    if !signalItemNames[$1.Lowered()] {
+      yylex.Error("unknown condition information item")
+      return 1
+    }
+    $$ = &SignalItem{Name: $1.Lowered(), Value: $3}
   }
 
 expression_list_opt:
@@ -7815,7 +9604,7 @@ reserved_keyword:
 | CASE
 | CALL
 | CHANGE
-| CHARACTER
+| CHARACTER %prec PG_CAST_TYPE_NON_KEYWORD
 | CHECK
 | COLLATE
 | COLUMN
@@ -7840,6 +9629,7 @@ reserved_keyword:
 | DISTINCT
 | DISTINCTROW
 | DIV
+| DML_RETURNING
 | DROP
 | ELSE
 | EMPTY
@@ -7861,6 +9651,7 @@ reserved_keyword:
 | HAVING
 | IF
 | IGNORE
+| ILIKE
 | IN
 | INDEX
 | INNER
@@ -7964,6 +9755,7 @@ reserved_keyword:
 */
 non_reserved_keyword:
   AGAINST
+| ACCOUNT
 | ACTION
 | ACTIVE
 | ADDDATE %prec FUNCTION_CALL_NON_KEYWORD
@@ -7973,6 +9765,7 @@ non_reserved_keyword:
 | ALWAYS
 | ARRAY
 | ASCII
+| AT
 | AUTO_INCREMENT
 | AUTOEXTEND_SIZE
 | AVG %prec FUNCTION_CALL_NON_KEYWORD
@@ -7998,6 +9791,7 @@ non_reserved_keyword:
 | CHECKSUM
 | CLEANUP
 | CLONE
+| CLOSE
 | COALESCE
 | CODE
 | COLLATION
@@ -8008,11 +9802,15 @@ non_reserved_keyword:
 | COMMITTED
 | COMPACT
 | COMPLETE
+| COMPLETION
 | COMPONENT
 | COMPRESSED
 | COMPRESSION
+| CONCURRENT
+| CONFLICT
 | CONNECTION
 | CONSISTENT
+| CONTAINS
 | COPY
 | COUNT %prec FUNCTION_CALL_NON_KEYWORD
 | CSV
@@ -8033,7 +9831,7 @@ non_reserved_keyword:
 | DISCARD
 | DISK
 | DO
-| DOUBLE
+| DOUBLE %prec PG_CAST_TYPE_NON_KEYWORD
 | DUMPFILE
 | DUPLICATE
 | DYNAMIC
@@ -8041,6 +9839,7 @@ non_reserved_keyword:
 | ENCLOSED
 | ENCRYPTION
 | END
+| ENDS
 | ENFORCED
 | ENGINE
 | ENGINE_ATTRIBUTE
@@ -8049,6 +9848,7 @@ non_reserved_keyword:
 | ERROR
 | ESCAPED
 | EVENT
+| EVERY
 | EXCHANGE
 | EXCLUDE
 | EXCLUSIVE
@@ -8064,9 +9864,11 @@ non_reserved_keyword:
 | FIXED
 | FLUSH
 | FOLLOWING
+| FOLLOWS
 | FORMAT
 | FORMAT_BYTES %prec FUNCTION_CALL_NON_KEYWORD
 | FORMAT_PICO_TIME %prec FUNCTION_CALL_NON_KEYWORD
+| FOUND
 | FULL
 | FUNCTION
 | GENERAL
@@ -8080,11 +9882,13 @@ non_reserved_keyword:
 | GTID_EXECUTED
 | GTID_SUBSET %prec FUNCTION_CALL_NON_KEYWORD
 | GTID_SUBTRACT %prec FUNCTION_CALL_NON_KEYWORD
+| HANDLER
 | HASH
 | HEADER
 | HISTOGRAM
 | HISTORY
 | HOSTS
+| IDENTIFIED
 | IMPORT
 | INACTIVE
 | INPLACE
@@ -8172,6 +9976,8 @@ non_reserved_keyword:
 | NCHAR
 | NESTED
 | NETWORK_NAMESPACE
+| NEVER
+| NOTHING
 | NOWAIT
 | NO
 | NONE
@@ -8200,8 +10006,11 @@ non_reserved_keyword:
 | PERSIST
 | PERSIST_ONLY
 | PLAN
+| PRECEDES
 | PRECEDING
+| PRECISION
 | PREPARE
+| PRESERVE
 | PRIVILEGE_CHECKS_USER
 | PRIVILEGES
 | PROCESS
@@ -8213,6 +10022,7 @@ non_reserved_keyword:
 | POSITION %prec FUNCTION_CALL_NON_KEYWORD
 | PROCEDURE
 | PROCESSLIST
+| PROXY %prec GRANT_PROXY_NON_KEYWORD
 | PURGE
 | QUERIES
 | QUERY
@@ -8242,12 +10052,14 @@ non_reserved_keyword:
 | RETAIN
 | RETRY
 | RETURNING
+| RETURNS
 | REUSE
 | ROLE
 | ROLLBACK
 | ROW_FORMAT
 | RTRIM %prec FUNCTION_CALL_NON_KEYWORD
 | S3
+| SCHEDULE
 | SECONDARY
 | SECONDARY_ENGINE
 | SECONDARY_ENGINE_ATTRIBUTE
@@ -8269,6 +10081,7 @@ non_reserved_keyword:
 | SRID
 | START
 | STARTING
+| STARTS
 | STATS_AUTO_RECALC
 | STATS_PERSISTENT
 | STATS_SAMPLE_PAGES
@@ -8357,6 +10170,7 @@ non_reserved_keyword:
 | UNICODE
 | UNSIGNED
 | UNTHROTTLE
+| UNTIL
 | UNUSED
 | UpdateXML %prec FUNCTION_CALL_NON_KEYWORD
 | UPGRADE
@@ -8368,6 +10182,7 @@ non_reserved_keyword:
 | VARBINARY
 | VARCHAR
 | VARIABLES
+| VARYING
 | VARIANCE %prec FUNCTION_CALL_NON_KEYWORD
 | VCPU
 | VEXPLAIN
@@ -8397,6 +10212,7 @@ non_reserved_keyword:
 | WORK
 | YEAR
 | ZEROFILL
+| ZONE
 | DAY
 | DAY_HOUR
 | DAY_MICROSECOND
@@ -26,11 +26,20 @@ func (tkn *Tokenizer) readBuffer() string {
 }
 
 func (tkn *Tokenizer) readCache() string {
-	return tkn.buf.ReadCache()
+	cache, err := tkn.buf.ReadCache()
+	tkn.setError(err)
+	return cache
 }
 
 func (tkn *Tokenizer) resetCache() {
-	tkn.buf.ResetCache()
+	tkn.setError(tkn.buf.ResetCache())
+}
+
+// setError records err as LastError, unless an error was already recorded.
+func (tkn *Tokenizer) setError(err error) {
+	if err != nil && tkn.LastError == nil {
+		tkn.LastError = err
+	}
 }
 
 func (tkn *Tokenizer) absolutePos() int {
@@ -48,7 +57,7 @@ func scanProcedureBody(tokenizer *Tokenizer, tagName string) (string, error) {
 		tokenizer.buf.CacheBlanks = false
 	}()
 loop:
-	for {
+	for tokenizer.LastError == nil {
 		tkn, val := tokenizer.Scan()
 		switch tkn {
 		case 0, eofChar:
@@ -76,12 +85,24 @@ loop:
 func SplitNext(tokenizer *Tokenizer) (string, error) {
 	var sb strings.Builder
 loop:
-	for {
+	for tokenizer.LastError == nil {
 		tkn, val := tokenizer.Scan()
 		switch tkn {
 		case COMMENT:
 			tokenizer.resetCache()
+		case DELIMITER_COMMAND:
+			tokenizer.resetCache()
+			if sb.Len() > 0 {
+				break loop
+			}
 		case ';':
+			if tokenizer.delimiter != "" {
+				// a semicolon within a statement ended by a custom delimiter
+				sb.WriteString(tokenizer.readCache())
+				break
+			}
+			fallthrough
+		case DELIMITER_END:
 			tokenizer.resetCache()
 			if sb.Len() > 0 {
 				break loop
@@ -114,6 +135,8 @@ loop:
 type Dialect interface {
 	iDialect()
 	EscapingBackslash() bool
+	// IdentifierQuote returns the character used to quote identifiers.
+	IdentifierQuote() byte
 }
 
 var _ Dialect = MysqlDialect{}
@@ -125,10 +148,17 @@ func (m MysqlDialect) EscapingBackslash() bool {
 	return true
 }
 
+func (m MysqlDialect) IdentifierQuote() byte {
+	return '`'
+}
+
 func (m MysqlDialect) iDialect() {}
 
 var _ Dialect = PostgresDialect{}
 
+// PostgresDialect lexes and parses PostgreSQL syntax: double-quoted
+// identifiers, `::` casts, `$1` positional parameters, E'...' strings,
+// ILIKE, `||` concatenation, RETURNING and ON CONFLICT.
 type PostgresDialect struct {
 }
 
@@ -136,4 +166,13 @@ func (p PostgresDialect) EscapingBackslash() bool {
 	return false
 }
 
+func (p PostgresDialect) IdentifierQuote() byte {
+	return '"'
+}
+
 func (p PostgresDialect) iDialect() {}
+
+func isPostgres(dialect Dialect) bool {
+	_, ok := dialect.(PostgresDialect)
+	return ok
+}
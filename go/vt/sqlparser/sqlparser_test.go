@@ -34,6 +34,74 @@ func Test_ParseNext(t *testing.T) {
 			name:  "select concat()",
 			input: "select concat(c1, '�'), concat('�', c1) from t1",
 		},
+		{
+			name:    "postgres quoted identifiers",
+			input:   `select "User"."Id", "a""b" from "User"`,
+			want:    "select `User`.Id, `a\"b` from `User`",
+			dialect: PostgresDialect{},
+		},
+		{
+			name:    "postgres casts",
+			input:   "select a::int, b::VARCHAR(10), c::numeric(10,2), d::text[], -e::int, (a + b)::bigint from t",
+			want:    "select a::int, b::varchar(10), c::numeric(10, 2), d::text[], -e::int, (a + b)::bigint from t",
+			dialect: PostgresDialect{},
+		},
+		{
+			name:    "postgres positional parameters",
+			input:   "select * from t where id = $1 and a ilike $2 and b not ilike 'x!%' escape '!'",
+			dialect: PostgresDialect{},
+		},
+		{
+			name:    "postgres concat",
+			input:   "select a || b || 'c' from t",
+			dialect: PostgresDialect{},
+		},
+		{
+			name:    "postgres insert returning",
+			input:   "insert into t(a, b) values ($1, $2) returning id, a as x",
+			dialect: PostgresDialect{},
+		},
+		{
+			name:    "postgres on conflict do update",
+			input:   "insert into t(a) values (1) on conflict (a) do update set b = excluded.b where t.c > 1 returning *",
+			dialect: PostgresDialect{},
+		},
+		{
+			name:    "postgres on conflict on constraint",
+			input:   "insert into t(a) values (1) on conflict on constraint t_pkey do nothing",
+			dialect: PostgresDialect{},
+		},
+		{
+			name:    "postgres update returning",
+			input:   "update t set a = 1 where b = 2 returning a, b",
+			dialect: PostgresDialect{},
+		},
+		{
+			name:    "postgres delete returning",
+			input:   "delete from t returning *",
+			dialect: PostgresDialect{},
+		},
+		{
+			name:    "postgres returning in an expression",
+			input:   "update t set a = json_value(doc, '$.a' returning char) returning a",
+			dialect: PostgresDialect{},
+		},
+		{
+			name:    "postgres returning in a subquery",
+			input:   "insert into t(a) select json_value(doc, '$.a' returning char) from s returning a",
+			dialect: PostgresDialect{},
+		},
+		{
+			name:    "postgres identifiers are folded to lower case",
+			input:   `select Col, "Col" from T`,
+			want:    "select col, Col from t",
+			dialect: PostgresDialect{},
+		},
+		{
+			name:    "mysql returning is not reserved",
+			input:   "select `returning` from t",
+			dialect: MysqlDialect{},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -56,6 +124,60 @@ func Test_ParseNext(t *testing.T) {
 	}
 }
 
+func Test_ParseNext_Postgres(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{
+			input: `select "User"."Id", "a""b", "name" from "User"`,
+			want:  `select "User"."Id", "a""b", "name" from "User"`,
+		},
+		{
+			input: `select Col, "Col", "col" from T`,
+			want:  `select col, "Col", col from t`,
+		},
+		{
+			input: `select 'x'`,
+		},
+		{
+			input: `select json_value(doc, '$.a' returning char) from t`,
+		},
+		{
+			input: `select 'it''s', 'a\b', E'a\nb' from t`,
+			want:  "select 'it''s', 'a\\b', 'a\nb' from t",
+		},
+		{
+			input: `select x::int from t where a ilike $1`,
+		},
+		{
+			input: `select a::double precision, b::character varying, c::character varying(10) from t`,
+		},
+		{
+			input: `select a::timestamp with time zone, b::timestamp without time zone, c::time with time zone, d::time without time zone from t`,
+		},
+		{
+			input: `select a::double as "precision", zone, varying from t group by a::time`,
+			want:  `select a::double as "precision", zone, "varying" from t group by a::time`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			tree, err := ParseNext(NewStringTokenizer(test.input, WithDialect(PostgresDialect{})))
+			require.NoError(t, err)
+			if len(test.want) == 0 {
+				test.want = test.input
+			}
+			got := StringWithDialect(tree, PostgresDialect{})
+			require.Equal(t, test.want, got)
+
+			again, err := ParseNext(NewStringTokenizer(got, WithDialect(PostgresDialect{})))
+			require.NoError(t, err)
+			require.True(t, Equals.SQLNode(tree, again), got)
+		})
+	}
+}
+
 func Test_ParseNext_WithCurrentTime(t *testing.T) {
 	tests := []struct {
 		name  string
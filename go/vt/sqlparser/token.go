@@ -45,8 +45,66 @@ type Tokenizer struct {
 	multi          bool
 	specialComment *Tokenizer
 
+	// inRoutine is set once the parser enters the body of a stored
+	// program: a DDL statement of the body is never returned as the
+	// partially parsed statement.
+	inRoutine bool
+
+	// delimiter is the statement delimiter set by the last DELIMITER
+	// command, or empty for the default `;`.
+	delimiter   string
+	inStatement bool
+	// lostStatement is set when the reader failed in the middle of a
+	// statement, whose rest is skipped if the reader is retried.
+	lostStatement bool
+	// parenDepth is the nesting of parentheses of a PostgreSQL statement,
+	// and dmlDepth is one more than the nesting of its last INSERT, UPDATE
+	// or DELETE keyword, or 0.
+	parenDepth int
+	dmlDepth   int
+
 	buf     *buffer.Buffer
 	dialect Dialect
+	// version is the version of MySQL the tokenizer emulates, in the
+	// comment version format.
+	version string
+
+	positions   *Positions
+	specialSpan Span
+	widen       SQLNode
+	// spans are the spans of the symbols in the stack of the parser,
+	// indexed by depth, and tokenSpan is the span of the last token lexed.
+	spans     []Span
+	tokenSpan Span
+	// errorPosition is the start of the token of the last syntax error,
+	// when positions are tracked.
+	errorPosition Position
+
+	// keepComments is set by WithComments. comments are the comments
+	// skipped while parsing the current statement, the ones from
+	// nextComment on not followed by a token yet, and lastEnd is the end
+	// position of the last token, lastComma whether it is a comma.
+	// attached are the comments attached to the nodes of the last parsed
+	// statement.
+	keepComments bool
+	comments     []sourceComment
+	nextComment  int
+	lastEnd      Position
+	lastComma    bool
+	attached     *NodeComments
+
+	// tokenStart is the offset of the last token scanned. source is set
+	// by ParseNextWithSource, and leadingComments by WithLeadingComments.
+	tokenStart      int
+	source          *sourceTracker
+	leadingComments bool
+
+	// diagnose is set by ParseScript, to keep the tokens expected by the
+	// parser at a syntax error in expected. errorStart is the offset of
+	// the token of the last syntax error.
+	diagnose   bool
+	expected   []string
+	errorStart int
 }
 
 type TokenizerOpt func(*Tokenizer)
@@ -63,52 +121,50 @@ func WithDialect(dialect Dialect) TokenizerOpt {
 	}
 }
 
+// WithLeadingComments makes ParseNextWithSource include the comments
+// before a statement in its source.
+func WithLeadingComments() TokenizerOpt {
+	return func(tokenizer *Tokenizer) {
+		tokenizer.leadingComments = true
+	}
+}
+
+// postgres reports whether the tokenizer lexes PostgreSQL syntax.
+func (tkn *Tokenizer) postgres() bool {
+	return isPostgres(tkn.dialect)
+}
+
 // NewStringTokenizer creates a new Tokenizer for the
 // sql string.
 func NewStringTokenizer(sql string, opts ...TokenizerOpt) *Tokenizer {
-	checkParserVersionFlag()
-
-	tokenizer := &Tokenizer{
-		buf:      buffer.NewStringBuffer(sql),
-		BindVars: make(map[string]struct{}),
-		dialect:  MysqlDialect{},
-	}
-	for _, opt := range opts {
-		opt(tokenizer)
-	}
-	return tokenizer
+	return defaultParser.Load().NewStringTokenizer(sql, opts...)
 }
 
 // NewReaderTokenizer creates a new Tokenizer for the
 // sql reader.
 func NewReaderTokenizer(reader io.Reader, opts ...TokenizerOpt) *Tokenizer {
-	checkParserVersionFlag()
-
-	tokenizer := &Tokenizer{
-		buf:      buffer.NewReaderBuffer(reader),
-		BindVars: make(map[string]struct{}),
-		dialect:  MysqlDialect{},
-	}
-	for _, opt := range opts {
-		opt(tokenizer)
-	}
-	return tokenizer
+	return defaultParser.Load().NewReaderTokenizer(reader, opts...)
 }
 
 // Lex returns the next token form the Tokenizer.
 // This function is used by go yacc.
 func (tkn *Tokenizer) Lex(lval *yySymType) int {
 	if tkn.SkipToEnd {
-		return tkn.skipStatement()
+		return lexDelimiter(tkn.skipStatement())
+	}
+
+	if tkn.positions != nil {
+		return tkn.lexSpan(lval)
 	}
 
 	typ, val := tkn.Scan()
-	for typ == COMMENT {
-		if tkn.AllowComments {
+	for typ == COMMENT || typ == DELIMITER_COMMAND {
+		if typ == COMMENT && tkn.AllowComments {
 			break
 		}
 		typ, val = tkn.Scan()
 	}
+	typ = lexDelimiter(typ)
 	if typ == 0 || typ == ';' || typ == LEX_ERROR {
 		// If encounter end of statement or invalid token,
 		// we should not accept partially parsed DDLs. They
@@ -121,6 +177,36 @@ func (tkn *Tokenizer) Lex(lval *yySymType) int {
 	return typ
 }
 
+// lexSpan is the variant of Lex used when source positions are tracked.
+func (tkn *Tokenizer) lexSpan(lval *yySymType) int {
+	typ, val, span := tkn.scanSpan()
+	for typ == COMMENT || typ == DELIMITER_COMMAND {
+		if typ == COMMENT && tkn.keepComments && (!tkn.AllowComments || !tkn.inStatement) {
+			// the comments before the statement are dropped by the grammar
+			tkn.comments = append(tkn.comments, sourceComment{text: val, span: span, prevEnd: tkn.lastEnd, prevComma: tkn.lastComma})
+		}
+		if typ == COMMENT && tkn.AllowComments {
+			break
+		}
+		typ, val, span = tkn.scanSpan()
+	}
+	if typ != COMMENT {
+		for i := tkn.nextComment; i < len(tkn.comments); i++ {
+			tkn.comments[i].nextStart = span.Start
+		}
+		tkn.nextComment = len(tkn.comments)
+		tkn.lastEnd, tkn.lastComma = span.End, typ == ','
+	}
+	typ = lexDelimiter(typ)
+	if typ == 0 || typ == ';' || typ == LEX_ERROR {
+		tkn.partialDDL = nil
+	}
+	lval.str = val
+	tkn.tokenSpan = span
+	tkn.lastToken = val
+	return typ
+}
+
 // PositionedErr holds context related to parser errors
 type PositionedErr struct {
 	Err  string
@@ -135,17 +221,94 @@ func (p PositionedErr) Error() string {
 	return fmt.Sprintf("%s at position %v", p.Err, p.Pos)
 }
 
+// ReadError is the error returned when the reader of a Tokenizer fails.
+// Offset is the absolute byte offset at which reading failed. The error ends
+// the input, unless the reader failed with a temporary error, such as a
+// timeout: the next ParseNext then retries the reader, and skips the rest
+// of the statement it failed in.
+type ReadError = buffer.ReadError
+
+// CacheError is the error returned when a statement is split from a
+// Tokenizer whose buffer has no cache, see WithCacheInBuffer.
+type CacheError = buffer.CacheError
+
 // Error is called by go yacc if there's a parsing error.
+// A failure of the reader takes precedence over the syntax error it caused.
 func (tkn *Tokenizer) Error(err string) {
-	tkn.LastError = PositionedErr{Err: err, Pos: tkn.absolutePos() + 1, Near: tkn.lastToken}
+	if readErr := tkn.buf.Err(); readErr != nil {
+		tkn.LastError = readErr
+	} else {
+		tkn.LastError = PositionedErr{Err: err, Pos: tkn.absolutePos() + 1, Near: tkn.lastToken}
+		tkn.errorStart = tkn.tokenStart
+		if tkn.positions != nil {
+			tkn.errorPosition = tkn.position(tkn.tokenStart)
+		}
+	}
 
 	// Try and re-sync to the next statement
 	tkn.skipStatement()
 }
 
+// lexDelimiter returns the token the parser sees for the scanned token:
+// a custom delimiter ends the statement like a semicolon.
+func lexDelimiter(typ int) int {
+	if typ == DELIMITER_END {
+		return ';'
+	}
+	return typ
+}
+
 // Scan scans the tokenizer for the next token and returns
 // the token type and an optional value.
+//
+// The DELIMITER client command is returned as a DELIMITER_COMMAND token with the
+// new delimiter as value, and changes the delimiter for the following tokens.
+// Until the delimiter is restored with `DELIMITER ;`, the delimiter is returned
+// as a DELIMITER_END token, while semicolons are returned as plain ';' tokens
+// that don't end the statement.
 func (tkn *Tokenizer) Scan() (int, string) {
+	typ, val := tkn.scan()
+	if tkn.source != nil {
+		tkn.source.scanned(typ, tkn.tokenStart)
+	}
+	switch typ {
+	case COMMENT:
+	case 0, DELIMITER_END, DELIMITER_COMMAND:
+		tkn.inStatement = false
+	case ';':
+		tkn.inStatement = tkn.delimiter != ""
+	default:
+		tkn.inStatement = true
+	}
+	if tkn.postgres() {
+		tkn.scannedPostgres(typ)
+	}
+	return typ, val
+}
+
+// scannedPostgres keeps track of the parentheses and the INSERT, UPDATE
+// and DELETE keywords of a PostgreSQL statement, for returningClause.
+func (tkn *Tokenizer) scannedPostgres(typ int) {
+	switch {
+	case !tkn.inStatement:
+		tkn.parenDepth, tkn.dmlDepth = 0, 0
+	case typ == '(':
+		tkn.parenDepth++
+	case typ == ')':
+		tkn.parenDepth--
+	case typ == INSERT || typ == UPDATE || typ == DELETE:
+		tkn.dmlDepth = tkn.parenDepth + 1
+	}
+}
+
+// returningClause reports whether a RETURNING keyword starts the RETURNING
+// clause of an INSERT, UPDATE or DELETE statement, instead of being part of
+// an expression like JSON_VALUE(doc, '$.a' RETURNING char).
+func (tkn *Tokenizer) returningClause() bool {
+	return tkn.dmlDepth == tkn.parenDepth+1
+}
+
+func (tkn *Tokenizer) scan() (int, string) {
 	if tkn.specialComment != nil {
 		// Enter specialComment scan mode.
 		// for scanning such kind of comment: /*! MySQL-specific code */
@@ -160,6 +323,18 @@ func (tkn *Tokenizer) Scan() (int, string) {
 	}
 
 	tkn.skipBlank()
+	tkn.tokenStart = tkn.absolutePos()
+	if ch := tkn.cur(); !tkn.inStatement && (ch == 'D' || ch == 'd') && tkn.atDelimiterCommand() {
+		return tkn.scanDelimiterCommand()
+	}
+	if tkn.atCustomDelimiter() {
+		if tkn.multi {
+			// In multi mode, the delimiter is treated as EOF like ';'.
+			return 0, ""
+		}
+		tkn.skip(len(tkn.delimiter))
+		return DELIMITER_END, ""
+	}
 	switch ch := tkn.cur(); {
 	case ch == '@':
 		tokenID := AT_ID
@@ -172,7 +347,12 @@ func (tkn *Tokenizer) Scan() (int, string) {
 		var tBytes string
 		if tkn.cur() == '`' {
 			tkn.skip(1)
-			tID, tBytes = tkn.scanLiteralIdentifier()
+			tID, tBytes = tkn.scanLiteralIdentifier('`')
+		} else if delim := tkn.cur(); tokenID == AT_ID && (delim == '\'' || delim == '"') {
+			// a quoted user variable like @'a b', or a quoted host like
+			// 'u'@'%', can hold any character
+			tkn.skip(1)
+			return tkn.scanString(delim, AT_STRING)
 		} else if tkn.cur() == eofChar {
 			return LEX_ERROR, ""
 		} else {
@@ -183,6 +363,19 @@ func (tkn *Tokenizer) Scan() (int, string) {
 		}
 		return tokenID, tBytes
 	case isLetter(ch):
+		if tkn.postgres() {
+			// $1 is a positional parameter
+			if ch == '$' && isDigit(tkn.peek(1)) {
+				tkn.skip(1)
+				tkn.scanMantissa(10)
+				return POSITIONAL_ARG, tkn.readBuffer()
+			}
+			// E'literal' is a string with C-style escapes
+			if (ch == 'E' || ch == 'e') && tkn.peek(1) == '\'' {
+				tkn.skip(2)
+				return tkn.scanEscapedString('\'', STRING, true)
+			}
+		}
 		if ch == 'X' || ch == 'x' {
 			if tkn.peek(1) == '\'' {
 				tkn.skip(2)
@@ -209,7 +402,7 @@ func (tkn *Tokenizer) Scan() (int, string) {
 	case ch == ':':
 		return tkn.scanBindVarOrAssignmentExpression()
 	case ch == ';':
-		if tkn.multi {
+		if tkn.multi && tkn.delimiter == "" {
 			// In multi mode, ';' is treated as EOF. So, we don't advance.
 			// Repeated calls to Scan will keep returning 0 until ParseNext
 			// forces the advance.
@@ -218,6 +411,11 @@ func (tkn *Tokenizer) Scan() (int, string) {
 		tkn.skip(1)
 		return ';', ""
 	case ch == eofChar:
+		// the input ended because the reader failed
+		if err := tkn.buf.Err(); err != nil && tkn.LastError != err {
+			tkn.LastError = err
+			return LEX_ERROR, ""
+		}
 		return 0, ""
 	default:
 		if ch == '.' && isDigit(tkn.peek(1)) {
@@ -239,6 +437,9 @@ func (tkn *Tokenizer) Scan() (int, string) {
 			tkn.skip(1)
 			if tkn.cur() == '|' {
 				tkn.skip(1)
+				if tkn.postgres() {
+					return CONCAT_OP, ""
+				}
 				return OR, ""
 			}
 			return int(ch), ""
@@ -334,10 +535,19 @@ func (tkn *Tokenizer) Scan() (int, string) {
 			return int(ch), ""
 		case '\'', '"':
 			tkn.skip(1)
+			if ch == '"' && tkn.postgres() {
+				return tkn.scanLiteralIdentifier(ch)
+			}
 			return tkn.scanString(ch, STRING)
 		case '`':
 			tkn.skip(1)
-			return tkn.scanLiteralIdentifier()
+			return tkn.scanLiteralIdentifier(ch)
+		case '[', ']':
+			tkn.skip(1)
+			if tkn.postgres() {
+				return int(ch), ""
+			}
+			return LEX_ERROR, string(byte(ch))
 		default:
 			tkn.skip(1)
 			return LEX_ERROR, string(byte(ch))
@@ -345,17 +555,112 @@ func (tkn *Tokenizer) Scan() (int, string) {
 	}
 }
 
+// retryRead retries the reader after a temporary failure, and skips the
+// rest of the statement it failed in.
+func (tkn *Tokenizer) retryRead() {
+	if _, ok := tkn.LastError.(*ReadError); !ok || !tkn.buf.Retry() {
+		return
+	}
+	tkn.LastError = nil
+	if tkn.lostStatement {
+		tkn.lostStatement = false
+		tkn.skipStatement()
+	}
+}
+
 // skipStatement scans until end of statement.
 func (tkn *Tokenizer) skipStatement() int {
 	tkn.SkipToEnd = false
 	for {
 		typ, _ := tkn.Scan()
-		if typ == 0 || typ == ';' || typ == LEX_ERROR {
+		if typ == 0 || typ == LEX_ERROR || tkn.endsStatement(typ) {
 			return typ
 		}
 	}
 }
 
+// endsStatement reports whether the token ends a statement. With a custom
+// delimiter, a semicolon is part of the statement, e.g. of the body of a
+// stored procedure, and only the delimiter ends it.
+func (tkn *Tokenizer) endsStatement(typ int) bool {
+	if tkn.delimiter != "" {
+		return typ == DELIMITER_END
+	}
+	return typ == ';'
+}
+
+const delimiterCommand = "delimiter"
+
+// atDelimiterCommand reports whether the DELIMITER client command starts at
+// the current position.
+func (tkn *Tokenizer) atDelimiterCommand() bool {
+	for i := 0; i < len(delimiterCommand); i++ {
+		ch := tkn.peek(i)
+		if 'A' <= ch && ch <= 'Z' {
+			ch += 'a' - 'A'
+		}
+		if ch != uint16(delimiterCommand[i]) {
+			return false
+		}
+	}
+	ch := tkn.peek(len(delimiterCommand))
+	return ch == ' ' || ch == '\t'
+}
+
+// scanDelimiterCommand scans the DELIMITER client command and changes the
+// delimiter. The rest of the line after the new delimiter is ignored.
+func (tkn *Tokenizer) scanDelimiterCommand() (int, string) {
+	tkn.skip(len(delimiterCommand))
+	for ch := tkn.cur(); ch == ' ' || ch == '\t'; ch = tkn.cur() {
+		tkn.skip(1)
+	}
+	for ch := tkn.cur(); ch != ' ' && ch != '\t' && ch != '\r' && ch != '\n' && ch != eofChar; ch = tkn.cur() {
+		tkn.next()
+	}
+	delimiter := tkn.readBuffer()
+	for ch := tkn.cur(); ch != '\n' && ch != eofChar; ch = tkn.cur() {
+		tkn.skip(1)
+	}
+	if delimiter == "" {
+		return LEX_ERROR, ""
+	}
+	if delimiter == ";" {
+		tkn.delimiter = ""
+	} else {
+		tkn.delimiter = delimiter
+	}
+	return DELIMITER_COMMAND, delimiter
+}
+
+// atCustomDelimiter reports whether a custom delimiter starts at the current
+// position. It ends any token, even without blanks before it, e.g. `end$$`.
+func (tkn *Tokenizer) atCustomDelimiter() bool {
+	return tkn.delimiter != "" && tkn.atDelimiter()
+}
+
+// atDelimiter reports whether the custom delimiter starts at the current position.
+func (tkn *Tokenizer) atDelimiter() bool {
+	for i := 0; i < len(tkn.delimiter); i++ {
+		if tkn.peek(i) != uint16(tkn.delimiter[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// skipDelimiter skips the delimiter that ended the previous statement in multi mode.
+func (tkn *Tokenizer) skipDelimiter() {
+	switch {
+	case tkn.delimiter == "" && tkn.cur() == ';':
+		tkn.skip(1)
+	case tkn.atCustomDelimiter():
+		tkn.skip(len(tkn.delimiter))
+	default:
+		return
+	}
+	tkn.skipBlank()
+}
+
 // skipBlank skips the cursor while it finds whitespace
 func (tkn *Tokenizer) skipBlank() {
 	tkn.buf.SkipBlank()
@@ -366,11 +671,20 @@ func (tkn *Tokenizer) scanIdentifier(isVariable bool) (int, string) {
 	for {
 		tkn.next()
 		ch := tkn.cur()
-		if !isLetter(ch) && !isDigit(ch) && !(isVariable && isCarat(ch)) {
+		if !isLetter(ch) && !isDigit(ch) && !(isVariable && isCarat(ch)) || tkn.atCustomDelimiter() {
 			break
 		}
 	}
 	keywordName := tkn.readBuffer()
+	if tkn.postgres() {
+		if keywordID, found := postgresKeywordLookupTable.LookupString(keywordName); found && (keywordID != DML_RETURNING || tkn.returningClause()) {
+			return keywordID, keywordName
+		}
+		if !isVariable {
+			// PostgreSQL folds the identifiers that are not quoted to lower case
+			keywordName = strings.ToLower(keywordName)
+		}
+	}
 	if keywordID, found := keywordLookupTable.LookupString(keywordName); found {
 		return keywordID, keywordName
 	}
@@ -406,26 +720,26 @@ func (tkn *Tokenizer) scanBitLiteral() (int, string) {
 	return BIT_LITERAL, bit
 }
 
-// scanLiteralIdentifierSlow scans an identifier surrounded by backticks which may
-// contain escape sequences instead of it. This method is only called from
+// scanLiteralIdentifierSlow scans an identifier surrounded by the given `delim`
+// which may contain escape sequences instead of it. This method is only called from
 // scanLiteralIdentifier once the first escape sequence is found in the identifier.
 // The provided `buf` contains the contents of the identifier that have been scanned
 // so far.
-func (tkn *Tokenizer) scanLiteralIdentifierSlow(buf *strings.Builder) (int, string) {
+func (tkn *Tokenizer) scanLiteralIdentifierSlow(buf *strings.Builder, delim uint16) (int, string) {
 	backTickSeen := true
 	for {
 		if backTickSeen {
-			if tkn.cur() != '`' {
+			if tkn.cur() != delim {
 				break
 			}
 			backTickSeen = false
-			buf.WriteByte('`')
+			buf.WriteByte(byte(delim))
 			tkn.skip(1)
 			continue
 		}
-		// The previous char was not a backtick.
+		// The previous char was not a delimiter.
 		switch tkn.cur() {
-		case '`':
+		case delim:
 			backTickSeen = true
 		case eofChar:
 			// Premature EOF.
@@ -439,15 +753,16 @@ func (tkn *Tokenizer) scanLiteralIdentifierSlow(buf *strings.Builder) (int, stri
 	return ID, buf.String()
 }
 
-// scanLiteralIdentifier scans an identifier enclosed by backticks. If the identifier
-// is a simple literal, it'll be returned as a slice of the input buffer. If the identifier
-// contains escape sequences, this function will fall back to scanLiteralIdentifierSlow
-func (tkn *Tokenizer) scanLiteralIdentifier() (int, string) {
+// scanLiteralIdentifier scans an identifier enclosed by the given `delim`, which is
+// a backtick, or a double quote in PostgreSQL. If the identifier is a simple literal,
+// it'll be returned as a slice of the input buffer. If the identifier contains escape
+// sequences, this function will fall back to scanLiteralIdentifierSlow
+func (tkn *Tokenizer) scanLiteralIdentifier(delim uint16) (int, string) {
 	//start := tkn.Pos
 	for {
 		switch tkn.cur() {
-		case '`':
-			if tkn.peek(1) != '`' {
+		case delim:
+			if tkn.peek(1) != delim {
 				id := tkn.readBuffer()
 				if len(id) == 0 {
 					return LEX_ERROR, ""
@@ -459,7 +774,7 @@ func (tkn *Tokenizer) scanLiteralIdentifier() (int, string) {
 			var buf strings.Builder
 			buf.WriteString(tkn.readBuffer())
 			tkn.skip(1)
-			return tkn.scanLiteralIdentifierSlow(&buf)
+			return tkn.scanLiteralIdentifierSlow(&buf, delim)
 		case eofChar:
 			// Premature EOF.
 			return LEX_ERROR, tkn.readBuffer()
@@ -474,6 +789,12 @@ func (tkn *Tokenizer) scanBindVarOrAssignmentExpression() (int, string) {
 	token := VALUE_ARG
 
 	tkn.next()
+	// In PostgreSQL, :: is the type cast operator. Example - a::int
+	if tkn.cur() == ':' && tkn.postgres() {
+		tkn.skip(1)
+		return TYPECAST, ""
+	}
+
 	// If : is followed by a digit, then it is an offset value arg. Example - :1, :10
 	if isDigit(tkn.cur()) {
 		tkn.scanMantissa(10)
@@ -491,13 +812,18 @@ func (tkn *Tokenizer) scanBindVarOrAssignmentExpression() (int, string) {
 		token = LIST_ARG
 		tkn.next()
 	}
-	if !isLetter(tkn.cur()) {
+	// A single : followed by a blank ends a label of a compound statement.
+	// Example - l1: begin ... end l1
+	if token == VALUE_ARG && isBlank(tkn.cur()) {
+		return ':', tkn.readBuffer()
+	}
+	if !isLetter(tkn.cur()) || tkn.atCustomDelimiter() {
 		return LEX_ERROR, tkn.readBuffer()
 	}
 	// If : is followed by a letter, it is a bindvariable. Example :v1, :v2
 	for {
 		ch := tkn.cur()
-		if !isLetter(ch) && !isDigit(ch) && ch != '.' {
+		if !isLetter(ch) && !isDigit(ch) && ch != '.' || tkn.atCustomDelimiter() {
 			break
 		}
 		tkn.next()
@@ -508,7 +834,7 @@ func (tkn *Tokenizer) scanBindVarOrAssignmentExpression() (int, string) {
 // scanMantissa scans a sequence of numeric characters with the same base.
 // This is a helper function only called from the numeric scanners
 func (tkn *Tokenizer) scanMantissa(base int) {
-	for digitVal(tkn.cur()) < base {
+	for digitVal(tkn.cur()) < base && !tkn.atCustomDelimiter() {
 		tkn.next()
 	}
 }
@@ -543,14 +869,14 @@ func (tkn *Tokenizer) scanNumber() (int, string) {
 
 	tkn.scanMantissa(10)
 
-	if tkn.cur() == '.' {
+	if tkn.cur() == '.' && !tkn.atCustomDelimiter() {
 		token = DECIMAL
 		tkn.next()
 		tkn.scanMantissa(10)
 	}
 
 exponent:
-	if tkn.cur() == 'e' || tkn.cur() == 'E' {
+	if (tkn.cur() == 'e' || tkn.cur() == 'E') && !tkn.atCustomDelimiter() {
 		token = FLOAT
 		tkn.next()
 		if tkn.cur() == '+' || tkn.cur() == '-' {
@@ -560,7 +886,8 @@ exponent:
 	}
 
 exit:
-	if isLetter(tkn.cur()) {
+	// a custom delimiter like $$ ends the number
+	if isLetter(tkn.cur()) && !tkn.atCustomDelimiter() {
 		// A letter cannot immediately follow a float number.
 		if token == FLOAT || token == DECIMAL {
 			return LEX_ERROR, tkn.readBuffer()
@@ -569,7 +896,7 @@ exit:
 		// as an identifier and not a number.
 		for {
 			ch := tkn.cur()
-			if !isLetter(ch) && !isDigit(ch) {
+			if !isLetter(ch) && !isDigit(ch) || tkn.atCustomDelimiter() {
 				break
 			}
 			tkn.next()
@@ -585,6 +912,12 @@ exit:
 // been scanned. If the skin contains any escape sequences, this function
 // will fall back to scanStringSlow
 func (tkn *Tokenizer) scanString(delim uint16, typ int) (int, string) {
+	return tkn.scanEscapedString(delim, typ, tkn.dialect.EscapingBackslash())
+}
+
+// scanEscapedString is scanString with the handling of backslash escapes
+// given explicitly, as they are always enabled for PostgreSQL E'...' strings.
+func (tkn *Tokenizer) scanEscapedString(delim uint16, typ int, escapingBackslash bool) (int, string) {
 	var sb strings.Builder
 	for {
 		switch char := tkn.cur(); char {
@@ -599,7 +932,7 @@ func (tkn *Tokenizer) scanString(delim uint16, typ int) (int, string) {
 			tkn.skip(1)
 
 		case '\\':
-			if tkn.dialect.EscapingBackslash() {
+			if escapingBackslash {
 				var ch uint16
 				sb.WriteString(tkn.buf.ReadBuffer())
 				tkn.skip(1)
@@ -752,9 +1085,15 @@ func (tkn *Tokenizer) scanMySQLSpecificComment() (int, string) {
 
 	commentVersion, sql := ExtractMysqlComment(tkn.readBuffer())
 
-	if mySQLParserVersion >= commentVersion {
+	if tkn.version >= commentVersion {
 		// Only add the special comment to the tokenizer if the version of MySQL is higher or equal to the comment version
-		tkn.specialComment = NewStringTokenizer(sql)
+		tkn.specialComment = &Tokenizer{
+			buf:         buffer.NewStringBuffer(sql),
+			BindVars:    make(map[string]struct{}),
+			dialect:     tkn.dialect,
+			version:     tkn.version,
+			inStatement: true,
+		}
 	}
 
 	return tkn.Scan()
@@ -764,15 +1103,28 @@ func (tkn *Tokenizer) scanMySQLSpecificComment() (int, string) {
 func (tkn *Tokenizer) reset() {
 	tkn.ParseTree = nil
 	tkn.partialDDL = nil
+	tkn.inRoutine = false
 	tkn.specialComment = nil
 	tkn.posVarIndex = 0
 	tkn.SkipToEnd = false
+	tkn.inStatement = false
+	if tkn.positions != nil {
+		tkn.positions.reset()
+	}
+	tkn.comments = nil
+	tkn.nextComment = 0
+	tkn.lastEnd, tkn.lastComma = Position{}, false
+	tkn.attached = nil
 }
 
 func isLetter(ch uint16) bool {
 	return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_' || ch == '$'
 }
 
+func isBlank(ch uint16) bool {
+	return ch == ' ' || ch == '\n' || ch == '\r' || ch == '\t'
+}
+
 func isCarat(ch uint16) bool {
 	return ch == '.' || ch == '\'' || ch == '"' || ch == '`'
 }
@@ -72,6 +72,14 @@ func TestLiteralID(t *testing.T) {
 		in:  "@@`@x @y`",
 		id:  AT_AT_ID,
 		out: "@x @y",
+	}, {
+		in:  "@'x y'",
+		id:  AT_STRING,
+		out: "x y",
+	}, {
+		in:  `@"x"`,
+		id:  AT_STRING,
+		out: "x",
 	}}
 
 	for _, tcase := range testcases {
@@ -237,8 +245,8 @@ func TestVersion(t *testing.T) {
 
 	for _, tcase := range testcases {
 		t.Run(tcase.version+"_"+tcase.in, func(t *testing.T) {
-			mySQLParserVersion = tcase.version
-			tok := NewStringTokenizer(tcase.in)
+			parser := &Parser{version: tcase.version, dialect: MysqlDialect{}}
+			tok := parser.NewStringTokenizer(tcase.in)
 			for _, expectedID := range tcase.id {
 				id, _ := tok.Scan()
 				require.Equal(t, expectedID, id)
@@ -319,3 +327,73 @@ func TestIntegerAndID(t *testing.T) {
 		})
 	}
 }
+
+func TestPostgresTokens(t *testing.T) {
+	testcases := []struct {
+		in  string
+		id  int
+		out string
+	}{{
+		in:  `"aa"`,
+		id:  ID,
+		out: "aa",
+	}, {
+		in:  `"a""b"`,
+		id:  ID,
+		out: `a"b`,
+	}, {
+		in:  `"a`,
+		id:  LEX_ERROR,
+		out: "a",
+	}, {
+		in:  "`aa`",
+		id:  ID,
+		out: "aa",
+	}, {
+		in:  "'a\\b'",
+		id:  STRING,
+		out: "a\\b",
+	}, {
+		in:  "E'a\\nb\\''",
+		id:  STRING,
+		out: "a\nb'",
+	}, {
+		in:  "e'a''b'",
+		id:  STRING,
+		out: "a'b",
+	}, {
+		in:  "$12",
+		id:  POSITIONAL_ARG,
+		out: "12",
+	}, {
+		in:  "$tag$",
+		id:  ID,
+		out: "$tag$",
+	}, {
+		in: "::",
+		id: TYPECAST,
+	}, {
+		in: "||",
+		id: CONCAT_OP,
+	}, {
+		in: "[",
+		id: '[',
+	}, {
+		in:  "ILIKE",
+		id:  ILIKE,
+		out: "ILIKE",
+	}, {
+		in:  "returning",
+		id:  RETURNING,
+		out: "returning",
+	}}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tkn := NewStringTokenizer(tcase.in, WithDialect(PostgresDialect{}))
+			id, out := tkn.Scan()
+			require.Equal(t, tcase.id, id)
+			require.Equal(t, tcase.out, out)
+		})
+	}
+}
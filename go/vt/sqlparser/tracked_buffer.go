@@ -39,7 +39,12 @@ type TrackedBuffer struct {
 	literal       func(string) (int, error)
 	fast          bool
 
-	escape escapeType
+	escape   escapeType
+	dialect  Dialect
+	comments *NodeComments
+	// trailing are the trailing comments of the last node, not written
+	// until the comma that follows it is.
+	trailing []string
 }
 
 type escapeType int
@@ -103,6 +108,34 @@ func (buf *TrackedBuffer) SetEscapeNoIdentifier() {
 	buf.escape = escapeNoIdentifiers
 }
 
+// SetDialect sets the SQL dialect of the serialized query. Identifiers are quoted with the
+// quote character of the dialect, and string literals only use backslash escapes if the
+// dialect supports them. By default, the query is serialized for MySQL.
+// Enabling this option will prevent the optimized fastFormat routines from running.
+func (buf *TrackedBuffer) SetDialect(dialect Dialect) {
+	buf.fast = false
+	buf.dialect = dialect
+}
+
+// SetComments sets the comments printed around the nodes of the serialized query.
+// Enabling this option will prevent the optimized fastFormat routines from running.
+func (buf *TrackedBuffer) SetComments(comments *NodeComments) {
+	buf.fast = false
+	buf.comments = comments
+}
+
+// postgres reports whether the query is serialized for PostgreSQL.
+func (buf *TrackedBuffer) postgres() bool {
+	return isPostgres(buf.dialect)
+}
+
+func (buf *TrackedBuffer) identifierQuote() byte {
+	if buf.dialect == nil {
+		return '`'
+	}
+	return buf.dialect.IdentifierQuote()
+}
+
 // WriteNode function, initiates the writing of a single SQLNode tree by passing
 // through to Myprintf with a default format string
 func (buf *TrackedBuffer) WriteNode(node SQLNode) *TrackedBuffer {
@@ -229,6 +262,10 @@ func getExpressionForParensEval(checkParens bool, value any) Expr {
 }
 
 func (buf *TrackedBuffer) formatter(node SQLNode) {
+	if buf.comments != nil {
+		buf.writeCommentsBefore(node)
+		defer buf.writeCommentsAfter(node)
+	}
 	switch {
 	case buf.fast:
 		node.formatFast(buf)
@@ -321,6 +358,33 @@ func String(node SQLNode) string {
 	return buf.String()
 }
 
+// StringWithComments returns a string representation of an SQLNode with the
+// comments attached to its nodes, see WithComments. A line comment at the
+// end of the statement ends its line, which is not part of the result.
+func StringWithComments(node SQLNode, comments *NodeComments) string {
+	if node == nil {
+		return "<nil>"
+	}
+
+	buf := NewTrackedBuffer(nil)
+	buf.SetComments(comments)
+	buf.formatter(node)
+	buf.writeTrailingComments()
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// StringWithDialect returns a string representation of an SQLNode in the syntax of the given dialect.
+func StringWithDialect(node SQLNode, dialect Dialect) string {
+	if node == nil {
+		return "<nil>"
+	}
+
+	buf := NewTrackedBuffer(nil)
+	buf.SetDialect(dialect)
+	node.Format(buf)
+	return buf.String()
+}
+
 // UnescapedString will return a string where no identifiers have been escaped.
 func UnescapedString(node SQLNode) string {
 	if node == nil {